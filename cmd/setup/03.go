@@ -74,6 +74,8 @@ func (mig *FirstInstance) Execute(ctx context.Context) error {
 		nil,
 		nil,
 		nil,
+		nil,
+		nil,
 	)
 
 	if err != nil {
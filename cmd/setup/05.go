@@ -0,0 +1,30 @@
+package setup
+
+import (
+	"context"
+	"database/sql"
+)
+
+const (
+	addSAMLIDPConfigColumns = `
+ALTER TABLE auth.idp_configs
+    ADD COLUMN IF NOT EXISTS is_saml BOOL NULL,
+    ADD COLUMN IF NOT EXISTS saml_metadata BYTEA NULL,
+    ADD COLUMN IF NOT EXISTS saml_key JSONB NULL,
+    ADD COLUMN IF NOT EXISTS saml_certificate BYTEA NULL,
+    ADD COLUMN IF NOT EXISTS saml_with_signed_request BOOL NULL;
+`
+)
+
+type SAMLIDPConfigColumns struct {
+	dbClient *sql.DB
+}
+
+func (mig *SAMLIDPConfigColumns) Execute(ctx context.Context) error {
+	_, err := mig.dbClient.ExecContext(ctx, addSAMLIDPConfigColumns)
+	return err
+}
+
+func (mig *SAMLIDPConfigColumns) String() string {
+	return "05_saml_idp_config_columns"
+}
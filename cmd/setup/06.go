@@ -0,0 +1,39 @@
+package setup
+
+import (
+	"context"
+	"database/sql"
+)
+
+const (
+	addLDAPIDPConfigColumns = `
+ALTER TABLE auth.idp_configs
+    ADD COLUMN IF NOT EXISTS is_ldap BOOL NULL,
+    ADD COLUMN IF NOT EXISTS ldap_url TEXT NULL,
+    ADD COLUMN IF NOT EXISTS ldap_start_tls BOOL NULL,
+    ADD COLUMN IF NOT EXISTS ldap_base_dn TEXT NULL,
+    ADD COLUMN IF NOT EXISTS ldap_bind_dn TEXT NULL,
+    ADD COLUMN IF NOT EXISTS ldap_bind_password JSONB NULL,
+    ADD COLUMN IF NOT EXISTS ldap_user_filter TEXT NULL,
+    ADD COLUMN IF NOT EXISTS ldap_id_attribute TEXT NULL,
+    ADD COLUMN IF NOT EXISTS ldap_preferred_username_attribute TEXT NULL,
+    ADD COLUMN IF NOT EXISTS ldap_first_name_attribute TEXT NULL,
+    ADD COLUMN IF NOT EXISTS ldap_last_name_attribute TEXT NULL,
+    ADD COLUMN IF NOT EXISTS ldap_display_name_attribute TEXT NULL,
+    ADD COLUMN IF NOT EXISTS ldap_email_attribute TEXT NULL,
+    ADD COLUMN IF NOT EXISTS ldap_phone_attribute TEXT NULL;
+`
+)
+
+type LDAPIDPConfigColumns struct {
+	dbClient *sql.DB
+}
+
+func (mig *LDAPIDPConfigColumns) Execute(ctx context.Context) error {
+	_, err := mig.dbClient.ExecContext(ctx, addLDAPIDPConfigColumns)
+	return err
+}
+
+func (mig *LDAPIDPConfigColumns) String() string {
+	return "06_ldap_idp_config_columns"
+}
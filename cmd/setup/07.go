@@ -0,0 +1,34 @@
+package setup
+
+import (
+	"context"
+	"database/sql"
+)
+
+const (
+	addOAuth2IDPConfigColumns = `
+ALTER TABLE auth.idp_configs
+    ADD COLUMN IF NOT EXISTS is_oauth2 BOOL NULL,
+    ADD COLUMN IF NOT EXISTS oauth2_user_endpoint TEXT NULL,
+    ADD COLUMN IF NOT EXISTS oauth2_id_path TEXT NULL,
+    ADD COLUMN IF NOT EXISTS oauth2_preferred_username_path TEXT NULL,
+    ADD COLUMN IF NOT EXISTS oauth2_first_name_path TEXT NULL,
+    ADD COLUMN IF NOT EXISTS oauth2_last_name_path TEXT NULL,
+    ADD COLUMN IF NOT EXISTS oauth2_display_name_path TEXT NULL,
+    ADD COLUMN IF NOT EXISTS oauth2_email_path TEXT NULL,
+    ADD COLUMN IF NOT EXISTS oauth2_phone_path TEXT NULL;
+`
+)
+
+type OAuth2IDPConfigColumns struct {
+	dbClient *sql.DB
+}
+
+func (mig *OAuth2IDPConfigColumns) Execute(ctx context.Context) error {
+	_, err := mig.dbClient.ExecContext(ctx, addOAuth2IDPConfigColumns)
+	return err
+}
+
+func (mig *OAuth2IDPConfigColumns) String() string {
+	return "07_oauth2_idp_config_columns"
+}
@@ -0,0 +1,41 @@
+package setup
+
+import (
+	"context"
+	"database/sql"
+)
+
+const (
+	createActionExecutions = `
+CREATE TABLE system.action_executions (
+    instance_id TEXT NOT NULL,
+    resource_owner TEXT NOT NULL,
+    id TEXT NOT NULL,
+    action_id TEXT NOT NULL,
+    action_name TEXT NOT NULL,
+    flow_type INT2 NOT NULL,
+    trigger_type INT2 NOT NULL,
+    creation_date TIMESTAMPTZ NOT NULL,
+    duration INT8 NOT NULL,
+    outcome INT2 NOT NULL,
+    error TEXT,
+    logs JSONB,
+
+    PRIMARY KEY (instance_id, resource_owner, id)
+);
+CREATE INDEX action_executions_creation_date_idx ON system.action_executions (instance_id, resource_owner, creation_date DESC);
+`
+)
+
+type ActionExecutionTable struct {
+	dbClient *sql.DB
+}
+
+func (mig *ActionExecutionTable) Execute(ctx context.Context) error {
+	_, err := mig.dbClient.ExecContext(ctx, createActionExecutions)
+	return err
+}
+
+func (mig *ActionExecutionTable) String() string {
+	return "08_action_executions"
+}
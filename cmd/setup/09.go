@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	"database/sql"
+)
+
+const (
+	addOTPFactorColumns = `
+ALTER TABLE auth.users
+    ADD COLUMN IF NOT EXISTS otp_sms_added BOOL NULL,
+    ADD COLUMN IF NOT EXISTS otp_email_added BOOL NULL;
+`
+)
+
+type OTPFactorColumns struct {
+	dbClient *sql.DB
+}
+
+func (mig *OTPFactorColumns) Execute(ctx context.Context) error {
+	_, err := mig.dbClient.ExecContext(ctx, addOTPFactorColumns)
+	return err
+}
+
+func (mig *OTPFactorColumns) String() string {
+	return "09_otp_factor_columns"
+}
@@ -0,0 +1,26 @@
+package setup
+
+import (
+	"context"
+	"database/sql"
+)
+
+const (
+	addRecoveryCodesColumn = `
+ALTER TABLE auth.users
+    ADD COLUMN IF NOT EXISTS recovery_codes_added BOOL NULL;
+`
+)
+
+type RecoveryCodesColumn struct {
+	dbClient *sql.DB
+}
+
+func (mig *RecoveryCodesColumn) Execute(ctx context.Context) error {
+	_, err := mig.dbClient.ExecContext(ctx, addRecoveryCodesColumn)
+	return err
+}
+
+func (mig *RecoveryCodesColumn) String() string {
+	return "10_recovery_codes_column"
+}
@@ -0,0 +1,28 @@
+package setup
+
+import (
+	"context"
+	"database/sql"
+)
+
+const (
+	// the projection table is created on start, so it might not exist yet
+	addOIDCLogoutURIColumns = `
+ALTER TABLE IF EXISTS projections.apps3_oidc_configs
+    ADD COLUMN IF NOT EXISTS back_channel_logout_uri TEXT NULL,
+    ADD COLUMN IF NOT EXISTS front_channel_logout_uri TEXT NULL;
+`
+)
+
+type OIDCLogoutURIColumns struct {
+	dbClient *sql.DB
+}
+
+func (mig *OIDCLogoutURIColumns) Execute(ctx context.Context) error {
+	_, err := mig.dbClient.ExecContext(ctx, addOIDCLogoutURIColumns)
+	return err
+}
+
+func (mig *OIDCLogoutURIColumns) String() string {
+	return "11_oidc_logout_uri_columns"
+}
@@ -0,0 +1,28 @@
+package setup
+
+import (
+	"context"
+	"database/sql"
+)
+
+const (
+	// the projection table is created on start, so it might not exist yet
+	addOIDCRequestObjectColumns = `
+ALTER TABLE IF EXISTS projections.apps3_oidc_configs
+    ADD COLUMN IF NOT EXISTS require_pushed_auth_requests BOOLEAN NOT NULL DEFAULT false,
+    ADD COLUMN IF NOT EXISTS require_signed_request_object BOOLEAN NOT NULL DEFAULT false;
+`
+)
+
+type OIDCRequestObjectColumns struct {
+	dbClient *sql.DB
+}
+
+func (mig *OIDCRequestObjectColumns) Execute(ctx context.Context) error {
+	_, err := mig.dbClient.ExecContext(ctx, addOIDCRequestObjectColumns)
+	return err
+}
+
+func (mig *OIDCRequestObjectColumns) String() string {
+	return "12_oidc_request_object_columns"
+}
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	"database/sql"
+)
+
+const (
+	addTokenConfirmationColumns = `
+ALTER TABLE auth.tokens
+    ADD COLUMN IF NOT EXISTS dpop_jkt TEXT,
+    ADD COLUMN IF NOT EXISTS certificate_thumbprint TEXT;
+`
+)
+
+type TokenConfirmationColumns struct {
+	dbClient *sql.DB
+}
+
+func (mig *TokenConfirmationColumns) Execute(ctx context.Context) error {
+	_, err := mig.dbClient.ExecContext(ctx, addTokenConfirmationColumns)
+	return err
+}
+
+func (mig *TokenConfirmationColumns) String() string {
+	return "13_token_confirmation_columns"
+}
@@ -0,0 +1,32 @@
+package setup
+
+import (
+	"context"
+	"database/sql"
+)
+
+const (
+	createQuotaPeriods = `
+CREATE TABLE system.quota_periods (
+    instance_id TEXT NOT NULL,
+    unit INT2 NOT NULL,
+    start TIMESTAMPTZ NOT NULL,
+    usage INT8 NOT NULL DEFAULT 0,
+
+    PRIMARY KEY (instance_id, unit, start)
+);
+`
+)
+
+type QuotaPeriodTable struct {
+	dbClient *sql.DB
+}
+
+func (mig *QuotaPeriodTable) Execute(ctx context.Context) error {
+	_, err := mig.dbClient.ExecContext(ctx, createQuotaPeriods)
+	return err
+}
+
+func (mig *QuotaPeriodTable) String() string {
+	return "14_quota_periods"
+}
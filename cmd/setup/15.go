@@ -0,0 +1,52 @@
+package setup
+
+import (
+	"context"
+	"database/sql"
+)
+
+const (
+	// the projection table is created on start, so it might not exist yet
+	addPasswordExpiryColumns = `
+ALTER TABLE IF EXISTS projections.users4_notifications
+    ADD COLUMN IF NOT EXISTS password_changed TIMESTAMPTZ NULL,
+    ADD COLUMN IF NOT EXISTS password_expiry_notified TIMESTAMPTZ NULL;
+`
+	notificationsTableExists = `
+SELECT EXISTS(
+    SELECT 1 FROM information_schema.tables
+    WHERE table_schema = 'projections' AND table_name = 'users4_notifications'
+);
+`
+	// existing passwords get the change date of the auth user view,
+	// otherwise they would never be considered for expiry notifications
+	backfillPasswordChanged = `
+UPDATE projections.users4_notifications n
+    SET password_changed = u.password_change
+    FROM auth.users u
+    WHERE u.id = n.user_id
+        AND u.instance_id = n.instance_id
+        AND n.password_set
+        AND n.password_changed IS NULL;
+`
+)
+
+type PasswordExpiryColumns struct {
+	dbClient *sql.DB
+}
+
+func (mig *PasswordExpiryColumns) Execute(ctx context.Context) error {
+	if _, err := mig.dbClient.ExecContext(ctx, addPasswordExpiryColumns); err != nil {
+		return err
+	}
+	var exists bool
+	if err := mig.dbClient.QueryRowContext(ctx, notificationsTableExists).Scan(&exists); err != nil || !exists {
+		return err
+	}
+	_, err := mig.dbClient.ExecContext(ctx, backfillPasswordChanged)
+	return err
+}
+
+func (mig *PasswordExpiryColumns) String() string {
+	return "15_password_expiry_columns"
+}
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	"database/sql"
+)
+
+const (
+	// the projection table is created on start, so it might not exist yet
+	addPasswordHistoryCountColumn = `
+ALTER TABLE IF EXISTS projections.password_age_policies
+    ADD COLUMN IF NOT EXISTS history_count BIGINT NOT NULL DEFAULT 0;
+`
+)
+
+type PasswordHistoryCountColumn struct {
+	dbClient *sql.DB
+}
+
+func (mig *PasswordHistoryCountColumn) Execute(ctx context.Context) error {
+	_, err := mig.dbClient.ExecContext(ctx, addPasswordHistoryCountColumn)
+	return err
+}
+
+func (mig *PasswordHistoryCountColumn) String() string {
+	return "16_password_history_count_column"
+}
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	"database/sql"
+)
+
+const (
+	// the projection table is created on start, so it might not exist yet
+	addBreachedPasswordCheckColumn = `
+ALTER TABLE IF EXISTS projections.password_complexity_policies
+    ADD COLUMN IF NOT EXISTS breached_password_check SMALLINT NOT NULL DEFAULT 0;
+`
+)
+
+type BreachedPasswordCheckColumn struct {
+	dbClient *sql.DB
+}
+
+func (mig *BreachedPasswordCheckColumn) Execute(ctx context.Context) error {
+	_, err := mig.dbClient.ExecContext(ctx, addBreachedPasswordCheckColumn)
+	return err
+}
+
+func (mig *BreachedPasswordCheckColumn) String() string {
+	return "17_breached_password_check_column"
+}
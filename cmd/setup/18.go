@@ -0,0 +1,38 @@
+package setup
+
+import (
+	"context"
+	"database/sql"
+)
+
+const (
+	// the projection table is created on start, so it might not exist yet
+	addLockoutPolicyColumns = `
+ALTER TABLE IF EXISTS projections.lockout_policies
+    ADD COLUMN IF NOT EXISTS max_otp_attempts INT8 NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS max_u2f_attempts INT8 NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS auto_unlock_after INT8 NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS attempt_delay INT8 NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS max_attempt_delay INT8 NOT NULL DEFAULT 0;
+`
+	addUserLockedAtColumn = `
+ALTER TABLE auth.users
+    ADD COLUMN IF NOT EXISTS locked_at TIMESTAMPTZ NULL;
+`
+)
+
+type LockoutPolicyColumns struct {
+	dbClient *sql.DB
+}
+
+func (mig *LockoutPolicyColumns) Execute(ctx context.Context) error {
+	if _, err := mig.dbClient.ExecContext(ctx, addLockoutPolicyColumns); err != nil {
+		return err
+	}
+	_, err := mig.dbClient.ExecContext(ctx, addUserLockedAtColumn)
+	return err
+}
+
+func (mig *LockoutPolicyColumns) String() string {
+	return "18_lockout_policy_columns"
+}
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	"database/sql"
+)
+
+const (
+	// the projection table is created on start, so it might not exist yet
+	addTrustedDeviceLifetimeColumn = `
+ALTER TABLE IF EXISTS projections.login_policies3
+    ADD COLUMN IF NOT EXISTS trusted_device_lifetime INT8 NOT NULL DEFAULT 0;
+`
+)
+
+type TrustedDeviceLifetimeColumn struct {
+	dbClient *sql.DB
+}
+
+func (mig *TrustedDeviceLifetimeColumn) Execute(ctx context.Context) error {
+	_, err := mig.dbClient.ExecContext(ctx, addTrustedDeviceLifetimeColumn)
+	return err
+}
+
+func (mig *TrustedDeviceLifetimeColumn) String() string {
+	return "19_trusted_device_lifetime_column"
+}
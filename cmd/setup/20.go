@@ -0,0 +1,26 @@
+package setup
+
+import (
+	"context"
+	"database/sql"
+)
+
+const (
+	addTokenActorColumn = `
+ALTER TABLE auth.tokens
+    ADD COLUMN IF NOT EXISTS actor JSONB;
+`
+)
+
+type TokenActorColumn struct {
+	dbClient *sql.DB
+}
+
+func (mig *TokenActorColumn) Execute(ctx context.Context) error {
+	_, err := mig.dbClient.ExecContext(ctx, addTokenActorColumn)
+	return err
+}
+
+func (mig *TokenActorColumn) String() string {
+	return "20_token_actor_column"
+}
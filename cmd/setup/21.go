@@ -0,0 +1,36 @@
+package setup
+
+import (
+	"context"
+	"database/sql"
+)
+
+const (
+	createDeliveries = `
+CREATE TABLE system.deliveries (
+    instance_id TEXT NOT NULL,
+    kind TEXT NOT NULL,
+    id TEXT NOT NULL,
+    creation_date TIMESTAMPTZ NOT NULL,
+    payload JSONB NOT NULL,
+    attempts INT4 NOT NULL DEFAULT 0,
+    next_attempt TIMESTAMPTZ NOT NULL,
+
+    PRIMARY KEY (instance_id, kind, id)
+);
+CREATE INDEX deliveries_next_attempt_idx ON system.deliveries (kind, next_attempt);
+`
+)
+
+type DeliveryTable struct {
+	dbClient *sql.DB
+}
+
+func (mig *DeliveryTable) Execute(ctx context.Context) error {
+	_, err := mig.dbClient.ExecContext(ctx, createDeliveries)
+	return err
+}
+
+func (mig *DeliveryTable) String() string {
+	return "21_deliveries"
+}
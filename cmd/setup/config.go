@@ -52,10 +52,27 @@ func MustNewConfig(v *viper.Viper) *Config {
 }
 
 type Steps struct {
-	s1ProjectionTable   *ProjectionTable
-	s2AssetsTable       *AssetTable
-	FirstInstance       *FirstInstance
-	s4EventstoreIndexes *EventstoreIndexes
+	s1ProjectionTable      *ProjectionTable
+	s2AssetsTable          *AssetTable
+	FirstInstance          *FirstInstance
+	s4EventstoreIndexes    *EventstoreIndexes
+	s5SAMLIDPConfig        *SAMLIDPConfigColumns
+	s6LDAPIDPConfig        *LDAPIDPConfigColumns
+	s7OAuth2IDPConfig      *OAuth2IDPConfigColumns
+	s8ActionExecutions     *ActionExecutionTable
+	s9OTPFactorColumns     *OTPFactorColumns
+	s10RecoveryCodesColumn *RecoveryCodesColumn
+	s11OIDCLogoutURIs      *OIDCLogoutURIColumns
+	s12OIDCRequestObject   *OIDCRequestObjectColumns
+	s13TokenConfirmation   *TokenConfirmationColumns
+	s14QuotaPeriods        *QuotaPeriodTable
+	s15PasswordExpiry      *PasswordExpiryColumns
+	s16PasswordHistory     *PasswordHistoryCountColumn
+	s17BreachedPasswords   *BreachedPasswordCheckColumn
+	s18LockoutPolicy       *LockoutPolicyColumns
+	s19TrustedDevices      *TrustedDeviceLifetimeColumn
+	s20TokenActor          *TokenActorColumn
+	s21Deliveries          *DeliveryTable
 }
 
 type encryptionKeyConfig struct {
@@ -79,6 +79,23 @@ func Setup(config *Config, steps *Steps, masterKey string) {
 	steps.FirstInstance.externalPort = config.ExternalPort
 
 	steps.s4EventstoreIndexes = &EventstoreIndexes{dbClient: dbClient, dbType: config.Database.Type()}
+	steps.s5SAMLIDPConfig = &SAMLIDPConfigColumns{dbClient: dbClient}
+	steps.s6LDAPIDPConfig = &LDAPIDPConfigColumns{dbClient: dbClient}
+	steps.s7OAuth2IDPConfig = &OAuth2IDPConfigColumns{dbClient: dbClient}
+	steps.s8ActionExecutions = &ActionExecutionTable{dbClient: dbClient}
+	steps.s9OTPFactorColumns = &OTPFactorColumns{dbClient: dbClient}
+	steps.s10RecoveryCodesColumn = &RecoveryCodesColumn{dbClient: dbClient}
+	steps.s11OIDCLogoutURIs = &OIDCLogoutURIColumns{dbClient: dbClient}
+	steps.s12OIDCRequestObject = &OIDCRequestObjectColumns{dbClient: dbClient}
+	steps.s13TokenConfirmation = &TokenConfirmationColumns{dbClient: dbClient}
+	steps.s14QuotaPeriods = &QuotaPeriodTable{dbClient: dbClient}
+	steps.s15PasswordExpiry = &PasswordExpiryColumns{dbClient: dbClient}
+	steps.s16PasswordHistory = &PasswordHistoryCountColumn{dbClient: dbClient}
+	steps.s17BreachedPasswords = &BreachedPasswordCheckColumn{dbClient: dbClient}
+	steps.s18LockoutPolicy = &LockoutPolicyColumns{dbClient: dbClient}
+	steps.s19TrustedDevices = &TrustedDeviceLifetimeColumn{dbClient: dbClient}
+	steps.s20TokenActor = &TokenActorColumn{dbClient: dbClient}
+	steps.s21Deliveries = &DeliveryTable{dbClient: dbClient}
 
 	repeatableSteps := []migration.RepeatableMigration{
 		&externalConfigChange{
@@ -98,6 +115,40 @@ func Setup(config *Config, steps *Steps, masterKey string) {
 	logging.OnError(err).Fatal("unable to migrate step 3")
 	err = migration.Migrate(ctx, eventstoreClient, steps.s4EventstoreIndexes)
 	logging.OnError(err).Fatal("unable to migrate step 4")
+	err = migration.Migrate(ctx, eventstoreClient, steps.s5SAMLIDPConfig)
+	logging.OnError(err).Fatal("unable to migrate step 5")
+	err = migration.Migrate(ctx, eventstoreClient, steps.s6LDAPIDPConfig)
+	logging.OnError(err).Fatal("unable to migrate step 6")
+	err = migration.Migrate(ctx, eventstoreClient, steps.s7OAuth2IDPConfig)
+	logging.OnError(err).Fatal("unable to migrate step 7")
+	err = migration.Migrate(ctx, eventstoreClient, steps.s8ActionExecutions)
+	logging.OnError(err).Fatal("unable to migrate step 8")
+	err = migration.Migrate(ctx, eventstoreClient, steps.s9OTPFactorColumns)
+	logging.OnError(err).Fatal("unable to migrate step 9")
+	err = migration.Migrate(ctx, eventstoreClient, steps.s10RecoveryCodesColumn)
+	logging.OnError(err).Fatal("unable to migrate step 10")
+	err = migration.Migrate(ctx, eventstoreClient, steps.s11OIDCLogoutURIs)
+	logging.OnError(err).Fatal("unable to migrate step 11")
+	err = migration.Migrate(ctx, eventstoreClient, steps.s12OIDCRequestObject)
+	logging.OnError(err).Fatal("unable to migrate step 12")
+	err = migration.Migrate(ctx, eventstoreClient, steps.s13TokenConfirmation)
+	logging.OnError(err).Fatal("unable to migrate step 13")
+	err = migration.Migrate(ctx, eventstoreClient, steps.s14QuotaPeriods)
+	logging.OnError(err).Fatal("unable to migrate step 14")
+	err = migration.Migrate(ctx, eventstoreClient, steps.s15PasswordExpiry)
+	logging.OnError(err).Fatal("unable to migrate step 15")
+	err = migration.Migrate(ctx, eventstoreClient, steps.s16PasswordHistory)
+	logging.OnError(err).Fatal("unable to migrate step 16")
+	err = migration.Migrate(ctx, eventstoreClient, steps.s17BreachedPasswords)
+	logging.OnError(err).Fatal("unable to migrate step 17")
+	err = migration.Migrate(ctx, eventstoreClient, steps.s18LockoutPolicy)
+	logging.OnError(err).Fatal("unable to migrate step 18")
+	err = migration.Migrate(ctx, eventstoreClient, steps.s19TrustedDevices)
+	logging.OnError(err).Fatal("unable to migrate step 19")
+	err = migration.Migrate(ctx, eventstoreClient, steps.s20TokenActor)
+	logging.OnError(err).Fatal("unable to migrate step 20")
+	err = migration.Migrate(ctx, eventstoreClient, steps.s21Deliveries)
+	logging.OnError(err).Fatal("unable to migrate step 21")
 
 	for _, repeatableStep := range repeatableSteps {
 		err = migration.Migrate(ctx, eventstoreClient, repeatableStep)
@@ -24,6 +24,8 @@ import (
 	"github.com/dennigogo/zitadel/internal/database"
 	"github.com/dennigogo/zitadel/internal/id"
 	"github.com/dennigogo/zitadel/internal/query/projection"
+	"github.com/dennigogo/zitadel/internal/quota"
+	"github.com/dennigogo/zitadel/internal/ratelimit"
 	static_config "github.com/dennigogo/zitadel/internal/static/config"
 	metrics "github.com/dennigogo/zitadel/internal/telemetry/metrics/config"
 	tracing "github.com/dennigogo/zitadel/internal/telemetry/tracing/config"
@@ -60,6 +62,8 @@ type Config struct {
 	CustomerPortal    string
 	Machine           *id.Config
 	Actions           *actions.Config
+	Quotas            quota.Config
+	RateLimits        ratelimit.Config
 }
 
 func MustNewConfig(v *viper.Viper) *Config {
@@ -101,6 +105,7 @@ type encryptionKeyConfig struct {
 	SMS                  *crypto.KeyConfig
 	SMTP                 *crypto.KeyConfig
 	User                 *crypto.KeyConfig
+	Webhook              *crypto.KeyConfig
 	CSRFCookieKeyID      string
 	UserAgentCookieKeyID string
 }
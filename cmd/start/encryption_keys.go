@@ -15,6 +15,7 @@ var (
 		"smsKey",
 		"smtpKey",
 		"userKey",
+		"webhookKey",
 		"csrfCookieKey",
 		"userAgentCookieKey",
 	}
@@ -29,6 +30,7 @@ type encryptionKeys struct {
 	SMS                crypto.EncryptionAlgorithm
 	SMTP               crypto.EncryptionAlgorithm
 	User               crypto.EncryptionAlgorithm
+	Webhook            crypto.EncryptionAlgorithm
 	CSRFCookieKey      []byte
 	UserAgentCookieKey []byte
 	OIDCKey            []byte
@@ -76,6 +78,10 @@ func ensureEncryptionKeys(keyConfig *encryptionKeyConfig, keyStorage crypto.KeyS
 	if err != nil {
 		return nil, err
 	}
+	keys.Webhook, err = crypto.NewAESCrypto(keyConfig.Webhook, keyStorage)
+	if err != nil {
+		return nil, err
+	}
 	key, err = crypto.LoadKey(keyConfig.CSRFCookieKeyID, keyStorage)
 	if err != nil {
 		return nil, err
@@ -27,6 +27,8 @@ import (
 
 	"github.com/dennigogo/zitadel/cmd/key"
 	cmd_tls "github.com/dennigogo/zitadel/cmd/tls"
+	"github.com/dennigogo/zitadel/internal/actions"
+	"github.com/dennigogo/zitadel/internal/actions/recorder"
 	admin_es "github.com/dennigogo/zitadel/internal/admin/repository/eventsourcing"
 	"github.com/dennigogo/zitadel/internal/api"
 	"github.com/dennigogo/zitadel/internal/api/assets"
@@ -38,6 +40,7 @@ import (
 	http_util "github.com/dennigogo/zitadel/internal/api/http"
 	"github.com/dennigogo/zitadel/internal/api/http/middleware"
 	"github.com/dennigogo/zitadel/internal/api/oidc"
+	"github.com/dennigogo/zitadel/internal/api/scim"
 	"github.com/dennigogo/zitadel/internal/api/ui/console"
 	"github.com/dennigogo/zitadel/internal/api/ui/login"
 	auth_es "github.com/dennigogo/zitadel/internal/auth/repository/eventsourcing"
@@ -50,8 +53,11 @@ import (
 	"github.com/dennigogo/zitadel/internal/id"
 	"github.com/dennigogo/zitadel/internal/notification"
 	"github.com/dennigogo/zitadel/internal/query"
+	"github.com/dennigogo/zitadel/internal/quota"
+	"github.com/dennigogo/zitadel/internal/ratelimit"
 	"github.com/dennigogo/zitadel/internal/static"
 	"github.com/dennigogo/zitadel/internal/webauthn"
+	"github.com/dennigogo/zitadel/internal/webhook"
 	"github.com/dennigogo/zitadel/openapi"
 )
 
@@ -122,6 +128,9 @@ func startZitadel(config *Config, masterKey string) error {
 		DisplayName:    config.WebAuthNName,
 		ExternalSecure: config.ExternalSecure,
 	}
+	quotas := quota.Start(ctx, dbClient, queries, config.Quotas)
+	actions.SetQuotaEnforcer(quotas)
+
 	commands, err := command.StartCommands(
 		eventstoreClient,
 		config.SystemDefaults,
@@ -139,27 +148,35 @@ func startZitadel(config *Config, masterKey string) error {
 		keys.DomainVerification,
 		keys.OIDC,
 		keys.SAML,
+		keys.Webhook,
 		&http.Client{},
+		quotas,
 	)
 	if err != nil {
 		return fmt.Errorf("cannot start commands: %w", err)
 	}
 
-	notification.Start(ctx, config.Projections.Customizations["notifications"], config.ExternalPort, config.ExternalSecure, commands, queries, eventstoreClient, assets.AssetAPIFromDomain(config.ExternalSecure, config.ExternalPort), config.SystemDefaults.Notifications.FileSystemPath, keys.User, keys.SMTP, keys.SMS)
+	limiter := ratelimit.Start(ctx, queries, config.RateLimits)
+
+	notification.Start(ctx, config.Projections.Customizations["notifications"], config.ExternalPort, config.ExternalSecure, commands, queries, eventstoreClient, assets.AssetAPIFromDomain(config.ExternalSecure, config.ExternalPort), config.SystemDefaults.Notifications.FileSystemPath, config.SystemDefaults.Notifications.PasswordExpiryCheckInterval, keys.User, keys.SMTP, keys.SMS, quotas)
+	webhook.Start(ctx, config.Projections.Customizations["webhook_deliveries"], config.SystemDefaults.Webhooks.Delivery, queries, keys.Webhook)
+	if config.Actions.Executions.Enabled {
+		actions.SetExecutionRecorder(recorder.Start(ctx, dbClient, config.Actions.Executions))
+	}
 
 	router := mux.NewRouter()
 	tlsConfig, err := config.TLS.Config()
 	if err != nil {
 		return err
 	}
-	err = startAPIs(ctx, router, commands, queries, eventstoreClient, dbClient, config, storage, authZRepo, keys)
+	err = startAPIs(ctx, router, commands, queries, eventstoreClient, dbClient, config, storage, authZRepo, keys, quotas, limiter)
 	if err != nil {
 		return err
 	}
 	return listen(ctx, router, config.Port, tlsConfig)
 }
 
-func startAPIs(ctx context.Context, router *mux.Router, commands *command.Commands, queries *query.Queries, eventstore *eventstore.Eventstore, dbClient *sql.DB, config *Config, store static.Storage, authZRepo authz_repo.Repository, keys *encryptionKeys) error {
+func startAPIs(ctx context.Context, router *mux.Router, commands *command.Commands, queries *query.Queries, eventstore *eventstore.Eventstore, dbClient *sql.DB, config *Config, store static.Storage, authZRepo authz_repo.Repository, keys *encryptionKeys, quotas quota.Enforcer, limiter ratelimit.Checker) error {
 	repo := struct {
 		authz_repo.Repository
 		*query.Queries
@@ -172,7 +189,7 @@ func startAPIs(ctx context.Context, router *mux.Router, commands *command.Comman
 	if err != nil {
 		return err
 	}
-	apis := api.New(config.Port, router, queries, verifier, config.InternalAuthZ, config.ExternalSecure, tlsConfig, config.HTTP2HostHeader, config.HTTP1HostHeader)
+	apis := api.New(config.Port, router, queries, quotas, limiter, verifier, config.InternalAuthZ, config.ExternalSecure, tlsConfig, config.TLS.TrustClientCertificateHeader, config.HTTP2HostHeader, config.HTTP1HostHeader)
 	authRepo, err := auth_es.Start(config.Auth, config.SystemDefaults, commands, queries, dbClient, keys.OIDC, keys.User)
 	if err != nil {
 		return fmt.Errorf("error starting auth repo: %w", err)
@@ -194,9 +211,16 @@ func startAPIs(ctx context.Context, router *mux.Router, commands *command.Comman
 		return err
 	}
 
-	instanceInterceptor := middleware.InstanceInterceptor(queries, config.HTTP1HostHeader, login.IgnoreInstanceEndpoints...)
+	instanceInterceptor := middleware.InstanceInterceptor(queries, config.HTTP1HostHeader, quotas, login.IgnoreInstanceEndpoints...)
+	rateLimitInterceptor := middleware.RateLimitInterceptor(limiter)
+	clientRateLimitInterceptor := middleware.ClientRateLimitInterceptor(limiter)
+	// the requests are limited after the instance of the request is known
+	instanceHandler := func(handler http.Handler) http.Handler {
+		return instanceInterceptor.Handler(rateLimitInterceptor.Handler(handler))
+	}
 	assetsCache := middleware.AssetsCacheInterceptor(config.AssetStorage.Cache.MaxAge, config.AssetStorage.Cache.SharedMaxAge)
-	apis.RegisterHandler(assets.HandlerPrefix, assets.NewHandler(commands, verifier, config.InternalAuthZ, id.SonyFlakeGenerator(), store, queries, instanceInterceptor.Handler, assetsCache.Handler))
+	apis.RegisterHandler(assets.HandlerPrefix, assets.NewHandler(commands, verifier, config.InternalAuthZ, id.SonyFlakeGenerator(), store, queries, instanceHandler, assetsCache.Handler))
+	apis.RegisterHandler(scim.HandlerPrefix, scim.NewHandler(commands, queries, verifier, config.InternalAuthZ, keys.User, config.ExternalSecure, instanceHandler, clientRateLimitInterceptor.Handler))
 
 	userAgentInterceptor, err := middleware.NewUserAgentHandler(config.UserAgentCookie, keys.UserAgentCookieKey, id.SonyFlakeGenerator(), config.ExternalSecure, login.EndpointResources)
 	if err != nil {
@@ -209,12 +233,13 @@ func startAPIs(ctx context.Context, router *mux.Router, commands *command.Comman
 	}
 	apis.RegisterHandler(openapi.HandlerPrefix, openAPIHandler)
 
-	oidcProvider, err := oidc.NewProvider(ctx, config.OIDC, login.DefaultLoggedOutPath, config.ExternalSecure, commands, queries, authRepo, keys.OIDC, keys.OIDCKey, eventstore, dbClient, userAgentInterceptor, instanceInterceptor.Handler)
+	oidcProvider, err := oidc.NewProvider(ctx, config.OIDC, login.DefaultLoggedOutPath, config.ExternalSecure, config.TLS.TrustClientCertificateHeader, commands, queries, authRepo, keys.OIDC, keys.OIDCKey, eventstore, dbClient, verifier, config.InternalAuthZ, userAgentInterceptor, instanceHandler)
 	if err != nil {
 		return fmt.Errorf("unable to start oidc provider: %w", err)
 	}
+	oidc.StartBackChannelLogout(ctx, config.Projections.Customizations["oidc_backchannel_logouts"], config.OIDC.BackChannelLogout, config.ExternalPort, config.ExternalSecure, queries, oidcProvider)
 
-	samlProvider, err := saml.NewProvider(ctx, config.SAML, config.ExternalSecure, commands, queries, authRepo, keys.OIDC, keys.SAML, eventstore, dbClient, instanceInterceptor.Handler, userAgentInterceptor)
+	samlProvider, err := saml.NewProvider(ctx, config.SAML, config.ExternalSecure, commands, queries, authRepo, keys.OIDC, keys.SAML, eventstore, dbClient, instanceHandler, userAgentInterceptor)
 	if err != nil {
 		return fmt.Errorf("unable to start saml provider: %w", err)
 	}
@@ -226,7 +251,7 @@ func startAPIs(ctx context.Context, router *mux.Router, commands *command.Comman
 	}
 	apis.RegisterHandler(console.HandlerPrefix, c)
 
-	l, err := login.CreateLogin(config.Login, commands, queries, authRepo, store, console.HandlerPrefix+"/", op.AuthCallbackURL(oidcProvider), provider.AuthCallbackURL(samlProvider), config.ExternalSecure, userAgentInterceptor, op.NewIssuerInterceptor(oidcProvider.IssuerFromRequest).Handler, provider.NewIssuerInterceptor(samlProvider.IssuerFromRequest).Handler, instanceInterceptor.Handler, assetsCache.Handler, keys.User, keys.IDPConfig, keys.CSRFCookieKey)
+	l, err := login.CreateLogin(config.Login, commands, queries, authRepo, store, console.HandlerPrefix+"/", op.AuthCallbackURL(oidcProvider), provider.AuthCallbackURL(samlProvider), oidc.AuthMethodsReferences, config.ExternalSecure, userAgentInterceptor, op.NewIssuerInterceptor(oidcProvider.IssuerFromRequest).Handler, provider.NewIssuerInterceptor(samlProvider.IssuerFromRequest).Handler, instanceHandler, assetsCache.Handler, keys.User, keys.IDPConfig, keys.CSRFCookieKey)
 	if err != nil {
 		return fmt.Errorf("unable to start login: %w", err)
 	}
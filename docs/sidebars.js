@@ -201,6 +201,7 @@ module.exports = {
             "apis/proto/admin",
             "apis/proto/system",
             "apis/proto/instance",
+            "apis/proto/quota",
             "apis/proto/org",
             "apis/proto/user",
             "apis/proto/app",
@@ -224,6 +225,7 @@ module.exports = {
           items: ["apis/assets/assets"],
         },
         "apis/actions",
+        "apis/scim",
       ],
     },
     {
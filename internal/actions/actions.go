@@ -4,14 +4,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
+	"github.com/dennigogo/zitadel/internal/domain"
 	z_errs "github.com/dennigogo/zitadel/internal/errors"
 	"github.com/dennigogo/zitadel/internal/query"
 	"github.com/dop251/goja_nodejs/require"
 )
 
 type Config struct {
-	HTTP HTTPConfig
+	HTTP       HTTPConfig
+	Executions ExecutionsConfig
 }
 
 var (
@@ -20,8 +23,18 @@ var (
 
 type jsAction func(fields, fields) error
 
-func Run(ctx context.Context, ctxParam contextFields, apiParam apiFields, script, name string, opts ...Option) error {
+func Run(ctx context.Context, ctxParam contextFields, apiParam apiFields, script, name string, opts ...Option) (err error) {
+	if err = checkQuota(ctx); err != nil {
+		return err
+	}
+	start := time.Now()
 	config, err := prepareRun(ctx, ctxParam, apiParam, script, opts)
+	if config != nil {
+		defer func() {
+			config.recordExecution(ctx, start, err)
+			config.reportUsage(ctx, start)
+		}()
+	}
 	if err != nil {
 		return err
 	}
@@ -83,31 +96,32 @@ func prepareRun(ctx context.Context, ctxParam contextFields, apiParam apiFields,
 func executeFn(config *runConfig, fn jsAction) (err error) {
 	defer func() {
 		r := recover()
-		if r != nil && !config.allowedToFail {
+		if r != nil {
 			var ok bool
-			if err, ok = r.(error); ok {
-				return
+			if err, ok = r.(error); !ok {
+				if e, ok := r.(string); ok {
+					err = errors.New(e)
+				} else {
+					err = fmt.Errorf("unknown error occured: %v", r)
+				}
 			}
-
-			e, ok := r.(string)
-			if ok {
-				err = errors.New(e)
-				return
-			}
-			err = fmt.Errorf("unknown error occured: %v", r)
+		}
+		// errors of actions allowed to fail are only recorded
+		if err != nil && config.allowedToFail {
+			config.ignoredErr = err
+			err = nil
 		}
 	}()
-	err = fn(config.ctxParam.fields, config.apiParam.fields)
-	if err != nil && !config.allowedToFail {
-		return err
-	}
-	return nil
+	return fn(config.ctxParam.fields, config.apiParam.fields)
 }
 
-func ActionToOptions(a *query.Action) []Option {
-	opts := make([]Option, 0, 1)
+// ActionToOptions returns the options to run the action in the trigger of the flow,
+// the execution is recorded if an ExecutionRecorder is set
+func ActionToOptions(a *query.Action, flowType domain.FlowType, triggerType domain.TriggerType) []Option {
+	opts := make([]Option, 0, 2)
 	if a.AllowedToFail {
 		opts = append(opts, WithAllowedToFail())
 	}
+	opts = append(opts, withExecution(a, flowType, triggerType))
 	return opts
 }
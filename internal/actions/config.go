@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/dop251/goja"
+	"github.com/dop251/goja_nodejs/console"
 	"github.com/dop251/goja_nodejs/require"
 	"github.com/zitadel/logging"
 )
@@ -26,6 +27,11 @@ type runConfig struct {
 	timeout,
 	prepareTimeout time.Duration
 	modules map[string]require.ModuleLoader
+	logger  console.Printer
+
+	execution  *Execution
+	ignoredErr error
+	dryRun     bool
 
 	vm       *goja.Runtime
 	ctxParam *ctxConfig
@@ -64,6 +70,15 @@ func newRunConfig(ctx context.Context, opts ...Option) *runConfig {
 		opt(config)
 	}
 
+	if config.execution != nil {
+		config.logger = &executionLogger{execution: config.execution, printer: config.logger}
+	}
+	if config.logger != nil {
+		config.modules["zitadel/log"] = func(runtime *goja.Runtime, module *goja.Object) {
+			console.RequireWithPrinter(config.logger)(runtime, module)
+		}
+	}
+
 	if config.prepareTimeout > config.timeout {
 		config.prepareTimeout = config.timeout
 	}
@@ -0,0 +1,32 @@
+package actions
+
+import (
+	z_errs "github.com/dennigogo/zitadel/internal/errors"
+)
+
+// Denial allows actions to refuse the current operation (e.g. a login).
+// The message is shown to the user, it's either a translation key
+// (e.g. "Errors.User.NotAllowed") or a plain text.
+// A denial is respected even if the action is allowed to fail.
+type Denial struct {
+	message string
+}
+
+// Deny is exposed to the scripts, only the first denial is kept
+func (d *Denial) Deny(message string) {
+	if d.message != "" {
+		return
+	}
+	if message == "" {
+		message = "Errors.Action.Denied"
+	}
+	d.message = message
+}
+
+// Err returns a permission denied error if an action denied the operation
+func (d *Denial) Err() error {
+	if d.message == "" {
+		return nil
+	}
+	return z_errs.ThrowPermissionDenied(nil, "ACTIO-Dn4y2", d.message)
+}
@@ -0,0 +1,73 @@
+package actions
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	z_errs "github.com/dennigogo/zitadel/internal/errors"
+)
+
+func TestDenial(t *testing.T) {
+	tests := []struct {
+		name        string
+		script      string
+		opts        []Option
+		wantMessage string
+	}{
+		{
+			name:   "not denied",
+			script: "function testFunc(ctx, api) {}",
+		},
+		{
+			name:        "denied",
+			script:      "function testFunc(ctx, api) { api.v1.deny('Errors.User.NotAllowed') }",
+			wantMessage: "Errors.User.NotAllowed",
+		},
+		{
+			name:        "denied without message",
+			script:      "function testFunc(ctx, api) { api.v1.deny('') }",
+			wantMessage: "Errors.Action.Denied",
+		},
+		{
+			name:        "first denial wins",
+			script:      "function testFunc(ctx, api) { api.v1.deny('first'); api.v1.deny('second') }",
+			wantMessage: "first",
+		},
+		{
+			name:        "denied although allowed to fail",
+			script:      "function testFunc(ctx, api) { api.v1.deny('denied'); throw 'some error' }",
+			opts:        []Option{WithAllowedToFail()},
+			wantMessage: "denied",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			denial := new(Denial)
+			api := WithAPIFields(
+				SetFields("v1",
+					SetFields("deny", denial.Deny),
+				),
+			)
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			_ = Run(ctx, nil, api, tt.script, "testFunc", tt.opts...)
+
+			err := denial.Err()
+			if tt.wantMessage == "" {
+				if err != nil {
+					t.Errorf("Err() unexpected error = %v", err)
+				}
+				return
+			}
+			if !z_errs.IsPermissionDenied(err) {
+				t.Fatalf("Err() expected permission denied, got %v", err)
+			}
+			caosErr := new(z_errs.CaosError)
+			if !errors.As(err, &caosErr) || caosErr.Message != tt.wantMessage {
+				t.Errorf("Err() message = %v, want %v", err, tt.wantMessage)
+			}
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package actions
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/dop251/goja"
+
+	"github.com/dennigogo/zitadel/internal/domain"
+)
+
+// DryRunResult is the outcome of a script executed by DryRun
+type DryRunResult struct {
+	Execution *Execution
+	APICalls  []*APICall
+}
+
+// APICall is a recorded access to the mocked api,
+// Assignment is set if a value was assigned instead of a function called
+type APICall struct {
+	Method     string
+	Args       []interface{}
+	Assignment bool
+}
+
+// DryRun executes the script without side effects:
+// the ctx object is built from the mock and calls on the api object are recorded instead of executed.
+// Values of the mock can also be called as functions which return the value (e.g. `ctx.v1.getUser()`).
+// The api mock provides static values (e.g. `api.userGrants`), all other fields of `api` are recorded.
+// No modules except zitadel/log are available.
+func DryRun(ctx context.Context, ctxMock, apiMock map[string]interface{}, script, name string, opts ...Option) *DryRunResult {
+	result := &DryRunResult{
+		Execution: &Execution{
+			ActionName: name,
+		},
+	}
+	ctxOpts := make([]FieldOption, 0, len(ctxMock))
+	for key, value := range ctxMock {
+		value := value
+		ctxOpts = append(ctxOpts, SetFields(key, func(c *FieldConfig) interface{} {
+			return mockValue(c.Runtime, value)
+		}))
+	}
+	apiOpts := make([]FieldOption, 0, len(apiMock)+1)
+	for key, value := range apiMock {
+		apiOpts = append(apiOpts, SetFields(key, value))
+	}
+	if _, ok := apiMock["v1"]; !ok {
+		apiOpts = append(apiOpts, SetFields("v1", func(c *FieldConfig) interface{} {
+			return recordingAPI(c.Runtime, []string{"v1"}, &result.APICalls)
+		}))
+	}
+
+	opts = append(opts, withDryRun(result.Execution))
+	err := Run(ctx, SetContextFields(ctxOpts...), WithAPIFields(apiOpts...), script, name, opts...)
+	// the execution is not finished if the run could not be prepared
+	if err != nil && result.Execution.Outcome == domain.ActionExecutionOutcomeUnspecified {
+		result.Execution.Outcome = domain.ActionExecutionOutcomeFailed
+		result.Execution.Error = truncate(err.Error(), maxExecutionErrorMessage)
+	}
+	return result
+}
+
+// withDryRun captures the execution without recording it
+func withDryRun(execution *Execution) Option {
+	return func(c *runConfig) {
+		c.execution = execution
+		c.dryRun = true
+	}
+}
+
+// mockValue returns objects as callable proxies which return the object if called
+func mockValue(runtime *goja.Runtime, value interface{}) goja.Value {
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		return runtime.ToValue(value)
+	}
+	target := runtime.ToValue(func(goja.FunctionCall) goja.Value { return goja.Undefined() }).ToObject(runtime)
+	return runtime.ToValue(runtime.NewProxy(target, &goja.ProxyTrapConfig{
+		Get: func(_ *goja.Object, property string, _ goja.Value) goja.Value {
+			v, ok := object[property]
+			if !ok {
+				return goja.Undefined()
+			}
+			return mockValue(runtime, v)
+		},
+		Has: func(_ *goja.Object, property string) bool {
+			_, ok := object[property]
+			return ok
+		},
+		OwnKeys: func(*goja.Object) *goja.Object {
+			return runtime.NewArray(sortedKeys(object)...)
+		},
+		GetOwnPropertyDescriptor: func(_ *goja.Object, property string) goja.PropertyDescriptor {
+			v, ok := object[property]
+			if !ok {
+				return goja.PropertyDescriptor{}
+			}
+			return goja.PropertyDescriptor{
+				Value:        mockValue(runtime, v),
+				Writable:     goja.FLAG_TRUE,
+				Configurable: goja.FLAG_TRUE,
+				Enumerable:   goja.FLAG_TRUE,
+			}
+		},
+		Apply: func(*goja.Object, goja.Value, []goja.Value) goja.Value {
+			return runtime.ToValue(object)
+		},
+	}))
+}
+
+// recordingAPI returns a proxy which records all function calls and assignments on any path
+func recordingAPI(runtime *goja.Runtime, path []string, calls *[]*APICall) goja.Value {
+	children := make(map[string]goja.Value)
+	target := runtime.ToValue(func(goja.FunctionCall) goja.Value { return goja.Undefined() }).ToObject(runtime)
+	return runtime.ToValue(runtime.NewProxy(target, &goja.ProxyTrapConfig{
+		Get: func(_ *goja.Object, property string, _ goja.Value) goja.Value {
+			if child, ok := children[property]; ok {
+				return child
+			}
+			child := recordingAPI(runtime, append(path[:len(path):len(path)], property), calls)
+			children[property] = child
+			return child
+		},
+		Set: func(_ *goja.Object, property string, value goja.Value, _ goja.Value) bool {
+			children[property] = value
+			*calls = append(*calls, &APICall{
+				Method:     strings.Join(append(path[:len(path):len(path)], property), "."),
+				Args:       []interface{}{value.Export()},
+				Assignment: true,
+			})
+			return true
+		},
+		Apply: func(_ *goja.Object, _ goja.Value, args []goja.Value) goja.Value {
+			call := &APICall{
+				Method: strings.Join(path, "."),
+				Args:   make([]interface{}, len(args)),
+			}
+			for i, arg := range args {
+				call.Args[i] = arg.Export()
+			}
+			*calls = append(*calls, call)
+			return goja.Undefined()
+		},
+	}))
+}
+
+func sortedKeys(object map[string]interface{}) []interface{} {
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	values := make([]interface{}, len(keys))
+	for i, key := range keys {
+		values[i] = key
+	}
+	return values
+}
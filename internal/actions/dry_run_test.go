@@ -0,0 +1,131 @@
+package actions
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/dennigogo/zitadel/internal/domain"
+)
+
+func TestDryRun(t *testing.T) {
+	type args struct {
+		ctxMock map[string]interface{}
+		apiMock map[string]interface{}
+		script  string
+		opts    []Option
+	}
+	type want struct {
+		outcome  domain.ActionExecutionOutcome
+		err      bool
+		logs     []*ExecutionLog
+		apiCalls []*APICall
+	}
+	tests := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "context values and functions",
+			args: args{
+				ctxMock: map[string]interface{}{
+					"v1": map[string]interface{}{
+						"getUser": map[string]interface{}{"id": "user1"},
+					},
+				},
+				script: `function testFunc(ctx, api) {
+					if (ctx.v1.getUser().id !== 'user1' || ctx.v1.getUser.id !== 'user1') { throw 'unexpected user' }
+				}`,
+			},
+			want: want{
+				outcome: domain.ActionExecutionOutcomeSucceeded,
+			},
+		},
+		{
+			name: "api calls recorded",
+			args: args{
+				script: `function testFunc(ctx, api) {
+					api.v1.user.setFirstName('first');
+					api.v1.claims = 'value';
+					api.v1.claims.length;
+				}`,
+			},
+			want: want{
+				outcome: domain.ActionExecutionOutcomeSucceeded,
+				apiCalls: []*APICall{
+					{Method: "v1.user.setFirstName", Args: []interface{}{"first"}},
+					{Method: "v1.claims", Args: []interface{}{"value"}, Assignment: true},
+				},
+			},
+		},
+		{
+			name: "logs captured",
+			args: args{
+				script: `function testFunc(ctx, api) {
+					let logger = require('zitadel/log');
+					logger.log('log');
+					logger.warn('warn');
+				}`,
+			},
+			want: want{
+				outcome: domain.ActionExecutionOutcomeSucceeded,
+				logs: []*ExecutionLog{
+					{Level: ExecutionLogLevelLog, Message: "log"},
+					{Level: ExecutionLogLevelWarn, Message: "warn"},
+				},
+			},
+		},
+		{
+			name: "failed",
+			args: args{
+				script: `function testFunc(ctx, api) { throw 'error' }`,
+			},
+			want: want{
+				outcome: domain.ActionExecutionOutcomeFailed,
+				err:     true,
+			},
+		},
+		{
+			name: "failure ignored",
+			args: args{
+				script: `function testFunc(ctx, api) { throw 'error' }`,
+				opts:   []Option{WithAllowedToFail()},
+			},
+			want: want{
+				outcome: domain.ActionExecutionOutcomeFailureIgnored,
+				err:     true,
+			},
+		},
+		{
+			name: "no side effects",
+			args: args{
+				script: `function testFunc(ctx, api) { require('zitadel/http') }`,
+			},
+			want: want{
+				outcome: domain.ActionExecutionOutcomeFailed,
+				err:     true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			got := DryRun(ctx, tt.args.ctxMock, tt.args.apiMock, tt.args.script, "testFunc", tt.args.opts...)
+			if got.Execution.Outcome != tt.want.outcome {
+				t.Errorf("DryRun() outcome = %v, want %v (error: %s)", got.Execution.Outcome, tt.want.outcome, got.Execution.Error)
+			}
+			if (got.Execution.Error != "") != tt.want.err {
+				t.Errorf("DryRun() error = %q, want error %v", got.Execution.Error, tt.want.err)
+			}
+			if !reflect.DeepEqual(got.Execution.Logs, tt.want.logs) {
+				t.Errorf("DryRun() logs = %v, want %v", got.Execution.Logs, tt.want.logs)
+			}
+			if !reflect.DeepEqual(got.APICalls, tt.want.apiCalls) {
+				t.Errorf("DryRun() api calls = %v, want %v", got.APICalls, tt.want.apiCalls)
+			}
+		})
+	}
+}
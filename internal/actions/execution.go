@@ -0,0 +1,153 @@
+package actions
+
+import (
+	"context"
+	"time"
+	"unicode/utf8"
+
+	"github.com/dop251/goja_nodejs/console"
+
+	"github.com/dennigogo/zitadel/internal/api/authz"
+	"github.com/dennigogo/zitadel/internal/domain"
+	"github.com/dennigogo/zitadel/internal/query"
+)
+
+const (
+	maxExecutionLogs         = 100
+	maxExecutionLogMessage   = 1000
+	maxExecutionErrorMessage = 2000
+)
+
+// Execution is the record of a single run of an action
+type Execution struct {
+	InstanceID    string
+	ResourceOwner string
+	ActionID      string
+	ActionName    string
+	FlowType      domain.FlowType
+	TriggerType   domain.TriggerType
+	CreationDate  time.Time
+	Duration      time.Duration
+	Outcome       domain.ActionExecutionOutcome
+	Error         string
+	Logs          []*ExecutionLog
+}
+
+type ExecutionLog struct {
+	Level   ExecutionLogLevel `json:"level"`
+	Message string            `json:"message"`
+}
+
+type ExecutionLogLevel string
+
+const (
+	ExecutionLogLevelLog   ExecutionLogLevel = "log"
+	ExecutionLogLevelWarn  ExecutionLogLevel = "warn"
+	ExecutionLogLevelError ExecutionLogLevel = "error"
+)
+
+// ExecutionsConfig bounds the recorded executions
+type ExecutionsConfig struct {
+	// Enabled defines if executions are recorded at all
+	Enabled bool
+	// MaxAge is the duration executions are kept
+	MaxAge time.Duration
+	// MaxEntries is the maximum amount of executions kept per organisation
+	MaxEntries uint64
+	// CleanupInterval defines how often executions older than MaxAge are removed
+	CleanupInterval time.Duration
+}
+
+// ExecutionRecorder stores the executions of actions
+type ExecutionRecorder interface {
+	RecordExecution(ctx context.Context, execution *Execution)
+}
+
+var executionRecorder ExecutionRecorder
+
+func SetExecutionRecorder(recorder ExecutionRecorder) {
+	executionRecorder = recorder
+}
+
+func withExecution(a *query.Action, flowType domain.FlowType, triggerType domain.TriggerType) Option {
+	return func(c *runConfig) {
+		c.execution = &Execution{
+			ResourceOwner: a.ResourceOwner,
+			ActionID:      a.ID,
+			ActionName:    a.Name,
+			FlowType:      flowType,
+			TriggerType:   triggerType,
+		}
+	}
+}
+
+func (c *runConfig) recordExecution(ctx context.Context, start time.Time, err error) {
+	if c.execution == nil {
+		return
+	}
+	c.execution.InstanceID = authz.GetInstance(ctx).InstanceID()
+	c.execution.CreationDate = start
+	c.execution.Duration = time.Since(start)
+	switch {
+	case err != nil:
+		c.execution.Outcome = domain.ActionExecutionOutcomeFailed
+		c.execution.Error = truncate(err.Error(), maxExecutionErrorMessage)
+	case c.ignoredErr != nil:
+		c.execution.Outcome = domain.ActionExecutionOutcomeFailureIgnored
+		c.execution.Error = truncate(c.ignoredErr.Error(), maxExecutionErrorMessage)
+	default:
+		c.execution.Outcome = domain.ActionExecutionOutcomeSucceeded
+	}
+	if executionRecorder != nil && !c.dryRun {
+		executionRecorder.RecordExecution(ctx, c.execution)
+	}
+}
+
+// executionLogger captures the output of the zitadel/log module
+// and forwards it to the printer
+type executionLogger struct {
+	execution *Execution
+	printer   console.Printer
+}
+
+func (l *executionLogger) Log(s string) {
+	l.append(ExecutionLogLevelLog, s)
+	if l.printer != nil {
+		l.printer.Log(s)
+	}
+}
+
+func (l *executionLogger) Warn(s string) {
+	l.append(ExecutionLogLevelWarn, s)
+	if l.printer != nil {
+		l.printer.Warn(s)
+	}
+}
+
+func (l *executionLogger) Error(s string) {
+	l.append(ExecutionLogLevelError, s)
+	if l.printer != nil {
+		l.printer.Error(s)
+	}
+}
+
+func (l *executionLogger) append(level ExecutionLogLevel, message string) {
+	if len(l.execution.Logs) >= maxExecutionLogs {
+		return
+	}
+	l.execution.Logs = append(l.execution.Logs, &ExecutionLog{
+		Level:   level,
+		Message: truncate(message, maxExecutionLogMessage),
+	})
+}
+
+// truncate cuts s to at most max bytes without splitting a character
+func truncate(s string, max int) string {
+	if len(s) <= max {
+		return s
+	}
+	for max > 0 && !utf8.RuneStart(s[max]) {
+		max--
+	}
+	return s[:max]
+}
@@ -0,0 +1,117 @@
+package actions
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dennigogo/zitadel/internal/domain"
+	"github.com/dennigogo/zitadel/internal/query"
+)
+
+type testRecorder struct {
+	executions []*Execution
+}
+
+func (r *testRecorder) RecordExecution(_ context.Context, execution *Execution) {
+	r.executions = append(r.executions, execution)
+}
+
+func TestRun_recordExecution(t *testing.T) {
+	tests := []struct {
+		name        string
+		action      *query.Action
+		script      string
+		wantErr     bool
+		wantOutcome domain.ActionExecutionOutcome
+		wantError   bool
+		wantLogs    int
+	}{
+		{
+			name:        "succeeded",
+			action:      &query.Action{ID: "action1", Name: "testFunc", ResourceOwner: "org1"},
+			script:      "function testFunc(ctx, api) { require('zitadel/log').log('hello') }",
+			wantOutcome: domain.ActionExecutionOutcomeSucceeded,
+			wantLogs:    1,
+		},
+		{
+			name:        "failed",
+			action:      &query.Action{ID: "action1", Name: "testFunc", ResourceOwner: "org1"},
+			script:      "function testFunc(ctx, api) { throw 'some error' }",
+			wantErr:     true,
+			wantOutcome: domain.ActionExecutionOutcomeFailed,
+			wantError:   true,
+		},
+		{
+			name:        "failure ignored",
+			action:      &query.Action{ID: "action1", Name: "testFunc", ResourceOwner: "org1", AllowedToFail: true},
+			script:      "function testFunc(ctx, api) { throw 'some error' }",
+			wantOutcome: domain.ActionExecutionOutcomeFailureIgnored,
+			wantError:   true,
+		},
+		{
+			name:        "invalid script",
+			action:      &query.Action{ID: "action1", Name: "testFunc", ResourceOwner: "org1", AllowedToFail: true},
+			script:      "function testFunc(ctx, api) {",
+			wantErr:     true,
+			wantOutcome: domain.ActionExecutionOutcomeFailed,
+			wantError:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := new(testRecorder)
+			SetExecutionRecorder(recorder)
+			defer SetExecutionRecorder(nil)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			opts := append(ActionToOptions(tt.action, domain.FlowTypeExternalAuthentication, domain.TriggerTypePostAuthentication), WithLogger(nil))
+			err := Run(ctx, nil, nil, tt.script, tt.action.Name, opts...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Run() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(recorder.executions) != 1 {
+				t.Fatalf("expected 1 recorded execution, got %d", len(recorder.executions))
+			}
+			execution := recorder.executions[0]
+			if execution.ActionID != tt.action.ID || execution.ResourceOwner != tt.action.ResourceOwner ||
+				execution.FlowType != domain.FlowTypeExternalAuthentication || execution.TriggerType != domain.TriggerTypePostAuthentication {
+				t.Errorf("unexpected execution %+v", execution)
+			}
+			if execution.Outcome != tt.wantOutcome {
+				t.Errorf("outcome = %v, want %v", execution.Outcome, tt.wantOutcome)
+			}
+			if (execution.Error != "") != tt.wantError {
+				t.Errorf("error = %q, want error %v", execution.Error, tt.wantError)
+			}
+			if len(execution.Logs) != tt.wantLogs {
+				t.Errorf("logs = %v, want %d", execution.Logs, tt.wantLogs)
+			}
+		})
+	}
+}
+
+func Test_truncate(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		max  int
+		want string
+	}{
+		{name: "shorter", s: "hello", max: 10, want: "hello"},
+		{name: "equal", s: "hello", max: 5, want: "hello"},
+		{name: "ascii", s: "hello", max: 3, want: "hel"},
+		{name: "multi byte character kept", s: "grüezi", max: 4, want: "grü"},
+		{name: "multi byte character not split", s: "grüezi", max: 3, want: "gr"},
+		{name: "four byte character not split", s: "a😀b", max: 4, want: "a"},
+		{name: "zero", s: "ü", max: 0, want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := truncate(tt.s, tt.max); got != tt.want {
+				t.Errorf("truncate() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package actions
 
 import (
 	"net"
+	"net/url"
 	"reflect"
 
 	z_errs "github.com/dennigogo/zitadel/internal/errors"
@@ -14,6 +15,14 @@ func SetHTTPConfig(config *HTTPConfig) {
 
 var httpConfig *HTTPConfig
 
+// IsHostBlocked checks the host of the address against the configured deny list
+func IsHostBlocked(address *url.URL) bool {
+	if httpConfig == nil {
+		return false
+	}
+	return isHostBlocked(httpConfig.DenyList, address)
+}
+
 type HTTPConfig struct {
 	DenyList []AddressChecker
 }
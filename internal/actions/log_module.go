@@ -3,7 +3,6 @@ package actions
 import (
 	"github.com/zitadel/logging"
 
-	"github.com/dop251/goja"
 	"github.com/dop251/goja_nodejs/console"
 )
 
@@ -23,8 +22,6 @@ func (*logrus) Error(s string) {
 
 func WithLogger(logger console.Printer) Option {
 	return func(c *runConfig) {
-		c.modules["zitadel/log"] = func(runtime *goja.Runtime, module *goja.Object) {
-			console.RequireWithPrinter(logger)(runtime, module)
-		}
+		c.logger = logger
 	}
 }
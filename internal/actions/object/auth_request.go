@@ -0,0 +1,90 @@
+package object
+
+import (
+	"time"
+
+	"github.com/dop251/goja"
+
+	"github.com/dennigogo/zitadel/internal/actions"
+	"github.com/dennigogo/zitadel/internal/domain"
+)
+
+func AuthRequestFromDomain(c *actions.FieldConfig, request *domain.AuthRequest) goja.Value {
+	if request == nil {
+		return c.Runtime.ToValue(nil)
+	}
+	return c.Runtime.ToValue(&authRequest{
+		Id:                     request.ID,
+		AgentId:                request.AgentID,
+		CreationDate:           request.CreationDate,
+		ChangeDate:             request.ChangeDate,
+		BrowserInfo:            browserInfoFromDomain(request.BrowserInfo),
+		ApplicationId:          request.ApplicationID,
+		CallbackUri:            request.CallbackURI,
+		TransferState:          request.TransferState,
+		Prompt:                 request.Prompt,
+		UiLocales:              request.UiLocales,
+		LoginHint:              request.LoginHint,
+		MaxAuthAge:             request.MaxAuthAge,
+		InstanceId:             request.InstanceID,
+		UserId:                 request.UserID,
+		UserName:               request.UserName,
+		LoginName:              request.LoginName,
+		DisplayName:            request.DisplayName,
+		UserOrgId:              request.UserOrgID,
+		RequestedOrgId:         request.RequestedOrgID,
+		RequestedOrgName:       request.RequestedOrgName,
+		RequestedPrimaryDomain: request.RequestedPrimaryDomain,
+		SelectedIdpConfigId:    request.SelectedIDPConfigID,
+		PasswordVerified:       request.PasswordVerified,
+		MfasVerified:           request.MFAsVerified,
+		Audience:               request.Audience,
+		AuthTime:               request.AuthTime,
+	})
+}
+
+func browserInfoFromDomain(info *domain.BrowserInfo) *browserInfo {
+	if info == nil {
+		return nil
+	}
+	return &browserInfo{
+		UserAgent:      info.UserAgent,
+		AcceptLanguage: info.AcceptLanguage,
+		RemoteIp:       info.RemoteIP.String(),
+	}
+}
+
+type authRequest struct {
+	Id                     string
+	AgentId                string
+	CreationDate           time.Time
+	ChangeDate             time.Time
+	BrowserInfo            *browserInfo
+	ApplicationId          string
+	CallbackUri            string
+	TransferState          string
+	Prompt                 []domain.Prompt
+	UiLocales              []string
+	LoginHint              string
+	MaxAuthAge             *time.Duration
+	InstanceId             string
+	UserId                 string
+	UserName               string
+	LoginName              string
+	DisplayName            string
+	UserOrgId              string
+	RequestedOrgId         string
+	RequestedOrgName       string
+	RequestedPrimaryDomain string
+	SelectedIdpConfigId    string
+	PasswordVerified       bool
+	MfasVerified           []domain.MFAType
+	Audience               []string
+	AuthTime               time.Time
+}
+
+type browserInfo struct {
+	UserAgent      string
+	AcceptLanguage string
+	RemoteIp       string
+}
@@ -0,0 +1,37 @@
+package actions
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/dennigogo/zitadel/internal/domain"
+	z_errs "github.com/dennigogo/zitadel/internal/errors"
+)
+
+// QuotaEnforcer meters the run time of the actions of an instance and checks its limit
+type QuotaEnforcer interface {
+	Report(ctx context.Context, unit domain.QuotaUnit, amount uint64)
+	Exhausted(ctx context.Context, unit domain.QuotaUnit) bool
+}
+
+var quotaEnforcer QuotaEnforcer
+
+func SetQuotaEnforcer(enforcer QuotaEnforcer) {
+	quotaEnforcer = enforcer
+}
+
+func checkQuota(ctx context.Context) error {
+	if quotaEnforcer != nil && quotaEnforcer.Exhausted(ctx, domain.QuotaUnitActionsAllRunsSeconds) {
+		return z_errs.ThrowPreconditionFailed(nil, "ACTIO-Qu0ta", "Errors.Quota.Exhausted")
+	}
+	return nil
+}
+
+// reportUsage adds every started second of the run to the usage of the quota
+func (c *runConfig) reportUsage(ctx context.Context, start time.Time) {
+	if quotaEnforcer == nil || c.dryRun {
+		return
+	}
+	quotaEnforcer.Report(ctx, domain.QuotaUnitActionsAllRunsSeconds, uint64(math.Ceil(time.Since(start).Seconds())))
+}
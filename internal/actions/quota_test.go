@@ -0,0 +1,77 @@
+package actions
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dennigogo/zitadel/internal/domain"
+	z_errs "github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/query"
+)
+
+type testQuotaEnforcer struct {
+	exhausted bool
+	reported  uint64
+}
+
+func (e *testQuotaEnforcer) Report(_ context.Context, unit domain.QuotaUnit, amount uint64) {
+	if unit == domain.QuotaUnitActionsAllRunsSeconds {
+		e.reported += amount
+	}
+}
+
+func (e *testQuotaEnforcer) Exhausted(_ context.Context, unit domain.QuotaUnit) bool {
+	return unit == domain.QuotaUnitActionsAllRunsSeconds && e.exhausted
+}
+
+func TestRun_quota(t *testing.T) {
+	tests := []struct {
+		name         string
+		exhausted    bool
+		dryRun       bool
+		wantErr      func(error) bool
+		wantReported uint64
+	}{
+		{
+			name:         "started second reported",
+			wantReported: 1,
+		},
+		{
+			name:         "dry run not reported",
+			dryRun:       true,
+			wantReported: 0,
+		},
+		{
+			name:         "exhausted",
+			exhausted:    true,
+			wantErr:      z_errs.IsPreconditionFailed,
+			wantReported: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			enforcer := &testQuotaEnforcer{exhausted: tt.exhausted}
+			SetQuotaEnforcer(enforcer)
+			defer SetQuotaEnforcer(nil)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			action := &query.Action{ID: "action1", Name: "testFunc", ResourceOwner: "org1"}
+			opts := append(ActionToOptions(action, domain.FlowTypeExternalAuthentication, domain.TriggerTypePostAuthentication), WithLogger(nil))
+			if tt.dryRun {
+				opts = append(opts, withDryRun(new(Execution)))
+			}
+			err := Run(ctx, nil, nil, "function testFunc(ctx, api) {}", action.Name, opts...)
+			if tt.wantErr == nil && err != nil {
+				t.Fatalf("Run() unexpected error = %v", err)
+			}
+			if tt.wantErr != nil && !tt.wantErr(err) {
+				t.Fatalf("Run() unexpected error = %v", err)
+			}
+			if enforcer.reported != tt.wantReported {
+				t.Errorf("reported = %d, want %d", enforcer.reported, tt.wantReported)
+			}
+		})
+	}
+}
@@ -0,0 +1,214 @@
+package recorder
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/zitadel/logging"
+
+	"github.com/dennigogo/zitadel/internal/actions"
+	"github.com/dennigogo/zitadel/internal/id"
+	"github.com/dennigogo/zitadel/internal/query"
+)
+
+const (
+	// bufferSize is the maximum amount of executions waiting to be stored,
+	// further executions are dropped until the worker caught up
+	bufferSize = 1000
+	// batchSize is the maximum amount of executions stored in a single statement
+	batchSize     = 100
+	flushInterval = time.Second
+	recordTimeout = 5 * time.Second
+)
+
+var _ actions.ExecutionRecorder = (*Recorder)(nil)
+
+// Recorder stores the executions of actions in the database.
+// Executions are operational logs which expire, so they are not pushed as events
+// but stored in batches by a single worker.
+// The amount of executions is bounded by the age and the count per organisation,
+// both are enforced periodically.
+type Recorder struct {
+	client      *sql.DB
+	idGenerator id.Generator
+	config      actions.ExecutionsConfig
+	executions  chan *actions.Execution
+}
+
+// Start stores the recorded executions until the context is done
+func Start(ctx context.Context, client *sql.DB, config actions.ExecutionsConfig) *Recorder {
+	r := newRecorder(client, id.SonyFlakeGenerator(), config)
+	go r.run(ctx)
+	if config.CleanupInterval > 0 && (config.MaxAge > 0 || config.MaxEntries > 0) {
+		go r.cleanup(ctx)
+	}
+	return r
+}
+
+func newRecorder(client *sql.DB, idGenerator id.Generator, config actions.ExecutionsConfig) *Recorder {
+	return &Recorder{
+		client:      client,
+		idGenerator: idGenerator,
+		config:      config,
+		executions:  make(chan *actions.Execution, bufferSize),
+	}
+}
+
+// RecordExecution queues the execution without blocking,
+// it's dropped if the buffer is full because recording must not interrupt the flow of the action
+func (r *Recorder) RecordExecution(_ context.Context, execution *actions.Execution) {
+	select {
+	case r.executions <- execution:
+	default:
+		logging.WithFields("action", execution.ActionID, "instance", execution.InstanceID).Warn("action execution buffer full, execution not recorded")
+	}
+}
+
+// run stores the queued executions as soon as a batch is full or at least every flush interval.
+// The executions queued when the context is done are stored before it returns
+func (r *Recorder) run(ctx context.Context) {
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+	batch := make([]*actions.Execution, 0, batchSize)
+	for {
+		select {
+		case <-ctx.Done():
+			r.flush(r.drain(batch))
+			return
+		case execution := <-r.executions:
+			batch = append(batch, execution)
+			if len(batch) < batchSize {
+				continue
+			}
+		case <-ticker.C:
+		}
+		r.flush(batch)
+		batch = batch[:0]
+	}
+}
+
+// drain appends the queued executions to the batch
+func (r *Recorder) drain(batch []*actions.Execution) []*actions.Execution {
+	for {
+		select {
+		case execution := <-r.executions:
+			batch = append(batch, execution)
+		default:
+			return batch
+		}
+	}
+}
+
+// flush stores the batch, errors are only logged
+func (r *Recorder) flush(batch []*actions.Execution) {
+	for len(batch) > 0 {
+		size := len(batch)
+		if size > batchSize {
+			size = batchSize
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), recordTimeout)
+		err := r.record(ctx, batch[:size])
+		cancel()
+		logging.WithFields("count", size).OnError(err).Warn("unable to record action executions")
+		batch = batch[size:]
+	}
+}
+
+func (r *Recorder) record(ctx context.Context, executions []*actions.Execution) error {
+	stmt := squirrel.Insert(query.ActionExecutionTable).
+		Columns(
+			query.ActionExecutionInstanceIDCol,
+			query.ActionExecutionResourceOwnerCol,
+			query.ActionExecutionIDCol,
+			query.ActionExecutionActionIDCol,
+			query.ActionExecutionActionNameCol,
+			query.ActionExecutionFlowTypeCol,
+			query.ActionExecutionTriggerTypeCol,
+			query.ActionExecutionCreationDateCol,
+			query.ActionExecutionDurationCol,
+			query.ActionExecutionOutcomeCol,
+			query.ActionExecutionErrorCol,
+			query.ActionExecutionLogsCol,
+		).
+		PlaceholderFormat(squirrel.Dollar)
+	for _, execution := range executions {
+		executionID, err := r.idGenerator.Next()
+		if err != nil {
+			return err
+		}
+		logs, err := json.Marshal(execution.Logs)
+		if err != nil {
+			return err
+		}
+		stmt = stmt.Values(
+			execution.InstanceID,
+			execution.ResourceOwner,
+			executionID,
+			execution.ActionID,
+			execution.ActionName,
+			execution.FlowType,
+			execution.TriggerType,
+			execution.CreationDate,
+			execution.Duration,
+			execution.Outcome,
+			execution.Error,
+			logs,
+		)
+	}
+	insert, args, err := stmt.ToSql()
+	if err != nil {
+		return err
+	}
+	_, err = r.client.ExecContext(ctx, insert, args...)
+	return err
+}
+
+func (r *Recorder) cleanup(ctx context.Context) {
+	ticker := time.NewTicker(r.config.CleanupInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := r.removeExpired(ctx, time.Now())
+			logging.OnError(err).Warn("unable to remove expired action executions")
+			err = r.trim(ctx)
+			logging.OnError(err).Warn("unable to trim action executions")
+		}
+	}
+}
+
+// removeExpired removes the executions older than MaxAge
+func (r *Recorder) removeExpired(ctx context.Context, now time.Time) error {
+	if r.config.MaxAge == 0 {
+		return nil
+	}
+	_, err := r.client.ExecContext(ctx, removeExpiredStmt, now.Add(-r.config.MaxAge))
+	return err
+}
+
+// trim removes the oldest executions of every organisation exceeding MaxEntries
+func (r *Recorder) trim(ctx context.Context) error {
+	if r.config.MaxEntries == 0 {
+		return nil
+	}
+	_, err := r.client.ExecContext(ctx, trimStmt, r.config.MaxEntries)
+	return err
+}
+
+const (
+	removeExpiredStmt = "DELETE FROM " + query.ActionExecutionTable +
+		" WHERE " + query.ActionExecutionCreationDateCol + " < $1"
+	trimStmt = "DELETE FROM " + query.ActionExecutionTable +
+		" WHERE (" + query.ActionExecutionInstanceIDCol + ", " + query.ActionExecutionResourceOwnerCol + ", " + query.ActionExecutionIDCol + ") IN (" +
+		"SELECT " + query.ActionExecutionInstanceIDCol + ", " + query.ActionExecutionResourceOwnerCol + ", " + query.ActionExecutionIDCol + " FROM (" +
+		"SELECT " + query.ActionExecutionInstanceIDCol + ", " + query.ActionExecutionResourceOwnerCol + ", " + query.ActionExecutionIDCol +
+		", ROW_NUMBER() OVER (PARTITION BY " + query.ActionExecutionInstanceIDCol + ", " + query.ActionExecutionResourceOwnerCol +
+		" ORDER BY " + query.ActionExecutionCreationDateCol + " DESC) AS position" +
+		" FROM " + query.ActionExecutionTable +
+		") AS executions WHERE position > $1)"
+)
@@ -0,0 +1,168 @@
+package recorder
+
+import (
+	"context"
+	"database/sql/driver"
+	"regexp"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	"github.com/dennigogo/zitadel/internal/actions"
+)
+
+const insertStmt = `^INSERT INTO system\.action_executions \(instance_id,resource_owner,id,action_id,action_name,flow_type,trigger_type,creation_date,duration,outcome,error,logs\) VALUES `
+
+func testRecorder(t *testing.T, config actions.ExecutionsConfig) (*Recorder, sqlmock.Sqlmock) {
+	t.Helper()
+	client, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("unable to create mock: %v", err)
+	}
+	t.Cleanup(func() { client.Close() })
+	return newRecorder(client, new(testIDGenerator), config), mock
+}
+
+type testIDGenerator struct {
+	next int
+}
+
+func (g *testIDGenerator) Next() (string, error) {
+	g.next++
+	return strconv.Itoa(g.next), nil
+}
+
+func executions(count int) []*actions.Execution {
+	executions := make([]*actions.Execution, count)
+	for i := range executions {
+		executions[i] = &actions.Execution{InstanceID: "instance1", ResourceOwner: "org1", ActionID: "action1"}
+	}
+	return executions
+}
+
+func TestRecorder_run(t *testing.T) {
+	tests := []struct {
+		name       string
+		executions int
+		wantArgs   []int
+	}{
+		{
+			name:       "no executions",
+			executions: 0,
+		},
+		{
+			name:       "single batch",
+			executions: 3,
+			wantArgs:   []int{3 * 12},
+		},
+		{
+			name:       "split in batches",
+			executions: batchSize + 1,
+			wantArgs:   []int{batchSize * 12, 12},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, mock := testRecorder(t, actions.ExecutionsConfig{})
+			for _, wantArgs := range tt.wantArgs {
+				args := make([]driver.Value, wantArgs)
+				for i := range args {
+					args[i] = sqlmock.AnyArg()
+				}
+				mock.ExpectExec(insertStmt).WithArgs(args...).WillReturnResult(sqlmock.NewResult(0, int64(wantArgs/12)))
+			}
+			for _, execution := range executions(tt.executions) {
+				r.RecordExecution(context.Background(), execution)
+			}
+
+			// the queued executions are stored when the recorder stops
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			r.run(ctx)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestRecorder_RecordExecution_bufferFull(t *testing.T) {
+	r, mock := testRecorder(t, actions.ExecutionsConfig{})
+	for _, execution := range executions(bufferSize + 1) {
+		r.RecordExecution(context.Background(), execution)
+	}
+	if len(r.executions) != bufferSize {
+		t.Errorf("queued executions = %d, want %d", len(r.executions), bufferSize)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRecorder_removeExpired(t *testing.T) {
+	now := time.Date(2022, 10, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name       string
+		config     actions.ExecutionsConfig
+		wantExpiry *time.Time
+	}{
+		{
+			name:   "max age not set",
+			config: actions.ExecutionsConfig{},
+		},
+		{
+			name:       "max age",
+			config:     actions.ExecutionsConfig{MaxAge: time.Hour},
+			wantExpiry: func() *time.Time { expiry := now.Add(-time.Hour); return &expiry }(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, mock := testRecorder(t, tt.config)
+			if tt.wantExpiry != nil {
+				mock.ExpectExec(regexp.QuoteMeta(removeExpiredStmt)).WithArgs(*tt.wantExpiry).WillReturnResult(sqlmock.NewResult(0, 1))
+			}
+			if err := r.removeExpired(context.Background(), now); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestRecorder_trim(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   actions.ExecutionsConfig
+		wantTrim bool
+	}{
+		{
+			name:   "max entries not set",
+			config: actions.ExecutionsConfig{},
+		},
+		{
+			name:     "max entries",
+			config:   actions.ExecutionsConfig{MaxEntries: 1000},
+			wantTrim: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, mock := testRecorder(t, tt.config)
+			if tt.wantTrim {
+				mock.ExpectExec(regexp.QuoteMeta(trimStmt)).WithArgs(tt.config.MaxEntries).WillReturnResult(sqlmock.NewResult(0, 1))
+			}
+			if err := r.trim(context.Background()); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
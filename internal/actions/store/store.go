@@ -0,0 +1,126 @@
+package store
+
+import (
+	"context"
+
+	"github.com/dennigogo/zitadel/internal/actions"
+	"github.com/dennigogo/zitadel/internal/api/authz"
+	"github.com/dennigogo/zitadel/internal/command"
+	"github.com/dennigogo/zitadel/internal/domain"
+	"github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/query"
+)
+
+// editor is set as user of the events pushed by actions
+const editor = "ACTION"
+
+var _ actions.Store = (*Store)(nil)
+
+// Store implements actions.Store,
+// all data is restricted to the organisation owning the action
+type Store struct {
+	command *command.Commands
+	query   *query.Queries
+	orgID   string
+}
+
+func New(command *command.Commands, query *query.Queries, orgID string) *Store {
+	return &Store{
+		command: command,
+		query:   query,
+		orgID:   orgID,
+	}
+}
+
+func (s *Store) setContext(ctx context.Context) context.Context {
+	return authz.SetCtxData(ctx, authz.CtxData{
+		UserID: editor,
+		OrgID:  s.orgID,
+	})
+}
+
+func (s *Store) GetUserMetadata(ctx context.Context, userID, key string) ([]byte, error) {
+	resourceOwnerQuery, err := query.NewUserMetadataResourceOwnerSearchQuery(s.orgID)
+	if err != nil {
+		return nil, err
+	}
+	metadata, err := s.query.GetUserMetadataByKey(ctx, true, userID, key, resourceOwnerQuery)
+	if errors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return metadata.Value, nil
+}
+
+func (s *Store) SetUserMetadata(ctx context.Context, userID, key string, value []byte) error {
+	_, err := s.command.SetUserMetadata(s.setContext(ctx), &domain.Metadata{Key: key, Value: value}, userID, s.orgID)
+	return err
+}
+
+func (s *Store) RemoveUserMetadata(ctx context.Context, userID, key string) error {
+	_, err := s.command.RemoveUserMetadata(s.setContext(ctx), key, userID, s.orgID)
+	return err
+}
+
+func (s *Store) GetOrgMetadata(ctx context.Context, key string) ([]byte, error) {
+	metadata, err := s.query.GetOrgMetadataByKey(ctx, true, s.orgID, key)
+	if errors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return metadata.Value, nil
+}
+
+func (s *Store) SetOrgMetadata(ctx context.Context, key string, value []byte) error {
+	_, err := s.command.SetOrgMetadata(s.setContext(ctx), s.orgID, &domain.Metadata{Key: key, Value: value})
+	return err
+}
+
+func (s *Store) RemoveOrgMetadata(ctx context.Context, key string) error {
+	_, err := s.command.RemoveOrgMetadata(s.setContext(ctx), s.orgID, key)
+	return err
+}
+
+// AddUserGrant only succeeds if the organisation owns the project or got it granted
+func (s *Store) AddUserGrant(ctx context.Context, userID, projectID, projectGrantID string, roles []string) (string, error) {
+	grant, err := s.command.AddUserGrant(s.setContext(ctx), &domain.UserGrant{
+		UserID:         userID,
+		ProjectID:      projectID,
+		ProjectGrantID: projectGrantID,
+		RoleKeys:       roles,
+	}, s.orgID)
+	if err != nil {
+		return "", err
+	}
+	return grant.AggregateID, nil
+}
+
+func (s *Store) RemoveUserGrant(ctx context.Context, grantID string) error {
+	_, err := s.command.RemoveUserGrantOfResourceOwner(s.setContext(ctx), grantID, s.orgID)
+	return err
+}
+
+func (s *Store) GetKeyValue(ctx context.Context, key string) ([]byte, error) {
+	kv, err := s.query.GetKeyValue(ctx, true, s.orgID, key)
+	if errors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return kv.Value, nil
+}
+
+func (s *Store) SetKeyValue(ctx context.Context, key string, value []byte) error {
+	_, err := s.command.SetKeyValue(s.setContext(ctx), s.orgID, key, value)
+	return err
+}
+
+func (s *Store) RemoveKeyValue(ctx context.Context, key string) error {
+	_, err := s.command.RemoveKeyValue(s.setContext(ctx), s.orgID, key)
+	return err
+}
@@ -0,0 +1,140 @@
+package actions
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/dop251/goja"
+	"github.com/zitadel/logging"
+)
+
+// Store gives the native modules access to the data of the organisation owning the action.
+// Implementations must restrict all reads and writes to that organisation.
+// Getters return nil if the entry does not exist.
+type Store interface {
+	GetUserMetadata(ctx context.Context, userID, key string) ([]byte, error)
+	SetUserMetadata(ctx context.Context, userID, key string, value []byte) error
+	RemoveUserMetadata(ctx context.Context, userID, key string) error
+	GetOrgMetadata(ctx context.Context, key string) ([]byte, error)
+	SetOrgMetadata(ctx context.Context, key string, value []byte) error
+	RemoveOrgMetadata(ctx context.Context, key string) error
+
+	AddUserGrant(ctx context.Context, userID, projectID, projectGrantID string, roles []string) (string, error)
+	RemoveUserGrant(ctx context.Context, grantID string) error
+
+	GetKeyValue(ctx context.Context, key string) ([]byte, error)
+	SetKeyValue(ctx context.Context, key string, value []byte) error
+	RemoveKeyValue(ctx context.Context, key string) error
+}
+
+// WithStore registers the modules zitadel/metadata, zitadel/usergrant and zitadel/kv
+func WithStore(ctx context.Context, store Store) Option {
+	return func(c *runConfig) {
+		c.modules["zitadel/metadata"] = func(runtime *goja.Runtime, module *goja.Object) {
+			requireMetadata(ctx, store, module)
+		}
+		c.modules["zitadel/usergrant"] = func(runtime *goja.Runtime, module *goja.Object) {
+			requireUserGrant(ctx, store, module)
+		}
+		c.modules["zitadel/kv"] = func(runtime *goja.Runtime, module *goja.Object) {
+			requireKeyValue(ctx, store, module)
+		}
+	}
+}
+
+func requireMetadata(ctx context.Context, store Store, module *goja.Object) {
+	o := module.Get("exports").(*goja.Object)
+	setModuleFunctions(o, map[string]interface{}{
+		"getUserMetadata": func(userID, key string) (interface{}, error) {
+			value, err := store.GetUserMetadata(ctx, userID, key)
+			if err != nil {
+				return nil, err
+			}
+			return unmarshalStoreValue(value), nil
+		},
+		"setUserMetadata": func(userID, key string, value interface{}) error {
+			data, err := json.Marshal(value)
+			if err != nil {
+				return err
+			}
+			return store.SetUserMetadata(ctx, userID, key, data)
+		},
+		"removeUserMetadata": func(userID, key string) error {
+			return store.RemoveUserMetadata(ctx, userID, key)
+		},
+		"getOrgMetadata": func(key string) (interface{}, error) {
+			value, err := store.GetOrgMetadata(ctx, key)
+			if err != nil {
+				return nil, err
+			}
+			return unmarshalStoreValue(value), nil
+		},
+		"setOrgMetadata": func(key string, value interface{}) error {
+			data, err := json.Marshal(value)
+			if err != nil {
+				return err
+			}
+			return store.SetOrgMetadata(ctx, key, data)
+		},
+		"removeOrgMetadata": func(key string) error {
+			return store.RemoveOrgMetadata(ctx, key)
+		},
+	})
+}
+
+func requireUserGrant(ctx context.Context, store Store, module *goja.Object) {
+	o := module.Get("exports").(*goja.Object)
+	setModuleFunctions(o, map[string]interface{}{
+		"add": func(userID, projectID string, roles []string) (string, error) {
+			return store.AddUserGrant(ctx, userID, projectID, "", roles)
+		},
+		"addOnProjectGrant": func(userID, projectID, projectGrantID string, roles []string) (string, error) {
+			return store.AddUserGrant(ctx, userID, projectID, projectGrantID, roles)
+		},
+		"remove": func(grantID string) error {
+			return store.RemoveUserGrant(ctx, grantID)
+		},
+	})
+}
+
+func requireKeyValue(ctx context.Context, store Store, module *goja.Object) {
+	o := module.Get("exports").(*goja.Object)
+	setModuleFunctions(o, map[string]interface{}{
+		"get": func(key string) (interface{}, error) {
+			value, err := store.GetKeyValue(ctx, key)
+			if err != nil {
+				return nil, err
+			}
+			return unmarshalStoreValue(value), nil
+		},
+		"set": func(key string, value interface{}) error {
+			data, err := json.Marshal(value)
+			if err != nil {
+				return err
+			}
+			return store.SetKeyValue(ctx, key, data)
+		},
+		"remove": func(key string) error {
+			return store.RemoveKeyValue(ctx, key)
+		},
+	})
+}
+
+func setModuleFunctions(o *goja.Object, functions map[string]interface{}) {
+	for name, fn := range functions {
+		logging.OnError(o.Set(name, fn)).Warn("unable to set module")
+	}
+}
+
+// unmarshalStoreValue returns the parsed json value,
+// values not set by an action (e.g. through the api) are returned as string
+func unmarshalStoreValue(value []byte) interface{} {
+	if value == nil {
+		return nil
+	}
+	var v interface{}
+	if err := json.Unmarshal(value, &v); err != nil {
+		return string(value)
+	}
+	return v
+}
@@ -0,0 +1,116 @@
+package actions
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	z_errs "github.com/dennigogo/zitadel/internal/errors"
+)
+
+type testStore struct {
+	Store
+	values map[string][]byte
+	grants map[string]string
+}
+
+func (s *testStore) GetKeyValue(_ context.Context, key string) ([]byte, error) {
+	return s.values[key], nil
+}
+
+func (s *testStore) SetKeyValue(_ context.Context, key string, value []byte) error {
+	s.values[key] = value
+	return nil
+}
+
+func (s *testStore) RemoveKeyValue(_ context.Context, key string) error {
+	if _, ok := s.values[key]; !ok {
+		return z_errs.ThrowNotFound(nil, "ACTIO-Kv0t1", "Errors.KeyValue.NotFound")
+	}
+	delete(s.values, key)
+	return nil
+}
+
+func (s *testStore) AddUserGrant(_ context.Context, userID, projectID, _ string, _ []string) (string, error) {
+	if projectID != "project" {
+		return "", z_errs.ThrowPreconditionFailed(nil, "ACTIO-Kv0t2", "Errors.Project.NotFound")
+	}
+	s.grants["grant"] = userID
+	return "grant", nil
+}
+
+func TestWithStore(t *testing.T) {
+	tests := []struct {
+		name    string
+		script  string
+		values  map[string][]byte
+		wantErr bool
+	}{
+		{
+			name: "set and get value",
+			script: `function testFunc(ctx, api) {
+				let kv = require('zitadel/kv');
+				kv.set('key', {count: 1});
+				if (kv.get('key').count !== 1) { throw 'unexpected value' }
+			}`,
+		},
+		{
+			name: "get value not set by action",
+			script: `function testFunc(ctx, api) {
+				if (require('zitadel/kv').get('key') !== 'plain') { throw 'unexpected value' }
+			}`,
+			values: map[string][]byte{"key": []byte("plain")},
+		},
+		{
+			name: "get missing value",
+			script: `function testFunc(ctx, api) {
+				if (require('zitadel/kv').get('key') !== null) { throw 'unexpected value' }
+			}`,
+		},
+		{
+			name: "remove missing value",
+			script: `function testFunc(ctx, api) {
+				require('zitadel/kv').remove('key');
+			}`,
+			wantErr: true,
+		},
+		{
+			name: "error catchable",
+			script: `function testFunc(ctx, api) {
+				try {
+					require('zitadel/kv').remove('key');
+				} catch (e) {
+					return
+				}
+				throw 'not thrown'
+			}`,
+		},
+		{
+			name: "add user grant",
+			script: `function testFunc(ctx, api) {
+				if (require('zitadel/usergrant').add('user', 'project', ['role']) !== 'grant') { throw 'unexpected id' }
+			}`,
+		},
+		{
+			name: "add user grant on foreign project",
+			script: `function testFunc(ctx, api) {
+				require('zitadel/usergrant').add('user', 'foreign', ['role']);
+			}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &testStore{values: tt.values, grants: make(map[string]string)}
+			if store.values == nil {
+				store.values = make(map[string][]byte)
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			err := Run(ctx, nil, nil, tt.script, "testFunc", WithStore(ctx, store))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Run() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
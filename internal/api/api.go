@@ -16,6 +16,8 @@ import (
 	http_util "github.com/dennigogo/zitadel/internal/api/http"
 	"github.com/dennigogo/zitadel/internal/errors"
 	"github.com/dennigogo/zitadel/internal/query"
+	"github.com/dennigogo/zitadel/internal/quota"
+	"github.com/dennigogo/zitadel/internal/ratelimit"
 	"github.com/dennigogo/zitadel/internal/telemetry/metrics"
 	"github.com/dennigogo/zitadel/internal/telemetry/tracing"
 )
@@ -28,6 +30,8 @@ type API struct {
 	router         *mux.Router
 	externalSecure bool
 	http1HostName  string
+	// trustClientCertificateHeader allows the client certificate header set by a TLS terminating proxy
+	trustClientCertificateHeader bool
 }
 
 type health interface {
@@ -35,7 +39,7 @@ type health interface {
 	Instance(ctx context.Context, shouldTriggerBulk bool) (*query.Instance, error)
 }
 
-func New(port uint16, router *mux.Router, queries *query.Queries, verifier *internal_authz.TokenVerifier, authZ internal_authz.Config, externalSecure bool, tlsConfig *tls.Config, http2HostName, http1HostName string) *API {
+func New(port uint16, router *mux.Router, queries *query.Queries, quotas quota.Enforcer, limiter ratelimit.Checker, verifier *internal_authz.TokenVerifier, authZ internal_authz.Config, externalSecure bool, tlsConfig *tls.Config, trustClientCertificateHeader bool, http2HostName, http1HostName string) *API {
 	api := &API{
 		port:           port,
 		verifier:       verifier,
@@ -43,8 +47,10 @@ func New(port uint16, router *mux.Router, queries *query.Queries, verifier *inte
 		router:         router,
 		externalSecure: externalSecure,
 		http1HostName:  http1HostName,
+
+		trustClientCertificateHeader: trustClientCertificateHeader,
 	}
-	api.grpcServer = server.CreateServer(api.verifier, authZ, queries, http2HostName, tlsConfig)
+	api.grpcServer = server.CreateServer(api.verifier, authZ, queries, quotas, limiter, http2HostName, tlsConfig, trustClientCertificateHeader)
 	api.routeGRPC()
 
 	api.RegisterHandler("/debug", api.healthHandler())
@@ -54,7 +60,7 @@ func New(port uint16, router *mux.Router, queries *query.Queries, verifier *inte
 
 func (a *API) RegisterServer(ctx context.Context, grpcServer server.Server) error {
 	grpcServer.RegisterServer(a.grpcServer)
-	handler, prefix, err := server.CreateGateway(ctx, grpcServer, a.port, a.http1HostName)
+	handler, prefix, err := server.CreateGateway(ctx, grpcServer, a.port, a.http1HostName, a.trustClientCertificateHeader)
 	if err != nil {
 		return err
 	}
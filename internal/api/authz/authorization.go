@@ -12,6 +12,9 @@ import (
 
 const (
 	authenticated = "authenticated"
+
+	// PermissionImpersonation allows to act as another user of the organisation (or instance) e.g. using token exchange
+	PermissionImpersonation = "impersonation"
 )
 
 func CheckUserAuthorization(ctx context.Context, req interface{}, token, orgID string, verifier *TokenVerifier, authConfig Config, requiredAuthOption Option, method string) (ctxSetter func(context.Context) context.Context, err error) {
@@ -49,6 +52,46 @@ func CheckUserAuthorization(ctx context.Context, req interface{}, token, orgID s
 	}, nil
 }
 
+// CheckImpersonation verifies the token of the actor and checks if they're allowed to impersonate the user of the organisation.
+// Permissions granted on a project (grant) do not allow impersonation.
+// The user must not have any permission the actor doesn't have (e.g. an ORG_IMPERSONATOR can't impersonate an ORG_OWNER),
+// as the actor would escalate their privileges otherwise.
+func CheckImpersonation(ctx context.Context, token, orgID, userID string, verifier *TokenVerifier, authConfig Config) (actorID string, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	ctxData, err := VerifyTokenAndCreateCtxData(ctx, token, orgID, verifier, "")
+	if err != nil {
+		return "", err
+	}
+	requestedPermissions, actorPermissions, err := getUserMethodPermissions(ctx, verifier, PermissionImpersonation, authConfig, ctxData)
+	if err != nil {
+		return "", err
+	}
+	if !HasGlobalExplicitPermission(requestedPermissions, PermissionImpersonation) {
+		return "", errors.ThrowPermissionDenied(nil, "AUTH-Imp2s", "Errors.User.Impersonation.NotAllowed")
+	}
+	userPermissions, err := getUserPermissions(ctx, verifier, authConfig, CtxData{UserID: userID, OrgID: orgID})
+	if err != nil {
+		return "", err
+	}
+	if !containsPermissions(actorPermissions, userPermissions) {
+		return "", errors.ThrowPermissionDenied(nil, "AUTH-Imp3e", "Errors.User.Impersonation.NotAllowed")
+	}
+	return ctxData.UserID, nil
+}
+
+// containsPermissions checks if all permissions are granted, either for the same context or globally
+func containsPermissions(granted, permissions []string) bool {
+	for _, perm := range permissions {
+		p, _ := SplitPermission(perm)
+		if !ExistsPerm(granted, perm) && !ExistsPerm(granted, p) {
+			return false
+		}
+	}
+	return true
+}
+
 func checkUserPermissions(req interface{}, userPerms []string, authOpt Option) error {
 	if len(userPerms) == 0 {
 		return errors.ThrowPermissionDenied(nil, "AUTH-5mWD2", "No matching permissions found")
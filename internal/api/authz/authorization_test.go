@@ -1,6 +1,7 @@
 package authz
 
 import (
+	"context"
 	"testing"
 
 	"github.com/dennigogo/zitadel/internal/errors"
@@ -276,3 +277,149 @@ func Test_GetPermissionCtxIDs(t *testing.T) {
 		})
 	}
 }
+
+func Test_CheckImpersonation(t *testing.T) {
+	authConfig := Config{
+		RolePermissionMappings: []RoleMapping{
+			{
+				Role:        "ORG_IMPERSONATOR",
+				Permissions: []string{PermissionImpersonation},
+			},
+			{
+				Role:        "PROJECT_OWNER",
+				Permissions: []string{PermissionImpersonation, "project.read"},
+			},
+			{
+				Role:        "ORG_OWNER",
+				Permissions: []string{"org.read", "project.read"},
+			},
+			{
+				Role:        "IAM_OWNER",
+				Permissions: []string{"iam.write"},
+			},
+		},
+	}
+	type args struct {
+		token           string
+		memberships     []*Membership
+		userMemberships []*Membership
+	}
+	tests := []struct {
+		name    string
+		args    args
+		actorID string
+		errFunc func(err error) bool
+	}{
+		{
+			name: "invalid token, unauthenticated error",
+			args: args{
+				token: "token",
+				memberships: []*Membership{
+					{MemberType: MemberTypeOrganisation, Roles: []string{"ORG_IMPERSONATOR"}},
+				},
+			},
+			errFunc: errors.IsUnauthenticated,
+		},
+		{
+			name: "no impersonation permission, permission denied error",
+			args: args{
+				token: BearerPrefix + "token",
+				memberships: []*Membership{
+					{MemberType: MemberTypeOrganisation, Roles: []string{"ORG_OWNER"}},
+				},
+			},
+			errFunc: errors.IsPermissionDenied,
+		},
+		{
+			name: "project permission, permission denied error",
+			args: args{
+				token: BearerPrefix + "token",
+				memberships: []*Membership{
+					{MemberType: MemberTypeProject, ObjectID: "project1", Roles: []string{"PROJECT_OWNER"}},
+				},
+			},
+			errFunc: errors.IsPermissionDenied,
+		},
+		{
+			name: "user is org owner, permission denied error",
+			args: args{
+				token: BearerPrefix + "token",
+				memberships: []*Membership{
+					{MemberType: MemberTypeOrganisation, Roles: []string{"ORG_IMPERSONATOR"}},
+				},
+				userMemberships: []*Membership{
+					{MemberType: MemberTypeOrganisation, Roles: []string{"ORG_OWNER"}},
+				},
+			},
+			errFunc: errors.IsPermissionDenied,
+		},
+		{
+			name: "user is iam owner, permission denied error",
+			args: args{
+				token: BearerPrefix + "token",
+				memberships: []*Membership{
+					{MemberType: MemberTypeOrganisation, Roles: []string{"ORG_OWNER", "ORG_IMPERSONATOR"}},
+				},
+				userMemberships: []*Membership{
+					{MemberType: MemberTypeIam, Roles: []string{"IAM_OWNER"}},
+				},
+			},
+			errFunc: errors.IsPermissionDenied,
+		},
+		{
+			name: "user without memberships, ok",
+			args: args{
+				token: BearerPrefix + "token",
+				memberships: []*Membership{
+					{MemberType: MemberTypeOrganisation, Roles: []string{"ORG_IMPERSONATOR"}},
+				},
+				userMemberships: []*Membership{},
+			},
+			actorID: "userID",
+		},
+		{
+			name: "user with same permissions, ok",
+			args: args{
+				token: BearerPrefix + "token",
+				memberships: []*Membership{
+					{MemberType: MemberTypeOrganisation, Roles: []string{"ORG_OWNER", "ORG_IMPERSONATOR"}},
+				},
+				userMemberships: []*Membership{
+					{MemberType: MemberTypeOrganisation, Roles: []string{"ORG_OWNER"}},
+				},
+			},
+			actorID: "userID",
+		},
+		{
+			name: "user with project permissions covered by global permissions, ok",
+			args: args{
+				token: BearerPrefix + "token",
+				memberships: []*Membership{
+					{MemberType: MemberTypeOrganisation, Roles: []string{"ORG_OWNER", "ORG_IMPERSONATOR"}},
+				},
+				userMemberships: []*Membership{
+					{MemberType: MemberTypeProject, ObjectID: "project1", Roles: []string{"PROJECT_OWNER"}},
+				},
+			},
+			actorID: "userID",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verifier := Start(&testVerifier{
+				memberships:     tt.args.memberships,
+				userMemberships: map[string][]*Membership{"user1": tt.args.userMemberships},
+			}, "", nil)
+			actorID, err := CheckImpersonation(context.Background(), tt.args.token, "orgID", "user1", verifier, authConfig)
+			if tt.errFunc == nil && err != nil {
+				t.Errorf("got wrong result, should not get err: actual: %v ", err)
+			}
+			if tt.errFunc != nil && !tt.errFunc(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if actorID != tt.actorID {
+				t.Errorf("got wrong result, expecting: %v, actual: %v ", tt.actorID, actorID)
+			}
+		})
+	}
+}
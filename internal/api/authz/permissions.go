@@ -39,6 +39,20 @@ func getUserMethodPermissions(ctx context.Context, t *TokenVerifier, requiredPer
 	return requestedPermissions, allPermissions, nil
 }
 
+// getUserPermissions returns all permissions of the user of the ctxData,
+// other than getUserMethodPermissions it doesn't retry if the user has no memberships
+func getUserPermissions(ctx context.Context, t *TokenVerifier, authConfig Config, ctxData CtxData) (_ []string, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	memberships, err := t.SearchMyMemberships(context.WithValue(ctx, dataKey, ctxData))
+	if err != nil {
+		return nil, err
+	}
+	_, permissions := mapMembershipsToPermissions("", memberships, authConfig)
+	return permissions, nil
+}
+
 func mapMembershipsToPermissions(requiredPerm string, memberships []*Membership, authConfig Config) (requestPermissions, allPermissions []string) {
 	requestPermissions = make([]string, 0)
 	allPermissions = make([]string, 0)
@@ -13,12 +13,18 @@ func getTestCtx(userID, orgID string) context.Context {
 
 type testVerifier struct {
 	memberships []*Membership
+	// userMemberships are returned instead of the memberships for the specific users
+	userMemberships map[string][]*Membership
+	confirmation    *TokenConfirmation
 }
 
-func (v *testVerifier) VerifyAccessToken(ctx context.Context, token, clientID, projectID string) (string, string, string, string, string, error) {
-	return "userID", "agentID", "clientID", "de", "orgID", nil
+func (v *testVerifier) VerifyAccessToken(ctx context.Context, token, clientID, projectID string) (string, string, string, string, string, *TokenConfirmation, error) {
+	return "userID", "agentID", "clientID", "de", "orgID", v.confirmation, nil
 }
 func (v *testVerifier) SearchMyMemberships(ctx context.Context) ([]*Membership, error) {
+	if memberships, ok := v.userMemberships[GetCtxData(ctx).UserID]; ok {
+		return memberships, nil
+	}
 	return v.memberships, nil
 }
 
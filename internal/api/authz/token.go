@@ -28,7 +28,7 @@ type TokenVerifier struct {
 }
 
 type authZRepo interface {
-	VerifyAccessToken(ctx context.Context, token, verifierClientID, projectID string) (userID, agentID, clientID, prefLang, resourceOwner string, err error)
+	VerifyAccessToken(ctx context.Context, token, verifierClientID, projectID string) (userID, agentID, clientID, prefLang, resourceOwner string, confirmation *TokenConfirmation, err error)
 	VerifierClientID(ctx context.Context, name string) (clientID, projectID string, err error)
 	SearchMyMemberships(ctx context.Context) ([]*Membership, error)
 	ProjectIDAndOriginsByClientID(ctx context.Context, clientID string) (projectID string, origins []string, err error)
@@ -50,7 +50,9 @@ func Start(authZRepo authZRepo, issuer string, keys map[string]*SystemAPIUser) (
 	}
 }
 
-func (v *TokenVerifier) VerifyAccessToken(ctx context.Context, token string, method string) (userID, clientID, agentID, prefLang, resourceOwner string, err error) {
+// VerifyAccessToken verifies the access token and, if the token is sender-constrained,
+// the DPoP proof (dpop is set if it's sent with the DPoP scheme) or the client certificate of the request
+func (v *TokenVerifier) VerifyAccessToken(ctx context.Context, token string, dpop bool, method string) (userID, clientID, agentID, prefLang, resourceOwner string, err error) {
 	if strings.HasPrefix(method, "/zitadel.system.v1.SystemService") {
 		userID, err := v.verifySystemToken(ctx, token)
 		if err != nil {
@@ -58,8 +60,14 @@ func (v *TokenVerifier) VerifyAccessToken(ctx context.Context, token string, met
 		}
 		return userID, "", "", "", "", nil
 	}
-	userID, agentID, clientID, prefLang, resourceOwner, err = v.authZRepo.VerifyAccessToken(ctx, token, "", GetInstance(ctx).ProjectID())
-	return userID, clientID, agentID, prefLang, resourceOwner, err
+	userID, agentID, clientID, prefLang, resourceOwner, confirmation, err := v.authZRepo.VerifyAccessToken(ctx, token, "", GetInstance(ctx).ProjectID())
+	if err != nil {
+		return "", "", "", "", "", err
+	}
+	if err = checkTokenConfirmation(ctx, confirmation, token, dpop, method); err != nil {
+		return "", "", "", "", "", err
+	}
+	return userID, clientID, agentID, prefLang, resourceOwner, nil
 }
 
 func (v *TokenVerifier) verifySystemToken(ctx context.Context, token string) (string, error) {
@@ -155,9 +163,12 @@ func verifyAccessToken(ctx context.Context, token string, t *TokenVerifier, meth
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
+	if strings.HasPrefix(token, DPoPPrefix) {
+		return t.VerifyAccessToken(ctx, strings.TrimPrefix(token, DPoPPrefix), true, method)
+	}
 	parts := strings.Split(token, BearerPrefix)
 	if len(parts) != 2 {
 		return "", "", "", "", "", caos_errs.ThrowUnauthenticated(nil, "AUTH-7fs1e", "invalid auth header")
 	}
-	return t.VerifyAccessToken(ctx, parts[1], method)
+	return t.VerifyAccessToken(ctx, parts[1], false, method)
 }
@@ -0,0 +1,252 @@
+package authz
+
+import (
+	"context"
+	"crypto"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	str_utils "github.com/zitadel/oidc/v2/pkg/strings"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"gopkg.in/square/go-jose.v2"
+
+	"github.com/dennigogo/zitadel/internal/api/grpc"
+	http_util "github.com/dennigogo/zitadel/internal/api/http"
+	caos_errs "github.com/dennigogo/zitadel/internal/errors"
+)
+
+const (
+	DPoPPrefix = "DPoP "
+	// DPoPTokenType is the token_type of access tokens bound to the key of a DPoP proof (RFC 9449)
+	DPoPTokenType = "DPoP"
+
+	dpopProofType = "dpop+jwt"
+	// dpopProofMaxAge is the time a DPoP proof is accepted after it was issued
+	dpopProofMaxAge = 5 * time.Minute
+	// dpopProofLeeway allows DPoP proofs issued in the future because of clock skew
+	dpopProofLeeway = 10 * time.Second
+)
+
+var (
+	// DPoPSigningAlgorithms are the (asymmetric) algorithms allowed to sign DPoP proofs
+	DPoPSigningAlgorithms = []string{
+		string(jose.RS256), string(jose.RS384), string(jose.RS512),
+		string(jose.PS256), string(jose.PS384), string(jose.PS512),
+		string(jose.ES256), string(jose.ES384), string(jose.ES512),
+		string(jose.EdDSA),
+	}
+	usedDPoPProofs = &dpopProofCache{proofs: make(map[string]time.Time)}
+)
+
+// TokenConfirmation is the `cnf` claim of sender-constrained access tokens,
+// which are bound to the key of the DPoP proofs (RFC 9449) and / or the client certificate of the mTLS connection (RFC 8705)
+type TokenConfirmation struct {
+	DPoPJKT               string `json:"jkt,omitempty"`
+	CertificateThumbprint string `json:"x5t#S256,omitempty"`
+}
+
+func (c *TokenConfirmation) IsZero() bool {
+	return c == nil || (c.DPoPJKT == "" && c.CertificateThumbprint == "")
+}
+
+type dpopProofClaims struct {
+	ID              string `json:"jti"`
+	Method          string `json:"htm"`
+	URI             string `json:"htu"`
+	IssuedAt        int64  `json:"iat"`
+	AccessTokenHash string `json:"ath"`
+}
+
+// VerifyDPoPProof verifies the DPoP proof (RFC 9449) of a request to the host and path with the (http) method
+// and returns the thumbprint of its key (jkt).
+// If an access token is passed, the proof must contain its hash (ath).
+// The scheme of the htu claim is ignored, as TLS might be terminated in front of ZITADEL.
+func VerifyDPoPProof(proof, method, host, path, accessToken string) (string, error) {
+	jws, err := jose.ParseSigned(proof)
+	if err != nil || len(jws.Signatures) != 1 {
+		return "", caos_errs.ThrowUnauthenticated(err, "AUTHZ-Dp0f1", "Errors.Token.DPoP.Invalid")
+	}
+	header := jws.Signatures[0].Protected
+	if typ, _ := header.ExtraHeaders[jose.HeaderType].(string); typ != dpopProofType {
+		return "", caos_errs.ThrowUnauthenticated(nil, "AUTHZ-Dp2ty", "Errors.Token.DPoP.Invalid")
+	}
+	if !str_utils.Contains(DPoPSigningAlgorithms, header.Algorithm) {
+		return "", caos_errs.ThrowUnauthenticated(nil, "AUTHZ-Dp3al", "Errors.Token.DPoP.Invalid")
+	}
+	key := header.JSONWebKey
+	if key == nil || !key.Valid() || !key.IsPublic() {
+		return "", caos_errs.ThrowUnauthenticated(nil, "AUTHZ-Dp4ky", "Errors.Token.DPoP.Invalid")
+	}
+	payload, err := jws.Verify(key)
+	if err != nil {
+		return "", caos_errs.ThrowUnauthenticated(err, "AUTHZ-Dp5sg", "Errors.Token.DPoP.Invalid")
+	}
+	claims := new(dpopProofClaims)
+	if err = json.Unmarshal(payload, claims); err != nil {
+		return "", caos_errs.ThrowUnauthenticated(err, "AUTHZ-Dp6cl", "Errors.Token.DPoP.Invalid")
+	}
+	if claims.ID == "" || claims.Method != method || !dpopURIMatches(claims.URI, host, path) {
+		return "", caos_errs.ThrowUnauthenticated(nil, "AUTHZ-Dp7rq", "Errors.Token.DPoP.Invalid")
+	}
+	issuedAt := time.Unix(claims.IssuedAt, 0)
+	now := time.Now()
+	if issuedAt.Before(now.Add(-dpopProofMaxAge)) || issuedAt.After(now.Add(dpopProofLeeway)) {
+		return "", caos_errs.ThrowUnauthenticated(nil, "AUTHZ-Dp8ia", "Errors.Token.DPoP.Invalid")
+	}
+	if accessToken != "" && claims.AccessTokenHash != AccessTokenHash(accessToken) {
+		return "", caos_errs.ThrowUnauthenticated(nil, "AUTHZ-Dp9at", "Errors.Token.DPoP.Invalid")
+	}
+	thumbprint, err := key.Thumbprint(crypto.SHA256)
+	if err != nil {
+		return "", caos_errs.ThrowUnauthenticated(err, "AUTHZ-Dp1tp", "Errors.Token.DPoP.Invalid")
+	}
+	jkt := base64.RawURLEncoding.EncodeToString(thumbprint)
+	if !usedDPoPProofs.use(jkt+":"+claims.ID, issuedAt.Add(dpopProofMaxAge)) {
+		return "", caos_errs.ThrowUnauthenticated(nil, "AUTHZ-Dp2rp", "Errors.Token.DPoP.Invalid")
+	}
+	return jkt, nil
+}
+
+// AccessTokenHash returns the hash of the access token as used in the ath claim of DPoP proofs
+func AccessTokenHash(accessToken string) string {
+	hash := sha256.Sum256([]byte(accessToken))
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
+
+func dpopURIMatches(htu, host, path string) bool {
+	uri, err := url.Parse(htu)
+	if err != nil {
+		return false
+	}
+	return strings.EqualFold(uri.Host, host) && uri.Path == path
+}
+
+// CertificateThumbprint returns the SHA-256 thumbprint of the certificate (x5t#S256)
+func CertificateThumbprint(cert *x509.Certificate) string {
+	hash := sha256.Sum256(cert.Raw)
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
+
+// ClientCertificateFromHeader parses the url encoded PEM certificate
+// of the x-zitadel-client-cert header set by a TLS terminating proxy
+func ClientCertificateFromHeader(value string) (*x509.Certificate, error) {
+	unescaped, err := url.PathUnescape(value)
+	if err != nil {
+		return nil, caos_errs.ThrowUnauthenticated(err, "AUTHZ-Ce1sc", "Errors.Token.ClientCertificate.Invalid")
+	}
+	block, _ := pem.Decode([]byte(unescaped))
+	if block == nil {
+		return nil, caos_errs.ThrowUnauthenticated(nil, "AUTHZ-Ce2pm", "Errors.Token.ClientCertificate.Invalid")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, caos_errs.ThrowUnauthenticated(err, "AUTHZ-Ce3pr", "Errors.Token.ClientCertificate.Invalid")
+	}
+	return cert, nil
+}
+
+// checkTokenConfirmation verifies that the caller possesses the key the access token is bound to:
+// DPoP bound tokens must be sent with the DPoP scheme and a proof of the key,
+// mTLS bound tokens must be sent over a connection with the same client certificate
+func checkTokenConfirmation(ctx context.Context, confirmation *TokenConfirmation, token string, dpop bool, method string) error {
+	if confirmation == nil {
+		confirmation = new(TokenConfirmation)
+	}
+	if (confirmation.DPoPJKT != "") != dpop {
+		return caos_errs.ThrowUnauthenticated(nil, "AUTHZ-Dp3sc", "Errors.Token.DPoP.Invalid")
+	}
+	if dpop {
+		proof := grpc.GetHeader(ctx, http_util.DPoP)
+		if proof == "" {
+			return caos_errs.ThrowUnauthenticated(nil, "AUTHZ-Dp4ms", "Errors.Token.DPoP.Missing")
+		}
+		httpMethod, path := requestMethodAndPath(ctx, method)
+		jkt, err := VerifyDPoPProof(proof, httpMethod, GetInstance(ctx).RequestedHost(), path, token)
+		if err != nil {
+			return err
+		}
+		if jkt != confirmation.DPoPJKT {
+			return caos_errs.ThrowUnauthenticated(nil, "AUTHZ-Dp5kb", "Errors.Token.DPoP.Invalid")
+		}
+	}
+	if confirmation.CertificateThumbprint == "" {
+		return nil
+	}
+	thumbprint, err := clientCertificateThumbprint(ctx)
+	if err != nil {
+		return err
+	}
+	if thumbprint != confirmation.CertificateThumbprint {
+		return caos_errs.ThrowUnauthenticated(nil, "AUTHZ-Ce4bd", "Errors.Token.ClientCertificate.Invalid")
+	}
+	return nil
+}
+
+// requestMethodAndPath returns the http request passed by the gateway
+// or the gRPC method, which is always called with POST.
+// The request info of other callers is removed by the RequestInfoInterceptor of the gRPC server.
+func requestMethodAndPath(ctx context.Context, method string) (string, string) {
+	if path := grpc.GetHeader(ctx, http_util.ZitadelRequestPath); path != "" {
+		return grpc.GetHeader(ctx, http_util.ZitadelRequestMethod), path
+	}
+	return http.MethodPost, method
+}
+
+// clientCertificateThumbprint returns the thumbprint of the client certificate of the mTLS connection.
+// The header of a TLS terminating proxy is only considered, if ZITADEL doesn't terminate TLS itself,
+// it's removed by the RequestInfoInterceptor of the gRPC server if the proxy isn't trusted.
+func clientCertificateThumbprint(ctx context.Context) (string, error) {
+	if p, ok := peer.FromContext(ctx); ok {
+		if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+			if len(tlsInfo.State.PeerCertificates) == 0 {
+				return "", nil
+			}
+			return CertificateThumbprint(tlsInfo.State.PeerCertificates[0]), nil
+		}
+	}
+	header := grpc.GetHeader(ctx, http_util.ZitadelClientCertificate)
+	if header == "" {
+		return "", nil
+	}
+	cert, err := ClientCertificateFromHeader(header)
+	if err != nil {
+		return "", err
+	}
+	return CertificateThumbprint(cert), nil
+}
+
+// dpopProofCache remembers the used DPoP proofs until they expire,
+// so they can't be replayed (on the same ZITADEL instance)
+type dpopProofCache struct {
+	mutex       sync.Mutex
+	proofs      map[string]time.Time
+	nextCleanup time.Time
+}
+
+func (c *dpopProofCache) use(id string, expiration time.Time) bool {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	now := time.Now()
+	if exp, ok := c.proofs[id]; ok && exp.After(now) {
+		return false
+	}
+	if now.After(c.nextCleanup) {
+		for proof, exp := range c.proofs {
+			if exp.Before(now) {
+				delete(c.proofs, proof)
+			}
+		}
+		c.nextCleanup = now.Add(dpopProofMaxAge)
+	}
+	c.proofs[id] = expiration
+	return true
+}
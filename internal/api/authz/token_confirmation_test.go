@@ -0,0 +1,259 @@
+package authz
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"gopkg.in/square/go-jose.v2"
+
+	http_util "github.com/dennigogo/zitadel/internal/api/http"
+	caos_errs "github.com/dennigogo/zitadel/internal/errors"
+)
+
+func dpopProof(t *testing.T, key *ecdsa.PrivateKey, typ string, claims map[string]interface{}) string {
+	t.Helper()
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.ES256, Key: key},
+		(&jose.SignerOptions{EmbedJWK: true}).WithType(jose.ContentType(typ)),
+	)
+	require.NoError(t, err)
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+	jws, err := signer.Sign(payload)
+	require.NoError(t, err)
+	proof, err := jws.CompactSerialize()
+	require.NoError(t, err)
+	return proof
+}
+
+func jwkThumbprint(t *testing.T, key *ecdsa.PrivateKey) string {
+	t.Helper()
+	thumbprint, err := (&jose.JSONWebKey{Key: key.Public()}).Thumbprint(crypto.SHA256)
+	require.NoError(t, err)
+	return base64.RawURLEncoding.EncodeToString(thumbprint)
+}
+
+func TestVerifyDPoPProof(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	claims := func(id string, modify func(map[string]interface{})) map[string]interface{} {
+		c := map[string]interface{}{
+			"jti": id,
+			"htm": "POST",
+			"htu": "https://zitadel.cloud/oauth/v2/token",
+			"iat": time.Now().Unix(),
+		}
+		if modify != nil {
+			modify(c)
+		}
+		return c
+	}
+	type args struct {
+		proof       string
+		accessToken string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "invalid proof",
+			args: args{
+				proof: "invalid",
+			},
+			wantErr: true,
+		},
+		{
+			name: "wrong type",
+			args: args{
+				proof: dpopProof(t, key, "JWT", claims("type", nil)),
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing id",
+			args: args{
+				proof: dpopProof(t, key, dpopProofType, claims("", nil)),
+			},
+			wantErr: true,
+		},
+		{
+			name: "wrong method",
+			args: args{
+				proof: dpopProof(t, key, dpopProofType, claims("method", func(c map[string]interface{}) { c["htm"] = "GET" })),
+			},
+			wantErr: true,
+		},
+		{
+			name: "wrong host",
+			args: args{
+				proof: dpopProof(t, key, dpopProofType, claims("host", func(c map[string]interface{}) { c["htu"] = "https://evil.com/oauth/v2/token" })),
+			},
+			wantErr: true,
+		},
+		{
+			name: "wrong path",
+			args: args{
+				proof: dpopProof(t, key, dpopProofType, claims("path", func(c map[string]interface{}) { c["htu"] = "https://zitadel.cloud/oauth/v2/introspect" })),
+			},
+			wantErr: true,
+		},
+		{
+			name: "expired",
+			args: args{
+				proof: dpopProof(t, key, dpopProofType, claims("expired", func(c map[string]interface{}) { c["iat"] = time.Now().Add(-time.Hour).Unix() })),
+			},
+			wantErr: true,
+		},
+		{
+			name: "issued in the future",
+			args: args{
+				proof: dpopProof(t, key, dpopProofType, claims("future", func(c map[string]interface{}) { c["iat"] = time.Now().Add(time.Hour).Unix() })),
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing access token hash",
+			args: args{
+				proof:       dpopProof(t, key, dpopProofType, claims("ath missing", nil)),
+				accessToken: "token",
+			},
+			wantErr: true,
+		},
+		{
+			name: "ok",
+			args: args{
+				proof: dpopProof(t, key, dpopProofType, claims("ok", nil)),
+			},
+			want: jwkThumbprint(t, key),
+		},
+		{
+			name: "ok with access token hash, query ignored",
+			args: args{
+				proof: dpopProof(t, key, dpopProofType, claims("ath", func(c map[string]interface{}) {
+					c["ath"] = AccessTokenHash("token")
+					c["htu"] = "http://zitadel.cloud/oauth/v2/token?query=ignored"
+				})),
+				accessToken: "token",
+			},
+			want: jwkThumbprint(t, key),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := VerifyDPoPProof(tt.args.proof, "POST", "zitadel.cloud", "/oauth/v2/token", tt.args.accessToken)
+			if tt.wantErr {
+				assert.True(t, caos_errs.IsUnauthenticated(err), "expected unauthenticated, got %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestVerifyDPoPProof_replay(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	proof := dpopProof(t, key, dpopProofType, map[string]interface{}{
+		"jti": "replay",
+		"htm": "POST",
+		"htu": "https://zitadel.cloud/oauth/v2/token",
+		"iat": time.Now().Unix(),
+	})
+	_, err = VerifyDPoPProof(proof, "POST", "zitadel.cloud", "/oauth/v2/token", "")
+	require.NoError(t, err)
+	_, err = VerifyDPoPProof(proof, "POST", "zitadel.cloud", "/oauth/v2/token", "")
+	assert.True(t, caos_errs.IsUnauthenticated(err), "expected unauthenticated, got %v", err)
+}
+
+func testCertificate(t *testing.T) *x509.Certificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "client"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert
+}
+
+func Test_clientCertificateThumbprint(t *testing.T) {
+	cert := testCertificate(t)
+	header := url.PathEscape(string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})))
+	tlsPeer := func(certs ...*x509.Certificate) *peer.Peer {
+		return &peer.Peer{AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{PeerCertificates: certs}}}
+	}
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "no certificate",
+			ctx:  context.Background(),
+			want: "",
+		},
+		{
+			name: "certificate of proxy",
+			ctx:  metadata.NewIncomingContext(context.Background(), metadata.Pairs(http_util.ZitadelClientCertificate, header)),
+			want: CertificateThumbprint(cert),
+		},
+		{
+			name:    "invalid certificate of proxy",
+			ctx:     metadata.NewIncomingContext(context.Background(), metadata.Pairs(http_util.ZitadelClientCertificate, "invalid")),
+			wantErr: true,
+		},
+		{
+			name: "certificate of tls connection",
+			ctx:  peer.NewContext(context.Background(), tlsPeer(cert)),
+			want: CertificateThumbprint(cert),
+		},
+		{
+			name: "tls connection ignores header",
+			ctx: peer.NewContext(
+				metadata.NewIncomingContext(context.Background(), metadata.Pairs(http_util.ZitadelClientCertificate, header)),
+				tlsPeer(),
+			),
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := clientCertificateThumbprint(tt.ctx)
+			if tt.wantErr {
+				assert.True(t, caos_errs.IsUnauthenticated(err), "expected unauthenticated, got %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
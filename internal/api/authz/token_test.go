@@ -55,6 +55,58 @@ func Test_VerifyAccessToken(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "dpop bound token sent as bearer",
+			args: args{
+				ctx:   context.Background(),
+				token: "Bearer AUTH",
+				verifier: &TokenVerifier{
+					authZRepo:   &testVerifier{memberships: []*Membership{}, confirmation: &TokenConfirmation{DPoPJKT: "jkt"}},
+					authMethods: MethodMapping{"/service/method": Option{Permission: "authenticated"}},
+				},
+				method: "/service/method",
+			},
+			wantErr: true,
+		},
+		{
+			name: "dpop scheme without proof",
+			args: args{
+				ctx:   context.Background(),
+				token: "DPoP AUTH",
+				verifier: &TokenVerifier{
+					authZRepo:   &testVerifier{memberships: []*Membership{}, confirmation: &TokenConfirmation{DPoPJKT: "jkt"}},
+					authMethods: MethodMapping{"/service/method": Option{Permission: "authenticated"}},
+				},
+				method: "/service/method",
+			},
+			wantErr: true,
+		},
+		{
+			name: "dpop scheme with unbound token",
+			args: args{
+				ctx:   context.Background(),
+				token: "DPoP AUTH",
+				verifier: &TokenVerifier{
+					authZRepo:   &testVerifier{memberships: []*Membership{}},
+					authMethods: MethodMapping{"/service/method": Option{Permission: "authenticated"}},
+				},
+				method: "/service/method",
+			},
+			wantErr: true,
+		},
+		{
+			name: "mtls bound token without certificate",
+			args: args{
+				ctx:   context.Background(),
+				token: "Bearer AUTH",
+				verifier: &TokenVerifier{
+					authZRepo:   &testVerifier{memberships: []*Membership{}, confirmation: &TokenConfirmation{CertificateThumbprint: "x5t"}},
+					authMethods: MethodMapping{"/service/method": Option{Permission: "authenticated"}},
+				},
+				method: "/service/method",
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1,8 +1,13 @@
 package action
 
 import (
+	"fmt"
+
 	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/dennigogo/zitadel/internal/actions"
 	object_grpc "github.com/dennigogo/zitadel/internal/api/grpc/object"
 	"github.com/dennigogo/zitadel/internal/domain"
 	"github.com/dennigogo/zitadel/internal/query"
@@ -17,6 +22,8 @@ func FlowTypeToDomain(flowType string) domain.FlowType {
 		return domain.FlowTypeExternalAuthentication
 	case domain.FlowTypeCustomiseToken.ID():
 		return domain.FlowTypeCustomiseToken
+	case domain.FlowTypeInternalAuthentication.ID():
+		return domain.FlowTypeInternalAuthentication
 	default:
 		return domain.FlowTypeUnspecified
 	}
@@ -45,6 +52,8 @@ func TriggerTypeToDomain(triggerType string) domain.TriggerType {
 		return domain.TriggerTypePreAccessTokenCreation
 	case domain.TriggerTypePreUserinfoCreation.ID():
 		return domain.TriggerTypePreUserinfoCreation
+	case domain.TriggerTypePreSAMLResponseCreation.ID():
+		return domain.TriggerTypePreSAMLResponseCreation
 	default:
 		return domain.TriggerTypeUnspecified
 	}
@@ -143,3 +152,108 @@ func ActionStateToDomain(state action_pb.ActionState) domain.ActionState {
 		return domain.ActionStateUnspecified
 	}
 }
+
+func ActionExecutionsToPb(executions []*query.ActionExecution) []*action_pb.ActionExecution {
+	list := make([]*action_pb.ActionExecution, len(executions))
+	for i, execution := range executions {
+		list[i] = ActionExecutionToPb(execution)
+	}
+	return list
+}
+
+func ActionExecutionToPb(execution *query.ActionExecution) *action_pb.ActionExecution {
+	logs := make([]*action_pb.ActionExecutionLog, len(execution.Logs))
+	for i, log := range execution.Logs {
+		logs[i] = &action_pb.ActionExecutionLog{
+			Level:   log.Level,
+			Message: log.Message,
+		}
+	}
+	return &action_pb.ActionExecution{
+		Id:            execution.ID,
+		ActionId:      execution.ActionID,
+		ActionName:    execution.ActionName,
+		FlowType:      FlowTypeToPb(execution.FlowType),
+		TriggerType:   TriggerTypeToPb(execution.TriggerType),
+		CreationDate:  timestamppb.New(execution.CreationDate),
+		Duration:      durationpb.New(execution.Duration),
+		Outcome:       ActionExecutionOutcomeToPb(execution.Outcome),
+		Error:         execution.Error,
+		Logs:          logs,
+		ResourceOwner: execution.ResourceOwner,
+	}
+}
+
+func ActionExecutionLogsToPb(executionLogs []*actions.ExecutionLog) []*action_pb.ActionExecutionLog {
+	logs := make([]*action_pb.ActionExecutionLog, len(executionLogs))
+	for i, log := range executionLogs {
+		logs[i] = &action_pb.ActionExecutionLog{
+			Level:   string(log.Level),
+			Message: log.Message,
+		}
+	}
+	return logs
+}
+
+func ActionExecutionAPICallsToPb(calls []*actions.APICall) []*action_pb.ActionExecutionAPICall {
+	list := make([]*action_pb.ActionExecutionAPICall, len(calls))
+	for i, call := range calls {
+		args := &structpb.ListValue{Values: make([]*structpb.Value, len(call.Args))}
+		for j, arg := range call.Args {
+			value, err := structpb.NewValue(arg)
+			if err != nil {
+				// values which are not representable in json are returned as their string representation
+				value = structpb.NewStringValue(fmt.Sprint(arg))
+			}
+			args.Values[j] = value
+		}
+		list[i] = &action_pb.ActionExecutionAPICall{
+			Method:     call.Method,
+			Args:       args,
+			Assignment: call.Assignment,
+		}
+	}
+	return list
+}
+
+func ActionExecutionOutcomeToPb(outcome domain.ActionExecutionOutcome) action_pb.ActionExecutionOutcome {
+	switch outcome {
+	case domain.ActionExecutionOutcomeSucceeded:
+		return action_pb.ActionExecutionOutcome_ACTION_EXECUTION_OUTCOME_SUCCEEDED
+	case domain.ActionExecutionOutcomeFailed:
+		return action_pb.ActionExecutionOutcome_ACTION_EXECUTION_OUTCOME_FAILED
+	case domain.ActionExecutionOutcomeFailureIgnored:
+		return action_pb.ActionExecutionOutcome_ACTION_EXECUTION_OUTCOME_FAILURE_IGNORED
+	default:
+		return action_pb.ActionExecutionOutcome_ACTION_EXECUTION_OUTCOME_UNSPECIFIED
+	}
+}
+
+func ActionExecutionOutcomeToDomain(outcome action_pb.ActionExecutionOutcome) domain.ActionExecutionOutcome {
+	switch outcome {
+	case action_pb.ActionExecutionOutcome_ACTION_EXECUTION_OUTCOME_SUCCEEDED:
+		return domain.ActionExecutionOutcomeSucceeded
+	case action_pb.ActionExecutionOutcome_ACTION_EXECUTION_OUTCOME_FAILED:
+		return domain.ActionExecutionOutcomeFailed
+	case action_pb.ActionExecutionOutcome_ACTION_EXECUTION_OUTCOME_FAILURE_IGNORED:
+		return domain.ActionExecutionOutcomeFailureIgnored
+	default:
+		return domain.ActionExecutionOutcomeUnspecified
+	}
+}
+
+func ActionExecutionActionIDQuery(q *action_pb.ActionExecutionActionIDQuery) (query.SearchQuery, error) {
+	return query.NewActionExecutionActionIDSearchQuery(q.ActionId)
+}
+
+func ActionExecutionFlowTypeQuery(q *action_pb.ActionExecutionFlowTypeQuery) (query.SearchQuery, error) {
+	return query.NewActionExecutionFlowTypeSearchQuery(FlowTypeToDomain(q.FlowType))
+}
+
+func ActionExecutionTriggerTypeQuery(q *action_pb.ActionExecutionTriggerTypeQuery) (query.SearchQuery, error) {
+	return query.NewActionExecutionTriggerTypeSearchQuery(TriggerTypeToDomain(q.TriggerType))
+}
+
+func ActionExecutionOutcomeQuery(q *action_pb.ActionExecutionOutcomeQuery) (query.SearchQuery, error) {
+	return query.NewActionExecutionOutcomeSearchQuery(ActionExecutionOutcomeToDomain(q.Outcome))
+}
@@ -7,6 +7,7 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	authn_grpc "github.com/dennigogo/zitadel/internal/api/grpc/authn"
+	policy_grpc "github.com/dennigogo/zitadel/internal/api/grpc/policy"
 	text_grpc "github.com/dennigogo/zitadel/internal/api/grpc/text"
 	"github.com/dennigogo/zitadel/internal/domain"
 	caos_errors "github.com/dennigogo/zitadel/internal/errors"
@@ -316,10 +317,10 @@ func (s *Server) getIDPs(ctx context.Context, orgID string) (_ []*v1_pb.DataOIDC
 				Idp: &management_pb.AddOrgOIDCIDPRequest{
 					Name:               idp.Name,
 					StylingType:        idp_pb.IDPStylingType(idp.StylingType),
-					ClientId:           idp.ClientID,
+					ClientId:           idp.OIDCIDP.ClientID,
 					ClientSecret:       clientSecret,
 					Issuer:             idp.OIDCIDP.Issuer,
-					Scopes:             idp.Scopes,
+					Scopes:             idp.OIDCIDP.Scopes,
 					DisplayNameMapping: idp_pb.OIDCMappingField(idp.DisplayNameMapping),
 					UsernameMapping:    idp_pb.OIDCMappingField(idp.UsernameMapping),
 					AutoRegister:       idp.AutoRegister,
@@ -382,6 +383,7 @@ func (s *Server) getLoginPolicy(ctx context.Context, orgID string) (_ *managemen
 		mfaInitSkip := durationpb.New(queriedLogin.MFAInitSkipLifetime)
 		secondFactor := durationpb.New(queriedLogin.SecondFactorCheckLifetime)
 		multiFactor := durationpb.New(queriedLogin.MultiFactorCheckLifetime)
+		trustedDevice := durationpb.New(queriedLogin.TrustedDeviceLifetime)
 
 		secondFactors := []policy_pb.SecondFactorType{}
 		for _, factor := range queriedLogin.SecondFactors {
@@ -419,6 +421,7 @@ func (s *Server) getLoginPolicy(ctx context.Context, orgID string) (_ *managemen
 			MfaInitSkipLifetime:        mfaInitSkip,
 			SecondFactorCheckLifetime:  secondFactor,
 			MultiFactorCheckLifetime:   multiFactor,
+			TrustedDeviceLifetime:      trustedDevice,
 			SecondFactors:              secondFactors,
 			MultiFactors:               multiFactors,
 			Idps:                       idpLinks,
@@ -466,6 +469,11 @@ func (s *Server) getLockoutPolicy(ctx context.Context, orgID string) (_ *managem
 	if !queriedLockout.IsDefault {
 		return &management_pb.AddCustomLockoutPolicyRequest{
 			MaxPasswordAttempts: uint32(queriedLockout.MaxPasswordAttempts),
+			MaxOtpAttempts:      uint32(queriedLockout.MaxOTPAttempts),
+			MaxU2FAttempts:      uint32(queriedLockout.MaxU2FAttempts),
+			AutoUnlockAfter:     durationpb.New(queriedLockout.AutoUnlockAfter),
+			AttemptDelay:        durationpb.New(queriedLockout.AttemptDelay),
+			MaxAttemptDelay:     durationpb.New(queriedLockout.MaxAttemptDelay),
 		}, nil
 	}
 	return nil, nil
@@ -486,6 +494,8 @@ func (s *Server) getPasswordComplexityPolicy(ctx context.Context, orgID string)
 			HasLowercase: queriedPasswordComplexity.HasLowercase,
 			HasNumber:    queriedPasswordComplexity.HasNumber,
 			HasSymbol:    queriedPasswordComplexity.HasSymbol,
+
+			BreachedPasswordCheck: policy_grpc.ModelBreachedPasswordCheckToPb(queriedPasswordComplexity.BreachedPasswordCheck),
 		}, nil
 	}
 	return nil, nil
@@ -639,7 +649,7 @@ func (s *Server) getUsers(ctx context.Context, org string, withPasswords bool, w
 func (s *Server) getTriggerActions(ctx context.Context, org string, processedActions []string) (_ []*management_pb.SetTriggerActionsRequest, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
-	flowTypes := []domain.FlowType{domain.FlowTypeExternalAuthentication}
+	flowTypes := []domain.FlowType{domain.FlowTypeExternalAuthentication, domain.FlowTypeInternalAuthentication}
 	triggerActions := make([]*management_pb.SetTriggerActionsRequest, 0)
 
 	for _, flowType := range flowTypes {
@@ -763,22 +773,26 @@ func (s *Server) getProjectsAndApps(ctx context.Context, org string) ([]*v1_pb.D
 				oidcApps = append(oidcApps, &v1_pb.DataOIDCApplication{
 					AppId: app.ID,
 					App: &management_pb.AddOIDCAppRequest{
-						ProjectId:                app.ProjectID,
-						Name:                     app.Name,
-						RedirectUris:             app.OIDCConfig.RedirectURIs,
-						ResponseTypes:            responseTypes,
-						GrantTypes:               grantTypes,
-						AppType:                  app_pb.OIDCAppType(app.OIDCConfig.AppType),
-						AuthMethodType:           app_pb.OIDCAuthMethodType(app.OIDCConfig.AuthMethodType),
-						PostLogoutRedirectUris:   app.OIDCConfig.PostLogoutRedirectURIs,
-						Version:                  app_pb.OIDCVersion(app.OIDCConfig.Version),
-						DevMode:                  app.OIDCConfig.IsDevMode,
-						AccessTokenType:          app_pb.OIDCTokenType(app.OIDCConfig.AccessTokenType),
-						AccessTokenRoleAssertion: app.OIDCConfig.AssertAccessTokenRole,
-						IdTokenRoleAssertion:     app.OIDCConfig.AssertIDTokenRole,
-						IdTokenUserinfoAssertion: app.OIDCConfig.AssertIDTokenUserinfo,
-						ClockSkew:                durationpb.New(app.OIDCConfig.ClockSkew),
-						AdditionalOrigins:        app.OIDCConfig.AdditionalOrigins,
+						ProjectId:                  app.ProjectID,
+						Name:                       app.Name,
+						RedirectUris:               app.OIDCConfig.RedirectURIs,
+						ResponseTypes:              responseTypes,
+						GrantTypes:                 grantTypes,
+						AppType:                    app_pb.OIDCAppType(app.OIDCConfig.AppType),
+						AuthMethodType:             app_pb.OIDCAuthMethodType(app.OIDCConfig.AuthMethodType),
+						PostLogoutRedirectUris:     app.OIDCConfig.PostLogoutRedirectURIs,
+						Version:                    app_pb.OIDCVersion(app.OIDCConfig.Version),
+						DevMode:                    app.OIDCConfig.IsDevMode,
+						AccessTokenType:            app_pb.OIDCTokenType(app.OIDCConfig.AccessTokenType),
+						AccessTokenRoleAssertion:   app.OIDCConfig.AssertAccessTokenRole,
+						IdTokenRoleAssertion:       app.OIDCConfig.AssertIDTokenRole,
+						IdTokenUserinfoAssertion:   app.OIDCConfig.AssertIDTokenUserinfo,
+						ClockSkew:                  durationpb.New(app.OIDCConfig.ClockSkew),
+						AdditionalOrigins:          app.OIDCConfig.AdditionalOrigins,
+						BackChannelLogoutUri:       app.OIDCConfig.BackChannelLogoutURI,
+						FrontChannelLogoutUri:      app.OIDCConfig.FrontChannelLogoutURI,
+						RequirePushedAuthRequests:  app.OIDCConfig.RequirePushedAuthRequests,
+						RequireSignedRequestObject: app.OIDCConfig.RequireSignedRequestObject,
 					},
 				})
 			}
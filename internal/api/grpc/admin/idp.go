@@ -63,6 +63,51 @@ func (s *Server) AddJWTIDP(ctx context.Context, req *admin_pb.AddJWTIDPRequest)
 	}, nil
 }
 
+func (s *Server) AddSAMLIDP(ctx context.Context, req *admin_pb.AddSAMLIDPRequest) (*admin_pb.AddSAMLIDPResponse, error) {
+	config, err := s.command.AddDefaultIDPConfig(ctx, addSAMLIDPRequestToDomain(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.AddSAMLIDPResponse{
+		IdpId: config.IDPConfigID,
+		Details: object_pb.AddToDetailsPb(
+			config.Sequence,
+			config.ChangeDate,
+			config.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) AddLDAPIDP(ctx context.Context, req *admin_pb.AddLDAPIDPRequest) (*admin_pb.AddLDAPIDPResponse, error) {
+	config, err := s.command.AddDefaultIDPConfig(ctx, addLDAPIDPRequestToDomain(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.AddLDAPIDPResponse{
+		IdpId: config.IDPConfigID,
+		Details: object_pb.AddToDetailsPb(
+			config.Sequence,
+			config.ChangeDate,
+			config.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) AddOAuth2IDP(ctx context.Context, req *admin_pb.AddOAuth2IDPRequest) (*admin_pb.AddOAuth2IDPResponse, error) {
+	config, err := s.command.AddDefaultIDPConfig(ctx, addOAuth2IDPRequestToDomain(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.AddOAuth2IDPResponse{
+		IdpId: config.IDPConfigID,
+		Details: object_pb.AddToDetailsPb(
+			config.Sequence,
+			config.ChangeDate,
+			config.ResourceOwner,
+		),
+	}, nil
+}
+
 func (s *Server) UpdateIDP(ctx context.Context, req *admin_pb.UpdateIDPRequest) (*admin_pb.UpdateIDPResponse, error) {
 	config, err := s.command.ChangeDefaultIDPConfig(ctx, updateIDPToDomain(req))
 	if err != nil {
@@ -150,3 +195,45 @@ func (s *Server) UpdateIDPJWTConfig(ctx context.Context, req *admin_pb.UpdateIDP
 		),
 	}, nil
 }
+
+func (s *Server) UpdateIDPSAMLConfig(ctx context.Context, req *admin_pb.UpdateIDPSAMLConfigRequest) (*admin_pb.UpdateIDPSAMLConfigResponse, error) {
+	config, err := s.command.ChangeDefaultIDPSAMLConfig(ctx, updateSAMLConfigToDomain(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateIDPSAMLConfigResponse{
+		Details: object_pb.ChangeToDetailsPb(
+			config.Sequence,
+			config.ChangeDate,
+			config.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) UpdateIDPLDAPConfig(ctx context.Context, req *admin_pb.UpdateIDPLDAPConfigRequest) (*admin_pb.UpdateIDPLDAPConfigResponse, error) {
+	config, err := s.command.ChangeDefaultIDPLDAPConfig(ctx, updateLDAPConfigToDomain(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateIDPLDAPConfigResponse{
+		Details: object_pb.ChangeToDetailsPb(
+			config.Sequence,
+			config.ChangeDate,
+			config.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) UpdateIDPOAuth2Config(ctx context.Context, req *admin_pb.UpdateIDPOAuth2ConfigRequest) (*admin_pb.UpdateIDPOAuth2ConfigResponse, error) {
+	config, err := s.command.ChangeDefaultIDPOAuth2Config(ctx, updateOAuth2ConfigToDomain(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateIDPOAuth2ConfigResponse{
+		Details: object_pb.ChangeToDetailsPb(
+			config.Sequence,
+			config.ChangeDate,
+			config.ResourceOwner,
+		),
+	}, nil
+}
@@ -50,6 +50,68 @@ func addJWTIDPRequestToDomainJWTIDPConfig(req *admin_pb.AddJWTIDPRequest) *domai
 	}
 }
 
+func addSAMLIDPRequestToDomain(req *admin_pb.AddSAMLIDPRequest) *domain.IDPConfig {
+	return &domain.IDPConfig{
+		Name:         req.Name,
+		SAMLConfig:   addSAMLIDPRequestToDomainSAMLIDPConfig(req),
+		StylingType:  idp_grpc.IDPStylingTypeToDomain(req.StylingType),
+		Type:         domain.IDPConfigTypeSAML,
+		AutoRegister: req.AutoRegister,
+	}
+}
+
+func addSAMLIDPRequestToDomainSAMLIDPConfig(req *admin_pb.AddSAMLIDPRequest) *domain.SAMLIDPConfig {
+	return &domain.SAMLIDPConfig{
+		Metadata:          req.Metadata,
+		MetadataURL:       req.MetadataUrl,
+		WithSignedRequest: req.WithSignedRequest,
+	}
+}
+
+func addLDAPIDPRequestToDomain(req *admin_pb.AddLDAPIDPRequest) *domain.IDPConfig {
+	return &domain.IDPConfig{
+		Name:         req.Name,
+		LDAPConfig:   addLDAPIDPRequestToDomainLDAPIDPConfig(req),
+		StylingType:  idp_grpc.IDPStylingTypeToDomain(req.StylingType),
+		Type:         domain.IDPConfigTypeLDAP,
+		AutoRegister: req.AutoRegister,
+	}
+}
+
+func addLDAPIDPRequestToDomainLDAPIDPConfig(req *admin_pb.AddLDAPIDPRequest) *domain.LDAPIDPConfig {
+	return &domain.LDAPIDPConfig{
+		URL:                req.Url,
+		StartTLS:           req.StartTls,
+		BaseDN:             req.BaseDn,
+		BindDN:             req.BindDn,
+		BindPasswordString: req.BindPassword,
+		UserFilter:         req.UserFilter,
+		Attributes:         idp_grpc.LDAPAttributesToDomain(req.Attributes),
+	}
+}
+
+func addOAuth2IDPRequestToDomain(req *admin_pb.AddOAuth2IDPRequest) *domain.IDPConfig {
+	return &domain.IDPConfig{
+		Name:         req.Name,
+		OAuth2Config: addOAuth2IDPRequestToDomainOAuth2IDPConfig(req),
+		StylingType:  idp_grpc.IDPStylingTypeToDomain(req.StylingType),
+		Type:         domain.IDPConfigTypeOAuth2,
+		AutoRegister: req.AutoRegister,
+	}
+}
+
+func addOAuth2IDPRequestToDomainOAuth2IDPConfig(req *admin_pb.AddOAuth2IDPRequest) *domain.OAuth2IDPConfig {
+	return &domain.OAuth2IDPConfig{
+		ClientID:              req.ClientId,
+		ClientSecretString:    req.ClientSecret,
+		AuthorizationEndpoint: req.AuthorizationEndpoint,
+		TokenEndpoint:         req.TokenEndpoint,
+		UserEndpoint:          req.UserEndpoint,
+		Scopes:                req.Scopes,
+		Mapping:               idp_grpc.OAuth2UserMappingToDomain(req.Mapping),
+	}
+}
+
 func updateIDPToDomain(req *admin_pb.UpdateIDPRequest) *domain.IDPConfig {
 	return &domain.IDPConfig{
 		IDPConfigID:  req.IdpId,
@@ -81,6 +143,41 @@ func updateJWTConfigToDomain(req *admin_pb.UpdateIDPJWTConfigRequest) *domain.JW
 	}
 }
 
+func updateSAMLConfigToDomain(req *admin_pb.UpdateIDPSAMLConfigRequest) *domain.SAMLIDPConfig {
+	return &domain.SAMLIDPConfig{
+		IDPConfigID:       req.IdpId,
+		Metadata:          req.Metadata,
+		MetadataURL:       req.MetadataUrl,
+		WithSignedRequest: req.WithSignedRequest,
+	}
+}
+
+func updateLDAPConfigToDomain(req *admin_pb.UpdateIDPLDAPConfigRequest) *domain.LDAPIDPConfig {
+	return &domain.LDAPIDPConfig{
+		IDPConfigID:        req.IdpId,
+		URL:                req.Url,
+		StartTLS:           req.StartTls,
+		BaseDN:             req.BaseDn,
+		BindDN:             req.BindDn,
+		BindPasswordString: req.BindPassword,
+		UserFilter:         req.UserFilter,
+		Attributes:         idp_grpc.LDAPAttributesToDomain(req.Attributes),
+	}
+}
+
+func updateOAuth2ConfigToDomain(req *admin_pb.UpdateIDPOAuth2ConfigRequest) *domain.OAuth2IDPConfig {
+	return &domain.OAuth2IDPConfig{
+		IDPConfigID:           req.IdpId,
+		ClientID:              req.ClientId,
+		ClientSecretString:    req.ClientSecret,
+		AuthorizationEndpoint: req.AuthorizationEndpoint,
+		TokenEndpoint:         req.TokenEndpoint,
+		UserEndpoint:          req.UserEndpoint,
+		Scopes:                req.Scopes,
+		Mapping:               idp_grpc.OAuth2UserMappingToDomain(req.Mapping),
+	}
+}
+
 func listIDPsToModel(instanceID string, req *admin_pb.ListIDPsRequest) (*query.IDPSearchQueries, error) {
 	offset, limit, asc := object.ListQueryToModel(req.Query)
 	queries, err := idpQueriesToModel(req.Queries)
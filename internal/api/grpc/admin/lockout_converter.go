@@ -8,5 +8,10 @@ import (
 func UpdateLockoutPolicyToDomain(p *admin.UpdateLockoutPolicyRequest) *domain.LockoutPolicy {
 	return &domain.LockoutPolicy{
 		MaxPasswordAttempts: uint64(p.MaxPasswordAttempts),
+		MaxOTPAttempts:      uint64(p.MaxOtpAttempts),
+		MaxU2FAttempts:      uint64(p.MaxU2FAttempts),
+		AutoUnlockAfter:     p.AutoUnlockAfter.AsDuration(),
+		AttemptDelay:        p.AttemptDelay.AsDuration(),
+		MaxAttemptDelay:     p.MaxAttemptDelay.AsDuration(),
 	}
 }
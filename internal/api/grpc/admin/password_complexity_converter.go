@@ -1,6 +1,7 @@
 package admin
 
 import (
+	policy_grpc "github.com/dennigogo/zitadel/internal/api/grpc/policy"
 	"github.com/dennigogo/zitadel/internal/domain"
 	admin_pb "github.com/dennigogo/zitadel/pkg/grpc/admin"
 )
@@ -12,5 +13,7 @@ func UpdatePasswordComplexityPolicyToDomain(req *admin_pb.UpdatePasswordComplexi
 		HasUppercase: req.HasUppercase,
 		HasNumber:    req.HasNumber,
 		HasSymbol:    req.HasSymbol,
+
+		BreachedPasswordCheck: policy_grpc.BreachedPasswordCheckToDomain(req.BreachedPasswordCheck),
 	}
 }
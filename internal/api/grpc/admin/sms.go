@@ -65,6 +65,68 @@ func (s *Server) UpdateSMSProviderTwilioToken(ctx context.Context, req *admin_pb
 	}, nil
 }
 
+func (s *Server) AddSMSProviderHTTP(ctx context.Context, req *admin_pb.AddSMSProviderHTTPRequest) (*admin_pb.AddSMSProviderHTTPResponse, error) {
+	id, result, err := s.command.AddSMSConfigHTTP(ctx, authz.GetInstance(ctx).InstanceID(), AddSMSConfigHTTPToConfig(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.AddSMSProviderHTTPResponse{
+		Details: object.DomainToAddDetailsPb(result),
+		Id:      id,
+	}, nil
+}
+
+func (s *Server) UpdateSMSProviderHTTP(ctx context.Context, req *admin_pb.UpdateSMSProviderHTTPRequest) (*admin_pb.UpdateSMSProviderHTTPResponse, error) {
+	result, err := s.command.ChangeSMSConfigHTTP(ctx, authz.GetInstance(ctx).InstanceID(), req.Id, UpdateSMSConfigHTTPToConfig(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateSMSProviderHTTPResponse{
+		Details: object.DomainToChangeDetailsPb(result),
+	}, nil
+}
+
+func (s *Server) UpdateSMSProviderHTTPAuthHeader(ctx context.Context, req *admin_pb.UpdateSMSProviderHTTPAuthHeaderRequest) (*admin_pb.UpdateSMSProviderHTTPAuthHeaderResponse, error) {
+	result, err := s.command.ChangeSMSConfigHTTPAuthHeader(ctx, authz.GetInstance(ctx).InstanceID(), req.Id, req.AuthHeaderValue)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateSMSProviderHTTPAuthHeaderResponse{
+		Details: object.DomainToChangeDetailsPb(result),
+	}, nil
+}
+
+func (s *Server) AddSMSProviderSMPP(ctx context.Context, req *admin_pb.AddSMSProviderSMPPRequest) (*admin_pb.AddSMSProviderSMPPResponse, error) {
+	id, result, err := s.command.AddSMSConfigSMPP(ctx, authz.GetInstance(ctx).InstanceID(), AddSMSConfigSMPPToConfig(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.AddSMSProviderSMPPResponse{
+		Details: object.DomainToAddDetailsPb(result),
+		Id:      id,
+	}, nil
+}
+
+func (s *Server) UpdateSMSProviderSMPP(ctx context.Context, req *admin_pb.UpdateSMSProviderSMPPRequest) (*admin_pb.UpdateSMSProviderSMPPResponse, error) {
+	result, err := s.command.ChangeSMSConfigSMPP(ctx, authz.GetInstance(ctx).InstanceID(), req.Id, UpdateSMSConfigSMPPToConfig(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateSMSProviderSMPPResponse{
+		Details: object.DomainToChangeDetailsPb(result),
+	}, nil
+}
+
+func (s *Server) UpdateSMSProviderSMPPPassword(ctx context.Context, req *admin_pb.UpdateSMSProviderSMPPPasswordRequest) (*admin_pb.UpdateSMSProviderSMPPPasswordResponse, error) {
+	result, err := s.command.ChangeSMSConfigSMPPPassword(ctx, authz.GetInstance(ctx).InstanceID(), req.Id, req.Password)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateSMSProviderSMPPPasswordResponse{
+		Details: object.DomainToChangeDetailsPb(result),
+	}, nil
+}
+
 func (s *Server) ActivateSMSProvider(ctx context.Context, req *admin_pb.ActivateSMSProviderRequest) (*admin_pb.ActivateSMSProviderResponse, error) {
 	result, err := s.command.ActivateSMSConfig(ctx, authz.GetInstance(ctx).InstanceID(), req.Id)
 	if err != nil {
@@ -3,6 +3,8 @@ package admin
 import (
 	"github.com/dennigogo/zitadel/internal/api/grpc/object"
 	"github.com/dennigogo/zitadel/internal/domain"
+	sms_http "github.com/dennigogo/zitadel/internal/notification/channels/http"
+	"github.com/dennigogo/zitadel/internal/notification/channels/smpp"
 	"github.com/dennigogo/zitadel/internal/notification/channels/twilio"
 	"github.com/dennigogo/zitadel/internal/query"
 	admin_pb "github.com/dennigogo/zitadel/pkg/grpc/admin"
@@ -41,6 +43,12 @@ func SMSConfigToPb(config *query.SMSConfig) settings_pb.SMSConfig {
 	if config.TwilioConfig != nil {
 		return TwilioConfigToPb(config.TwilioConfig)
 	}
+	if config.HTTPConfig != nil {
+		return HTTPConfigToPb(config.HTTPConfig)
+	}
+	if config.SMPPConfig != nil {
+		return SMPPConfigToPb(config.SMPPConfig)
+	}
 	return nil
 }
 
@@ -53,6 +61,33 @@ func TwilioConfigToPb(twilio *query.Twilio) *settings_pb.SMSProvider_Twilio {
 	}
 }
 
+func HTTPConfigToPb(http *query.HTTP) *settings_pb.SMSProvider_Http {
+	return &settings_pb.SMSProvider_Http{
+		Http: &settings_pb.HTTPConfig{
+			Endpoint:           http.Endpoint,
+			Method:             http.Method,
+			ContentType:        http.ContentType,
+			BodyTemplate:       http.BodyTemplate,
+			AuthHeaderName:     http.AuthHeaderName,
+			SuccessStatusCodes: http.SuccessStatusCodes,
+			SenderNumber:       http.SenderNumber,
+		},
+	}
+}
+
+func SMPPConfigToPb(smpp *query.SMPP) *settings_pb.SMSProvider_Smpp {
+	return &settings_pb.SMSProvider_Smpp{
+		Smpp: &settings_pb.SMPPConfig{
+			Host:         smpp.Host,
+			Port:         uint32(smpp.Port),
+			Tls:          smpp.TLS,
+			SystemId:     smpp.SystemID,
+			SystemType:   smpp.SystemType,
+			SenderNumber: smpp.SenderNumber,
+		},
+	}
+}
+
 func smsStateToPb(state domain.SMSConfigState) settings_pb.SMSProviderConfigState {
 	switch state {
 	case domain.SMSConfigStateInactive:
@@ -78,3 +113,51 @@ func UpdateSMSConfigTwilioToConfig(req *admin_pb.UpdateSMSProviderTwilioRequest)
 		SenderNumber: req.SenderNumber,
 	}
 }
+
+func AddSMSConfigHTTPToConfig(req *admin_pb.AddSMSProviderHTTPRequest) *sms_http.HTTPConfig {
+	return &sms_http.HTTPConfig{
+		Endpoint:           req.Endpoint,
+		Method:             req.Method,
+		ContentType:        req.ContentType,
+		BodyTemplate:       req.BodyTemplate,
+		AuthHeaderName:     req.AuthHeaderName,
+		AuthHeaderValue:    req.AuthHeaderValue,
+		SuccessStatusCodes: req.SuccessStatusCodes,
+		SenderNumber:       req.SenderNumber,
+	}
+}
+
+func UpdateSMSConfigHTTPToConfig(req *admin_pb.UpdateSMSProviderHTTPRequest) *sms_http.HTTPConfig {
+	return &sms_http.HTTPConfig{
+		Endpoint:           req.Endpoint,
+		Method:             req.Method,
+		ContentType:        req.ContentType,
+		BodyTemplate:       req.BodyTemplate,
+		AuthHeaderName:     req.AuthHeaderName,
+		SuccessStatusCodes: req.SuccessStatusCodes,
+		SenderNumber:       req.SenderNumber,
+	}
+}
+
+func AddSMSConfigSMPPToConfig(req *admin_pb.AddSMSProviderSMPPRequest) *smpp.SMPPConfig {
+	return &smpp.SMPPConfig{
+		Host:         req.Host,
+		Port:         uint16(req.Port),
+		TLS:          req.Tls,
+		SystemID:     req.SystemId,
+		Password:     req.Password,
+		SystemType:   req.SystemType,
+		SenderNumber: req.SenderNumber,
+	}
+}
+
+func UpdateSMSConfigSMPPToConfig(req *admin_pb.UpdateSMSProviderSMPPRequest) *smpp.SMPPConfig {
+	return &smpp.SMPPConfig{
+		Host:         req.Host,
+		Port:         uint16(req.Port),
+		TLS:          req.Tls,
+		SystemID:     req.SystemId,
+		SystemType:   req.SystemType,
+		SenderNumber: req.SenderNumber,
+	}
+}
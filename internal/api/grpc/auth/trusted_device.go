@@ -0,0 +1,32 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/dennigogo/zitadel/internal/api/authz"
+	"github.com/dennigogo/zitadel/internal/api/grpc/object"
+	user_grpc "github.com/dennigogo/zitadel/internal/api/grpc/user"
+	auth_pb "github.com/dennigogo/zitadel/pkg/grpc/auth"
+)
+
+func (s *Server) ListMyTrustedDevices(ctx context.Context, req *auth_pb.ListMyTrustedDevicesRequest) (*auth_pb.ListMyTrustedDevicesResponse, error) {
+	res, err := s.query.SearchUserTrustedDevices(ctx, true, authz.GetCtxData(ctx).UserID, ListMyTrustedDevicesToQuery(req))
+	if err != nil {
+		return nil, err
+	}
+	return &auth_pb.ListMyTrustedDevicesResponse{
+		Result:  user_grpc.TrustedDevicesToPb(res.Devices),
+		Details: object.ToListDetails(res.Count, res.Sequence, res.Timestamp),
+	}, nil
+}
+
+func (s *Server) RemoveMyTrustedDevice(ctx context.Context, req *auth_pb.RemoveMyTrustedDeviceRequest) (*auth_pb.RemoveMyTrustedDeviceResponse, error) {
+	ctxData := authz.GetCtxData(ctx)
+	details, err := s.command.HumanRevokeMFADevice(ctx, ctxData.UserID, ctxData.ResourceOwner, req.UserAgentId)
+	if err != nil {
+		return nil, err
+	}
+	return &auth_pb.RemoveMyTrustedDeviceResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
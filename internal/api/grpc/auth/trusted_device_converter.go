@@ -0,0 +1,18 @@
+package auth
+
+import (
+	"github.com/dennigogo/zitadel/internal/api/grpc/object"
+	"github.com/dennigogo/zitadel/internal/query"
+	auth_pb "github.com/dennigogo/zitadel/pkg/grpc/auth"
+)
+
+func ListMyTrustedDevicesToQuery(req *auth_pb.ListMyTrustedDevicesRequest) *query.UserTrustedDeviceSearchQueries {
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	return &query.UserTrustedDeviceSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+	}
+}
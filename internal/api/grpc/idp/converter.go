@@ -88,9 +88,13 @@ func IDPTypeToPb(idpType domain.IDPConfigType) idp_pb.IDPType {
 	case domain.IDPConfigTypeOIDC:
 		return idp_pb.IDPType_IDP_TYPE_OIDC
 	case domain.IDPConfigTypeSAML:
-		return idp_pb.IDPType_IDP_TYPE_UNSPECIFIED
+		return idp_pb.IDPType_IDP_TYPE_SAML
 	case domain.IDPConfigTypeJWT:
 		return idp_pb.IDPType_IDP_TYPE_JWT
+	case domain.IDPConfigTypeLDAP:
+		return idp_pb.IDPType_IDP_TYPE_LDAP
+	case domain.IDPConfigTypeOAuth2:
+		return idp_pb.IDPType_IDP_TYPE_OAUTH2
 	default:
 		return idp_pb.IDPType_IDP_TYPE_UNSPECIFIED
 	}
@@ -149,14 +153,34 @@ func ModelIDPViewToConfigPb(config *query.IDP) idp_pb.IDPConfig {
 	if config.OIDCIDP != nil {
 		return &idp_pb.IDP_OidcConfig{
 			OidcConfig: &idp_pb.OIDCConfig{
-				ClientId:           config.ClientID,
+				ClientId:           config.OIDCIDP.ClientID,
 				Issuer:             config.OIDCIDP.Issuer,
-				Scopes:             config.Scopes,
+				Scopes:             config.OIDCIDP.Scopes,
 				DisplayNameMapping: ModelMappingFieldToPb(config.DisplayNameMapping),
 				UsernameMapping:    ModelMappingFieldToPb(config.UsernameMapping),
 			},
 		}
 	}
+	if config.SAMLIDP != nil {
+		return &idp_pb.IDP_SamlConfig{
+			SamlConfig: &idp_pb.SAMLConfig{
+				Metadata:          config.SAMLIDP.Metadata,
+				MetadataUrl:       config.SAMLIDP.MetadataURL,
+				WithSignedRequest: config.SAMLIDP.WithSignedRequest,
+				Certificate:       config.SAMLIDP.Certificate,
+			},
+		}
+	}
+	if config.LDAPIDP != nil {
+		return &idp_pb.IDP_LdapConfig{
+			LdapConfig: LDAPIDPToPb(config.LDAPIDP),
+		}
+	}
+	if config.OAuth2IDP != nil {
+		return &idp_pb.IDP_Oauth2Config{
+			Oauth2Config: OAuth2IDPToPb(config.OAuth2IDP),
+		}
+	}
 	return &idp_pb.IDP_JwtConfig{
 		JwtConfig: &idp_pb.JWTConfig{
 			JwtEndpoint:  config.Endpoint,
@@ -171,14 +195,34 @@ func IDPViewToConfigPb(config *query.IDP) idp_pb.IDPConfig {
 	if config.OIDCIDP != nil {
 		return &idp_pb.IDP_OidcConfig{
 			OidcConfig: &idp_pb.OIDCConfig{
-				ClientId:           config.ClientID,
+				ClientId:           config.OIDCIDP.ClientID,
 				Issuer:             config.OIDCIDP.Issuer,
-				Scopes:             config.Scopes,
+				Scopes:             config.OIDCIDP.Scopes,
 				DisplayNameMapping: MappingFieldToPb(config.DisplayNameMapping),
 				UsernameMapping:    MappingFieldToPb(config.UsernameMapping),
 			},
 		}
 	}
+	if config.SAMLIDP != nil {
+		return &idp_pb.IDP_SamlConfig{
+			SamlConfig: &idp_pb.SAMLConfig{
+				Metadata:          config.SAMLIDP.Metadata,
+				MetadataUrl:       config.SAMLIDP.MetadataURL,
+				WithSignedRequest: config.SAMLIDP.WithSignedRequest,
+				Certificate:       config.SAMLIDP.Certificate,
+			},
+		}
+	}
+	if config.LDAPIDP != nil {
+		return &idp_pb.IDP_LdapConfig{
+			LdapConfig: LDAPIDPToPb(config.LDAPIDP),
+		}
+	}
+	if config.OAuth2IDP != nil {
+		return &idp_pb.IDP_Oauth2Config{
+			Oauth2Config: OAuth2IDPToPb(config.OAuth2IDP),
+		}
+	}
 	return &idp_pb.IDP_JwtConfig{
 		JwtConfig: &idp_pb.JWTConfig{
 			JwtEndpoint:  config.JWTIDP.Endpoint,
@@ -296,3 +340,71 @@ func ownerTypeToPB(typ domain.IdentityProviderType) idp_pb.IDPOwnerType {
 		return idp_pb.IDPOwnerType_IDP_OWNER_TYPE_UNSPECIFIED
 	}
 }
+
+func LDAPIDPToPb(config *query.LDAPIDP) *idp_pb.LDAPConfig {
+	return &idp_pb.LDAPConfig{
+		Url:        config.URL,
+		StartTls:   config.StartTLS,
+		BaseDn:     config.BaseDN,
+		BindDn:     config.BindDN,
+		UserFilter: config.UserFilter,
+		Attributes: &idp_pb.LDAPAttributes{
+			IdAttribute:                config.IDAttribute,
+			PreferredUsernameAttribute: config.PreferredUsernameAttribute,
+			FirstNameAttribute:         config.FirstNameAttribute,
+			LastNameAttribute:          config.LastNameAttribute,
+			DisplayNameAttribute:       config.DisplayNameAttribute,
+			EmailAttribute:             config.EmailAttribute,
+			PhoneAttribute:             config.PhoneAttribute,
+		},
+	}
+}
+
+func LDAPAttributesToDomain(attributes *idp_pb.LDAPAttributes) domain.LDAPAttributes {
+	if attributes == nil {
+		return domain.LDAPAttributes{}
+	}
+	return domain.LDAPAttributes{
+		IDAttribute:                attributes.IdAttribute,
+		PreferredUsernameAttribute: attributes.PreferredUsernameAttribute,
+		FirstNameAttribute:         attributes.FirstNameAttribute,
+		LastNameAttribute:          attributes.LastNameAttribute,
+		DisplayNameAttribute:       attributes.DisplayNameAttribute,
+		EmailAttribute:             attributes.EmailAttribute,
+		PhoneAttribute:             attributes.PhoneAttribute,
+	}
+}
+
+func OAuth2IDPToPb(config *query.OAuth2IDP) *idp_pb.OAuth2Config {
+	return &idp_pb.OAuth2Config{
+		ClientId:              config.ClientID,
+		AuthorizationEndpoint: config.AuthorizationEndpoint,
+		TokenEndpoint:         config.TokenEndpoint,
+		UserEndpoint:          config.UserEndpoint,
+		Scopes:                config.Scopes,
+		Mapping: &idp_pb.OAuth2UserMapping{
+			IdPath:                config.IDPath,
+			PreferredUsernamePath: config.PreferredUsernamePath,
+			FirstNamePath:         config.FirstNamePath,
+			LastNamePath:          config.LastNamePath,
+			DisplayNamePath:       config.DisplayNamePath,
+			EmailPath:             config.EmailPath,
+			PhonePath:             config.PhonePath,
+		},
+	}
+}
+
+func OAuth2UserMappingToDomain(mapping *idp_pb.OAuth2UserMapping) domain.OAuth2UserMapping {
+	if mapping == nil {
+		return domain.OAuth2UserMapping{}
+	}
+	return domain.OAuth2UserMapping{
+		IDPath:                mapping.IdPath,
+		PreferredUsernamePath: mapping.PreferredUsernamePath,
+		FirstNamePath:         mapping.FirstNamePath,
+		LastNamePath:          mapping.LastNamePath,
+		DisplayNamePath:       mapping.DisplayNamePath,
+		EmailPath:             mapping.EmailPath,
+		PhonePath:             mapping.PhonePath,
+	}
+}
@@ -2,7 +2,11 @@ package management
 
 import (
 	"context"
+	"time"
 
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/dennigogo/zitadel/internal/actions"
 	"github.com/dennigogo/zitadel/internal/api/authz"
 	action_grpc "github.com/dennigogo/zitadel/internal/api/grpc/action"
 	obj_grpc "github.com/dennigogo/zitadel/internal/api/grpc/object"
@@ -96,3 +100,38 @@ func (s *Server) DeleteAction(ctx context.Context, req *mgmt_pb.DeleteActionRequ
 	_, err = s.command.DeleteAction(ctx, req.Id, authz.GetCtxData(ctx).OrgID, flowTypes...)
 	return &mgmt_pb.DeleteActionResponse{}, err
 }
+
+func (s *Server) ListActionExecutions(ctx context.Context, req *mgmt_pb.ListActionExecutionsRequest) (*mgmt_pb.ListActionExecutionsResponse, error) {
+	query, err := listActionExecutionsToQuery(authz.GetCtxData(ctx).OrgID, req)
+	if err != nil {
+		return nil, err
+	}
+	executions, err := s.query.SearchActionExecutions(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListActionExecutionsResponse{
+		Details: obj_grpc.ToListDetails(executions.Count, 0, time.Now()),
+		Result:  action_grpc.ActionExecutionsToPb(executions.Executions),
+	}, nil
+}
+
+func (s *Server) TestAction(ctx context.Context, req *mgmt_pb.TestActionRequest) (*mgmt_pb.TestActionResponse, error) {
+	actionCtx, cancel := context.WithTimeout(ctx, testActionTimeout(req))
+	defer cancel()
+	result := actions.DryRun(
+		actionCtx,
+		req.GetContext().AsMap(),
+		req.GetApi().AsMap(),
+		req.Script,
+		req.Name,
+		testActionRequestToOptions(req)...,
+	)
+	return &mgmt_pb.TestActionResponse{
+		Outcome:  action_grpc.ActionExecutionOutcomeToPb(result.Execution.Outcome),
+		Error:    result.Execution.Error,
+		Duration: durationpb.New(result.Execution.Duration),
+		Logs:     action_grpc.ActionExecutionLogsToPb(result.Execution.Logs),
+		ApiCalls: action_grpc.ActionExecutionAPICallsToPb(result.APICalls),
+	}, nil
+}
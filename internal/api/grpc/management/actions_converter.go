@@ -1,6 +1,9 @@
 package management
 
 import (
+	"time"
+
+	"github.com/dennigogo/zitadel/internal/actions"
 	action_grpc "github.com/dennigogo/zitadel/internal/api/grpc/action"
 	"github.com/dennigogo/zitadel/internal/api/grpc/object"
 	"github.com/dennigogo/zitadel/internal/domain"
@@ -10,6 +13,8 @@ import (
 	mgmt_pb "github.com/dennigogo/zitadel/pkg/grpc/management"
 )
 
+const maxTestActionTimeout = 20 * time.Second
+
 func CreateActionRequestToDomain(req *mgmt_pb.CreateActionRequest) *domain.Action {
 	return &domain.Action{
 		Name:          req.Name,
@@ -65,3 +70,57 @@ func ActionQueryToQuery(query interface{}) (query.SearchQuery, error) {
 	}
 	return nil, errors.ThrowInvalidArgument(nil, "MGMT-dsg3z", "Errors.Query.InvalidRequest")
 }
+
+func listActionExecutionsToQuery(orgID string, req *mgmt_pb.ListActionExecutionsRequest) (_ *query.ActionExecutionSearchQueries, err error) {
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	queries := make([]query.SearchQuery, len(req.Queries)+1)
+	queries[0], err = query.NewActionExecutionResourceOwnerSearchQuery(orgID)
+	if err != nil {
+		return nil, err
+	}
+	for i, executionQuery := range req.Queries {
+		queries[i+1], err = ActionExecutionQueryToQuery(executionQuery.Query)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &query.ActionExecutionSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset:        offset,
+			Limit:         limit,
+			Asc:           asc,
+			SortingColumn: query.ActionExecutionColumnCreationDate,
+		},
+		Queries: queries,
+	}, nil
+}
+
+func ActionExecutionQueryToQuery(query interface{}) (query.SearchQuery, error) {
+	switch q := query.(type) {
+	case *mgmt_pb.ActionExecutionQuery_ActionIdQuery:
+		return action_grpc.ActionExecutionActionIDQuery(q.ActionIdQuery)
+	case *mgmt_pb.ActionExecutionQuery_FlowTypeQuery:
+		return action_grpc.ActionExecutionFlowTypeQuery(q.FlowTypeQuery)
+	case *mgmt_pb.ActionExecutionQuery_TriggerTypeQuery:
+		return action_grpc.ActionExecutionTriggerTypeQuery(q.TriggerTypeQuery)
+	case *mgmt_pb.ActionExecutionQuery_OutcomeQuery:
+		return action_grpc.ActionExecutionOutcomeQuery(q.OutcomeQuery)
+	}
+	return nil, errors.ThrowInvalidArgument(nil, "MGMT-Ae8q3", "Errors.Query.InvalidRequest")
+}
+
+func testActionRequestToOptions(req *mgmt_pb.TestActionRequest) []actions.Option {
+	if req.AllowedToFail {
+		return []actions.Option{actions.WithAllowedToFail()}
+	}
+	return nil
+}
+
+// testActionTimeout behaves like the timeout of stored actions:
+// the maximum is used if no timeout is set
+func testActionTimeout(req *mgmt_pb.TestActionRequest) time.Duration {
+	if timeout := req.Timeout.AsDuration(); timeout > 0 && timeout < maxTestActionTimeout {
+		return timeout
+	}
+	return maxTestActionTimeout
+}
@@ -63,6 +63,51 @@ func (s *Server) AddOrgJWTIDP(ctx context.Context, req *mgmt_pb.AddOrgJWTIDPRequ
 	}, nil
 }
 
+func (s *Server) AddOrgSAMLIDP(ctx context.Context, req *mgmt_pb.AddOrgSAMLIDPRequest) (*mgmt_pb.AddOrgSAMLIDPResponse, error) {
+	config, err := s.command.AddIDPConfig(ctx, AddSAMLIDPRequestToDomain(req), authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddOrgSAMLIDPResponse{
+		IdpId: config.IDPConfigID,
+		Details: object_pb.AddToDetailsPb(
+			config.Sequence,
+			config.ChangeDate,
+			config.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) AddOrgLDAPIDP(ctx context.Context, req *mgmt_pb.AddOrgLDAPIDPRequest) (*mgmt_pb.AddOrgLDAPIDPResponse, error) {
+	config, err := s.command.AddIDPConfig(ctx, AddLDAPIDPRequestToDomain(req), authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddOrgLDAPIDPResponse{
+		IdpId: config.IDPConfigID,
+		Details: object_pb.AddToDetailsPb(
+			config.Sequence,
+			config.ChangeDate,
+			config.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) AddOrgOAuth2IDP(ctx context.Context, req *mgmt_pb.AddOrgOAuth2IDPRequest) (*mgmt_pb.AddOrgOAuth2IDPResponse, error) {
+	config, err := s.command.AddIDPConfig(ctx, AddOAuth2IDPRequestToDomain(req), authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddOrgOAuth2IDPResponse{
+		IdpId: config.IDPConfigID,
+		Details: object_pb.AddToDetailsPb(
+			config.Sequence,
+			config.ChangeDate,
+			config.ResourceOwner,
+		),
+	}, nil
+}
+
 func (s *Server) DeactivateOrgIDP(ctx context.Context, req *mgmt_pb.DeactivateOrgIDPRequest) (*mgmt_pb.DeactivateOrgIDPResponse, error) {
 	objectDetails, err := s.command.DeactivateIDPConfig(ctx, req.IdpId, authz.GetCtxData(ctx).OrgID)
 	if err != nil {
@@ -142,3 +187,45 @@ func (s *Server) UpdateOrgIDPJWTConfig(ctx context.Context, req *mgmt_pb.UpdateO
 		),
 	}, nil
 }
+
+func (s *Server) UpdateOrgIDPSAMLConfig(ctx context.Context, req *mgmt_pb.UpdateOrgIDPSAMLConfigRequest) (*mgmt_pb.UpdateOrgIDPSAMLConfigResponse, error) {
+	config, err := s.command.ChangeIDPSAMLConfig(ctx, updateSAMLConfigToDomain(req), authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateOrgIDPSAMLConfigResponse{
+		Details: object_pb.ChangeToDetailsPb(
+			config.Sequence,
+			config.ChangeDate,
+			config.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) UpdateOrgIDPLDAPConfig(ctx context.Context, req *mgmt_pb.UpdateOrgIDPLDAPConfigRequest) (*mgmt_pb.UpdateOrgIDPLDAPConfigResponse, error) {
+	config, err := s.command.ChangeIDPLDAPConfig(ctx, updateLDAPConfigToDomain(req), authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateOrgIDPLDAPConfigResponse{
+		Details: object_pb.ChangeToDetailsPb(
+			config.Sequence,
+			config.ChangeDate,
+			config.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) UpdateOrgIDPOAuth2Config(ctx context.Context, req *mgmt_pb.UpdateOrgIDPOAuth2ConfigRequest) (*mgmt_pb.UpdateOrgIDPOAuth2ConfigResponse, error) {
+	config, err := s.command.ChangeIDPOAuth2Config(ctx, updateOAuth2ConfigToDomain(req), authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateOrgIDPOAuth2ConfigResponse{
+		Details: object_pb.ChangeToDetailsPb(
+			config.Sequence,
+			config.ChangeDate,
+			config.ResourceOwner,
+		),
+	}, nil
+}
@@ -54,6 +54,68 @@ func addJWTIDPRequestToDomainJWTIDPConfig(req *mgmt_pb.AddOrgJWTIDPRequest) *dom
 	}
 }
 
+func AddSAMLIDPRequestToDomain(req *mgmt_pb.AddOrgSAMLIDPRequest) *domain.IDPConfig {
+	return &domain.IDPConfig{
+		Name:         req.Name,
+		SAMLConfig:   addSAMLIDPRequestToDomainSAMLIDPConfig(req),
+		StylingType:  idp_grpc.IDPStylingTypeToDomain(req.StylingType),
+		Type:         domain.IDPConfigTypeSAML,
+		AutoRegister: req.AutoRegister,
+	}
+}
+
+func addSAMLIDPRequestToDomainSAMLIDPConfig(req *mgmt_pb.AddOrgSAMLIDPRequest) *domain.SAMLIDPConfig {
+	return &domain.SAMLIDPConfig{
+		Metadata:          req.Metadata,
+		MetadataURL:       req.MetadataUrl,
+		WithSignedRequest: req.WithSignedRequest,
+	}
+}
+
+func AddLDAPIDPRequestToDomain(req *mgmt_pb.AddOrgLDAPIDPRequest) *domain.IDPConfig {
+	return &domain.IDPConfig{
+		Name:         req.Name,
+		LDAPConfig:   addLDAPIDPRequestToDomainLDAPIDPConfig(req),
+		StylingType:  idp_grpc.IDPStylingTypeToDomain(req.StylingType),
+		Type:         domain.IDPConfigTypeLDAP,
+		AutoRegister: req.AutoRegister,
+	}
+}
+
+func addLDAPIDPRequestToDomainLDAPIDPConfig(req *mgmt_pb.AddOrgLDAPIDPRequest) *domain.LDAPIDPConfig {
+	return &domain.LDAPIDPConfig{
+		URL:                req.Url,
+		StartTLS:           req.StartTls,
+		BaseDN:             req.BaseDn,
+		BindDN:             req.BindDn,
+		BindPasswordString: req.BindPassword,
+		UserFilter:         req.UserFilter,
+		Attributes:         idp_grpc.LDAPAttributesToDomain(req.Attributes),
+	}
+}
+
+func AddOAuth2IDPRequestToDomain(req *mgmt_pb.AddOrgOAuth2IDPRequest) *domain.IDPConfig {
+	return &domain.IDPConfig{
+		Name:         req.Name,
+		OAuth2Config: addOAuth2IDPRequestToDomainOAuth2IDPConfig(req),
+		StylingType:  idp_grpc.IDPStylingTypeToDomain(req.StylingType),
+		Type:         domain.IDPConfigTypeOAuth2,
+		AutoRegister: req.AutoRegister,
+	}
+}
+
+func addOAuth2IDPRequestToDomainOAuth2IDPConfig(req *mgmt_pb.AddOrgOAuth2IDPRequest) *domain.OAuth2IDPConfig {
+	return &domain.OAuth2IDPConfig{
+		ClientID:              req.ClientId,
+		ClientSecretString:    req.ClientSecret,
+		AuthorizationEndpoint: req.AuthorizationEndpoint,
+		TokenEndpoint:         req.TokenEndpoint,
+		UserEndpoint:          req.UserEndpoint,
+		Scopes:                req.Scopes,
+		Mapping:               idp_grpc.OAuth2UserMappingToDomain(req.Mapping),
+	}
+}
+
 func updateIDPToDomain(req *mgmt_pb.UpdateOrgIDPRequest) *domain.IDPConfig {
 	return &domain.IDPConfig{
 		IDPConfigID:  req.IdpId,
@@ -85,6 +147,41 @@ func updateJWTConfigToDomain(req *mgmt_pb.UpdateOrgIDPJWTConfigRequest) *domain.
 	}
 }
 
+func updateSAMLConfigToDomain(req *mgmt_pb.UpdateOrgIDPSAMLConfigRequest) *domain.SAMLIDPConfig {
+	return &domain.SAMLIDPConfig{
+		IDPConfigID:       req.IdpId,
+		Metadata:          req.Metadata,
+		MetadataURL:       req.MetadataUrl,
+		WithSignedRequest: req.WithSignedRequest,
+	}
+}
+
+func updateLDAPConfigToDomain(req *mgmt_pb.UpdateOrgIDPLDAPConfigRequest) *domain.LDAPIDPConfig {
+	return &domain.LDAPIDPConfig{
+		IDPConfigID:        req.IdpId,
+		URL:                req.Url,
+		StartTLS:           req.StartTls,
+		BaseDN:             req.BaseDn,
+		BindDN:             req.BindDn,
+		BindPasswordString: req.BindPassword,
+		UserFilter:         req.UserFilter,
+		Attributes:         idp_grpc.LDAPAttributesToDomain(req.Attributes),
+	}
+}
+
+func updateOAuth2ConfigToDomain(req *mgmt_pb.UpdateOrgIDPOAuth2ConfigRequest) *domain.OAuth2IDPConfig {
+	return &domain.OAuth2IDPConfig{
+		IDPConfigID:           req.IdpId,
+		ClientID:              req.ClientId,
+		ClientSecretString:    req.ClientSecret,
+		AuthorizationEndpoint: req.AuthorizationEndpoint,
+		TokenEndpoint:         req.TokenEndpoint,
+		UserEndpoint:          req.UserEndpoint,
+		Scopes:                req.Scopes,
+		Mapping:               idp_grpc.OAuth2UserMappingToDomain(req.Mapping),
+	}
+}
+
 func listIDPsToModel(ctx context.Context, req *mgmt_pb.ListOrgIDPsRequest) (queries *query.IDPSearchQueries, err error) {
 	offset, limit, asc := object.ListQueryToModel(req.Query)
 	q, err := idpQueriesToModel(req.Queries)
@@ -25,6 +25,7 @@ func AddLoginPolicyToCommand(p *mgmt_pb.AddCustomLoginPolicyRequest) *command.Ad
 		MFAInitSkipLifetime:        p.MfaInitSkipLifetime.AsDuration(),
 		SecondFactorCheckLifetime:  p.SecondFactorCheckLifetime.AsDuration(),
 		MultiFactorCheckLifetime:   p.MultiFactorCheckLifetime.AsDuration(),
+		TrustedDeviceLifetime:      p.TrustedDeviceLifetime.AsDuration(),
 		SecondFactors:              policy_grpc.SecondFactorsTypesToDomain(p.SecondFactors),
 		MultiFactors:               policy_grpc.MultiFactorsTypesToDomain(p.MultiFactors),
 		IDPProviders:               addLoginPolicyIDPsToCommand(p.Idps),
@@ -61,6 +62,7 @@ func updateLoginPolicyToCommand(p *mgmt_pb.UpdateCustomLoginPolicyRequest) *comm
 		MFAInitSkipLifetime:        p.MfaInitSkipLifetime.AsDuration(),
 		SecondFactorCheckLifetime:  p.SecondFactorCheckLifetime.AsDuration(),
 		MultiFactorCheckLifetime:   p.MultiFactorCheckLifetime.AsDuration(),
+		TrustedDeviceLifetime:      p.TrustedDeviceLifetime.AsDuration(),
 	}
 }
 
@@ -9,6 +9,7 @@ func AddPasswordAgePolicyToDomain(policy *mgmt_pb.AddCustomPasswordAgePolicyRequ
 	return &domain.PasswordAgePolicy{
 		MaxAgeDays:     uint64(policy.MaxAgeDays),
 		ExpireWarnDays: uint64(policy.ExpireWarnDays),
+		HistoryCount:   uint64(policy.HistoryCount),
 	}
 }
 
@@ -16,5 +17,6 @@ func UpdatePasswordAgePolicyToDomain(policy *mgmt_pb.UpdateCustomPasswordAgePoli
 	return &domain.PasswordAgePolicy{
 		MaxAgeDays:     uint64(policy.MaxAgeDays),
 		ExpireWarnDays: uint64(policy.ExpireWarnDays),
+		HistoryCount:   uint64(policy.HistoryCount),
 	}
 }
@@ -1,6 +1,7 @@
 package management
 
 import (
+	policy_grpc "github.com/dennigogo/zitadel/internal/api/grpc/policy"
 	"github.com/dennigogo/zitadel/internal/domain"
 	mgmt_pb "github.com/dennigogo/zitadel/pkg/grpc/management"
 )
@@ -12,6 +13,8 @@ func AddPasswordComplexityPolicyToDomain(req *mgmt_pb.AddCustomPasswordComplexit
 		HasUppercase: req.HasUppercase,
 		HasNumber:    req.HasNumber,
 		HasSymbol:    req.HasSymbol,
+
+		BreachedPasswordCheck: policy_grpc.BreachedPasswordCheckToDomain(req.BreachedPasswordCheck),
 	}
 }
 
@@ -22,5 +25,7 @@ func UpdatePasswordComplexityPolicyToDomain(req *mgmt_pb.UpdateCustomPasswordCom
 		HasUppercase: req.HasUppercase,
 		HasNumber:    req.HasNumber,
 		HasSymbol:    req.HasSymbol,
+
+		BreachedPasswordCheck: policy_grpc.BreachedPasswordCheckToDomain(req.BreachedPasswordCheck),
 	}
 }
@@ -41,21 +41,25 @@ func AddOIDCAppRequestToDomain(req *mgmt_pb.AddOIDCAppRequest) *domain.OIDCApp {
 		ObjectRoot: models.ObjectRoot{
 			AggregateID: req.ProjectId,
 		},
-		AppName:                  req.Name,
-		OIDCVersion:              app_grpc.OIDCVersionToDomain(req.Version),
-		RedirectUris:             req.RedirectUris,
-		ResponseTypes:            app_grpc.OIDCResponseTypesToDomain(req.ResponseTypes),
-		GrantTypes:               app_grpc.OIDCGrantTypesToDomain(req.GrantTypes),
-		ApplicationType:          app_grpc.OIDCApplicationTypeToDomain(req.AppType),
-		AuthMethodType:           app_grpc.OIDCAuthMethodTypeToDomain(req.AuthMethodType),
-		PostLogoutRedirectUris:   req.PostLogoutRedirectUris,
-		DevMode:                  req.DevMode,
-		AccessTokenType:          app_grpc.OIDCTokenTypeToDomain(req.AccessTokenType),
-		AccessTokenRoleAssertion: req.AccessTokenRoleAssertion,
-		IDTokenRoleAssertion:     req.IdTokenRoleAssertion,
-		IDTokenUserinfoAssertion: req.IdTokenUserinfoAssertion,
-		ClockSkew:                req.ClockSkew.AsDuration(),
-		AdditionalOrigins:        req.AdditionalOrigins,
+		AppName:                    req.Name,
+		OIDCVersion:                app_grpc.OIDCVersionToDomain(req.Version),
+		RedirectUris:               req.RedirectUris,
+		ResponseTypes:              app_grpc.OIDCResponseTypesToDomain(req.ResponseTypes),
+		GrantTypes:                 app_grpc.OIDCGrantTypesToDomain(req.GrantTypes),
+		ApplicationType:            app_grpc.OIDCApplicationTypeToDomain(req.AppType),
+		AuthMethodType:             app_grpc.OIDCAuthMethodTypeToDomain(req.AuthMethodType),
+		PostLogoutRedirectUris:     req.PostLogoutRedirectUris,
+		DevMode:                    req.DevMode,
+		AccessTokenType:            app_grpc.OIDCTokenTypeToDomain(req.AccessTokenType),
+		AccessTokenRoleAssertion:   req.AccessTokenRoleAssertion,
+		IDTokenRoleAssertion:       req.IdTokenRoleAssertion,
+		IDTokenUserinfoAssertion:   req.IdTokenUserinfoAssertion,
+		ClockSkew:                  req.ClockSkew.AsDuration(),
+		AdditionalOrigins:          req.AdditionalOrigins,
+		BackChannelLogoutURI:       req.BackChannelLogoutUri,
+		FrontChannelLogoutURI:      req.FrontChannelLogoutUri,
+		RequirePushedAuthRequests:  req.RequirePushedAuthRequests,
+		RequireSignedRequestObject: req.RequireSignedRequestObject,
 	}
 }
 
@@ -92,20 +96,24 @@ func UpdateOIDCAppConfigRequestToDomain(app *mgmt_pb.UpdateOIDCAppConfigRequest)
 		ObjectRoot: models.ObjectRoot{
 			AggregateID: app.ProjectId,
 		},
-		AppID:                    app.AppId,
-		RedirectUris:             app.RedirectUris,
-		ResponseTypes:            app_grpc.OIDCResponseTypesToDomain(app.ResponseTypes),
-		GrantTypes:               app_grpc.OIDCGrantTypesToDomain(app.GrantTypes),
-		ApplicationType:          app_grpc.OIDCApplicationTypeToDomain(app.AppType),
-		AuthMethodType:           app_grpc.OIDCAuthMethodTypeToDomain(app.AuthMethodType),
-		PostLogoutRedirectUris:   app.PostLogoutRedirectUris,
-		DevMode:                  app.DevMode,
-		AccessTokenType:          app_grpc.OIDCTokenTypeToDomain(app.AccessTokenType),
-		AccessTokenRoleAssertion: app.AccessTokenRoleAssertion,
-		IDTokenRoleAssertion:     app.IdTokenRoleAssertion,
-		IDTokenUserinfoAssertion: app.IdTokenUserinfoAssertion,
-		ClockSkew:                app.ClockSkew.AsDuration(),
-		AdditionalOrigins:        app.AdditionalOrigins,
+		AppID:                      app.AppId,
+		RedirectUris:               app.RedirectUris,
+		ResponseTypes:              app_grpc.OIDCResponseTypesToDomain(app.ResponseTypes),
+		GrantTypes:                 app_grpc.OIDCGrantTypesToDomain(app.GrantTypes),
+		ApplicationType:            app_grpc.OIDCApplicationTypeToDomain(app.AppType),
+		AuthMethodType:             app_grpc.OIDCAuthMethodTypeToDomain(app.AuthMethodType),
+		PostLogoutRedirectUris:     app.PostLogoutRedirectUris,
+		DevMode:                    app.DevMode,
+		AccessTokenType:            app_grpc.OIDCTokenTypeToDomain(app.AccessTokenType),
+		AccessTokenRoleAssertion:   app.AccessTokenRoleAssertion,
+		IDTokenRoleAssertion:       app.IdTokenRoleAssertion,
+		IDTokenUserinfoAssertion:   app.IdTokenUserinfoAssertion,
+		ClockSkew:                  app.ClockSkew.AsDuration(),
+		AdditionalOrigins:          app.AdditionalOrigins,
+		BackChannelLogoutURI:       app.BackChannelLogoutUri,
+		FrontChannelLogoutURI:      app.FrontChannelLogoutUri,
+		RequirePushedAuthRequests:  app.RequirePushedAuthRequests,
+		RequireSignedRequestObject: app.RequireSignedRequestObject,
 	}
 }
 
@@ -325,6 +325,16 @@ func (s *Server) UnlockUser(ctx context.Context, req *mgmt_pb.UnlockUserRequest)
 	}, nil
 }
 
+func (s *Server) TerminateUserSessions(ctx context.Context, req *mgmt_pb.TerminateUserSessionsRequest) (*mgmt_pb.TerminateUserSessionsResponse, error) {
+	objectDetails, err := s.command.TerminateHumanSessions(ctx, req.Id, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.TerminateUserSessionsResponse{
+		Details: obj_grpc.DomainToChangeDetailsPb(objectDetails),
+	}, nil
+}
+
 func (s *Server) RemoveUser(ctx context.Context, req *mgmt_pb.RemoveUserRequest) (*mgmt_pb.RemoveUserResponse, error) {
 	userGrantUserQuery, err := query.NewUserGrantUserIDSearchQuery(req.Id)
 	if err != nil {
@@ -615,6 +625,32 @@ func (s *Server) RemoveHumanAuthFactorU2F(ctx context.Context, req *mgmt_pb.Remo
 	}, nil
 }
 
+func (s *Server) ListHumanTrustedDevices(ctx context.Context, req *mgmt_pb.ListHumanTrustedDevicesRequest) (*mgmt_pb.ListHumanTrustedDevicesResponse, error) {
+	queries := ListHumanTrustedDevicesToQuery(req)
+	err := queries.AppendMyResourceOwnerQuery(authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.query.SearchUserTrustedDevices(ctx, true, req.UserId, queries)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListHumanTrustedDevicesResponse{
+		Result:  user_grpc.TrustedDevicesToPb(res.Devices),
+		Details: obj_grpc.ToListDetails(res.Count, res.Sequence, res.Timestamp),
+	}, nil
+}
+
+func (s *Server) RemoveHumanTrustedDevice(ctx context.Context, req *mgmt_pb.RemoveHumanTrustedDeviceRequest) (*mgmt_pb.RemoveHumanTrustedDeviceResponse, error) {
+	objectDetails, err := s.command.HumanRevokeMFADevice(ctx, req.UserId, authz.GetCtxData(ctx).OrgID, req.UserAgentId)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RemoveHumanTrustedDeviceResponse{
+		Details: obj_grpc.DomainToChangeDetailsPb(objectDetails),
+	}, nil
+}
+
 func (s *Server) ListHumanPasswordless(ctx context.Context, req *mgmt_pb.ListHumanPasswordlessRequest) (*mgmt_pb.ListHumanPasswordlessResponse, error) {
 	query := new(query.UserAuthMethodSearchQueries)
 	err := query.AppendUserIDQuery(req.UserId)
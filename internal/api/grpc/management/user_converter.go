@@ -149,7 +149,7 @@ func ImportHumanUserRequestToDomain(req *mgmt_pb.ImportHumanUserRequest) (human
 		human.Password.ChangeRequired = req.PasswordChangeRequired
 	}
 
-	if req.HashedPassword != nil && req.HashedPassword.Value != "" && req.HashedPassword.Algorithm != "" {
+	if req.HashedPassword != nil && req.HashedPassword.Value != "" {
 		human.HashedPassword = domain.NewHashedPassword(req.HashedPassword.Value, req.HashedPassword.Algorithm)
 	}
 
@@ -373,3 +373,14 @@ func MemberTypeToDomain(mType user_model.MemberType) domain.MemberType {
 		return domain.MemberTypeUnspecified
 	}
 }
+
+func ListHumanTrustedDevicesToQuery(req *mgmt_pb.ListHumanTrustedDevicesRequest) *query.UserTrustedDeviceSearchQueries {
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	return &query.UserTrustedDeviceSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+	}
+}
@@ -0,0 +1,100 @@
+package management
+
+import (
+	"github.com/dennigogo/zitadel/internal/api/grpc/object"
+	webhook_grpc "github.com/dennigogo/zitadel/internal/api/grpc/webhook"
+	"github.com/dennigogo/zitadel/internal/domain"
+	"github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/eventstore/v1/models"
+	"github.com/dennigogo/zitadel/internal/query"
+	mgmt_pb "github.com/dennigogo/zitadel/pkg/grpc/management"
+)
+
+func addWebhookRequestToDomain(req *mgmt_pb.AddWebhookRequest) *domain.Webhook {
+	return &domain.Webhook{
+		Name:       req.Name,
+		URL:        req.Url,
+		EventTypes: req.EventTypes,
+	}
+}
+
+func updateWebhookRequestToDomain(req *mgmt_pb.UpdateWebhookRequest) *domain.Webhook {
+	return &domain.Webhook{
+		ObjectRoot: models.ObjectRoot{
+			AggregateID: req.Id,
+		},
+		Name:       req.Name,
+		URL:        req.Url,
+		EventTypes: req.EventTypes,
+	}
+}
+
+func listWebhooksToQuery(resourceOwner string, req *mgmt_pb.ListWebhooksRequest) (_ *query.WebhookSearchQueries, err error) {
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	queries := make([]query.SearchQuery, len(req.Queries)+1)
+	queries[0], err = query.NewWebhookResourceOwnerQuery(resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	for i, webhookQuery := range req.Queries {
+		queries[i+1], err = webhookQueryToQuery(webhookQuery.Query)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &query.WebhookSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+		Queries: queries,
+	}, nil
+}
+
+func webhookQueryToQuery(q interface{}) (query.SearchQuery, error) {
+	switch q := q.(type) {
+	case *mgmt_pb.WebhookQuery_NameQuery:
+		return webhook_grpc.WebhookNameQuery(q.NameQuery)
+	case *mgmt_pb.WebhookQuery_StateQuery:
+		return webhook_grpc.WebhookStateQuery(q.StateQuery)
+	case *mgmt_pb.WebhookQuery_EventTypeQuery:
+		return webhook_grpc.WebhookEventTypeQuery(q.EventTypeQuery)
+	}
+	return nil, errors.ThrowInvalidArgument(nil, "MGMT-Wb4r8", "Errors.Query.InvalidRequest")
+}
+
+func listWebhookDeliveriesToQuery(resourceOwner string, req *mgmt_pb.ListWebhookDeliveriesRequest) (_ *query.WebhookDeliverySearchQueries, err error) {
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	queries := make([]query.SearchQuery, len(req.Queries)+2)
+	queries[0], err = query.NewWebhookDeliveryResourceOwnerSearchQuery(resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	queries[1], err = query.NewWebhookDeliveryWebhookIDSearchQuery(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	for i, deliveryQuery := range req.Queries {
+		queries[i+2], err = webhookDeliveryQueryToQuery(deliveryQuery.Query)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &query.WebhookDeliverySearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+		Queries: queries,
+	}, nil
+}
+
+func webhookDeliveryQueryToQuery(q interface{}) (query.SearchQuery, error) {
+	switch q := q.(type) {
+	case *mgmt_pb.WebhookDeliveryQuery_StateQuery:
+		return webhook_grpc.WebhookDeliveryStateQuery(q.StateQuery)
+	}
+	return nil, errors.ThrowInvalidArgument(nil, "MGMT-Wh7d2", "Errors.Query.InvalidRequest")
+}
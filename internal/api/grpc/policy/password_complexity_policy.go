@@ -2,6 +2,7 @@ package policy
 
 import (
 	"github.com/dennigogo/zitadel/internal/api/grpc/object"
+	"github.com/dennigogo/zitadel/internal/domain"
 	"github.com/dennigogo/zitadel/internal/query"
 	policy_pb "github.com/dennigogo/zitadel/pkg/grpc/policy"
 )
@@ -14,6 +15,8 @@ func ModelPasswordComplexityPolicyToPb(policy *query.PasswordComplexityPolicy) *
 		HasLowercase: policy.HasLowercase,
 		HasNumber:    policy.HasNumber,
 		HasSymbol:    policy.HasSymbol,
+
+		BreachedPasswordCheck: ModelBreachedPasswordCheckToPb(policy.BreachedPasswordCheck),
 		Details: object.ToViewDetailsPb(
 			policy.Sequence,
 			policy.CreationDate,
@@ -22,3 +25,27 @@ func ModelPasswordComplexityPolicyToPb(policy *query.PasswordComplexityPolicy) *
 		),
 	}
 }
+
+func BreachedPasswordCheckToDomain(check policy_pb.BreachedPasswordCheck) domain.BreachedPasswordCheck {
+	switch check {
+	case policy_pb.BreachedPasswordCheck_BREACHED_PASSWORD_CHECK_DISABLED:
+		return domain.BreachedPasswordCheckDisabled
+	case policy_pb.BreachedPasswordCheck_BREACHED_PASSWORD_CHECK_WARN:
+		return domain.BreachedPasswordCheckWarn
+	case policy_pb.BreachedPasswordCheck_BREACHED_PASSWORD_CHECK_REJECT:
+		return domain.BreachedPasswordCheckReject
+	default:
+		return -1
+	}
+}
+
+func ModelBreachedPasswordCheckToPb(check domain.BreachedPasswordCheck) policy_pb.BreachedPasswordCheck {
+	switch check {
+	case domain.BreachedPasswordCheckWarn:
+		return policy_pb.BreachedPasswordCheck_BREACHED_PASSWORD_CHECK_WARN
+	case domain.BreachedPasswordCheckReject:
+		return policy_pb.BreachedPasswordCheck_BREACHED_PASSWORD_CHECK_REJECT
+	default:
+		return policy_pb.BreachedPasswordCheck_BREACHED_PASSWORD_CHECK_DISABLED
+	}
+}
@@ -1,6 +1,8 @@
 package policy
 
 import (
+	"google.golang.org/protobuf/types/known/durationpb"
+
 	"github.com/dennigogo/zitadel/internal/api/grpc/object"
 	"github.com/dennigogo/zitadel/internal/query"
 	policy_pb "github.com/dennigogo/zitadel/pkg/grpc/policy"
@@ -10,6 +12,11 @@ func ModelLockoutPolicyToPb(policy *query.LockoutPolicy) *policy_pb.LockoutPolic
 	return &policy_pb.LockoutPolicy{
 		IsDefault:           policy.IsDefault,
 		MaxPasswordAttempts: policy.MaxPasswordAttempts,
+		MaxOtpAttempts:      policy.MaxOTPAttempts,
+		MaxU2FAttempts:      policy.MaxU2FAttempts,
+		AutoUnlockAfter:     durationpb.New(policy.AutoUnlockAfter),
+		AttemptDelay:        durationpb.New(policy.AttemptDelay),
+		MaxAttemptDelay:     durationpb.New(policy.MaxAttemptDelay),
 		Details: object.ToViewDetailsPb(
 			policy.Sequence,
 			policy.CreationDate,
@@ -42,24 +42,28 @@ func AppConfigToPb(app *query.App) app_pb.AppConfig {
 func AppOIDCConfigToPb(app *query.OIDCApp) *app_pb.App_OidcConfig {
 	return &app_pb.App_OidcConfig{
 		OidcConfig: &app_pb.OIDCConfig{
-			RedirectUris:             app.RedirectURIs,
-			ResponseTypes:            OIDCResponseTypesFromModel(app.ResponseTypes),
-			GrantTypes:               OIDCGrantTypesFromModel(app.GrantTypes),
-			AppType:                  OIDCApplicationTypeToPb(app.AppType),
-			ClientId:                 app.ClientID,
-			AuthMethodType:           OIDCAuthMethodTypeToPb(app.AuthMethodType),
-			PostLogoutRedirectUris:   app.PostLogoutRedirectURIs,
-			Version:                  OIDCVersionToPb(domain.OIDCVersion(app.Version)),
-			NoneCompliant:            len(app.ComplianceProblems) != 0,
-			ComplianceProblems:       ComplianceProblemsToLocalizedMessages(app.ComplianceProblems),
-			DevMode:                  app.IsDevMode,
-			AccessTokenType:          oidcTokenTypeToPb(app.AccessTokenType),
-			AccessTokenRoleAssertion: app.AssertAccessTokenRole,
-			IdTokenRoleAssertion:     app.AssertIDTokenRole,
-			IdTokenUserinfoAssertion: app.AssertIDTokenUserinfo,
-			ClockSkew:                durationpb.New(app.ClockSkew),
-			AdditionalOrigins:        app.AdditionalOrigins,
-			AllowedOrigins:           app.AllowedOrigins,
+			RedirectUris:               app.RedirectURIs,
+			ResponseTypes:              OIDCResponseTypesFromModel(app.ResponseTypes),
+			GrantTypes:                 OIDCGrantTypesFromModel(app.GrantTypes),
+			AppType:                    OIDCApplicationTypeToPb(app.AppType),
+			ClientId:                   app.ClientID,
+			AuthMethodType:             OIDCAuthMethodTypeToPb(app.AuthMethodType),
+			PostLogoutRedirectUris:     app.PostLogoutRedirectURIs,
+			Version:                    OIDCVersionToPb(domain.OIDCVersion(app.Version)),
+			NoneCompliant:              len(app.ComplianceProblems) != 0,
+			ComplianceProblems:         ComplianceProblemsToLocalizedMessages(app.ComplianceProblems),
+			DevMode:                    app.IsDevMode,
+			AccessTokenType:            oidcTokenTypeToPb(app.AccessTokenType),
+			AccessTokenRoleAssertion:   app.AssertAccessTokenRole,
+			IdTokenRoleAssertion:       app.AssertIDTokenRole,
+			IdTokenUserinfoAssertion:   app.AssertIDTokenUserinfo,
+			ClockSkew:                  durationpb.New(app.ClockSkew),
+			AdditionalOrigins:          app.AdditionalOrigins,
+			AllowedOrigins:             app.AllowedOrigins,
+			BackChannelLogoutUri:       app.BackChannelLogoutURI,
+			FrontChannelLogoutUri:      app.FrontChannelLogoutURI,
+			RequirePushedAuthRequests:  app.RequirePushedAuthRequests,
+			RequireSignedRequestObject: app.RequireSignedRequestObject,
 		},
 	}
 }
@@ -135,6 +139,10 @@ func OIDCGrantTypesFromModel(grantTypes []domain.OIDCGrantType) []app_pb.OIDCGra
 			oidcGrantTypes[i] = app_pb.OIDCGrantType_OIDC_GRANT_TYPE_IMPLICIT
 		case domain.OIDCGrantTypeRefreshToken:
 			oidcGrantTypes[i] = app_pb.OIDCGrantType_OIDC_GRANT_TYPE_REFRESH_TOKEN
+		case domain.OIDCGrantTypeDeviceCode:
+			oidcGrantTypes[i] = app_pb.OIDCGrantType_OIDC_GRANT_TYPE_DEVICE_CODE
+		case domain.OIDCGrantTypeTokenExchange:
+			oidcGrantTypes[i] = app_pb.OIDCGrantType_OIDC_GRANT_TYPE_TOKEN_EXCHANGE
 		}
 	}
 	return oidcGrantTypes
@@ -153,6 +161,10 @@ func OIDCGrantTypesToDomain(grantTypes []app_pb.OIDCGrantType) []domain.OIDCGran
 			oidcGrantTypes[i] = domain.OIDCGrantTypeImplicit
 		case app_pb.OIDCGrantType_OIDC_GRANT_TYPE_REFRESH_TOKEN:
 			oidcGrantTypes[i] = domain.OIDCGrantTypeRefreshToken
+		case app_pb.OIDCGrantType_OIDC_GRANT_TYPE_DEVICE_CODE:
+			oidcGrantTypes[i] = domain.OIDCGrantTypeDeviceCode
+		case app_pb.OIDCGrantType_OIDC_GRANT_TYPE_TOKEN_EXCHANGE:
+			oidcGrantTypes[i] = domain.OIDCGrantTypeTokenExchange
 		}
 	}
 	return oidcGrantTypes
@@ -2,8 +2,10 @@ package server
 
 import (
 	"context"
+	"encoding/pem"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strings"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
@@ -13,6 +15,7 @@ import (
 
 	client_middleware "github.com/dennigogo/zitadel/internal/api/grpc/client/middleware"
 	"github.com/dennigogo/zitadel/internal/api/grpc/server/middleware"
+	http_util "github.com/dennigogo/zitadel/internal/api/http"
 	http_mw "github.com/dennigogo/zitadel/internal/api/http/middleware"
 )
 
@@ -23,6 +26,7 @@ const (
 var (
 	customHeaders = []string{
 		"x-zitadel-",
+		http_util.DPoP,
 	}
 	jsonMarshaler = &runtime.JSONPb{
 		UnmarshalOptions: protojson.UnmarshalOptions{
@@ -57,7 +61,7 @@ type Gateway interface {
 
 type GatewayFunc func(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) error
 
-func CreateGateway(ctx context.Context, g Gateway, port uint16, http1HostName string) (http.Handler, string, error) {
+func CreateGateway(ctx context.Context, g Gateway, port uint16, http1HostName string, trustClientCertificateHeader bool) (http.Handler, string, error) {
 	runtimeMux := runtime.NewServeMux(serveMuxOptions...)
 	opts := []grpc.DialOption{
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
@@ -67,11 +71,12 @@ func CreateGateway(ctx context.Context, g Gateway, port uint16, http1HostName st
 	if err != nil {
 		return nil, "", fmt.Errorf("failed to register grpc gateway: %w", err)
 	}
-	return addInterceptors(runtimeMux, http1HostName), g.GatewayPathPrefix(), nil
+	return addInterceptors(runtimeMux, http1HostName, trustClientCertificateHeader), g.GatewayPathPrefix(), nil
 }
 
-func addInterceptors(handler http.Handler, http1HostName string) http.Handler {
+func addInterceptors(handler http.Handler, http1HostName string, trustClientCertificateHeader bool) http.Handler {
 	handler = http1Host(handler, http1HostName)
+	handler = requestInfo(handler, trustClientCertificateHeader)
 	handler = http_mw.CORSInterceptor(handler)
 	handler = http_mw.DefaultTelemetryHandler(handler)
 	return http_mw.DefaultMetricsHandler(handler)
@@ -88,3 +93,35 @@ func http1Host(next http.Handler, http1HostName string) http.Handler {
 		next.ServeHTTP(w, r)
 	})
 }
+
+// requestInfo passes the method and path of the http request and the client certificate of the mTLS connection,
+// which are needed to verify sender-constrained access tokens (DPoP and mTLS).
+// The client certificate header of the client is only passed, if it's set by a trusted TLS terminating proxy.
+func requestInfo(next http.Handler, trustClientCertificateHeader bool) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.Header.Set(http_util.ZitadelRequestMethod, r.Method)
+		r.Header.Set(http_util.ZitadelRequestPath, requestPath(r))
+		middleware.SetGatewayToken(r.Header)
+		switch {
+		case r.TLS != nil:
+			// ZITADEL terminates TLS itself, so only the certificate of the connection is passed
+			r.Header.Del(http_util.ZitadelClientCertificate)
+			if len(r.TLS.PeerCertificates) > 0 {
+				cert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: r.TLS.PeerCertificates[0].Raw})
+				r.Header.Set(http_util.ZitadelClientCertificate, url.PathEscape(string(cert)))
+			}
+		case !trustClientCertificateHeader:
+			r.Header.Del(http_util.ZitadelClientCertificate)
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// requestPath returns the path requested by the client, as the prefix of the gateway is already stripped from the url
+func requestPath(r *http.Request) string {
+	uri, err := url.ParseRequestURI(r.RequestURI)
+	if err != nil {
+		return r.URL.Path
+	}
+	return uri.Path
+}
@@ -14,8 +14,11 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/dennigogo/zitadel/internal/api/authz"
+	grpc_util "github.com/dennigogo/zitadel/internal/api/grpc"
+	"github.com/dennigogo/zitadel/internal/domain"
 	caos_errors "github.com/dennigogo/zitadel/internal/errors"
 	"github.com/dennigogo/zitadel/internal/i18n"
+	"github.com/dennigogo/zitadel/internal/quota"
 	"github.com/dennigogo/zitadel/internal/telemetry/tracing"
 )
 
@@ -27,15 +30,15 @@ type InstanceVerifier interface {
 	GetInstance(ctx context.Context)
 }
 
-func InstanceInterceptor(verifier authz.InstanceVerifier, headerName string, ignoredServices ...string) grpc.UnaryServerInterceptor {
+func InstanceInterceptor(verifier authz.InstanceVerifier, headerName string, quotas quota.Enforcer, ignoredServices ...string) grpc.UnaryServerInterceptor {
 	translator, err := newZitadelTranslator(language.English)
 	logging.OnError(err).Panic("unable to get translator")
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		return setInstance(ctx, req, info, handler, verifier, headerName, translator, ignoredServices...)
+		return setInstance(ctx, req, info, handler, verifier, headerName, translator, quotas, ignoredServices...)
 	}
 }
 
-func setInstance(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler, verifier authz.InstanceVerifier, headerName string, translator *i18n.Translator, ignoredServices ...string) (_ interface{}, err error) {
+func setInstance(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler, verifier authz.InstanceVerifier, headerName string, translator *i18n.Translator, quotas quota.Enforcer, ignoredServices ...string) (_ interface{}, err error) {
 	interceptorCtx, span := tracing.NewServerInterceptorSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 	for _, service := range ignoredServices {
@@ -60,7 +63,26 @@ func setInstance(ctx context.Context, req interface{}, info *grpc.UnaryServerInf
 		return nil, status.Error(codes.NotFound, err.Error())
 	}
 	span.End()
-	return handler(authz.WithInstance(ctx, instance), req)
+	ctx = authz.WithInstance(ctx, instance)
+	if quotaExhausted(ctx, quotas) {
+		return nil, status.Error(codes.ResourceExhausted, translator.LocalizeFromCtx(ctx, "Errors.Quota.Exhausted", nil))
+	}
+	return handler(ctx, req)
+}
+
+// quotaExhausted meters the authenticated requests and checks the limit of their quota,
+// the limits of the created resources are checked by the commands
+func quotaExhausted(ctx context.Context, quotas quota.Enforcer) bool {
+	if quotas == nil {
+		return false
+	}
+	if grpc_util.GetAuthorizationHeader(ctx) != "" {
+		if quotas.Exhausted(ctx, domain.QuotaUnitRequestsAllAuthenticated) {
+			return true
+		}
+		quotas.Report(ctx, domain.QuotaUnitRequestsAllAuthenticated, 1)
+	}
+	return false
 }
 
 func hostFromContext(ctx context.Context, headerName string) (string, error) {
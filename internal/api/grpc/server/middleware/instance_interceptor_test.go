@@ -11,6 +11,7 @@ import (
 	"google.golang.org/grpc/metadata"
 
 	"github.com/dennigogo/zitadel/internal/api/authz"
+	"github.com/dennigogo/zitadel/internal/domain"
 )
 
 func Test_hostNameFromContext(t *testing.T) {
@@ -135,7 +136,7 @@ func Test_setInstance(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := setInstance(tt.args.ctx, tt.args.req, tt.args.info, tt.args.handler, tt.args.verifier, tt.args.headerName, nil)
+			got, err := setInstance(tt.args.ctx, tt.args.req, tt.args.info, tt.args.handler, tt.args.verifier, tt.args.headerName, nil, nil)
 			if (err != nil) != tt.res.err {
 				t.Errorf("setInstance() error = %v, wantErr %v", err, tt.res.err)
 				return
@@ -147,8 +148,91 @@ func Test_setInstance(t *testing.T) {
 	}
 }
 
+func Test_quotaExhausted(t *testing.T) {
+	type args struct {
+		ctx       context.Context
+		exhausted map[domain.QuotaUnit]bool
+	}
+	type res struct {
+		want     bool
+		reported map[domain.QuotaUnit]uint64
+	}
+	tests := []struct {
+		name string
+		args args
+		res  res
+	}{
+		{
+			"unauthenticated request, not metered",
+			args{
+				ctx: context.Background(),
+			},
+			res{
+				want:     false,
+				reported: map[domain.QuotaUnit]uint64{},
+			},
+		},
+		{
+			"authenticated request, metered",
+			args{
+				ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer token")),
+			},
+			res{
+				want:     false,
+				reported: map[domain.QuotaUnit]uint64{domain.QuotaUnitRequestsAllAuthenticated: 1},
+			},
+		},
+		{
+			"requests exhausted",
+			args{
+				ctx:       metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer token")),
+				exhausted: map[domain.QuotaUnit]bool{domain.QuotaUnitRequestsAllAuthenticated: true},
+			},
+			res{
+				want:     true,
+				reported: map[domain.QuotaUnit]uint64{},
+			},
+		},
+		{
+			"users exhausted, checked by the commands",
+			args{
+				ctx:       metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer token")),
+				exhausted: map[domain.QuotaUnit]bool{domain.QuotaUnitUsers: true},
+			},
+			res{
+				want:     false,
+				reported: map[domain.QuotaUnit]uint64{domain.QuotaUnitRequestsAllAuthenticated: 1},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			quotas := &mockQuotas{exhausted: tt.args.exhausted, reported: make(map[domain.QuotaUnit]uint64)}
+			if got := quotaExhausted(tt.args.ctx, quotas); got != tt.res.want {
+				t.Errorf("quotaExhausted() got = %v, want %v", got, tt.res.want)
+			}
+			if !reflect.DeepEqual(quotas.reported, tt.res.reported) {
+				t.Errorf("quotaExhausted() reported = %v, want %v", quotas.reported, tt.res.reported)
+			}
+		})
+	}
+}
+
 type mockRequest struct{}
 
+type mockQuotas struct {
+	exhausted map[domain.QuotaUnit]bool
+	reported  map[domain.QuotaUnit]uint64
+}
+
+func (m *mockQuotas) Report(_ context.Context, unit domain.QuotaUnit, amount uint64) {
+	m.reported[unit] += amount
+}
+
+func (m *mockQuotas) Exhausted(_ context.Context, unit domain.QuotaUnit) bool {
+	return m.exhausted[unit]
+}
+
 type mockInstanceVerifier struct {
 	host string
 }
@@ -0,0 +1,75 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/zitadel/logging"
+	"golang.org/x/text/language"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/dennigogo/zitadel/internal/api/authz"
+	http_util "github.com/dennigogo/zitadel/internal/api/http"
+	"github.com/dennigogo/zitadel/internal/i18n"
+	"github.com/dennigogo/zitadel/internal/ratelimit"
+)
+
+// RateLimitInterceptor limits the requests per instance, authenticated user and ip,
+// it must be called after the instance and authorization interceptors
+func RateLimitInterceptor(limiter ratelimit.Checker, ignoredServices ...string) grpc.UnaryServerInterceptor {
+	translator, err := newZitadelTranslator(language.English)
+	logging.OnError(err).Panic("unable to get translator")
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return limitRate(ctx, req, info, handler, limiter, translator, ignoredServices...)
+	}
+}
+
+func limitRate(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler, limiter ratelimit.Checker, translator *i18n.Translator, ignoredServices ...string) (interface{}, error) {
+	for _, service := range ignoredServices {
+		if !strings.HasPrefix(service, "/") {
+			service = "/" + service
+		}
+		if strings.HasPrefix(info.FullMethod, service) {
+			return handler(ctx, req)
+		}
+	}
+	result := limiter.Allow(ctx, authz.GetCtxData(ctx).UserID, remoteIP(ctx, limiter.TrustedProxies()))
+	setRateLimitHeaders(ctx, info, result)
+	if !result.Allowed {
+		return nil, status.Error(codes.ResourceExhausted, translator.LocalizeFromCtx(ctx, "Errors.RateLimit.Exceeded", nil))
+	}
+	return handler(ctx, req)
+}
+
+func setRateLimitHeaders(ctx context.Context, info *grpc.UnaryServerInfo, result *ratelimit.Result) {
+	headers := result.Headers()
+	if len(headers) == 0 {
+		return
+	}
+	header := metadata.New(headers)
+	for key, value := range headers {
+		header.Append(runtime.MetadataHeaderPrefix+key, value)
+	}
+	err := grpc.SetHeader(ctx, header)
+	logging.OnError(err).WithField("req", info.FullMethod).Warn("cannot set rate limit headers on grpc response")
+}
+
+// remoteIP returns the ip of the client in front of the trusted proxies,
+// the gateway is an additional trusted proxy as it appends the address of the http request to the x-forwarded-for header
+func remoteIP(ctx context.Context, trustedProxies int) string {
+	var remoteAddr string
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		remoteAddr = p.Addr.String()
+	}
+	if fromGateway(ctx) {
+		trustedProxies++
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	return http_util.ForwardedClientIP(http.Header(md), remoteAddr, trustedProxies)
+}
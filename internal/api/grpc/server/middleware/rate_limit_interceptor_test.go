@@ -0,0 +1,153 @@
+package middleware
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/dennigogo/zitadel/internal/i18n"
+	"github.com/dennigogo/zitadel/internal/ratelimit"
+)
+
+type mockLimiter struct {
+	allowed        bool
+	trustedProxies int
+	client         string
+	ip             string
+}
+
+func (m *mockLimiter) Allow(_ context.Context, client, ip string) *ratelimit.Result {
+	m.client = client
+	m.ip = ip
+	return &ratelimit.Result{Allowed: m.allowed}
+}
+
+func (m *mockLimiter) AllowClient(_ context.Context, client string) *ratelimit.Result {
+	m.client = client
+	return &ratelimit.Result{Allowed: m.allowed}
+}
+
+func (m *mockLimiter) TrustedProxies() int {
+	return m.trustedProxies
+}
+
+func Test_limitRate(t *testing.T) {
+	translator, err := i18n.NewTranslator(http.Dir("../../../../static"), language.English, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	type args struct {
+		ctx             context.Context
+		method          string
+		allowed         bool
+		trustedProxies  int
+		ignoredServices []string
+	}
+	type res struct {
+		want    interface{}
+		code    codes.Code
+		checked bool
+		ip      string
+	}
+	tests := []struct {
+		name string
+		args args
+		res  res
+	}{
+		{
+			name: "ignored service",
+			args: args{
+				ctx:             context.Background(),
+				method:          "/zitadel.system.v1.SystemService/ListInstances",
+				ignoredServices: []string{"zitadel.system.v1.SystemService"},
+			},
+			res: res{
+				want: &mockReq{},
+			},
+		},
+		{
+			name: "allowed, forwarded ip of trusted proxy",
+			args: args{
+				ctx:            metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-forwarded-for", "6.6.6.6, 1.2.3.4")),
+				method:         "/zitadel.management.v1.ManagementService/ListUsers",
+				allowed:        true,
+				trustedProxies: 1,
+			},
+			res: res{
+				want:    &mockReq{},
+				checked: true,
+				ip:      "1.2.3.4",
+			},
+		},
+		{
+			name: "allowed, forwarded ip without trusted proxy ignored",
+			args: args{
+				ctx: metadata.NewIncomingContext(
+					peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("5.6.7.8"), Port: 1234}}),
+					metadata.Pairs("x-forwarded-for", "1.2.3.4"),
+				),
+				method:  "/zitadel.management.v1.ManagementService/ListUsers",
+				allowed: true,
+			},
+			res: res{
+				want:    &mockReq{},
+				checked: true,
+				ip:      "5.6.7.8",
+			},
+		},
+		{
+			name: "allowed, forwarded ip of gateway",
+			args: args{
+				ctx: metadata.NewIncomingContext(
+					peer.NewContext(context.WithValue(context.Background(), gatewayCtxKey{}, true), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 1234}}),
+					metadata.Pairs("x-forwarded-for", "6.6.6.6, 1.2.3.4"),
+				),
+				method:  "/zitadel.management.v1.ManagementService/ListUsers",
+				allowed: true,
+			},
+			res: res{
+				want:    &mockReq{},
+				checked: true,
+				ip:      "1.2.3.4",
+			},
+		},
+		{
+			name: "exceeded, peer ip",
+			args: args{
+				ctx:    peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("5.6.7.8"), Port: 1234}}),
+				method: "/zitadel.management.v1.ManagementService/ListUsers",
+			},
+			res: res{
+				code:    codes.ResourceExhausted,
+				checked: true,
+				ip:      "5.6.7.8",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limiter := &mockLimiter{allowed: tt.args.allowed, trustedProxies: tt.args.trustedProxies, ip: "unchecked"}
+			got, err := limitRate(tt.args.ctx, &mockReq{}, mockInfo(tt.args.method), emptyMockHandler, limiter, translator, tt.args.ignoredServices...)
+			if tt.res.code != codes.OK {
+				assert.Equal(t, tt.res.code, status.Code(err))
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.res.want, got)
+			}
+			if tt.res.checked {
+				assert.Equal(t, tt.res.ip, limiter.ip)
+			} else {
+				assert.Equal(t, "unchecked", limiter.ip)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package middleware
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"net/http"
+	"strings"
+
+	"github.com/zitadel/logging"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	http_util "github.com/dennigogo/zitadel/internal/api/http"
+)
+
+// gatewayToken authenticates the calls of the gateway,
+// which is the only caller allowed to pass the method and path of the http request
+var gatewayToken = newGatewayToken()
+
+func newGatewayToken() string {
+	token := make([]byte, 32)
+	_, err := rand.Read(token)
+	logging.Log("MIDDLE-Gw3tk").OnError(err).Fatal("unable to create gateway token")
+	return base64.RawURLEncoding.EncodeToString(token)
+}
+
+// SetGatewayToken marks the http request as passed by the gateway
+func SetGatewayToken(header http.Header) {
+	header.Set(http_util.ZitadelGatewayToken, gatewayToken)
+}
+
+// RequestInfoInterceptor removes the request info of calls not passed by the gateway
+// and the client certificate header, if it's not set by a trusted TLS terminating proxy,
+// so clients can't spoof the request or the certificate sender-constrained access tokens are bound to
+func RequestInfoInterceptor(trustClientCertificateHeader bool) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return handler(sanitizeRequestInfo(ctx, trustClientCertificateHeader), req)
+	}
+}
+
+func sanitizeRequestInfo(ctx context.Context, trustClientCertificateHeader bool) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+	md = md.Copy()
+	fromGateway := isGatewayToken(md.Get(http_util.ZitadelGatewayToken))
+	delete(md, strings.ToLower(http_util.ZitadelGatewayToken))
+	if !fromGateway {
+		delete(md, strings.ToLower(http_util.ZitadelRequestMethod))
+		delete(md, strings.ToLower(http_util.ZitadelRequestPath))
+		// the gateway already removed the header of the client if it isn't trusted
+		if !trustClientCertificateHeader {
+			delete(md, strings.ToLower(http_util.ZitadelClientCertificate))
+		}
+	} else {
+		ctx = context.WithValue(ctx, gatewayCtxKey{}, true)
+	}
+	return metadata.NewIncomingContext(ctx, md)
+}
+
+type gatewayCtxKey struct{}
+
+// fromGateway returns true if the call was passed by the gateway
+func fromGateway(ctx context.Context) bool {
+	gateway, _ := ctx.Value(gatewayCtxKey{}).(bool)
+	return gateway
+}
+
+func isGatewayToken(tokens []string) bool {
+	return len(tokens) == 1 && subtle.ConstantTimeCompare([]byte(tokens[0]), []byte(gatewayToken)) == 1
+}
@@ -0,0 +1,95 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+
+	http_util "github.com/dennigogo/zitadel/internal/api/http"
+)
+
+func Test_sanitizeRequestInfo(t *testing.T) {
+	gatewayHeader := make(http.Header)
+	SetGatewayToken(gatewayHeader)
+	requestInfo := func(token string) metadata.MD {
+		md := metadata.Pairs(
+			http_util.ZitadelRequestMethod, http.MethodGet,
+			http_util.ZitadelRequestPath, "/management/v1/users/me",
+			http_util.ZitadelClientCertificate, "cert",
+			http_util.Authorization, "Bearer token",
+		)
+		if token != "" {
+			md.Set(http_util.ZitadelGatewayToken, token)
+		}
+		return md
+	}
+	type args struct {
+		md                           metadata.MD
+		trustClientCertificateHeader bool
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    metadata.MD
+		gateway bool
+	}{
+		{
+			name: "no metadata",
+			args: args{},
+			want: nil,
+		},
+		{
+			name: "spoofed request info and certificate, removed",
+			args: args{
+				md: requestInfo(""),
+			},
+			want: metadata.Pairs(http_util.Authorization, "Bearer token"),
+		},
+		{
+			name: "spoofed gateway token, removed",
+			args: args{
+				md: requestInfo("spoofed"),
+			},
+			want: metadata.Pairs(http_util.Authorization, "Bearer token"),
+		},
+		{
+			name: "certificate of trusted proxy, request info removed",
+			args: args{
+				md:                           requestInfo(""),
+				trustClientCertificateHeader: true,
+			},
+			want: metadata.Pairs(
+				http_util.ZitadelClientCertificate, "cert",
+				http_util.Authorization, "Bearer token",
+			),
+		},
+		{
+			name: "gateway, request info passed",
+			args: args{
+				md: requestInfo(gatewayHeader.Get(http_util.ZitadelGatewayToken)),
+			},
+			want: metadata.Pairs(
+				http_util.ZitadelRequestMethod, http.MethodGet,
+				http_util.ZitadelRequestPath, "/management/v1/users/me",
+				http_util.ZitadelClientCertificate, "cert",
+				http_util.Authorization, "Bearer token",
+			),
+			gateway: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.args.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, tt.args.md)
+			}
+			ctx = sanitizeRequestInfo(ctx, tt.args.trustClientCertificateHeader)
+			got, _ := metadata.FromIncomingContext(ctx)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.gateway, fromGateway(ctx))
+		})
+	}
+}
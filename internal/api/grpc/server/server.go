@@ -11,6 +11,8 @@ import (
 	grpc_api "github.com/dennigogo/zitadel/internal/api/grpc"
 	"github.com/dennigogo/zitadel/internal/api/grpc/server/middleware"
 	"github.com/dennigogo/zitadel/internal/query"
+	"github.com/dennigogo/zitadel/internal/quota"
+	"github.com/dennigogo/zitadel/internal/ratelimit"
 	"github.com/dennigogo/zitadel/internal/telemetry/metrics"
 	system_pb "github.com/dennigogo/zitadel/pkg/grpc/system"
 )
@@ -23,17 +25,19 @@ type Server interface {
 	AuthMethods() authz.MethodMapping
 }
 
-func CreateServer(verifier *authz.TokenVerifier, authConfig authz.Config, queries *query.Queries, hostHeaderName string, tlsConfig *tls.Config) *grpc.Server {
+func CreateServer(verifier *authz.TokenVerifier, authConfig authz.Config, queries *query.Queries, quotas quota.Enforcer, limiter ratelimit.Checker, hostHeaderName string, tlsConfig *tls.Config, trustClientCertificateHeader bool) *grpc.Server {
 	metricTypes := []metrics.MetricType{metrics.MetricTypeTotalCount, metrics.MetricTypeRequestCount, metrics.MetricTypeStatusCode}
 	serverOptions := []grpc.ServerOption{
 		grpc.UnaryInterceptor(
 			grpc_middleware.ChainUnaryServer(
 				middleware.DefaultTracingServer(),
 				middleware.MetricsHandler(metricTypes, grpc_api.Probes...),
+				middleware.RequestInfoInterceptor(trustClientCertificateHeader),
 				middleware.NoCacheInterceptor(),
 				middleware.ErrorHandler(),
-				middleware.InstanceInterceptor(queries, hostHeaderName, system_pb.SystemService_MethodPrefix),
+				middleware.InstanceInterceptor(queries, hostHeaderName, quotas, system_pb.SystemService_MethodPrefix),
 				middleware.AuthorizationInterceptor(verifier, authConfig),
+				middleware.RateLimitInterceptor(limiter, system_pb.SystemService_MethodPrefix),
 				middleware.TranslationHandler(),
 				middleware.ValidationHandler(),
 				middleware.ServiceHandler(),
@@ -0,0 +1,52 @@
+package system
+
+import (
+	"context"
+	"time"
+
+	"github.com/dennigogo/zitadel/internal/api/authz"
+	"github.com/dennigogo/zitadel/internal/api/grpc/object"
+	system_pb "github.com/dennigogo/zitadel/pkg/grpc/system"
+)
+
+func (s *Server) SetQuota(ctx context.Context, req *system_pb.SetQuotaRequest) (*system_pb.SetQuotaResponse, error) {
+	ctx = authz.WithInstanceID(ctx, req.InstanceId)
+	details, err := s.command.SetQuota(ctx, SetQuotaPbToDomain(req))
+	if err != nil {
+		return nil, err
+	}
+	return &system_pb.SetQuotaResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) RemoveQuota(ctx context.Context, req *system_pb.RemoveQuotaRequest) (*system_pb.RemoveQuotaResponse, error) {
+	ctx = authz.WithInstanceID(ctx, req.InstanceId)
+	details, err := s.command.RemoveQuota(ctx, QuotaUnitPbToDomain(req.Unit))
+	if err != nil {
+		return nil, err
+	}
+	return &system_pb.RemoveQuotaResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) ListQuotas(ctx context.Context, req *system_pb.ListQuotasRequest) (*system_pb.ListQuotasResponse, error) {
+	ctx = authz.WithInstanceID(ctx, req.InstanceId)
+	quotas, err := s.query.SearchQuotas(ctx)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	usages := make([]uint64, len(quotas.Quotas))
+	for i, quota := range quotas.Quotas {
+		usages[i], err = s.query.QuotaUsage(ctx, quota, now)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &system_pb.ListQuotasResponse{
+		Result:  QuotasToPb(quotas.Quotas, usages, now),
+		Details: object.ToListDetails(quotas.Count, quotas.Sequence, quotas.Timestamp),
+	}, nil
+}
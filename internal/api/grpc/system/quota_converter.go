@@ -0,0 +1,111 @@
+package system
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/dennigogo/zitadel/internal/api/grpc/object"
+	"github.com/dennigogo/zitadel/internal/domain"
+	"github.com/dennigogo/zitadel/internal/query"
+	quota_pb "github.com/dennigogo/zitadel/pkg/grpc/quota"
+	system_pb "github.com/dennigogo/zitadel/pkg/grpc/system"
+)
+
+func SetQuotaPbToDomain(req *system_pb.SetQuotaRequest) *domain.Quota {
+	quota := &domain.Quota{
+		Unit:          QuotaUnitPbToDomain(req.Unit),
+		ResetInterval: req.ResetInterval.AsDuration(),
+		Amount:        req.Amount,
+		Limit:         req.Limit,
+		Notifications: QuotaNotificationsPbToDomain(req.Notifications),
+	}
+	if req.From != nil {
+		quota.From = req.From.AsTime()
+	}
+	return quota
+}
+
+func QuotaNotificationsPbToDomain(notifications []*quota_pb.Notification) []*domain.QuotaNotification {
+	result := make([]*domain.QuotaNotification, len(notifications))
+	for i, notification := range notifications {
+		result[i] = &domain.QuotaNotification{
+			Percent: uint16(notification.Percent),
+			Repeat:  notification.Repeat,
+			CallURL: notification.CallUrl,
+		}
+	}
+	return result
+}
+
+func QuotaUnitPbToDomain(unit quota_pb.Unit) domain.QuotaUnit {
+	switch unit {
+	case quota_pb.Unit_UNIT_REQUESTS_ALL_AUTHENTICATED:
+		return domain.QuotaUnitRequestsAllAuthenticated
+	case quota_pb.Unit_UNIT_ACTIONS_ALL_RUNS_SECONDS:
+		return domain.QuotaUnitActionsAllRunsSeconds
+	case quota_pb.Unit_UNIT_NOTIFICATIONS_ALL:
+		return domain.QuotaUnitNotificationsAll
+	case quota_pb.Unit_UNIT_USERS:
+		return domain.QuotaUnitUsers
+	case quota_pb.Unit_UNIT_ORGS:
+		return domain.QuotaUnitOrgs
+	default:
+		return domain.QuotaUnitUnspecified
+	}
+}
+
+func QuotaUnitToPb(unit domain.QuotaUnit) quota_pb.Unit {
+	switch unit {
+	case domain.QuotaUnitRequestsAllAuthenticated:
+		return quota_pb.Unit_UNIT_REQUESTS_ALL_AUTHENTICATED
+	case domain.QuotaUnitActionsAllRunsSeconds:
+		return quota_pb.Unit_UNIT_ACTIONS_ALL_RUNS_SECONDS
+	case domain.QuotaUnitNotificationsAll:
+		return quota_pb.Unit_UNIT_NOTIFICATIONS_ALL
+	case domain.QuotaUnitUsers:
+		return quota_pb.Unit_UNIT_USERS
+	case domain.QuotaUnitOrgs:
+		return quota_pb.Unit_UNIT_ORGS
+	default:
+		return quota_pb.Unit_UNIT_UNSPECIFIED
+	}
+}
+
+func QuotasToPb(quotas []*query.Quota, usages []uint64, now time.Time) []*quota_pb.Quota {
+	result := make([]*quota_pb.Quota, len(quotas))
+	for i, quota := range quotas {
+		result[i] = QuotaToPb(quota, usages[i], now)
+	}
+	return result
+}
+
+func QuotaToPb(quota *query.Quota, usage uint64, now time.Time) *quota_pb.Quota {
+	pb := &quota_pb.Quota{
+		Details:       object.ToViewDetailsPb(quota.Sequence, quota.CreationDate, quota.ChangeDate, quota.InstanceID),
+		Unit:          QuotaUnitToPb(quota.Unit),
+		Amount:        quota.Amount,
+		Limit:         quota.Limit,
+		Notifications: QuotaNotificationsToPb(quota.Notifications),
+		Usage:         usage,
+	}
+	if quota.Unit.IsPeriodic() {
+		pb.From = timestamppb.New(quota.From)
+		pb.ResetInterval = durationpb.New(quota.ResetInterval)
+		pb.PeriodStart = timestamppb.New(quota.PeriodStart(now))
+	}
+	return pb
+}
+
+func QuotaNotificationsToPb(notifications []*query.QuotaNotification) []*quota_pb.Notification {
+	result := make([]*quota_pb.Notification, len(notifications))
+	for i, notification := range notifications {
+		result[i] = &quota_pb.Notification{
+			Percent: uint32(notification.Percent),
+			Repeat:  notification.Repeat,
+			CallUrl: notification.CallURL,
+		}
+	}
+	return result
+}
@@ -0,0 +1,45 @@
+package system
+
+import (
+	"context"
+
+	"github.com/dennigogo/zitadel/internal/api/authz"
+	"github.com/dennigogo/zitadel/internal/api/grpc/object"
+	system_pb "github.com/dennigogo/zitadel/pkg/grpc/system"
+)
+
+func (s *Server) SetRateLimits(ctx context.Context, req *system_pb.SetRateLimitsRequest) (*system_pb.SetRateLimitsResponse, error) {
+	ctx = authz.WithInstanceID(ctx, req.InstanceId)
+	details, err := s.command.SetRateLimits(ctx, SetRateLimitsPbToDomain(req))
+	if err != nil {
+		return nil, err
+	}
+	return &system_pb.SetRateLimitsResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) ResetRateLimits(ctx context.Context, req *system_pb.ResetRateLimitsRequest) (*system_pb.ResetRateLimitsResponse, error) {
+	ctx = authz.WithInstanceID(ctx, req.InstanceId)
+	details, err := s.command.ResetRateLimits(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &system_pb.ResetRateLimitsResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) GetRateLimits(ctx context.Context, req *system_pb.GetRateLimitsRequest) (*system_pb.GetRateLimitsResponse, error) {
+	ctx = authz.WithInstanceID(ctx, req.InstanceId)
+	limits, err := s.query.GetRateLimits(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &system_pb.GetRateLimitsResponse{
+		Details:  object.ToViewDetailsPb(limits.Sequence, limits.CreationDate, limits.ChangeDate, limits.InstanceID),
+		Instance: RateLimitToPb(limits.Instance),
+		Client:   RateLimitToPb(limits.Client),
+		Ip:       RateLimitToPb(limits.IP),
+	}, nil
+}
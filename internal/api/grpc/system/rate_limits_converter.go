@@ -0,0 +1,36 @@
+package system
+
+import (
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/dennigogo/zitadel/internal/domain"
+	system_pb "github.com/dennigogo/zitadel/pkg/grpc/system"
+)
+
+func SetRateLimitsPbToDomain(req *system_pb.SetRateLimitsRequest) *domain.RateLimits {
+	return &domain.RateLimits{
+		Instance: RateLimitPbToDomain(req.Instance),
+		Client:   RateLimitPbToDomain(req.Client),
+		IP:       RateLimitPbToDomain(req.Ip),
+	}
+}
+
+func RateLimitPbToDomain(limit *system_pb.RateLimit) domain.RateLimit {
+	if limit == nil {
+		return domain.RateLimit{}
+	}
+	return domain.RateLimit{
+		Requests: limit.Requests,
+		Interval: limit.Interval.AsDuration(),
+	}
+}
+
+func RateLimitToPb(limit domain.RateLimit) *system_pb.RateLimit {
+	if limit.IsZero() {
+		return nil
+	}
+	return &system_pb.RateLimit{
+		Requests: limit.Requests,
+		Interval: durationpb.New(limit.Interval),
+	}
+}
@@ -215,6 +215,27 @@ func UserAuthMethodToWebAuthNTokenPb(token *query.AuthMethod) *user_pb.WebAuthNT
 	}
 }
 
+func TrustedDevicesToPb(devices []*query.UserTrustedDevice) []*user_pb.TrustedDevice {
+	d := make([]*user_pb.TrustedDevice, len(devices))
+	for i, device := range devices {
+		d[i] = TrustedDeviceToPb(device)
+	}
+	return d
+}
+
+func TrustedDeviceToPb(device *query.UserTrustedDevice) *user_pb.TrustedDevice {
+	return &user_pb.TrustedDevice{
+		UserAgentId: device.UserAgentID,
+		Description: device.Description,
+		Details: object.ToViewDetailsPb(
+			device.Sequence,
+			device.CreationDate,
+			device.ChangeDate,
+			device.ResourceOwner,
+		),
+	}
+}
+
 func ExternalIDPViewsToExternalIDPs(externalIDPs []*query.IDPUserLink) []*domain.UserIDPLink {
 	idps := make([]*domain.UserIDPLink, len(externalIDPs))
 	for i, idp := range externalIDPs {
@@ -25,6 +25,12 @@ const (
 	IfNoneMatch     = "If-None-Match"
 	LastModified    = "Last-Modified"
 	Etag            = "Etag"
+	DPoP            = "dpop"
+
+	RetryAfter         = "retry-after"
+	RateLimitLimit     = "ratelimit-limit"
+	RateLimitRemaining = "ratelimit-remaining"
+	RateLimitReset     = "ratelimit-reset"
 
 	ContentSecurityPolicy   = "content-security-policy"
 	XXSSProtection          = "x-xss-protection"
@@ -36,6 +42,13 @@ const (
 	PermissionsPolicy       = "permissions-policy"
 
 	ZitadelOrgID = "x-zitadel-orgid"
+	// ZitadelClientCertificate is set by a TLS terminating proxy to the url encoded PEM client certificate of the mTLS connection
+	ZitadelClientCertificate = "x-zitadel-client-cert"
+	// ZitadelRequestMethod and ZitadelRequestPath pass the original http request from the gateway to the gRPC server
+	ZitadelRequestMethod = "x-zitadel-request-method"
+	ZitadelRequestPath   = "x-zitadel-request-path"
+	// ZitadelGatewayToken authenticates the gateway passing the request method and path to the gRPC server
+	ZitadelGatewayToken = "x-zitadel-gateway-token"
 )
 
 type key int
@@ -100,8 +113,13 @@ func GetOrgID(r *http.Request) string {
 	return r.Header.Get(ZitadelOrgID)
 }
 
+// GetForwardedFor returns the first ip of the x-forwarded-for header,
+// the headers might be canonical http headers or lower case grpc metadata
 func GetForwardedFor(headers http.Header) (string, bool) {
 	forwarded, ok := headers[ForwardedFor]
+	if !ok {
+		forwarded, ok = headers[http.CanonicalHeaderKey(ForwardedFor)]
+	}
 	if ok {
 		ip := strings.TrimSpace(strings.Split(forwarded[0], ",")[0])
 		if ip != "" {
@@ -111,6 +129,43 @@ func GetForwardedFor(headers http.Header) (string, bool) {
 	return "", false
 }
 
+// ForwardedClientIP returns the ip of the client in front of the trusted proxies.
+// Every proxy appends the address of its peer to the x-forwarded-for header,
+// so the ip of the client is the entry appended by the outermost trusted proxy, counted from the right.
+// Entries left of it are set by the client itself and can't be trusted.
+// The remote address is returned if no proxy is trusted or the header has fewer entries than trusted proxies.
+func ForwardedClientIP(headers http.Header, remoteAddr string, trustedProxies int) string {
+	remoteIP := remoteAddr
+	if host, _, err := net.SplitHostPort(remoteAddr); err == nil {
+		remoteIP = host
+	}
+	if trustedProxies <= 0 {
+		return remoteIP
+	}
+	forwarded := forwardedFor(headers)
+	if len(forwarded) < trustedProxies {
+		return remoteIP
+	}
+	return forwarded[len(forwarded)-trustedProxies]
+}
+
+// forwardedFor returns all entries of the x-forwarded-for headers in the order they were appended
+func forwardedFor(headers http.Header) []string {
+	values, ok := headers[ForwardedFor]
+	if !ok {
+		values = headers[http.CanonicalHeaderKey(ForwardedFor)]
+	}
+	forwarded := make([]string, 0, len(values))
+	for _, value := range values {
+		for _, ip := range strings.Split(value, ",") {
+			if ip = strings.TrimSpace(ip); ip != "" {
+				forwarded = append(forwarded, ip)
+			}
+		}
+	}
+	return forwarded
+}
+
 func RemoteAddrFromCtx(ctx context.Context) string {
 	ctxRemoteAddr, _ := ctx.Value(remoteAddr).(string)
 	return ctxRemoteAddr
@@ -12,22 +12,27 @@ import (
 	"golang.org/x/text/language"
 
 	"github.com/dennigogo/zitadel/internal/api/authz"
+	http_util "github.com/dennigogo/zitadel/internal/api/http"
+	"github.com/dennigogo/zitadel/internal/domain"
 	caos_errors "github.com/dennigogo/zitadel/internal/errors"
 	"github.com/dennigogo/zitadel/internal/i18n"
+	"github.com/dennigogo/zitadel/internal/quota"
 	"github.com/dennigogo/zitadel/internal/telemetry/tracing"
 )
 
 type instanceInterceptor struct {
 	verifier        authz.InstanceVerifier
 	headerName      string
+	quotas          quota.Enforcer
 	ignoredPrefixes []string
 	translator      *i18n.Translator
 }
 
-func InstanceInterceptor(verifier authz.InstanceVerifier, headerName string, ignoredPrefixes ...string) *instanceInterceptor {
+func InstanceInterceptor(verifier authz.InstanceVerifier, headerName string, quotas quota.Enforcer, ignoredPrefixes ...string) *instanceInterceptor {
 	return &instanceInterceptor{
 		verifier:        verifier,
 		headerName:      headerName,
+		quotas:          quotas,
 		ignoredPrefixes: ignoredPrefixes,
 		translator:      newZitadelTranslator(),
 	}
@@ -62,9 +67,25 @@ func (a *instanceInterceptor) handleInstance(w http.ResponseWriter, r *http.Requ
 		return
 	}
 	r = r.WithContext(ctx)
+	if a.quotaExhausted(r) {
+		http.Error(w, a.translator.LocalizeFromRequest(r, "Errors.Quota.Exhausted", nil), http.StatusTooManyRequests)
+		return
+	}
 	next.ServeHTTP(w, r)
 }
 
+// quotaExhausted meters the authenticated requests and checks the limit of their quota
+func (a *instanceInterceptor) quotaExhausted(r *http.Request) bool {
+	if a.quotas == nil || http_util.GetAuthorization(r) == "" {
+		return false
+	}
+	if a.quotas.Exhausted(r.Context(), domain.QuotaUnitRequestsAllAuthenticated) {
+		return true
+	}
+	a.quotas.Report(r.Context(), domain.QuotaUnitRequestsAllAuthenticated, 1)
+	return false
+}
+
 func setInstance(r *http.Request, verifier authz.InstanceVerifier, headerName string) (_ context.Context, err error) {
 	ctx := r.Context()
 
@@ -12,6 +12,7 @@ import (
 	"golang.org/x/text/language"
 
 	"github.com/dennigogo/zitadel/internal/api/authz"
+	"github.com/dennigogo/zitadel/internal/domain"
 )
 
 func Test_instanceInterceptor_Handler(t *testing.T) {
@@ -225,6 +226,73 @@ func Test_setInstance(t *testing.T) {
 	}
 }
 
+func Test_instanceInterceptor_quotaExhausted(t *testing.T) {
+	type args struct {
+		request   *http.Request
+		exhausted bool
+	}
+	type res struct {
+		want     bool
+		reported uint64
+	}
+	tests := []struct {
+		name string
+		args args
+		res  res
+	}{
+		{
+			"unauthenticated, not metered",
+			args{
+				request:   httptest.NewRequest("", "/url", nil),
+				exhausted: true,
+			},
+			res{
+				want:     false,
+				reported: 0,
+			},
+		},
+		{
+			"authenticated, metered",
+			args{
+				request: func() *http.Request {
+					r := httptest.NewRequest("", "/url", nil)
+					r.Header.Set("authorization", "Bearer token")
+					return r
+				}(),
+			},
+			res{
+				want:     false,
+				reported: 1,
+			},
+		},
+		{
+			"authenticated, exhausted",
+			args{
+				request: func() *http.Request {
+					r := httptest.NewRequest("", "/url", nil)
+					r.Header.Set("authorization", "Bearer token")
+					return r
+				}(),
+				exhausted: true,
+			},
+			res{
+				want:     true,
+				reported: 0,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			quotas := &mockQuotas{exhausted: tt.args.exhausted}
+			a := &instanceInterceptor{
+				quotas: quotas,
+			}
+			assert.Equal(t, tt.res.want, a.quotaExhausted(tt.args.request))
+			assert.Equal(t, tt.res.reported, quotas.reported)
+		})
+	}
+}
+
 type testHandler struct {
 	context context.Context
 }
@@ -233,6 +301,21 @@ func (t *testHandler) ServeHTTP(_ http.ResponseWriter, r *http.Request) {
 	t.context = r.Context()
 }
 
+type mockQuotas struct {
+	exhausted bool
+	reported  uint64
+}
+
+func (m *mockQuotas) Report(_ context.Context, unit domain.QuotaUnit, amount uint64) {
+	if unit == domain.QuotaUnitRequestsAllAuthenticated {
+		m.reported += amount
+	}
+}
+
+func (m *mockQuotas) Exhausted(_ context.Context, unit domain.QuotaUnit) bool {
+	return unit == domain.QuotaUnitRequestsAllAuthenticated && m.exhausted
+}
+
 type mockInstanceVerifier struct {
 	host string
 }
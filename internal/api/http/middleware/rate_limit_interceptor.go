@@ -0,0 +1,79 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/dennigogo/zitadel/internal/api/authz"
+	http_util "github.com/dennigogo/zitadel/internal/api/http"
+	"github.com/dennigogo/zitadel/internal/i18n"
+	"github.com/dennigogo/zitadel/internal/ratelimit"
+)
+
+type rateLimitInterceptor struct {
+	limiter    ratelimit.Checker
+	translator *i18n.Translator
+}
+
+// RateLimitInterceptor limits the requests per instance and ip,
+// it must be called after the instance interceptor.
+// The client is not known before the authentication, see ClientRateLimitInterceptor
+func RateLimitInterceptor(limiter ratelimit.Checker) *rateLimitInterceptor {
+	return &rateLimitInterceptor{
+		limiter:    limiter,
+		translator: newZitadelTranslator(),
+	}
+}
+
+func (a *rateLimitInterceptor) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		a.handleRateLimit(w, r, next)
+	})
+}
+
+func (a *rateLimitInterceptor) HandlerFunc(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		a.handleRateLimit(w, r, next)
+	}
+}
+
+func (a *rateLimitInterceptor) handleRateLimit(w http.ResponseWriter, r *http.Request, next http.Handler) {
+	ip := http_util.ForwardedClientIP(r.Header, r.RemoteAddr, a.limiter.TrustedProxies())
+	if !a.checkResult(w, r, a.limiter.Allow(r.Context(), "", ip)) {
+		return
+	}
+	next.ServeHTTP(w, r)
+}
+
+// checkResult sets the rate limit headers and returns false if the request was denied
+func (a *rateLimitInterceptor) checkResult(w http.ResponseWriter, r *http.Request, result *ratelimit.Result) bool {
+	for key, value := range result.Headers() {
+		w.Header().Set(key, value)
+	}
+	if !result.Allowed {
+		http.Error(w, a.translator.LocalizeFromRequest(r, "Errors.RateLimit.Exceeded", nil), http.StatusTooManyRequests)
+		return false
+	}
+	return true
+}
+
+type clientRateLimitInterceptor struct {
+	*rateLimitInterceptor
+}
+
+// ClientRateLimitInterceptor limits the requests per authenticated user or client,
+// it must be called after the authorization, requests without authenticated user are not limited
+func ClientRateLimitInterceptor(limiter ratelimit.Checker) *clientRateLimitInterceptor {
+	return &clientRateLimitInterceptor{
+		rateLimitInterceptor: RateLimitInterceptor(limiter),
+	}
+}
+
+func (a *clientRateLimitInterceptor) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		client := authz.GetCtxData(r.Context()).UserID
+		if client != "" && !a.checkResult(w, r, a.limiter.AllowClient(r.Context(), client)) {
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
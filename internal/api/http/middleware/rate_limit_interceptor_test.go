@@ -0,0 +1,239 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+
+	"github.com/dennigogo/zitadel/internal/api/authz"
+	"github.com/dennigogo/zitadel/internal/i18n"
+	"github.com/dennigogo/zitadel/internal/ratelimit"
+)
+
+func Test_rateLimitInterceptor_Handler(t *testing.T) {
+	translator, err := i18n.NewTranslator(http.Dir("../../../static"), language.English, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	type args struct {
+		request        *http.Request
+		trustedProxies int
+		result         *ratelimit.Result
+	}
+	type res struct {
+		statusCode int
+		ip         string
+		headers    map[string]string
+	}
+	tests := []struct {
+		name string
+		args args
+		res  res
+	}{
+		{
+			"no limit",
+			args{
+				request: httptest.NewRequest("GET", "/oauth/v2/authorize?client_id=client", nil),
+				result:  &ratelimit.Result{Allowed: true},
+			},
+			res{
+				statusCode: 200,
+				ip:         "192.0.2.1",
+				headers:    map[string]string{},
+			},
+		},
+		{
+			"allowed",
+			args{
+				request: func() *http.Request {
+					r := httptest.NewRequest("POST", "/oauth/v2/token", strings.NewReader("grant_type=client_credentials"))
+					r.SetBasicAuth("client", "secret")
+					r.Header.Set("x-forwarded-for", "1.2.3.4")
+					return r
+				}(),
+				trustedProxies: 1,
+				result:         &ratelimit.Result{Allowed: true, Limit: 10, Remaining: 9},
+			},
+			res{
+				statusCode: 200,
+				ip:         "1.2.3.4",
+				headers: map[string]string{
+					"Ratelimit-Limit":     "10",
+					"Ratelimit-Remaining": "9",
+					"Ratelimit-Reset":     "0",
+				},
+			},
+		},
+		{
+			"exceeded",
+			args{
+				request: func() *http.Request {
+					r := httptest.NewRequest("POST", "/oauth/v2/token", strings.NewReader("client_id=client&grant_type=authorization_code"))
+					r.Header.Set("content-type", "application/x-www-form-urlencoded")
+					return r
+				}(),
+				result: &ratelimit.Result{Limit: 10, RetryAfter: 1},
+			},
+			res{
+				statusCode: 429,
+				ip:         "192.0.2.1",
+				headers: map[string]string{
+					"Ratelimit-Limit":     "10",
+					"Ratelimit-Remaining": "0",
+					"Ratelimit-Reset":     "0",
+					"Retry-After":         "1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limiter := &mockLimiter{result: tt.args.result, trustedProxies: tt.args.trustedProxies}
+			a := &rateLimitInterceptor{
+				limiter:    limiter,
+				translator: translator,
+			}
+			next := &testHandler{}
+			got := a.Handler(next)
+			rr := httptest.NewRecorder()
+			got.ServeHTTP(rr, tt.args.request)
+			assert.Equal(t, tt.res.statusCode, rr.Code)
+			assert.Empty(t, limiter.client, "clients must not be limited before the authentication")
+			assert.Equal(t, tt.res.ip, limiter.ip)
+			for key, value := range tt.res.headers {
+				assert.Equal(t, value, rr.Header().Get(key))
+			}
+			if len(tt.res.headers) == 0 {
+				assert.Empty(t, rr.Header().Get("Ratelimit-Limit"))
+			}
+		})
+	}
+}
+
+func Test_rateLimitInterceptor_remoteIP(t *testing.T) {
+	request := func(forwardedFor ...string) *http.Request {
+		r := httptest.NewRequest("GET", "/ui/login", nil)
+		for _, value := range forwardedFor {
+			r.Header.Add("x-forwarded-for", value)
+		}
+		return r
+	}
+	tests := []struct {
+		name           string
+		request        *http.Request
+		trustedProxies int
+		want           string
+	}{
+		{
+			"no trusted proxy, header ignored",
+			request("1.2.3.4"),
+			0,
+			"192.0.2.1",
+		},
+		{
+			"trusted proxy, spoofed entries ignored",
+			request("6.6.6.6, 1.2.3.4"),
+			1,
+			"1.2.3.4",
+		},
+		{
+			"two trusted proxies, multiple headers",
+			request("6.6.6.6, 1.2.3.4", "10.0.0.1"),
+			2,
+			"1.2.3.4",
+		},
+		{
+			"fewer entries than trusted proxies, remote address",
+			request("1.2.3.4"),
+			2,
+			"192.0.2.1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limiter := &mockLimiter{result: &ratelimit.Result{Allowed: true}, trustedProxies: tt.trustedProxies}
+			a := &rateLimitInterceptor{limiter: limiter}
+			a.Handler(&testHandler{}).ServeHTTP(httptest.NewRecorder(), tt.request)
+			assert.Equal(t, tt.want, limiter.ip)
+		})
+	}
+}
+
+func Test_clientRateLimitInterceptor_Handler(t *testing.T) {
+	translator, err := i18n.NewTranslator(http.Dir("../../../static"), language.English, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		result     *ratelimit.Result
+		statusCode int
+		client     string
+	}{
+		{
+			"unauthenticated, not limited",
+			context.Background(),
+			&ratelimit.Result{Limit: 10},
+			200,
+			"",
+		},
+		{
+			"authenticated, allowed",
+			authz.NewMockContext("instance", "org", "user"),
+			&ratelimit.Result{Allowed: true, Limit: 10, Remaining: 9},
+			200,
+			"user",
+		},
+		{
+			"authenticated, exceeded",
+			authz.NewMockContext("instance", "org", "user"),
+			&ratelimit.Result{Limit: 10, RetryAfter: 1},
+			429,
+			"user",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limiter := &mockLimiter{result: tt.result}
+			a := &clientRateLimitInterceptor{
+				rateLimitInterceptor: &rateLimitInterceptor{
+					limiter:    limiter,
+					translator: translator,
+				},
+			}
+			rr := httptest.NewRecorder()
+			a.Handler(&testHandler{}).ServeHTTP(rr, httptest.NewRequest("GET", "/scim/v2/org/Users", nil).WithContext(tt.ctx))
+			assert.Equal(t, tt.statusCode, rr.Code)
+			assert.Equal(t, tt.client, limiter.client)
+			assert.Empty(t, limiter.ip)
+		})
+	}
+}
+
+type mockLimiter struct {
+	result         *ratelimit.Result
+	trustedProxies int
+	client         string
+	ip             string
+}
+
+func (m *mockLimiter) Allow(_ context.Context, client, ip string) *ratelimit.Result {
+	m.client = client
+	m.ip = ip
+	return m.result
+}
+
+func (m *mockLimiter) AllowClient(_ context.Context, client string) *ratelimit.Result {
+	m.client = client
+	return m.result
+}
+
+func (m *mockLimiter) TrustedProxies() int {
+	return m.trustedProxies
+}
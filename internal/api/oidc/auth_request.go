@@ -11,12 +11,15 @@ import (
 
 	"github.com/dennigogo/zitadel/internal/api/authz"
 	"github.com/dennigogo/zitadel/internal/api/http/middleware"
+	"github.com/dennigogo/zitadel/internal/domain"
 	"github.com/dennigogo/zitadel/internal/errors"
 	"github.com/dennigogo/zitadel/internal/query"
 	"github.com/dennigogo/zitadel/internal/telemetry/tracing"
 	"github.com/dennigogo/zitadel/internal/user/model"
 )
 
+// CreateAuthRequest creates the auth request of the login,
+// the parameters of pushed authorization requests and request objects were already resolved and verified by the pushedAuthorization interceptor
 func (o *OPStorage) CreateAuthRequest(ctx context.Context, req *oidc.AuthRequest, userID string) (_ op.AuthRequest, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
@@ -47,6 +50,7 @@ func (o *OPStorage) AuthRequestByID(ctx context.Context, id string) (_ op.AuthRe
 	if err != nil {
 		return nil, err
 	}
+	setSessionUserAgentID(ctx, resp.AgentID)
 	return AuthRequestFromBusiness(resp)
 }
 
@@ -82,11 +86,19 @@ func (o *OPStorage) CreateAccessToken(ctx context.Context, req op.TokenRequest)
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 	var userAgentID, applicationID, userOrgID string
-	authReq, ok := req.(*AuthRequest)
-	if ok {
+	var actor *domain.TokenActor
+	switch authReq := req.(type) {
+	case *AuthRequest:
 		userAgentID = authReq.AgentID
 		applicationID = authReq.ApplicationID
 		userOrgID = authReq.UserOrgID
+	case *DeviceTokenRequest:
+		applicationID = authReq.ClientID
+		userOrgID = authReq.UserOrgID
+	case *ExchangeTokenRequest:
+		applicationID = authReq.clientID
+		userOrgID = authReq.resourceOwner
+		actor = authReq.actor
 	}
 
 	accessTokenLifetime, _, _, _, err := o.getOIDCSettings(ctx)
@@ -94,10 +106,11 @@ func (o *OPStorage) CreateAccessToken(ctx context.Context, req op.TokenRequest)
 		return "", time.Time{}, err
 	}
 
-	resp, err := o.command.AddUserToken(setContextUserSystem(ctx), userOrgID, userAgentID, applicationID, req.GetSubject(), req.GetAudience(), req.GetScopes(), accessTokenLifetime) //PLANNED: lifetime from client
+	resp, err := o.command.AddUserToken(setContextUserSystem(ctx), userOrgID, userAgentID, applicationID, req.GetSubject(), req.GetAudience(), req.GetScopes(), accessTokenLifetime, tokenConfirmationFromCtx(ctx), actor) //PLANNED: lifetime from client
 	if err != nil {
 		return "", time.Time{}, err
 	}
+	setSessionUserAgentID(ctx, userAgentID)
 	return resp.TokenID, resp.Expiration, nil
 }
 
@@ -120,13 +133,14 @@ func (o *OPStorage) CreateAccessAndRefreshTokens(ctx context.Context, req op.Tok
 
 	resp, token, err := o.command.AddAccessAndRefreshToken(setContextUserSystem(ctx), userOrgID, userAgentID, applicationID, req.GetSubject(),
 		refreshToken, req.GetAudience(), scopes, authMethodsReferences, accessTokenLifetime,
-		refreshTokenIdleExpiration, refreshTokenExpiration, authTime) //PLANNED: lifetime from client
+		refreshTokenIdleExpiration, refreshTokenExpiration, authTime, tokenConfirmationFromCtx(ctx)) //PLANNED: lifetime from client
 	if err != nil {
 		if errors.IsErrorInvalidArgument(err) {
 			err = oidc.ErrInvalidGrant().WithParent(err)
 		}
 		return "", "", time.Time{}, err
 	}
+	setSessionUserAgentID(ctx, userAgentID)
 	return resp.TokenID, token, resp.Expiration, nil
 }
 
@@ -139,6 +153,14 @@ func getInfoFromRequest(req op.TokenRequest) (string, string, string, time.Time,
 	if ok {
 		return refreshReq.UserAgentID, refreshReq.ClientID, "", refreshReq.AuthTime, refreshReq.AuthMethodsReferences
 	}
+	deviceReq, ok := req.(*DeviceTokenRequest)
+	if ok {
+		return "", deviceReq.ClientID, deviceReq.UserOrgID, deviceReq.AuthTime, deviceReq.AuthMethodsReferences
+	}
+	exchangeReq, ok := req.(*ExchangeTokenRequest)
+	if ok {
+		return "", exchangeReq.clientID, exchangeReq.resourceOwner, exchangeReq.authTime, nil
+	}
 	return "", "", "", time.Time{}, nil
 }
 
@@ -166,6 +188,11 @@ func (o *OPStorage) TerminateSession(ctx context.Context, userID, clientID strin
 	if len(userIDs) == 0 {
 		return nil
 	}
+	// the participating clients have to be determined before the session ends
+	issuer := op.IssuerFromContext(ctx)
+	for _, id := range userIDs {
+		addFrontChannelLogoutURIs(ctx, o.frontChannelLogoutURIs(ctx, issuer, userAgentID, id)...)
+	}
 	data := authz.CtxData{
 		UserID: userID,
 	}
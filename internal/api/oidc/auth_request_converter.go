@@ -23,6 +23,7 @@ const (
 	amrPWD          = "pwd"
 	amrMFA          = "mfa"
 	amrOTP          = "otp"
+	amrSMS          = "sms"
 	amrUserPresence = "user"
 )
 
@@ -55,6 +56,12 @@ func (a *AuthRequest) GetAMR() []string {
 	return amr
 }
 
+// AuthMethodsReferences returns the amr of the login,
+// it's used by the login to approve a device authorization
+func AuthMethodsReferences(authReq *domain.AuthRequest) []string {
+	return (&AuthRequest{AuthRequest: authReq}).GetAMR()
+}
+
 func (a *AuthRequest) GetAudience() []string {
 	return a.Audience
 }
@@ -279,8 +286,11 @@ func CodeChallengeToOIDC(challenge *domain.OIDCCodeChallenge) *oidc.CodeChalleng
 
 func AMRFromMFAType(mfaType domain.MFAType) string {
 	switch mfaType {
-	case domain.MFATypeOTP:
+	case domain.MFATypeOTP,
+		domain.MFATypeOTPEmail:
 		return amrOTP
+	case domain.MFATypeOTPSMS:
+		return amrSMS
 	case domain.MFATypeU2F,
 		domain.MFATypeU2FUserVerification:
 		return amrUserPresence
@@ -0,0 +1,324 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zitadel/logging"
+	"github.com/zitadel/oidc/v2/pkg/crypto"
+	"github.com/zitadel/oidc/v2/pkg/op"
+	"gopkg.in/square/go-jose.v2"
+
+	"github.com/dennigogo/zitadel/internal/api/authz"
+	http_utils "github.com/dennigogo/zitadel/internal/api/http"
+	"github.com/dennigogo/zitadel/internal/delivery"
+	"github.com/dennigogo/zitadel/internal/domain"
+	"github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/eventstore"
+	"github.com/dennigogo/zitadel/internal/eventstore/handler"
+	"github.com/dennigogo/zitadel/internal/eventstore/handler/crdb"
+	"github.com/dennigogo/zitadel/internal/id"
+	"github.com/dennigogo/zitadel/internal/query"
+	"github.com/dennigogo/zitadel/internal/query/projection"
+	"github.com/dennigogo/zitadel/internal/repository/user"
+)
+
+const (
+	BackChannelLogoutProjectionTable = "projections.oidc_backchannel_logouts"
+
+	// logoutTokenType is the `typ` header of the logout token (OpenID Connect Back-Channel Logout 1.0)
+	logoutTokenType        = "logout+jwt"
+	backChannelLogoutEvent = "http://schemas.openid.net/event/backchannel-logout"
+	logoutTokenLifetime    = 2 * time.Minute
+
+	backChannelLogoutKind delivery.Kind = "oidc_backchannel_logout"
+)
+
+type BackChannelLogoutConfig struct {
+	// Timeout of a single delivery attempt
+	Timeout time.Duration
+	// MaxAttempts until the delivery to a relying party is given up
+	MaxAttempts uint8
+	// InitialBackoff is doubled after each failed attempt up to the MaxBackoff
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// Interval in which the pending logouts are delivered
+	Interval time.Duration
+	// BatchSize is the maximum amount of logouts delivered per interval
+	BatchSize uint16
+	// MaxEventAge prevents the delivery of logouts, which happened longer ago (e.g. on the first start)
+	MaxEventAge time.Duration
+}
+
+func (c *BackChannelLogoutConfig) deliveryConfig() delivery.Config {
+	if c == nil {
+		return delivery.Config{}
+	}
+	return delivery.Config{
+		Timeout:        c.Timeout,
+		MaxAttempts:    c.MaxAttempts,
+		InitialBackoff: c.InitialBackoff,
+		MaxBackoff:     c.MaxBackoff,
+		Interval:       c.Interval,
+		BatchSize:      c.BatchSize,
+	}
+}
+
+// backChannelLogoutQueries are the queries used to find the relying parties of a session and the issuer
+type backChannelLogoutQueries interface {
+	sessionClientQueries
+	SearchInstanceDomains(ctx context.Context, queries *query.InstanceDomainSearchQueries) (*query.InstanceDomains, error)
+}
+
+type signingKeyGetter interface {
+	SigningKey(ctx context.Context) (op.SigningKey, error)
+}
+
+// StartBackChannelLogout starts the projection, which queues the logouts of
+// all relying parties which participated in a session, as soon as the session ended
+// and the worker, which delivers the logout tokens
+func StartBackChannelLogout(ctx context.Context, customConfig projection.CustomConfig, config *BackChannelLogoutConfig, externalPort uint16, externalSecure bool, queries *query.Queries, provider op.OpenIDProvider) {
+	storage, ok := provider.Storage().(*OPStorage)
+	if !ok {
+		logging.Panic("unable to start back-channel logout: unknown storage")
+	}
+	projectionConfig := projection.ApplyCustomConfig(customConfig)
+	projection.BackChannelLogoutProjection = newBackChannelLogoutProjection(ctx, projectionConfig, config, queries)
+	delivery.Start(ctx, projectionConfig.Client, backChannelLogoutKind, config.deliveryConfig(), &backChannelLogoutHandler{
+		queries:        queries,
+		keys:           storage,
+		idGenerator:    id.SonyFlakeGenerator(),
+		externalPort:   externalPort,
+		externalSecure: externalSecure,
+	})
+}
+
+type backChannelLogoutProjection struct {
+	crdb.StatementHandler
+	queries     backChannelLogoutQueries
+	maxEventAge time.Duration
+}
+
+func newBackChannelLogoutProjection(
+	ctx context.Context,
+	config crdb.StatementHandlerConfig,
+	logoutConfig *BackChannelLogoutConfig,
+	queries backChannelLogoutQueries,
+) *backChannelLogoutProjection {
+	p := new(backChannelLogoutProjection)
+	config.ProjectionName = BackChannelLogoutProjectionTable
+	config.Reducers = p.reducers()
+	p.StatementHandler = crdb.NewStatementHandler(ctx, config)
+	p.queries = queries
+	if logoutConfig != nil {
+		p.maxEventAge = logoutConfig.MaxEventAge
+	}
+	return p
+}
+
+func (p *backChannelLogoutProjection) reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: user.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  user.HumanSignedOutType,
+					Reduce: p.reduceSignedOut,
+				},
+				{
+					Event:  user.UserV1SignedOutType,
+					Reduce: p.reduceSignedOut,
+				},
+			},
+		},
+	}
+}
+
+// reduceSignedOut only queues the logouts, they are delivered by the worker
+// so a relying party which is slow or not reachable doesn't block the projection
+func (p *backChannelLogoutProjection) reduceSignedOut(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanSignedOutEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "OIDC-Wf3qa", "reduce.wrong.event.type %s", user.HumanSignedOutType)
+	}
+	logouts, err := p.queuedLogouts(e)
+	if err != nil {
+		return nil, err
+	}
+	statements := make([]func(eventstore.Event) crdb.Exec, 0, len(logouts))
+	for _, logout := range logouts {
+		payload, err := json.Marshal(logout)
+		if err != nil {
+			return nil, errors.ThrowInternal(err, "OIDC-Qb4lm", "unable to marshal logout")
+		}
+		statements = append(statements, delivery.AddEnqueueStatement(backChannelLogoutKind, logout.id(e.Sequence()), payload))
+	}
+	return crdb.NewMultiStatement(e, statements...), nil
+}
+
+// queuedLogout is the payload of a pending back-channel logout,
+// the uri of the client and the logout token are resolved when it's delivered
+type queuedLogout struct {
+	ClientID  string `json:"clientID"`
+	UserID    string `json:"userID"`
+	SessionID string `json:"sessionID"`
+}
+
+func (l *queuedLogout) id(sequence uint64) string {
+	return strconv.FormatUint(sequence, 10) + ":" + l.ClientID
+}
+
+// queuedLogouts returns the logouts of the clients with a back-channel logout uri,
+// which participated in the session until the sign out
+func (p *backChannelLogoutProjection) queuedLogouts(e *user.HumanSignedOutEvent) ([]*queuedLogout, error) {
+	if e.UserAgentID == "" || (p.maxEventAge > 0 && time.Since(e.CreationDate()) > p.maxEventAge) {
+		return nil, nil
+	}
+	ctx := authz.WithInstanceID(context.Background(), e.Aggregate().InstanceID)
+	// only the clients until the sign out are considered, the user might have signed in again since
+	clientIDs, err := p.queries.OIDCSessionClientIDs(ctx, e.UserAgentID, e.Aggregate().ID, e.Sequence())
+	if err != nil {
+		return nil, err
+	}
+	logouts := make([]*queuedLogout, 0, len(clientIDs))
+	for _, clientID := range clientIDs {
+		app, err := p.queries.AppByOIDCClientID(ctx, clientID)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		if app.OIDCConfig == nil || app.OIDCConfig.BackChannelLogoutURI == "" {
+			continue
+		}
+		logouts = append(logouts, &queuedLogout{
+			ClientID:  clientID,
+			UserID:    e.Aggregate().ID,
+			SessionID: domain.OIDCSessionID(e.UserAgentID, e.Aggregate().ID),
+		})
+	}
+	return logouts, nil
+}
+
+var _ delivery.Handler = (*backChannelLogoutHandler)(nil)
+
+// backChannelLogoutHandler creates the logout tokens of the queued logouts
+type backChannelLogoutHandler struct {
+	queries        backChannelLogoutQueries
+	keys           signingKeyGetter
+	idGenerator    id.Generator
+	externalPort   uint16
+	externalSecure bool
+}
+
+// Request reads the current back-channel logout uri of the client
+// and creates the logout token for every attempt, so it doesn't expire while the delivery is retried
+func (h *backChannelLogoutHandler) Request(ctx context.Context, d *delivery.Delivery) (*http.Request, error) {
+	logout := new(queuedLogout)
+	if err := json.Unmarshal(d.Payload, logout); err != nil {
+		return nil, errors.ThrowInvalidArgument(err, "OIDC-Rb3kd", "unable to unmarshal logout")
+	}
+	app, err := h.queries.AppByOIDCClientID(ctx, logout.ClientID)
+	if err != nil {
+		return nil, err
+	}
+	if app.OIDCConfig == nil || app.OIDCConfig.BackChannelLogoutURI == "" {
+		return nil, errors.ThrowPreconditionFailed(nil, "OIDC-Rb7wq", "back-channel logout uri removed")
+	}
+	ctx, issuer, err := h.issuer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	token, err := h.logoutToken(ctx, issuer, logout)
+	if err != nil {
+		return nil, err
+	}
+	body := url.Values{"logout_token": {token}}.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, app.OIDCConfig.BackChannelLogoutURI, strings.NewReader(body))
+	if err != nil {
+		return nil, errors.ThrowInvalidArgument(err, "OIDC-Pw2nf", "invalid back-channel logout uri")
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return req, nil
+}
+
+// Attempted logs the failed logouts, a relying party which is not reachable doesn't block the logout of the others
+func (h *backChannelLogoutHandler) Attempted(_ context.Context, d *delivery.Delivery, result *delivery.Result) error {
+	logging.WithFields("instance", d.InstanceID, "id", d.ID, "attempts", result.Attempts, "final", result.Final).
+		OnError(result.Err).Warn("unable to deliver back-channel logout")
+	return nil
+}
+
+// issuer returns the issuer of the instance based on its primary domain
+func (h *backChannelLogoutHandler) issuer(ctx context.Context) (context.Context, string, error) {
+	primary, err := query.NewInstanceDomainPrimarySearchQuery(true)
+	if err != nil {
+		return ctx, "", err
+	}
+	domains, err := h.queries.SearchInstanceDomains(ctx, &query.InstanceDomainSearchQueries{
+		Queries: []query.SearchQuery{primary},
+	})
+	if err != nil {
+		return ctx, "", err
+	}
+	if len(domains.Domains) < 1 {
+		return ctx, "", errors.ThrowInternal(nil, "OIDC-Gk2sf", "Errors.Internal")
+	}
+	ctx = authz.WithRequestedDomain(ctx, domains.Domains[0].Domain)
+	return ctx, http_utils.BuildHTTP(domains.Domains[0].Domain, h.externalPort, h.externalSecure), nil
+}
+
+type logoutTokenClaims struct {
+	Issuer     string              `json:"iss"`
+	Subject    string              `json:"sub"`
+	Audience   []string            `json:"aud"`
+	IssuedAt   int64               `json:"iat"`
+	Expiration int64               `json:"exp"`
+	JWTID      string              `json:"jti"`
+	SessionID  string              `json:"sid,omitempty"`
+	Events     map[string]struct{} `json:"events"`
+}
+
+func (h *backChannelLogoutHandler) logoutToken(ctx context.Context, issuer string, logout *queuedLogout) (string, error) {
+	tokenID, err := h.idGenerator.Next()
+	if err != nil {
+		return "", err
+	}
+	now := time.Now().UTC()
+	claims := &logoutTokenClaims{
+		Issuer:     issuer,
+		Subject:    logout.UserID,
+		Audience:   []string{logout.ClientID},
+		IssuedAt:   now.Unix(),
+		Expiration: now.Add(logoutTokenLifetime).Unix(),
+		JWTID:      tokenID,
+		SessionID:  logout.SessionID,
+		Events: map[string]struct{}{
+			backChannelLogoutEvent: {},
+		},
+	}
+	signingKey, err := h.keys.SigningKey(ctx)
+	if err != nil {
+		return "", err
+	}
+	signer, err := jose.NewSigner(
+		jose.SigningKey{
+			Algorithm: signingKey.SignatureAlgorithm(),
+			Key: &jose.JSONWebKey{
+				Key:   signingKey.Key(),
+				KeyID: signingKey.ID(),
+			},
+		},
+		(&jose.SignerOptions{}).WithType(logoutTokenType),
+	)
+	if err != nil {
+		return "", errors.ThrowInternal(err, "OIDC-Kd8wq", "unable to create signer")
+	}
+	return crypto.Sign(claims, signer)
+}
@@ -0,0 +1,251 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zitadel/oidc/v2/pkg/op"
+	"gopkg.in/square/go-jose.v2"
+
+	"github.com/dennigogo/zitadel/internal/delivery"
+	"github.com/dennigogo/zitadel/internal/domain"
+	"github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/eventstore/repository"
+	id_mock "github.com/dennigogo/zitadel/internal/id/mock"
+	"github.com/dennigogo/zitadel/internal/query"
+	"github.com/dennigogo/zitadel/internal/repository/user"
+)
+
+type testLogoutQueries struct {
+	clientIDs []string
+	apps      map[string]*query.App
+	domain    string
+}
+
+func (q *testLogoutQueries) OIDCSessionClientIDs(context.Context, string, string, uint64) ([]string, error) {
+	return q.clientIDs, nil
+}
+
+func (q *testLogoutQueries) AppByOIDCClientID(_ context.Context, clientID string) (*query.App, error) {
+	app, ok := q.apps[clientID]
+	if !ok {
+		return nil, errors.ThrowNotFound(nil, "QUERY-Test1", "Errors.App.NotFound")
+	}
+	return app, nil
+}
+
+func (q *testLogoutQueries) SearchInstanceDomains(context.Context, *query.InstanceDomainSearchQueries) (*query.InstanceDomains, error) {
+	return &query.InstanceDomains{
+		Domains: []*query.InstanceDomain{{Domain: q.domain, IsPrimary: true}},
+	}, nil
+}
+
+func testApp(clientID, backChannelLogoutURI, frontChannelLogoutURI string) *query.App {
+	return &query.App{
+		OIDCConfig: &query.OIDCApp{
+			ClientID:              clientID,
+			BackChannelLogoutURI:  backChannelLogoutURI,
+			FrontChannelLogoutURI: frontChannelLogoutURI,
+		},
+	}
+}
+
+type testSigningKey struct {
+	key *rsa.PrivateKey
+}
+
+func (k *testSigningKey) SignatureAlgorithm() jose.SignatureAlgorithm {
+	return jose.RS256
+}
+
+func (k *testSigningKey) Key() interface{} {
+	return k.key
+}
+
+func (k *testSigningKey) ID() string {
+	return "key1"
+}
+
+func (k *testSigningKey) SigningKey(context.Context) (op.SigningKey, error) {
+	return k, nil
+}
+
+func signedOutEvent(t *testing.T, userAgentID string, creationDate time.Time) *user.HumanSignedOutEvent {
+	t.Helper()
+	data, err := json.Marshal(map[string]string{"userAgentID": userAgentID})
+	require.NoError(t, err)
+	event, err := user.HumanSignedOutEventMapper(&repository.Event{
+		AggregateID:   "user1",
+		AggregateType: repository.AggregateType(user.AggregateType),
+		ResourceOwner: sql.NullString{String: "org1", Valid: true},
+		InstanceID:    "instance1",
+		Type:          repository.EventType(user.HumanSignedOutType),
+		Sequence:      15,
+		CreationDate:  creationDate,
+		Data:          data,
+	})
+	require.NoError(t, err)
+	return event.(*user.HumanSignedOutEvent)
+}
+
+func Test_backChannelLogoutProjection_queuedLogouts(t *testing.T) {
+	queries := &testLogoutQueries{
+		clientIDs: []string{"client1", "client2", "removed", "client3"},
+		apps: map[string]*query.App{
+			"client1": testApp("client1", "https://rp1.example.com/logout", ""),
+			"client2": testApp("client2", "", "https://rp2.example.com/logout"),
+			"client3": testApp("client3", "https://rp3.example.com/logout", ""),
+		},
+	}
+	tests := []struct {
+		name  string
+		event *user.HumanSignedOutEvent
+		want  []*queuedLogout
+	}{
+		{
+			name:  "without user agent, no logouts",
+			event: signedOutEvent(t, "", time.Now()),
+			want:  nil,
+		},
+		{
+			name:  "event too old, no logouts",
+			event: signedOutEvent(t, "agent1", time.Now().Add(-2*time.Hour)),
+			want:  nil,
+		},
+		{
+			name:  "clients with back-channel logout uri",
+			event: signedOutEvent(t, "agent1", time.Now()),
+			want: []*queuedLogout{
+				{ClientID: "client1", UserID: "user1", SessionID: domain.OIDCSessionID("agent1", "user1")},
+				{ClientID: "client3", UserID: "user1", SessionID: domain.OIDCSessionID("agent1", "user1")},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &backChannelLogoutProjection{queries: queries, maxEventAge: time.Hour}
+			got, err := p.queuedLogouts(tt.event)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_queuedLogout_id(t *testing.T) {
+	logout := &queuedLogout{ClientID: "client1"}
+	assert.Equal(t, "15:client1", logout.id(15))
+}
+
+func Test_backChannelLogoutHandler_Request(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	queries := &testLogoutQueries{
+		apps: map[string]*query.App{
+			"client1": testApp("client1", "https://rp1.example.com/logout", ""),
+			"client2": testApp("client2", "", ""),
+		},
+		domain: "instance.example.com",
+	}
+	idGenerator := id_mock.NewMockGenerator(gomock.NewController(t))
+	idGenerator.EXPECT().Next().Return("token1", nil).AnyTimes()
+	payload := func(clientID string) []byte {
+		data, err := json.Marshal(&queuedLogout{ClientID: clientID, UserID: "user1", SessionID: "agent1:user1"})
+		require.NoError(t, err)
+		return data
+	}
+	tests := []struct {
+		name     string
+		clientID string
+		wantErr  func(error) bool
+	}{
+		{
+			name:     "client removed, given up",
+			clientID: "removed",
+			wantErr:  errors.IsNotFound,
+		},
+		{
+			name:     "back-channel logout uri removed, given up",
+			clientID: "client2",
+			wantErr:  errors.IsPreconditionFailed,
+		},
+		{
+			name:     "logout token",
+			clientID: "client1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &backChannelLogoutHandler{
+				queries:        queries,
+				keys:           &testSigningKey{key: key},
+				idGenerator:    idGenerator,
+				externalPort:   443,
+				externalSecure: true,
+			}
+			req, err := h.Request(context.Background(), &delivery.Delivery{
+				InstanceID: "instance1",
+				ID:         "15:" + tt.clientID,
+				Payload:    payload(tt.clientID),
+			})
+			if tt.wantErr != nil {
+				assert.True(t, tt.wantErr(err), "unexpected error: %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, http.MethodPost, req.Method)
+			assert.Equal(t, "https://rp1.example.com/logout", req.URL.String())
+			assert.Equal(t, "application/x-www-form-urlencoded", req.Header.Get("Content-Type"))
+			require.NoError(t, req.ParseForm())
+
+			token, err := jose.ParseSigned(req.PostForm.Get("logout_token"))
+			require.NoError(t, err)
+			require.Len(t, token.Signatures, 1)
+			assert.Equal(t, logoutTokenType, token.Signatures[0].Header.ExtraHeaders[jose.HeaderType])
+			assert.Equal(t, "key1", token.Signatures[0].Header.KeyID)
+			data, err := token.Verify(&key.PublicKey)
+			require.NoError(t, err)
+
+			claims := new(logoutTokenClaims)
+			require.NoError(t, json.Unmarshal(data, claims))
+			assert.Equal(t, "https://instance.example.com", claims.Issuer)
+			assert.Equal(t, "user1", claims.Subject)
+			assert.Equal(t, []string{"client1"}, claims.Audience)
+			assert.Equal(t, "agent1:user1", claims.SessionID)
+			assert.Equal(t, "token1", claims.JWTID)
+			assert.Equal(t, int64(logoutTokenLifetime.Seconds()), claims.Expiration-claims.IssuedAt)
+			assert.Contains(t, claims.Events, backChannelLogoutEvent)
+		})
+	}
+}
+
+func TestBackChannelLogoutConfig_deliveryConfig(t *testing.T) {
+	var config *BackChannelLogoutConfig
+	assert.Equal(t, delivery.Config{}, config.deliveryConfig())
+
+	config = &BackChannelLogoutConfig{
+		Timeout:        time.Second,
+		MaxAttempts:    3,
+		InitialBackoff: time.Second,
+		MaxBackoff:     10 * time.Second,
+		Interval:       time.Second,
+		BatchSize:      100,
+		MaxEventAge:    time.Hour,
+	}
+	assert.Equal(t, delivery.Config{
+		Timeout:        time.Second,
+		MaxAttempts:    3,
+		InitialBackoff: time.Second,
+		MaxBackoff:     10 * time.Second,
+		Interval:       time.Second,
+		BatchSize:      100,
+	}, config.deliveryConfig())
+}
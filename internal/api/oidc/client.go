@@ -15,6 +15,7 @@ import (
 
 	"github.com/dennigogo/zitadel/internal/actions"
 	"github.com/dennigogo/zitadel/internal/actions/object"
+	"github.com/dennigogo/zitadel/internal/actions/store"
 	"github.com/dennigogo/zitadel/internal/api/authz"
 	api_http "github.com/dennigogo/zitadel/internal/api/http"
 	"github.com/dennigogo/zitadel/internal/crypto"
@@ -143,6 +144,10 @@ func (o *OPStorage) SetUserinfoFromToken(ctx context.Context, userInfo oidc.User
 	if err != nil {
 		return errors.ThrowPermissionDenied(nil, "OIDC-Dsfb2", "token is not valid or has expired")
 	}
+	if token.DPoPJKT != "" || token.CertificateThumbprint != "" {
+		// the userinfo endpoint of the oidc library can't verify the possession of the key the token is bound to
+		return errors.ThrowPermissionDenied(nil, "OIDC-Cnf3u", "sender-constrained tokens are not supported")
+	}
 	if token.ApplicationID != "" {
 		app, err := o.query.AppByOIDCClientID(ctx, token.ApplicationID)
 		if err != nil {
@@ -170,7 +175,14 @@ func (o *OPStorage) SetUserinfoFromScopes(ctx context.Context, userInfo oidc.Use
 			}
 		}
 	}
-	return o.setUserinfo(ctx, userInfo, userID, applicationID, scopes)
+	err = o.setUserinfo(ctx, userInfo, userID, applicationID, scopes)
+	if err != nil {
+		return err
+	}
+	if sessionID := domain.OIDCSessionID(sessionUserAgentID(ctx), userID); sessionID != "" {
+		userInfo.AppendClaims(ClaimSessionID, sessionID)
+	}
+	return nil
 }
 
 func (o *OPStorage) SetIntrospectionFromToken(ctx context.Context, introspection oidc.IntrospectionResponse, tokenID, subject, clientID string) error {
@@ -197,6 +209,18 @@ func (o *OPStorage) SetIntrospectionFromToken(ctx context.Context, introspection
 			introspection.SetScopes(token.Scopes)
 			introspection.SetClientID(token.ApplicationID)
 			introspection.SetTokenType(oidc.BearerToken)
+			if token.DPoPJKT != "" {
+				introspection.SetTokenType(authz.DPoPTokenType)
+			}
+			if token.DPoPJKT != "" || token.CertificateThumbprint != "" {
+				introspection.AppendClaims(ClaimConfirmation, &authz.TokenConfirmation{
+					DPoPJKT:               token.DPoPJKT,
+					CertificateThumbprint: token.CertificateThumbprint,
+				})
+			}
+			if token.Actor != nil {
+				introspection.AppendClaims(ClaimActor, token.Actor)
+			}
 			introspection.SetExpiration(token.Expiration)
 			introspection.SetIssuedAt(token.CreationDate)
 			introspection.SetNotBefore(token.CreationDate)
@@ -383,7 +407,7 @@ func (o *OPStorage) userinfoFlows(ctx context.Context, resourceOwner string, use
 			apiFields,
 			action.Script,
 			action.Name,
-			append(actions.ActionToOptions(action), actions.WithHTTP(actionCtx), actions.WithLogger(actions.ServerLog))...,
+			append(actions.ActionToOptions(action, domain.FlowTypeCustomiseToken, domain.TriggerTypePreUserinfoCreation), actions.WithHTTP(actionCtx), actions.WithLogger(actions.ServerLog), actions.WithStore(actionCtx, store.New(o.command, o.query, action.ResourceOwner)))...,
 		)
 		cancel()
 		if err != nil {
@@ -436,6 +460,9 @@ func (o *OPStorage) GetPrivateClaimsFromScopes(ctx context.Context, userID, clie
 		}
 	}
 
+	if confirmation := tokenConfirmationFromCtx(ctx); !confirmation.IsZero() {
+		claims = appendClaim(claims, ClaimConfirmation, confirmation)
+	}
 	if len(roles) == 0 || clientID == "" {
 		return o.privateClaimsFlows(ctx, userID, claims)
 	}
@@ -538,7 +565,7 @@ func (o *OPStorage) privateClaimsFlows(ctx context.Context, userID string, claim
 			apiFields,
 			action.Script,
 			action.Name,
-			append(actions.ActionToOptions(action), actions.WithHTTP(actionCtx), actions.WithLogger(actions.ServerLog))...,
+			append(actions.ActionToOptions(action, domain.FlowTypeCustomiseToken, domain.TriggerTypePreAccessTokenCreation), actions.WithHTTP(actionCtx), actions.WithLogger(actions.ServerLog), actions.WithStore(actionCtx, store.New(o.command, o.query, action.ResourceOwner)))...,
 		)
 		cancel()
 		if err != nil {
@@ -0,0 +1,470 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/zitadel/logging"
+	httphelper "github.com/zitadel/oidc/v2/pkg/http"
+	"github.com/zitadel/oidc/v2/pkg/oidc"
+	"github.com/zitadel/oidc/v2/pkg/op"
+	str_utils "github.com/zitadel/oidc/v2/pkg/strings"
+
+	"github.com/dennigogo/zitadel/internal/api/authz"
+	http_utils "github.com/dennigogo/zitadel/internal/api/http"
+	"github.com/dennigogo/zitadel/internal/api/ui/login"
+	"github.com/dennigogo/zitadel/internal/crypto"
+	"github.com/dennigogo/zitadel/internal/domain"
+	caos_errs "github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/query"
+	"github.com/dennigogo/zitadel/internal/telemetry/tracing"
+)
+
+const (
+	// GrantTypeDeviceCode is the grant type of the OAuth 2.0 Device Authorization Grant (RFC 8628)
+	GrantTypeDeviceCode oidc.GrantType = "urn:ietf:params:oauth:grant-type:device_code"
+
+	defaultDeviceAuthEndpoint = "/oauth/v2/device_authorization"
+	deviceCodeBytes           = 32
+	userCodeRetries           = 3
+	// expiredDeviceAuthBatchSize limits the expired device authorizations removed per cleanup,
+	// the remaining ones are removed by the next cleanups
+	expiredDeviceAuthBatchSize = 100
+	expiredDeviceAuthTimeout   = time.Minute
+
+	errorAuthorizationPending = "authorization_pending"
+	errorAccessDenied         = "access_denied"
+	errorExpiredToken         = "expired_token"
+)
+
+type DeviceAuthConfig struct {
+	Lifetime        time.Duration
+	PollInterval    time.Duration
+	CleanupInterval time.Duration
+	UserCode        *UserCodeConfig
+}
+
+type UserCodeConfig struct {
+	CharSet      string
+	CharAmount   int
+	DashInterval int
+}
+
+type deviceAuthorizationRequest struct {
+	Scopes       oidc.SpaceDelimitedArray `schema:"scope"`
+	ClientID     string                   `schema:"client_id"`
+	ClientSecret string                   `schema:"client_secret"`
+}
+
+func (r *deviceAuthorizationRequest) SetClientID(clientID string) {
+	r.ClientID = clientID
+}
+
+func (r *deviceAuthorizationRequest) SetClientSecret(clientSecret string) {
+	r.ClientSecret = clientSecret
+}
+
+type deviceAuthorizationResponse struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete"`
+	ExpiresIn               int    `json:"expires_in"`
+	Interval                int    `json:"interval"`
+}
+
+type deviceAccessTokenRequest struct {
+	GrantType    oidc.GrantType `schema:"grant_type"`
+	DeviceCode   string         `schema:"device_code"`
+	ClientID     string         `schema:"client_id"`
+	ClientSecret string         `schema:"client_secret"`
+}
+
+func (r *deviceAccessTokenRequest) SetClientID(clientID string) {
+	r.ClientID = clientID
+}
+
+func (r *deviceAccessTokenRequest) SetClientSecret(clientSecret string) {
+	r.ClientSecret = clientSecret
+}
+
+// DeviceTokenRequest is the token request of an approved device authorization
+type DeviceTokenRequest struct {
+	*domain.DeviceAuth
+	audience []string
+}
+
+func (r *DeviceTokenRequest) GetAMR() []string {
+	return r.AuthMethodsReferences
+}
+
+func (r *DeviceTokenRequest) GetAudience() []string {
+	return r.audience
+}
+
+func (r *DeviceTokenRequest) GetAuthTime() time.Time {
+	return r.AuthTime
+}
+
+func (r *DeviceTokenRequest) GetClientID() string {
+	return r.ClientID
+}
+
+func (r *DeviceTokenRequest) GetScopes() []string {
+	return r.Scopes
+}
+
+func (r *DeviceTokenRequest) GetSubject() string {
+	return r.Subject
+}
+
+type deviceAuthQueries interface {
+	DeviceAuthByDeviceCode(ctx context.Context, shouldTriggerBulk bool, deviceCode string) (*domain.DeviceAuth, error)
+	ExpiredDeviceAuths(ctx context.Context, now time.Time, limit uint64) ([]*query.ExpiredDeviceAuth, error)
+}
+
+type deviceAuthCommands interface {
+	AddDeviceAuth(ctx context.Context, clientID, deviceCode, userCode string, expires time.Time, scopes []string) (string, *domain.ObjectDetails, error)
+	ExchangeDeviceAuth(ctx context.Context, id string) (*domain.ObjectDetails, error)
+	RemoveDeviceAuth(ctx context.Context, id string) (*domain.ObjectDetails, error)
+}
+
+// deviceAuthorization adds the Device Authorization Grant (RFC 8628) to the provider,
+// which is not (yet) supported by the oidc library.
+// The state is kept in the eventstore, so a device can poll any instance of ZITADEL.
+type deviceAuthorization struct {
+	provider       op.OpenIDProvider
+	storage        *OPStorage
+	queries        deviceAuthQueries
+	commands       deviceAuthCommands
+	config         *DeviceAuthConfig
+	endpoint       op.Endpoint
+	externalSecure bool
+}
+
+// registerDeviceAuthorization returns the device authorization endpoint, which is nil if it's not configured
+func registerDeviceAuthorization(ctx context.Context, router *mux.Router, provider op.OpenIDProvider, storage *OPStorage, config *DeviceAuthConfig, endpointConfig *EndpointConfig, externalSecure bool) *op.Endpoint {
+	if config == nil {
+		return nil
+	}
+	d := &deviceAuthorization{
+		provider:       provider,
+		storage:        storage,
+		queries:        storage.query,
+		commands:       storage.command,
+		config:         config,
+		endpoint:       op.NewEndpoint(defaultDeviceAuthEndpoint),
+		externalSecure: externalSecure,
+	}
+	if endpointConfig != nil && endpointConfig.DeviceAuth != nil {
+		d.endpoint = op.NewEndpointWithURL(endpointConfig.DeviceAuth.Path, endpointConfig.DeviceAuth.URL)
+	}
+	router.Use(d.interceptor)
+	router.HandleFunc(d.endpoint.Relative(), d.handleDeviceAuthorization)
+	if config.CleanupInterval > 0 {
+		go d.removeExpiredPeriodically(ctx, config.CleanupInterval)
+	}
+	return &d.endpoint
+}
+
+// interceptor handles the token requests of the device code grant
+func (d *deviceAuthorization) interceptor(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == d.provider.TokenEndpoint().Relative() && r.FormValue("grant_type") == string(GrantTypeDeviceCode) {
+			d.handleDeviceAccessToken(w, r)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (d *deviceAuthorization) handleDeviceAuthorization(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		op.RequestError(w, r, oidc.ErrInvalidRequest().WithDescription("device authorization request must be a POST"))
+		return
+	}
+	resp, err := d.createDeviceAuthorization(r)
+	if err != nil {
+		op.RequestError(w, r, err)
+		return
+	}
+	httphelper.MarshalJSON(w, resp)
+}
+
+func (d *deviceAuthorization) createDeviceAuthorization(r *http.Request) (_ *deviceAuthorizationResponse, err error) {
+	ctx, span := tracing.NewSpan(r.Context())
+	defer func() { span.EndWithError(err) }()
+
+	req := new(deviceAuthorizationRequest)
+	if err = op.ParseAuthenticatedTokenRequest(r, d.provider.Decoder(), req); err != nil {
+		return nil, err
+	}
+	if _, err = authenticateClient(ctx, d.storage, req.ClientID, req.ClientSecret, GrantTypeDeviceCode, true); err != nil {
+		return nil, err
+	}
+	scopes, err := d.storage.assertProjectRoleScopes(ctx, req.ClientID, req.Scopes)
+	if err != nil {
+		return nil, oidc.ErrServerError().WithParent(err)
+	}
+	deviceCode, err := generateDeviceCode()
+	if err != nil {
+		return nil, oidc.ErrServerError().WithParent(err)
+	}
+	expires := time.Now().UTC().Add(d.config.Lifetime)
+	var userCode string
+	for i := 0; i < userCodeRetries; i++ {
+		userCode, err = generateUserCode(d.config.UserCode)
+		if err != nil {
+			return nil, oidc.ErrServerError().WithParent(err)
+		}
+		_, _, err = d.commands.AddDeviceAuth(setContextUserSystem(ctx), req.ClientID, deviceCode, userCode, expires, scopes)
+		if !caos_errs.IsErrorAlreadyExists(err) {
+			break
+		}
+	}
+	if err != nil {
+		return nil, oidc.ErrServerError().WithParent(err)
+	}
+	verificationURI := http_utils.BuildOrigin(authz.GetInstance(ctx).RequestedHost(), d.externalSecure) + login.HandlerPrefix + login.EndpointDeviceAuth
+	return &deviceAuthorizationResponse{
+		DeviceCode:              deviceCode,
+		UserCode:                formatUserCode(userCode, d.config.UserCode.DashInterval),
+		VerificationURI:         verificationURI,
+		VerificationURIComplete: verificationURI + "?" + login.QueryUserCode + "=" + url.QueryEscape(userCode),
+		ExpiresIn:               int(d.config.Lifetime / time.Second),
+		Interval:                int(d.config.PollInterval / time.Second),
+	}, nil
+}
+
+func (d *deviceAuthorization) handleDeviceAccessToken(w http.ResponseWriter, r *http.Request) {
+	resp, err := d.deviceAccessToken(r)
+	if err != nil {
+		op.RequestError(w, r, err)
+		return
+	}
+	httphelper.MarshalJSON(w, resp)
+}
+
+func (d *deviceAuthorization) deviceAccessToken(r *http.Request) (_ *oidc.AccessTokenResponse, err error) {
+	ctx, span := tracing.NewSpan(r.Context())
+	defer func() { span.EndWithError(err) }()
+
+	req := new(deviceAccessTokenRequest)
+	if err = op.ParseAuthenticatedTokenRequest(r, d.provider.Decoder(), req); err != nil {
+		return nil, err
+	}
+	if req.DeviceCode == "" {
+		return nil, oidc.ErrInvalidRequest().WithDescription("device_code missing")
+	}
+	client, err := authenticateClient(ctx, d.storage, req.ClientID, req.ClientSecret, GrantTypeDeviceCode, true)
+	if err != nil {
+		return nil, err
+	}
+	deviceAuth, err := d.exchangeDeviceCode(ctx, req.ClientID, req.DeviceCode)
+	if err != nil {
+		return nil, err
+	}
+	audience, err := d.storage.audienceOfClient(ctx, deviceAuth.ClientID)
+	if err != nil {
+		return nil, oidc.ErrServerError().WithParent(err)
+	}
+	return d.createTokenResponse(ctx, &DeviceTokenRequest{DeviceAuth: deviceAuth, audience: audience}, client)
+}
+
+// exchangeDeviceCode returns the approved device authorization of the device code.
+// It's removed before the tokens are created, so the device code can only be exchanged once,
+// even if the device polls concurrently or the creation of the tokens fails.
+func (d *deviceAuthorization) exchangeDeviceCode(ctx context.Context, clientID, deviceCode string) (*domain.DeviceAuth, error) {
+	deviceAuth, err := d.queries.DeviceAuthByDeviceCode(ctx, true, deviceCode)
+	if err != nil {
+		if caos_errs.IsNotFound(err) {
+			return nil, oidc.ErrInvalidGrant().WithDescription("device_code invalid")
+		}
+		return nil, oidc.ErrServerError().WithParent(err)
+	}
+	if deviceAuth.ClientID != clientID {
+		return nil, oidc.ErrInvalidGrant().WithDescription("device_code was not issued for this client")
+	}
+	if deviceAuth.IsExpired() {
+		d.removeDeviceAuth(ctx, deviceAuth.ID)
+		return nil, &oidc.Error{ErrorType: errorExpiredToken}
+	}
+	switch deviceAuth.State {
+	case domain.DeviceAuthStateApproved:
+		// handled below
+	case domain.DeviceAuthStateDenied:
+		d.removeDeviceAuth(ctx, deviceAuth.ID)
+		return nil, &oidc.Error{ErrorType: errorAccessDenied}
+	default:
+		return nil, &oidc.Error{ErrorType: errorAuthorizationPending}
+	}
+	_, err = d.commands.ExchangeDeviceAuth(setContextUserSystem(ctx), deviceAuth.ID)
+	if caos_errs.IsNotFound(err) {
+		return nil, oidc.ErrInvalidGrant().WithDescription("device_code was already exchanged")
+	}
+	if err != nil {
+		return nil, oidc.ErrServerError().WithParent(err)
+	}
+	return deviceAuth, nil
+}
+
+func (d *deviceAuthorization) createTokenResponse(ctx context.Context, req *DeviceTokenRequest, client op.Client) (*oidc.AccessTokenResponse, error) {
+	var (
+		tokenID, refreshToken string
+		exp                   time.Time
+		err                   error
+	)
+	if str_utils.Contains(req.Scopes, oidc.ScopeOfflineAccess) && op.ValidateGrantType(client, oidc.GrantTypeRefreshToken) {
+		tokenID, refreshToken, exp, err = d.storage.CreateAccessAndRefreshTokens(ctx, req, "")
+	} else {
+		tokenID, exp, err = d.storage.CreateAccessToken(ctx, req)
+	}
+	if err != nil {
+		return nil, err
+	}
+	var accessToken string
+	if client.AccessTokenType() == op.AccessTokenTypeJWT {
+		accessToken, err = op.CreateJWT(ctx, op.IssuerFromContext(ctx), req, exp, tokenID, client, d.storage)
+	} else {
+		accessToken, err = op.CreateBearerToken(tokenID, req.GetSubject(), d.provider.Crypto())
+	}
+	if err != nil {
+		return nil, err
+	}
+	var idToken string
+	if str_utils.Contains(req.Scopes, oidc.ScopeOpenID) {
+		idToken, err = op.CreateIDToken(ctx, op.IssuerFromContext(ctx), req, client.IDTokenLifetime(), accessToken, "", d.storage, client)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &oidc.AccessTokenResponse{
+		AccessToken:  accessToken,
+		IDToken:      idToken,
+		RefreshToken: refreshToken,
+		TokenType:    oidc.BearerToken,
+		ExpiresIn:    uint64(exp.Add(client.ClockSkew()).Sub(time.Now().UTC()).Seconds()),
+	}, nil
+}
+
+// authenticateClient authenticates the client of grants which are not handled by the oidc library
+// and checks if it's allowed to use the grant type
+func authenticateClient(ctx context.Context, storage *OPStorage, clientID, clientSecret string, grantType oidc.GrantType, allowPublic bool) (op.Client, error) {
+	if clientID == "" {
+		return nil, oidc.ErrInvalidClient().WithDescription("client_id missing")
+	}
+	client, err := storage.GetClientByClientID(ctx, clientID)
+	if err != nil {
+		return nil, oidc.ErrInvalidClient().WithParent(err)
+	}
+	switch client.AuthMethod() {
+	case oidc.AuthMethodNone:
+		if !allowPublic {
+			return nil, oidc.ErrInvalidClient().WithDescription("client must be authenticated for %s grant", grantType)
+		}
+	case oidc.AuthMethodBasic, oidc.AuthMethodPost:
+		if err = op.AuthorizeClientIDSecret(ctx, clientID, clientSecret, storage); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, oidc.ErrInvalidClient().WithDescription("auth method of client is not supported for %s grant", grantType)
+	}
+	if !op.ValidateGrantType(client, grantType) {
+		return nil, oidc.ErrUnauthorizedClient().WithDescription("%s grant not allowed for client", grantType)
+	}
+	return client, nil
+}
+
+func (d *deviceAuthorization) removeDeviceAuth(ctx context.Context, id string) {
+	_, err := d.commands.RemoveDeviceAuth(setContextUserSystem(ctx), id)
+	logging.WithFields("id", id).OnError(err).Warn("unable to remove device authorization")
+}
+
+// removeExpiredPeriodically removes the device authorizations, which expired before the device polled them,
+// so their device and user codes don't stay reserved
+func (d *deviceAuthorization) removeExpiredPeriodically(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			removeCtx, cancel := context.WithTimeout(ctx, expiredDeviceAuthTimeout)
+			d.removeExpired(removeCtx)
+			cancel()
+		}
+	}
+}
+
+func (d *deviceAuthorization) removeExpired(ctx context.Context) {
+	expired, err := d.queries.ExpiredDeviceAuths(ctx, time.Now(), expiredDeviceAuthBatchSize)
+	if err != nil {
+		logging.WithError(err).Warn("unable to query expired device authorizations")
+		return
+	}
+	for _, deviceAuth := range expired {
+		_, err = d.commands.RemoveDeviceAuth(setContextUserSystem(authz.WithInstanceID(ctx, deviceAuth.InstanceID)), deviceAuth.ID)
+		if caos_errs.IsNotFound(err) {
+			// already removed by another instance of ZITADEL
+			continue
+		}
+		logging.WithFields("instance", deviceAuth.InstanceID, "id", deviceAuth.ID).OnError(err).Warn("unable to remove expired device authorization")
+	}
+}
+
+// audienceOfClient returns the same audience as for an auth request of the client:
+// all clients of the project and the project itself
+func (o *OPStorage) audienceOfClient(ctx context.Context, clientID string) ([]string, error) {
+	projectID, err := o.query.ProjectIDFromOIDCClientID(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+	projectIDQuery, err := query.NewAppProjectIDSearchQuery(projectID)
+	if err != nil {
+		return nil, err
+	}
+	audience, err := o.query.SearchClientIDs(ctx, &query.AppSearchQueries{Queries: []query.SearchQuery{projectIDQuery}})
+	if err != nil {
+		return nil, err
+	}
+	for _, aud := range audience {
+		if aud == projectID {
+			return audience, nil
+		}
+	}
+	return append(audience, projectID), nil
+}
+
+func generateDeviceCode() (string, error) {
+	code := make([]byte, deviceCodeBytes)
+	if _, err := rand.Read(code); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(code), nil
+}
+
+// generateUserCode returns the code without dashes, they're only added for displaying
+func generateUserCode(config *UserCodeConfig) (string, error) {
+	return crypto.GenerateRandomString(uint(config.CharAmount), []rune(config.CharSet))
+}
+
+func formatUserCode(code string, dashInterval int) string {
+	if dashInterval <= 0 {
+		return code
+	}
+	var formatted strings.Builder
+	for i, char := range code {
+		if i > 0 && i%dashInterval == 0 {
+			formatted.WriteRune('-')
+		}
+		formatted.WriteRune(char)
+	}
+	return formatted.String()
+}
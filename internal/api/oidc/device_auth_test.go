@@ -0,0 +1,242 @@
+package oidc
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zitadel/oidc/v2/pkg/oidc"
+
+	"github.com/dennigogo/zitadel/internal/api/authz"
+	"github.com/dennigogo/zitadel/internal/domain"
+	caos_errs "github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/query"
+)
+
+type testDeviceAuthQueries struct {
+	deviceAuth *domain.DeviceAuth
+	expired    []*query.ExpiredDeviceAuth
+	err        error
+}
+
+func (q *testDeviceAuthQueries) DeviceAuthByDeviceCode(_ context.Context, _ bool, deviceCode string) (*domain.DeviceAuth, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	if q.deviceAuth == nil || q.deviceAuth.DeviceCode != deviceCode {
+		return nil, caos_errs.ThrowNotFound(nil, "QUERY-Test1", "Errors.DeviceAuth.NotFound")
+	}
+	return q.deviceAuth, nil
+}
+
+func (q *testDeviceAuthQueries) ExpiredDeviceAuths(context.Context, time.Time, uint64) ([]*query.ExpiredDeviceAuth, error) {
+	return q.expired, q.err
+}
+
+type testDeviceAuthCommands struct {
+	exchangeErr error
+	removeErrs  map[string]error
+	exchanged   []string
+	removed     []string
+}
+
+func (c *testDeviceAuthCommands) AddDeviceAuth(context.Context, string, string, string, time.Time, []string) (string, *domain.ObjectDetails, error) {
+	return "", nil, caos_errs.ThrowUnimplemented(nil, "COMMAND-Test1", "not implemented")
+}
+
+func (c *testDeviceAuthCommands) ExchangeDeviceAuth(_ context.Context, id string) (*domain.ObjectDetails, error) {
+	if c.exchangeErr != nil {
+		return nil, c.exchangeErr
+	}
+	c.exchanged = append(c.exchanged, id)
+	return &domain.ObjectDetails{}, nil
+}
+
+func (c *testDeviceAuthCommands) RemoveDeviceAuth(ctx context.Context, id string) (*domain.ObjectDetails, error) {
+	c.removed = append(c.removed, authz.GetInstance(ctx).InstanceID()+":"+id)
+	return &domain.ObjectDetails{}, c.removeErrs[id]
+}
+
+func testDeviceAuth(state domain.DeviceAuthState, expires time.Time) *domain.DeviceAuth {
+	return &domain.DeviceAuth{
+		ID:         "device1",
+		ClientID:   "client1",
+		DeviceCode: "devicecode",
+		UserCode:   "ABCDEFGH",
+		Expires:    expires,
+		Scopes:     []string{"openid"},
+		State:      state,
+	}
+}
+
+func Test_deviceAuthorization_exchangeDeviceCode(t *testing.T) {
+	type fields struct {
+		queries  *testDeviceAuthQueries
+		commands *testDeviceAuthCommands
+	}
+	tests := []struct {
+		name          string
+		fields        fields
+		clientID      string
+		want          *domain.DeviceAuth
+		wantErr       error
+		wantExchanged []string
+		wantRemoved   []string
+	}{
+		{
+			name: "unknown device code",
+			fields: fields{
+				queries:  &testDeviceAuthQueries{},
+				commands: &testDeviceAuthCommands{},
+			},
+			clientID: "client1",
+			wantErr:  oidc.ErrInvalidGrant().WithDescription("device_code invalid"),
+		},
+		{
+			name: "query failed",
+			fields: fields{
+				queries:  &testDeviceAuthQueries{err: caos_errs.ThrowInternal(nil, "QUERY-Test2", "Errors.Internal")},
+				commands: &testDeviceAuthCommands{},
+			},
+			clientID: "client1",
+			wantErr:  oidc.ErrServerError(),
+		},
+		{
+			name: "other client",
+			fields: fields{
+				queries:  &testDeviceAuthQueries{deviceAuth: testDeviceAuth(domain.DeviceAuthStateApproved, time.Now().Add(time.Minute))},
+				commands: &testDeviceAuthCommands{},
+			},
+			clientID: "client2",
+			wantErr:  oidc.ErrInvalidGrant().WithDescription("device_code was not issued for this client"),
+		},
+		{
+			name: "expired, removed",
+			fields: fields{
+				queries:  &testDeviceAuthQueries{deviceAuth: testDeviceAuth(domain.DeviceAuthStateApproved, time.Now().Add(-time.Minute))},
+				commands: &testDeviceAuthCommands{},
+			},
+			clientID:    "client1",
+			wantErr:     &oidc.Error{ErrorType: errorExpiredToken},
+			wantRemoved: []string{":device1"},
+		},
+		{
+			name: "denied, removed",
+			fields: fields{
+				queries:  &testDeviceAuthQueries{deviceAuth: testDeviceAuth(domain.DeviceAuthStateDenied, time.Now().Add(time.Minute))},
+				commands: &testDeviceAuthCommands{},
+			},
+			clientID:    "client1",
+			wantErr:     &oidc.Error{ErrorType: errorAccessDenied},
+			wantRemoved: []string{":device1"},
+		},
+		{
+			name: "pending",
+			fields: fields{
+				queries:  &testDeviceAuthQueries{deviceAuth: testDeviceAuth(domain.DeviceAuthStateInitiated, time.Now().Add(time.Minute))},
+				commands: &testDeviceAuthCommands{},
+			},
+			clientID: "client1",
+			wantErr:  &oidc.Error{ErrorType: errorAuthorizationPending},
+		},
+		{
+			name: "exchanged concurrently",
+			fields: fields{
+				queries: &testDeviceAuthQueries{deviceAuth: testDeviceAuth(domain.DeviceAuthStateApproved, time.Now().Add(time.Minute))},
+				commands: &testDeviceAuthCommands{
+					exchangeErr: caos_errs.ThrowNotFound(nil, "COMMAND-Test2", "Errors.DeviceAuth.NotFound"),
+				},
+			},
+			clientID: "client1",
+			wantErr:  oidc.ErrInvalidGrant().WithDescription("device_code was already exchanged"),
+		},
+		{
+			name: "exchange failed",
+			fields: fields{
+				queries: &testDeviceAuthQueries{deviceAuth: testDeviceAuth(domain.DeviceAuthStateApproved, time.Now().Add(time.Minute))},
+				commands: &testDeviceAuthCommands{
+					exchangeErr: caos_errs.ThrowInternal(nil, "COMMAND-Test3", "Errors.Internal"),
+				},
+			},
+			clientID: "client1",
+			wantErr:  oidc.ErrServerError(),
+		},
+		{
+			name: "approved, exchanged",
+			fields: fields{
+				queries:  &testDeviceAuthQueries{deviceAuth: testDeviceAuth(domain.DeviceAuthStateApproved, time.Now().Add(time.Minute))},
+				commands: &testDeviceAuthCommands{},
+			},
+			clientID:      "client1",
+			want:          testDeviceAuth(domain.DeviceAuthStateApproved, time.Time{}),
+			wantExchanged: []string{"device1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &deviceAuthorization{
+				queries:  tt.fields.queries,
+				commands: tt.fields.commands,
+			}
+			got, err := d.exchangeDeviceCode(context.Background(), tt.clientID, "devicecode")
+			assertOIDCError(t, tt.wantErr, err)
+			if tt.want != nil {
+				require.NotNil(t, got)
+				got.Expires = time.Time{}
+			}
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantExchanged, tt.fields.commands.exchanged)
+			assert.Equal(t, tt.wantRemoved, tt.fields.commands.removed)
+		})
+	}
+}
+
+func Test_deviceAuthorization_removeExpired(t *testing.T) {
+	commands := &testDeviceAuthCommands{
+		removeErrs: map[string]error{
+			"device2": caos_errs.ThrowNotFound(nil, "COMMAND-Test4", "Errors.DeviceAuth.NotFound"),
+			"device3": caos_errs.ThrowInternal(nil, "COMMAND-Test5", "Errors.Internal"),
+		},
+	}
+	d := &deviceAuthorization{
+		queries: &testDeviceAuthQueries{
+			expired: []*query.ExpiredDeviceAuth{
+				{ID: "device1", InstanceID: "instance1"},
+				{ID: "device2", InstanceID: "instance1"},
+				{ID: "device3", InstanceID: "instance2"},
+				{ID: "device4", InstanceID: "instance2"},
+			},
+		},
+		commands: commands,
+	}
+	d.removeExpired(context.Background())
+	assert.Equal(t, []string{"instance1:device1", "instance1:device2", "instance2:device3", "instance2:device4"}, commands.removed)
+}
+
+func Test_formatUserCode(t *testing.T) {
+	tests := []struct {
+		code         string
+		dashInterval int
+		want         string
+	}{
+		{code: "ABCDEFGH", dashInterval: 4, want: "ABCD-EFGH"},
+		{code: "ABCDEFGHI", dashInterval: 3, want: "ABC-DEF-GHI"},
+		{code: "ABCDEFGH", dashInterval: 0, want: "ABCDEFGH"},
+		{code: "ABC", dashInterval: 4, want: "ABC"},
+	}
+	for _, tt := range tests {
+		got := formatUserCode(tt.code, tt.dashInterval)
+		assert.Equal(t, tt.want, got)
+		assert.Equal(t, tt.code, domain.NormalizeUserCode(got))
+	}
+}
+
+func Test_generateUserCode(t *testing.T) {
+	code, err := generateUserCode(&UserCodeConfig{CharSet: "BCDF", CharAmount: 8})
+	require.NoError(t, err)
+	assert.Len(t, code, 8)
+	assert.Empty(t, strings.Trim(code, "BCDF"))
+}
@@ -0,0 +1,57 @@
+package oidc
+
+import (
+	"net/http"
+
+	httphelper "github.com/zitadel/oidc/v2/pkg/http"
+	"github.com/zitadel/oidc/v2/pkg/oidc"
+	"github.com/zitadel/oidc/v2/pkg/op"
+
+	"github.com/dennigogo/zitadel/internal/api/authz"
+)
+
+type discoveryConfiguration struct {
+	*oidc.DiscoveryConfiguration
+	DeviceAuthorizationEndpoint        string   `json:"device_authorization_endpoint,omitempty"`
+	PushedAuthorizationRequestEndpoint string   `json:"pushed_authorization_request_endpoint,omitempty"`
+	RequirePushedAuthorizationRequests bool     `json:"require_pushed_authorization_requests"`
+	BackChannelLogoutSupported         bool     `json:"backchannel_logout_supported"`
+	BackChannelLogoutSessionSupported  bool     `json:"backchannel_logout_session_supported"`
+	FrontChannelLogoutSupported        bool     `json:"frontchannel_logout_supported"`
+	FrontChannelLogoutSessionSupported bool     `json:"frontchannel_logout_session_supported"`
+	DPoPSigningAlgValuesSupported      []string `json:"dpop_signing_alg_values_supported"`
+	TLSClientCertificateBoundTokens    bool     `json:"tls_client_certificate_bound_access_tokens"`
+}
+
+// discoveryInterceptor adds the endpoints, grant types, logout mechanisms and sender-constrained tokens,
+// which are implemented by ZITADEL instead of the oidc library, to the discovery
+// pushed authorization requests are only required by the clients configured to do so
+func discoveryInterceptor(provider op.OpenIDProvider, deviceAuthEndpoint, pushedAuthRequestEndpoint *op.Endpoint) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != oidc.DiscoveryEndpoint {
+				next.ServeHTTP(w, r)
+				return
+			}
+			config := &discoveryConfiguration{
+				DiscoveryConfiguration:             op.CreateDiscoveryConfig(r, provider, provider.Storage()),
+				BackChannelLogoutSupported:         true,
+				BackChannelLogoutSessionSupported:  true,
+				FrontChannelLogoutSupported:        true,
+				FrontChannelLogoutSessionSupported: true,
+				DPoPSigningAlgValuesSupported:      authz.DPoPSigningAlgorithms,
+				TLSClientCertificateBoundTokens:    true,
+			}
+			config.GrantTypesSupported = append(config.GrantTypesSupported, oidc.GrantTypeTokenExchange)
+			if deviceAuthEndpoint != nil {
+				config.GrantTypesSupported = append(config.GrantTypesSupported, GrantTypeDeviceCode)
+				config.DeviceAuthorizationEndpoint = deviceAuthEndpoint.Absolute(op.IssuerFromContext(r.Context()))
+			}
+			if pushedAuthRequestEndpoint != nil {
+				config.PushedAuthorizationRequestEndpoint = pushedAuthRequestEndpoint.Absolute(op.IssuerFromContext(r.Context()))
+				config.RequestURIParameterSupported = true
+			}
+			httphelper.MarshalJSON(w, config)
+		})
+	}
+}
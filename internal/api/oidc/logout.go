@@ -0,0 +1,176 @@
+package oidc
+
+import (
+	"context"
+	"html/template"
+	"net/http"
+	"net/url"
+
+	"github.com/zitadel/logging"
+	str_utils "github.com/zitadel/oidc/v2/pkg/strings"
+
+	"github.com/dennigogo/zitadel/internal/domain"
+	"github.com/dennigogo/zitadel/internal/query"
+)
+
+const (
+	// ClaimSessionID is the session id of the user on the user agent (OpenID Connect Front-Channel and Back-Channel Logout)
+	ClaimSessionID = "sid"
+)
+
+type sessionCtxKey struct{}
+
+// oidcSession holds the session information of a single request,
+// which is known only to some of the storage calls
+type oidcSession struct {
+	userAgentID            string
+	frontChannelLogoutURIs []string
+}
+
+// sessionInterceptor provides the (empty) session information for every request
+// and renders the front-channel logout page instead of the redirect of the end_session endpoint,
+// if there are relying parties to notify
+func sessionInterceptor(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		session := new(oidcSession)
+		next.ServeHTTP(
+			&frontChannelLogoutWriter{ResponseWriter: w, session: session},
+			r.WithContext(context.WithValue(r.Context(), sessionCtxKey{}, session)),
+		)
+	})
+}
+
+func sessionFromCtx(ctx context.Context) *oidcSession {
+	session, _ := ctx.Value(sessionCtxKey{}).(*oidcSession)
+	return session
+}
+
+func setSessionUserAgentID(ctx context.Context, userAgentID string) {
+	if session := sessionFromCtx(ctx); session != nil && userAgentID != "" {
+		session.userAgentID = userAgentID
+	}
+}
+
+func sessionUserAgentID(ctx context.Context) string {
+	if session := sessionFromCtx(ctx); session != nil {
+		return session.userAgentID
+	}
+	return ""
+}
+
+func addFrontChannelLogoutURIs(ctx context.Context, uris ...string) {
+	session := sessionFromCtx(ctx)
+	if session == nil {
+		return
+	}
+	for _, uri := range uris {
+		if !str_utils.Contains(session.frontChannelLogoutURIs, uri) {
+			session.frontChannelLogoutURIs = append(session.frontChannelLogoutURIs, uri)
+		}
+	}
+}
+
+// sessionClientQueries are the queries used to find the clients of a session
+type sessionClientQueries interface {
+	OIDCSessionClientIDs(ctx context.Context, userAgentID, userID string, sequence uint64) ([]string, error)
+	AppByOIDCClientID(ctx context.Context, clientID string) (*query.App, error)
+}
+
+func (o *OPStorage) frontChannelLogoutURIs(ctx context.Context, issuer, userAgentID, userID string) []string {
+	return sessionFrontChannelLogoutURIs(ctx, o.query, issuer, userAgentID, userID)
+}
+
+// sessionFrontChannelLogoutURIs returns the front-channel logout uris of all clients,
+// which participated in the session of the user on the user agent
+// the uris already contain the issuer and session id as query parameters
+func sessionFrontChannelLogoutURIs(ctx context.Context, queries sessionClientQueries, issuer, userAgentID, userID string) []string {
+	clientIDs, err := queries.OIDCSessionClientIDs(ctx, userAgentID, userID, 0)
+	if err != nil {
+		logging.WithError(err).Warn("unable to get clients of session")
+		return nil
+	}
+	uris := make([]string, 0, len(clientIDs))
+	for _, clientID := range clientIDs {
+		app, err := queries.AppByOIDCClientID(ctx, clientID)
+		if err != nil {
+			logging.WithError(err).WithField("client_id", clientID).Warn("unable to get client of session")
+			continue
+		}
+		uri, err := frontChannelLogoutURI(app, issuer, domain.OIDCSessionID(userAgentID, userID))
+		if err != nil {
+			logging.WithError(err).WithField("client_id", clientID).Warn("invalid front-channel logout uri")
+			continue
+		}
+		if uri != "" {
+			uris = append(uris, uri)
+		}
+	}
+	return uris
+}
+
+func frontChannelLogoutURI(app *query.App, issuer, sessionID string) (string, error) {
+	if app.OIDCConfig == nil || app.OIDCConfig.FrontChannelLogoutURI == "" {
+		return "", nil
+	}
+	uri, err := url.Parse(app.OIDCConfig.FrontChannelLogoutURI)
+	if err != nil {
+		return "", err
+	}
+	params := uri.Query()
+	params.Set("iss", issuer)
+	params.Set(ClaimSessionID, sessionID)
+	uri.RawQuery = params.Encode()
+	return uri.String(), nil
+}
+
+var frontChannelLogoutTemplate = template.Must(template.New("frontchannel_logout").Parse(`<!DOCTYPE html>
+<html>
+<head>
+	<meta charset="utf-8">
+	<title>Logout</title>
+</head>
+<body>
+	{{ range .URIs }}<iframe src="{{ . }}" style="display:none"></iframe>
+	{{ end }}
+	<script>
+		window.onload = function () { window.location.replace({{ .RedirectURI }}); };
+	</script>
+	<noscript><a href="{{ .RedirectURI }}">Continue</a></noscript>
+</body>
+</html>`))
+
+// frontChannelLogoutWriter replaces the redirect after the logout with a page,
+// which loads the front-channel logout uris of the relying parties in iframes and redirects afterwards
+type frontChannelLogoutWriter struct {
+	http.ResponseWriter
+	session     *oidcSession
+	intercepted bool
+}
+
+func (w *frontChannelLogoutWriter) WriteHeader(statusCode int) {
+	if statusCode != http.StatusFound || len(w.session.frontChannelLogoutURIs) == 0 {
+		w.ResponseWriter.WriteHeader(statusCode)
+		return
+	}
+	w.intercepted = true
+	redirectURI := w.Header().Get("Location")
+	w.Header().Del("Location")
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.ResponseWriter.WriteHeader(http.StatusOK)
+	err := frontChannelLogoutTemplate.Execute(w.ResponseWriter, &struct {
+		URIs        []string
+		RedirectURI string
+	}{
+		URIs:        w.session.frontChannelLogoutURIs,
+		RedirectURI: redirectURI,
+	})
+	logging.OnError(err).Warn("unable to render front-channel logout page")
+}
+
+func (w *frontChannelLogoutWriter) Write(b []byte) (int, error) {
+	if w.intercepted {
+		// the body of the redirect is replaced by the logout page
+		return len(b), nil
+	}
+	return w.ResponseWriter.Write(b)
+}
@@ -0,0 +1,147 @@
+package oidc
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dennigogo/zitadel/internal/domain"
+	"github.com/dennigogo/zitadel/internal/query"
+)
+
+func Test_frontChannelLogoutURI(t *testing.T) {
+	tests := []struct {
+		name    string
+		app     *query.App
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "no oidc app",
+			app:  &query.App{},
+			want: "",
+		},
+		{
+			name: "no front-channel logout uri",
+			app:  testApp("client1", "https://rp.example.com/backchannel", ""),
+			want: "",
+		},
+		{
+			name: "issuer and session id added",
+			app:  testApp("client1", "", "https://rp.example.com/logout"),
+			want: "https://rp.example.com/logout?iss=https%3A%2F%2Fissuer.example.com&sid=session1",
+		},
+		{
+			name: "existing query kept",
+			app:  testApp("client1", "", "https://rp.example.com/logout?tenant=1"),
+			want: "https://rp.example.com/logout?iss=https%3A%2F%2Fissuer.example.com&sid=session1&tenant=1",
+		},
+		{
+			name:    "invalid uri",
+			app:     testApp("client1", "", "://invalid"),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := frontChannelLogoutURI(tt.app, "https://issuer.example.com", "session1")
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_sessionFrontChannelLogoutURIs(t *testing.T) {
+	queries := &testLogoutQueries{
+		clientIDs: []string{"client1", "client2", "removed", "client3"},
+		apps: map[string]*query.App{
+			"client1": testApp("client1", "", "https://rp1.example.com/logout"),
+			"client2": testApp("client2", "https://rp2.example.com/logout", ""),
+			"client3": testApp("client3", "", "https://rp3.example.com/logout"),
+		},
+	}
+	sessionID := url.QueryEscape(domain.OIDCSessionID("agent1", "user1"))
+	got := sessionFrontChannelLogoutURIs(context.Background(), queries, "https://issuer.example.com", "agent1", "user1")
+	assert.Equal(t, []string{
+		"https://rp1.example.com/logout?iss=https%3A%2F%2Fissuer.example.com&sid=" + sessionID,
+		"https://rp3.example.com/logout?iss=https%3A%2F%2Fissuer.example.com&sid=" + sessionID,
+	}, got)
+}
+
+func Test_sessionInterceptor(t *testing.T) {
+	tests := []struct {
+		name         string
+		logoutURIs   []string
+		statusCode   int
+		wantCode     int
+		wantLocation string
+		wantBody     []string
+	}{
+		{
+			name:         "no front-channel logout, redirect",
+			statusCode:   http.StatusFound,
+			wantCode:     http.StatusFound,
+			wantLocation: "https://rp.example.com/loggedout",
+		},
+		{
+			name:         "front-channel logout without redirect, unchanged",
+			logoutURIs:   []string{"https://rp1.example.com/logout"},
+			statusCode:   http.StatusBadRequest,
+			wantCode:     http.StatusBadRequest,
+			wantLocation: "https://rp.example.com/loggedout",
+			wantBody:     []string{"redirect body"},
+		},
+		{
+			name:       "front-channel logout, page rendered",
+			logoutURIs: []string{"https://rp1.example.com/logout", "https://rp2.example.com/logout", "https://rp1.example.com/logout"},
+			statusCode: http.StatusFound,
+			wantCode:   http.StatusOK,
+			wantBody: []string{
+				`<iframe src="https://rp1.example.com/logout" style="display:none"></iframe>`,
+				`<iframe src="https://rp2.example.com/logout" style="display:none"></iframe>`,
+				`window.location.replace("https://rp.example.com/loggedout")`,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				setSessionUserAgentID(r.Context(), "agent1")
+				assert.Equal(t, "agent1", sessionUserAgentID(r.Context()))
+				addFrontChannelLogoutURIs(r.Context(), tt.logoutURIs...)
+				w.Header().Set("Location", "https://rp.example.com/loggedout")
+				w.WriteHeader(tt.statusCode)
+				w.Write([]byte("redirect body"))
+			})
+			rr := httptest.NewRecorder()
+			sessionInterceptor(next).ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/oidc/v1/end_session", nil))
+			assert.Equal(t, tt.wantCode, rr.Code)
+			assert.Equal(t, tt.wantLocation, rr.Header().Get("Location"))
+			for _, body := range tt.wantBody {
+				assert.Contains(t, rr.Body.String(), body)
+			}
+			if tt.wantCode == http.StatusOK {
+				assert.Equal(t, 2, strings.Count(rr.Body.String(), "<iframe"))
+				assert.NotContains(t, rr.Body.String(), "redirect body")
+			}
+		})
+	}
+}
+
+func Test_sessionFromCtx_withoutSession(t *testing.T) {
+	ctx := context.Background()
+	setSessionUserAgentID(ctx, "agent1")
+	addFrontChannelLogoutURIs(ctx, "https://rp.example.com/logout")
+	assert.Nil(t, sessionFromCtx(ctx))
+	assert.Empty(t, sessionUserAgentID(ctx))
+}
@@ -7,11 +7,13 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/gorilla/mux"
 	"github.com/rakyll/statik/fs"
 	"github.com/zitadel/oidc/v2/pkg/op"
 	"golang.org/x/text/language"
 
 	"github.com/dennigogo/zitadel/internal/api/assets"
+	"github.com/dennigogo/zitadel/internal/api/authz"
 	http_utils "github.com/dennigogo/zitadel/internal/api/http"
 	"github.com/dennigogo/zitadel/internal/api/http/middleware"
 	"github.com/dennigogo/zitadel/internal/api/ui/login"
@@ -40,16 +42,21 @@ type Config struct {
 	UserAgentCookieConfig             *middleware.UserAgentCookieConfig
 	Cache                             *middleware.CacheConfig
 	CustomEndpoints                   *EndpointConfig
+	DeviceAuth                        *DeviceAuthConfig
+	BackChannelLogout                 *BackChannelLogoutConfig
+	PushedAuthRequest                 *PushedAuthRequestConfig
 }
 
 type EndpointConfig struct {
-	Auth          *Endpoint
-	Token         *Endpoint
-	Introspection *Endpoint
-	Userinfo      *Endpoint
-	Revocation    *Endpoint
-	EndSession    *Endpoint
-	Keys          *Endpoint
+	Auth              *Endpoint
+	Token             *Endpoint
+	Introspection     *Endpoint
+	Userinfo          *Endpoint
+	Revocation        *Endpoint
+	EndSession        *Endpoint
+	Keys              *Endpoint
+	DeviceAuth        *Endpoint
+	PushedAuthRequest *Endpoint
 }
 
 type Endpoint struct {
@@ -71,14 +78,16 @@ type OPStorage struct {
 	encAlg                            crypto.EncryptionAlgorithm
 	locker                            crdb.Locker
 	assetAPIPrefix                    func(ctx context.Context) string
+	verifier                          *authz.TokenVerifier
+	authConfig                        authz.Config
 }
 
-func NewProvider(ctx context.Context, config Config, defaultLogoutRedirectURI string, externalSecure bool, command *command.Commands, query *query.Queries, repo repository.Repository, encryptionAlg crypto.EncryptionAlgorithm, cryptoKey []byte, es *eventstore.Eventstore, projections *sql.DB, userAgentCookie, instanceHandler func(http.Handler) http.Handler) (op.OpenIDProvider, error) {
+func NewProvider(ctx context.Context, config Config, defaultLogoutRedirectURI string, externalSecure, trustClientCertificateHeader bool, command *command.Commands, query *query.Queries, repo repository.Repository, encryptionAlg crypto.EncryptionAlgorithm, cryptoKey []byte, es *eventstore.Eventstore, projections *sql.DB, verifier *authz.TokenVerifier, authConfig authz.Config, userAgentCookie, instanceHandler func(http.Handler) http.Handler) (op.OpenIDProvider, error) {
 	opConfig, err := createOPConfig(config, defaultLogoutRedirectURI, cryptoKey)
 	if err != nil {
 		return nil, caos_errs.ThrowInternal(err, "OIDC-EGrqd", "cannot create op config: %w")
 	}
-	storage := newStorage(config, command, query, repo, encryptionAlg, es, projections, externalSecure)
+	storage := newStorage(config, command, query, repo, encryptionAlg, es, projections, verifier, authConfig, externalSecure)
 	options, err := createOptions(config, externalSecure, userAgentCookie, instanceHandler)
 	if err != nil {
 		return nil, caos_errs.ThrowInternal(err, "OIDC-D3gq1", "cannot create options: %w")
@@ -93,6 +102,16 @@ func NewProvider(ctx context.Context, config Config, defaultLogoutRedirectURI st
 	if err != nil {
 		return nil, caos_errs.ThrowInternal(err, "OIDC-DAtg3", "cannot create provider")
 	}
+	router, ok := provider.HttpHandler().(*mux.Router)
+	if !ok {
+		return nil, caos_errs.ThrowInternal(nil, "OIDC-Dv3k8", "unable to register additional endpoints and grants")
+	}
+	deviceAuthEndpoint := registerDeviceAuthorization(ctx, router, provider, storage, config.DeviceAuth, config.CustomEndpoints, externalSecure)
+	registerTokenExchange(router, provider, storage)
+	pushedAuthRequestEndpoint := registerPushedAuthorization(router, provider, storage, config.PushedAuthRequest, config.CustomEndpoints)
+	router.Use(discoveryInterceptor(provider, deviceAuthEndpoint, pushedAuthRequestEndpoint))
+	router.Use(sessionInterceptor)
+	router.Use(senderConstraintInterceptor(provider, trustClientCertificateHeader))
 	return provider, nil
 }
 
@@ -168,7 +187,7 @@ func customEndpoints(endpointConfig *EndpointConfig) []op.Option {
 	return options
 }
 
-func newStorage(config Config, command *command.Commands, query *query.Queries, repo repository.Repository, encAlg crypto.EncryptionAlgorithm, es *eventstore.Eventstore, projections *sql.DB, externalSecure bool) *OPStorage {
+func newStorage(config Config, command *command.Commands, query *query.Queries, repo repository.Repository, encAlg crypto.EncryptionAlgorithm, es *eventstore.Eventstore, projections *sql.DB, verifier *authz.TokenVerifier, authConfig authz.Config, externalSecure bool) *OPStorage {
 	return &OPStorage{
 		repo:                              repo,
 		command:                           command,
@@ -183,6 +202,8 @@ func newStorage(config Config, command *command.Commands, query *query.Queries,
 		encAlg:                            encAlg,
 		locker:                            crdb.NewLocker(projections, locksTable, signingKey),
 		assetAPIPrefix:                    assets.AssetAPI(externalSecure),
+		verifier:                          verifier,
+		authConfig:                        authConfig,
 	}
 }
 
@@ -0,0 +1,408 @@
+package oidc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	httphelper "github.com/zitadel/oidc/v2/pkg/http"
+	"github.com/zitadel/oidc/v2/pkg/oidc"
+	"github.com/zitadel/oidc/v2/pkg/op"
+	str_utils "github.com/zitadel/oidc/v2/pkg/strings"
+	"gopkg.in/square/go-jose.v2"
+
+	"github.com/dennigogo/zitadel/internal/crypto"
+	"github.com/dennigogo/zitadel/internal/domain"
+	"github.com/dennigogo/zitadel/internal/query"
+	"github.com/dennigogo/zitadel/internal/telemetry/tracing"
+)
+
+const (
+	defaultPushedAuthRequestEndpoint = "/oauth/v2/par"
+
+	paramRequest             = "request"
+	paramRequestURI          = "request_uri"
+	paramClientID            = "client_id"
+	paramClientSecret        = "client_secret"
+	paramClientAssertion     = "client_assertion"
+	paramClientAssertionType = "client_assertion_type"
+)
+
+// requestObjectRegisteredClaims are the claims of the request object (RFC 9101),
+// which are not passed as parameters of the authorization request
+var requestObjectRegisteredClaims = []string{"iss", "aud", "exp", "iat", "nbf", "jti", "sub", paramRequest, paramRequestURI}
+
+type PushedAuthRequestConfig struct {
+	Lifetime time.Duration
+}
+
+type pushedAuthorizationRequest struct {
+	ClientID            string `schema:"client_id"`
+	ClientSecret        string `schema:"client_secret"`
+	ClientAssertion     string `schema:"client_assertion"`
+	ClientAssertionType string `schema:"client_assertion_type"`
+	RequestParam        string `schema:"request"`
+	RequestURI          string `schema:"request_uri"`
+}
+
+func (r *pushedAuthorizationRequest) SetClientID(clientID string) {
+	r.ClientID = clientID
+}
+
+func (r *pushedAuthorizationRequest) SetClientSecret(clientSecret string) {
+	r.ClientSecret = clientSecret
+}
+
+type pushedAuthorizationResponse struct {
+	RequestURI string `json:"request_uri"`
+	ExpiresIn  int    `json:"expires_in"`
+}
+
+type pushedAuthorizationQueries interface {
+	AppByOIDCClientID(ctx context.Context, clientID string) (*query.App, error)
+	GetAuthNKeyPublicKeyByIDAndIdentifier(ctx context.Context, id string, identifier string) ([]byte, error)
+}
+
+type pushedAuthorizationCommands interface {
+	AddPushedAuthRequest(ctx context.Context, clientID string, params map[string][]string, expires time.Time) (string, *domain.ObjectDetails, error)
+	UsePushedAuthRequest(ctx context.Context, id, clientID string) (map[string][]string, error)
+	UseRequestObject(ctx context.Context, clientID, jwtID string, expires time.Time) (*domain.ObjectDetails, error)
+}
+
+// pushedAuthorization adds Pushed Authorization Requests (RFC 9126) to the provider
+// and verifies the request objects of JWT-Secured Authorization Requests (RFC 9101) against the keys of the client,
+// both are not (fully) supported by the oidc library.
+// The authorization request is always built from the pushed or verified parameters only.
+type pushedAuthorization struct {
+	provider op.OpenIDProvider
+	storage  *OPStorage
+	queries  pushedAuthorizationQueries
+	commands pushedAuthorizationCommands
+	config   *PushedAuthRequestConfig
+	endpoint *op.Endpoint
+}
+
+// registerPushedAuthorization returns the pushed authorization request endpoint, which is nil if it's not configured,
+// the request objects of the authorization endpoint are verified in any case
+func registerPushedAuthorization(router *mux.Router, provider op.OpenIDProvider, storage *OPStorage, config *PushedAuthRequestConfig, endpointConfig *EndpointConfig) *op.Endpoint {
+	p := &pushedAuthorization{
+		provider: provider,
+		storage:  storage,
+		queries:  storage.query,
+		commands: storage.command,
+		config:   config,
+	}
+	router.Use(p.interceptor)
+	if config == nil {
+		return nil
+	}
+	endpoint := op.NewEndpoint(defaultPushedAuthRequestEndpoint)
+	if endpointConfig != nil && endpointConfig.PushedAuthRequest != nil {
+		endpoint = op.NewEndpointWithURL(endpointConfig.PushedAuthRequest.Path, endpointConfig.PushedAuthRequest.URL)
+	}
+	p.endpoint = &endpoint
+	router.HandleFunc(p.endpoint.Relative(), p.handlePushedAuthorization)
+	return p.endpoint
+}
+
+// interceptor replaces the parameters of the authorization request
+// by the ones of the pushed authorization request or the verified request object
+func (p *pushedAuthorization) interceptor(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != p.provider.AuthorizationEndpoint().Relative() {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if err := p.resolveAuthorizeParams(r); err != nil {
+			op.RequestError(w, r, err)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (p *pushedAuthorization) resolveAuthorizeParams(r *http.Request) (err error) {
+	ctx, span := tracing.NewSpan(r.Context())
+	defer func() { span.EndWithError(err) }()
+
+	if err = r.ParseForm(); err != nil {
+		return oidc.ErrInvalidRequest().WithDescription("cannot parse form").WithParent(err)
+	}
+	clientID := r.Form.Get(paramClientID)
+	if clientID == "" {
+		// the oidc library will return the error of the missing client
+		return nil
+	}
+	app, err := p.queries.AppByOIDCClientID(ctx, clientID)
+	if err != nil {
+		return oidc.ErrInvalidRequest().WithDescription("unable to retrieve client by id").WithParent(err)
+	}
+	if app.OIDCConfig == nil {
+		return nil
+	}
+	var params url.Values
+	switch {
+	case r.Form.Get(paramRequestURI) != "":
+		params, err = p.usePushedAuthRequest(ctx, r.Form.Get(paramRequestURI), clientID)
+	case app.OIDCConfig.RequirePushedAuthRequests:
+		return oidc.ErrInvalidRequest().WithDescription("client must use pushed authorization requests")
+	case r.Form.Get(paramRequest) != "" || app.OIDCConfig.RequireSignedRequestObject:
+		params, err = p.verifyRequestObject(ctx, r.Form.Get(paramRequest), clientID, op.IssuerFromContext(ctx))
+	default:
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	r.Form = params
+	return nil
+}
+
+func (p *pushedAuthorization) usePushedAuthRequest(ctx context.Context, requestURI, clientID string) (url.Values, error) {
+	id, ok := domain.PushedAuthRequestIDFromURI(requestURI)
+	if !ok {
+		return nil, oidc.ErrRequestNotSupported().WithDescription("only request_uri of pushed authorization requests are supported")
+	}
+	params, err := p.commands.UsePushedAuthRequest(setContextUserSystem(ctx), id, clientID)
+	if err != nil {
+		return nil, oidc.ErrInvalidRequest().WithDescription("request_uri is invalid or expired").WithParent(err)
+	}
+	values := url.Values(params)
+	values.Set(paramClientID, clientID)
+	return values, nil
+}
+
+func (p *pushedAuthorization) handlePushedAuthorization(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		op.RequestError(w, r, oidc.ErrInvalidRequest().WithDescription("pushed authorization request must be a POST"))
+		return
+	}
+	resp, err := p.pushAuthorizationRequest(r)
+	if err != nil {
+		op.RequestError(w, r, err)
+		return
+	}
+	httphelper.MarshalJSONWithStatus(w, resp, http.StatusCreated)
+}
+
+func (p *pushedAuthorization) pushAuthorizationRequest(r *http.Request) (_ *pushedAuthorizationResponse, err error) {
+	ctx, span := tracing.NewSpan(r.Context())
+	defer func() { span.EndWithError(err) }()
+
+	req := new(pushedAuthorizationRequest)
+	if err = op.ParseAuthenticatedTokenRequest(r, p.provider.Decoder(), req); err != nil {
+		return nil, err
+	}
+	client, err := p.authenticateClient(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if req.RequestURI != "" {
+		return nil, oidc.ErrInvalidRequest().WithDescription("request_uri must not be pushed")
+	}
+	app, err := p.queries.AppByOIDCClientID(ctx, client.GetID())
+	if err != nil {
+		return nil, oidc.ErrServerError().WithParent(err)
+	}
+	params := authorizeParams(r.Form)
+	if req.RequestParam != "" || app.OIDCConfig.RequireSignedRequestObject {
+		params, err = p.verifyRequestObject(ctx, req.RequestParam, client.GetID(), op.IssuerFromContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+	}
+	params.Set(paramClientID, client.GetID())
+	authReq := new(oidc.AuthRequest)
+	if err = p.provider.Decoder().Decode(authReq, params); err != nil {
+		return nil, oidc.ErrInvalidRequest().WithDescription("cannot parse auth request").WithParent(err)
+	}
+	if _, err = op.ValidateAuthRequest(ctx, authReq, p.storage, p.provider.IDTokenHintVerifier(ctx)); err != nil {
+		return nil, err
+	}
+	id, _, err := p.commands.AddPushedAuthRequest(setContextUserSystem(ctx), client.GetID(), params, time.Now().UTC().Add(p.config.Lifetime))
+	if err != nil {
+		return nil, oidc.ErrServerError().WithParent(err)
+	}
+	return &pushedAuthorizationResponse{
+		RequestURI: domain.PushedAuthRequestURI(id),
+		ExpiresIn:  int(p.config.Lifetime / time.Second),
+	}, nil
+}
+
+// authenticateClient authenticates the client the same way as on the token endpoint,
+// public clients are allowed to push their authorization requests as well
+func (p *pushedAuthorization) authenticateClient(ctx context.Context, req *pushedAuthorizationRequest) (op.Client, error) {
+	if req.ClientAssertionType == oidc.ClientAssertionTypeJWTAssertion {
+		exchanger, ok := p.provider.(op.JWTAuthorizationGrantExchanger)
+		if !ok {
+			return nil, oidc.ErrInvalidClient().WithDescription("private_key_jwt is not supported")
+		}
+		client, err := op.AuthorizePrivateJWTKey(ctx, req.ClientAssertion, exchanger)
+		if err != nil {
+			return nil, oidc.ErrInvalidClient().WithParent(err)
+		}
+		if req.ClientID != "" && req.ClientID != client.GetID() {
+			return nil, oidc.ErrInvalidClient().WithDescription("client_id does not match the client_assertion")
+		}
+		return client, nil
+	}
+	if req.ClientID == "" {
+		return nil, oidc.ErrInvalidClient().WithDescription("client_id missing")
+	}
+	client, err := p.storage.GetClientByClientID(ctx, req.ClientID)
+	if err != nil {
+		return nil, oidc.ErrInvalidClient().WithParent(err)
+	}
+	switch client.AuthMethod() {
+	case oidc.AuthMethodNone:
+		return client, nil
+	case oidc.AuthMethodBasic, oidc.AuthMethodPost:
+		if err = op.AuthorizeClientIDSecret(ctx, req.ClientID, req.ClientSecret, p.storage); err != nil {
+			return nil, err
+		}
+		return client, nil
+	default:
+		return nil, oidc.ErrInvalidClient().WithDescription("client_assertion missing")
+	}
+}
+
+// authorizeParams returns the parameters of the authorization request without the client authentication
+func authorizeParams(form url.Values) url.Values {
+	params := make(url.Values, len(form))
+	for key, values := range form {
+		switch key {
+		case paramClientSecret, paramClientAssertion, paramClientAssertionType, paramRequest, paramRequestURI:
+			continue
+		}
+		params[key] = values
+	}
+	return params
+}
+
+type requestObjectClaims struct {
+	Issuer     string        `json:"iss"`
+	Audience   oidc.Audience `json:"aud"`
+	ClientID   string        `json:"client_id"`
+	Expiration int64         `json:"exp"`
+	NotBefore  int64         `json:"nbf"`
+	JWTID      string        `json:"jti"`
+}
+
+func (c *requestObjectClaims) SetSignatureAlgorithm(jose.SignatureAlgorithm) {}
+
+// verifyRequestObject verifies the request object (RFC 9101) with the keys of the client
+// and returns its claims as parameters of the authorization request.
+// The request object must expire and is accepted only once (by its jti), so an intercepted one can't be replayed.
+func (p *pushedAuthorization) verifyRequestObject(ctx context.Context, requestObject, clientID, issuer string) (_ url.Values, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if requestObject == "" {
+		return nil, oidc.ErrInvalidRequest().WithDescription("client must pass a signed request object")
+	}
+	claims := new(requestObjectClaims)
+	payload, err := oidc.ParseToken(requestObject, claims)
+	if err != nil {
+		return nil, oidc.ErrInvalidRequest().WithDescription("request object is invalid").WithParent(err)
+	}
+	if claims.Issuer != clientID || (claims.ClientID != "" && claims.ClientID != clientID) {
+		return nil, oidc.ErrInvalidRequest().WithDescription("request object must be issued by the client")
+	}
+	if !str_utils.Contains(claims.Audience, issuer) {
+		return nil, oidc.ErrInvalidRequest().WithDescription("audience of the request object must contain the issuer")
+	}
+	if claims.Expiration == 0 || claims.JWTID == "" {
+		return nil, oidc.ErrInvalidRequest().WithDescription("request object must contain exp and jti")
+	}
+	expiration := time.Unix(claims.Expiration, 0)
+	if expiration.Before(time.Now()) {
+		return nil, oidc.ErrInvalidRequest().WithDescription("request object is expired")
+	}
+	if claims.NotBefore != 0 && time.Unix(claims.NotBefore, 0).After(time.Now()) {
+		return nil, oidc.ErrInvalidRequest().WithDescription("request object is not valid yet")
+	}
+	if err = oidc.CheckSignature(ctx, requestObject, payload, claims, nil, &clientKeySet{queries: p.queries, clientID: clientID}); err != nil {
+		return nil, oidc.ErrInvalidRequest().WithDescription("signature of the request object is invalid").WithParent(err)
+	}
+	params, err := requestObjectParams(payload)
+	if err != nil {
+		return nil, oidc.ErrInvalidRequest().WithDescription("request object is invalid").WithParent(err)
+	}
+	// the jti is only used after the signature is verified, so others can't use up the jti of the client
+	if _, err = p.commands.UseRequestObject(setContextUserSystem(ctx), clientID, claims.JWTID, expiration); err != nil {
+		return nil, oidc.ErrInvalidRequest().WithDescription("request object was already used").WithParent(err)
+	}
+	params.Set(paramClientID, clientID)
+	return params, nil
+}
+
+// requestObjectParams converts the claims of the request object into parameters of the authorization request,
+// arrays are joined by spaces (e.g. scope and prompt)
+func requestObjectParams(payload []byte) (url.Values, error) {
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.UseNumber()
+	claims := make(map[string]interface{})
+	if err := decoder.Decode(&claims); err != nil {
+		return nil, err
+	}
+	params := make(url.Values, len(claims))
+	for key, claim := range claims {
+		if str_utils.Contains(requestObjectRegisteredClaims, key) {
+			continue
+		}
+		value, err := requestObjectParamValue(claim)
+		if err != nil {
+			return nil, fmt.Errorf("claim %s: %w", key, err)
+		}
+		params.Set(key, value)
+	}
+	return params, nil
+}
+
+func requestObjectParamValue(claim interface{}) (string, error) {
+	switch value := claim.(type) {
+	case string:
+		return value, nil
+	case json.Number:
+		return value.String(), nil
+	case bool:
+		return fmt.Sprint(value), nil
+	case []interface{}:
+		values := make([]string, len(value))
+		for i, v := range value {
+			s, ok := v.(string)
+			if !ok {
+				return "", fmt.Errorf("unsupported array value %v", v)
+			}
+			values[i] = s
+		}
+		return strings.Join(values, " "), nil
+	default:
+		return "", fmt.Errorf("unsupported value %v", claim)
+	}
+}
+
+// clientKeySet verifies the signature with the public keys registered on the application
+type clientKeySet struct {
+	queries  pushedAuthorizationQueries
+	clientID string
+}
+
+func (k *clientKeySet) VerifySignature(ctx context.Context, jws *jose.JSONWebSignature) ([]byte, error) {
+	keyID, _ := oidc.GetKeyIDAndAlg(jws)
+	publicKeyData, err := k.queries.GetAuthNKeyPublicKeyByIDAndIdentifier(ctx, keyID, k.clientID)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching keys: %w", err)
+	}
+	publicKey, err := crypto.BytesToPublicKey(publicKeyData)
+	if err != nil {
+		return nil, err
+	}
+	return jws.Verify(publicKey)
+}
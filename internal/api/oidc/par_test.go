@@ -0,0 +1,384 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zitadel/oidc/v2/pkg/oidc"
+	"github.com/zitadel/oidc/v2/pkg/op"
+	"gopkg.in/square/go-jose.v2"
+
+	"github.com/dennigogo/zitadel/internal/crypto"
+	"github.com/dennigogo/zitadel/internal/domain"
+	caos_errs "github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/query"
+)
+
+const testIssuer = "https://issuer.example.com"
+
+type testPARQueries struct {
+	apps map[string]*query.App
+	keys map[string][]byte
+}
+
+func (q *testPARQueries) AppByOIDCClientID(_ context.Context, clientID string) (*query.App, error) {
+	app, ok := q.apps[clientID]
+	if !ok {
+		return nil, caos_errs.ThrowNotFound(nil, "QUERY-Test1", "Errors.App.NotFound")
+	}
+	return app, nil
+}
+
+func (q *testPARQueries) GetAuthNKeyPublicKeyByIDAndIdentifier(_ context.Context, id string, identifier string) ([]byte, error) {
+	key, ok := q.keys[identifier+":"+id]
+	if !ok {
+		return nil, caos_errs.ThrowNotFound(nil, "QUERY-Test2", "Errors.AuthNKey.NotFound")
+	}
+	return key, nil
+}
+
+type testPARCommands struct {
+	pushed   map[string]map[string][]string
+	usedJTIs map[string]bool
+}
+
+func (c *testPARCommands) AddPushedAuthRequest(context.Context, string, map[string][]string, time.Time) (string, *domain.ObjectDetails, error) {
+	return "", nil, caos_errs.ThrowUnimplemented(nil, "COMMAND-Test1", "not implemented")
+}
+
+func (c *testPARCommands) UsePushedAuthRequest(_ context.Context, id, clientID string) (map[string][]string, error) {
+	params, ok := c.pushed[clientID+":"+id]
+	if !ok {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Test2", "Errors.PushedAuthRequest.NotFound")
+	}
+	delete(c.pushed, clientID+":"+id)
+	return params, nil
+}
+
+func (c *testPARCommands) UseRequestObject(_ context.Context, clientID, jwtID string, _ time.Time) (*domain.ObjectDetails, error) {
+	if c.usedJTIs[clientID+":"+jwtID] {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Test3", "Errors.RequestObject.AlreadyUsed")
+	}
+	c.usedJTIs[clientID+":"+jwtID] = true
+	return &domain.ObjectDetails{}, nil
+}
+
+func newTestPushedAuthorization(t *testing.T, key *rsa.PublicKey) *pushedAuthorization {
+	t.Helper()
+	publicKey, err := crypto.PublicKeyToBytes(key)
+	require.NoError(t, err)
+	return &pushedAuthorization{
+		queries: &testPARQueries{
+			apps: map[string]*query.App{
+				"client1":   {OIDCConfig: &query.OIDCApp{ClientID: "client1"}},
+				"par":       {OIDCConfig: &query.OIDCApp{ClientID: "par", RequirePushedAuthRequests: true}},
+				"signed":    {OIDCConfig: &query.OIDCApp{ClientID: "signed", RequireSignedRequestObject: true}},
+				"saml":      {},
+				"otherkeys": {OIDCConfig: &query.OIDCApp{ClientID: "otherkeys"}},
+			},
+			keys: map[string][]byte{
+				"client1:key1": publicKey,
+				"par:key1":     publicKey,
+				"signed:key1":  publicKey,
+			},
+		},
+		commands: &testPARCommands{
+			pushed: map[string]map[string][]string{
+				"par:par1": {"scope": {"openid"}, "redirect_uri": {"https://rp.example.com/cb"}},
+			},
+			usedJTIs: map[string]bool{"client1:used": true},
+		},
+	}
+}
+
+func signRequestObject(t *testing.T, key *rsa.PrivateKey, claims map[string]interface{}) string {
+	t.Helper()
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.RS256, Key: key},
+		(&jose.SignerOptions{}).WithHeader("kid", "key1"),
+	)
+	require.NoError(t, err)
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+	jws, err := signer.Sign(payload)
+	require.NoError(t, err)
+	token, err := jws.CompactSerialize()
+	require.NoError(t, err)
+	return token
+}
+
+// requestObjectClaimsFor returns valid claims of a request object of the client,
+// the changes are applied on top of them (a nil value removes the claim)
+func requestObjectClaimsFor(clientID string, changes map[string]interface{}) map[string]interface{} {
+	claims := map[string]interface{}{
+		"iss":          clientID,
+		"aud":          []string{testIssuer},
+		"exp":          time.Now().Add(time.Minute).Unix(),
+		"jti":          "jti1",
+		"client_id":    clientID,
+		"scope":        []string{"openid", "profile"},
+		"redirect_uri": "https://rp.example.com/cb",
+		"max_age":      300,
+	}
+	for claim, value := range changes {
+		if value == nil {
+			delete(claims, claim)
+			continue
+		}
+		claims[claim] = value
+	}
+	return claims
+}
+
+func assertOIDCErrorDescription(t *testing.T, wantDescription string, err error) {
+	t.Helper()
+	if wantDescription == "" {
+		assert.NoError(t, err)
+		return
+	}
+	var oidcErr *oidc.Error
+	require.True(t, errors.As(err, &oidcErr), "unexpected error: %v", err)
+	assert.Equal(t, oidc.InvalidRequest, oidcErr.ErrorType)
+	assert.Equal(t, wantDescription, oidcErr.Description)
+}
+
+func Test_pushedAuthorization_verifyRequestObject(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	tests := []struct {
+		name            string
+		clientID        string
+		requestObject   string
+		want            url.Values
+		wantDescription string
+	}{
+		{
+			name:            "request object missing",
+			clientID:        "client1",
+			wantDescription: "client must pass a signed request object",
+		},
+		{
+			name:            "no jwt",
+			clientID:        "client1",
+			requestObject:   "invalid",
+			wantDescription: "request object is invalid",
+		},
+		{
+			name:            "other issuer",
+			clientID:        "client1",
+			requestObject:   signRequestObject(t, key, requestObjectClaimsFor("client1", map[string]interface{}{"iss": "client2"})),
+			wantDescription: "request object must be issued by the client",
+		},
+		{
+			name:            "other client_id",
+			clientID:        "client1",
+			requestObject:   signRequestObject(t, key, requestObjectClaimsFor("client1", map[string]interface{}{"client_id": "client2"})),
+			wantDescription: "request object must be issued by the client",
+		},
+		{
+			name:            "other audience",
+			clientID:        "client1",
+			requestObject:   signRequestObject(t, key, requestObjectClaimsFor("client1", map[string]interface{}{"aud": []string{"https://other.example.com"}})),
+			wantDescription: "audience of the request object must contain the issuer",
+		},
+		{
+			name:            "exp missing",
+			clientID:        "client1",
+			requestObject:   signRequestObject(t, key, requestObjectClaimsFor("client1", map[string]interface{}{"exp": nil})),
+			wantDescription: "request object must contain exp and jti",
+		},
+		{
+			name:            "jti missing",
+			clientID:        "client1",
+			requestObject:   signRequestObject(t, key, requestObjectClaimsFor("client1", map[string]interface{}{"jti": nil})),
+			wantDescription: "request object must contain exp and jti",
+		},
+		{
+			name:            "expired",
+			clientID:        "client1",
+			requestObject:   signRequestObject(t, key, requestObjectClaimsFor("client1", map[string]interface{}{"exp": time.Now().Add(-time.Minute).Unix()})),
+			wantDescription: "request object is expired",
+		},
+		{
+			name:            "not valid yet",
+			clientID:        "client1",
+			requestObject:   signRequestObject(t, key, requestObjectClaimsFor("client1", map[string]interface{}{"nbf": time.Now().Add(time.Minute).Unix()})),
+			wantDescription: "request object is not valid yet",
+		},
+		{
+			name:            "signed by other key",
+			clientID:        "client1",
+			requestObject:   signRequestObject(t, otherKey, requestObjectClaimsFor("client1", nil)),
+			wantDescription: "signature of the request object is invalid",
+		},
+		{
+			name:            "key of other client",
+			clientID:        "otherkeys",
+			requestObject:   signRequestObject(t, key, requestObjectClaimsFor("otherkeys", nil)),
+			wantDescription: "signature of the request object is invalid",
+		},
+		{
+			name:            "replayed",
+			clientID:        "client1",
+			requestObject:   signRequestObject(t, key, requestObjectClaimsFor("client1", map[string]interface{}{"jti": "used"})),
+			wantDescription: "request object was already used",
+		},
+		{
+			name:          "verified",
+			clientID:      "client1",
+			requestObject: signRequestObject(t, key, requestObjectClaimsFor("client1", map[string]interface{}{"nbf": time.Now().Add(-time.Minute).Unix()})),
+			want: url.Values{
+				"client_id":    {"client1"},
+				"scope":        {"openid profile"},
+				"redirect_uri": {"https://rp.example.com/cb"},
+				"max_age":      {"300"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newTestPushedAuthorization(t, &key.PublicKey)
+			got, err := p.verifyRequestObject(context.Background(), tt.requestObject, tt.clientID, testIssuer)
+			assertOIDCErrorDescription(t, tt.wantDescription, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_pushedAuthorization_verifyRequestObject_replay(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	p := newTestPushedAuthorization(t, &key.PublicKey)
+	requestObject := signRequestObject(t, key, requestObjectClaimsFor("client1", nil))
+
+	_, err = p.verifyRequestObject(context.Background(), requestObject, "client1", testIssuer)
+	require.NoError(t, err)
+	_, err = p.verifyRequestObject(context.Background(), requestObject, "client1", testIssuer)
+	assertOIDCErrorDescription(t, "request object was already used", err)
+}
+
+// requestWithIssuer returns the request with the issuer in its context, as set by the provider
+func requestWithIssuer(r *http.Request) *http.Request {
+	var req *http.Request
+	op.NewIssuerInterceptor(func(*http.Request) string { return testIssuer }).
+		Handler(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) { req = r })).
+		ServeHTTP(httptest.NewRecorder(), r)
+	return req
+}
+
+func Test_pushedAuthorization_resolveAuthorizeParams(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	tests := []struct {
+		name            string
+		params          url.Values
+		want            url.Values
+		wantErr         error
+		wantDescription string
+	}{
+		{
+			name:   "client_id missing, unchanged",
+			params: url.Values{"scope": {"openid"}},
+			want:   url.Values{"scope": {"openid"}},
+		},
+		{
+			name:            "unknown client",
+			params:          url.Values{"client_id": {"unknown"}},
+			wantDescription: "unable to retrieve client by id",
+		},
+		{
+			name:   "no oidc app, unchanged",
+			params: url.Values{"client_id": {"saml"}, "request_uri": {"https://rp.example.com/request"}},
+			want:   url.Values{"client_id": {"saml"}, "request_uri": {"https://rp.example.com/request"}},
+		},
+		{
+			name:   "plain request, unchanged",
+			params: url.Values{"client_id": {"client1"}, "scope": {"openid"}},
+			want:   url.Values{"client_id": {"client1"}, "scope": {"openid"}},
+		},
+		{
+			name:    "request_uri of other server",
+			params:  url.Values{"client_id": {"par"}, "request_uri": {"https://rp.example.com/request"}},
+			wantErr: oidc.ErrRequestNotSupported(),
+		},
+		{
+			name:            "unknown pushed request",
+			params:          url.Values{"client_id": {"par"}, "request_uri": {domain.PushedAuthRequestURI("unknown")}},
+			wantDescription: "request_uri is invalid or expired",
+		},
+		{
+			name:            "pushed request of other client",
+			params:          url.Values{"client_id": {"client1"}, "request_uri": {domain.PushedAuthRequestURI("par1")}},
+			wantDescription: "request_uri is invalid or expired",
+		},
+		{
+			name:   "pushed request, replaced",
+			params: url.Values{"client_id": {"par"}, "request_uri": {domain.PushedAuthRequestURI("par1")}, "scope": {"openid email"}},
+			want:   url.Values{"client_id": {"par"}, "scope": {"openid"}, "redirect_uri": {"https://rp.example.com/cb"}},
+		},
+		{
+			name:            "pushed request required",
+			params:          url.Values{"client_id": {"par"}, "scope": {"openid"}},
+			wantDescription: "client must use pushed authorization requests",
+		},
+		{
+			name:            "request object required",
+			params:          url.Values{"client_id": {"signed"}, "scope": {"openid"}},
+			wantDescription: "client must pass a signed request object",
+		},
+		{
+			name: "request object, replaced",
+			params: url.Values{
+				"client_id": {"client1"},
+				"request":   {signRequestObject(t, key, requestObjectClaimsFor("client1", nil))},
+				"scope":     {"openid email"},
+			},
+			want: url.Values{
+				"client_id":    {"client1"},
+				"scope":        {"openid profile"},
+				"redirect_uri": {"https://rp.example.com/cb"},
+				"max_age":      {"300"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newTestPushedAuthorization(t, &key.PublicKey)
+			r := requestWithIssuer(httptest.NewRequest(http.MethodGet, "/oauth/v2/authorize?"+tt.params.Encode(), nil))
+			err := p.resolveAuthorizeParams(r)
+			if tt.wantErr != nil {
+				assertOIDCError(t, tt.wantErr, err)
+				return
+			}
+			assertOIDCErrorDescription(t, tt.wantDescription, err)
+			if tt.wantDescription == "" {
+				assert.Equal(t, tt.want, r.Form)
+			}
+		})
+	}
+}
+
+func Test_authorizeParams(t *testing.T) {
+	got := authorizeParams(url.Values{
+		"client_id":             {"client1"},
+		"client_secret":         {"secret"},
+		"client_assertion":      {"assertion"},
+		"client_assertion_type": {oidc.ClientAssertionTypeJWTAssertion},
+		"request":               {"request"},
+		"request_uri":           {"request_uri"},
+		"scope":                 {"openid"},
+	})
+	assert.Equal(t, url.Values{"client_id": {"client1"}, "scope": {"openid"}}, got)
+}
@@ -0,0 +1,152 @@
+package oidc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/zitadel/logging"
+	"github.com/zitadel/oidc/v2/pkg/oidc"
+	"github.com/zitadel/oidc/v2/pkg/op"
+
+	"github.com/dennigogo/zitadel/internal/api/authz"
+	http_utils "github.com/dennigogo/zitadel/internal/api/http"
+)
+
+const (
+	// ClaimConfirmation is the claim of the key, the access token is bound to (RFC 7800)
+	ClaimConfirmation = "cnf"
+
+	errorInvalidDPoPProof = "invalid_dpop_proof"
+)
+
+type tokenConfirmationCtxKey struct{}
+
+// senderConstraintInterceptor binds the access tokens issued by the token endpoint
+// to the key of the DPoP proof (RFC 9449) and / or to the client certificate of the mTLS connection (RFC 8705),
+// which are both not supported by the oidc library
+func senderConstraintInterceptor(provider op.OpenIDProvider, trustClientCertificateHeader bool) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != provider.TokenEndpoint().Relative() {
+				next.ServeHTTP(w, r)
+				return
+			}
+			confirmation, err := requestTokenConfirmation(r, trustClientCertificateHeader)
+			if err != nil {
+				op.RequestError(w, r, err)
+				return
+			}
+			if confirmation.IsZero() {
+				next.ServeHTTP(w, r)
+				return
+			}
+			r = r.WithContext(context.WithValue(r.Context(), tokenConfirmationCtxKey{}, confirmation))
+			if confirmation.DPoPJKT == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+			writer := &dpopTokenTypeWriter{ResponseWriter: w}
+			next.ServeHTTP(writer, r)
+			writer.flush()
+		})
+	}
+}
+
+func tokenConfirmationFromCtx(ctx context.Context) *authz.TokenConfirmation {
+	confirmation, _ := ctx.Value(tokenConfirmationCtxKey{}).(*authz.TokenConfirmation)
+	return confirmation
+}
+
+func requestTokenConfirmation(r *http.Request, trustClientCertificateHeader bool) (*authz.TokenConfirmation, error) {
+	confirmation := new(authz.TokenConfirmation)
+	if proofs := r.Header.Values(http_utils.DPoP); len(proofs) > 0 {
+		if len(proofs) > 1 {
+			return nil, (&oidc.Error{ErrorType: errorInvalidDPoPProof}).WithDescription("only one DPoP proof is allowed")
+		}
+		jkt, err := authz.VerifyDPoPProof(proofs[0], r.Method, authz.GetInstance(r.Context()).RequestedHost(), r.URL.Path, "")
+		if err != nil {
+			return nil, (&oidc.Error{ErrorType: errorInvalidDPoPProof}).WithDescription("DPoP proof is invalid").WithParent(err)
+		}
+		confirmation.DPoPJKT = jkt
+	}
+	thumbprint, err := clientCertificateThumbprint(r, trustClientCertificateHeader)
+	if err != nil {
+		return nil, oidc.ErrInvalidRequest().WithDescription("client certificate is invalid").WithParent(err)
+	}
+	confirmation.CertificateThumbprint = thumbprint
+	return confirmation, nil
+}
+
+// clientCertificateThumbprint returns the thumbprint of the client certificate of the mTLS connection.
+// The header of a TLS terminating proxy is only considered, if ZITADEL doesn't terminate TLS itself
+// and the proxy is explicitly trusted, as any client could pass the (public) certificate otherwise.
+func clientCertificateThumbprint(r *http.Request, trustClientCertificateHeader bool) (string, error) {
+	if r.TLS != nil {
+		if len(r.TLS.PeerCertificates) == 0 {
+			return "", nil
+		}
+		return authz.CertificateThumbprint(r.TLS.PeerCertificates[0]), nil
+	}
+	if !trustClientCertificateHeader {
+		return "", nil
+	}
+	header := r.Header.Get(http_utils.ZitadelClientCertificate)
+	if header == "" {
+		return "", nil
+	}
+	cert, err := authz.ClientCertificateFromHeader(header)
+	if err != nil {
+		return "", err
+	}
+	return authz.CertificateThumbprint(cert), nil
+}
+
+// dpopTokenTypeWriter returns the token_type DPoP instead of Bearer,
+// which is always set by the oidc library
+type dpopTokenTypeWriter struct {
+	http.ResponseWriter
+	statusCode int
+	body       bytes.Buffer
+}
+
+func (w *dpopTokenTypeWriter) WriteHeader(statusCode int) {
+	w.statusCode = statusCode
+}
+
+func (w *dpopTokenTypeWriter) Write(b []byte) (int, error) {
+	if w.statusCode == 0 {
+		w.statusCode = http.StatusOK
+	}
+	return w.body.Write(b)
+}
+
+func (w *dpopTokenTypeWriter) flush() {
+	body := w.body.Bytes()
+	if w.statusCode == http.StatusOK {
+		body = dpopTokenResponse(body)
+	}
+	if w.statusCode != 0 {
+		w.ResponseWriter.WriteHeader(w.statusCode)
+	}
+	_, err := w.ResponseWriter.Write(body)
+	logging.OnError(err).Warn("unable to write token response")
+}
+
+func dpopTokenResponse(body []byte) []byte {
+	response := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(body, &response); err != nil {
+		return body
+	}
+	var tokenType string
+	if err := json.Unmarshal(response["token_type"], &tokenType); err != nil || tokenType != oidc.BearerToken {
+		return body
+	}
+	response["token_type"], _ = json.Marshal(authz.DPoPTokenType)
+	dpopBody, err := json.Marshal(response)
+	if err != nil {
+		return body
+	}
+	return dpopBody
+}
@@ -0,0 +1,122 @@
+package oidc
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dennigogo/zitadel/internal/api/authz"
+	http_utils "github.com/dennigogo/zitadel/internal/api/http"
+)
+
+func testCertificate(t *testing.T) *x509.Certificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "client"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert
+}
+
+func Test_clientCertificateThumbprint(t *testing.T) {
+	cert := testCertificate(t)
+	header := url.PathEscape(string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})))
+	request := func(header string, certs ...*x509.Certificate) *http.Request {
+		r := httptest.NewRequest(http.MethodPost, "/oauth/v2/token", nil)
+		if header != "" {
+			r.Header.Set(http_utils.ZitadelClientCertificate, header)
+		}
+		if certs != nil {
+			r.TLS = &tls.ConnectionState{PeerCertificates: certs}
+		}
+		return r
+	}
+	type args struct {
+		r                            *http.Request
+		trustClientCertificateHeader bool
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "no certificate",
+			args: args{
+				r: request(""),
+			},
+			want: "",
+		},
+		{
+			name: "spoofed header, ignored",
+			args: args{
+				r: request(header),
+			},
+			want: "",
+		},
+		{
+			name: "header of trusted proxy",
+			args: args{
+				r:                            request(header),
+				trustClientCertificateHeader: true,
+			},
+			want: authz.CertificateThumbprint(cert),
+		},
+		{
+			name: "invalid header of trusted proxy",
+			args: args{
+				r:                            request("invalid"),
+				trustClientCertificateHeader: true,
+			},
+			wantErr: true,
+		},
+		{
+			name: "certificate of tls connection",
+			args: args{
+				r: request("", cert),
+			},
+			want: authz.CertificateThumbprint(cert),
+		},
+		{
+			name: "tls connection without certificate, header ignored",
+			args: args{
+				r:                            request(header, []*x509.Certificate{}...),
+				trustClientCertificateHeader: true,
+			},
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := clientCertificateThumbprint(tt.args.r, tt.args.trustClientCertificateHeader)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
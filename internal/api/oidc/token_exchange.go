@@ -0,0 +1,419 @@
+package oidc
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/zitadel/oidc/v2/pkg/crypto"
+	httphelper "github.com/zitadel/oidc/v2/pkg/http"
+	"github.com/zitadel/oidc/v2/pkg/oidc"
+	"github.com/zitadel/oidc/v2/pkg/oidc/grants/tokenexchange"
+	"github.com/zitadel/oidc/v2/pkg/op"
+	str_utils "github.com/zitadel/oidc/v2/pkg/strings"
+
+	"github.com/dennigogo/zitadel/internal/api/authz"
+	"github.com/dennigogo/zitadel/internal/domain"
+	caos_errs "github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/query"
+	"github.com/dennigogo/zitadel/internal/telemetry/tracing"
+	usr_model "github.com/dennigogo/zitadel/internal/user/model"
+)
+
+const (
+	// TokenTypeUserID is used as subject_token_type to impersonate the user with the id provided as subject_token
+	TokenTypeUserID = "urn:zitadel:params:oauth:token-type:user_id"
+
+	// ClaimActor is the claim of the acting party (RFC 8693)
+	ClaimActor = "act"
+
+	errorInvalidTarget = "invalid_target"
+	tokenTypeNA        = "N_A"
+)
+
+type tokenExchangeRequest struct {
+	SubjectToken       string                   `schema:"subject_token"`
+	SubjectTokenType   string                   `schema:"subject_token_type"`
+	ActorToken         string                   `schema:"actor_token"`
+	ActorTokenType     string                   `schema:"actor_token_type"`
+	RequestedTokenType string                   `schema:"requested_token_type"`
+	Audience           []string                 `schema:"audience"`
+	Scopes             oidc.SpaceDelimitedArray `schema:"scope"`
+	ClientID           string                   `schema:"client_id"`
+	ClientSecret       string                   `schema:"client_secret"`
+}
+
+func (r *tokenExchangeRequest) SetClientID(clientID string) {
+	r.ClientID = clientID
+}
+
+func (r *tokenExchangeRequest) SetClientSecret(clientSecret string) {
+	r.ClientSecret = clientSecret
+}
+
+type tokenExchangeResponse struct {
+	AccessToken     string                   `json:"access_token"`
+	IssuedTokenType string                   `json:"issued_token_type"`
+	TokenType       string                   `json:"token_type"`
+	ExpiresIn       uint64                   `json:"expires_in,omitempty"`
+	Scopes          oidc.SpaceDelimitedArray `json:"scope,omitempty"`
+}
+
+// ExchangeTokenRequest is the token request of a token exchange,
+// the subject is either delegated (subject_token) or impersonated (user id and actor_token)
+type ExchangeTokenRequest struct {
+	subject       string
+	resourceOwner string
+	clientID      string
+	audience      []string
+	scopes        []string
+	authTime      time.Time
+	actor         *domain.TokenActor
+}
+
+func (r *ExchangeTokenRequest) GetAMR() []string {
+	return nil
+}
+
+func (r *ExchangeTokenRequest) GetAudience() []string {
+	return r.audience
+}
+
+func (r *ExchangeTokenRequest) GetAuthTime() time.Time {
+	return r.authTime
+}
+
+func (r *ExchangeTokenRequest) GetClientID() string {
+	return r.clientID
+}
+
+func (r *ExchangeTokenRequest) GetScopes() []string {
+	return r.scopes
+}
+
+func (r *ExchangeTokenRequest) GetSubject() string {
+	return r.subject
+}
+
+// tokenExchange adds the OAuth 2.0 Token Exchange (RFC 8693) to the provider,
+// which is not (yet) implemented by the oidc library.
+// Clients must have the token exchange grant type enabled.
+type tokenExchange struct {
+	provider op.OpenIDProvider
+	storage  *OPStorage
+}
+
+func registerTokenExchange(router *mux.Router, provider op.OpenIDProvider, storage *OPStorage) {
+	t := &tokenExchange{
+		provider: provider,
+		storage:  storage,
+	}
+	router.Use(t.interceptor)
+}
+
+// interceptor handles the token requests of the token exchange grant
+func (t *tokenExchange) interceptor(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == t.provider.TokenEndpoint().Relative() && r.FormValue("grant_type") == string(oidc.GrantTypeTokenExchange) {
+			t.handleTokenExchange(w, r)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (t *tokenExchange) handleTokenExchange(w http.ResponseWriter, r *http.Request) {
+	resp, err := t.exchangeToken(r)
+	if err != nil {
+		op.RequestError(w, r, err)
+		return
+	}
+	httphelper.MarshalJSON(w, resp)
+}
+
+func (t *tokenExchange) exchangeToken(r *http.Request) (_ *tokenExchangeResponse, err error) {
+	ctx, span := tracing.NewSpan(r.Context())
+	defer func() { span.EndWithError(err) }()
+
+	req := new(tokenExchangeRequest)
+	if err = op.ParseAuthenticatedTokenRequest(r, t.provider.Decoder(), req); err != nil {
+		return nil, err
+	}
+	if err = validateTokenExchangeRequest(req); err != nil {
+		return nil, err
+	}
+	client, err := authenticateClient(ctx, t.storage, req.ClientID, req.ClientSecret, oidc.GrantTypeTokenExchange, false)
+	if err != nil {
+		return nil, err
+	}
+
+	var exchangeReq *ExchangeTokenRequest
+	switch req.SubjectTokenType {
+	case TokenTypeUserID:
+		exchangeReq, err = t.storage.impersonate(ctx, client.GetID(), req.SubjectToken, req.ActorToken, req.Scopes)
+	case tokenexchange.AccessTokenType:
+		exchangeReq, err = t.delegate(ctx, client.GetID(), req)
+	default:
+		err = oidc.ErrInvalidRequest().WithDescription("subject_token_type %s is not supported", req.SubjectTokenType)
+	}
+	if err != nil {
+		return nil, err
+	}
+	exchangeReq.audience, err = t.storage.exchangeAudience(ctx, client.GetID(), exchangeReq.subject, req.Audience)
+	if err != nil {
+		return nil, err
+	}
+	return t.createExchangeResponse(ctx, exchangeReq, req.RequestedTokenType, client)
+}
+
+func validateTokenExchangeRequest(req *tokenExchangeRequest) error {
+	if req.SubjectToken == "" || req.SubjectTokenType == "" {
+		return oidc.ErrInvalidRequest().WithDescription("subject_token and subject_token_type must be provided")
+	}
+	if (req.ActorToken == "") != (req.ActorTokenType == "") {
+		return oidc.ErrInvalidRequest().WithDescription("actor_token and actor_token_type must be provided together")
+	}
+	if req.ActorTokenType != "" && req.ActorTokenType != tokenexchange.AccessTokenType {
+		return oidc.ErrInvalidRequest().WithDescription("actor_token_type %s is not supported", req.ActorTokenType)
+	}
+	if req.SubjectTokenType == TokenTypeUserID && req.ActorToken == "" {
+		return oidc.ErrInvalidRequest().WithDescription("actor_token must be provided for impersonation")
+	}
+	return nil
+}
+
+func (t *tokenExchange) createExchangeResponse(ctx context.Context, req *ExchangeTokenRequest, requestedTokenType string, client op.Client) (*tokenExchangeResponse, error) {
+	if requestedTokenType == "" {
+		requestedTokenType = tokenexchange.AccessTokenType
+	}
+	resp := &tokenExchangeResponse{
+		IssuedTokenType: requestedTokenType,
+		TokenType:       oidc.BearerToken,
+		Scopes:          req.scopes,
+	}
+	switch requestedTokenType {
+	case tokenexchange.AccessTokenType, tokenexchange.JWTTokenType:
+		tokenID, exp, err := t.storage.CreateAccessToken(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		if requestedTokenType == tokenexchange.JWTTokenType || client.AccessTokenType() == op.AccessTokenTypeJWT {
+			resp.AccessToken, err = t.storage.createExchangeJWT(ctx, req, exp, tokenID, client)
+		} else {
+			resp.AccessToken, err = op.CreateBearerToken(tokenID, req.subject, t.provider.Crypto())
+		}
+		if err != nil {
+			return nil, err
+		}
+		resp.ExpiresIn = uint64(exp.Add(client.ClockSkew()).Sub(time.Now().UTC()).Seconds())
+	case tokenexchange.IDTokenType:
+		var err error
+		resp.AccessToken, err = t.storage.createExchangeIDToken(ctx, req, client)
+		if err != nil {
+			return nil, err
+		}
+		resp.TokenType = tokenTypeNA
+		resp.ExpiresIn = uint64(client.IDTokenLifetime().Seconds())
+	default:
+		return nil, oidc.ErrInvalidRequest().WithDescription("requested_token_type %s is not supported", requestedTokenType)
+	}
+	return resp, nil
+}
+
+func (t *tokenExchange) delegate(ctx context.Context, clientID string, req *tokenExchangeRequest) (*ExchangeTokenRequest, error) {
+	subjectToken, err := t.verifyToken(ctx, req.SubjectToken)
+	if err != nil {
+		return nil, oidc.ErrInvalidGrant().WithDescription("subject_token invalid").WithParent(err)
+	}
+	var actorToken *usr_model.TokenView
+	if req.ActorToken != "" {
+		actorToken, err = t.verifyToken(ctx, req.ActorToken)
+		if err != nil {
+			return nil, oidc.ErrInvalidGrant().WithDescription("actor_token invalid").WithParent(err)
+		}
+	}
+	return t.storage.delegate(ctx, clientID, subjectToken, actorToken, req.Scopes)
+}
+
+// verifyToken returns the still valid access token, which can either be opaque or a JWT
+func (t *tokenExchange) verifyToken(ctx context.Context, token string) (*usr_model.TokenView, error) {
+	tokenID, subject, ok := t.tokenIDAndSubject(ctx, token)
+	if !ok {
+		return nil, caos_errs.ThrowUnauthenticated(nil, "OIDC-Tx2k8", "invalid token")
+	}
+	return t.storage.repo.TokenByIDs(ctx, subject, tokenID)
+}
+
+func (t *tokenExchange) tokenIDAndSubject(ctx context.Context, token string) (tokenID, subject string, ok bool) {
+	tokenIDSubject, err := t.provider.Crypto().Decrypt(token)
+	if err != nil {
+		claims, err := op.VerifyAccessToken(ctx, token, t.provider.AccessTokenVerifier(ctx))
+		if err != nil {
+			return "", "", false
+		}
+		return claims.GetTokenID(), claims.GetSubject(), true
+	}
+	splitToken := strings.Split(tokenIDSubject, ":")
+	if len(splitToken) != 2 {
+		return "", "", false
+	}
+	return splitToken[0], splitToken[1], true
+}
+
+// impersonate checks if the actor is allowed to impersonate the user provided as subject_token
+// and records the impersonation on the user
+func (o *OPStorage) impersonate(ctx context.Context, clientID, userID, actorToken string, scopes []string) (*ExchangeTokenRequest, error) {
+	user, err := o.query.GetUserByID(ctx, true, userID)
+	if err != nil {
+		if caos_errs.IsNotFound(err) {
+			return nil, oidc.ErrInvalidRequest().WithDescription("subject_token invalid")
+		}
+		return nil, oidc.ErrServerError().WithParent(err)
+	}
+	actorID, err := authz.CheckImpersonation(ctx, authz.BearerPrefix+actorToken, user.ResourceOwner, user.ID, o.verifier, o.authConfig)
+	if err != nil {
+		return nil, (&oidc.Error{ErrorType: errorAccessDenied}).WithDescription("actor is not allowed to impersonate the subject").WithParent(err)
+	}
+	actor := &domain.TokenActor{
+		UserID: actorID,
+		Issuer: op.IssuerFromContext(ctx),
+	}
+	if _, err = o.command.ImpersonateUser(setContextUserSystem(ctx), user.ID, user.ResourceOwner, clientID, actor); err != nil {
+		return nil, oidc.ErrInvalidRequest().WithParent(err)
+	}
+	if len(scopes) == 0 {
+		scopes = []string{oidc.ScopeOpenID}
+	}
+	return &ExchangeTokenRequest{
+		subject:       user.ID,
+		resourceOwner: user.ResourceOwner,
+		clientID:      clientID,
+		scopes:        scopes,
+		authTime:      time.Now().UTC(),
+		actor:         actor,
+	}, nil
+}
+
+// delegate exchanges the subject token, which must have been issued for the project of the client.
+// If an actor token is provided, the actor will be added to the new token.
+func (o *OPStorage) delegate(ctx context.Context, clientID string, subjectToken, actorToken *usr_model.TokenView, scopes []string) (*ExchangeTokenRequest, error) {
+	projectID, err := o.query.ProjectIDFromOIDCClientID(ctx, clientID)
+	if err != nil {
+		return nil, oidc.ErrServerError().WithParent(err)
+	}
+	scopes, err = delegationScopes(subjectToken, clientID, projectID, scopes)
+	if err != nil {
+		return nil, err
+	}
+	exchangeReq := &ExchangeTokenRequest{
+		subject:       subjectToken.UserID,
+		resourceOwner: subjectToken.ResourceOwner,
+		clientID:      clientID,
+		scopes:        scopes,
+		authTime:      time.Now().UTC(),
+	}
+	if actorToken == nil {
+		return exchangeReq, nil
+	}
+	exchangeReq.actor = &domain.TokenActor{
+		UserID: actorToken.UserID,
+		Issuer: op.IssuerFromContext(ctx),
+	}
+	return exchangeReq, nil
+}
+
+// delegationScopes checks that the subject token was issued for the project of the client
+// and returns the requested scopes, which must have been granted to the subject token
+func delegationScopes(subjectToken *usr_model.TokenView, clientID, projectID string, scopes []string) ([]string, error) {
+	if !str_utils.Contains(subjectToken.Audience, clientID) && !str_utils.Contains(subjectToken.Audience, projectID) {
+		return nil, oidc.ErrInvalidGrant().WithDescription("subject_token was not issued for the project of the client")
+	}
+	if len(scopes) == 0 {
+		return subjectToken.Scopes, nil
+	}
+	for _, scope := range scopes {
+		if !str_utils.Contains(subjectToken.Scopes, scope) {
+			return nil, oidc.ErrInvalidScope().WithDescription("scope %s was not granted to the subject_token", scope)
+		}
+	}
+	return scopes, nil
+}
+
+// exchangeAudience returns the audience of the client extended by the requested projects.
+// The subject must be granted on the requested projects, so the token can't be used for projects (of other organisations)
+// the subject has no access to.
+func (o *OPStorage) exchangeAudience(ctx context.Context, clientID, subject string, requested []string) ([]string, error) {
+	audience, err := o.audienceOfClient(ctx, clientID)
+	if err != nil {
+		return nil, oidc.ErrServerError().WithParent(err)
+	}
+	for _, projectID := range requested {
+		if str_utils.Contains(audience, projectID) {
+			continue
+		}
+		if err = o.checkUserGrantOfProject(ctx, subject, projectID); err != nil {
+			return nil, err
+		}
+		audience = append(audience, projectID)
+	}
+	return audience, nil
+}
+
+func (o *OPStorage) checkUserGrantOfProject(ctx context.Context, userID, projectID string) error {
+	userIDQuery, err := query.NewUserGrantUserIDSearchQuery(userID)
+	if err != nil {
+		return oidc.ErrServerError().WithParent(err)
+	}
+	projectIDQuery, err := query.NewUserGrantProjectIDSearchQuery(projectID)
+	if err != nil {
+		return oidc.ErrServerError().WithParent(err)
+	}
+	if _, err = o.query.UserGrant(ctx, false, userIDQuery, projectIDQuery); err != nil {
+		if caos_errs.IsNotFound(err) {
+			return (&oidc.Error{ErrorType: errorInvalidTarget}).WithDescription("subject is not granted on audience %s", projectID)
+		}
+		return oidc.ErrServerError().WithParent(err)
+	}
+	return nil
+}
+
+func (o *OPStorage) createExchangeJWT(ctx context.Context, req *ExchangeTokenRequest, exp time.Time, tokenID string, client op.Client) (string, error) {
+	claims := oidc.NewAccessTokenClaims(op.IssuerFromContext(ctx), req.subject, req.audience, exp, tokenID, client.GetID(), client.ClockSkew())
+	privateClaims, err := o.GetPrivateClaimsFromScopes(ctx, req.subject, client.GetID(), client.RestrictAdditionalAccessTokenScopes()(req.scopes))
+	if err != nil {
+		return "", err
+	}
+	if req.actor != nil {
+		privateClaims = appendClaim(privateClaims, ClaimActor, req.actor)
+	}
+	claims.SetPrivateClaims(privateClaims)
+	return o.signExchangeToken(ctx, claims)
+}
+
+func (o *OPStorage) createExchangeIDToken(ctx context.Context, req *ExchangeTokenRequest, client op.Client) (string, error) {
+	exp := time.Now().UTC().Add(client.ClockSkew()).Add(client.IDTokenLifetime())
+	claims := oidc.NewIDTokenClaims(op.IssuerFromContext(ctx), req.subject, req.audience, exp, req.authTime, "", "", nil, client.GetID(), client.ClockSkew())
+	userInfo := oidc.NewUserInfo()
+	if err := o.SetUserinfoFromScopes(ctx, userInfo, req.subject, client.GetID(), client.RestrictAdditionalIdTokenScopes()(req.scopes)); err != nil {
+		return "", err
+	}
+	if req.actor != nil {
+		userInfo.AppendClaims(ClaimActor, req.actor)
+	}
+	claims.SetUserinfo(userInfo)
+	return o.signExchangeToken(ctx, claims)
+}
+
+func (o *OPStorage) signExchangeToken(ctx context.Context, claims interface{}) (string, error) {
+	signingKey, err := o.SigningKey(ctx)
+	if err != nil {
+		return "", err
+	}
+	signer, err := op.SignerFromKey(signingKey)
+	if err != nil {
+		return "", err
+	}
+	return crypto.Sign(claims, signer)
+}
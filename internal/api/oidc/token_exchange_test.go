@@ -0,0 +1,158 @@
+package oidc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zitadel/oidc/v2/pkg/oidc"
+	"github.com/zitadel/oidc/v2/pkg/oidc/grants/tokenexchange"
+
+	usr_model "github.com/dennigogo/zitadel/internal/user/model"
+)
+
+func Test_validateTokenExchangeRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *tokenExchangeRequest
+		wantErr error
+	}{
+		{
+			name:    "subject token missing",
+			req:     &tokenExchangeRequest{SubjectTokenType: tokenexchange.AccessTokenType},
+			wantErr: oidc.ErrInvalidRequest(),
+		},
+		{
+			name:    "subject token type missing",
+			req:     &tokenExchangeRequest{SubjectToken: "token"},
+			wantErr: oidc.ErrInvalidRequest(),
+		},
+		{
+			name: "actor token type missing",
+			req: &tokenExchangeRequest{
+				SubjectToken:     "token",
+				SubjectTokenType: tokenexchange.AccessTokenType,
+				ActorToken:       "actor",
+			},
+			wantErr: oidc.ErrInvalidRequest(),
+		},
+		{
+			name: "unsupported actor token type",
+			req: &tokenExchangeRequest{
+				SubjectToken:     "token",
+				SubjectTokenType: tokenexchange.AccessTokenType,
+				ActorToken:       "actor",
+				ActorTokenType:   tokenexchange.IDTokenType,
+			},
+			wantErr: oidc.ErrInvalidRequest(),
+		},
+		{
+			name: "impersonation without actor",
+			req: &tokenExchangeRequest{
+				SubjectToken:     "user1",
+				SubjectTokenType: TokenTypeUserID,
+			},
+			wantErr: oidc.ErrInvalidRequest(),
+		},
+		{
+			name: "delegation",
+			req: &tokenExchangeRequest{
+				SubjectToken:     "token",
+				SubjectTokenType: tokenexchange.AccessTokenType,
+			},
+		},
+		{
+			name: "delegation with actor",
+			req: &tokenExchangeRequest{
+				SubjectToken:     "token",
+				SubjectTokenType: tokenexchange.AccessTokenType,
+				ActorToken:       "actor",
+				ActorTokenType:   tokenexchange.AccessTokenType,
+			},
+		},
+		{
+			name: "impersonation",
+			req: &tokenExchangeRequest{
+				SubjectToken:     "user1",
+				SubjectTokenType: TokenTypeUserID,
+				ActorToken:       "actor",
+				ActorTokenType:   tokenexchange.AccessTokenType,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateTokenExchangeRequest(tt.req)
+			assertOIDCError(t, tt.wantErr, err)
+		})
+	}
+}
+
+func Test_delegationScopes(t *testing.T) {
+	subjectToken := &usr_model.TokenView{
+		Audience: []string{"client1", "project1"},
+		Scopes:   []string{oidc.ScopeOpenID, oidc.ScopeProfile},
+	}
+	type args struct {
+		clientID  string
+		projectID string
+		scopes    []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []string
+		wantErr error
+	}{
+		{
+			name: "other project, invalid grant",
+			args: args{
+				clientID:  "client2",
+				projectID: "project2",
+			},
+			wantErr: oidc.ErrInvalidGrant(),
+		},
+		{
+			name: "scope not granted, invalid scope",
+			args: args{
+				clientID:  "client1",
+				projectID: "project1",
+				scopes:    []string{oidc.ScopeOpenID, oidc.ScopeEmail},
+			},
+			wantErr: oidc.ErrInvalidScope(),
+		},
+		{
+			name: "scopes of subject token",
+			args: args{
+				clientID:  "client2",
+				projectID: "project1",
+			},
+			want: []string{oidc.ScopeOpenID, oidc.ScopeProfile},
+		},
+		{
+			name: "subset of scopes",
+			args: args{
+				clientID:  "client1",
+				projectID: "project2",
+				scopes:    []string{oidc.ScopeProfile},
+			},
+			want: []string{oidc.ScopeProfile},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := delegationScopes(subjectToken, tt.args.clientID, tt.args.projectID, tt.args.scopes)
+			assertOIDCError(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// assertOIDCError checks the type of the oidc error, as the descriptions are not part of the tests
+func assertOIDCError(t *testing.T, want, err error) {
+	t.Helper()
+	if want == nil {
+		assert.NoError(t, err)
+		return
+	}
+	assert.ErrorIs(t, err, want)
+}
@@ -80,6 +80,10 @@ func AuthRequestFromBusiness(authReq *domain.AuthRequest) (_ models.AuthRequestI
 }
 
 func CreateAuthRequestToBusiness(ctx context.Context, authReq *samlp.AuthnRequestType, acsUrl, protocolBinding, applicationID, relayState, userAgentID string) *domain.AuthRequest {
+	var nameIDFormat string
+	if authReq.NameIDPolicy != nil {
+		nameIDFormat = authReq.NameIDPolicy.Format
+	}
 	return &domain.AuthRequest{
 		CreationDate:  time.Now(),
 		AgentID:       userAgentID,
@@ -88,12 +92,13 @@ func CreateAuthRequestToBusiness(ctx context.Context, authReq *samlp.AuthnReques
 		TransferState: relayState,
 		InstanceID:    authz.GetInstance(ctx).InstanceID(),
 		Request: &domain.AuthRequestSAML{
-			ID:          authReq.Id,
-			BindingType: protocolBinding,
-			Code:        "",
-			Issuer:      authReq.Issuer.Text,
-			IssuerName:  authReq.Issuer.SPProvidedID,
-			Destination: authReq.Destination,
+			ID:           authReq.Id,
+			BindingType:  protocolBinding,
+			Code:         "",
+			Issuer:       authReq.Issuer.Text,
+			IssuerName:   authReq.Issuer.SPProvidedID,
+			Destination:  authReq.Destination,
+			NameIDFormat: nameIDFormat,
 		},
 	}
 }
@@ -0,0 +1,40 @@
+package saml
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/dennigogo/zitadel/internal/domain"
+)
+
+type authRequestCtxKey struct{}
+
+// authRequestHolder remembers the auth request loaded during a request,
+// the saml library only passes the user id when the response attributes are set
+type authRequestHolder struct {
+	authRequest *domain.AuthRequest
+}
+
+// authRequestInterceptor adds an empty auth request holder to the context of every request
+func authRequestInterceptor(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), authRequestCtxKey{}, new(authRequestHolder))
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func rememberAuthRequest(ctx context.Context, authRequest *domain.AuthRequest) {
+	holder, ok := ctx.Value(authRequestCtxKey{}).(*authRequestHolder)
+	if !ok {
+		return
+	}
+	holder.authRequest = authRequest
+}
+
+func authRequestFromContext(ctx context.Context) *domain.AuthRequest {
+	holder, ok := ctx.Value(authRequestCtxKey{}).(*authRequestHolder)
+	if !ok {
+		return nil
+	}
+	return holder.authRequest
+}
@@ -65,6 +65,7 @@ func NewProvider(
 			instanceHandler,
 			userAgentCookie,
 			http_utils.CopyHeadersToContext,
+			authRequestInterceptor,
 		),
 	}
 	if !externalSecure {
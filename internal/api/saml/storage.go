@@ -4,12 +4,17 @@ import (
 	"context"
 	"time"
 
+	"github.com/dop251/goja"
+	"github.com/zitadel/logging"
 	"github.com/zitadel/saml/pkg/provider"
 	"github.com/zitadel/saml/pkg/provider/key"
 	"github.com/zitadel/saml/pkg/provider/models"
 	"github.com/zitadel/saml/pkg/provider/serviceprovider"
 	"github.com/zitadel/saml/pkg/provider/xml/samlp"
 
+	"github.com/dennigogo/zitadel/internal/actions"
+	"github.com/dennigogo/zitadel/internal/actions/object"
+	"github.com/dennigogo/zitadel/internal/actions/store"
 	"github.com/dennigogo/zitadel/internal/api/http/middleware"
 	"github.com/dennigogo/zitadel/internal/auth/repository"
 	"github.com/dennigogo/zitadel/internal/command"
@@ -27,6 +32,12 @@ var _ provider.IdentityProviderStorage = &Storage{}
 var _ provider.AuthStorage = &Storage{}
 var _ provider.UserStorage = &Storage{}
 
+const (
+	nameIDFormatUnspecified  = "urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified"
+	nameIDFormatEmailAddress = "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress"
+	nameIDFormatPersistent   = "urn:oasis:names:tc:SAML:2.0:nameid-format:persistent"
+)
+
 type Storage struct {
 	certChan                   <-chan interface{}
 	defaultCertificateLifetime time.Duration
@@ -95,6 +106,9 @@ func (p *Storage) CreateAuthRequest(ctx context.Context, req *samlp.AuthnRequest
 	}
 
 	authRequest := CreateAuthRequestToBusiness(ctx, req, acsUrl, protocolBinding, applicationID, relayState, userAgentID)
+	if err = checkNameIDFormat(authRequest.Request.(*domain.AuthRequestSAML).NameIDFormat); err != nil {
+		return nil, err
+	}
 
 	resp, err := p.repo.CreateAuthRequest(ctx, authRequest)
 	if err != nil {
@@ -115,6 +129,7 @@ func (p *Storage) AuthRequestByID(ctx context.Context, id string) (_ models.Auth
 	if err != nil {
 		return nil, err
 	}
+	rememberAuthRequest(ctx, resp)
 	return AuthRequestFromBusiness(resp)
 }
 
@@ -127,7 +142,14 @@ func (p *Storage) SetUserinfoWithUserID(ctx context.Context, userinfo models.Att
 	}
 
 	setUserinfo(user, userinfo, attributes)
-	return nil
+	if authRequest := authRequestFromContext(ctx); authRequest != nil {
+		if samlRequest, ok := authRequest.Request.(*domain.AuthRequestSAML); ok {
+			if err = setNameID(user, userinfo, samlRequest.NameIDFormat); err != nil {
+				return err
+			}
+		}
+	}
+	return p.samlResponseFlows(ctx, user, userinfo)
 }
 
 func (p *Storage) SetUserinfoWithLoginName(ctx context.Context, userinfo models.AttributeSetter, loginName string, attributes []int) (err error) {
@@ -139,12 +161,16 @@ func (p *Storage) SetUserinfoWithLoginName(ctx context.Context, userinfo models.
 		return err
 	}
 	user, err := p.query.GetUser(ctx, true, loginNameSQ)
+	// the subject of attribute queries is the user id if the persistent NameID format was requested
+	if errors.IsNotFound(err) {
+		user, err = p.query.GetUserByID(ctx, true, loginName)
+	}
 	if err != nil {
 		return err
 	}
 
 	setUserinfo(user, userinfo, attributes)
-	return nil
+	return p.samlResponseFlows(ctx, user, userinfo)
 }
 
 func setUserinfo(user *query.User, userinfo models.AttributeSetter, attributes []int) {
@@ -185,3 +211,218 @@ func setUserinfo(user *query.User, userinfo models.AttributeSetter, attributes [
 		}
 	}
 }
+
+// checkNameIDFormat returns an error if the NameID format requested by the service provider is not supported
+func checkNameIDFormat(format string) error {
+	switch format {
+	case "",
+		nameIDFormatUnspecified,
+		nameIDFormatEmailAddress,
+		nameIDFormatPersistent:
+		return nil
+	default:
+		return errors.ThrowInvalidArgument(nil, "SAML-Nf3s1", "Errors.SAML.NameIDFormatNotSupported")
+	}
+}
+
+// setNameID sets the NameID in the requested format:
+// the user id for persistent and the username for unspecified and emailAddress (as the username is used by default)
+func setNameID(user *query.User, userinfo models.AttributeSetter, format string) error {
+	switch format {
+	case "":
+		return nil
+	case nameIDFormatPersistent:
+		return overwriteNameID(userinfo, format, user.ID)
+	default:
+		return overwriteNameID(userinfo, format, user.PreferredLoginName)
+	}
+}
+
+func overwriteNameID(userinfo models.AttributeSetter, format, value string) error {
+	if err := checkNameIDFormat(format); err != nil || format == "" {
+		return errors.ThrowInvalidArgument(err, "SAML-Nf5s9", "Errors.SAML.NameIDFormatNotSupported")
+	}
+	setter, ok := userinfo.(nameIDSetter)
+	if !ok {
+		return errors.ThrowInternal(nil, "SAML-Nf8s2", "Errors.SAML.NameIDFormatNotSupported")
+	}
+	setter.SetNameID(format, value)
+	return nil
+}
+
+// customAttributeSetter is implemented by attribute setters
+// which support attributes besides the predefined ones
+type customAttributeSetter interface {
+	SetCustomAttribute(name, friendlyName, nameFormat string, attributeValue []string)
+}
+
+// nameIDSetter is implemented by attribute setters
+// which support NameID formats besides the default one
+type nameIDSetter interface {
+	SetNameID(format, value string)
+}
+
+var (
+	_ customAttributeSetter = (*provider.Attributes)(nil)
+	_ nameIDSetter          = (*provider.Attributes)(nil)
+)
+
+type customAttribute struct {
+	nameFormat string
+	values     []string
+}
+
+func (p *Storage) samlResponseFlows(ctx context.Context, user *query.User, userinfo models.AttributeSetter) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	queriedActions, err := p.query.GetActiveActionsByFlowAndTriggerType(ctx, domain.FlowTypeCustomiseToken, domain.TriggerTypePreSAMLResponseCreation, user.ResourceOwner)
+	if err != nil {
+		return err
+	}
+	if len(queriedActions) == 0 {
+		return nil
+	}
+	authRequest := authRequestFromContext(ctx)
+	userGrants, err := p.userGrantsForAttributes(ctx, user.ID, authRequest)
+	if err != nil {
+		return err
+	}
+
+	var nameIDFormat, nameID string
+	customAttributeNames := make([]string, 0)
+	customAttributes := make(map[string]*customAttribute)
+	setCustomAttribute := func(name, nameFormat string, values []string, appendValues bool) {
+		attribute, ok := customAttributes[name]
+		if !ok {
+			customAttributeNames = append(customAttributeNames, name)
+			customAttributes[name] = &customAttribute{nameFormat: nameFormat, values: values}
+			return
+		}
+		attribute.nameFormat = nameFormat
+		if appendValues {
+			attribute.values = append(attribute.values, values...)
+			return
+		}
+		attribute.values = values
+	}
+
+	ctxFields := actions.SetContextFields(
+		actions.SetFields("v1",
+			actions.SetFields("getUser", func(c *actions.FieldConfig) interface{} {
+				return func(goja.FunctionCall) goja.Value {
+					return object.UserFromQuery(c, user)
+				}
+			}),
+			actions.SetFields("authRequest", func(c *actions.FieldConfig) interface{} {
+				return object.AuthRequestFromDomain(c, authRequest)
+			}),
+			actions.SetFields("user",
+				actions.SetFields("grants", func(c *actions.FieldConfig) interface{} {
+					return object.UserGrantsFromQuery(c, userGrants)
+				}),
+				actions.SetFields("getMetadata", func(c *actions.FieldConfig) interface{} {
+					return func(goja.FunctionCall) goja.Value {
+						resourceOwnerQuery, err := query.NewUserMetadataResourceOwnerSearchQuery(user.ResourceOwner)
+						if err != nil {
+							logging.WithError(err).Debug("unable to create search query")
+							panic(err)
+						}
+						metadata, err := p.query.SearchUserMetadata(
+							ctx,
+							true,
+							user.ID,
+							&query.UserMetadataSearchQueries{Queries: []query.SearchQuery{resourceOwnerQuery}},
+						)
+						if err != nil {
+							logging.WithError(err).Info("unable to get md in action")
+							panic(err)
+						}
+						return object.UserMetadataListFromQuery(c, metadata)
+					}
+				}),
+			),
+		),
+	)
+	apiFields := actions.WithAPIFields(
+		actions.SetFields("v1",
+			actions.SetFields("attributes",
+				actions.SetFields("setEmail", userinfo.SetEmail),
+				actions.SetFields("setFullName", userinfo.SetFullName),
+				actions.SetFields("setGivenName", userinfo.SetGivenName),
+				actions.SetFields("setSurname", userinfo.SetSurname),
+				actions.SetFields("setUsername", userinfo.SetUsername),
+				actions.SetFields("setNameID", func(format, value string) {
+					nameIDFormat, nameID = format, value
+				}),
+				actions.SetFields("setCustomAttribute", func(name, nameFormat string, values ...string) {
+					setCustomAttribute(name, nameFormat, values, false)
+				}),
+				actions.SetFields("appendCustomAttribute", func(name, nameFormat string, values ...string) {
+					setCustomAttribute(name, nameFormat, values, true)
+				}),
+			),
+		),
+	)
+
+	for _, action := range queriedActions {
+		actionCtx, cancel := context.WithTimeout(ctx, action.Timeout())
+		err = actions.Run(
+			actionCtx,
+			ctxFields,
+			apiFields,
+			action.Script,
+			action.Name,
+			append(actions.ActionToOptions(action, domain.FlowTypeCustomiseToken, domain.TriggerTypePreSAMLResponseCreation), actions.WithHTTP(actionCtx), actions.WithLogger(actions.ServerLog), actions.WithStore(actionCtx, store.New(p.command, p.query, action.ResourceOwner)))...,
+		)
+		cancel()
+		if err != nil {
+			return err
+		}
+	}
+
+	if nameID != "" {
+		if err = overwriteNameID(userinfo, nameIDFormat, nameID); err != nil {
+			return err
+		}
+	}
+	return setCustomAttributes(userinfo, customAttributeNames, customAttributes)
+}
+
+// setCustomAttributes adds the custom attributes in the order they were set first
+func setCustomAttributes(userinfo models.AttributeSetter, names []string, attributes map[string]*customAttribute) error {
+	if len(names) == 0 {
+		return nil
+	}
+	setter, ok := userinfo.(customAttributeSetter)
+	if !ok {
+		return errors.ThrowInternal(nil, "SAML-Ca7s3", "custom attributes not supported by the attribute setter")
+	}
+	for _, name := range names {
+		attribute := attributes[name]
+		setter.SetCustomAttribute(name, "", attribute.nameFormat, attribute.values)
+	}
+	return nil
+}
+
+// userGrantsForAttributes returns the grants of the user on the project of the requesting application,
+// all grants of the user are returned if the application is unknown (e.g. attribute queries)
+func (p *Storage) userGrantsForAttributes(ctx context.Context, userID string, authRequest *domain.AuthRequest) (*query.UserGrants, error) {
+	userIDQuery, err := query.NewUserGrantUserIDSearchQuery(userID)
+	if err != nil {
+		return nil, err
+	}
+	queries := []query.SearchQuery{userIDQuery}
+	if authRequest != nil && authRequest.ApplicationID != "" {
+		app, err := p.query.AppByID(ctx, authRequest.ApplicationID)
+		if err != nil {
+			return nil, err
+		}
+		projectQuery, err := query.NewUserGrantProjectIDSearchQuery(app.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+		queries = append(queries, projectQuery)
+	}
+	return p.query.UserGrants(ctx, &query.UserGrantsQueries{Queries: queries})
+}
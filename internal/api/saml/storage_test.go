@@ -0,0 +1,213 @@
+package saml
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zitadel/saml/pkg/provider"
+	"github.com/zitadel/saml/pkg/provider/xml/saml"
+	"github.com/zitadel/saml/pkg/provider/xml/samlp"
+
+	"github.com/dennigogo/zitadel/internal/domain"
+	caos_errs "github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/query"
+)
+
+func testUser() *query.User {
+	return &query.User{
+		ID:                 "user1",
+		PreferredLoginName: "user@example.com",
+		Human: &query.Human{
+			FirstName:   "first",
+			LastName:    "last",
+			DisplayName: "first last",
+			Email:       "first.last@example.com",
+		},
+	}
+}
+
+func Test_CreateAuthRequestToBusiness(t *testing.T) {
+	tests := []struct {
+		name         string
+		nameIDPolicy *samlp.NameIDPolicyType
+		want         string
+	}{
+		{
+			name: "no name id policy",
+			want: "",
+		},
+		{
+			name:         "persistent",
+			nameIDPolicy: &samlp.NameIDPolicyType{Format: nameIDFormatPersistent},
+			want:         nameIDFormatPersistent,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			authReq := &samlp.AuthnRequestType{
+				Id:           "request1",
+				Issuer:       &saml.NameIDType{Text: "issuer"},
+				NameIDPolicy: tt.nameIDPolicy,
+			}
+			got := CreateAuthRequestToBusiness(context.Background(), authReq, "https://sp.example.com/acs", provider.PostBinding, "app1", "state", "agent1")
+			assert.Equal(t, tt.want, got.Request.(*domain.AuthRequestSAML).NameIDFormat)
+		})
+	}
+}
+
+func Test_setNameID(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  string
+		want    *saml.NameIDType
+		wantErr func(error) bool
+	}{
+		{
+			name:   "default, username",
+			format: "",
+			want:   &saml.NameIDType{Format: nameIDFormatEmailAddress, Text: "user@example.com"},
+		},
+		{
+			name:   "unspecified, username",
+			format: nameIDFormatUnspecified,
+			want:   &saml.NameIDType{Format: nameIDFormatUnspecified, Text: "user@example.com"},
+		},
+		{
+			name:   "email address, username",
+			format: nameIDFormatEmailAddress,
+			want:   &saml.NameIDType{Format: nameIDFormatEmailAddress, Text: "user@example.com"},
+		},
+		{
+			name:   "persistent, user id",
+			format: nameIDFormatPersistent,
+			want:   &saml.NameIDType{Format: nameIDFormatPersistent, Text: "user1"},
+		},
+		{
+			name:    "transient, not supported",
+			format:  "urn:oasis:names:tc:SAML:2.0:nameid-format:transient",
+			wantErr: caos_errs.IsErrorInvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user := testUser()
+			attributes := new(provider.Attributes)
+			setUserinfo(user, attributes, nil)
+			err := setNameID(user, attributes, tt.format)
+			if tt.wantErr != nil {
+				assert.True(t, tt.wantErr(err), "unexpected error: %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, attributes.GetNameID())
+		})
+	}
+}
+
+func Test_overwriteNameID(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  string
+		value   string
+		want    *saml.NameIDType
+		wantErr bool
+	}{
+		{
+			name:    "format missing",
+			value:   "value",
+			wantErr: true,
+		},
+		{
+			name:    "format not supported",
+			format:  "urn:oasis:names:tc:SAML:2.0:nameid-format:transient",
+			value:   "value",
+			wantErr: true,
+		},
+		{
+			name:   "email address",
+			format: nameIDFormatEmailAddress,
+			value:  "first.last@example.com",
+			want:   &saml.NameIDType{Format: nameIDFormatEmailAddress, Text: "first.last@example.com"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attributes := new(provider.Attributes)
+			err := overwriteNameID(attributes, tt.format, tt.value)
+			if tt.wantErr {
+				assert.True(t, caos_errs.IsErrorInvalidArgument(err), "unexpected error: %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, attributes.GetNameID())
+		})
+	}
+}
+
+func Test_setUserinfo(t *testing.T) {
+	basic := "urn:oasis:names:tc:SAML:2.0:attrname-format:basic"
+	tests := []struct {
+		name       string
+		user       *query.User
+		attributes []int
+		want       []*saml.AttributeType
+	}{
+		{
+			name: "all attributes of human",
+			user: testUser(),
+			want: []*saml.AttributeType{
+				{Name: "Email", NameFormat: basic, AttributeValue: []string{"first.last@example.com"}},
+				{Name: "SurName", NameFormat: basic, AttributeValue: []string{"last"}},
+				{Name: "FirstName", NameFormat: basic, AttributeValue: []string{"first"}},
+				{Name: "FullName", NameFormat: basic, AttributeValue: []string{"first last"}},
+				{Name: "UserName", NameFormat: basic, AttributeValue: []string{"user@example.com"}},
+				{Name: "UserID", NameFormat: basic, AttributeValue: []string{"user1"}},
+			},
+		},
+		{
+			name: "all attributes of machine",
+			user: &query.User{ID: "machine1", PreferredLoginName: "machine"},
+			want: []*saml.AttributeType{
+				{Name: "UserName", NameFormat: basic, AttributeValue: []string{"machine"}},
+				{Name: "UserID", NameFormat: basic, AttributeValue: []string{"machine1"}},
+			},
+		},
+		{
+			name:       "requested attributes",
+			user:       testUser(),
+			attributes: []int{provider.AttributeEmail, provider.AttributeUserID},
+			want: []*saml.AttributeType{
+				{Name: "Email", NameFormat: basic, AttributeValue: []string{"first.last@example.com"}},
+				{Name: "UserID", NameFormat: basic, AttributeValue: []string{"user1"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attributes := new(provider.Attributes)
+			setUserinfo(tt.user, attributes, tt.attributes)
+			assert.Equal(t, tt.want, attributes.GetSAML())
+		})
+	}
+}
+
+func Test_setCustomAttributes(t *testing.T) {
+	attributes := new(provider.Attributes)
+	attributes.SetUserID("user1")
+	err := setCustomAttributes(attributes,
+		[]string{"groups", "department"},
+		map[string]*customAttribute{
+			"department": {nameFormat: "urn:oasis:names:tc:SAML:2.0:attrname-format:uri", values: []string{"it"}},
+			"groups":     {values: []string{"admin", "user"}},
+		},
+	)
+	require.NoError(t, err)
+	basic := "urn:oasis:names:tc:SAML:2.0:attrname-format:basic"
+	assert.Equal(t, []*saml.AttributeType{
+		{Name: "UserID", NameFormat: basic, AttributeValue: []string{"user1"}},
+		{Name: "groups", NameFormat: basic, AttributeValue: []string{"admin", "user"}},
+		{Name: "department", NameFormat: "urn:oasis:names:tc:SAML:2.0:attrname-format:uri", AttributeValue: []string{"it"}},
+	}, attributes.GetSAML())
+}
@@ -0,0 +1,164 @@
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	caos_errs "github.com/dennigogo/zitadel/internal/errors"
+)
+
+// bulkIDReference matches references to resources created in the same bulk request (e.g. `bulkId:qwerty`)
+var bulkIDReference = regexp.MustCompile(`bulkId:([^"/\s]+)`)
+
+// BulkRequest is the body of a bulk request (RFC 7644 section 3.7)
+type BulkRequest struct {
+	Schemas      []string         `json:"schemas"`
+	FailOnErrors int              `json:"failOnErrors"`
+	Operations   []*BulkOperation `json:"Operations"`
+}
+
+type BulkOperation struct {
+	Method  string          `json:"method"`
+	BulkID  string          `json:"bulkId,omitempty"`
+	Version string          `json:"version,omitempty"`
+	Path    string          `json:"path"`
+	Data    json.RawMessage `json:"data,omitempty"`
+}
+
+type BulkResponse struct {
+	Schemas    []string                 `json:"schemas"`
+	Operations []*BulkOperationResponse `json:"Operations"`
+}
+
+type BulkOperationResponse struct {
+	Method   string      `json:"method"`
+	BulkID   string      `json:"bulkId,omitempty"`
+	Location string      `json:"location,omitempty"`
+	Status   string      `json:"status"`
+	Response interface{} `json:"response,omitempty"`
+}
+
+// bulk executes the operations in the given order,
+// the permissions are checked by each operation
+func (h *Handler) bulk(ctx context.Context, req *request) (*response, error) {
+	bulk := new(BulkRequest)
+	if err := unmarshalBody(req.body, bulk); err != nil {
+		return nil, err
+	}
+	if len(bulk.Operations) > maxBulkOperations {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "SCIM-Bu2k7", "Errors.SCIM.TooMany")
+	}
+	ids := make(map[string]string)
+	resp := &BulkResponse{
+		Schemas:    []string{schemaBulkResponse},
+		Operations: make([]*BulkOperationResponse, 0, len(bulk.Operations)),
+	}
+	var failed int
+	for _, operation := range bulk.Operations {
+		if bulk.FailOnErrors > 0 && failed >= bulk.FailOnErrors {
+			break
+		}
+		result := h.bulkOperation(ctx, req.orgID, operation, ids)
+		if result.Response != nil {
+			failed++
+		}
+		resp.Operations = append(resp.Operations, result)
+	}
+	return &response{status: http.StatusOK, resource: resp}, nil
+}
+
+// bulkOperation executes a single operation, only errors are returned as response
+func (h *Handler) bulkOperation(ctx context.Context, orgID string, operation *BulkOperation, ids map[string]string) *BulkOperationResponse {
+	result := &BulkOperationResponse{
+		Method: operation.Method,
+		BulkID: operation.BulkID,
+	}
+	resp, err := h.executeBulkOperation(ctx, orgID, operation, ids)
+	if err != nil {
+		scimErr := toError(err)
+		result.Status = scimErr.Status
+		result.Response = scimErr
+		return result
+	}
+	result.Status = strconv.Itoa(resp.status)
+	var id string
+	switch resource := resp.resource.(type) {
+	case *User:
+		id, result.Location = resource.ID, resource.Meta.Location
+	case *Group:
+		id, result.Location = resource.ID, resource.Meta.Location
+	}
+	if operation.BulkID != "" && id != "" {
+		ids[operation.BulkID] = id
+	}
+	return result
+}
+
+func (h *Handler) executeBulkOperation(ctx context.Context, orgID string, operation *BulkOperation, ids map[string]string) (*response, error) {
+	path, err := resolveBulkIDs(operation.Path, ids)
+	if err != nil {
+		return nil, err
+	}
+	data, err := resolveBulkIDs(string(operation.Data), ids)
+	if err != nil {
+		return nil, err
+	}
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segments) > 2 {
+		return nil, invalidPath()
+	}
+	var id string
+	if len(segments) == 2 {
+		id = segments[1]
+	}
+	op, err := h.bulkOperationHandler(strings.ToUpper(operation.Method), segments[0], id != "")
+	if err != nil {
+		return nil, err
+	}
+	return op(ctx, &request{
+		orgID: orgID,
+		id:    id,
+		body:  []byte(data),
+	})
+}
+
+func (h *Handler) bulkOperationHandler(method, endpoint string, hasID bool) (operation, error) {
+	switch {
+	case method == http.MethodPost && !hasID && endpoint == endpointUsers:
+		return h.createUser, nil
+	case method == http.MethodPut && hasID && endpoint == endpointUsers:
+		return h.replaceUser, nil
+	case method == http.MethodPatch && hasID && endpoint == endpointUsers:
+		return h.patchUser, nil
+	case method == http.MethodDelete && hasID && endpoint == endpointUsers:
+		return h.deleteUser, nil
+	case method == http.MethodPost && !hasID && endpoint == endpointGroups:
+		return h.createGroup, nil
+	case method == http.MethodPut && hasID && endpoint == endpointGroups:
+		return h.replaceGroup, nil
+	case method == http.MethodPatch && hasID && endpoint == endpointGroups:
+		return h.patchGroup, nil
+	case method == http.MethodDelete && hasID && endpoint == endpointGroups:
+		return h.deleteGroup, nil
+	default:
+		return nil, invalidPath()
+	}
+}
+
+// resolveBulkIDs replaces the references to resources created in the same request by their ids
+func resolveBulkIDs(s string, ids map[string]string) (string, error) {
+	var err error
+	resolved := bulkIDReference.ReplaceAllStringFunc(s, func(reference string) string {
+		id, ok := ids[strings.TrimPrefix(reference, "bulkId:")]
+		if !ok {
+			err = caos_errs.ThrowInvalidArgument(nil, "SCIM-Bu6r3", "Errors.SCIM.InvalidValue")
+			return reference
+		}
+		return id
+	})
+	return resolved, err
+}
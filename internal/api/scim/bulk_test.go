@@ -0,0 +1,136 @@
+package scim
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_bulk(t *testing.T) {
+	type res struct {
+		status   int
+		statuses []string
+		members  []string
+	}
+	tests := []struct {
+		name  string
+		token string
+		body  string
+		res   res
+	}{
+		{
+			name:  "create and patch group by bulk id",
+			token: "owner",
+			body: `{"schemas": ["urn:ietf:params:scim:api:messages:2.0:BulkRequest"], "Operations": [
+				{"method": "POST", "path": "/Groups", "bulkId": "readers", "data": {"displayName": "Readers"}},
+				{"method": "PATCH", "path": "/Groups/bulkId:readers", "data": {"Operations": [{"op": "add", "path": "members", "value": [{"value": "human2"}]}]}}
+			]}`,
+			res: res{
+				status:   http.StatusOK,
+				statuses: []string{"201", "200"},
+				members:  []string{"human2"},
+			},
+		},
+		{
+			name:  "unknown bulk id",
+			token: "owner",
+			body: `{"Operations": [
+				{"method": "PATCH", "path": "/Groups/bulkId:unknown", "data": {"Operations": []}},
+				{"method": "DELETE", "path": "/Groups/group1"}
+			]}`,
+			res: res{
+				status:   http.StatusOK,
+				statuses: []string{"400", "204"},
+			},
+		},
+		{
+			name:  "fail on errors, stopped",
+			token: "owner",
+			body: `{"failOnErrors": 1, "Operations": [
+				{"method": "POST", "path": "/Groups", "data": {"displayName": "Admins"}},
+				{"method": "DELETE", "path": "/Groups/group1"}
+			]}`,
+			res: res{
+				status:   http.StatusOK,
+				statuses: []string{"409"},
+			},
+		},
+		{
+			name:  "invalid path",
+			token: "owner",
+			body:  `{"Operations": [{"method": "POST", "path": "/Groups/group1/members"}]}`,
+			res: res{
+				status:   http.StatusOK,
+				statuses: []string{"400"},
+			},
+		},
+		{
+			name:  "permission missing, forbidden",
+			token: "reader",
+			body:  `{"Operations": [{"method": "POST", "path": "/Groups", "data": {"displayName": "Readers"}}]}`,
+			res: res{
+				status:   http.StatusOK,
+				statuses: []string{"403"},
+			},
+		},
+		{
+			name:  "too many operations",
+			token: "owner",
+			body:  `{"Operations": [` + strings.Repeat(`{"method": "DELETE", "path": "/Groups/group1"},`, maxBulkOperations) + `{"method": "DELETE", "path": "/Groups/group1"}]}`,
+			res: res{
+				status: http.StatusBadRequest,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newTestStore()
+			store.addGroup("group1", "Admins", "human1")
+			resp := serve(newTestHandler(store), http.MethodPost, "/org1/Bulk", tt.token, tt.body)
+			require.Equal(t, tt.res.status, resp.Code, resp.Body.String())
+			if tt.res.statuses == nil {
+				return
+			}
+			bulk := new(BulkResponse)
+			require.NoError(t, json.Unmarshal(resp.Body.Bytes(), bulk))
+			statuses := make([]string, len(bulk.Operations))
+			for i, operation := range bulk.Operations {
+				statuses[i] = operation.Status
+			}
+			assert.Equal(t, tt.res.statuses, statuses)
+			if tt.res.members != nil {
+				assert.Equal(t, tt.res.members, store.members("id1"))
+			}
+		})
+	}
+}
+
+func Test_resolveBulkIDs(t *testing.T) {
+	ids := map[string]string{"readers": "group2", "user": "user1"}
+	tests := []struct {
+		name    string
+		s       string
+		want    string
+		wantErr bool
+	}{
+		{name: "no reference", s: "/Groups/group1", want: "/Groups/group1"},
+		{name: "path", s: "/Groups/bulkId:readers", want: "/Groups/group2"},
+		{name: "data", s: `{"members": [{"value": "bulkId:user"}]}`, want: `{"members": [{"value": "user1"}]}`},
+		{name: "unknown", s: "/Groups/bulkId:unknown", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolveBulkIDs(tt.s, ids)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,237 @@
+package scim
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"strings"
+
+	caos_errs "github.com/dennigogo/zitadel/internal/errors"
+)
+
+const (
+	operatorEqual      = "eq"
+	operatorNotEqual   = "ne"
+	operatorContains   = "co"
+	operatorStartsWith = "sw"
+	operatorEndsWith   = "ew"
+	operatorPresent    = "pr"
+)
+
+// filter is a list of expressions which all have to match (joined by `and`),
+// `or`, `not`, comparisons of order and complex attribute filters are not supported
+type filter []*expression
+
+type expression struct {
+	// attribute is the lower case attribute path without the schema (e.g. `name.givenname`)
+	attribute string
+	operator  string
+	// value is either a string, bool, float64 or nil
+	value interface{}
+}
+
+func parseFilter(s string) (filter, error) {
+	tokens, err := tokenize(s)
+	if err != nil {
+		return nil, err
+	}
+	f := make(filter, 0, len(tokens)/3+1)
+	for len(tokens) > 0 {
+		if len(f) > 0 {
+			if !strings.EqualFold(tokens[0], "and") {
+				return nil, invalidFilter(nil)
+			}
+			tokens = tokens[1:]
+		}
+		if len(tokens) < 2 {
+			return nil, invalidFilter(nil)
+		}
+		expr := &expression{
+			attribute: attributePath(tokens[0]),
+			operator:  strings.ToLower(tokens[1]),
+		}
+		tokens = tokens[2:]
+		switch expr.operator {
+		case operatorPresent:
+		case operatorEqual, operatorNotEqual, operatorContains, operatorStartsWith, operatorEndsWith:
+			if len(tokens) == 0 {
+				return nil, invalidFilter(nil)
+			}
+			if err = json.Unmarshal([]byte(tokens[0]), &expr.value); err != nil {
+				return nil, invalidFilter(err)
+			}
+			tokens = tokens[1:]
+		default:
+			return nil, invalidFilter(nil)
+		}
+		f = append(f, expr)
+	}
+	return f, nil
+}
+
+// tokenize splits the filter by whitespaces, quoted strings are kept as one token including the quotes
+func tokenize(s string) ([]string, error) {
+	tokens := make([]string, 0, 3)
+	for i := 0; i < len(s); {
+		switch c := s[i]; {
+		case c == ' ':
+			i++
+		case c == '(' || c == ')' || c == '[' || c == ']':
+			return nil, invalidFilter(nil)
+		case c == '"':
+			end := i + 1
+			for ; end < len(s) && s[end] != '"'; end++ {
+				if s[end] == '\\' {
+					end++
+				}
+			}
+			if end >= len(s) {
+				return nil, invalidFilter(nil)
+			}
+			tokens = append(tokens, s[i:end+1])
+			i = end + 1
+		default:
+			end := strings.IndexAny(s[i:], " \"()[]")
+			if end < 0 {
+				end = len(s) - i
+			}
+			tokens = append(tokens, s[i:i+end])
+			i += end
+		}
+	}
+	return tokens, nil
+}
+
+// attributePath removes the core schemas from the path and returns it in lower case
+func attributePath(path string) string {
+	path = strings.ToLower(path)
+	for _, schema := range []string{schemaUser, schemaGroup} {
+		prefix := strings.ToLower(schema) + ":"
+		if strings.HasPrefix(path, prefix) {
+			return strings.TrimPrefix(path, prefix)
+		}
+	}
+	return path
+}
+
+func invalidFilter(err error) error {
+	return caos_errs.ThrowInvalidArgument(err, "SCIM-Fl4t8", "Errors.SCIM.InvalidFilter")
+}
+
+// stringValue returns the value of a string comparison
+func (e *expression) stringValue() (string, error) {
+	value, ok := e.value.(string)
+	if !ok {
+		return "", invalidFilter(nil)
+	}
+	return value, nil
+}
+
+// matches compares the expression with a value of a resource, strings are compared case insensitive
+func (e *expression) matches(value interface{}, caseExact bool) bool {
+	if e.operator == operatorPresent {
+		s, ok := value.(string)
+		return value != nil && (!ok || s != "")
+	}
+	expected, ok := e.value.(string)
+	if !ok {
+		return e.operator == operatorEqual && value == e.value ||
+			e.operator == operatorNotEqual && value != e.value
+	}
+	actual, ok := value.(string)
+	if !ok {
+		return e.operator == operatorNotEqual
+	}
+	if !caseExact {
+		expected = strings.ToLower(expected)
+		actual = strings.ToLower(actual)
+	}
+	switch e.operator {
+	case operatorEqual:
+		return actual == expected
+	case operatorNotEqual:
+		return actual != expected
+	case operatorContains:
+		return strings.Contains(actual, expected)
+	case operatorStartsWith:
+		return strings.HasPrefix(actual, expected)
+	case operatorEndsWith:
+		return strings.HasSuffix(actual, expected)
+	}
+	return false
+}
+
+// listRequest contains the filter and the page of a list or search request
+type listRequest struct {
+	filter filter
+	// startIndex is 1-based
+	startIndex uint64
+	count      uint64
+}
+
+func listRequestFromQuery(values url.Values) (_ *listRequest, err error) {
+	req := &listRequest{
+		startIndex: 1,
+		count:      maxResults,
+	}
+	if f := values.Get("filter"); f != "" {
+		if req.filter, err = parseFilter(f); err != nil {
+			return nil, err
+		}
+	}
+	if startIndex := values.Get("startIndex"); startIndex != "" {
+		if req.startIndex, err = strconv.ParseUint(startIndex, 10, 64); err != nil {
+			return nil, caos_errs.ThrowInvalidArgument(err, "SCIM-Lq8v2", "Errors.SCIM.InvalidValue")
+		}
+	}
+	if count := values.Get("count"); count != "" {
+		if req.count, err = strconv.ParseUint(count, 10, 64); err != nil {
+			return nil, caos_errs.ThrowInvalidArgument(err, "SCIM-Lq3c9", "Errors.SCIM.InvalidValue")
+		}
+	}
+	req.sanitize()
+	return req, nil
+}
+
+// SearchRequest is the body of a search using POST (RFC 7644 section 3.4.3)
+type SearchRequest struct {
+	Schemas    []string `json:"schemas"`
+	Filter     string   `json:"filter"`
+	StartIndex uint64   `json:"startIndex"`
+	Count      *uint64  `json:"count"`
+}
+
+func listRequestFromBody(body []byte) (_ *listRequest, err error) {
+	search := new(SearchRequest)
+	if err = unmarshalBody(body, search); err != nil {
+		return nil, err
+	}
+	req := &listRequest{
+		startIndex: search.StartIndex,
+		count:      maxResults,
+	}
+	if search.Count != nil {
+		req.count = *search.Count
+	}
+	if search.Filter != "" {
+		if req.filter, err = parseFilter(search.Filter); err != nil {
+			return nil, err
+		}
+	}
+	req.sanitize()
+	return req, nil
+}
+
+func (r *listRequest) sanitize() {
+	if r.startIndex < 1 {
+		r.startIndex = 1
+	}
+	if r.count > maxResults {
+		r.count = maxResults
+	}
+}
+
+// offset returns the 0-based offset of the page
+func (r *listRequest) offset() uint64 {
+	return r.startIndex - 1
+}
@@ -0,0 +1,218 @@
+package scim
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	caos_errs "github.com/dennigogo/zitadel/internal/errors"
+)
+
+func Test_parseFilter(t *testing.T) {
+	type args struct {
+		filter string
+	}
+	type res struct {
+		filter  filter
+		errFunc func(error) bool
+	}
+	tests := []struct {
+		name string
+		args args
+		res  res
+	}{
+		{
+			name: "equal",
+			args: args{`userName eq "Bjensen"`},
+			res: res{
+				filter: filter{{attribute: "username", operator: operatorEqual, value: "Bjensen"}},
+			},
+		},
+		{
+			name: "schema prefix, sub attribute",
+			args: args{`urn:ietf:params:scim:schemas:core:2.0:User:name.familyName co "O'Malley"`},
+			res: res{
+				filter: filter{{attribute: "name.familyname", operator: operatorContains, value: "O'Malley"}},
+			},
+		},
+		{
+			name: "quoted whitespaces and escapes",
+			args: args{`displayName EQ "the \"best\" group"`},
+			res: res{
+				filter: filter{{attribute: "displayname", operator: operatorEqual, value: `the "best" group`}},
+			},
+		},
+		{
+			name: "present and boolean joined by and",
+			args: args{`title pr and active eq true`},
+			res: res{
+				filter: filter{
+					{attribute: "title", operator: operatorPresent},
+					{attribute: "active", operator: operatorEqual, value: true},
+				},
+			},
+		},
+		{
+			name: "or not supported",
+			args: args{`title pr or active eq true`},
+			res: res{
+				errFunc: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "grouping not supported",
+			args: args{`emails[type eq "work"]`},
+			res: res{
+				errFunc: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "unknown operator",
+			args: args{`meta.lastModified gt "2011-05-13T04:42:34Z"`},
+			res: res{
+				errFunc: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "missing value",
+			args: args{`userName eq`},
+			res: res{
+				errFunc: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "unterminated string",
+			args: args{`userName eq "bjensen`},
+			res: res{
+				errFunc: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseFilter(tt.args.filter)
+			if tt.res.errFunc != nil {
+				assert.True(t, tt.res.errFunc(err), "unexpected error: %v", err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.res.filter, got)
+		})
+	}
+}
+
+func Test_expressionMatches(t *testing.T) {
+	type args struct {
+		expr      *expression
+		value     interface{}
+		caseExact bool
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "equal case insensitive",
+			args: args{&expression{operator: operatorEqual, value: "BJensen"}, "bjensen", false},
+			want: true,
+		},
+		{
+			name: "equal case exact",
+			args: args{&expression{operator: operatorEqual, value: "BJensen"}, "bjensen", true},
+			want: false,
+		},
+		{
+			name: "not equal",
+			args: args{&expression{operator: operatorNotEqual, value: "a"}, "b", false},
+			want: true,
+		},
+		{
+			name: "starts with",
+			args: args{&expression{operator: operatorStartsWith, value: "bj"}, "bjensen", false},
+			want: true,
+		},
+		{
+			name: "ends with",
+			args: args{&expression{operator: operatorEndsWith, value: "@example.com"}, "bjensen@example.com", false},
+			want: true,
+		},
+		{
+			name: "present empty string",
+			args: args{&expression{operator: operatorPresent}, "", false},
+			want: false,
+		},
+		{
+			name: "present boolean",
+			args: args{&expression{operator: operatorPresent}, false, false},
+			want: true,
+		},
+		{
+			name: "boolean",
+			args: args{&expression{operator: operatorEqual, value: true}, true, false},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.args.expr.matches(tt.args.value, tt.args.caseExact))
+		})
+	}
+}
+
+func Test_listRequestFromQuery(t *testing.T) {
+	type res struct {
+		req     *listRequest
+		errFunc func(error) bool
+	}
+	tests := []struct {
+		name   string
+		values url.Values
+		res    res
+	}{
+		{
+			name:   "defaults",
+			values: url.Values{},
+			res: res{
+				req: &listRequest{startIndex: 1, count: maxResults},
+			},
+		},
+		{
+			name:   "sanitized",
+			values: url.Values{"startIndex": {"0"}, "count": {"1000"}},
+			res: res{
+				req: &listRequest{startIndex: 1, count: maxResults},
+			},
+		},
+		{
+			name:   "page and filter",
+			values: url.Values{"startIndex": {"11"}, "count": {"10"}, "filter": {`userName sw "b"`}},
+			res: res{
+				req: &listRequest{
+					filter:     filter{{attribute: "username", operator: operatorStartsWith, value: "b"}},
+					startIndex: 11,
+					count:      10,
+				},
+			},
+		},
+		{
+			name:   "invalid count",
+			values: url.Values{"count": {"-1"}},
+			res: res{
+				errFunc: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := listRequestFromQuery(tt.values)
+			if tt.res.errFunc != nil {
+				assert.True(t, tt.res.errFunc(err), "unexpected error: %v", err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.res.req, got)
+		})
+	}
+}
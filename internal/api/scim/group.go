@@ -0,0 +1,426 @@
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/dennigogo/zitadel/internal/domain"
+	caos_errs "github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/query"
+)
+
+const (
+	// metadataKeyGroupPrefix is the prefix of the organisation metadata a group is stored in,
+	// followed by the id of the group
+	metadataKeyGroupPrefix = "scim.group."
+	// metadataKeyGroupMemberPrefix is the prefix of the organisation metadata a member of a group is stored in,
+	// followed by the id of the group and the id of the user (e.g. `scim.groupmember.{groupId}.{userId}`).
+	// Every member is stored separately, so concurrent changes of the members of a group don't overwrite each other.
+	metadataKeyGroupMemberPrefix = "scim.groupmember."
+)
+
+// Group is the resource of a group of users (RFC 7643 section 4.2)
+type Group struct {
+	Schemas     []string       `json:"schemas"`
+	ID          string         `json:"id,omitempty"`
+	ExternalID  string         `json:"externalId,omitempty"`
+	DisplayName string         `json:"displayName"`
+	Members     []*GroupMember `json:"members,omitempty"`
+	Meta        *Meta          `json:"meta,omitempty"`
+}
+
+type GroupMember struct {
+	Value string `json:"value"`
+	Ref   string `json:"$ref,omitempty"`
+	Type  string `json:"type,omitempty"`
+}
+
+// storedGroup is the value of the metadata of a group, the members are stored separately
+type storedGroup struct {
+	DisplayName string `json:"displayName"`
+	ExternalID  string `json:"externalId,omitempty"`
+}
+
+func groupMemberKey(groupID, userID string) string {
+	return metadataKeyGroupMemberPrefix + groupID + "." + userID
+}
+
+// parseGroupMemberKey returns the id of the group and of the user of the metadata key of a member
+func parseGroupMemberKey(key string) (groupID, userID string, ok bool) {
+	return strings.Cut(strings.TrimPrefix(key, metadataKeyGroupMemberPrefix), ".")
+}
+
+// groupToSCIM maps the metadata of the group and its members to the resource,
+// the version and the last modification include the changes of the members
+func (h *Handler) groupToSCIM(ctx context.Context, metadata *query.OrgMetadata, members []*query.OrgMetadata) (*Group, error) {
+	stored := new(storedGroup)
+	if err := json.Unmarshal(metadata.Value, stored); err != nil {
+		return nil, caos_errs.ThrowInternal(err, "SCIM-Gr3j8", "Errors.Internal")
+	}
+	id := strings.TrimPrefix(metadata.Key, metadataKeyGroupPrefix)
+	group := &Group{
+		Schemas:     []string{schemaGroup},
+		ID:          id,
+		ExternalID:  stored.ExternalID,
+		DisplayName: stored.DisplayName,
+		Members:     make([]*GroupMember, 0, len(members)),
+		Meta: &Meta{
+			ResourceType: resourceTypeGroup,
+			Created:      metadata.CreationDate,
+			LastModified: metadata.ChangeDate,
+			Location:     h.location(ctx, metadata.ResourceOwner, endpointGroups, id),
+		},
+	}
+	sequence := metadata.Sequence
+	for _, member := range members {
+		_, userID, ok := parseGroupMemberKey(member.Key)
+		if !ok {
+			continue
+		}
+		group.Members = append(group.Members, &GroupMember{
+			Value: userID,
+			Ref:   h.location(ctx, metadata.ResourceOwner, endpointUsers, userID),
+			Type:  resourceTypeUser,
+		})
+		if member.Sequence > sequence {
+			sequence = member.Sequence
+		}
+		if member.ChangeDate.After(group.Meta.LastModified) {
+			group.Meta.LastModified = member.ChangeDate
+		}
+	}
+	group.Meta.Version = version(sequence)
+	return group, nil
+}
+
+func (h *Handler) getGroup(ctx context.Context, req *request) (*response, error) {
+	if err := checkPermission(ctx, permissionRead); err != nil {
+		return nil, err
+	}
+	group, err := h.group(ctx, req.orgID, req.id)
+	if err != nil {
+		return nil, err
+	}
+	return &response{status: http.StatusOK, resource: group}, nil
+}
+
+func (h *Handler) listGroups(ctx context.Context, req *request) (*response, error) {
+	if err := checkPermission(ctx, permissionRead); err != nil {
+		return nil, err
+	}
+	list, err := listRequestFromQuery(req.query)
+	if err != nil {
+		return nil, err
+	}
+	return h.searchGroupResources(ctx, req.orgID, list)
+}
+
+func (h *Handler) searchGroups(ctx context.Context, req *request) (*response, error) {
+	if err := checkPermission(ctx, permissionRead); err != nil {
+		return nil, err
+	}
+	list, err := listRequestFromBody(req.body)
+	if err != nil {
+		return nil, err
+	}
+	return h.searchGroupResources(ctx, req.orgID, list)
+}
+
+// searchGroupResources filters and pages the groups in memory
+func (h *Handler) searchGroupResources(ctx context.Context, orgID string, list *listRequest) (*response, error) {
+	groups, err := h.groups(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	matching := make([]*Group, 0, len(groups))
+	for _, group := range groups {
+		matches, err := group.matches(list.filter)
+		if err != nil {
+			return nil, err
+		}
+		if matches {
+			matching = append(matching, group)
+		}
+	}
+	total := uint64(len(matching))
+	page := make([]*Group, 0, list.count)
+	if offset := list.offset(); offset < total {
+		end := offset + list.count
+		if end > total {
+			end = total
+		}
+		page = append(page, matching[offset:end]...)
+	}
+	return listResponse(page, total, list.startIndex, uint64(len(page))), nil
+}
+
+func (g *Group) matches(f filter) (bool, error) {
+	for _, expr := range f {
+		var matches bool
+		switch expr.attribute {
+		case "id":
+			matches = expr.matches(g.ID, true)
+		case "externalid":
+			matches = expr.matches(g.ExternalID, true)
+		case "displayname":
+			matches = expr.matches(g.DisplayName, false)
+		case "members", "members.value":
+			if expr.operator == operatorPresent {
+				matches = len(g.Members) > 0
+				break
+			}
+			for _, member := range g.Members {
+				if expr.matches(member.Value, true) {
+					matches = true
+					break
+				}
+			}
+		default:
+			return false, invalidFilter(nil)
+		}
+		if !matches {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (h *Handler) createGroup(ctx context.Context, req *request) (*response, error) {
+	if err := checkPermission(ctx, permissionWrite); err != nil {
+		return nil, err
+	}
+	group := new(Group)
+	if err := unmarshalBody(req.body, group); err != nil {
+		return nil, err
+	}
+	id, err := h.idGenerator.Next()
+	if err != nil {
+		return nil, err
+	}
+	if err = h.saveGroup(ctx, req.orgID, id, nil, group); err != nil {
+		return nil, err
+	}
+	created, err := h.group(ctx, req.orgID, id)
+	if err != nil {
+		return nil, err
+	}
+	return &response{
+		status:   http.StatusCreated,
+		resource: created,
+		location: created.Meta.Location,
+	}, nil
+}
+
+func (h *Handler) replaceGroup(ctx context.Context, req *request) (*response, error) {
+	if err := checkPermission(ctx, permissionWrite); err != nil {
+		return nil, err
+	}
+	current, err := h.group(ctx, req.orgID, req.id)
+	if err != nil {
+		return nil, err
+	}
+	group := new(Group)
+	if err = unmarshalBody(req.body, group); err != nil {
+		return nil, err
+	}
+	return h.updateGroup(ctx, req.orgID, current, group)
+}
+
+func (h *Handler) patchGroup(ctx context.Context, req *request) (*response, error) {
+	if err := checkPermission(ctx, permissionWrite); err != nil {
+		return nil, err
+	}
+	current, err := h.group(ctx, req.orgID, req.id)
+	if err != nil {
+		return nil, err
+	}
+	group := new(Group)
+	if err = patchResource(req.body, current, group); err != nil {
+		return nil, err
+	}
+	return h.updateGroup(ctx, req.orgID, current, group)
+}
+
+func (h *Handler) updateGroup(ctx context.Context, orgID string, current, group *Group) (*response, error) {
+	if err := h.saveGroup(ctx, orgID, current.ID, current, group); err != nil {
+		return nil, err
+	}
+	updated, err := h.group(ctx, orgID, current.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &response{status: http.StatusOK, resource: updated}, nil
+}
+
+func (h *Handler) deleteGroup(ctx context.Context, req *request) (*response, error) {
+	if err := checkPermission(ctx, permissionDelete); err != nil {
+		return nil, err
+	}
+	group, err := h.group(ctx, req.orgID, req.id)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]string, 0, len(group.Members)+1)
+	for _, member := range group.Members {
+		keys = append(keys, groupMemberKey(group.ID, member.Value))
+	}
+	keys = append(keys, metadataKeyGroupPrefix+group.ID)
+	if err = h.removeMetadata(ctx, req.orgID, keys...); err != nil {
+		return nil, err
+	}
+	return &response{status: http.StatusNoContent}, nil
+}
+
+// saveGroup validates and stores the group,
+// members which are not part of the current group must be users of the organisation.
+// Only the changes to the current group are written, so members added or removed concurrently are kept.
+func (h *Handler) saveGroup(ctx context.Context, orgID, id string, current, group *Group) error {
+	if group.DisplayName == "" {
+		return caos_errs.ThrowInvalidArgument(nil, "SCIM-Gr8c2", "Errors.SCIM.InvalidValue")
+	}
+	groups, err := h.groups(ctx, orgID)
+	if err != nil {
+		return err
+	}
+	for _, existing := range groups {
+		if existing.ID != id && strings.EqualFold(existing.DisplayName, group.DisplayName) {
+			return caos_errs.ThrowAlreadyExists(nil, "SCIM-Gr5v7", "Errors.SCIM.Group.Taken")
+		}
+	}
+	set := make([]*domain.Metadata, 0, len(group.Members)+1)
+	if current == nil || current.DisplayName != group.DisplayName || current.ExternalID != group.ExternalID {
+		value, err := json.Marshal(&storedGroup{
+			DisplayName: group.DisplayName,
+			ExternalID:  group.ExternalID,
+		})
+		if err != nil {
+			return caos_errs.ThrowInternal(err, "SCIM-Gr6b9", "Errors.Internal")
+		}
+		set = append(set, &domain.Metadata{Key: metadataKeyGroupPrefix + id, Value: value})
+	}
+	currentMembers := make(map[string]bool)
+	if current != nil {
+		for _, member := range current.Members {
+			currentMembers[member.Value] = true
+		}
+	}
+	members := make(map[string]bool, len(group.Members))
+	for _, member := range group.Members {
+		if member.Value == "" || members[member.Value] {
+			continue
+		}
+		members[member.Value] = true
+		if currentMembers[member.Value] {
+			continue
+		}
+		if _, err = h.user(ctx, orgID, member.Value); err != nil {
+			return caos_errs.ThrowInvalidArgument(err, "SCIM-Gr1m4", "Errors.SCIM.Group.UnknownMember")
+		}
+		set = append(set, &domain.Metadata{Key: groupMemberKey(id, member.Value), Value: []byte(member.Value)})
+	}
+	removed := make([]string, 0)
+	for member := range currentMembers {
+		if !members[member] {
+			removed = append(removed, groupMemberKey(id, member))
+		}
+	}
+	if len(set) > 0 {
+		if _, err = h.commands.BulkSetOrgMetadata(ctx, orgID, set...); err != nil {
+			return err
+		}
+	}
+	return h.removeMetadata(ctx, orgID, removed...)
+}
+
+// removeGroupMember removes the user from all groups of the organisation
+func (h *Handler) removeGroupMember(ctx context.Context, orgID, userID string) error {
+	members, err := h.searchMetadata(ctx, orgID, metadataKeyGroupMemberPrefix)
+	if err != nil {
+		return err
+	}
+	keys := make([]string, 0)
+	for _, member := range members {
+		if _, memberID, ok := parseGroupMemberKey(member.Key); ok && memberID == userID {
+			keys = append(keys, member.Key)
+		}
+	}
+	return h.removeMetadata(ctx, orgID, keys...)
+}
+
+// removeMetadata removes the metadata of the organisation,
+// keys which were already removed (e.g. by a concurrent request) are skipped
+func (h *Handler) removeMetadata(ctx context.Context, orgID string, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	_, err := h.commands.BulkRemoveOrgMetadata(ctx, orgID, keys...)
+	if !caos_errs.IsNotFound(err) {
+		return err
+	}
+	for _, key := range keys {
+		if _, err = h.commands.RemoveOrgMetadata(ctx, orgID, key); err != nil && !caos_errs.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h *Handler) group(ctx context.Context, orgID, id string) (*Group, error) {
+	metadata, err := h.queries.GetOrgMetadataByKey(ctx, true, orgID, metadataKeyGroupPrefix+id)
+	if err != nil {
+		if caos_errs.IsNotFound(err) {
+			return nil, caos_errs.ThrowNotFound(err, "SCIM-Gr4n1", "Errors.SCIM.Group.NotFound")
+		}
+		return nil, err
+	}
+	members, err := h.searchMetadata(ctx, orgID, metadataKeyGroupMemberPrefix+id+".")
+	if err != nil {
+		return nil, err
+	}
+	return h.groupToSCIM(ctx, metadata, members)
+}
+
+func (h *Handler) groups(ctx context.Context, orgID string) ([]*Group, error) {
+	metadata, err := h.searchMetadata(ctx, orgID, metadataKeyGroupPrefix)
+	if err != nil {
+		return nil, err
+	}
+	memberMetadata, err := h.searchMetadata(ctx, orgID, metadataKeyGroupMemberPrefix)
+	if err != nil {
+		return nil, err
+	}
+	members := make(map[string][]*query.OrgMetadata, len(metadata))
+	for _, member := range memberMetadata {
+		if groupID, _, ok := parseGroupMemberKey(member.Key); ok {
+			members[groupID] = append(members[groupID], member)
+		}
+	}
+	groups := make([]*Group, len(metadata))
+	for i, data := range metadata {
+		if groups[i], err = h.groupToSCIM(ctx, data, members[strings.TrimPrefix(data.Key, metadataKeyGroupPrefix)]); err != nil {
+			return nil, err
+		}
+	}
+	return groups, nil
+}
+
+// searchMetadata returns the metadata of the organisation with the key prefix, sorted by key
+func (h *Handler) searchMetadata(ctx context.Context, orgID, keyPrefix string) ([]*query.OrgMetadata, error) {
+	keyQuery, err := query.NewOrgMetadataKeySearchQuery(keyPrefix, query.TextStartsWith)
+	if err != nil {
+		return nil, err
+	}
+	metadata, err := h.queries.SearchOrgMetadata(ctx, true, orgID, &query.OrgMetadataSearchQueries{
+		SearchRequest: query.SearchRequest{
+			SortingColumn: query.OrgMetadataKeyCol,
+			Asc:           true,
+		},
+		Queries: []query.SearchQuery{keyQuery},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return metadata.Metadata, nil
+}
@@ -0,0 +1,254 @@
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dennigogo/zitadel/internal/api/authz"
+)
+
+func TestHandler_groups(t *testing.T) {
+	type res struct {
+		status   int
+		members  []string
+		keys     []string
+		location bool
+	}
+	tests := []struct {
+		name   string
+		method string
+		target string
+		body   string
+		res    res
+	}{
+		{
+			name:   "create group, created",
+			method: http.MethodPost,
+			target: "/org1/Groups",
+			body:   `{"displayName": "Readers", "members": [{"value": "human1"}, {"value": "human1"}]}`,
+			res: res{
+				status:   http.StatusCreated,
+				keys:     []string{"scim.group.group1", "scim.group.id1", "scim.groupmember.group1.human1", "scim.groupmember.id1.human1"},
+				location: true,
+			},
+		},
+		{
+			name:   "create group, display name taken",
+			method: http.MethodPost,
+			target: "/org1/Groups",
+			body:   `{"displayName": "admins"}`,
+			res: res{
+				status: http.StatusConflict,
+				keys:   []string{"scim.group.group1", "scim.groupmember.group1.human1"},
+			},
+		},
+		{
+			name:   "create group, unknown member",
+			method: http.MethodPost,
+			target: "/org1/Groups",
+			body:   `{"displayName": "Readers", "members": [{"value": "unknown"}]}`,
+			res: res{
+				status: http.StatusBadRequest,
+				keys:   []string{"scim.group.group1", "scim.groupmember.group1.human1"},
+			},
+		},
+		{
+			name:   "create group, machine member",
+			method: http.MethodPost,
+			target: "/org1/Groups",
+			body:   `{"displayName": "Readers", "members": [{"value": "machine1"}]}`,
+			res: res{
+				status: http.StatusBadRequest,
+				keys:   []string{"scim.group.group1", "scim.groupmember.group1.human1"},
+			},
+		},
+		{
+			name:   "get group, not found",
+			method: http.MethodGet,
+			target: "/org1/Groups/unknown",
+			res: res{
+				status: http.StatusNotFound,
+				keys:   []string{"scim.group.group1", "scim.groupmember.group1.human1"},
+			},
+		},
+		{
+			name:   "patch group, add member",
+			method: http.MethodPatch,
+			target: "/org1/Groups/group1",
+			body:   `{"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"], "Operations": [{"op": "add", "path": "members", "value": [{"value": "human2"}]}]}`,
+			res: res{
+				status:  http.StatusOK,
+				members: []string{"human1", "human2"},
+				keys:    []string{"scim.group.group1", "scim.groupmember.group1.human1", "scim.groupmember.group1.human2"},
+			},
+		},
+		{
+			name:   "patch group, remove member",
+			method: http.MethodPatch,
+			target: "/org1/Groups/group1",
+			body:   `{"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"], "Operations": [{"op": "remove", "path": "members[value eq \"human1\"]"}]}`,
+			res: res{
+				status:  http.StatusOK,
+				members: []string{},
+				keys:    []string{"scim.group.group1"},
+			},
+		},
+		{
+			name:   "patch group, unknown member",
+			method: http.MethodPatch,
+			target: "/org1/Groups/group1",
+			body:   `{"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"], "Operations": [{"op": "add", "path": "members", "value": [{"value": "unknown"}]}]}`,
+			res: res{
+				status: http.StatusBadRequest,
+				keys:   []string{"scim.group.group1", "scim.groupmember.group1.human1"},
+			},
+		},
+		{
+			name:   "replace group, ok",
+			method: http.MethodPut,
+			target: "/org1/Groups/group1",
+			body:   `{"displayName": "Owners", "members": [{"value": "human2"}, {"value": "human3"}]}`,
+			res: res{
+				status:  http.StatusOK,
+				members: []string{"human2", "human3"},
+				keys:    []string{"scim.group.group1", "scim.groupmember.group1.human2", "scim.groupmember.group1.human3"},
+			},
+		},
+		{
+			name:   "replace group, display name missing",
+			method: http.MethodPut,
+			target: "/org1/Groups/group1",
+			body:   `{"members": [{"value": "human2"}]}`,
+			res: res{
+				status: http.StatusBadRequest,
+				keys:   []string{"scim.group.group1", "scim.groupmember.group1.human1"},
+			},
+		},
+		{
+			name:   "delete group, members removed",
+			method: http.MethodDelete,
+			target: "/org1/Groups/group1",
+			res: res{
+				status: http.StatusNoContent,
+				keys:   []string{},
+			},
+		},
+		{
+			name:   "delete user, removed from groups",
+			method: http.MethodDelete,
+			target: "/org1/Users/human1",
+			res: res{
+				status: http.StatusNoContent,
+				keys:   []string{"scim.group.group1"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newTestStore()
+			store.addGroup("group1", "Admins", "human1")
+			resp := serve(newTestHandler(store), tt.method, tt.target, "owner", tt.body)
+			require.Equal(t, tt.res.status, resp.Code, resp.Body.String())
+			assert.Equal(t, tt.res.keys, store.keys())
+			if tt.res.location {
+				assert.NotEmpty(t, resp.Header().Get("Location"))
+			}
+			if tt.res.members != nil {
+				group := new(Group)
+				require.NoError(t, json.Unmarshal(resp.Body.Bytes(), group))
+				members := make([]string, 0, len(group.Members))
+				for _, member := range group.Members {
+					members = append(members, member.Value)
+				}
+				assert.Equal(t, tt.res.members, members)
+			}
+		})
+	}
+}
+
+func TestHandler_saveGroup_concurrent(t *testing.T) {
+	members := func(ids ...string) []*GroupMember {
+		groupMembers := make([]*GroupMember, len(ids))
+		for i, id := range ids {
+			groupMembers[i] = &GroupMember{Value: id}
+		}
+		return groupMembers
+	}
+	tests := []struct {
+		name   string
+		first  []*GroupMember
+		second []*GroupMember
+		want   []string
+	}{
+		{
+			name:   "both add a member, both kept",
+			first:  members("human1", "human2"),
+			second: members("human1", "human3"),
+			want:   []string{"human1", "human2", "human3"},
+		},
+		{
+			name:   "one removes, one adds a member",
+			first:  members(),
+			second: members("human1", "human2"),
+			want:   []string{"human2"},
+		},
+		{
+			name:   "both remove the same member",
+			first:  members(),
+			second: members(),
+			want:   []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newTestStore()
+			store.addGroup("group1", "Admins", "human1")
+			h := newTestHandler(store)
+			ctx := authz.SetCtxData(context.Background(), authz.CtxData{OrgID: "org1"})
+
+			current, err := h.group(ctx, "org1", "group1")
+			require.NoError(t, err)
+			// both changes are based on the same state of the group
+			require.NoError(t, h.saveGroup(ctx, "org1", "group1", current, &Group{DisplayName: "Admins", Members: tt.first}))
+			require.NoError(t, h.saveGroup(ctx, "org1", "group1", current, &Group{DisplayName: "Admins", Members: tt.second}))
+			assert.Equal(t, tt.want, store.members("group1"))
+		})
+	}
+}
+
+func TestHandler_removeMetadata(t *testing.T) {
+	store := newTestStore()
+	store.addGroup("group1", "Admins", "human1", "human2")
+	h := newTestHandler(store)
+
+	// human3 is not a member (e.g. already removed by a concurrent request)
+	err := h.removeMetadata(context.Background(), "org1", groupMemberKey("group1", "human1"), groupMemberKey("group1", "human3"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"human2"}, store.members("group1"))
+}
+
+func Test_parseGroupMemberKey(t *testing.T) {
+	tests := []struct {
+		name        string
+		key         string
+		wantGroupID string
+		wantUserID  string
+		wantOK      bool
+	}{
+		{name: "member", key: "scim.groupmember.group1.user1", wantGroupID: "group1", wantUserID: "user1", wantOK: true},
+		{name: "user missing", key: "scim.groupmember.group1", wantGroupID: "group1", wantOK: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			groupID, userID, ok := parseGroupMemberKey(tt.key)
+			assert.Equal(t, tt.wantGroupID, groupID)
+			assert.Equal(t, tt.wantUserID, userID)
+			assert.Equal(t, tt.wantOK, ok)
+		})
+	}
+}
@@ -0,0 +1,330 @@
+package scim
+
+import (
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+
+	caos_errs "github.com/dennigogo/zitadel/internal/errors"
+)
+
+const (
+	patchAdd     = "add"
+	patchReplace = "replace"
+	patchRemove  = "remove"
+)
+
+// PatchRequest is the body of a PATCH request (RFC 7644 section 3.5.2)
+type PatchRequest struct {
+	Schemas    []string          `json:"schemas"`
+	Operations []*PatchOperation `json:"Operations"`
+}
+
+type PatchOperation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	Value interface{} `json:"value"`
+}
+
+// path is a parsed attribute path of a patch operation, e.g. `emails[type eq "work"].value`
+type path struct {
+	attribute    string
+	filter       *expression
+	subAttribute string
+}
+
+func parsePath(p string) (*path, error) {
+	parsed := new(path)
+	if start := strings.IndexByte(p, '['); start >= 0 {
+		end := strings.LastIndexByte(p, ']')
+		if end < start {
+			return nil, invalidPath()
+		}
+		f, err := parseFilter(p[start+1 : end])
+		if err != nil || len(f) != 1 {
+			return nil, invalidPath()
+		}
+		parsed.filter = f[0]
+		rest := strings.ToLower(p[end+1:])
+		p = attributePath(p[:start])
+		if rest != "" {
+			if !strings.HasPrefix(rest, ".") {
+				return nil, invalidPath()
+			}
+			parsed.subAttribute = rest[1:]
+		}
+	} else {
+		p = attributePath(p)
+		if i := strings.IndexByte(p, '.'); i >= 0 {
+			parsed.subAttribute = p[i+1:]
+			p = p[:i]
+		}
+	}
+	if p == "" || strings.ContainsAny(parsed.subAttribute, ".[]") {
+		return nil, invalidPath()
+	}
+	parsed.attribute = p
+	return parsed, nil
+}
+
+func invalidPath() error {
+	return caos_errs.ThrowInvalidArgument(nil, "SCIM-Pa8k2", "Errors.SCIM.InvalidPath")
+}
+
+// applyPatch applies the operations on the json representation of a resource
+func applyPatch(resource map[string]interface{}, operations []*PatchOperation) error {
+	for _, operation := range operations {
+		if err := applyOperation(resource, operation); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func applyOperation(resource map[string]interface{}, operation *PatchOperation) error {
+	op := strings.ToLower(operation.Op)
+	switch op {
+	case patchAdd, patchReplace:
+		if operation.Path != "" {
+			p, err := parsePath(operation.Path)
+			if err != nil {
+				return err
+			}
+			return setValue(resource, p, operation.Value, op == patchAdd)
+		}
+		values, ok := operation.Value.(map[string]interface{})
+		if !ok {
+			return caos_errs.ThrowInvalidArgument(nil, "SCIM-Pa3n7", "Errors.SCIM.InvalidValue")
+		}
+		for attribute, value := range values {
+			p, err := parsePath(attribute)
+			if err != nil {
+				return err
+			}
+			if err = setValue(resource, p, value, op == patchAdd); err != nil {
+				return err
+			}
+		}
+		return nil
+	case patchRemove:
+		if operation.Path == "" {
+			return caos_errs.ThrowInvalidArgument(nil, "SCIM-Pa9d4", "Errors.SCIM.NoTarget")
+		}
+		p, err := parsePath(operation.Path)
+		if err != nil {
+			return err
+		}
+		return removeValue(resource, p, operation.Value)
+	default:
+		return caos_errs.ThrowInvalidArgument(nil, "SCIM-Pa6w1", "Errors.SCIM.InvalidSyntax")
+	}
+}
+
+func setValue(resource map[string]interface{}, p *path, value interface{}, add bool) error {
+	key := lookupKey(resource, p.attribute)
+	current := resource[key]
+	if p.filter == nil && p.subAttribute == "" {
+		list, isList := current.([]interface{})
+		if add && isList {
+			resource[key] = appendValues(list, value)
+			return nil
+		}
+		resource[key] = value
+		return nil
+	}
+	if object, ok := current.(map[string]interface{}); ok && p.filter == nil {
+		object[lookupKey(object, p.subAttribute)] = value
+		return nil
+	}
+	if current == nil && p.filter == nil {
+		resource[key] = map[string]interface{}{p.subAttribute: value}
+		return nil
+	}
+	list, _ := current.([]interface{})
+	matched := false
+	for _, element := range list {
+		object, ok := element.(map[string]interface{})
+		if !ok || !matchesElement(object, p.filter) {
+			continue
+		}
+		matched = true
+		if p.subAttribute == "" {
+			values, ok := value.(map[string]interface{})
+			if !ok {
+				return caos_errs.ThrowInvalidArgument(nil, "SCIM-Pa2q5", "Errors.SCIM.InvalidValue")
+			}
+			for k, v := range values {
+				object[lookupKey(object, k)] = v
+			}
+			continue
+		}
+		object[lookupKey(object, p.subAttribute)] = value
+	}
+	if matched {
+		return nil
+	}
+	// the element is created if no element matches
+	// e.g. `emails[type eq "work"].value` on a user without emails
+	element := make(map[string]interface{})
+	if p.filter != nil {
+		if p.filter.operator != operatorEqual {
+			return caos_errs.ThrowInvalidArgument(nil, "SCIM-Pa5z3", "Errors.SCIM.NoTarget")
+		}
+		element[p.filter.subAttribute()] = p.filter.value
+	}
+	if p.subAttribute == "" {
+		values, ok := value.(map[string]interface{})
+		if !ok {
+			return caos_errs.ThrowInvalidArgument(nil, "SCIM-Pa7h8", "Errors.SCIM.InvalidValue")
+		}
+		for k, v := range values {
+			element[k] = v
+		}
+	} else {
+		element[p.subAttribute] = value
+	}
+	resource[key] = append(list, element)
+	return nil
+}
+
+func removeValue(resource map[string]interface{}, p *path, value interface{}) error {
+	key := lookupKey(resource, p.attribute)
+	current, ok := resource[key]
+	if !ok {
+		return nil
+	}
+	if p.filter == nil && p.subAttribute == "" {
+		list, isList := current.([]interface{})
+		values, hasValues := value.([]interface{})
+		if !isList || !hasValues {
+			delete(resource, key)
+			return nil
+		}
+		// elements listed in the value are removed (e.g. members: [{"value": "id"}])
+		resource[key] = removeElements(list, values)
+		return nil
+	}
+	if object, ok := current.(map[string]interface{}); ok && p.filter == nil {
+		delete(object, lookupKey(object, p.subAttribute))
+		return nil
+	}
+	list, _ := current.([]interface{})
+	remaining := make([]interface{}, 0, len(list))
+	for _, element := range list {
+		object, ok := element.(map[string]interface{})
+		if !ok || (p.filter != nil && !matchesElement(object, p.filter)) {
+			remaining = append(remaining, element)
+			continue
+		}
+		if p.subAttribute != "" {
+			delete(object, lookupKey(object, p.subAttribute))
+			remaining = append(remaining, object)
+		}
+	}
+	resource[key] = remaining
+	return nil
+}
+
+func appendValues(list []interface{}, value interface{}) []interface{} {
+	values, ok := value.([]interface{})
+	if !ok {
+		values = []interface{}{value}
+	}
+	for _, v := range values {
+		if !containsElement(list, v) {
+			list = append(list, v)
+		}
+	}
+	return list
+}
+
+func removeElements(list, values []interface{}) []interface{} {
+	remaining := make([]interface{}, 0, len(list))
+	for _, element := range list {
+		if !containsElement(values, element) {
+			remaining = append(remaining, element)
+		}
+	}
+	return remaining
+}
+
+// containsElement compares elements by their value attribute if it's an object
+func containsElement(list []interface{}, element interface{}) bool {
+	for _, e := range list {
+		if reflect.DeepEqual(elementValue(e), elementValue(element)) {
+			return true
+		}
+	}
+	return false
+}
+
+func elementValue(element interface{}) interface{} {
+	object, ok := element.(map[string]interface{})
+	if !ok {
+		return element
+	}
+	return object[lookupKey(object, "value")]
+}
+
+func matchesElement(object map[string]interface{}, e *expression) bool {
+	return e.matches(object[lookupKey(object, e.subAttribute())], false)
+}
+
+// subAttribute returns the attribute of an expression used in a value filter (e.g. `type` of `emails[type eq "work"]`)
+func (e *expression) subAttribute() string {
+	if i := strings.LastIndexByte(e.attribute, '.'); i >= 0 {
+		return e.attribute[i+1:]
+	}
+	return e.attribute
+}
+
+// lookupKey returns the existing key matching the attribute case insensitive
+func lookupKey(object map[string]interface{}, attribute string) string {
+	for key := range object {
+		if strings.EqualFold(key, attribute) {
+			return key
+		}
+	}
+	return attribute
+}
+
+// patchResource applies the patch request on the current resource and unmarshals the result into patched
+func patchResource(body []byte, current, patched interface{}) error {
+	patch := new(PatchRequest)
+	if err := unmarshalBody(body, patch); err != nil {
+		return err
+	}
+	data, err := json.Marshal(current)
+	if err != nil {
+		return caos_errs.ThrowInternal(err, "SCIM-Pa4m6", "Errors.Internal")
+	}
+	object := make(map[string]interface{})
+	if err = json.Unmarshal(data, &object); err != nil {
+		return caos_errs.ThrowInternal(err, "SCIM-Pa1s3", "Errors.Internal")
+	}
+	if err = applyPatch(object, patch.Operations); err != nil {
+		return err
+	}
+	normalizeBoolean(object, "active")
+	data, err = json.Marshal(object)
+	if err != nil {
+		return caos_errs.ThrowInternal(err, "SCIM-Pa8u2", "Errors.Internal")
+	}
+	if err = json.Unmarshal(data, patched); err != nil {
+		return caos_errs.ThrowInvalidArgument(err, "SCIM-Pa3f9", "Errors.SCIM.InvalidValue")
+	}
+	return nil
+}
+
+// normalizeBoolean converts booleans sent as strings (e.g. `"False"`) to booleans
+func normalizeBoolean(object map[string]interface{}, attribute string) {
+	key := lookupKey(object, attribute)
+	value, ok := object[key].(string)
+	if !ok {
+		return
+	}
+	if b, err := strconv.ParseBool(value); err == nil {
+		object[key] = b
+	}
+}
@@ -0,0 +1,242 @@
+package scim
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	caos_errs "github.com/dennigogo/zitadel/internal/errors"
+)
+
+func Test_parsePath(t *testing.T) {
+	type res struct {
+		path    *path
+		errFunc func(error) bool
+	}
+	tests := []struct {
+		name string
+		path string
+		res  res
+	}{
+		{
+			name: "attribute",
+			path: "displayName",
+			res: res{
+				path: &path{attribute: "displayname"},
+			},
+		},
+		{
+			name: "sub attribute with schema",
+			path: "urn:ietf:params:scim:schemas:core:2.0:User:name.givenName",
+			res: res{
+				path: &path{attribute: "name", subAttribute: "givenname"},
+			},
+		},
+		{
+			name: "value filter",
+			path: `members[value eq "2819c223"]`,
+			res: res{
+				path: &path{
+					attribute: "members",
+					filter:    &expression{attribute: "value", operator: operatorEqual, value: "2819c223"},
+				},
+			},
+		},
+		{
+			name: "value filter and sub attribute",
+			path: `emails[type eq "Work"].value`,
+			res: res{
+				path: &path{
+					attribute:    "emails",
+					filter:       &expression{attribute: "type", operator: operatorEqual, value: "Work"},
+					subAttribute: "value",
+				},
+			},
+		},
+		{
+			name: "missing dot after filter",
+			path: `emails[type eq "work"]value`,
+			res: res{
+				errFunc: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "empty",
+			path: "",
+			res: res{
+				errFunc: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parsePath(tt.path)
+			if tt.res.errFunc != nil {
+				assert.True(t, tt.res.errFunc(err), "unexpected error: %v", err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.res.path, got)
+		})
+	}
+}
+
+func Test_patchResource(t *testing.T) {
+	active := true
+	inactive := false
+	type args struct {
+		body    string
+		current *User
+	}
+	type res struct {
+		user    *User
+		errFunc func(error) bool
+	}
+	tests := []struct {
+		name string
+		args args
+		res  res
+	}{
+		{
+			name: "replace attribute",
+			args: args{
+				body:    `{"Operations":[{"op":"replace","path":"userName","value":"bjensen"}]}`,
+				current: &User{UserName: "babs", Active: &active},
+			},
+			res: res{
+				user: &User{UserName: "bjensen", Active: &active},
+			},
+		},
+		{
+			name: "replace without path, boolean as string",
+			args: args{
+				body:    `{"Operations":[{"op":"Replace","value":{"active":"False","name.givenName":"Barbara"}}]}`,
+				current: &User{Name: &Name{GivenName: "Babs", FamilyName: "Jensen"}, Active: &active},
+			},
+			res: res{
+				user: &User{Name: &Name{GivenName: "Barbara", FamilyName: "Jensen"}, Active: &inactive},
+			},
+		},
+		{
+			name: "replace filtered sub attribute",
+			args: args{
+				body: `{"Operations":[{"op":"replace","path":"emails[type eq \"work\"].value","value":"bjensen@example.com"}]}`,
+				current: &User{
+					Emails: []*MultiValuedAttribute{{Value: "babs@example.com", Type: "work", Primary: true}},
+				},
+			},
+			res: res{
+				user: &User{
+					Emails: []*MultiValuedAttribute{{Value: "bjensen@example.com", Type: "work", Primary: true}},
+				},
+			},
+		},
+		{
+			name: "add filtered sub attribute without element",
+			args: args{
+				body:    `{"Operations":[{"op":"add","path":"phoneNumbers[type eq \"work\"].value","value":"+41 79 123 45 67"}]}`,
+				current: &User{},
+			},
+			res: res{
+				user: &User{
+					PhoneNumbers: []*MultiValuedAttribute{{Value: "+41 79 123 45 67", Type: "work"}},
+				},
+			},
+		},
+		{
+			name: "remove attribute",
+			args: args{
+				body:    `{"Operations":[{"op":"remove","path":"nickName"}]}`,
+				current: &User{UserName: "bjensen", NickName: "Babs"},
+			},
+			res: res{
+				user: &User{UserName: "bjensen"},
+			},
+		},
+		{
+			name: "remove without path",
+			args: args{
+				body:    `{"Operations":[{"op":"remove"}]}`,
+				current: &User{},
+			},
+			res: res{
+				errFunc: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "unknown operation",
+			args: args{
+				body:    `{"Operations":[{"op":"move","path":"nickName"}]}`,
+				current: &User{},
+			},
+			res: res{
+				errFunc: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := new(User)
+			err := patchResource([]byte(tt.args.body), tt.args.current, got)
+			if tt.res.errFunc != nil {
+				assert.True(t, tt.res.errFunc(err), "unexpected error: %v", err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.res.user, got)
+		})
+	}
+}
+
+func Test_patchGroupMembers(t *testing.T) {
+	type args struct {
+		body    string
+		current *Group
+	}
+	tests := []struct {
+		name string
+		args args
+		want []*GroupMember
+	}{
+		{
+			name: "add members",
+			args: args{
+				body:    `{"Operations":[{"op":"add","path":"members","value":[{"value":"1"},{"value":"2"}]}]}`,
+				current: &Group{DisplayName: "group", Members: []*GroupMember{{Value: "1"}}},
+			},
+			want: []*GroupMember{{Value: "1"}, {Value: "2"}},
+		},
+		{
+			name: "remove member by filter",
+			args: args{
+				body:    `{"Operations":[{"op":"remove","path":"members[value eq \"1\"]"}]}`,
+				current: &Group{DisplayName: "group", Members: []*GroupMember{{Value: "1"}, {Value: "2"}}},
+			},
+			want: []*GroupMember{{Value: "2"}},
+		},
+		{
+			name: "remove members by value",
+			args: args{
+				body:    `{"Operations":[{"op":"remove","path":"members","value":[{"value":"2"}]}]}`,
+				current: &Group{DisplayName: "group", Members: []*GroupMember{{Value: "1"}, {Value: "2"}}},
+			},
+			want: []*GroupMember{{Value: "1"}},
+		},
+		{
+			name: "remove all members",
+			args: args{
+				body:    `{"Operations":[{"op":"remove","path":"members"}]}`,
+				current: &Group{DisplayName: "group", Members: []*GroupMember{{Value: "1"}}},
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := new(Group)
+			err := patchResource([]byte(tt.args.body), tt.args.current, got)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got.Members)
+		})
+	}
+}
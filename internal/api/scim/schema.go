@@ -0,0 +1,241 @@
+package scim
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	schemaUser                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	schemaGroup                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	schemaServiceProviderConfig = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	schemaResourceType          = "urn:ietf:params:scim:schemas:core:2.0:ResourceType"
+	schemaSchema                = "urn:ietf:params:scim:schemas:core:2.0:Schema"
+	schemaListResponse          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	schemaBulkResponse          = "urn:ietf:params:scim:api:messages:2.0:BulkResponse"
+	schemaError                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+
+	resourceTypeUser  = "User"
+	resourceTypeGroup = "Group"
+	endpointUsers     = "Users"
+	endpointGroups    = "Groups"
+)
+
+type Meta struct {
+	ResourceType string    `json:"resourceType"`
+	Created      time.Time `json:"created"`
+	LastModified time.Time `json:"lastModified"`
+	Location     string    `json:"location"`
+	Version      string    `json:"version,omitempty"`
+}
+
+func version(sequence uint64) string {
+	return `W/"` + strconv.FormatUint(sequence, 10) + `"`
+}
+
+type ListResponse struct {
+	Schemas      []string    `json:"schemas"`
+	TotalResults uint64      `json:"totalResults"`
+	StartIndex   uint64      `json:"startIndex"`
+	ItemsPerPage uint64      `json:"itemsPerPage"`
+	Resources    interface{} `json:"Resources"`
+}
+
+type ServiceProviderConfig struct {
+	Schemas               []string                `json:"schemas"`
+	DocumentationURI      string                  `json:"documentationUri,omitempty"`
+	Patch                 supported               `json:"patch"`
+	Bulk                  bulkSupported           `json:"bulk"`
+	Filter                filterSupported         `json:"filter"`
+	ChangePassword        supported               `json:"changePassword"`
+	Sort                  supported               `json:"sort"`
+	ETag                  supported               `json:"etag"`
+	AuthenticationSchemes []*authenticationScheme `json:"authenticationSchemes"`
+}
+
+type supported struct {
+	Supported bool `json:"supported"`
+}
+
+type bulkSupported struct {
+	Supported      bool `json:"supported"`
+	MaxOperations  int  `json:"maxOperations"`
+	MaxPayloadSize int  `json:"maxPayloadSize"`
+}
+
+type filterSupported struct {
+	Supported  bool `json:"supported"`
+	MaxResults int  `json:"maxResults"`
+}
+
+type authenticationScheme struct {
+	Type        string `json:"type"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Primary     bool   `json:"primary"`
+}
+
+func (h *Handler) serviceProviderConfig(context.Context, *request) (*response, error) {
+	return &response{
+		status: http.StatusOK,
+		resource: &ServiceProviderConfig{
+			Schemas:          []string{schemaServiceProviderConfig},
+			DocumentationURI: "https://docs.zitadel.com/docs/apis/scim",
+			Patch:            supported{Supported: true},
+			Bulk: bulkSupported{
+				Supported:      true,
+				MaxOperations:  maxBulkOperations,
+				MaxPayloadSize: maxPayloadSize,
+			},
+			Filter: filterSupported{
+				Supported:  true,
+				MaxResults: maxResults,
+			},
+			ChangePassword: supported{Supported: true},
+			Sort:           supported{Supported: false},
+			ETag:           supported{Supported: false},
+			AuthenticationSchemes: []*authenticationScheme{
+				{
+					Type:        "oauthbearertoken",
+					Name:        "OAuth Bearer Token",
+					Description: "Authentication with a personal access token of a machine user",
+					Primary:     true,
+				},
+			},
+		},
+	}, nil
+}
+
+type ResourceType struct {
+	Schemas     []string `json:"schemas"`
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Endpoint    string   `json:"endpoint"`
+	Description string   `json:"description"`
+	Schema      string   `json:"schema"`
+}
+
+func (h *Handler) resourceTypes(context.Context, *request) (*response, error) {
+	types := []*ResourceType{
+		{
+			Schemas:     []string{schemaResourceType},
+			ID:          resourceTypeUser,
+			Name:        resourceTypeUser,
+			Endpoint:    "/" + endpointUsers,
+			Description: "Human users of the organisation",
+			Schema:      schemaUser,
+		},
+		{
+			Schemas:     []string{schemaResourceType},
+			ID:          resourceTypeGroup,
+			Name:        resourceTypeGroup,
+			Endpoint:    "/" + endpointGroups,
+			Description: "Groups of users of the organisation",
+			Schema:      schemaGroup,
+		},
+	}
+	return listResponse(types, uint64(len(types)), 1, uint64(len(types))), nil
+}
+
+type Schema struct {
+	Schemas     []string     `json:"schemas"`
+	ID          string       `json:"id"`
+	Name        string       `json:"name"`
+	Description string       `json:"description"`
+	Attributes  []*Attribute `json:"attributes"`
+}
+
+type Attribute struct {
+	Name          string       `json:"name"`
+	Type          string       `json:"type"`
+	MultiValued   bool         `json:"multiValued"`
+	Required      bool         `json:"required"`
+	CaseExact     bool         `json:"caseExact"`
+	Mutability    string       `json:"mutability"`
+	Returned      string       `json:"returned"`
+	Uniqueness    string       `json:"uniqueness"`
+	SubAttributes []*Attribute `json:"subAttributes,omitempty"`
+}
+
+func stringAttribute(name string, required bool, sub ...*Attribute) *Attribute {
+	return &Attribute{
+		Name:          name,
+		Type:          "string",
+		Required:      required,
+		Mutability:    "readWrite",
+		Returned:      "default",
+		Uniqueness:    "none",
+		SubAttributes: sub,
+	}
+}
+
+func multiValuedAttribute(name string, sub ...*Attribute) *Attribute {
+	return &Attribute{
+		Name:          name,
+		Type:          "complex",
+		MultiValued:   true,
+		Mutability:    "readWrite",
+		Returned:      "default",
+		Uniqueness:    "none",
+		SubAttributes: sub,
+	}
+}
+
+func (h *Handler) schemas(context.Context, *request) (*response, error) {
+	userName := stringAttribute("userName", true)
+	userName.Uniqueness = "server"
+	name := stringAttribute("name", true, stringAttribute("formatted", false), stringAttribute("familyName", true), stringAttribute("givenName", true))
+	name.Type = "complex"
+	active := &Attribute{Name: "active", Type: "boolean", Mutability: "readWrite", Returned: "default", Uniqueness: "none"}
+	password := stringAttribute("password", false)
+	password.Mutability = "writeOnly"
+	password.Returned = "never"
+	displayName := stringAttribute("displayName", true)
+	displayName.Uniqueness = "server"
+	members := multiValuedAttribute("members", stringAttribute("value", true), stringAttribute("display", false), stringAttribute("$ref", false))
+	schemas := []*Schema{
+		{
+			Schemas:     []string{schemaSchema},
+			ID:          schemaUser,
+			Name:        resourceTypeUser,
+			Description: "User Account",
+			Attributes: []*Attribute{
+				userName,
+				name,
+				stringAttribute("displayName", false),
+				stringAttribute("nickName", false),
+				stringAttribute("preferredLanguage", false),
+				active,
+				password,
+				multiValuedAttribute("emails", stringAttribute("value", true), stringAttribute("type", false), &Attribute{Name: "primary", Type: "boolean"}),
+				multiValuedAttribute("phoneNumbers", stringAttribute("value", true), stringAttribute("type", false), &Attribute{Name: "primary", Type: "boolean"}),
+			},
+		},
+		{
+			Schemas:     []string{schemaSchema},
+			ID:          schemaGroup,
+			Name:        resourceTypeGroup,
+			Description: "Group",
+			Attributes: []*Attribute{
+				displayName,
+				members,
+			},
+		},
+	}
+	return listResponse(schemas, uint64(len(schemas)), 1, uint64(len(schemas))), nil
+}
+
+func listResponse(resources interface{}, total, startIndex, itemsPerPage uint64) *response {
+	return &response{
+		status: http.StatusOK,
+		resource: &ListResponse{
+			Schemas:      []string{schemaListResponse},
+			TotalResults: total,
+			StartIndex:   startIndex,
+			ItemsPerPage: itemsPerPage,
+			Resources:    resources,
+		},
+	}
+}
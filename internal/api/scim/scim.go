@@ -0,0 +1,310 @@
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/zitadel/logging"
+
+	"github.com/dennigogo/zitadel/internal/api/authz"
+	http_util "github.com/dennigogo/zitadel/internal/api/http"
+	"github.com/dennigogo/zitadel/internal/command"
+	"github.com/dennigogo/zitadel/internal/crypto"
+	"github.com/dennigogo/zitadel/internal/domain"
+	caos_errs "github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/id"
+	"github.com/dennigogo/zitadel/internal/query"
+)
+
+const (
+	HandlerPrefix = "/scim/v2"
+
+	contentType = "application/scim+json"
+
+	// maxPayloadSize is the maximum size of a request body (1 MiB)
+	maxPayloadSize = 1 << 20
+	// maxResults is the maximum amount of resources returned in a list
+	maxResults = 100
+	// maxBulkOperations is the maximum amount of operations of a bulk request
+	maxBulkOperations = 100
+
+	permissionRead   = "user.read"
+	permissionWrite  = "user.write"
+	permissionDelete = "user.delete"
+
+	orgIDParam = "orgId"
+	idParam    = "id"
+)
+
+type scimCommands interface {
+	AddHuman(ctx context.Context, resourceOwner string, human *command.AddHuman) (*domain.HumanDetails, error)
+	ChangeUsername(ctx context.Context, orgID, userID, userName string) (*domain.ObjectDetails, error)
+	ChangeHumanProfile(ctx context.Context, profile *domain.Profile) (*domain.Profile, error)
+	ChangeHumanEmail(ctx context.Context, email *domain.Email, emailCodeGenerator crypto.Generator) (*domain.Email, error)
+	ChangeHumanPhone(ctx context.Context, phone *domain.Phone, resourceOwner string, phoneCodeGenerator crypto.Generator) (*domain.Phone, error)
+	RemoveHumanPhone(ctx context.Context, userID, resourceOwner string) (*domain.ObjectDetails, error)
+	SetPassword(ctx context.Context, orgID, userID, passwordString string, oneTime bool) (*domain.ObjectDetails, error)
+	DeactivateUser(ctx context.Context, userID, resourceOwner string) (*domain.ObjectDetails, error)
+	ReactivateUser(ctx context.Context, userID, resourceOwner string) (*domain.ObjectDetails, error)
+	RemoveUser(ctx context.Context, userID, resourceOwner string, cascadingUserMemberships []*command.CascadingMembership, cascadingGrantIDs ...string) (*domain.ObjectDetails, error)
+	SetUserMetadata(ctx context.Context, metadata *domain.Metadata, userID, resourceOwner string) (*domain.Metadata, error)
+	RemoveUserMetadata(ctx context.Context, metadataKey, userID, resourceOwner string) (*domain.ObjectDetails, error)
+	BulkSetOrgMetadata(ctx context.Context, orgID string, metadatas ...*domain.Metadata) (*domain.ObjectDetails, error)
+	RemoveOrgMetadata(ctx context.Context, orgID, metadataKey string) (*domain.ObjectDetails, error)
+	BulkRemoveOrgMetadata(ctx context.Context, orgID string, metadataKeys ...string) (*domain.ObjectDetails, error)
+}
+
+type scimQueries interface {
+	GetUserByID(ctx context.Context, shouldTriggerBulk bool, userID string, queries ...query.SearchQuery) (*query.User, error)
+	SearchUsers(ctx context.Context, queries *query.UserSearchQueries) (*query.Users, error)
+	GetUserMetadataByKey(ctx context.Context, shouldTriggerBulk bool, userID, key string, queries ...query.SearchQuery) (*query.UserMetadata, error)
+	UserGrants(ctx context.Context, queries *query.UserGrantsQueries) (*query.UserGrants, error)
+	Memberships(ctx context.Context, queries *query.MembershipSearchQuery) (*query.Memberships, error)
+	GetOrgMetadataByKey(ctx context.Context, shouldTriggerBulk bool, orgID string, key string, queries ...query.SearchQuery) (*query.OrgMetadata, error)
+	SearchOrgMetadata(ctx context.Context, shouldTriggerBulk bool, orgID string, queries *query.OrgMetadataSearchQueries) (*query.OrgMetadataList, error)
+	InitEncryptionGenerator(ctx context.Context, generatorType domain.SecretGeneratorType, algorithm crypto.EncryptionAlgorithm) (crypto.Generator, error)
+}
+
+// Handler serves the SCIM 2.0 api (RFC 7643, RFC 7644) of an organisation.
+// Users are mapped to human users, groups are stored as metadata of the organisation.
+// Only machine users (e.g. authenticated by a personal access token) are allowed to call the api.
+type Handler struct {
+	commands       scimCommands
+	queries        scimQueries
+	verifier       *authz.TokenVerifier
+	authConfig     authz.Config
+	userCodeAlg    crypto.EncryptionAlgorithm
+	idGenerator    id.Generator
+	externalSecure bool
+}
+
+func NewHandler(
+	commands *command.Commands,
+	queries *query.Queries,
+	verifier *authz.TokenVerifier,
+	authConfig authz.Config,
+	userCodeAlg crypto.EncryptionAlgorithm,
+	externalSecure bool,
+	instanceInterceptor func(http.Handler) http.Handler,
+	clientRateLimitInterceptor func(http.Handler) http.Handler,
+) http.Handler {
+	h := &Handler{
+		commands:       commands,
+		queries:        queries,
+		verifier:       verifier,
+		authConfig:     authConfig,
+		userCodeAlg:    userCodeAlg,
+		idGenerator:    id.SonyFlakeGenerator(),
+		externalSecure: externalSecure,
+	}
+	return h.router(instanceInterceptor, clientRateLimitInterceptor)
+}
+
+func (h *Handler) router(instanceInterceptor, clientRateLimitInterceptor func(http.Handler) http.Handler) http.Handler {
+	router := mux.NewRouter()
+	router.Use(instanceInterceptor, h.authorizationInterceptor, clientRateLimitInterceptor)
+	org := router.PathPrefix("/{" + orgIDParam + "}").Subrouter()
+	org.HandleFunc("/ServiceProviderConfig", h.handle(h.serviceProviderConfig)).Methods(http.MethodGet)
+	org.HandleFunc("/ResourceTypes", h.handle(h.resourceTypes)).Methods(http.MethodGet)
+	org.HandleFunc("/Schemas", h.handle(h.schemas)).Methods(http.MethodGet)
+	org.HandleFunc("/Users", h.handle(h.listUsers)).Methods(http.MethodGet)
+	org.HandleFunc("/Users", h.handle(h.createUser)).Methods(http.MethodPost)
+	org.HandleFunc("/Users/.search", h.handle(h.searchUsers)).Methods(http.MethodPost)
+	org.HandleFunc("/Users/{"+idParam+"}", h.handle(h.getUser)).Methods(http.MethodGet)
+	org.HandleFunc("/Users/{"+idParam+"}", h.handle(h.replaceUser)).Methods(http.MethodPut)
+	org.HandleFunc("/Users/{"+idParam+"}", h.handle(h.patchUser)).Methods(http.MethodPatch)
+	org.HandleFunc("/Users/{"+idParam+"}", h.handle(h.deleteUser)).Methods(http.MethodDelete)
+	org.HandleFunc("/Groups", h.handle(h.listGroups)).Methods(http.MethodGet)
+	org.HandleFunc("/Groups", h.handle(h.createGroup)).Methods(http.MethodPost)
+	org.HandleFunc("/Groups/.search", h.handle(h.searchGroups)).Methods(http.MethodPost)
+	org.HandleFunc("/Groups/{"+idParam+"}", h.handle(h.getGroup)).Methods(http.MethodGet)
+	org.HandleFunc("/Groups/{"+idParam+"}", h.handle(h.replaceGroup)).Methods(http.MethodPut)
+	org.HandleFunc("/Groups/{"+idParam+"}", h.handle(h.patchGroup)).Methods(http.MethodPatch)
+	org.HandleFunc("/Groups/{"+idParam+"}", h.handle(h.deleteGroup)).Methods(http.MethodDelete)
+	org.HandleFunc("/Bulk", h.handle(h.bulk)).Methods(http.MethodPost)
+	return router
+}
+
+// request is a single operation on the api, either received over http or as part of a bulk request
+type request struct {
+	orgID string
+	id    string
+	query url.Values
+	body  []byte
+}
+
+// response is the result of an operation, a resource is only written if set
+type response struct {
+	status   int
+	resource interface{}
+	location string
+}
+
+type operation func(ctx context.Context, req *request) (*response, error)
+
+func (h *Handler) handle(op operation) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxPayloadSize+1))
+		if err != nil {
+			writeError(w, caos_errs.ThrowInvalidArgument(err, "SCIM-Rb3k1", "Errors.SCIM.InvalidSyntax"))
+			return
+		}
+		if len(body) > maxPayloadSize {
+			writeError(w, caos_errs.ThrowInvalidArgument(nil, "SCIM-Rb8m4", "Errors.SCIM.TooMany"))
+			return
+		}
+		vars := mux.Vars(r)
+		resp, err := op(r.Context(), &request{
+			orgID: vars[orgIDParam],
+			id:    vars[idParam],
+			query: r.URL.Query(),
+			body:  body,
+		})
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		writeResponse(w, resp)
+	}
+}
+
+func (h *Handler) authorizationInterceptor(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, err := h.authorize(r)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// authorize verifies the token for the organisation of the path
+// and ensures the caller is a machine user
+func (h *Handler) authorize(r *http.Request) (context.Context, error) {
+	ctx := r.Context()
+	token := http_util.GetAuthorization(r)
+	if token == "" {
+		return nil, caos_errs.ThrowUnauthenticated(nil, "SCIM-Ah4n2", "auth header missing")
+	}
+	ctxSetter, err := authz.CheckUserAuthorization(ctx, nil, token, mux.Vars(r)[orgIDParam], h.verifier, h.authConfig, authz.Option{Permission: permissionRead}, r.RequestURI)
+	if err != nil {
+		return nil, err
+	}
+	ctx = ctxSetter(ctx)
+	caller, err := h.queries.GetUserByID(ctx, false, authz.GetCtxData(ctx).UserID)
+	if err != nil {
+		return nil, err
+	}
+	if caller.Type != domain.UserTypeMachine {
+		return nil, caos_errs.ThrowPermissionDenied(nil, "SCIM-Ah8w3", "Errors.SCIM.MachineUserRequired")
+	}
+	return ctx, nil
+}
+
+func checkPermission(ctx context.Context, permission string) error {
+	if authz.ExistsPerm(authz.GetAllPermissionsFromCtx(ctx), permission) {
+		return nil
+	}
+	return caos_errs.ThrowPermissionDenied(nil, "SCIM-Ah2x6", "No matching permissions found")
+}
+
+func (h *Handler) location(ctx context.Context, orgID, resourceType, id string) string {
+	return http_util.BuildOrigin(authz.GetInstance(ctx).RequestedHost(), h.externalSecure) + HandlerPrefix + "/" + orgID + "/" + resourceType + "/" + id
+}
+
+func writeResponse(w http.ResponseWriter, resp *response) {
+	if resp.location != "" {
+		w.Header().Set("Location", resp.location)
+	}
+	if resp.resource == nil {
+		w.WriteHeader(resp.status)
+		return
+	}
+	body, err := json.Marshal(resp.resource)
+	if err != nil {
+		writeError(w, caos_errs.ThrowInternal(err, "SCIM-Wr5j2", "Errors.Internal"))
+		return
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(resp.status)
+	_, err = w.Write(body)
+	logging.OnError(err).Warn("unable to write scim response")
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	scimErr := toError(err)
+	logging.WithError(err).WithField("status", scimErr.Status).Debug("scim request failed")
+	writeResponse(w, &response{
+		status:   scimErr.status,
+		resource: scimErr,
+	})
+}
+
+// Error is the error response defined in RFC 7644 section 3.12
+type Error struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	SCIMType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail,omitempty"`
+
+	status int
+}
+
+// scimTypes maps error messages to the detail error keywords of RFC 7644
+var scimTypes = map[string]string{
+	"Errors.SCIM.InvalidFilter": "invalidFilter",
+	"Errors.SCIM.InvalidSyntax": "invalidSyntax",
+	"Errors.SCIM.InvalidPath":   "invalidPath",
+	"Errors.SCIM.InvalidValue":  "invalidValue",
+	"Errors.SCIM.NoTarget":      "noTarget",
+	"Errors.SCIM.TooMany":       "tooMany",
+	"Errors.SCIM.Mutability":    "mutability",
+	"Errors.SCIM.Group.Taken":   "uniqueness",
+	"Errors.User.AlreadyExists": "uniqueness",
+}
+
+func toError(err error) *Error {
+	status := http.StatusInternalServerError
+	switch {
+	case caos_errs.IsErrorInvalidArgument(err), caos_errs.IsPreconditionFailed(err):
+		status = http.StatusBadRequest
+	case caos_errs.IsUnauthenticated(err):
+		status = http.StatusUnauthorized
+	case caos_errs.IsPermissionDenied(err):
+		status = http.StatusForbidden
+	case caos_errs.IsNotFound(err):
+		status = http.StatusNotFound
+	case caos_errs.IsErrorAlreadyExists(err):
+		status = http.StatusConflict
+	case caos_errs.IsUnimplemented(err):
+		status = http.StatusNotImplemented
+	}
+	scimErr := &Error{
+		Schemas: []string{schemaError},
+		Status:  strconv.Itoa(status),
+		status:  status,
+	}
+	var caosErr caos_errs.Error
+	if !errors.As(err, &caosErr) {
+		return scimErr
+	}
+	scimErr.Detail = caosErr.GetMessage() + " (" + caosErr.GetID() + ")"
+	if status == http.StatusBadRequest || status == http.StatusConflict {
+		scimErr.SCIMType = scimTypes[caosErr.GetMessage()]
+	}
+	return scimErr
+}
+
+func unmarshalBody(body []byte, v interface{}) error {
+	if err := json.Unmarshal(body, v); err != nil {
+		return caos_errs.ThrowInvalidArgument(err, "SCIM-Um7b3", "Errors.SCIM.InvalidSyntax")
+	}
+	return nil
+}
@@ -0,0 +1,475 @@
+package scim
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dennigogo/zitadel/internal/api/authz"
+	"github.com/dennigogo/zitadel/internal/command"
+	"github.com/dennigogo/zitadel/internal/crypto"
+	"github.com/dennigogo/zitadel/internal/domain"
+	caos_errs "github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/query"
+)
+
+// testStore keeps the users and the metadata of the organisation org1 in memory
+type testStore struct {
+	mu       sync.Mutex
+	sequence uint64
+	users    map[string]*query.User
+	metadata map[string]*query.OrgMetadata
+}
+
+func newTestStore() *testStore {
+	store := &testStore{
+		users:    make(map[string]*query.User),
+		metadata: make(map[string]*query.OrgMetadata),
+	}
+	for _, id := range []string{"machine1", "machine2", "machine3"} {
+		store.users[id] = &query.User{ID: id, ResourceOwner: "org1", Type: domain.UserTypeMachine, Username: id, Machine: &query.Machine{Name: id}}
+	}
+	for _, id := range []string{"human1", "human2", "human3"} {
+		store.addHuman(id, id)
+	}
+	return store
+}
+
+func (s *testStore) addHuman(id, username string) {
+	s.users[id] = &query.User{
+		ID:            id,
+		ResourceOwner: "org1",
+		State:         domain.UserStateActive,
+		Type:          domain.UserTypeHuman,
+		Username:      username,
+		Human:         &query.Human{FirstName: username, LastName: username, DisplayName: username, Email: username + "@example.com"},
+	}
+}
+
+func (s *testStore) setMetadata(key string, value []byte) {
+	s.sequence++
+	s.metadata[key] = &query.OrgMetadata{ResourceOwner: "org1", Sequence: s.sequence, Key: key, Value: value}
+}
+
+// addGroup stores the group like the handler does
+func (s *testStore) addGroup(id, displayName string, members ...string) {
+	s.setMetadata(metadataKeyGroupPrefix+id, []byte(`{"displayName":"`+displayName+`"}`))
+	for _, member := range members {
+		s.setMetadata(groupMemberKey(id, member), []byte(member))
+	}
+}
+
+// members returns the ids of the stored members of the group
+func (s *testStore) members(groupID string) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	members := make([]string, 0)
+	for key := range s.metadata {
+		if id, userID, ok := parseGroupMemberKey(key); ok && strings.HasPrefix(key, metadataKeyGroupMemberPrefix) && id == groupID {
+			members = append(members, userID)
+		}
+	}
+	sort.Strings(members)
+	return members
+}
+
+func (s *testStore) keys() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	keys := make([]string, 0, len(s.metadata))
+	for key := range s.metadata {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+type testCommands struct {
+	*testStore
+}
+
+func (c *testCommands) AddHuman(_ context.Context, resourceOwner string, human *command.AddHuman) (*domain.HumanDetails, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, user := range c.users {
+		if user.Username == human.Username {
+			return nil, caos_errs.ThrowAlreadyExists(nil, "TEST-Us1k2", "Errors.User.AlreadyExists")
+		}
+	}
+	c.sequence++
+	id := "created" + strconv.FormatUint(c.sequence, 10)
+	c.addHuman(id, human.Username)
+	return &domain.HumanDetails{ID: id, ObjectDetails: domain.ObjectDetails{ResourceOwner: resourceOwner}}, nil
+}
+
+func (c *testCommands) ChangeUsername(context.Context, string, string, string) (*domain.ObjectDetails, error) {
+	return &domain.ObjectDetails{}, nil
+}
+
+func (c *testCommands) ChangeHumanProfile(_ context.Context, profile *domain.Profile) (*domain.Profile, error) {
+	return profile, nil
+}
+
+func (c *testCommands) ChangeHumanEmail(_ context.Context, email *domain.Email, _ crypto.Generator) (*domain.Email, error) {
+	return email, nil
+}
+
+func (c *testCommands) ChangeHumanPhone(_ context.Context, phone *domain.Phone, _ string, _ crypto.Generator) (*domain.Phone, error) {
+	return phone, nil
+}
+
+func (c *testCommands) RemoveHumanPhone(context.Context, string, string) (*domain.ObjectDetails, error) {
+	return &domain.ObjectDetails{}, nil
+}
+
+func (c *testCommands) SetPassword(context.Context, string, string, string, bool) (*domain.ObjectDetails, error) {
+	return &domain.ObjectDetails{}, nil
+}
+
+func (c *testCommands) DeactivateUser(_ context.Context, userID, _ string) (*domain.ObjectDetails, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.users[userID].State = domain.UserStateInactive
+	return &domain.ObjectDetails{}, nil
+}
+
+func (c *testCommands) ReactivateUser(_ context.Context, userID, _ string) (*domain.ObjectDetails, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.users[userID].State = domain.UserStateActive
+	return &domain.ObjectDetails{}, nil
+}
+
+func (c *testCommands) RemoveUser(_ context.Context, userID, _ string, _ []*command.CascadingMembership, _ ...string) (*domain.ObjectDetails, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.users, userID)
+	return &domain.ObjectDetails{}, nil
+}
+
+func (c *testCommands) SetUserMetadata(_ context.Context, metadata *domain.Metadata, _, _ string) (*domain.Metadata, error) {
+	return metadata, nil
+}
+
+func (c *testCommands) RemoveUserMetadata(context.Context, string, string, string) (*domain.ObjectDetails, error) {
+	return &domain.ObjectDetails{}, nil
+}
+
+func (c *testCommands) BulkSetOrgMetadata(_ context.Context, _ string, metadatas ...*domain.Metadata) (*domain.ObjectDetails, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, metadata := range metadatas {
+		c.setMetadata(metadata.Key, metadata.Value)
+	}
+	return &domain.ObjectDetails{}, nil
+}
+
+func (c *testCommands) RemoveOrgMetadata(_ context.Context, _, metadataKey string) (*domain.ObjectDetails, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.metadata[metadataKey]; !ok {
+		return nil, caos_errs.ThrowNotFound(nil, "TEST-Me2n4", "Errors.Metadata.NotFound")
+	}
+	delete(c.metadata, metadataKey)
+	return &domain.ObjectDetails{}, nil
+}
+
+func (c *testCommands) BulkRemoveOrgMetadata(_ context.Context, _ string, metadataKeys ...string) (*domain.ObjectDetails, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, key := range metadataKeys {
+		if _, ok := c.metadata[key]; !ok {
+			return nil, caos_errs.ThrowNotFound(nil, "TEST-Me5w1", "Errors.Metadata.KeyNotExisting")
+		}
+	}
+	for _, key := range metadataKeys {
+		delete(c.metadata, key)
+	}
+	return &domain.ObjectDetails{}, nil
+}
+
+type testQueries struct {
+	*testStore
+}
+
+// textQuery returns the text of the first text query
+func textQuery(queries []query.SearchQuery) (string, bool) {
+	for _, q := range queries {
+		if text, ok := q.(*query.TextQuery); ok {
+			return text.Text, true
+		}
+	}
+	return "", false
+}
+
+func (q *testQueries) GetUserByID(_ context.Context, _ bool, userID string, queries ...query.SearchQuery) (*query.User, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	user, ok := q.users[userID]
+	if resourceOwner, hasQuery := textQuery(queries); !ok || hasQuery && user.ResourceOwner != resourceOwner {
+		return nil, caos_errs.ThrowNotFound(nil, "TEST-Us4m2", "Errors.User.NotFound")
+	}
+	return user, nil
+}
+
+func (q *testQueries) SearchUsers(context.Context, *query.UserSearchQueries) (*query.Users, error) {
+	return nil, caos_errs.ThrowUnimplemented(nil, "TEST-Us7c1", "not implemented")
+}
+
+func (q *testQueries) GetUserMetadataByKey(context.Context, bool, string, string, ...query.SearchQuery) (*query.UserMetadata, error) {
+	return nil, caos_errs.ThrowNotFound(nil, "TEST-Um3k8", "Errors.Metadata.NotFound")
+}
+
+func (q *testQueries) UserGrants(context.Context, *query.UserGrantsQueries) (*query.UserGrants, error) {
+	return &query.UserGrants{}, nil
+}
+
+func (q *testQueries) Memberships(context.Context, *query.MembershipSearchQuery) (*query.Memberships, error) {
+	return &query.Memberships{}, nil
+}
+
+func (q *testQueries) GetOrgMetadataByKey(_ context.Context, _ bool, _ string, key string, _ ...query.SearchQuery) (*query.OrgMetadata, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	metadata, ok := q.metadata[key]
+	if !ok {
+		return nil, caos_errs.ThrowNotFound(nil, "TEST-Me8s3", "Errors.Metadata.NotFound")
+	}
+	return metadata, nil
+}
+
+func (q *testQueries) SearchOrgMetadata(_ context.Context, _ bool, _ string, queries *query.OrgMetadataSearchQueries) (*query.OrgMetadataList, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	prefix, _ := textQuery(queries.Queries)
+	list := &query.OrgMetadataList{Metadata: make([]*query.OrgMetadata, 0)}
+	for key, metadata := range q.metadata {
+		if strings.HasPrefix(key, prefix) {
+			list.Metadata = append(list.Metadata, metadata)
+		}
+	}
+	sort.Slice(list.Metadata, func(i, j int) bool {
+		return list.Metadata[i].Key < list.Metadata[j].Key
+	})
+	list.Count = uint64(len(list.Metadata))
+	return list, nil
+}
+
+func (q *testQueries) InitEncryptionGenerator(context.Context, domain.SecretGeneratorType, crypto.EncryptionAlgorithm) (crypto.Generator, error) {
+	return nil, caos_errs.ThrowUnimplemented(nil, "TEST-Ge2n5", "not implemented")
+}
+
+// testAuthZRepo verifies the tokens of the test users
+type testAuthZRepo struct{}
+
+// testTokens maps the tokens to the users
+var testTokens = map[string]string{
+	"owner":  "machine1",
+	"reader": "machine2",
+	"viewer": "machine3",
+	"human":  "human1",
+}
+
+var testMemberships = map[string][]*authz.Membership{
+	"machine1": {{MemberType: authz.MemberTypeOrganisation, AggregateID: "org1", ObjectID: "org1", Roles: []string{"ORG_OWNER"}}},
+	"machine2": {{MemberType: authz.MemberTypeOrganisation, AggregateID: "org1", ObjectID: "org1", Roles: []string{"ORG_USER_READER"}}},
+	"machine3": {{MemberType: authz.MemberTypeOrganisation, AggregateID: "org1", ObjectID: "org1", Roles: []string{"ORG_VIEWER"}}},
+	"human1":   {{MemberType: authz.MemberTypeOrganisation, AggregateID: "org1", ObjectID: "org1", Roles: []string{"ORG_OWNER"}}},
+}
+
+var testAuthConfig = authz.Config{
+	RolePermissionMappings: []authz.RoleMapping{
+		{Role: "ORG_OWNER", Permissions: []string{permissionRead, permissionWrite, permissionDelete}},
+		{Role: "ORG_USER_READER", Permissions: []string{permissionRead}},
+		{Role: "ORG_VIEWER", Permissions: []string{"org.read"}},
+	},
+}
+
+func (r *testAuthZRepo) VerifyAccessToken(_ context.Context, token, _, _ string) (string, string, string, string, string, *authz.TokenConfirmation, error) {
+	userID, ok := testTokens[token]
+	if !ok {
+		return "", "", "", "", "", nil, caos_errs.ThrowUnauthenticated(nil, "TEST-To3k9", "invalid token")
+	}
+	return userID, "", "", "", "org1", nil, nil
+}
+
+func (r *testAuthZRepo) VerifierClientID(context.Context, string) (string, string, error) {
+	return "", "", nil
+}
+
+func (r *testAuthZRepo) SearchMyMemberships(ctx context.Context) ([]*authz.Membership, error) {
+	return testMemberships[authz.GetCtxData(ctx).UserID], nil
+}
+
+func (r *testAuthZRepo) ProjectIDAndOriginsByClientID(context.Context, string) (string, []string, error) {
+	return "", nil, nil
+}
+
+func (r *testAuthZRepo) ExistsOrg(context.Context, string) error {
+	return nil
+}
+
+type testIDGenerator struct {
+	mu   sync.Mutex
+	next int
+}
+
+func (g *testIDGenerator) Next() (string, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.next++
+	return "id" + strconv.Itoa(g.next), nil
+}
+
+func newTestHandler(store *testStore) *Handler {
+	return &Handler{
+		commands:    &testCommands{store},
+		queries:     &testQueries{store},
+		verifier:    authz.Start(&testAuthZRepo{}, "", nil),
+		authConfig:  testAuthConfig,
+		idGenerator: new(testIDGenerator),
+	}
+}
+
+// serve sends the request through the router of the handler
+func serve(h *Handler, method, target, token, body string) *httptest.ResponseRecorder {
+	passThrough := func(next http.Handler) http.Handler { return next }
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	recorder := httptest.NewRecorder()
+	h.router(passThrough, passThrough).ServeHTTP(recorder, req)
+	return recorder
+}
+
+func TestHandler_authorization(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		target     string
+		token      string
+		wantStatus int
+	}{
+		{
+			name:       "token missing, unauthenticated",
+			method:     http.MethodGet,
+			target:     "/org1/Groups",
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "token invalid, unauthenticated",
+			method:     http.MethodGet,
+			target:     "/org1/Groups",
+			token:      "invalid",
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "human user, forbidden",
+			method:     http.MethodGet,
+			target:     "/org1/Groups",
+			token:      "human",
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "read permission missing, forbidden",
+			method:     http.MethodGet,
+			target:     "/org1/Groups",
+			token:      "viewer",
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "read permission, ok",
+			method:     http.MethodGet,
+			target:     "/org1/Groups",
+			token:      "reader",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "write permission missing, forbidden",
+			method:     http.MethodPost,
+			target:     "/org1/Groups",
+			token:      "reader",
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "delete permission missing, forbidden",
+			method:     http.MethodDelete,
+			target:     "/org1/Groups/group1",
+			token:      "reader",
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "delete permission, no content",
+			method:     http.MethodDelete,
+			target:     "/org1/Groups/group1",
+			token:      "owner",
+			wantStatus: http.StatusNoContent,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newTestStore()
+			store.addGroup("group1", "Admins", "human1")
+			resp := serve(newTestHandler(store), tt.method, tt.target, tt.token, "")
+			assert.Equal(t, tt.wantStatus, resp.Code, resp.Body.String())
+			if tt.wantStatus >= http.StatusBadRequest {
+				assert.Equal(t, contentType, resp.Header().Get("Content-Type"))
+				assert.Contains(t, resp.Body.String(), schemaError)
+			}
+		})
+	}
+}
+
+func TestHandler_payloadTooLarge(t *testing.T) {
+	body := `{"displayName": "` + strings.Repeat("a", maxPayloadSize) + `"}`
+	resp := serve(newTestHandler(newTestStore()), http.MethodPost, "/org1/Groups", "owner", body)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Contains(t, resp.Body.String(), `"scimType":"tooMany"`)
+}
+
+func Test_toError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want *Error
+	}{
+		{
+			name: "invalid filter",
+			err:  caos_errs.ThrowInvalidArgument(nil, "TEST-Er1f4", "Errors.SCIM.InvalidFilter"),
+			want: &Error{Schemas: []string{schemaError}, Status: "400", SCIMType: "invalidFilter", Detail: "Errors.SCIM.InvalidFilter (TEST-Er1f4)", status: http.StatusBadRequest},
+		},
+		{
+			name: "group taken",
+			err:  caos_errs.ThrowAlreadyExists(nil, "TEST-Er6u2", "Errors.SCIM.Group.Taken"),
+			want: &Error{Schemas: []string{schemaError}, Status: "409", SCIMType: "uniqueness", Detail: "Errors.SCIM.Group.Taken (TEST-Er6u2)", status: http.StatusConflict},
+		},
+		{
+			name: "not found, no scim type",
+			err:  caos_errs.ThrowNotFound(nil, "TEST-Er3n7", "Errors.SCIM.Group.NotFound"),
+			want: &Error{Schemas: []string{schemaError}, Status: "404", Detail: "Errors.SCIM.Group.NotFound (TEST-Er3n7)", status: http.StatusNotFound},
+		},
+		{
+			name: "permission denied",
+			err:  caos_errs.ThrowPermissionDenied(nil, "TEST-Er8p5", "No matching permissions found"),
+			want: &Error{Schemas: []string{schemaError}, Status: "403", Detail: "No matching permissions found (TEST-Er8p5)", status: http.StatusForbidden},
+		},
+		{
+			name: "unknown error, internal",
+			err:  context.Canceled,
+			want: &Error{Schemas: []string{schemaError}, Status: "500", status: http.StatusInternalServerError},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, toError(tt.err))
+		})
+	}
+}
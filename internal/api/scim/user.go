@@ -0,0 +1,604 @@
+package scim
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/zitadel/logging"
+	"golang.org/x/text/language"
+
+	"github.com/dennigogo/zitadel/internal/command"
+	"github.com/dennigogo/zitadel/internal/domain"
+	caos_errs "github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/eventstore/v1/models"
+	"github.com/dennigogo/zitadel/internal/query"
+)
+
+// metadataKeyExternalID is the key of the user metadata the externalId is stored in
+const metadataKeyExternalID = "scim.externalId"
+
+// User is the resource of a human user (RFC 7643 section 4.1)
+type User struct {
+	Schemas           []string                `json:"schemas"`
+	ID                string                  `json:"id,omitempty"`
+	ExternalID        string                  `json:"externalId,omitempty"`
+	UserName          string                  `json:"userName"`
+	Name              *Name                   `json:"name,omitempty"`
+	DisplayName       string                  `json:"displayName,omitempty"`
+	NickName          string                  `json:"nickName,omitempty"`
+	PreferredLanguage string                  `json:"preferredLanguage,omitempty"`
+	Active            *bool                   `json:"active,omitempty"`
+	Password          string                  `json:"password,omitempty"`
+	Emails            []*MultiValuedAttribute `json:"emails,omitempty"`
+	PhoneNumbers      []*MultiValuedAttribute `json:"phoneNumbers,omitempty"`
+	Meta              *Meta                   `json:"meta,omitempty"`
+}
+
+type Name struct {
+	Formatted  string `json:"formatted,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+}
+
+type MultiValuedAttribute struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+// primaryValue returns the value of the last primary attribute or the first value if none is primary,
+// as users only have one email and phone
+func primaryValue(attributes []*MultiValuedAttribute) string {
+	for i := len(attributes) - 1; i >= 0; i-- {
+		if attributes[i].Primary {
+			return attributes[i].Value
+		}
+	}
+	if len(attributes) > 0 {
+		return attributes[0].Value
+	}
+	return ""
+}
+
+func (u *User) givenName() string {
+	if u.Name == nil {
+		return ""
+	}
+	return u.Name.GivenName
+}
+
+func (u *User) familyName() string {
+	if u.Name == nil {
+		return ""
+	}
+	return u.Name.FamilyName
+}
+
+func (u *User) preferredLanguage() language.Tag {
+	if u.PreferredLanguage == "" {
+		return language.Und
+	}
+	lang, err := language.Parse(u.PreferredLanguage)
+	logging.OnError(err).Debug("unable to parse language")
+	return lang
+}
+
+func (h *Handler) userToSCIM(ctx context.Context, user *query.User, externalID string) *User {
+	active := user.State != domain.UserStateInactive
+	resource := &User{
+		Schemas:    []string{schemaUser},
+		ID:         user.ID,
+		ExternalID: externalID,
+		UserName:   user.Username,
+		Name: &Name{
+			Formatted:  user.Human.DisplayName,
+			FamilyName: user.Human.LastName,
+			GivenName:  user.Human.FirstName,
+		},
+		DisplayName: user.Human.DisplayName,
+		NickName:    user.Human.NickName,
+		Active:      &active,
+		Emails: []*MultiValuedAttribute{
+			{
+				Value:   user.Human.Email,
+				Type:    "work",
+				Primary: true,
+			},
+		},
+		Meta: &Meta{
+			ResourceType: resourceTypeUser,
+			Created:      user.CreationDate,
+			LastModified: user.ChangeDate,
+			Location:     h.location(ctx, user.ResourceOwner, endpointUsers, user.ID),
+			Version:      version(user.Sequence),
+		},
+	}
+	if !user.Human.PreferredLanguage.IsRoot() {
+		resource.PreferredLanguage = user.Human.PreferredLanguage.String()
+	}
+	if user.Human.Phone != "" {
+		resource.PhoneNumbers = []*MultiValuedAttribute{
+			{
+				Value:   user.Human.Phone,
+				Type:    "work",
+				Primary: true,
+			},
+		}
+	}
+	return resource
+}
+
+func (h *Handler) getUser(ctx context.Context, req *request) (*response, error) {
+	if err := checkPermission(ctx, permissionRead); err != nil {
+		return nil, err
+	}
+	resource, err := h.userResource(ctx, req.orgID, req.id)
+	if err != nil {
+		return nil, err
+	}
+	return &response{status: http.StatusOK, resource: resource}, nil
+}
+
+func (h *Handler) listUsers(ctx context.Context, req *request) (*response, error) {
+	if err := checkPermission(ctx, permissionRead); err != nil {
+		return nil, err
+	}
+	list, err := listRequestFromQuery(req.query)
+	if err != nil {
+		return nil, err
+	}
+	return h.searchUserResources(ctx, req.orgID, list)
+}
+
+func (h *Handler) searchUsers(ctx context.Context, req *request) (*response, error) {
+	if err := checkPermission(ctx, permissionRead); err != nil {
+		return nil, err
+	}
+	list, err := listRequestFromBody(req.body)
+	if err != nil {
+		return nil, err
+	}
+	return h.searchUserResources(ctx, req.orgID, list)
+}
+
+func (h *Handler) searchUserResources(ctx context.Context, orgID string, list *listRequest) (*response, error) {
+	queries, err := usersQuery(orgID, list.filter)
+	if err != nil {
+		return nil, err
+	}
+	// a count of 0 only returns the total results
+	limit := list.count
+	if limit == 0 {
+		limit = 1
+	}
+	users, err := h.queries.SearchUsers(ctx, &query.UserSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset:        list.offset(),
+			Limit:         limit,
+			SortingColumn: query.UserIDCol,
+			Asc:           true,
+		},
+		Queries: queries,
+	})
+	if err != nil {
+		return nil, err
+	}
+	resources := make([]*User, 0, len(users.Users))
+	if list.count > 0 {
+		for _, user := range users.Users {
+			resources = append(resources, h.userToSCIM(ctx, user, h.externalID(ctx, orgID, user.ID)))
+		}
+	}
+	return listResponse(resources, users.Count, list.startIndex, uint64(len(resources))), nil
+}
+
+func (h *Handler) createUser(ctx context.Context, req *request) (*response, error) {
+	if err := checkPermission(ctx, permissionWrite); err != nil {
+		return nil, err
+	}
+	resource := new(User)
+	if err := unmarshalBody(req.body, resource); err != nil {
+		return nil, err
+	}
+	inactive := resource.Active != nil && !*resource.Active
+	// users without password are in state initial which can't be deactivated
+	if inactive && resource.Password == "" {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "SCIM-Us4k9", "Errors.User.CantDeactivateInitial")
+	}
+	human := &command.AddHuman{
+		Username:    resource.UserName,
+		FirstName:   resource.givenName(),
+		LastName:    resource.familyName(),
+		NickName:    resource.NickName,
+		DisplayName: resource.DisplayName,
+		// the provisioning system is trusted, therefore the email and phone are verified
+		Email: command.Email{
+			Address:  primaryValue(resource.Emails),
+			Verified: true,
+		},
+		PreferredLanguage: resource.preferredLanguage(),
+		Password:          resource.Password,
+	}
+	if phone := primaryValue(resource.PhoneNumbers); phone != "" {
+		human.Phone = command.Phone{
+			Number:   phone,
+			Verified: true,
+		}
+	}
+	details, err := h.commands.AddHuman(ctx, req.orgID, human)
+	if err != nil {
+		return nil, err
+	}
+	if resource.ExternalID != "" {
+		if _, err = h.commands.SetUserMetadata(ctx, &domain.Metadata{Key: metadataKeyExternalID, Value: []byte(resource.ExternalID)}, details.ID, req.orgID); err != nil {
+			return nil, err
+		}
+	}
+	if inactive {
+		if _, err = h.commands.DeactivateUser(ctx, details.ID, req.orgID); err != nil {
+			return nil, err
+		}
+	}
+	created, err := h.userResource(ctx, req.orgID, details.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &response{
+		status:   http.StatusCreated,
+		resource: created,
+		location: created.Meta.Location,
+	}, nil
+}
+
+func (h *Handler) replaceUser(ctx context.Context, req *request) (*response, error) {
+	if err := checkPermission(ctx, permissionWrite); err != nil {
+		return nil, err
+	}
+	current, err := h.user(ctx, req.orgID, req.id)
+	if err != nil {
+		return nil, err
+	}
+	resource := new(User)
+	if err = unmarshalBody(req.body, resource); err != nil {
+		return nil, err
+	}
+	return h.updateUser(ctx, req.orgID, current, h.externalID(ctx, req.orgID, current.ID), resource)
+}
+
+func (h *Handler) patchUser(ctx context.Context, req *request) (*response, error) {
+	if err := checkPermission(ctx, permissionWrite); err != nil {
+		return nil, err
+	}
+	current, err := h.user(ctx, req.orgID, req.id)
+	if err != nil {
+		return nil, err
+	}
+	externalID := h.externalID(ctx, req.orgID, current.ID)
+	resource := new(User)
+	if err = patchResource(req.body, h.userToSCIM(ctx, current, externalID), resource); err != nil {
+		return nil, err
+	}
+	return h.updateUser(ctx, req.orgID, current, externalID, resource)
+}
+
+// updateUser changes the user to match the resource,
+// the email and the name are kept if they are missing because they are required
+func (h *Handler) updateUser(ctx context.Context, orgID string, current *query.User, currentExternalID string, resource *User) (_ *response, err error) {
+	if resource.UserName != "" && resource.UserName != current.Username {
+		if _, err = h.commands.ChangeUsername(ctx, orgID, current.ID, resource.UserName); err != nil {
+			return nil, err
+		}
+	}
+	if profile, changed := profileToDomain(orgID, current, resource); changed {
+		if _, err = h.commands.ChangeHumanProfile(ctx, profile); err != nil {
+			return nil, err
+		}
+	}
+	if email := primaryValue(resource.Emails); email != "" && email != current.Human.Email {
+		emailCodeGenerator, err := h.queries.InitEncryptionGenerator(ctx, domain.SecretGeneratorTypeVerifyEmailCode, h.userCodeAlg)
+		if err != nil {
+			return nil, err
+		}
+		_, err = h.commands.ChangeHumanEmail(ctx, &domain.Email{
+			ObjectRoot:      models.ObjectRoot{AggregateID: current.ID, ResourceOwner: orgID},
+			EmailAddress:    email,
+			IsEmailVerified: true,
+		}, emailCodeGenerator)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err = h.updatePhone(ctx, orgID, current, primaryValue(resource.PhoneNumbers)); err != nil {
+		return nil, err
+	}
+	if resource.Password != "" {
+		if _, err = h.commands.SetPassword(ctx, orgID, current.ID, resource.Password, false); err != nil {
+			return nil, err
+		}
+	}
+	if resource.ExternalID != currentExternalID {
+		if resource.ExternalID == "" {
+			_, err = h.commands.RemoveUserMetadata(ctx, metadataKeyExternalID, current.ID, orgID)
+		} else {
+			_, err = h.commands.SetUserMetadata(ctx, &domain.Metadata{Key: metadataKeyExternalID, Value: []byte(resource.ExternalID)}, current.ID, orgID)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err = h.updateUserState(ctx, orgID, current, resource.Active); err != nil {
+		return nil, err
+	}
+	updated, err := h.userResource(ctx, orgID, current.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &response{status: http.StatusOK, resource: updated}, nil
+}
+
+func profileToDomain(orgID string, current *query.User, resource *User) (*domain.Profile, bool) {
+	profile := &domain.Profile{
+		ObjectRoot:        models.ObjectRoot{AggregateID: current.ID, ResourceOwner: orgID},
+		FirstName:         current.Human.FirstName,
+		LastName:          current.Human.LastName,
+		NickName:          resource.NickName,
+		DisplayName:       current.Human.DisplayName,
+		PreferredLanguage: current.Human.PreferredLanguage,
+		Gender:            current.Human.Gender,
+	}
+	if givenName := resource.givenName(); givenName != "" {
+		profile.FirstName = givenName
+	}
+	if familyName := resource.familyName(); familyName != "" {
+		profile.LastName = familyName
+	}
+	if resource.DisplayName != "" {
+		profile.DisplayName = resource.DisplayName
+	}
+	if resource.PreferredLanguage != "" {
+		profile.PreferredLanguage = resource.preferredLanguage()
+	}
+	changed := profile.FirstName != current.Human.FirstName ||
+		profile.LastName != current.Human.LastName ||
+		profile.NickName != current.Human.NickName ||
+		profile.DisplayName != current.Human.DisplayName ||
+		profile.PreferredLanguage != current.Human.PreferredLanguage
+	return profile, changed
+}
+
+func (h *Handler) updatePhone(ctx context.Context, orgID string, current *query.User, phone string) error {
+	if phone == current.Human.Phone {
+		return nil
+	}
+	if phone == "" {
+		_, err := h.commands.RemoveHumanPhone(ctx, current.ID, orgID)
+		return err
+	}
+	phoneCodeGenerator, err := h.queries.InitEncryptionGenerator(ctx, domain.SecretGeneratorTypeVerifyPhoneCode, h.userCodeAlg)
+	if err != nil {
+		return err
+	}
+	_, err = h.commands.ChangeHumanPhone(ctx, &domain.Phone{
+		ObjectRoot:      models.ObjectRoot{AggregateID: current.ID},
+		PhoneNumber:     phone,
+		IsPhoneVerified: true,
+	}, orgID, phoneCodeGenerator)
+	return err
+}
+
+func (h *Handler) updateUserState(ctx context.Context, orgID string, current *query.User, active *bool) (err error) {
+	if active == nil || *active == (current.State != domain.UserStateInactive) {
+		return nil
+	}
+	if *active {
+		_, err = h.commands.ReactivateUser(ctx, current.ID, orgID)
+		return err
+	}
+	_, err = h.commands.DeactivateUser(ctx, current.ID, orgID)
+	return err
+}
+
+func (h *Handler) deleteUser(ctx context.Context, req *request) (*response, error) {
+	if err := checkPermission(ctx, permissionDelete); err != nil {
+		return nil, err
+	}
+	if _, err := h.user(ctx, req.orgID, req.id); err != nil {
+		return nil, err
+	}
+	userGrantUserQuery, err := query.NewUserGrantUserIDSearchQuery(req.id)
+	if err != nil {
+		return nil, err
+	}
+	grants, err := h.queries.UserGrants(ctx, &query.UserGrantsQueries{
+		Queries: []query.SearchQuery{userGrantUserQuery},
+	})
+	if err != nil {
+		return nil, err
+	}
+	membershipsUserQuery, err := query.NewMembershipUserIDQuery(req.id)
+	if err != nil {
+		return nil, err
+	}
+	memberships, err := h.queries.Memberships(ctx, &query.MembershipSearchQuery{
+		Queries: []query.SearchQuery{membershipsUserQuery},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if _, err = h.commands.RemoveUser(ctx, req.id, req.orgID, cascadingMemberships(memberships.Memberships), userGrantsToIDs(grants.UserGrants)...); err != nil {
+		return nil, err
+	}
+	if err = h.removeGroupMember(ctx, req.orgID, req.id); err != nil {
+		return nil, err
+	}
+	return &response{status: http.StatusNoContent}, nil
+}
+
+// user returns the human user of the organisation
+func (h *Handler) user(ctx context.Context, orgID, userID string) (*query.User, error) {
+	resourceOwner, err := query.NewUserResourceOwnerSearchQuery(orgID, query.TextEquals)
+	if err != nil {
+		return nil, err
+	}
+	user, err := h.queries.GetUserByID(ctx, true, userID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if user.Human == nil {
+		return nil, caos_errs.ThrowNotFound(nil, "SCIM-Us8n3", "Errors.User.NotFound")
+	}
+	return user, nil
+}
+
+func (h *Handler) userResource(ctx context.Context, orgID, userID string) (*User, error) {
+	user, err := h.user(ctx, orgID, userID)
+	if err != nil {
+		return nil, err
+	}
+	return h.userToSCIM(ctx, user, h.externalID(ctx, orgID, userID)), nil
+}
+
+func (h *Handler) externalID(ctx context.Context, orgID, userID string) string {
+	resourceOwner, err := query.NewUserMetadataResourceOwnerSearchQuery(orgID)
+	if err != nil {
+		return ""
+	}
+	metadata, err := h.queries.GetUserMetadataByKey(ctx, true, userID, metadataKeyExternalID, resourceOwner)
+	if err != nil {
+		logging.OnError(err).WithField("user", userID).Debug("no external id of user")
+		return ""
+	}
+	return string(metadata.Value)
+}
+
+// usersQuery maps the filter to the queries of human users of the organisation
+func usersQuery(orgID string, f filter) ([]query.SearchQuery, error) {
+	resourceOwner, err := query.NewUserResourceOwnerSearchQuery(orgID, query.TextEquals)
+	if err != nil {
+		return nil, err
+	}
+	human, err := query.NewUserTypeSearchQuery(int32(domain.UserTypeHuman))
+	if err != nil {
+		return nil, err
+	}
+	queries := []query.SearchQuery{resourceOwner, human}
+	for _, expr := range f {
+		q, err := userExpressionToQuery(expr)
+		if err != nil {
+			return nil, err
+		}
+		queries = append(queries, q)
+	}
+	return queries, nil
+}
+
+func userExpressionToQuery(expr *expression) (query.SearchQuery, error) {
+	if expr.attribute == "active" {
+		active, ok := expr.value.(bool)
+		if !ok || (expr.operator != operatorEqual && expr.operator != operatorNotEqual) {
+			return nil, invalidFilter(nil)
+		}
+		comparison := query.NumberNotEquals
+		if active != (expr.operator == operatorEqual) {
+			comparison = query.NumberEquals
+		}
+		return query.NewNumberQuery(query.UserStateCol, int32(domain.UserStateInactive), comparison)
+	}
+	var newQuery func(string, query.TextComparison) (query.SearchQuery, error)
+	switch expr.attribute {
+	case "username":
+		newQuery = query.NewUserUsernameSearchQuery
+	case "emails", "emails.value":
+		newQuery = query.NewUserEmailSearchQuery
+	case "phonenumbers", "phonenumbers.value":
+		newQuery = query.NewUserPhoneSearchQuery
+	case "name.givenname":
+		newQuery = query.NewUserFirstNameSearchQuery
+	case "name.familyname":
+		newQuery = query.NewUserLastNameSearchQuery
+	case "displayname", "name.formatted":
+		newQuery = query.NewUserDisplayNameSearchQuery
+	case "nickname":
+		newQuery = query.NewUserNickNameSearchQuery
+	default:
+		return nil, invalidFilter(nil)
+	}
+	value, err := expr.stringValue()
+	if err != nil {
+		return nil, err
+	}
+	comparison, err := textComparison(expr.operator)
+	if err != nil {
+		return nil, err
+	}
+	return newQuery(value, comparison)
+}
+
+// textComparison maps the operator to a case insensitive comparison
+func textComparison(operator string) (query.TextComparison, error) {
+	switch operator {
+	case operatorEqual:
+		return query.TextEqualsIgnoreCase, nil
+	case operatorNotEqual:
+		return query.TextNotEquals, nil
+	case operatorContains:
+		return query.TextContainsIgnoreCase, nil
+	case operatorStartsWith:
+		return query.TextStartsWithIgnoreCase, nil
+	case operatorEndsWith:
+		return query.TextEndsWithIgnoreCase, nil
+	default:
+		return 0, invalidFilter(nil)
+	}
+}
+
+func cascadingMemberships(memberships []*query.Membership) []*command.CascadingMembership {
+	cascades := make([]*command.CascadingMembership, len(memberships))
+	for i, membership := range memberships {
+		cascades[i] = &command.CascadingMembership{
+			UserID:        membership.UserID,
+			ResourceOwner: membership.ResourceOwner,
+			IAM:           cascadingIAMMembership(membership.IAM),
+			Org:           cascadingOrgMembership(membership.Org),
+			Project:       cascadingProjectMembership(membership.Project),
+			ProjectGrant:  cascadingProjectGrantMembership(membership.ProjectGrant),
+		}
+	}
+	return cascades
+}
+
+func cascadingIAMMembership(membership *query.IAMMembership) *command.CascadingIAMMembership {
+	if membership == nil {
+		return nil
+	}
+	return &command.CascadingIAMMembership{IAMID: membership.IAMID}
+}
+
+func cascadingOrgMembership(membership *query.OrgMembership) *command.CascadingOrgMembership {
+	if membership == nil {
+		return nil
+	}
+	return &command.CascadingOrgMembership{OrgID: membership.OrgID}
+}
+
+func cascadingProjectMembership(membership *query.ProjectMembership) *command.CascadingProjectMembership {
+	if membership == nil {
+		return nil
+	}
+	return &command.CascadingProjectMembership{ProjectID: membership.ProjectID}
+}
+
+func cascadingProjectGrantMembership(membership *query.ProjectGrantMembership) *command.CascadingProjectGrantMembership {
+	if membership == nil {
+		return nil
+	}
+	return &command.CascadingProjectGrantMembership{ProjectID: membership.ProjectID, GrantID: membership.GrantID}
+}
+
+func userGrantsToIDs(userGrants []*query.UserGrant) []string {
+	converted := make([]string, len(userGrants))
+	for i, grant := range userGrants {
+		converted[i] = grant.ID
+	}
+	return converted
+}
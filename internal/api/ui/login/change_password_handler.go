@@ -19,6 +19,13 @@ type changePasswordData struct {
 	NewPasswordConfirmation string `schema:"change-password-confirmation"`
 }
 
+type passwordDoneData struct {
+	userData
+	// BreachedWarning is set if the new password was found in the breached passwords
+	// and the password complexity policy only warns about it
+	BreachedWarning bool
+}
+
 func (l *Login) handleChangePassword(w http.ResponseWriter, r *http.Request) {
 	data := new(changePasswordData)
 	authReq, err := l.getAuthRequestAndParseData(r, data)
@@ -32,7 +39,8 @@ func (l *Login) handleChangePassword(w http.ResponseWriter, r *http.Request) {
 		l.renderChangePassword(w, r, authReq, err)
 		return
 	}
-	l.renderChangePasswordDone(w, r, authReq)
+	breached := l.command.BreachedPasswordWarning(setContext(r.Context(), authReq.UserOrgID), authReq.UserOrgID, data.NewPassword)
+	l.renderChangePasswordDone(w, r, authReq, breached)
 }
 
 func (l *Login) renderChangePassword(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, err error) {
@@ -43,6 +51,7 @@ func (l *Login) renderChangePassword(w http.ResponseWriter, r *http.Request, aut
 	data := passwordData{
 		baseData:    l.getBaseData(r, authReq, "Change Password", errID, errMessage),
 		profileData: l.getProfileData(authReq),
+		Expired:     passwordExpired(authReq),
 	}
 	policy := l.getPasswordComplexityPolicy(r, authReq.UserOrgID)
 	if policy != nil {
@@ -64,8 +73,24 @@ func (l *Login) renderChangePassword(w http.ResponseWriter, r *http.Request, aut
 	l.renderer.RenderTemplate(w, r, translator, l.renderer.Templates[tmplChangePassword], data, nil)
 }
 
-func (l *Login) renderChangePasswordDone(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest) {
+func (l *Login) renderChangePasswordDone(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, breachedWarning bool) {
 	var errType, errMessage string
-	data := l.getUserData(r, authReq, "Password Change Done", errType, errMessage)
+	data := passwordDoneData{
+		userData:        l.getUserData(r, authReq, "Password Change Done", errType, errMessage),
+		BreachedWarning: breachedWarning,
+	}
 	l.renderer.RenderTemplate(w, r, l.getTranslator(r.Context(), authReq), l.renderer.Templates[tmplChangePasswordDone], data, nil)
 }
+
+// passwordExpired returns true if the password has to be changed because of the password age policy
+func passwordExpired(authReq *domain.AuthRequest) bool {
+	if authReq == nil {
+		return false
+	}
+	for _, step := range authReq.PossibleSteps {
+		if changePassword, ok := step.(*domain.ChangePasswordStep); ok {
+			return changePassword.Expired
+		}
+	}
+	return false
+}
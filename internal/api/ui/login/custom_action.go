@@ -7,10 +7,12 @@ import (
 	"github.com/dop251/goja"
 	"github.com/zitadel/logging"
 	"github.com/zitadel/oidc/v2/pkg/oidc"
+	"golang.org/x/oauth2"
 	"golang.org/x/text/language"
 
 	"github.com/dennigogo/zitadel/internal/actions"
 	"github.com/dennigogo/zitadel/internal/actions/object"
+	"github.com/dennigogo/zitadel/internal/actions/store"
 	"github.com/dennigogo/zitadel/internal/api/authz"
 	"github.com/dennigogo/zitadel/internal/domain"
 	iam_model "github.com/dennigogo/zitadel/internal/iam/model"
@@ -29,6 +31,10 @@ func (l *Login) customExternalUserMapping(ctx context.Context, user *domain.Exte
 	if err != nil {
 		return nil, err
 	}
+	// identity providers without tokens (e.g. SAML) provide empty token fields
+	if tokens == nil {
+		tokens = &oidc.Tokens{Token: &oauth2.Token{}, IDTokenClaims: oidc.EmptyIDTokenClaims()}
+	}
 
 	ctxFields := actions.SetContextFields(
 		actions.SetFields("accessToken", tokens.AccessToken),
@@ -115,7 +121,7 @@ func (l *Login) customExternalUserMapping(ctx context.Context, user *domain.Exte
 			apiFields,
 			a.Script,
 			a.Name,
-			append(actions.ActionToOptions(a), actions.WithHTTP(actionCtx), actions.WithLogger(actions.ServerLog))...,
+			append(actions.ActionToOptions(a, domain.FlowTypeExternalAuthentication, domain.TriggerTypePostAuthentication), actions.WithHTTP(actionCtx), actions.WithLogger(actions.ServerLog), actions.WithStore(actionCtx, store.New(l.command, l.query, a.ResourceOwner)))...,
 		)
 		cancel()
 		if err != nil {
@@ -219,7 +225,7 @@ func (l *Login) customExternalUserToLoginUserMapping(ctx context.Context, user *
 			apiFields,
 			a.Script,
 			a.Name,
-			append(actions.ActionToOptions(a), actions.WithHTTP(actionCtx), actions.WithLogger(actions.ServerLog))...,
+			append(actions.ActionToOptions(a, domain.FlowTypeExternalAuthentication, domain.TriggerTypePreCreation), actions.WithHTTP(actionCtx), actions.WithLogger(actions.ServerLog), actions.WithStore(actionCtx, store.New(l.command, l.query, a.ResourceOwner)))...,
 		)
 		cancel()
 		if err != nil {
@@ -229,8 +235,10 @@ func (l *Login) customExternalUserToLoginUserMapping(ctx context.Context, user *
 	return user, metadata, err
 }
 
-func (l *Login) customGrants(ctx context.Context, userID string, tokens *oidc.Tokens, req *domain.AuthRequest, config *iam_model.IDPConfigView, resourceOwner string) ([]*domain.UserGrant, error) {
-	triggerActions, err := l.query.GetActiveActionsByFlowAndTriggerType(ctx, domain.FlowTypeExternalAuthentication, domain.TriggerTypePostCreation, resourceOwner)
+// customUserGrants runs the post creation actions of the flow,
+// the contextFields are passed to the actions in addition to the created user
+func (l *Login) customUserGrants(ctx context.Context, flowType domain.FlowType, userID, resourceOwner string, contextFields ...actions.FieldOption) ([]*domain.UserGrant, error) {
+	triggerActions, err := l.query.GetActiveActionsByFlowAndTriggerType(ctx, flowType, domain.TriggerTypePostCreation, resourceOwner)
 	if err != nil {
 		return nil, err
 	}
@@ -280,14 +288,180 @@ func (l *Login) customGrants(ctx context.Context, userID string, tokens *oidc.To
 		),
 	)
 
+	for _, a := range triggerActions {
+		actionCtx, cancel := context.WithTimeout(ctx, a.Timeout())
+
+		ctxFields := actions.SetContextFields(
+			append([]actions.FieldOption{
+				actions.SetFields("v1",
+					actions.SetFields("getUser", func(c *actions.FieldConfig) interface{} {
+						return func(call goja.FunctionCall) goja.Value {
+							user, err := l.query.GetUserByID(actionCtx, true, userID)
+							if err != nil {
+								panic(err)
+							}
+							return object.UserFromQuery(c, user)
+						}
+					}),
+				),
+			}, contextFields...)...,
+		)
+
+		err = actions.Run(
+			actionCtx,
+			ctxFields,
+			apiFields,
+			a.Script,
+			a.Name,
+			append(actions.ActionToOptions(a, flowType, domain.TriggerTypePostCreation), actions.WithHTTP(actionCtx), actions.WithLogger(actions.ServerLog), actions.WithStore(actionCtx, store.New(l.command, l.query, a.ResourceOwner)))...,
+		)
+		cancel()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return actionUserGrantsToDomain(userID, actionUserGrants), err
+}
+
+func (l *Login) customRegistrationMapping(ctx context.Context, user *domain.Human, formData *registerFormData, req *domain.AuthRequest, resourceOwner string) (*domain.Human, []*domain.Metadata, error) {
+	triggerActions, err := l.query.GetActiveActionsByFlowAndTriggerType(ctx, domain.FlowTypeInternalAuthentication, domain.TriggerTypePreCreation, resourceOwner)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	metadata := make([]*domain.Metadata, 0)
+	denial := new(actions.Denial)
+
+	ctxFields := actions.SetContextFields(
+		actions.SetFields("v1",
+			actions.SetFields("user", func(c *actions.FieldConfig) interface{} {
+				return object.UserFromHuman(c, user)
+			}),
+			actions.SetFields("registration", func(c *actions.FieldConfig) interface{} {
+				return c.Runtime.ToValue(formData.toActionRegistration())
+			}),
+			actions.SetFields("authRequest", func(c *actions.FieldConfig) interface{} {
+				return object.AuthRequestFromDomain(c, req)
+			}),
+		),
+	)
+	apiFields := actions.WithAPIFields(
+		actions.SetFields("v1",
+			actions.SetFields("deny", denial.Deny),
+			actions.SetFields("user",
+				actions.SetFields("setFirstName", func(firstName string) {
+					user.FirstName = firstName
+				}),
+				actions.SetFields("setLastName", func(lastName string) {
+					user.LastName = lastName
+				}),
+				actions.SetFields("setNickName", func(nickName string) {
+					user.NickName = nickName
+				}),
+				actions.SetFields("setDisplayName", func(displayName string) {
+					user.DisplayName = displayName
+				}),
+				actions.SetFields("setPreferredLanguage", func(preferredLanguage string) {
+					user.PreferredLanguage = language.Make(preferredLanguage)
+				}),
+				actions.SetFields("setGender", func(gender domain.Gender) {
+					user.Gender = gender
+				}),
+				actions.SetFields("setUsername", func(username string) {
+					user.Username = username
+				}),
+				actions.SetFields("setPhone", func(phone string) {
+					if user.Phone == nil {
+						user.Phone = &domain.Phone{}
+					}
+					user.Phone.PhoneNumber = phone
+				}),
+				actions.SetFields("appendMetadata", func(call goja.FunctionCall) goja.Value {
+					if len(call.Arguments) != 2 {
+						panic("exactly 2 (key, value) arguments expected")
+					}
+					key := call.Arguments[0].Export().(string)
+					val := call.Arguments[1].Export()
+
+					value, err := json.Marshal(val)
+					if err != nil {
+						logging.WithError(err).Debug("unable to marshal")
+						panic(err)
+					}
+
+					metadata = append(metadata,
+						&domain.Metadata{
+							Key:   key,
+							Value: value,
+						})
+					return nil
+				}),
+			),
+		),
+	)
+
+	for _, a := range triggerActions {
+		actionCtx, cancel := context.WithTimeout(ctx, a.Timeout())
+		err = actions.Run(
+			actionCtx,
+			ctxFields,
+			apiFields,
+			a.Script,
+			a.Name,
+			append(actions.ActionToOptions(a, domain.FlowTypeInternalAuthentication, domain.TriggerTypePreCreation), actions.WithHTTP(actionCtx), actions.WithLogger(actions.ServerLog), actions.WithStore(actionCtx, store.New(l.command, l.query, a.ResourceOwner)))...,
+		)
+		cancel()
+		if err := denial.Err(); err != nil {
+			return nil, nil, err
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return user, metadata, err
+}
+
+// customInternalGrants runs the post creation actions of the registration,
+// like on pre creation the actions get the registration form data and the auth request
+func (l *Login) customInternalGrants(ctx context.Context, userID, resourceOwner string, formData *registerFormData, req *domain.AuthRequest) ([]*domain.UserGrant, error) {
+	return l.customUserGrants(ctx, domain.FlowTypeInternalAuthentication, userID, resourceOwner,
+		actions.SetFields("v1",
+			actions.SetFields("registration", func(c *actions.FieldConfig) interface{} {
+				return c.Runtime.ToValue(formData.toActionRegistration())
+			}),
+			actions.SetFields("authRequest", func(c *actions.FieldConfig) interface{} {
+				return object.AuthRequestFromDomain(c, req)
+			}),
+		),
+	)
+}
+
+// customInternalAuthentication runs the post authentication actions of local users,
+// the actions are able to deny the login
+func (l *Login) customInternalAuthentication(ctx context.Context, req *domain.AuthRequest) error {
+	triggerActions, err := l.query.GetActiveActionsByFlowAndTriggerType(ctx, domain.FlowTypeInternalAuthentication, domain.TriggerTypePostAuthentication, req.UserOrgID)
+	if err != nil {
+		return err
+	}
+
+	denial := new(actions.Denial)
+	apiFields := actions.WithAPIFields(
+		actions.SetFields("v1",
+			actions.SetFields("deny", denial.Deny),
+		),
+	)
+
 	for _, a := range triggerActions {
 		actionCtx, cancel := context.WithTimeout(ctx, a.Timeout())
 
 		ctxFields := actions.SetContextFields(
 			actions.SetFields("v1",
+				actions.SetFields("authRequest", func(c *actions.FieldConfig) interface{} {
+					return object.AuthRequestFromDomain(c, req)
+				}),
 				actions.SetFields("getUser", func(c *actions.FieldConfig) interface{} {
 					return func(call goja.FunctionCall) goja.Value {
-						user, err := l.query.GetUserByID(actionCtx, true, userID)
+						user, err := l.query.GetUserByID(actionCtx, true, req.UserID)
 						if err != nil {
 							panic(err)
 						}
@@ -303,14 +477,17 @@ func (l *Login) customGrants(ctx context.Context, userID string, tokens *oidc.To
 			apiFields,
 			a.Script,
 			a.Name,
-			append(actions.ActionToOptions(a), actions.WithHTTP(actionCtx), actions.WithLogger(actions.ServerLog))...,
+			append(actions.ActionToOptions(a, domain.FlowTypeInternalAuthentication, domain.TriggerTypePostAuthentication), actions.WithHTTP(actionCtx), actions.WithLogger(actions.ServerLog), actions.WithStore(actionCtx, store.New(l.command, l.query, a.ResourceOwner)))...,
 		)
 		cancel()
+		if err := denial.Err(); err != nil {
+			return err
+		}
 		if err != nil {
-			return nil, err
+			return err
 		}
 	}
-	return actionUserGrantsToDomain(userID, actionUserGrants), err
+	return nil
 }
 
 func actionUserGrantsToDomain(userID string, actionUserGrants []actions.UserGrant) []*domain.UserGrant {
@@ -0,0 +1,174 @@
+package login
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/dennigogo/zitadel/internal/api/authz"
+	http_mw "github.com/dennigogo/zitadel/internal/api/http/middleware"
+	"github.com/dennigogo/zitadel/internal/domain"
+	caos_errs "github.com/dennigogo/zitadel/internal/errors"
+)
+
+const (
+	tmplDeviceAuthUserCode = "device-usercode"
+	tmplDeviceAuthAction   = "device-action"
+	tmplDeviceAuthDone     = "device-done"
+
+	QueryUserCode = "user_code"
+
+	deviceAuthAllow = "allow"
+)
+
+type deviceAuthUserCodeFormData struct {
+	UserCode string `schema:"user_code"`
+}
+
+type deviceAuthActionFormData struct {
+	Action string `schema:"action"`
+}
+
+type deviceAuthUserCodeData struct {
+	baseData
+	UserCode string
+}
+
+type deviceAuthActionData struct {
+	userData
+	AppName string
+	Scopes  []string
+}
+
+type deviceAuthDoneData struct {
+	baseData
+	Approved bool
+}
+
+func (l *Login) handleDeviceAuthUserCode(w http.ResponseWriter, r *http.Request) {
+	l.renderDeviceAuthUserCode(w, r, r.FormValue(QueryUserCode), nil)
+}
+
+// handleDeviceAuthUserCodeCheck starts the login of the user for the device authorization with the entered user code
+func (l *Login) handleDeviceAuthUserCodeCheck(w http.ResponseWriter, r *http.Request) {
+	data := new(deviceAuthUserCodeFormData)
+	if err := l.getParseData(r, data); err != nil {
+		l.renderDeviceAuthUserCode(w, r, "", err)
+		return
+	}
+	deviceAuth, err := l.query.DeviceAuthByUserCode(r.Context(), true, domain.NormalizeUserCode(data.UserCode))
+	if err != nil {
+		l.renderDeviceAuthUserCode(w, r, data.UserCode, err)
+		return
+	}
+	if err = checkDeviceAuthPending(deviceAuth); err != nil {
+		l.renderDeviceAuthUserCode(w, r, data.UserCode, err)
+		return
+	}
+	userAgentID, _ := http_mw.UserAgentIDFromCtx(r.Context())
+	authReq, err := l.authRepo.CreateAuthRequest(r.Context(), &domain.AuthRequest{
+		CreationDate:  time.Now(),
+		AgentID:       userAgentID,
+		BrowserInfo:   domain.BrowserInfoFromRequest(r),
+		ApplicationID: deviceAuth.ClientID,
+		InstanceID:    authz.GetInstance(r.Context()).InstanceID(),
+		Request: &domain.AuthRequestDevice{
+			ID:       deviceAuth.ID,
+			UserCode: deviceAuth.UserCode,
+			Scopes:   deviceAuth.Scopes,
+		},
+	})
+	if err != nil {
+		l.renderDeviceAuthUserCode(w, r, data.UserCode, err)
+		return
+	}
+	http.Redirect(w, r, l.renderer.pathPrefix+EndpointLogin+"?"+QueryAuthRequestID+"="+authReq.ID, http.StatusFound)
+}
+
+func (l *Login) renderDeviceAuthUserCode(w http.ResponseWriter, r *http.Request, userCode string, err error) {
+	var errID, errMessage string
+	if err != nil {
+		errID, errMessage = l.getErrorMessage(r, err)
+	}
+	data := &deviceAuthUserCodeData{
+		baseData: l.getBaseData(r, nil, "Device Authorization", errID, errMessage),
+		UserCode: userCode,
+	}
+	l.renderer.RenderTemplate(w, r, l.getTranslator(r.Context(), nil), l.renderer.Templates[tmplDeviceAuthUserCode], data, nil)
+}
+
+// renderDeviceAuthAction asks the authenticated user to allow or deny the device
+func (l *Login) renderDeviceAuthAction(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, deviceReq *domain.AuthRequestDevice) {
+	data := &deviceAuthActionData{
+		userData: l.getUserData(r, authReq, "Device Authorization", "", ""),
+		AppName:  authReq.ApplicationID,
+		Scopes:   deviceReq.Scopes,
+	}
+	app, err := l.query.AppByOIDCClientID(r.Context(), authReq.ApplicationID)
+	if err == nil {
+		data.AppName = app.Name
+	}
+	l.renderer.RenderTemplate(w, r, l.getTranslator(r.Context(), authReq), l.renderer.Templates[tmplDeviceAuthAction], data, nil)
+}
+
+func (l *Login) handleDeviceAuthAction(w http.ResponseWriter, r *http.Request) {
+	data := new(deviceAuthActionFormData)
+	authReq, err := l.getAuthRequestAndParseData(r, data)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	if authReq == nil {
+		l.renderError(w, r, nil, caos_errs.ThrowInvalidArgument(nil, "LOGIN-Dv7q1", "Errors.AuthRequest.NotFound"))
+		return
+	}
+	deviceReq, ok := authReq.Request.(*domain.AuthRequestDevice)
+	if !ok || !deviceAuthLoginDone(authReq) {
+		l.renderError(w, r, authReq, caos_errs.ThrowPreconditionFailed(nil, "LOGIN-Dv4h6", "Errors.AuthRequest.RequestTypeNotSupported"))
+		return
+	}
+	ctx := setContext(r.Context(), authReq.UserOrgID)
+	approved := data.Action == deviceAuthAllow
+	if approved {
+		_, err = l.command.ApproveDeviceAuth(ctx, deviceReq.ID, authReq.UserID, authReq.UserOrgID, l.authMethodsReferences(authReq), authReq.AuthTime)
+	} else {
+		_, err = l.command.DenyDeviceAuth(ctx, deviceReq.ID)
+	}
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	if err = l.authRepo.DeleteAuthRequest(r.Context(), authReq.ID); err != nil {
+		l.renderError(w, r, nil, err)
+		return
+	}
+	l.renderDeviceAuthDone(w, r, approved)
+}
+
+func (l *Login) renderDeviceAuthDone(w http.ResponseWriter, r *http.Request, approved bool) {
+	data := &deviceAuthDoneData{
+		baseData: l.getBaseData(r, nil, "Device Authorization", "", ""),
+		Approved: approved,
+	}
+	l.renderer.RenderTemplate(w, r, l.getTranslator(r.Context(), nil), l.renderer.Templates[tmplDeviceAuthDone], data, nil)
+}
+
+// checkDeviceAuthPending checks that the device authorization can still be allowed or denied by the user
+func checkDeviceAuthPending(deviceAuth *domain.DeviceAuth) error {
+	if deviceAuth.State != domain.DeviceAuthStateInitiated || deviceAuth.IsExpired() {
+		return caos_errs.ThrowPreconditionFailed(nil, "LOGIN-Dv2m8", "Errors.DeviceAuth.Expired")
+	}
+	return nil
+}
+
+// deviceAuthLoginDone checks that the user finished the login (incl. mfa and policies)
+func deviceAuthLoginDone(authReq *domain.AuthRequest) bool {
+	if authReq.UserID == "" || len(authReq.PossibleSteps) == 0 {
+		return false
+	}
+	for _, step := range authReq.PossibleSteps {
+		if step.Type() != domain.NextStepRedirectToCallback {
+			return false
+		}
+	}
+	return true
+}
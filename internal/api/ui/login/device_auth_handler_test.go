@@ -0,0 +1,101 @@
+package login
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dennigogo/zitadel/internal/domain"
+	"github.com/dennigogo/zitadel/internal/errors"
+)
+
+func Test_checkDeviceAuthPending(t *testing.T) {
+	tests := []struct {
+		name       string
+		deviceAuth *domain.DeviceAuth
+		wantErr    bool
+	}{
+		{
+			name:       "pending, ok",
+			deviceAuth: &domain.DeviceAuth{State: domain.DeviceAuthStateInitiated, Expires: time.Now().Add(time.Minute)},
+		},
+		{
+			name:       "expired, precondition error",
+			deviceAuth: &domain.DeviceAuth{State: domain.DeviceAuthStateInitiated, Expires: time.Now().Add(-time.Minute)},
+			wantErr:    true,
+		},
+		{
+			name:       "approved, precondition error",
+			deviceAuth: &domain.DeviceAuth{State: domain.DeviceAuthStateApproved, Expires: time.Now().Add(time.Minute)},
+			wantErr:    true,
+		},
+		{
+			name:       "denied, precondition error",
+			deviceAuth: &domain.DeviceAuth{State: domain.DeviceAuthStateDenied, Expires: time.Now().Add(time.Minute)},
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkDeviceAuthPending(tt.deviceAuth)
+			if tt.wantErr {
+				assert.True(t, errors.IsPreconditionFailed(err), "unexpected error: %v", err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func Test_deviceAuthLoginDone(t *testing.T) {
+	tests := []struct {
+		name    string
+		authReq *domain.AuthRequest
+		want    bool
+	}{
+		{
+			name: "user missing",
+			authReq: &domain.AuthRequest{
+				PossibleSteps: []domain.NextStep{&domain.RedirectToCallbackStep{}},
+			},
+			want: false,
+		},
+		{
+			name: "steps missing",
+			authReq: &domain.AuthRequest{
+				UserID: "user1",
+			},
+			want: false,
+		},
+		{
+			name: "mfa missing",
+			authReq: &domain.AuthRequest{
+				UserID:        "user1",
+				PossibleSteps: []domain.NextStep{&domain.MFAVerificationStep{}},
+			},
+			want: false,
+		},
+		{
+			name: "password and callback",
+			authReq: &domain.AuthRequest{
+				UserID:        "user1",
+				PossibleSteps: []domain.NextStep{&domain.PasswordStep{}, &domain.RedirectToCallbackStep{}},
+			},
+			want: false,
+		},
+		{
+			name: "login done",
+			authReq: &domain.AuthRequest{
+				UserID:        "user1",
+				PossibleSteps: []domain.NextStep{&domain.RedirectToCallbackStep{}},
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, deviceAuthLoginDone(tt.authReq))
+		})
+	}
+}
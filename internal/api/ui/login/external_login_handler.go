@@ -94,6 +94,18 @@ func (l *Login) handleIDP(w http.ResponseWriter, r *http.Request, authReq *domai
 		l.renderLogin(w, r, authReq, err)
 		return
 	}
+	if idpConfig.IsSAML {
+		l.handleSAMLAuthorize(w, r, authReq, idpConfig)
+		return
+	}
+	if idpConfig.IsLDAP {
+		l.renderLDAPLogin(w, r, authReq, idpConfig, "", nil)
+		return
+	}
+	if idpConfig.IsOAuth2 {
+		l.handleOAuth2Authorize(w, r, authReq, idpConfig)
+		return
+	}
 	if !idpConfig.IsOIDC {
 		l.handleJWTAuthorize(w, r, authReq, idpConfig)
 		return
@@ -165,6 +177,10 @@ func (l *Login) handleExternalLoginCallback(w http.ResponseWriter, r *http.Reque
 		l.handleExternalUserAuthenticated(w, r, authReq, idpConfig, userAgentID, tokens)
 		return
 	}
+	if idpConfig.IsOAuth2 {
+		l.handleOAuth2Callback(w, r, authReq, idpConfig, userAgentID, data.Code)
+		return
+	}
 	l.renderError(w, r, authReq, errors.ThrowPreconditionFailed(nil, "RP-asff2", "Errors.ExternalIDP.IDPTypeNotImplemented"))
 }
 
@@ -199,8 +215,11 @@ func (l *Login) handleExternalUserAuthenticated(w http.ResponseWriter, r *http.R
 		l.renderError(w, r, authReq, err)
 		return
 	}
+	l.handleExternalUserLogin(w, r, authReq, idpConfig, userAgentID, externalUser)
+}
 
-	err = l.authRepo.CheckExternalUserLogin(setContext(r.Context(), ""), authReq.ID, userAgentID, externalUser, domain.BrowserInfoFromRequest(r))
+func (l *Login) handleExternalUserLogin(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, idpConfig *iam_model.IDPConfigView, userAgentID string, externalUser *domain.ExternalUser) {
+	err := l.authRepo.CheckExternalUserLogin(setContext(r.Context(), ""), authReq.ID, userAgentID, externalUser, domain.BrowserInfoFromRequest(r))
 	if err != nil {
 		if errors.IsNotFound(err) {
 			err = nil
@@ -392,7 +411,7 @@ func (l *Login) handleAutoRegister(w http.ResponseWriter, r *http.Request, authR
 		l.renderError(w, r, authReq, err)
 		return
 	}
-	userGrants, err := l.customGrants(r.Context(), authReq.UserID, nil, authReq, idpConfig, resourceOwner)
+	userGrants, err := l.customUserGrants(r.Context(), domain.FlowTypeExternalAuthentication, authReq.UserID, resourceOwner)
 	if err != nil {
 		l.renderError(w, r, authReq, err)
 		return
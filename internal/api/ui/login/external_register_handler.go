@@ -78,6 +78,18 @@ func (l *Login) handleExternalRegisterByConfigID(w http.ResponseWriter, r *http.
 		l.renderLogin(w, r, authReq, err)
 		return
 	}
+	if idpConfig.IsSAML {
+		l.handleSAMLAuthorize(w, r, authReq, idpConfig)
+		return
+	}
+	if idpConfig.IsLDAP {
+		l.renderLDAPLogin(w, r, authReq, idpConfig, "", nil)
+		return
+	}
+	if idpConfig.IsOAuth2 {
+		l.handleOAuth2Authorize(w, r, authReq, idpConfig)
+		return
+	}
 	if !idpConfig.IsOIDC {
 		l.handleJWTAuthorize(w, r, authReq, idpConfig)
 		return
@@ -84,7 +84,8 @@ func (l *Login) checkPWCode(w http.ResponseWriter, r *http.Request, authReq *dom
 		l.renderInitPassword(w, r, authReq, data.UserID, "", err)
 		return
 	}
-	l.renderInitPasswordDone(w, r, authReq, userOrg)
+	breached := l.command.BreachedPasswordWarning(setContext(r.Context(), userOrg), userOrg, data.Password)
+	l.renderInitPasswordDone(w, r, authReq, userOrg, breached)
 }
 
 func (l *Login) resendPasswordSet(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest) {
@@ -155,8 +156,11 @@ func (l *Login) renderInitPassword(w http.ResponseWriter, r *http.Request, authR
 	l.renderer.RenderTemplate(w, r, translator, l.renderer.Templates[tmplInitPassword], data, nil)
 }
 
-func (l *Login) renderInitPasswordDone(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, orgID string) {
-	data := l.getUserData(r, authReq, "Password Init Done", "", "")
+func (l *Login) renderInitPasswordDone(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, orgID string, breachedWarning bool) {
+	data := passwordDoneData{
+		userData:        l.getUserData(r, authReq, "Password Init Done", "", ""),
+		BreachedWarning: breachedWarning,
+	}
 	translator := l.getTranslator(r.Context(), authReq)
 	if authReq == nil {
 		l.customTexts(r.Context(), translator, orgID)
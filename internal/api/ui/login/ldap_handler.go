@@ -0,0 +1,216 @@
+package login
+
+import (
+	"crypto/tls"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-ldap/ldap/v3"
+
+	"github.com/dennigogo/zitadel/internal/crypto"
+	"github.com/dennigogo/zitadel/internal/domain"
+	"github.com/dennigogo/zitadel/internal/errors"
+	iam_model "github.com/dennigogo/zitadel/internal/iam/model"
+)
+
+const (
+	tmplLDAPLogin = "ldaplogin"
+
+	ldapTimeout = 10 * time.Second
+)
+
+type ldapLoginFormData struct {
+	IDPConfigID string `schema:"idpConfigID"`
+	Username    string `schema:"username"`
+	Password    string `schema:"password"`
+}
+
+type ldapLoginData struct {
+	baseData
+	IDPConfigID string
+	IDPName     string
+	Username    string
+}
+
+func (l *Login) renderLDAPLogin(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, idpConfig *iam_model.IDPConfigView, username string, err error) {
+	var errID, errMessage string
+	if err != nil {
+		errID, errMessage = l.getErrorMessage(r, err)
+	}
+	data := ldapLoginData{
+		baseData:    l.getBaseData(r, authReq, "LDAPLogin", errID, errMessage),
+		IDPConfigID: idpConfig.IDPConfigID,
+		IDPName:     idpConfig.Name,
+		Username:    username,
+	}
+	l.renderer.RenderTemplate(w, r, l.getTranslator(r.Context(), authReq), l.renderer.Templates[tmplLDAPLogin], data, nil)
+}
+
+func (l *Login) handleLDAPLogin(w http.ResponseWriter, r *http.Request) {
+	data := new(ldapLoginFormData)
+	authReq, err := l.getAuthRequestAndParseData(r, data)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	if authReq == nil {
+		l.defaultRedirect(w, r)
+		return
+	}
+	if authReq.SelectedIDPConfigID != data.IDPConfigID {
+		l.renderError(w, r, authReq, errors.ThrowInvalidArgument(nil, "LOGIN-Lq9s2", "Errors.User.ExternalIDP.NotAllowed"))
+		return
+	}
+	idpConfig, err := l.getIDPConfigByID(r, data.IDPConfigID)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	if !idpConfig.IsLDAP {
+		l.renderError(w, r, authReq, errors.ThrowPreconditionFailed(nil, "LOGIN-Lw3fn", "Errors.ExternalIDP.IDPTypeNotImplemented"))
+		return
+	}
+	bindPassword, err := crypto.DecryptString(idpConfig.LDAPBindPassword, l.idpConfigAlg)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	externalUser, err := ldapAuthenticate(idpConfig, bindPassword, data.Username, data.Password)
+	if err != nil {
+		l.renderLDAPLogin(w, r, authReq, idpConfig, data.Username, err)
+		return
+	}
+	externalUser, err = l.customExternalUserMapping(r.Context(), externalUser, nil, authReq, idpConfig)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	l.handleExternalUserLogin(w, r, authReq, idpConfig, authReq.AgentID, externalUser)
+}
+
+// ldapAuthenticate searches the user with the configured filter using the service account
+// and verifies the password by binding as the found entry
+func ldapAuthenticate(idpConfig *iam_model.IDPConfigView, bindPassword, username, password string) (*domain.ExternalUser, error) {
+	if username == "" || password == "" {
+		// an empty password would result in an unauthenticated bind which most servers accept
+		return nil, errors.ThrowInvalidArgument(nil, "LOGIN-Lm8dc", "Errors.User.ExternalIDP.LDAPCredentialsInvalid")
+	}
+	conn, err := ldapConnect(idpConfig)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	if idpConfig.LDAPBindDN != "" {
+		if err = conn.Bind(idpConfig.LDAPBindDN, bindPassword); err != nil {
+			return nil, errors.ThrowInternal(err, "LOGIN-Lb2vk", "Errors.User.ExternalIDP.LDAPBindFailed")
+		}
+	}
+	entry, err := ldapSearchUser(conn, idpConfig, username)
+	if err != nil {
+		return nil, err
+	}
+	if err = conn.Bind(entry.DN, password); err != nil {
+		if ldap.IsErrorWithCode(err, ldap.LDAPResultInvalidCredentials) {
+			return nil, errors.ThrowInvalidArgument(err, "LOGIN-Lc4ws", "Errors.User.ExternalIDP.LDAPCredentialsInvalid")
+		}
+		return nil, errors.ThrowInternal(err, "LOGIN-Lz7pe", "Errors.User.ExternalIDP.LDAPBindFailed")
+	}
+	return mapLDAPEntryToLoginUser(entry, idpConfig)
+}
+
+func ldapConnect(idpConfig *iam_model.IDPConfigView) (*ldap.Conn, error) {
+	conn, err := ldap.DialURL(idpConfig.LDAPURL, ldap.DialWithDialer(&net.Dialer{Timeout: ldapTimeout}))
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "LOGIN-Ld3ko", "Errors.User.ExternalIDP.LDAPConnectionFailed")
+	}
+	conn.SetTimeout(ldapTimeout)
+	if !idpConfig.LDAPStartTLS {
+		return conn, nil
+	}
+	ldapURL, err := url.Parse(idpConfig.LDAPURL)
+	if err != nil {
+		conn.Close()
+		return nil, errors.ThrowInternal(err, "LOGIN-Lx0gh", "Errors.User.ExternalIDP.LDAPConnectionFailed")
+	}
+	if err = conn.StartTLS(&tls.Config{ServerName: ldapURL.Hostname()}); err != nil {
+		conn.Close()
+		return nil, errors.ThrowInternal(err, "LOGIN-Lt5qa", "Errors.User.ExternalIDP.LDAPConnectionFailed")
+	}
+	return conn, nil
+}
+
+func ldapSearchUser(conn *ldap.Conn, idpConfig *iam_model.IDPConfigView, username string) (*ldap.Entry, error) {
+	filter := strings.ReplaceAll(idpConfig.LDAPUserFilter, domain.LDAPUsernamePlaceholder, ldap.EscapeFilter(username))
+	result, err := conn.Search(ldap.NewSearchRequest(
+		idpConfig.LDAPBaseDN,
+		ldap.ScopeWholeSubtree,
+		ldap.NeverDerefAliases,
+		2,
+		int(ldapTimeout.Seconds()),
+		false,
+		filter,
+		ldapSearchAttributes(idpConfig),
+		nil,
+	))
+	if err != nil {
+		if ldap.IsErrorWithCode(err, ldap.LDAPResultSizeLimitExceeded) {
+			return nil, errors.ThrowInvalidArgument(err, "LOGIN-Ls1mq", "Errors.User.ExternalIDP.LDAPCredentialsInvalid")
+		}
+		return nil, errors.ThrowInternal(err, "LOGIN-Lr6ny", "Errors.User.ExternalIDP.LDAPSearchFailed")
+	}
+	// the user must be unique, otherwise the bind could be done with the wrong entry
+	if len(result.Entries) != 1 {
+		return nil, errors.ThrowInvalidArgument(nil, "LOGIN-Le9ui", "Errors.User.ExternalIDP.LDAPCredentialsInvalid")
+	}
+	return result.Entries[0], nil
+}
+
+func ldapSearchAttributes(idpConfig *iam_model.IDPConfigView) []string {
+	attributes := make([]string, 0, 7)
+	for _, attribute := range []string{
+		idpConfig.LDAPIDAttribute,
+		idpConfig.LDAPUsernameAttribute,
+		idpConfig.LDAPFirstNameAttribute,
+		idpConfig.LDAPLastNameAttribute,
+		idpConfig.LDAPDisplayNameAttribute,
+		idpConfig.LDAPEmailAttribute,
+		idpConfig.LDAPPhoneAttribute,
+	} {
+		if attribute != "" {
+			attributes = append(attributes, attribute)
+		}
+	}
+	return attributes
+}
+
+// mapLDAPEntryToLoginUser requires the id attribute, an empty id would link all users without it to the same account
+func mapLDAPEntryToLoginUser(entry *ldap.Entry, idpConfig *iam_model.IDPConfigView) (*domain.ExternalUser, error) {
+	externalUser := &domain.ExternalUser{
+		IDPConfigID:       idpConfig.IDPConfigID,
+		ExternalUserID:    ldapAttributeValue(entry, idpConfig.LDAPIDAttribute),
+		PreferredUsername: ldapAttributeValue(entry, idpConfig.LDAPUsernameAttribute),
+		DisplayName:       ldapAttributeValue(entry, idpConfig.LDAPDisplayNameAttribute),
+		FirstName:         ldapAttributeValue(entry, idpConfig.LDAPFirstNameAttribute),
+		LastName:          ldapAttributeValue(entry, idpConfig.LDAPLastNameAttribute),
+		Email:             ldapAttributeValue(entry, idpConfig.LDAPEmailAttribute),
+		Phone:             ldapAttributeValue(entry, idpConfig.LDAPPhoneAttribute),
+	}
+	if externalUser.ExternalUserID == "" {
+		return nil, errors.ThrowPreconditionFailed(nil, "LOGIN-Lk5vb", "Errors.User.ExternalIDP.LDAPUserIDMissing")
+	}
+	if externalUser.DisplayName == "" {
+		externalUser.DisplayName = externalUser.PreferredUsername
+	}
+	return externalUser, nil
+}
+
+func ldapAttributeValue(entry *ldap.Entry, attribute string) string {
+	if attribute == "" {
+		return ""
+	}
+	return entry.GetAttributeValue(attribute)
+}
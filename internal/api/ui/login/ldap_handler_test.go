@@ -0,0 +1,408 @@
+package login
+
+import (
+	"io"
+	"net"
+	"strings"
+	"testing"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+	"github.com/go-ldap/ldap/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dennigogo/zitadel/internal/domain"
+	"github.com/dennigogo/zitadel/internal/errors"
+	iam_model "github.com/dennigogo/zitadel/internal/iam/model"
+)
+
+type testLDAPEntry struct {
+	dn         string
+	password   string
+	attributes map[string][]string
+}
+
+// startTestLDAPServer serves binds and searches of the entries on a local port,
+// it supports the equality, presence, and and or filters used by the user filters of the tests
+func startTestLDAPServer(t *testing.T, entries ...*testLDAPEntry) string {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go serveTestLDAPConn(conn, entries)
+		}
+	}()
+	return "ldap://" + listener.Addr().String()
+}
+
+func serveTestLDAPConn(conn net.Conn, entries []*testLDAPEntry) {
+	defer conn.Close()
+	for {
+		packet, err := ber.ReadPacket(conn)
+		if err != nil {
+			return
+		}
+		messageID := packet.Children[0].Value.(int64)
+		op := packet.Children[1]
+		switch op.Tag {
+		case ldap.ApplicationBindRequest:
+			code := int64(ldap.LDAPResultInvalidCredentials)
+			dn, password := op.Children[1].Data.String(), op.Children[2].Data.String()
+			for _, entry := range entries {
+				if entry.dn == dn && password != "" && entry.password == password {
+					code = ldap.LDAPResultSuccess
+				}
+			}
+			writeTestLDAPResponse(conn, messageID, testLDAPResult(ldap.ApplicationBindResponse, code))
+		case ldap.ApplicationSearchRequest:
+			sizeLimit := op.Children[3].Value.(int64)
+			code := int64(ldap.LDAPResultSuccess)
+			var found int64
+			for _, entry := range entries {
+				if !testLDAPFilterMatches(op.Children[6], entry) {
+					continue
+				}
+				if found++; found > sizeLimit {
+					code = ldap.LDAPResultSizeLimitExceeded
+					break
+				}
+				writeTestLDAPResponse(conn, messageID, testLDAPSearchEntry(entry, op.Children[7]))
+			}
+			writeTestLDAPResponse(conn, messageID, testLDAPResult(ldap.ApplicationSearchResultDone, code))
+		case ldap.ApplicationUnbindRequest:
+			return
+		}
+	}
+}
+
+func testLDAPFilterMatches(filter *ber.Packet, entry *testLDAPEntry) bool {
+	switch filter.Tag {
+	case ldap.FilterAnd:
+		for _, child := range filter.Children {
+			if !testLDAPFilterMatches(child, entry) {
+				return false
+			}
+		}
+		return true
+	case ldap.FilterOr:
+		for _, child := range filter.Children {
+			if testLDAPFilterMatches(child, entry) {
+				return true
+			}
+		}
+		return false
+	case ldap.FilterPresent:
+		for name := range entry.attributes {
+			if strings.EqualFold(name, filter.Data.String()) {
+				return true
+			}
+		}
+	case ldap.FilterEqualityMatch:
+		attribute, value := filter.Children[0].Data.String(), filter.Children[1].Data.String()
+		for name, values := range entry.attributes {
+			if !strings.EqualFold(name, attribute) {
+				continue
+			}
+			for _, v := range values {
+				if v == value {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func testLDAPResult(tag ber.Tag, code int64) *ber.Packet {
+	result := ber.Encode(ber.ClassApplication, ber.TypeConstructed, tag, nil, "Result")
+	result.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, code, "Result Code"))
+	result.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "Matched DN"))
+	result.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "Diagnostic Message"))
+	return result
+}
+
+func testLDAPSearchEntry(entry *testLDAPEntry, requested *ber.Packet) *ber.Packet {
+	result := ber.Encode(ber.ClassApplication, ber.TypeConstructed, ldap.ApplicationSearchResultEntry, nil, "Search Result Entry")
+	result.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, entry.dn, "DN"))
+	attributes := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Attributes")
+	for _, child := range requested.Children {
+		name := child.Data.String()
+		values, ok := entry.attributes[name]
+		if !ok {
+			continue
+		}
+		attribute := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Attribute")
+		attribute.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, name, "Type"))
+		set := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSet, nil, "Values")
+		for _, value := range values {
+			set.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, value, "Value"))
+		}
+		attribute.AppendChild(set)
+		attributes.AppendChild(attribute)
+	}
+	result.AppendChild(attributes)
+	return result
+}
+
+func writeTestLDAPResponse(w io.Writer, messageID int64, op *ber.Packet) {
+	envelope := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "LDAP Response")
+	envelope.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, messageID, "Message ID"))
+	envelope.AppendChild(op)
+	w.Write(envelope.Bytes())
+}
+
+func testLDAPUser(uid, id, password string) *testLDAPEntry {
+	return &testLDAPEntry{
+		dn:       "uid=" + uid + ",ou=people,dc=example,dc=com",
+		password: password,
+		attributes: map[string][]string{
+			"objectClass": {"person"},
+			"uid":         {uid},
+			"entryUUID":   {id},
+			"givenName":   {"Test"},
+			"sn":          {"User"},
+			"mail":        {uid + "@example.com"},
+		},
+	}
+}
+
+func testLDAPIDPConfig(url string) *iam_model.IDPConfigView {
+	return &iam_model.IDPConfigView{
+		IDPConfigID:            "idp1",
+		LDAPURL:                url,
+		LDAPBaseDN:             "dc=example,dc=com",
+		LDAPBindDN:             "cn=admin,dc=example,dc=com",
+		LDAPUserFilter:         "(&(objectClass=person)(uid=%s))",
+		LDAPIDAttribute:        "entryUUID",
+		LDAPUsernameAttribute:  "uid",
+		LDAPFirstNameAttribute: "givenName",
+		LDAPLastNameAttribute:  "sn",
+		LDAPEmailAttribute:     "mail",
+	}
+}
+
+func Test_ldapAuthenticate(t *testing.T) {
+	noID := testLDAPUser("noid", "", "password")
+	delete(noID.attributes, "entryUUID")
+	duplicate := testLDAPUser("duplicate", "id2", "password")
+	url := startTestLDAPServer(t,
+		&testLDAPEntry{dn: "cn=admin,dc=example,dc=com", password: "admin"},
+		testLDAPUser("user", "id1", "password"),
+		duplicate,
+		&testLDAPEntry{dn: "uid=duplicate,ou=other,dc=example,dc=com", password: "password", attributes: duplicate.attributes},
+		noID,
+		testLDAPUser("*", "id3", "password"),
+		testLDAPUser("many", "id4", "password"),
+		testLDAPUser("many", "id5", "password"),
+		testLDAPUser("many", "id6", "password"),
+	)
+	type args struct {
+		url          string
+		bindPassword string
+		username     string
+		password     string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *domain.ExternalUser
+		wantErr func(error) bool
+	}{
+		{
+			name: "empty password, invalid argument error",
+			args: args{
+				url:          url,
+				bindPassword: "admin",
+				username:     "user",
+			},
+			wantErr: errors.IsErrorInvalidArgument,
+		},
+		{
+			name: "server unavailable, internal error",
+			args: args{
+				url:          "ldap://127.0.0.1:1",
+				bindPassword: "admin",
+				username:     "user",
+				password:     "password",
+			},
+			wantErr: errors.IsInternal,
+		},
+		{
+			name: "service bind failed, internal error",
+			args: args{
+				url:          url,
+				bindPassword: "wrong",
+				username:     "user",
+				password:     "password",
+			},
+			wantErr: errors.IsInternal,
+		},
+		{
+			name: "unknown user, invalid argument error",
+			args: args{
+				url:          url,
+				bindPassword: "admin",
+				username:     "unknown",
+				password:     "password",
+			},
+			wantErr: errors.IsErrorInvalidArgument,
+		},
+		{
+			name: "ambiguous user, invalid argument error",
+			args: args{
+				url:          url,
+				bindPassword: "admin",
+				username:     "duplicate",
+				password:     "password",
+			},
+			wantErr: errors.IsErrorInvalidArgument,
+		},
+		{
+			name: "size limit exceeded, invalid argument error",
+			args: args{
+				url:          url,
+				bindPassword: "admin",
+				username:     "many",
+				password:     "password",
+			},
+			wantErr: errors.IsErrorInvalidArgument,
+		},
+		{
+			name: "wrong password, invalid argument error",
+			args: args{
+				url:          url,
+				bindPassword: "admin",
+				username:     "user",
+				password:     "wrong",
+			},
+			wantErr: errors.IsErrorInvalidArgument,
+		},
+		{
+			name: "id attribute missing, precondition error",
+			args: args{
+				url:          url,
+				bindPassword: "admin",
+				username:     "noid",
+				password:     "password",
+			},
+			wantErr: errors.IsPreconditionFailed,
+		},
+		{
+			name: "authenticated, ok",
+			args: args{
+				url:          url,
+				bindPassword: "admin",
+				username:     "user",
+				password:     "password",
+			},
+			want: &domain.ExternalUser{
+				IDPConfigID:       "idp1",
+				ExternalUserID:    "id1",
+				PreferredUsername: "user",
+				DisplayName:       "user",
+				FirstName:         "Test",
+				LastName:          "User",
+				Email:             "user@example.com",
+			},
+		},
+		{
+			name: "username escaped in filter, ok",
+			args: args{
+				url:          url,
+				bindPassword: "admin",
+				username:     "*",
+				password:     "password",
+			},
+			want: &domain.ExternalUser{
+				IDPConfigID:       "idp1",
+				ExternalUserID:    "id3",
+				PreferredUsername: "*",
+				DisplayName:       "*",
+				FirstName:         "Test",
+				LastName:          "User",
+				Email:             "*@example.com",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ldapAuthenticate(testLDAPIDPConfig(tt.args.url), tt.args.bindPassword, tt.args.username, tt.args.password)
+			if tt.wantErr != nil {
+				assert.True(t, tt.wantErr(err), "unexpected error: %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_mapLDAPEntryToLoginUser(t *testing.T) {
+	idpConfig := &iam_model.IDPConfigView{
+		IDPConfigID:              "idp1",
+		LDAPIDAttribute:          "entryUUID",
+		LDAPUsernameAttribute:    "uid",
+		LDAPDisplayNameAttribute: "displayName",
+		LDAPPhoneAttribute:       "telephoneNumber",
+	}
+	tests := []struct {
+		name       string
+		attributes map[string][]string
+		want       *domain.ExternalUser
+		wantErr    func(error) bool
+	}{
+		{
+			name: "id missing, precondition error",
+			attributes: map[string][]string{
+				"uid": {"user"},
+			},
+			wantErr: errors.IsPreconditionFailed,
+		},
+		{
+			name: "display name missing, username used",
+			attributes: map[string][]string{
+				"entryUUID": {"id1"},
+				"uid":       {"user"},
+			},
+			want: &domain.ExternalUser{
+				IDPConfigID:       "idp1",
+				ExternalUserID:    "id1",
+				PreferredUsername: "user",
+				DisplayName:       "user",
+			},
+		},
+		{
+			name: "all attributes, ok",
+			attributes: map[string][]string{
+				"entryUUID":       {"id1"},
+				"uid":             {"user"},
+				"displayName":     {"Test User"},
+				"telephoneNumber": {"+41 71 000 00 00"},
+			},
+			want: &domain.ExternalUser{
+				IDPConfigID:       "idp1",
+				ExternalUserID:    "id1",
+				PreferredUsername: "user",
+				DisplayName:       "Test User",
+				Phone:             "+41 71 000 00 00",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := mapLDAPEntryToLoginUser(ldap.NewEntry("uid=user,dc=example,dc=com", tt.attributes), idpConfig)
+			if tt.wantErr != nil {
+				assert.True(t, tt.wantErr(err), "unexpected error: %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -38,8 +38,10 @@ type Login struct {
 	consolePath         string
 	oidcAuthCallbackURL func(context.Context, string) string
 	samlAuthCallbackURL func(context.Context, string) string
-	idpConfigAlg        crypto.EncryptionAlgorithm
-	userCodeAlg         crypto.EncryptionAlgorithm
+	// authMethodsReferences returns the amr claim of the login, which is stored on a device authorization
+	authMethodsReferences func(*domain.AuthRequest) []string
+	idpConfigAlg          crypto.EncryptionAlgorithm
+	userCodeAlg           crypto.EncryptionAlgorithm
 }
 
 type Config struct {
@@ -63,6 +65,7 @@ func CreateLogin(config Config,
 	consolePath string,
 	oidcAuthCallbackURL func(context.Context, string) string,
 	samlAuthCallbackURL func(context.Context, string) string,
+	authMethodsReferences func(*domain.AuthRequest) []string,
 	externalSecure bool,
 	userAgentCookie,
 	issuerInterceptor,
@@ -75,16 +78,17 @@ func CreateLogin(config Config,
 ) (*Login, error) {
 
 	login := &Login{
-		oidcAuthCallbackURL: oidcAuthCallbackURL,
-		samlAuthCallbackURL: samlAuthCallbackURL,
-		externalSecure:      externalSecure,
-		consolePath:         consolePath,
-		command:             command,
-		query:               query,
-		staticStorage:       staticStorage,
-		authRepo:            authRepo,
-		idpConfigAlg:        idpConfigAlg,
-		userCodeAlg:         userCodeAlg,
+		oidcAuthCallbackURL:   oidcAuthCallbackURL,
+		samlAuthCallbackURL:   samlAuthCallbackURL,
+		authMethodsReferences: authMethodsReferences,
+		externalSecure:        externalSecure,
+		consolePath:           consolePath,
+		command:               command,
+		query:                 query,
+		staticStorage:         staticStorage,
+		authRepo:              authRepo,
+		idpConfigAlg:          idpConfigAlg,
+		userCodeAlg:           userCodeAlg,
 	}
 	statikFS, err := fs.NewWithNamespace("login")
 	if err != nil {
@@ -117,6 +121,10 @@ func createCSRFInterceptor(cookieName string, csrfCookieKey []byte, externalSecu
 				handler.ServeHTTP(w, r)
 				return
 			}
+			// the SAML response is posted by the identity provider and therefore can't contain a csrf token
+			if strings.HasSuffix(r.URL.Path, EndpointSAMLACS) {
+				r = csrf.UnsafeSkipCheck(r)
+			}
 			csrf.Protect(csrfCookieKey,
 				csrf.Secure(externalSecure),
 				csrf.CookieName(http_utils.SetCookiePrefix(cookieName, "", path, externalSecure)),
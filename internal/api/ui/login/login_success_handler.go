@@ -56,11 +56,15 @@ func (l *Login) renderSuccessAndCallback(w http.ResponseWriter, r *http.Request,
 
 func (l *Login) redirectToCallback(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest) {
 	var callback string
-	switch authReq.Request.(type) {
+	switch request := authReq.Request.(type) {
 	case *domain.AuthRequestOIDC:
 		callback = l.oidcAuthCallbackURL(r.Context(), authReq.ID)
 	case *domain.AuthRequestSAML:
 		callback = l.samlAuthCallbackURL(r.Context(), authReq.ID)
+	case *domain.AuthRequestDevice:
+		// the device receives the tokens by polling, so the user only has to allow it
+		l.renderDeviceAuthAction(w, r, authReq, request)
+		return
 	default:
 		l.renderInternalError(w, r, authReq, caos_errs.ThrowInternal(nil, "LOGIN-rhjQF", "Errors.AuthRequest.RequestTypeNotSupported"))
 		return
@@ -3,7 +3,10 @@ package login
 import (
 	"net/http"
 
+	"github.com/zitadel/logging"
+
 	"github.com/dennigogo/zitadel/internal/domain"
+	caos_errs "github.com/dennigogo/zitadel/internal/errors"
 )
 
 const (
@@ -17,6 +20,17 @@ func (l *Login) renderMFAInitDone(w http.ResponseWriter, r *http.Request, authRe
 	var errType, errMessage string
 	data.baseData = l.getBaseData(r, authReq, "MFA Init Done", errType, errMessage)
 	data.profileData = l.getProfileData(authReq)
+	data.RecoveryCodes = l.initRecoveryCodes(r, authReq)
 	translator := l.getTranslator(r.Context(), authReq)
 	l.renderer.RenderTemplate(w, r, translator, l.renderer.Templates[tmplMFAInitDone], data, nil)
 }
+
+// initRecoveryCodes creates the recovery codes after the first mfa was set up,
+// they are only returned (and shown) once
+func (l *Login) initRecoveryCodes(r *http.Request, authReq *domain.AuthRequest) []string {
+	codes, _, err := l.command.AddHumanRecoveryCodes(setContext(r.Context(), authReq.UserOrgID), authReq.UserID, authReq.UserOrgID)
+	if err != nil && !caos_errs.IsErrorAlreadyExists(err) {
+		logging.WithError(err).WithField("userID", authReq.UserID).Warn("unable to create recovery codes")
+	}
+	return codes
+}
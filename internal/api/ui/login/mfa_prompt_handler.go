@@ -86,6 +86,12 @@ func (l *Login) handleMFACreation(w http.ResponseWriter, r *http.Request, authRe
 	case domain.MFATypeU2F:
 		l.renderRegisterU2F(w, r, authReq, nil)
 		return
+	case domain.MFATypeOTPSMS:
+		l.handleOTPSMSCreation(w, r, authReq, data)
+		return
+	case domain.MFATypeOTPEmail:
+		l.handleOTPEmailCreation(w, r, authReq, data)
+		return
 	}
 	l.renderError(w, r, authReq, caos_errs.ThrowPreconditionFailed(nil, "APP-Or3HO", "Errors.User.MFA.NoProviders"))
 }
@@ -103,3 +109,21 @@ func (l *Login) handleOTPCreation(w http.ResponseWriter, r *http.Request, authRe
 	}
 	l.renderMFAInitVerify(w, r, authReq, data, nil)
 }
+
+func (l *Login) handleOTPSMSCreation(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, data *mfaVerifyData) {
+	_, err := l.command.AddHumanOTPSMS(setContext(r.Context(), authReq.UserOrgID), authReq.UserID, authReq.UserOrgID)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	l.renderMFAInitDone(w, r, authReq, &mfaDoneData{MFAType: data.MFAType})
+}
+
+func (l *Login) handleOTPEmailCreation(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, data *mfaVerifyData) {
+	_, err := l.command.AddHumanOTPEmail(setContext(r.Context(), authReq.UserOrgID), authReq.UserID, authReq.UserOrgID)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	l.renderMFAInitDone(w, r, authReq, &mfaDoneData{MFAType: data.MFAType})
+}
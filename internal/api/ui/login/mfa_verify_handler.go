@@ -3,8 +3,11 @@ package login
 import (
 	"net/http"
 
+	"github.com/zitadel/logging"
+
 	http_mw "github.com/dennigogo/zitadel/internal/api/http/middleware"
 	"github.com/dennigogo/zitadel/internal/domain"
+	caos_errs "github.com/dennigogo/zitadel/internal/errors"
 )
 
 const (
@@ -15,6 +18,8 @@ type mfaVerifyFormData struct {
 	MFAType          domain.MFAType `schema:"mfaType"`
 	Code             string         `schema:"code"`
 	SelectedProvider domain.MFAType `schema:"provider"`
+	Resend           bool           `schema:"resend"`
+	TrustDevice      bool           `schema:"trustDevice"`
 }
 
 func (l *Login) handleMFAVerify(w http.ResponseWriter, r *http.Request) {
@@ -29,21 +34,57 @@ func (l *Login) handleMFAVerify(w http.ResponseWriter, r *http.Request) {
 		l.renderError(w, r, authReq, err)
 		return
 	}
+	if data.Resend {
+		l.renderMFAVerifySelected(w, r, authReq, step, data.MFAType, nil)
+		return
+	}
 	if data.Code == "" {
 		l.renderMFAVerifySelected(w, r, authReq, step, data.SelectedProvider, nil)
 		return
 	}
-	if data.MFAType == domain.MFATypeOTP {
-		userAgentID, _ := http_mw.UserAgentIDFromCtx(r.Context())
-		err = l.authRepo.VerifyMFAOTP(setContext(r.Context(), authReq.UserOrgID), authReq.ID, authReq.UserID, authReq.UserOrgID, data.Code, userAgentID, domain.BrowserInfoFromRequest(r))
-		if err != nil {
-			l.renderMFAVerifySelected(w, r, authReq, step, domain.MFATypeOTP, err)
-			return
-		}
+	userAgentID, _ := http_mw.UserAgentIDFromCtx(r.Context())
+	if err = l.verifyMFA(r, authReq, step.MFAProviders, data.MFAType, data.Code, userAgentID); err != nil {
+		l.renderMFAVerifySelected(w, r, authReq, step, data.MFAType, err)
+		return
+	}
+	// the second factor is verified at this point, unknown types are rejected by verifyMFA
+	if data.TrustDevice {
+		l.trustDevice(r, authReq, userAgentID)
 	}
 	l.renderNextStep(w, r, authReq)
 }
 
+// verifyMFA checks the code of the second factor,
+// types which are not offered by the step, without a code (e.g. U2F) or unknown types are rejected
+func (l *Login) verifyMFA(r *http.Request, authReq *domain.AuthRequest, providers []domain.MFAType, mfaType domain.MFAType, code, userAgentID string) error {
+	if !containsMFAType(providers, mfaType) {
+		return caos_errs.ThrowInvalidArgument(nil, "LOGIN-Mf7u3", "Errors.User.MFA.TypeNotSupported")
+	}
+	ctx := setContext(r.Context(), authReq.UserOrgID)
+	switch mfaType {
+	case domain.MFATypeOTP:
+		return l.authRepo.VerifyMFAOTP(ctx, authReq.ID, authReq.UserID, authReq.UserOrgID, code, userAgentID, domain.BrowserInfoFromRequest(r))
+	case domain.MFATypeOTPSMS:
+		return l.authRepo.VerifyMFAOTPSMS(ctx, authReq.ID, authReq.UserID, authReq.UserOrgID, code, userAgentID, domain.BrowserInfoFromRequest(r))
+	case domain.MFATypeOTPEmail:
+		return l.authRepo.VerifyMFAOTPEmail(ctx, authReq.ID, authReq.UserID, authReq.UserOrgID, code, userAgentID, domain.BrowserInfoFromRequest(r))
+	case domain.MFATypeRecoveryCode:
+		return l.authRepo.VerifyMFARecoveryCode(ctx, authReq.ID, authReq.UserID, authReq.UserOrgID, code, userAgentID, domain.BrowserInfoFromRequest(r))
+	default:
+		return caos_errs.ThrowInvalidArgument(nil, "LOGIN-Mf4t2", "Errors.User.MFA.TypeNotSupported")
+	}
+}
+
+// trustDevice remembers the user agent after a successful mfa verification,
+// a failure must not prevent the login as the mfa is already verified
+func (l *Login) trustDevice(r *http.Request, authReq *domain.AuthRequest, userAgentID string) {
+	if authReq.LoginPolicy == nil || authReq.LoginPolicy.TrustedDeviceLifetime <= 0 {
+		return
+	}
+	err := l.command.HumanTrustMFADevice(setContext(r.Context(), authReq.UserOrgID), authReq.UserID, authReq.UserOrgID, userAgentID, authReq.ID, r.UserAgent())
+	logging.WithFields("userID", authReq.UserID).OnError(err).Warn("unable to trust device")
+}
+
 func (l *Login) renderMFAVerify(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, verificationStep *domain.MFAVerificationStep, err error) {
 	if verificationStep == nil {
 		l.renderError(w, r, authReq, err)
@@ -54,6 +95,10 @@ func (l *Login) renderMFAVerify(w http.ResponseWriter, r *http.Request, authReq
 }
 
 func (l *Login) renderMFAVerifySelected(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, verificationStep *domain.MFAVerificationStep, selectedProvider domain.MFAType, err error) {
+	if err == nil && verificationStep != nil {
+		// the code is only sent on selection (or resend), not after a failed check
+		err = l.sendMFAOTPCode(r, authReq, selectedProvider)
+	}
 	var errID, errMessage string
 	if err != nil {
 		errID, errMessage = l.getErrorMessage(r, err)
@@ -70,6 +115,9 @@ func (l *Login) renderMFAVerifySelected(w http.ResponseWriter, r *http.Request,
 	case domain.MFATypeOTP:
 		data.MFAProviders = removeSelectedProviderFromList(verificationStep.MFAProviders, domain.MFATypeOTP)
 		data.SelectedMFAProvider = domain.MFATypeOTP
+	case domain.MFATypeOTPSMS, domain.MFATypeOTPEmail, domain.MFATypeRecoveryCode:
+		data.MFAProviders = removeSelectedProviderFromList(verificationStep.MFAProviders, selectedProvider)
+		data.SelectedMFAProvider = selectedProvider
 	default:
 		l.renderError(w, r, authReq, err)
 		return
@@ -77,6 +125,27 @@ func (l *Login) renderMFAVerifySelected(w http.ResponseWriter, r *http.Request,
 	l.renderer.RenderTemplate(w, r, l.getTranslator(r.Context(), authReq), l.renderer.Templates[tmplMFAVerify], data, nil)
 }
 
+func (l *Login) sendMFAOTPCode(r *http.Request, authReq *domain.AuthRequest, provider domain.MFAType) error {
+	userAgentID, _ := http_mw.UserAgentIDFromCtx(r.Context())
+	switch provider {
+	case domain.MFATypeOTPSMS:
+		return l.authRepo.SendMFAOTPSMS(setContext(r.Context(), authReq.UserOrgID), authReq.ID, authReq.UserID, authReq.UserOrgID, userAgentID)
+	case domain.MFATypeOTPEmail:
+		return l.authRepo.SendMFAOTPEmail(setContext(r.Context(), authReq.UserOrgID), authReq.ID, authReq.UserID, authReq.UserOrgID, userAgentID)
+	default:
+		return nil
+	}
+}
+
+func containsMFAType(providers []domain.MFAType, mfaType domain.MFAType) bool {
+	for _, provider := range providers {
+		if provider == mfaType {
+			return true
+		}
+	}
+	return false
+}
+
 func removeSelectedProviderFromList(providers []domain.MFAType, selected domain.MFAType) []domain.MFAType {
 	for i := len(providers) - 1; i >= 0; i-- {
 		if providers[i] == selected {
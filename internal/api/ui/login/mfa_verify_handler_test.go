@@ -0,0 +1,138 @@
+package login
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	auth_repository "github.com/dennigogo/zitadel/internal/auth/repository"
+	"github.com/dennigogo/zitadel/internal/command"
+	"github.com/dennigogo/zitadel/internal/domain"
+	caos_errs "github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/form"
+)
+
+// testMFAAuthRepo returns an auth request waiting for the verification of the second factor,
+// only the otp code "123456" is valid
+type testMFAAuthRepo struct {
+	auth_repository.Repository
+
+	authRequestCalls int
+	verifyCalls      int
+}
+
+func (r *testMFAAuthRepo) AuthRequestByID(_ context.Context, id, userAgentID string) (*domain.AuthRequest, error) {
+	r.authRequestCalls++
+	return &domain.AuthRequest{
+		ID:        id,
+		AgentID:   userAgentID,
+		UserID:    "user1",
+		UserOrgID: "org1",
+		// the primary domain is only queried if it's not requested
+		RequestedPrimaryDomain: "example.com",
+		PossibleSteps: []domain.NextStep{
+			&domain.MFAVerificationStep{MFAProviders: []domain.MFAType{domain.MFATypeRecoveryCode, domain.MFATypeOTP, domain.MFATypeU2F}},
+		},
+		LoginPolicy: &domain.LoginPolicy{TrustedDeviceLifetime: time.Hour},
+	}, nil
+}
+
+func (r *testMFAAuthRepo) BeginMFAU2FLogin(context.Context, string, string, string, string) (*domain.WebAuthNLogin, error) {
+	return &domain.WebAuthNLogin{}, nil
+}
+
+func (r *testMFAAuthRepo) VerifyMFAOTP(_ context.Context, _, _, _, code, _ string, _ *domain.BrowserInfo) error {
+	r.verifyCalls++
+	if code != "123456" {
+		return caos_errs.ThrowInvalidArgument(nil, "TEST-Mf2c8", "Errors.User.Code.Invalid")
+	}
+	return nil
+}
+
+func (r *testMFAAuthRepo) VerifyMFAOTPSMS(context.Context, string, string, string, string, string, *domain.BrowserInfo) error {
+	r.verifyCalls++
+	return nil
+}
+
+func TestLogin_handleMFAVerify(t *testing.T) {
+	type res struct {
+		verifyCalls int
+		nextStep    bool
+	}
+	tests := []struct {
+		name        string
+		mfaType     domain.MFAType
+		code        string
+		trustDevice bool
+		res         res
+	}{
+		{
+			name:        "unknown type, rejected",
+			mfaType:     domain.MFAType(99),
+			code:        "123456",
+			trustDevice: true,
+			res:         res{},
+		},
+		{
+			name:        "u2f without credential, rejected",
+			mfaType:     domain.MFATypeU2F,
+			code:        "123456",
+			trustDevice: true,
+			res:         res{},
+		},
+		{
+			name:        "type not offered, rejected",
+			mfaType:     domain.MFATypeOTPSMS,
+			code:        "123456",
+			trustDevice: true,
+			res:         res{},
+		},
+		{
+			name:        "invalid code, rejected",
+			mfaType:     domain.MFATypeOTP,
+			code:        "000000",
+			trustDevice: true,
+			res:         res{verifyCalls: 1},
+		},
+		{
+			name:    "valid code, next step",
+			mfaType: domain.MFATypeOTP,
+			code:    "123456",
+			res:     res{verifyCalls: 1, nextStep: true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			authRepo := new(testMFAAuthRepo)
+			l := &Login{
+				// the commands have no eventstore, so trusting the device would fail the test
+				command:  new(command.Commands),
+				authRepo: authRepo,
+				parser:   form.NewParser(),
+				renderer: CreateRenderer(HandlerPrefix, http.Dir("static"), nil, "language"),
+			}
+			body := url.Values{
+				QueryAuthRequestID: {"authRequest1"},
+				"mfaType":          {strconv.Itoa(int(tt.mfaType))},
+				"code":             {tt.code},
+				"trustDevice":      {strconv.FormatBool(tt.trustDevice)},
+			}
+			req := httptest.NewRequest(http.MethodPost, EndpointMFAVerify, strings.NewReader(body.Encode()))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			resp := httptest.NewRecorder()
+
+			l.handleMFAVerify(resp, req)
+
+			assert.Equal(t, tt.res.verifyCalls, authRepo.verifyCalls)
+			// the next step loads the auth request again
+			assert.Equal(t, tt.res.nextStep, authRepo.authRequestCalls > 1)
+		})
+	}
+}
@@ -22,6 +22,7 @@ type mfaU2FData struct {
 type mfaU2FFormData struct {
 	webAuthNFormData
 	SelectedProvider domain.MFAType `schema:"provider"`
+	TrustDevice      bool           `schema:"trustDevice"`
 }
 
 func (l *Login) renderU2FVerification(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, providers []domain.MFAType, err error) {
@@ -75,5 +76,8 @@ func (l *Login) handleU2FVerification(w http.ResponseWriter, r *http.Request) {
 		l.renderU2FVerification(w, r, authReq, step.MFAProviders, err)
 		return
 	}
+	if formData.TrustDevice {
+		l.trustDevice(r, authReq, userAgentID)
+	}
 	l.renderNextStep(w, r, authReq)
 }
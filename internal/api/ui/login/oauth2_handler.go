@@ -0,0 +1,141 @@
+package login
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/zitadel/oidc/v2/pkg/client/rp"
+	"github.com/zitadel/oidc/v2/pkg/oidc"
+
+	"github.com/dennigogo/zitadel/internal/domain"
+	"github.com/dennigogo/zitadel/internal/errors"
+	iam_model "github.com/dennigogo/zitadel/internal/iam/model"
+)
+
+const (
+	oauth2PathSeparator = "."
+	// oauth2UserInfoMaxSize limits the size of the userinfo response read from the provider
+	oauth2UserInfoMaxSize = 1 << 20
+)
+
+func (l *Login) handleOAuth2Authorize(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, idpConfig *iam_model.IDPConfigView) {
+	provider, err := l.getRPConfig(r.Context(), idpConfig, EndpointExternalLoginCallback)
+	if err != nil {
+		l.renderLogin(w, r, authReq, err)
+		return
+	}
+	http.Redirect(w, r, rp.AuthURL(authReq.ID, provider), http.StatusFound)
+}
+
+func (l *Login) handleOAuth2Callback(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, idpConfig *iam_model.IDPConfigView, userAgentID, code string) {
+	provider, err := l.getRPConfig(r.Context(), idpConfig, EndpointExternalLoginCallback)
+	if err != nil {
+		l.renderLogin(w, r, authReq, err)
+		return
+	}
+	tokens, err := rp.CodeExchange(r.Context(), code, provider)
+	if err != nil {
+		l.renderLogin(w, r, authReq, err)
+		return
+	}
+	userInfo, err := oauth2UserInfo(r.Context(), provider.HttpClient(), idpConfig.OAuth2UserEndpoint, tokens)
+	if err != nil {
+		l.renderLogin(w, r, authReq, err)
+		return
+	}
+	externalUser := mapOAuth2UserInfoToLoginUser(userInfo, idpConfig)
+	if externalUser.ExternalUserID == "" {
+		l.renderLogin(w, r, authReq, errors.ThrowPreconditionFailed(nil, "LOGIN-Oq8wd", "Errors.User.ExternalIDP.OAuth2UserIDMissing"))
+		return
+	}
+	externalUser, err = l.customExternalUserMapping(r.Context(), externalUser, tokens, authReq, idpConfig)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	l.handleExternalUserLogin(w, r, authReq, idpConfig, userAgentID, externalUser)
+}
+
+// oauth2UserInfo calls the user endpoint of the provider with the received access token
+func oauth2UserInfo(ctx context.Context, client *http.Client, userEndpoint string, tokens *oidc.Tokens) (map[string]interface{}, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, userEndpoint, nil)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "LOGIN-Ow2nc", "Errors.User.ExternalIDP.OAuth2UserInfoFailed")
+	}
+	req.Header.Set("Accept", "application/json")
+	tokens.SetAuthHeader(req)
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "LOGIN-Ok5ru", "Errors.User.ExternalIDP.OAuth2UserInfoFailed")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, errors.ThrowPreconditionFailed(nil, "LOGIN-Oz3hy", "Errors.User.ExternalIDP.OAuth2UserInfoFailed")
+	}
+	userInfo := make(map[string]interface{})
+	decoder := json.NewDecoder(io.LimitReader(resp.Body, oauth2UserInfoMaxSize))
+	// numbers are kept as they are, so large ids do not lose precision
+	decoder.UseNumber()
+	if err = decoder.Decode(&userInfo); err != nil {
+		return nil, errors.ThrowInternal(err, "LOGIN-Od6ve", "Errors.User.ExternalIDP.OAuth2UserInfoFailed")
+	}
+	return userInfo, nil
+}
+
+func mapOAuth2UserInfoToLoginUser(userInfo map[string]interface{}, idpConfig *iam_model.IDPConfigView) *domain.ExternalUser {
+	externalUser := &domain.ExternalUser{
+		IDPConfigID:       idpConfig.IDPConfigID,
+		ExternalUserID:    oauth2UserInfoValue(userInfo, idpConfig.OAuth2IDPath),
+		PreferredUsername: oauth2UserInfoValue(userInfo, idpConfig.OAuth2UsernamePath),
+		DisplayName:       oauth2UserInfoValue(userInfo, idpConfig.OAuth2DisplayNamePath),
+		FirstName:         oauth2UserInfoValue(userInfo, idpConfig.OAuth2FirstNamePath),
+		LastName:          oauth2UserInfoValue(userInfo, idpConfig.OAuth2LastNamePath),
+		Email:             oauth2UserInfoValue(userInfo, idpConfig.OAuth2EmailPath),
+		Phone:             oauth2UserInfoValue(userInfo, idpConfig.OAuth2PhonePath),
+	}
+	if externalUser.DisplayName == "" {
+		externalUser.DisplayName = externalUser.PreferredUsername
+	}
+	if externalUser.DisplayName == "" {
+		externalUser.DisplayName = externalUser.Email
+	}
+	return externalUser
+}
+
+// oauth2UserInfoValue resolves a path like `emails.0.value` in the userinfo response
+// objects are accessed by key and arrays by index
+// it returns an empty string if the path cannot be resolved or doesn't point to a scalar value
+func oauth2UserInfoValue(userInfo map[string]interface{}, path string) string {
+	if path == "" {
+		return ""
+	}
+	var value interface{} = userInfo
+	for _, segment := range strings.Split(path, oauth2PathSeparator) {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			value = v[segment]
+		case []interface{}:
+			index, err := strconv.Atoi(segment)
+			if err != nil || index < 0 || index >= len(v) {
+				return ""
+			}
+			value = v[index]
+		default:
+			return ""
+		}
+	}
+	switch v := value.(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,190 @@
+package login
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zitadel/oidc/v2/pkg/oidc"
+	"golang.org/x/oauth2"
+
+	"github.com/dennigogo/zitadel/internal/domain"
+	"github.com/dennigogo/zitadel/internal/errors"
+	iam_model "github.com/dennigogo/zitadel/internal/iam/model"
+)
+
+func testOAuth2UserInfo(t *testing.T, body string) map[string]interface{} {
+	t.Helper()
+	userInfo := make(map[string]interface{})
+	decoder := json.NewDecoder(strings.NewReader(body))
+	decoder.UseNumber()
+	require.NoError(t, decoder.Decode(&userInfo))
+	return userInfo
+}
+
+func Test_oauth2UserInfoValue(t *testing.T) {
+	userInfo := `{
+		"id": 12345678901234567890,
+		"login": "gigi",
+		"verified": true,
+		"name": null,
+		"profile": {"first_name": "Gigi", "address": {"city": "Zurich"}},
+		"emails": [{"value": "gigi@example.com"}, {"value": "giraffe@example.com"}],
+		"tags": ["a", "b"]
+	}`
+	tests := []struct {
+		name string
+		path string
+		want string
+	}{
+		{name: "empty path", path: "", want: ""},
+		{name: "string", path: "login", want: "gigi"},
+		{name: "large number keeps precision", path: "id", want: "12345678901234567890"},
+		{name: "bool", path: "verified", want: "true"},
+		{name: "null", path: "name", want: ""},
+		{name: "missing key", path: "unknown", want: ""},
+		{name: "nested object", path: "profile.first_name", want: "Gigi"},
+		{name: "deeply nested object", path: "profile.address.city", want: "Zurich"},
+		{name: "object not scalar", path: "profile", want: ""},
+		{name: "array index", path: "emails.1.value", want: "giraffe@example.com"},
+		{name: "array of scalars", path: "tags.0", want: "a"},
+		{name: "array not scalar", path: "tags", want: ""},
+		{name: "array index out of range", path: "emails.2.value", want: ""},
+		{name: "array negative index", path: "emails.-1.value", want: ""},
+		{name: "array key instead of index", path: "emails.first.value", want: ""},
+		{name: "path below scalar", path: "login.value", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, oauth2UserInfoValue(testOAuth2UserInfo(t, userInfo), tt.path))
+		})
+	}
+}
+
+func Test_mapOAuth2UserInfoToLoginUser(t *testing.T) {
+	idpConfig := &iam_model.IDPConfigView{
+		IDPConfigID:           "idp1",
+		OAuth2IDPath:          "id",
+		OAuth2UsernamePath:    "login",
+		OAuth2DisplayNamePath: "name",
+		OAuth2FirstNamePath:   "profile.first_name",
+		OAuth2LastNamePath:    "profile.last_name",
+		OAuth2EmailPath:       "emails.0.value",
+		OAuth2PhonePath:       "phone",
+	}
+	tests := []struct {
+		name     string
+		userInfo string
+		want     *domain.ExternalUser
+	}{
+		{
+			name:     "all mapped",
+			userInfo: `{"id": 1, "login": "gigi", "name": "Gigi Giraffe", "profile": {"first_name": "Gigi", "last_name": "Giraffe"}, "emails": [{"value": "gigi@example.com"}], "phone": "+41791234567"}`,
+			want: &domain.ExternalUser{
+				IDPConfigID:       "idp1",
+				ExternalUserID:    "1",
+				PreferredUsername: "gigi",
+				DisplayName:       "Gigi Giraffe",
+				FirstName:         "Gigi",
+				LastName:          "Giraffe",
+				Email:             "gigi@example.com",
+				Phone:             "+41791234567",
+			},
+		},
+		{
+			name:     "display name from username",
+			userInfo: `{"id": "user1", "login": "gigi", "emails": [{"value": "gigi@example.com"}]}`,
+			want: &domain.ExternalUser{
+				IDPConfigID:       "idp1",
+				ExternalUserID:    "user1",
+				PreferredUsername: "gigi",
+				DisplayName:       "gigi",
+				Email:             "gigi@example.com",
+			},
+		},
+		{
+			name:     "display name from email",
+			userInfo: `{"id": "user1", "emails": [{"value": "gigi@example.com"}]}`,
+			want: &domain.ExternalUser{
+				IDPConfigID:    "idp1",
+				ExternalUserID: "user1",
+				DisplayName:    "gigi@example.com",
+				Email:          "gigi@example.com",
+			},
+		},
+		{
+			name:     "id missing",
+			userInfo: `{"login": "gigi"}`,
+			want: &domain.ExternalUser{
+				IDPConfigID:       "idp1",
+				PreferredUsername: "gigi",
+				DisplayName:       "gigi",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, mapOAuth2UserInfoToLoginUser(testOAuth2UserInfo(t, tt.userInfo), idpConfig))
+		})
+	}
+}
+
+func Test_oauth2UserInfo(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		want    map[string]interface{}
+		wantErr func(error) bool
+	}{
+		{
+			name:    "error status, precondition error",
+			status:  http.StatusUnauthorized,
+			body:    `{"error": "invalid_token"}`,
+			wantErr: errors.IsPreconditionFailed,
+		},
+		{
+			name:    "invalid json, internal error",
+			status:  http.StatusOK,
+			body:    `<html></html>`,
+			wantErr: errors.IsInternal,
+		},
+		{
+			name:    "body too large, internal error",
+			status:  http.StatusOK,
+			body:    `{"id": "user1", "padding": "` + strings.Repeat("a", oauth2UserInfoMaxSize) + `"}`,
+			wantErr: errors.IsInternal,
+		},
+		{
+			name:   "ok",
+			status: http.StatusOK,
+			body:   `{"id": 1, "login": "gigi"}`,
+			want:   map[string]interface{}{"id": json.Number("1"), "login": "gigi"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+				assert.Equal(t, "application/json", r.Header.Get("Accept"))
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			tokens := &oidc.Tokens{Token: &oauth2.Token{AccessToken: "token", TokenType: "Bearer"}}
+			got, err := oauth2UserInfo(context.Background(), server.Client(), server.URL, tokens)
+			if tt.wantErr != nil {
+				assert.True(t, tt.wantErr(err), "unexpected error: %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package login
+
+import (
+	"net/http"
+	"time"
+
+	http_mw "github.com/dennigogo/zitadel/internal/api/http/middleware"
+	"github.com/dennigogo/zitadel/internal/domain"
+)
+
+const (
+	tmplPasswordExpiryWarning = "passwordexpirywarning"
+)
+
+type passwordExpiryWarningFormData struct {
+	Skip bool `schema:"skip"`
+}
+
+type passwordExpiryWarningData struct {
+	baseData
+	profileData
+	ExpirationDate string
+}
+
+func (l *Login) handlePasswordExpiryWarning(w http.ResponseWriter, r *http.Request) {
+	data := new(passwordExpiryWarningFormData)
+	authReq, err := l.getAuthRequestAndParseData(r, data)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	if !data.Skip {
+		l.renderChangePassword(w, r, authReq, nil)
+		return
+	}
+	userAgentID, _ := http_mw.UserAgentIDFromCtx(r.Context())
+	err = l.authRepo.SkipPasswordExpiryWarning(r.Context(), authReq.ID, userAgentID)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	l.handleLogin(w, r)
+}
+
+func (l *Login) renderPasswordExpiryWarning(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, step *domain.PasswordExpiryWarningStep, err error) {
+	var errID, errMessage string
+	if err != nil {
+		errID, errMessage = l.getErrorMessage(r, err)
+	}
+	data := passwordExpiryWarningData{
+		baseData:       l.getBaseData(r, authReq, "Password Expiry Warning", errID, errMessage),
+		profileData:    l.getProfileData(authReq),
+		ExpirationDate: step.ExpirationDate.Format(time.RFC1123),
+	}
+	translator := l.getTranslator(r.Context(), authReq)
+	l.renderer.RenderTemplate(w, r, translator, l.renderer.Templates[tmplPasswordExpiryWarning], data, nil)
+}
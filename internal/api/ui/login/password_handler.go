@@ -3,6 +3,8 @@ package login
 import (
 	"net/http"
 
+	"github.com/zitadel/logging"
+
 	"github.com/dennigogo/zitadel/internal/domain"
 )
 
@@ -47,5 +49,12 @@ func (l *Login) handlePasswordCheck(w http.ResponseWriter, r *http.Request) {
 		l.renderPassword(w, r, authReq, err)
 		return
 	}
+	err = l.customInternalAuthentication(r.Context(), authReq)
+	if err != nil {
+		signOutErr := l.command.HumansSignOut(setContext(r.Context(), authReq.UserOrgID), authReq.AgentID, []string{authReq.UserID})
+		logging.WithFields("authRequestID", authReq.ID).OnError(signOutErr).Error("unable to sign out denied user")
+		l.renderPassword(w, r, authReq, err)
+		return
+	}
 	l.renderNextStep(w, r, authReq)
 }
@@ -83,7 +83,29 @@ func (l *Login) handleRegisterCheck(w http.ResponseWriter, r *http.Request) {
 		l.renderRegister(w, r, authRequest, data, err)
 		return
 	}
-	user, err := l.command.RegisterHuman(setContext(r.Context(), resourceOwner), resourceOwner, data.toHumanDomain(), nil, nil, initCodeGenerator, emailCodeGenerator, phoneCodeGenerator)
+	human, metadata, err := l.customRegistrationMapping(r.Context(), data.toHumanDomain(), data, authRequest, resourceOwner)
+	if err != nil {
+		l.renderRegister(w, r, authRequest, data, err)
+		return
+	}
+	user, err := l.command.RegisterHuman(setContext(r.Context(), resourceOwner), resourceOwner, human, nil, nil, initCodeGenerator, emailCodeGenerator, phoneCodeGenerator)
+	if err != nil {
+		l.renderRegister(w, r, authRequest, data, err)
+		return
+	}
+	if len(metadata) > 0 {
+		_, err = l.command.BulkSetUserMetadata(setContext(r.Context(), resourceOwner), user.AggregateID, resourceOwner, metadata...)
+		if err != nil {
+			l.renderRegister(w, r, authRequest, data, err)
+			return
+		}
+	}
+	userGrants, err := l.customInternalGrants(r.Context(), user.AggregateID, resourceOwner, data, authRequest)
+	if err != nil {
+		l.renderRegister(w, r, authRequest, data, err)
+		return
+	}
+	err = l.appendUserGrants(r.Context(), userGrants, resourceOwner)
 	if err != nil {
 		l.renderRegister(w, r, authRequest, data, err)
 		return
@@ -197,3 +219,24 @@ func (d registerFormData) toHumanDomain() *domain.Human {
 		},
 	}
 }
+
+// actionRegistration is the registration form data provided to actions, passwords are omitted
+type actionRegistration struct {
+	Email     string
+	Username  string
+	Firstname string
+	Lastname  string
+	Language  string
+	Gender    domain.Gender
+}
+
+func (d *registerFormData) toActionRegistration() *actionRegistration {
+	return &actionRegistration{
+		Email:     d.Email,
+		Username:  d.Username,
+		Firstname: d.Firstname,
+		Lastname:  d.Lastname,
+		Language:  d.Language,
+		Gender:    domain.Gender(d.Gender),
+	}
+}
@@ -67,6 +67,7 @@ func CreateRenderer(pathPrefix string, staticDir http.FileSystem, staticStorage
 		tmplPasswordResetDone:            "password_reset_done.html",
 		tmplChangePassword:               "change_password.html",
 		tmplChangePasswordDone:           "change_password_done.html",
+		tmplPasswordExpiryWarning:        "password_expiry_warning.html",
 		tmplRegisterOption:               "register_option.html",
 		tmplRegister:                     "register.html",
 		tmplExternalRegisterOverview:     "external_register_overview.html",
@@ -77,6 +78,10 @@ func CreateRenderer(pathPrefix string, staticDir http.FileSystem, staticStorage
 		tmplLinkUsersDone:                "link_users_done.html",
 		tmplExternalNotFoundOption:       "external_not_found_option.html",
 		tmplLoginSuccess:                 "login_success.html",
+		tmplLDAPLogin:                    "ldap_login.html",
+		tmplDeviceAuthUserCode:           "device_usercode.html",
+		tmplDeviceAuthAction:             "device_action.html",
+		tmplDeviceAuthDone:               "device_done.html",
 	}
 	funcs := map[string]interface{}{
 		"resourceUrl": func(file string) string {
@@ -114,6 +119,9 @@ func CreateRenderer(pathPrefix string, staticDir http.FileSystem, staticStorage
 		"externalIDPAuthURL": func(authReqID, idpConfigID string) string {
 			return path.Join(r.pathPrefix, fmt.Sprintf("%s?%s=%s&%s=%s", EndpointExternalLogin, QueryAuthRequestID, authReqID, queryIDPConfigID, idpConfigID))
 		},
+		"ldapLoginUrl": func() string {
+			return path.Join(r.pathPrefix, EndpointLDAPLogin)
+		},
 		"externalIDPRegisterURL": func(authReqID, idpConfigID string) string {
 			return path.Join(r.pathPrefix, fmt.Sprintf("%s?%s=%s&%s=%s", EndpointExternalRegister, QueryAuthRequestID, authReqID, queryIDPConfigID, idpConfigID))
 		},
@@ -174,6 +182,9 @@ func CreateRenderer(pathPrefix string, staticDir http.FileSystem, staticStorage
 		"changePasswordUrl": func() string {
 			return path.Join(r.pathPrefix, EndpointChangePassword)
 		},
+		"passwordExpiryWarningUrl": func() string {
+			return path.Join(r.pathPrefix, EndpointPasswordExpiryWarning)
+		},
 		"registerOptionUrl": func() string {
 			return path.Join(r.pathPrefix, EndpointRegisterOption)
 		},
@@ -192,6 +203,12 @@ func CreateRenderer(pathPrefix string, staticDir http.FileSystem, staticStorage
 		"externalNotFoundOptionUrl": func(action string) string {
 			return path.Join(r.pathPrefix, EndpointExternalNotFoundOption+"?"+action+"=true")
 		},
+		"deviceAuthUrl": func() string {
+			return path.Join(r.pathPrefix, EndpointDeviceAuth)
+		},
+		"deviceAuthActionUrl": func() string {
+			return path.Join(r.pathPrefix, EndpointDeviceAuthAction)
+		},
 		"selectedLanguage": func(l string) bool {
 			return false
 		},
@@ -287,6 +304,8 @@ func (l *Login) chooseNextStep(w http.ResponseWriter, r *http.Request, authReq *
 		l.redirectToLoginSuccess(w, r, authReq.ID)
 	case *domain.ChangePasswordStep:
 		l.renderChangePassword(w, r, authReq, err)
+	case *domain.PasswordExpiryWarningStep:
+		l.renderPasswordExpiryWarning(w, r, authReq, step, err)
 	case *domain.VerifyEMailStep:
 		l.renderMailVerification(w, r, authReq, "", err)
 	case *domain.MFAPromptStep:
@@ -616,6 +635,7 @@ type passwordData struct {
 	HasLowercase string
 	HasNumber    string
 	HasSymbol    string
+	Expired      bool
 }
 
 type userSelectionData struct {
@@ -641,7 +661,8 @@ type mfaVerifyData struct {
 type mfaDoneData struct {
 	baseData
 	profileData
-	MFAType domain.MFAType
+	MFAType       domain.MFAType
+	RecoveryCodes []string
 }
 
 type otpData struct {
@@ -13,6 +13,9 @@ const (
 	EndpointLogin                    = "/login"
 	EndpointExternalLogin            = "/login/externalidp"
 	EndpointExternalLoginCallback    = "/login/externalidp/callback"
+	EndpointSAMLACS                  = "/login/externalidp/saml/acs"
+	EndpointSAMLMetadata             = "/login/externalidp/saml/metadata"
+	EndpointLDAPLogin                = "/login/externalidp/ldap"
 	EndpointJWTAuthorize             = "/login/jwt/authorize"
 	EndpointJWTCallback              = "/login/jwt/callback"
 	EndpointPasswordlessLogin        = "/login/passwordless"
@@ -25,6 +28,7 @@ const (
 	EndpointInitPassword             = "/password/init"
 	EndpointChangePassword           = "/password/change"
 	EndpointPasswordReset            = "/password/reset"
+	EndpointPasswordExpiryWarning    = "/password/expiry"
 	EndpointInitUser                 = "/user/init"
 	EndpointMFAVerify                = "/mfa/verify"
 	EndpointMFAPrompt                = "/mfa/prompt"
@@ -41,6 +45,8 @@ const (
 	EndpointLogoutDone               = "/logout/done"
 	EndpointLoginSuccess             = "/login/success"
 	EndpointExternalNotFoundOption   = "/externaluser/option"
+	EndpointDeviceAuth               = "/device"
+	EndpointDeviceAuthAction         = "/device/action"
 
 	EndpointResources        = "/resources"
 	EndpointDynamicResources = "/resources/dynamic"
@@ -64,6 +70,9 @@ func CreateRouter(login *Login, staticDir http.FileSystem, interceptors ...mux.M
 	router.HandleFunc(EndpointLogin, login.handleLogin).Methods(http.MethodGet, http.MethodPost)
 	router.HandleFunc(EndpointExternalLogin, login.handleExternalLogin).Methods(http.MethodGet)
 	router.HandleFunc(EndpointExternalLoginCallback, login.handleExternalLoginCallback).Methods(http.MethodGet)
+	router.HandleFunc(EndpointSAMLACS, login.handleSAMLACS).Methods(http.MethodPost)
+	router.HandleFunc(EndpointSAMLMetadata, login.handleSAMLMetadata).Methods(http.MethodGet)
+	router.HandleFunc(EndpointLDAPLogin, login.handleLDAPLogin).Methods(http.MethodPost)
 	router.HandleFunc(EndpointJWTAuthorize, login.handleJWTRequest).Methods(http.MethodGet)
 	router.HandleFunc(EndpointJWTCallback, login.handleJWTCallback).Methods(http.MethodGet)
 	router.HandleFunc(EndpointPasswordlessLogin, login.handlePasswordlessVerification).Methods(http.MethodPost)
@@ -89,6 +98,7 @@ func CreateRouter(login *Login, staticDir http.FileSystem, interceptors ...mux.M
 	router.HandleFunc(EndpointMailVerification, login.handleMailVerification).Methods(http.MethodGet)
 	router.HandleFunc(EndpointMailVerification, login.handleMailVerificationCheck).Methods(http.MethodPost)
 	router.HandleFunc(EndpointChangePassword, login.handleChangePassword).Methods(http.MethodPost)
+	router.HandleFunc(EndpointPasswordExpiryWarning, login.handlePasswordExpiryWarning).Methods(http.MethodPost)
 	router.HandleFunc(EndpointRegisterOption, login.handleRegisterOption).Methods(http.MethodGet)
 	router.HandleFunc(EndpointRegisterOption, login.handleRegisterOptionCheck).Methods(http.MethodPost)
 	router.HandleFunc(EndpointExternalNotFoundOption, login.handleExternalNotFoundOptionCheck).Methods(http.MethodPost)
@@ -103,6 +113,9 @@ func CreateRouter(login *Login, staticDir http.FileSystem, interceptors ...mux.M
 	router.HandleFunc(EndpointRegisterOrg, login.handleRegisterOrg).Methods(http.MethodGet)
 	router.HandleFunc(EndpointRegisterOrg, login.handleRegisterOrgCheck).Methods(http.MethodPost)
 	router.HandleFunc(EndpointLoginSuccess, login.handleLoginSuccess).Methods(http.MethodGet)
+	router.HandleFunc(EndpointDeviceAuth, login.handleDeviceAuthUserCode).Methods(http.MethodGet)
+	router.HandleFunc(EndpointDeviceAuth, login.handleDeviceAuthUserCodeCheck).Methods(http.MethodPost)
+	router.HandleFunc(EndpointDeviceAuthAction, login.handleDeviceAuthAction).Methods(http.MethodPost)
 	router.SkipClean(true).Handle("", http.RedirectHandler(HandlerPrefix+"/", http.StatusMovedPermanently))
 	return router
 }
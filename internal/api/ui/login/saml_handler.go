@@ -0,0 +1,574 @@
+package login
+
+import (
+	"bytes"
+	"compress/flate"
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	xml_encoding "encoding/xml"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/beevik/etree"
+	dsig "github.com/russellhaering/goxmldsig"
+	"github.com/russellhaering/goxmldsig/etreeutils"
+	"github.com/zitadel/saml/pkg/provider/signature"
+	"github.com/zitadel/saml/pkg/provider/xml"
+	"github.com/zitadel/saml/pkg/provider/xml/md"
+	"github.com/zitadel/saml/pkg/provider/xml/saml"
+	"github.com/zitadel/saml/pkg/provider/xml/samlp"
+	"github.com/zitadel/saml/pkg/provider/xml/xml_dsig"
+
+	http_mw "github.com/dennigogo/zitadel/internal/api/http/middleware"
+	"github.com/dennigogo/zitadel/internal/crypto"
+	"github.com/dennigogo/zitadel/internal/domain"
+	"github.com/dennigogo/zitadel/internal/errors"
+	iam_model "github.com/dennigogo/zitadel/internal/iam/model"
+)
+
+const (
+	samlBindingRedirect    = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect"
+	samlBindingPost        = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST"
+	samlProtocol           = "urn:oasis:names:tc:SAML:2.0:protocol"
+	samlStatusSuccess      = "urn:oasis:names:tc:SAML:2.0:status:Success"
+	samlNameIDUnspecified  = "urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified"
+	samlConfirmationBearer = "urn:oasis:names:tc:SAML:2.0:cm:bearer"
+	samlSignatureAlgorithm = dsig.RSASHA256SignatureMethod
+
+	samlRequestParam    = "SAMLRequest"
+	samlRelayStateParam = "RelayState"
+	samlSigAlgParam     = "SigAlg"
+	samlSignatureParam  = "Signature"
+
+	samlClockSkew = 3 * time.Second
+)
+
+type samlACSData struct {
+	SAMLResponse string `schema:"SAMLResponse"`
+	RelayState   string `schema:"RelayState"`
+}
+
+type samlMetadataData struct {
+	IDPConfigID string `schema:"idpConfigID"`
+}
+
+// handleSAMLAuthorize redirects the user to the SAML identity provider using the HTTP-Redirect binding
+// as the response of the identity provider will be posted cross site, the user agent is passed (encrypted) as RelayState
+func (l *Login) handleSAMLAuthorize(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, idpConfig *iam_model.IDPConfigView) {
+	entity, err := xml.ParseMetadataXmlIntoStruct(idpConfig.SAMLMetadata)
+	if err != nil || entity.IDPSSODescriptor == nil {
+		l.renderLogin(w, r, authReq, errors.ThrowPreconditionFailed(err, "LOGIN-3n8fs", "Errors.ExternalIDP.SAMLMetadataInvalid"))
+		return
+	}
+	ssoURL := ""
+	for _, sso := range entity.IDPSSODescriptor.SingleSignOnService {
+		if sso.Binding == samlBindingRedirect {
+			ssoURL = sso.Location
+			break
+		}
+	}
+	if ssoURL == "" {
+		l.renderLogin(w, r, authReq, errors.ThrowPreconditionFailed(nil, "LOGIN-9fn2s", "Errors.ExternalIDP.SAMLMetadataInvalid"))
+		return
+	}
+	userAgentID, ok := http_mw.UserAgentIDFromCtx(r.Context())
+	if !ok {
+		l.renderLogin(w, r, authReq, errors.ThrowPreconditionFailed(nil, "LOGIN-Mf9s2", "Errors.AuthRequest.UserAgentNotFound"))
+		return
+	}
+	relayState, err := l.idpConfigAlg.Encrypt([]byte(userAgentID))
+	if err != nil {
+		l.renderLogin(w, r, authReq, err)
+		return
+	}
+	samlRequest, err := l.samlAuthnRequest(r.Context(), authReq, idpConfig, ssoURL)
+	if err != nil {
+		l.renderLogin(w, r, authReq, err)
+		return
+	}
+	redirect, err := url.Parse(ssoURL)
+	if err != nil {
+		l.renderLogin(w, r, authReq, err)
+		return
+	}
+	query := samlRequestParam + "=" + url.QueryEscape(samlRequest) +
+		"&" + samlRelayStateParam + "=" + url.QueryEscape(base64.RawURLEncoding.EncodeToString(relayState))
+	if idpConfig.SAMLWithSignedRequest {
+		query += "&" + samlSigAlgParam + "=" + url.QueryEscape(samlSignatureAlgorithm)
+		sig, err := l.samlSignRedirect(idpConfig, query)
+		if err != nil {
+			l.renderLogin(w, r, authReq, err)
+			return
+		}
+		query += "&" + samlSignatureParam + "=" + url.QueryEscape(base64.StdEncoding.EncodeToString(sig))
+	}
+	if redirect.RawQuery != "" {
+		query = redirect.RawQuery + "&" + query
+	}
+	redirect.RawQuery = query
+	http.Redirect(w, r, redirect.String(), http.StatusFound)
+}
+
+// samlAuthnRequest creates the deflated and base64 encoded AuthnRequest
+// its ID is derived from the auth request, so the response can be assigned to it
+func (l *Login) samlAuthnRequest(ctx context.Context, authReq *domain.AuthRequest, idpConfig *iam_model.IDPConfigView, destination string) (string, error) {
+	request := &samlp.AuthnRequestType{
+		Id:                          samlRequestID(authReq.ID),
+		Version:                     "2.0",
+		IssueInstant:                time.Now().UTC().Format(time.RFC3339),
+		Destination:                 destination,
+		ProtocolBinding:             samlBindingPost,
+		AssertionConsumerServiceURL: l.baseURL(ctx) + EndpointSAMLACS,
+		Issuer: &saml.NameIDType{
+			Text: l.samlEntityID(ctx, idpConfig.IDPConfigID),
+		},
+		NameIDPolicy: &samlp.NameIDPolicyType{
+			Format:      samlNameIDUnspecified,
+			AllowCreate: true,
+		},
+	}
+	data, err := xml.Marshal(request)
+	if err != nil {
+		return "", err
+	}
+	var deflated bytes.Buffer
+	writer, err := flate.NewWriter(&deflated, flate.DefaultCompression)
+	if err != nil {
+		return "", err
+	}
+	if _, err = writer.Write([]byte(data)); err != nil {
+		return "", err
+	}
+	if err = writer.Close(); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(deflated.Bytes()), nil
+}
+
+func (l *Login) samlSignRedirect(idpConfig *iam_model.IDPConfigView, query string) ([]byte, error) {
+	keyPEM, err := crypto.Decrypt(idpConfig.SAMLKey, l.idpConfigAlg)
+	if err != nil {
+		return nil, err
+	}
+	key, err := crypto.BytesToPrivateKey(keyPEM)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(idpConfig.SAMLCertificate)
+	if block == nil {
+		return nil, errors.ThrowPreconditionFailed(nil, "LOGIN-s9Gm2", "Errors.ExternalIDP.SAMLMetadataInvalid")
+	}
+	tlsCert, err := signature.ParseTlsKeyPair(block.Bytes, key)
+	if err != nil {
+		return nil, err
+	}
+	signingContext, err := signature.GetSigningContext(tlsCert, samlSignatureAlgorithm)
+	if err != nil {
+		return nil, err
+	}
+	return signature.CreateRedirect(signingContext, query)
+}
+
+// handleSAMLACS handles the response of the SAML identity provider (HTTP-POST binding)
+// the request is not protected by the csrf middleware as it's initiated by the identity provider
+func (l *Login) handleSAMLACS(w http.ResponseWriter, r *http.Request) {
+	data := new(samlACSData)
+	err := l.getParseData(r, data)
+	if err != nil {
+		l.renderError(w, r, nil, err)
+		return
+	}
+	if data.SAMLResponse == "" || data.RelayState == "" {
+		l.renderError(w, r, nil, errors.ThrowInvalidArgument(nil, "LOGIN-Ns8f2", "Errors.AuthRequest.MissingParameters"))
+		return
+	}
+	id, err := base64.RawURLEncoding.DecodeString(data.RelayState)
+	if err != nil {
+		l.renderError(w, r, nil, err)
+		return
+	}
+	userAgentID, err := l.idpConfigAlg.DecryptString(id, l.idpConfigAlg.EncryptionKeyID())
+	if err != nil {
+		l.renderError(w, r, nil, err)
+		return
+	}
+	rawResponse, err := base64.StdEncoding.DecodeString(data.SAMLResponse)
+	if err != nil {
+		l.renderError(w, r, nil, errors.ThrowInvalidArgument(err, "LOGIN-3m0fs", "Errors.ExternalIDP.SAMLResponseInvalid"))
+		return
+	}
+	response, err := xml.DecodeResponse("", string(rawResponse))
+	if err != nil {
+		l.renderError(w, r, nil, errors.ThrowInvalidArgument(err, "LOGIN-Gm3n2", "Errors.ExternalIDP.SAMLResponseInvalid"))
+		return
+	}
+	authReq, err := l.authRepo.AuthRequestByID(r.Context(), strings.TrimPrefix(response.InResponseTo, "_"), userAgentID)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	idpConfig, err := l.authRepo.GetIDPConfigByID(r.Context(), authReq.SelectedIDPConfigID)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	if !idpConfig.IsSAML {
+		l.renderError(w, r, authReq, errors.ThrowPreconditionFailed(nil, "LOGIN-Bf32s", "Errors.ExternalIDP.IDPTypeNotImplemented"))
+		return
+	}
+	assertion, err := l.validateSAMLResponse(r.Context(), rawResponse, response, authReq, idpConfig)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	externalUser := mapSAMLAssertionToLoginUser(assertion, idpConfig)
+	externalUser, err = l.customExternalUserMapping(r.Context(), externalUser, nil, authReq, idpConfig)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	metadata := externalUser.Metadatas
+	err = l.authRepo.CheckExternalUserLogin(setContext(r.Context(), ""), authReq.ID, authReq.AgentID, externalUser, domain.BrowserInfoFromRequest(r))
+	if err != nil {
+		l.externalUserNotFound(w, r, authReq, idpConfig, nil, err)
+		return
+	}
+	if len(metadata) > 0 {
+		authReq, err = l.authRepo.AuthRequestByID(r.Context(), authReq.ID, authReq.AgentID)
+		if err != nil {
+			l.renderError(w, r, authReq, err)
+			return
+		}
+		_, err = l.command.BulkSetUserMetadata(setContext(r.Context(), authReq.UserOrgID), authReq.UserID, authReq.UserOrgID, metadata...)
+		if err != nil {
+			l.renderError(w, r, authReq, err)
+			return
+		}
+	}
+	// the (same site) redirect ensures the user agent cookie is sent again for the next steps
+	redirect, err := l.redirectToJWTCallback(r.Context(), authReq)
+	if err != nil {
+		l.renderError(w, r, nil, err)
+		return
+	}
+	http.Redirect(w, r, redirect, http.StatusFound)
+}
+
+// validateSAMLResponse checks the status, signature (of the response or the assertion) and conditions
+// of the response against the metadata of the identity provider
+// it returns the assertion of the signed element, so only signed values are mapped to the user
+func (l *Login) validateSAMLResponse(ctx context.Context, rawResponse []byte, response *samlp.ResponseType, authReq *domain.AuthRequest, idpConfig *iam_model.IDPConfigView) (*saml.AssertionType, error) {
+	if response.InResponseTo != samlRequestID(authReq.ID) {
+		return nil, errors.ThrowInvalidArgument(nil, "LOGIN-Ks93n", "Errors.ExternalIDP.SAMLResponseInvalid")
+	}
+	if response.Status.StatusCode.Value != samlStatusSuccess {
+		return nil, errors.ThrowPreconditionFailed(nil, "LOGIN-0fn3s", "Errors.ExternalIDP.SAMLResponseInvalid")
+	}
+	if response.Destination != "" && response.Destination != l.baseURL(ctx)+EndpointSAMLACS {
+		return nil, errors.ThrowInvalidArgument(nil, "LOGIN-M9s2d", "Errors.ExternalIDP.SAMLResponseInvalid")
+	}
+	entity, err := xml.ParseMetadataXmlIntoStruct(idpConfig.SAMLMetadata)
+	if err != nil || entity.IDPSSODescriptor == nil {
+		return nil, errors.ThrowPreconditionFailed(err, "LOGIN-Sf3n2", "Errors.ExternalIDP.SAMLMetadataInvalid")
+	}
+	if response.Issuer != nil && response.Issuer.Text != "" && response.Issuer.Text != string(entity.EntityID) {
+		return nil, errors.ThrowInvalidArgument(nil, "LOGIN-9dk2s", "Errors.ExternalIDP.SAMLResponseInvalid")
+	}
+	certs, err := signature.ParseCertificates(xml.GetCertsFromKeyDescriptors(entity.IDPSSODescriptor.KeyDescriptor))
+	if err != nil || len(certs) == 0 {
+		return nil, errors.ThrowPreconditionFailed(err, "LOGIN-n0f2s", "Errors.ExternalIDP.SAMLMetadataInvalid")
+	}
+	assertion, err := validateSAMLSignature(rawResponse, certs)
+	if err != nil {
+		return nil, err
+	}
+	if err = l.validateSAMLAssertion(ctx, assertion, authReq, idpConfig); err != nil {
+		return nil, err
+	}
+	return assertion, nil
+}
+
+// validateSAMLSignature validates the signature of the response or (if the response is not signed) of the assertion
+// the assertion is decoded from the validated element and not from the raw response to prevent signature wrapping
+func validateSAMLSignature(rawResponse []byte, certs []*x509.Certificate) (*saml.AssertionType, error) {
+	doc := etree.NewDocument()
+	if err := doc.ReadFromBytes(rawResponse); err != nil {
+		return nil, errors.ThrowInvalidArgument(err, "LOGIN-Nf92s", "Errors.ExternalIDP.SAMLResponseInvalid")
+	}
+	root := doc.Root()
+	if root == nil || root.Tag != "Response" {
+		return nil, errors.ThrowInvalidArgument(nil, "LOGIN-3mf9s", "Errors.ExternalIDP.SAMLResponseInvalid")
+	}
+	// only the signed assertion must be present in the response
+	if len(root.FindElements("//Assertion")) != 1 {
+		return nil, errors.ThrowInvalidArgument(nil, "LOGIN-Ms8fj", "Errors.ExternalIDP.SAMLResponseInvalid")
+	}
+	signed := root
+	if root.FindElement("./Signature") == nil {
+		signed = root.FindElement("./Assertion")
+	}
+	if signed == nil || signed.FindElement("./Signature") == nil {
+		return nil, errors.ThrowInvalidArgument(nil, "LOGIN-Pw0fn", "Errors.ExternalIDP.SAMLResponseInvalid")
+	}
+	if !samlSignatureReferencesElement(signed) {
+		return nil, errors.ThrowInvalidArgument(nil, "LOGIN-Rf8s2", "Errors.ExternalIDP.SAMLResponseInvalid")
+	}
+	validated, err := validateSAMLPostSignature(certs, signed)
+	if err != nil {
+		return nil, errors.ThrowInvalidArgument(err, "LOGIN-Lf3ms", "Errors.ExternalIDP.SAMLResponseInvalid")
+	}
+	validatedDoc := etree.NewDocument()
+	validatedDoc.SetRoot(validated)
+	validatedXML, err := validatedDoc.WriteToBytes()
+	if err != nil {
+		return nil, errors.ThrowInvalidArgument(err, "LOGIN-Wm3f9", "Errors.ExternalIDP.SAMLResponseInvalid")
+	}
+	if validated.Tag == "Assertion" {
+		assertion := new(saml.AssertionType)
+		if err = xml_encoding.Unmarshal(validatedXML, assertion); err != nil {
+			return nil, errors.ThrowInvalidArgument(err, "LOGIN-Nv9s3", "Errors.ExternalIDP.SAMLResponseInvalid")
+		}
+		return assertion, nil
+	}
+	response := new(samlp.ResponseType)
+	if err = xml_encoding.Unmarshal(validatedXML, response); err != nil {
+		return nil, errors.ThrowInvalidArgument(err, "LOGIN-Gs0f2", "Errors.ExternalIDP.SAMLResponseInvalid")
+	}
+	return &response.Assertion, nil
+}
+
+// samlSignatureReferencesElement checks that the signature of the element has a single reference
+// which points to the ID of the element itself
+func samlSignatureReferencesElement(el *etree.Element) bool {
+	id := el.SelectAttrValue("ID", "")
+	if id == "" {
+		return false
+	}
+	references := el.FindElements("./Signature/SignedInfo/Reference")
+	return len(references) == 1 && references[0].SelectAttrValue("URI", "") == "#"+id
+}
+
+// validateSAMLPostSignature validates the enveloped signature of the element
+// and returns the validated element (without the signature)
+func validateSAMLPostSignature(certs []*x509.Certificate, el *etree.Element) (*etree.Element, error) {
+	validationContext := dsig.NewDefaultValidationContext(&dsig.MemoryX509CertificateStore{Roots: certs})
+	validationContext.IdAttribute = "ID"
+
+	el = el.Copy()
+	// without a certificate in the signature, the certificates of the metadata are used
+	if el.FindElement("./Signature/KeyInfo/X509Data/X509Certificate") == nil {
+		if keyInfo := el.FindElement("./Signature/KeyInfo"); keyInfo != nil {
+			el.FindElement("./Signature").RemoveChild(keyInfo)
+		}
+	}
+	nsCtx, err := etreeutils.NSBuildParentContext(el)
+	if err != nil {
+		return nil, err
+	}
+	nsCtx, err = nsCtx.SubContext(el)
+	if err != nil {
+		return nil, err
+	}
+	el, err = etreeutils.NSDetatch(nsCtx, el)
+	if err != nil {
+		return nil, err
+	}
+	return validationContext.Validate(el)
+}
+
+// validateSAMLAssertion checks the subject, its bearer confirmation and the conditions of the assertion
+func (l *Login) validateSAMLAssertion(ctx context.Context, assertion *saml.AssertionType, authReq *domain.AuthRequest, idpConfig *iam_model.IDPConfigView) error {
+	if assertion.Subject == nil || assertion.Subject.NameID == nil || assertion.Subject.NameID.Text == "" {
+		return errors.ThrowInvalidArgument(nil, "LOGIN-Fn3sk", "Errors.ExternalIDP.SAMLResponseInvalid")
+	}
+	now := time.Now().UTC()
+	if !hasSAMLBearerConfirmation(assertion.Subject.SubjectConfirmation, l.baseURL(ctx)+EndpointSAMLACS, samlRequestID(authReq.ID), now) {
+		return errors.ThrowInvalidArgument(nil, "LOGIN-Bs0f3", "Errors.ExternalIDP.SAMLResponseInvalid")
+	}
+	if assertion.Conditions == nil {
+		return errors.ThrowInvalidArgument(nil, "LOGIN-Cm2s8", "Errors.ExternalIDP.SAMLResponseInvalid")
+	}
+	if assertion.Conditions.NotBefore != "" {
+		notBefore, err := time.Parse(time.RFC3339, assertion.Conditions.NotBefore)
+		if err != nil || now.Add(samlClockSkew).Before(notBefore) {
+			return errors.ThrowInvalidArgument(err, "LOGIN-2nf8s", "Errors.ExternalIDP.SAMLResponseInvalid")
+		}
+	}
+	if assertion.Conditions.NotOnOrAfter != "" {
+		notOnOrAfter, err := time.Parse(time.RFC3339, assertion.Conditions.NotOnOrAfter)
+		if err != nil || !now.Add(-samlClockSkew).Before(notOnOrAfter) {
+			return errors.ThrowInvalidArgument(err, "LOGIN-N0sf3", "Errors.ExternalIDP.SAMLResponseInvalid")
+		}
+	}
+	// the assertion must be restricted to ZITADEL as audience
+	if len(assertion.Conditions.AudienceRestriction) == 0 {
+		return errors.ThrowInvalidArgument(nil, "LOGIN-Ad8f2", "Errors.ExternalIDP.SAMLResponseInvalid")
+	}
+	entityID := l.samlEntityID(ctx, idpConfig.IDPConfigID)
+	for _, restriction := range assertion.Conditions.AudienceRestriction {
+		if !containsString(restriction.Audience, entityID) {
+			return errors.ThrowInvalidArgument(nil, "LOGIN-Mf0s2", "Errors.ExternalIDP.SAMLResponseInvalid")
+		}
+	}
+	return nil
+}
+
+// hasSAMLBearerConfirmation checks if one of the confirmations is a valid bearer confirmation
+// for the assertion consumer service and the request
+func hasSAMLBearerConfirmation(confirmations []saml.SubjectConfirmationType, recipient, requestID string, now time.Time) bool {
+	for _, confirmation := range confirmations {
+		data := confirmation.SubjectConfirmationData
+		if confirmation.Method != samlConfirmationBearer || data == nil {
+			continue
+		}
+		if data.Recipient != recipient || data.InResponseTo != requestID || data.NotOnOrAfter == "" {
+			continue
+		}
+		notOnOrAfter, err := time.Parse(time.RFC3339, data.NotOnOrAfter)
+		if err == nil && now.Add(-samlClockSkew).Before(notOnOrAfter) {
+			return true
+		}
+	}
+	return false
+}
+
+// handleSAMLMetadata returns the service provider metadata of ZITADEL for the requested SAML identity provider
+func (l *Login) handleSAMLMetadata(w http.ResponseWriter, r *http.Request) {
+	data := new(samlMetadataData)
+	err := l.getParseData(r, data)
+	if err != nil {
+		l.renderError(w, r, nil, err)
+		return
+	}
+	idpConfig, err := l.getIDPConfigByID(r, data.IDPConfigID)
+	if err != nil {
+		l.renderError(w, r, nil, err)
+		return
+	}
+	if !idpConfig.IsSAML {
+		l.renderError(w, r, nil, errors.ThrowNotFound(nil, "LOGIN-Ms92f", "Errors.IDPConfig.NotExisting"))
+		return
+	}
+	block, _ := pem.Decode(idpConfig.SAMLCertificate)
+	if block == nil {
+		l.renderError(w, r, nil, errors.ThrowPreconditionFailed(nil, "LOGIN-Dn3s2", "Errors.ExternalIDP.SAMLMetadataInvalid"))
+		return
+	}
+	metadata := &md.EntityDescriptorType{
+		EntityID: md.EntityIDType(l.samlEntityID(r.Context(), idpConfig.IDPConfigID)),
+		SPSSODescriptor: &md.SPSSODescriptorType{
+			AuthnRequestsSigned:        boolString(idpConfig.SAMLWithSignedRequest),
+			WantAssertionsSigned:       "true",
+			ProtocolSupportEnumeration: samlProtocol,
+			AssertionConsumerService: []md.IndexedEndpointType{
+				{
+					Index:     "0",
+					IsDefault: "true",
+					Binding:   samlBindingPost,
+					Location:  l.baseURL(r.Context()) + EndpointSAMLACS,
+				},
+			},
+			KeyDescriptor: []md.KeyDescriptorType{
+				{
+					Use: md.KeyTypesSigning,
+					KeyInfo: xml_dsig.KeyInfoType{
+						X509Data: []xml_dsig.X509DataType{
+							{X509Certificate: base64.StdEncoding.EncodeToString(block.Bytes)},
+						},
+					},
+				},
+			},
+			NameIDFormat: []string{samlNameIDUnspecified},
+		},
+	}
+	w.Header().Set("Content-Type", "application/samlmetadata+xml")
+	if err = xml.WriteXMLMarshalled(w, metadata); err != nil {
+		l.renderError(w, r, nil, err)
+	}
+}
+
+func (l *Login) samlEntityID(ctx context.Context, idpConfigID string) string {
+	return l.baseURL(ctx) + EndpointSAMLMetadata + "?idpConfigID=" + url.QueryEscape(idpConfigID)
+}
+
+// samlRequestID prefixes the auth request id, as xml ids must not start with a digit
+func samlRequestID(authRequestID string) string {
+	return "_" + authRequestID
+}
+
+// mapSAMLAssertionToLoginUser maps the NameID and the common (friendly) attribute names
+// the mapping can be customised by actions
+func mapSAMLAssertionToLoginUser(assertion *saml.AssertionType, idpConfig *iam_model.IDPConfigView) *domain.ExternalUser {
+	externalUser := &domain.ExternalUser{
+		IDPConfigID:    idpConfig.IDPConfigID,
+		ExternalUserID: assertion.Subject.NameID.Text,
+	}
+	for _, statement := range assertion.AttributeStatement {
+		for _, attribute := range statement.Attribute {
+			if attribute == nil || len(attribute.AttributeValue) == 0 {
+				continue
+			}
+			value := strings.TrimSpace(attribute.AttributeValue[0])
+			switch samlAttributeName(attribute) {
+			case "email", "mail", "emailaddress", "urn:oid:0.9.2342.19200300.100.1.3":
+				externalUser.Email = value
+			case "givenname", "firstname", "urn:oid:2.5.4.42":
+				externalUser.FirstName = value
+			case "surname", "sn", "lastname", "urn:oid:2.5.4.4":
+				externalUser.LastName = value
+			case "displayname", "name", "urn:oid:2.16.840.1.113730.3.1.241":
+				externalUser.DisplayName = value
+			case "username", "uid", "urn:oid:0.9.2342.19200300.100.1.1":
+				externalUser.PreferredUsername = value
+			case "nickname":
+				externalUser.NickName = value
+			case "phone", "telephonenumber", "mobile", "urn:oid:2.5.4.20":
+				externalUser.Phone = value
+			}
+		}
+	}
+	if externalUser.DisplayName == "" {
+		externalUser.DisplayName = externalUser.PreferredUsername
+	}
+	if externalUser.DisplayName == "" {
+		externalUser.DisplayName = externalUser.Email
+	}
+	if externalUser.DisplayName == "" {
+		externalUser.DisplayName = externalUser.ExternalUserID
+	}
+	return externalUser
+}
+
+// samlAttributeName returns the lower cased name of the attribute
+// claim uris (e.g. http://schemas.xmlsoap.org/ws/2005/05/identity/claims/emailaddress) are reduced to their last segment
+func samlAttributeName(attribute *saml.AttributeType) string {
+	name := attribute.FriendlyName
+	if name == "" {
+		name = attribute.Name
+	}
+	name = strings.ToLower(name)
+	if strings.HasPrefix(name, "http://") || strings.HasPrefix(name, "https://") {
+		name = name[strings.LastIndex(name, "/")+1:]
+	}
+	return name
+}
+
+func containsString(list []string, value string) bool {
+	for _, item := range list {
+		if strings.TrimSpace(item) == value {
+			return true
+		}
+	}
+	return false
+}
+
+func boolString(b bool) string {
+	if b {
+		return "true"
+	}
+	return "false"
+}
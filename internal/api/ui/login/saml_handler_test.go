@@ -0,0 +1,294 @@
+package login
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/beevik/etree"
+	dsig "github.com/russellhaering/goxmldsig"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zitadel/saml/pkg/provider/xml"
+	"github.com/zitadel/saml/pkg/provider/xml/saml"
+
+	"github.com/dennigogo/zitadel/internal/domain"
+	iam_model "github.com/dennigogo/zitadel/internal/iam/model"
+)
+
+const (
+	testSAMLIssuer = "https://idp.example.com"
+
+	testSAMLMetadata = `<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" entityID="` + testSAMLIssuer + `">
+	<md:IDPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+		<md:KeyDescriptor use="signing">
+			<ds:KeyInfo xmlns:ds="http://www.w3.org/2000/09/xmldsig#"><ds:X509Data><ds:X509Certificate>%s</ds:X509Certificate></ds:X509Data></ds:KeyInfo>
+		</md:KeyDescriptor>
+		<md:SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="` + testSAMLIssuer + `/sso"/>
+	</md:IDPSSODescriptor>
+</md:EntityDescriptor>`
+
+	testSAMLResponse = `<samlp:Response xmlns:samlp="urn:oasis:names:tc:SAML:2.0:protocol" ID="response1" Version="2.0" IssueInstant="%[1]s" Destination="%[2]s" InResponseTo="_authRequest1">
+	<saml:Issuer xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion">` + testSAMLIssuer + `</saml:Issuer>
+	<samlp:Status><samlp:StatusCode Value="urn:oasis:names:tc:SAML:2.0:status:Success"/></samlp:Status>
+	<saml:Assertion xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion" ID="assertion1" Version="2.0" IssueInstant="%[1]s">
+		<saml:Issuer>` + testSAMLIssuer + `</saml:Issuer>
+		<saml:Subject>
+			<saml:NameID>%[3]s</saml:NameID>
+			<saml:SubjectConfirmation Method="urn:oasis:names:tc:SAML:2.0:cm:bearer">
+				<saml:SubjectConfirmationData Recipient="%[2]s" InResponseTo="%[4]s" NotOnOrAfter="%[5]s"/>
+			</saml:SubjectConfirmation>
+		</saml:Subject>
+		%[6]s
+		<saml:AttributeStatement>
+			<saml:Attribute Name="http://schemas.xmlsoap.org/ws/2005/05/identity/claims/emailaddress"><saml:AttributeValue>user1@example.com</saml:AttributeValue></saml:Attribute>
+			<saml:Attribute Name="urn:oid:2.5.4.42" FriendlyName="givenName"><saml:AttributeValue>Jane</saml:AttributeValue></saml:Attribute>
+			<saml:Attribute Name="sn"><saml:AttributeValue>Doe</saml:AttributeValue></saml:Attribute>
+		</saml:AttributeStatement>
+	</saml:Assertion>
+</samlp:Response>`
+
+	testSAMLConditions = `<saml:Conditions NotBefore="%s" NotOnOrAfter="%s"><saml:AudienceRestriction><saml:Audience>%s</saml:Audience></saml:AudienceRestriction></saml:Conditions>`
+)
+
+type testSAMLSigned int
+
+const (
+	testSAMLUnsigned testSAMLSigned = iota
+	testSAMLSignedResponse
+	testSAMLSignedAssertion
+)
+
+// testSAMLResponseArgs describe the response of the identity provider,
+// the zero values result in a valid response for the auth request
+type testSAMLResponseArgs struct {
+	signed       testSAMLSigned
+	nameID       string
+	inResponseTo string
+	audience     string
+	notOnOrAfter time.Time
+	noConditions bool
+	// modify is applied to the document after signing
+	modify func(t *testing.T, doc *etree.Document)
+}
+
+func TestLogin_validateSAMLResponse(t *testing.T) {
+	ctx := context.Background()
+	l := &Login{}
+	idpConfig, tlsCert := testSAMLIDPConfig(t)
+	authReq := &domain.AuthRequest{ID: "authRequest1"}
+
+	tests := []struct {
+		name    string
+		args    testSAMLResponseArgs
+		wantErr bool
+		want    *domain.ExternalUser
+	}{
+		{
+			name:    "unsigned, error",
+			args:    testSAMLResponseArgs{signed: testSAMLUnsigned},
+			wantErr: true,
+		},
+		{
+			name: "signed response, ok",
+			args: testSAMLResponseArgs{signed: testSAMLSignedResponse},
+			want: &domain.ExternalUser{
+				IDPConfigID:    "idp1",
+				ExternalUserID: "user1",
+				Email:          "user1@example.com",
+				FirstName:      "Jane",
+				LastName:       "Doe",
+				DisplayName:    "user1@example.com",
+			},
+		},
+		{
+			name: "signed assertion, ok",
+			args: testSAMLResponseArgs{signed: testSAMLSignedAssertion},
+			want: &domain.ExternalUser{
+				IDPConfigID:    "idp1",
+				ExternalUserID: "user1",
+				Email:          "user1@example.com",
+				FirstName:      "Jane",
+				LastName:       "Doe",
+				DisplayName:    "user1@example.com",
+			},
+		},
+		{
+			name: "signed assertion modified, error",
+			args: testSAMLResponseArgs{
+				signed: testSAMLSignedAssertion,
+				modify: func(t *testing.T, doc *etree.Document) {
+					doc.FindElement("//Subject/NameID").SetText("admin")
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "wrapped assertion, error",
+			args: testSAMLResponseArgs{
+				signed: testSAMLSignedAssertion,
+				// the signed assertion is moved to the extensions and replaced by an unsigned one
+				modify: func(t *testing.T, doc *etree.Document) {
+					root := doc.Root()
+					signed := root.FindElement("./Assertion")
+					wrapped := signed.Copy()
+					wrapped.CreateAttr("ID", "assertion2")
+					wrapped.RemoveChild(wrapped.FindElement("./Signature"))
+					wrapped.FindElement("./Subject/NameID").SetText("admin")
+					root.RemoveChild(signed)
+					root.CreateElement("samlp:Extensions").AddChild(signed)
+					root.AddChild(wrapped)
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "signature references other element, error",
+			args: testSAMLResponseArgs{
+				signed: testSAMLSignedAssertion,
+				modify: func(t *testing.T, doc *etree.Document) {
+					doc.FindElement("//Assertion/Signature/SignedInfo/Reference").CreateAttr("URI", "")
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "wrong audience, error",
+			args:    testSAMLResponseArgs{signed: testSAMLSignedAssertion, audience: "https://sp.example.com"},
+			wantErr: true,
+		},
+		{
+			name:    "expired, error",
+			args:    testSAMLResponseArgs{signed: testSAMLSignedAssertion, notOnOrAfter: time.Now().Add(-time.Minute)},
+			wantErr: true,
+		},
+		{
+			name:    "conditions missing, error",
+			args:    testSAMLResponseArgs{signed: testSAMLSignedAssertion, noConditions: true},
+			wantErr: true,
+		},
+		{
+			name:    "subject confirmation for other request, error",
+			args:    testSAMLResponseArgs{signed: testSAMLSignedAssertion, inResponseTo: "_authRequest2"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rawResponse := testSAMLResponseXML(t, l, ctx, tlsCert, tt.args)
+			response, err := xml.DecodeResponse("", string(rawResponse))
+			require.NoError(t, err)
+
+			assertion, err := l.validateSAMLResponse(ctx, rawResponse, response, authReq, idpConfig)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, mapSAMLAssertionToLoginUser(assertion, idpConfig))
+		})
+	}
+}
+
+func testSAMLIDPConfig(t *testing.T) (*iam_model.IDPConfigView, tls.Certificate) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "idp.example.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}
+	cert, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	return &iam_model.IDPConfigView{
+		IDPConfigID:  "idp1",
+		IsSAML:       true,
+		SAMLMetadata: []byte(fmt.Sprintf(testSAMLMetadata, base64.StdEncoding.EncodeToString(cert))),
+	}, tls.Certificate{
+		Certificate: [][]byte{cert},
+		PrivateKey:  key,
+	}
+}
+
+func testSAMLResponseXML(t *testing.T, l *Login, ctx context.Context, tlsCert tls.Certificate, args testSAMLResponseArgs) []byte {
+	t.Helper()
+	now := time.Now().UTC()
+	if args.nameID == "" {
+		args.nameID = "user1"
+	}
+	if args.inResponseTo == "" {
+		args.inResponseTo = "_authRequest1"
+	}
+	if args.audience == "" {
+		args.audience = l.samlEntityID(ctx, "idp1")
+	}
+	if args.notOnOrAfter.IsZero() {
+		args.notOnOrAfter = now.Add(5 * time.Minute)
+	}
+	conditions := ""
+	if !args.noConditions {
+		conditions = fmt.Sprintf(testSAMLConditions, now.Add(-time.Minute).Format(time.RFC3339), args.notOnOrAfter.UTC().Format(time.RFC3339), args.audience)
+	}
+	response := fmt.Sprintf(testSAMLResponse,
+		now.Format(time.RFC3339),
+		l.baseURL(ctx)+EndpointSAMLACS,
+		args.nameID,
+		args.inResponseTo,
+		args.notOnOrAfter.UTC().Format(time.RFC3339),
+		conditions,
+	)
+
+	doc := etree.NewDocument()
+	require.NoError(t, doc.ReadFromString(response))
+	signingContext := dsig.NewDefaultSigningContext(dsig.TLSCertKeyStore(tlsCert))
+	signingContext.Canonicalizer = dsig.MakeC14N10ExclusiveCanonicalizerWithPrefixList("")
+	switch args.signed {
+	case testSAMLSignedResponse:
+		signed, err := signingContext.SignEnveloped(doc.Root())
+		require.NoError(t, err)
+		doc.SetRoot(signed)
+	case testSAMLSignedAssertion:
+		assertion := doc.Root().FindElement("./Assertion")
+		signed, err := signingContext.SignEnveloped(assertion)
+		require.NoError(t, err)
+		index := assertion.Index()
+		doc.Root().RemoveChild(assertion)
+		doc.Root().InsertChildAt(index, signed)
+	}
+	if args.modify != nil {
+		args.modify(t, doc)
+	}
+	raw, err := doc.WriteToBytes()
+	require.NoError(t, err)
+	return raw
+}
+
+func Test_samlAttributeName(t *testing.T) {
+	tests := []struct {
+		name         string
+		attrName     string
+		friendlyName string
+		want         string
+	}{
+		{name: "friendly name", attrName: "urn:oid:2.5.4.42", friendlyName: "givenName", want: "givenname"},
+		{name: "name", attrName: "Mail", want: "mail"},
+		{name: "claim uri", attrName: "http://schemas.xmlsoap.org/ws/2005/05/identity/claims/emailaddress", want: "emailaddress"},
+		{name: "oid", attrName: "urn:oid:2.5.4.4", want: "urn:oid:2.5.4.4"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, samlAttributeName(&saml.AttributeType{Name: tt.attrName, FriendlyName: tt.friendlyName}))
+		})
+	}
+}
@@ -46,6 +46,8 @@ type AuthRequestRepo struct {
 	OrgViewProvider           orgViewProvider
 	LoginPolicyViewProvider   loginPolicyViewProvider
 	LockoutPolicyViewProvider lockoutPolicyViewProvider
+	PasswordAgePolicyProvider passwordAgePolicyProvider
+	TrustedDeviceProvider     trustedDeviceProvider
 	PrivacyPolicyProvider     privacyPolicyProvider
 	IDPProviderViewProvider   idpProviderViewProvider
 	IDPUserLinksProvider      idpUserLinksProvider
@@ -80,6 +82,14 @@ type lockoutPolicyViewProvider interface {
 	LockoutPolicyByOrg(context.Context, bool, string) (*query.LockoutPolicy, error)
 }
 
+type passwordAgePolicyProvider interface {
+	PasswordAgePolicyByOrg(context.Context, bool, string) (*query.PasswordAgePolicy, error)
+}
+
+type trustedDeviceProvider interface {
+	UserTrustedDeviceByID(ctx context.Context, shouldTriggerBulk bool, userID, userAgentID string) (*query.UserTrustedDevice, error)
+}
+
 type idpProviderViewProvider interface {
 	IDPProvidersByAggregateIDAndState(string, string, iam_model.IDPConfigState) ([]*iam_view_model.IDPProviderView, error)
 }
@@ -345,7 +355,26 @@ func lockoutPolicyToDomain(policy *query.LockoutPolicy) *domain.LockoutPolicy {
 		},
 		Default:             policy.IsDefault,
 		MaxPasswordAttempts: policy.MaxPasswordAttempts,
+		MaxOTPAttempts:      policy.MaxOTPAttempts,
+		MaxU2FAttempts:      policy.MaxU2FAttempts,
 		ShowLockOutFailures: policy.ShowFailures,
+		AutoUnlockAfter:     policy.AutoUnlockAfter,
+		AttemptDelay:        policy.AttemptDelay,
+		MaxAttemptDelay:     policy.MaxAttemptDelay,
+	}
+}
+
+func passwordAgePolicyToDomain(policy *query.PasswordAgePolicy) *domain.PasswordAgePolicy {
+	return &domain.PasswordAgePolicy{
+		ObjectRoot: es_models.ObjectRoot{
+			AggregateID:   policy.ID,
+			Sequence:      policy.Sequence,
+			ResourceOwner: policy.ResourceOwner,
+			CreationDate:  policy.CreationDate,
+			ChangeDate:    policy.ChangeDate,
+		},
+		MaxAgeDays:     policy.MaxAgeDays,
+		ExpireWarnDays: policy.ExpireWarnDays,
 	}
 }
 
@@ -359,6 +388,82 @@ func (repo *AuthRequestRepo) VerifyMFAOTP(ctx context.Context, authRequestID, us
 	return repo.Command.HumanCheckMFAOTP(ctx, userID, code, resourceOwner, request.WithCurrentInfo(info))
 }
 
+func (repo *AuthRequestRepo) SendMFAOTPSMS(ctx context.Context, authRequestID, userID, resourceOwner, userAgentID string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+	request, err := repo.getAuthRequestEnsureUser(ctx, authRequestID, userAgentID, userID)
+	if err != nil {
+		return err
+	}
+	codeGenerator, err := repo.otpCodeGenerator(ctx, domain.SecretGeneratorTypeOTPSMS, domain.SecretGeneratorTypeVerifyPhoneCode)
+	if err != nil {
+		return err
+	}
+	return repo.Command.HumanSendOTPSMS(ctx, userID, resourceOwner, request, codeGenerator)
+}
+
+func (repo *AuthRequestRepo) VerifyMFAOTPSMS(ctx context.Context, authRequestID, userID, resourceOwner, code, userAgentID string, info *domain.BrowserInfo) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+	request, err := repo.getAuthRequestEnsureUser(ctx, authRequestID, userAgentID, userID)
+	if err != nil {
+		return err
+	}
+	codeGenerator, err := repo.otpCodeGenerator(ctx, domain.SecretGeneratorTypeOTPSMS, domain.SecretGeneratorTypeVerifyPhoneCode)
+	if err != nil {
+		return err
+	}
+	return repo.Command.HumanCheckOTPSMS(ctx, userID, code, resourceOwner, request.WithCurrentInfo(info), codeGenerator)
+}
+
+func (repo *AuthRequestRepo) SendMFAOTPEmail(ctx context.Context, authRequestID, userID, resourceOwner, userAgentID string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+	request, err := repo.getAuthRequestEnsureUser(ctx, authRequestID, userAgentID, userID)
+	if err != nil {
+		return err
+	}
+	codeGenerator, err := repo.otpCodeGenerator(ctx, domain.SecretGeneratorTypeOTPEmail, domain.SecretGeneratorTypeVerifyEmailCode)
+	if err != nil {
+		return err
+	}
+	return repo.Command.HumanSendOTPEmail(ctx, userID, resourceOwner, request, codeGenerator)
+}
+
+func (repo *AuthRequestRepo) VerifyMFAOTPEmail(ctx context.Context, authRequestID, userID, resourceOwner, code, userAgentID string, info *domain.BrowserInfo) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+	request, err := repo.getAuthRequestEnsureUser(ctx, authRequestID, userAgentID, userID)
+	if err != nil {
+		return err
+	}
+	codeGenerator, err := repo.otpCodeGenerator(ctx, domain.SecretGeneratorTypeOTPEmail, domain.SecretGeneratorTypeVerifyEmailCode)
+	if err != nil {
+		return err
+	}
+	return repo.Command.HumanCheckOTPEmail(ctx, userID, code, resourceOwner, request.WithCurrentInfo(info), codeGenerator)
+}
+
+func (repo *AuthRequestRepo) VerifyMFARecoveryCode(ctx context.Context, authRequestID, userID, resourceOwner, code, userAgentID string, info *domain.BrowserInfo) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+	request, err := repo.getAuthRequestEnsureUser(ctx, authRequestID, userAgentID, userID)
+	if err != nil {
+		return err
+	}
+	return repo.Command.HumanCheckRecoveryCode(ctx, userID, code, resourceOwner, request.WithCurrentInfo(info))
+}
+
+// otpCodeGenerator falls back to the verification code generator
+// for instances which were set up before the otp generators existed
+func (repo *AuthRequestRepo) otpCodeGenerator(ctx context.Context, generatorType, fallbackType domain.SecretGeneratorType) (crypto.Generator, error) {
+	codeGenerator, err := repo.Query.InitEncryptionGenerator(ctx, generatorType, repo.UserCodeAlg)
+	if errors.IsNotFound(err) {
+		return repo.Query.InitEncryptionGenerator(ctx, fallbackType, repo.UserCodeAlg)
+	}
+	return codeGenerator, err
+}
+
 func (repo *AuthRequestRepo) BeginMFAU2FLogin(ctx context.Context, userID, resourceOwner, authRequestID, userAgentID string) (login *domain.WebAuthNLogin, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
@@ -453,6 +558,16 @@ func (repo *AuthRequestRepo) LinkExternalUsers(ctx context.Context, authReqID, u
 	return repo.AuthRequests.UpdateAuthRequest(ctx, request)
 }
 
+// SkipPasswordExpiryWarning continues the login without changing the password, which expires soon
+func (repo *AuthRequestRepo) SkipPasswordExpiryWarning(ctx context.Context, authReqID, userAgentID string) error {
+	request, err := repo.getAuthRequest(ctx, authReqID, userAgentID)
+	if err != nil {
+		return err
+	}
+	request.PasswordExpiryWarned = true
+	return repo.AuthRequests.UpdateAuthRequest(ctx, request)
+}
+
 func (repo *AuthRequestRepo) ResetLinkingUsers(ctx context.Context, authReqID, userAgentID string) error {
 	request, err := repo.getAuthRequest(ctx, authReqID, userAgentID)
 	if err != nil {
@@ -585,6 +700,11 @@ func (repo *AuthRequestRepo) fillPolicies(ctx context.Context, request *domain.A
 		return err
 	}
 	request.LockoutPolicy = lockoutPolicyToDomain(lockoutPolicy)
+	passwordAgePolicy, err := repo.PasswordAgePolicyProvider.PasswordAgePolicyByOrg(ctx, false, orgID)
+	if err != nil {
+		return err
+	}
+	request.PasswordAgePolicy = passwordAgePolicyToDomain(passwordAgePolicy)
 	privacyPolicy, err := repo.GetPrivacyPolicy(ctx, orgID)
 	if err != nil {
 		return err
@@ -659,7 +779,7 @@ func (repo *AuthRequestRepo) checkLoginName(ctx context.Context, request *domain
 		return err
 	}
 	// if there's an active (human) user, let's use it
-	if user != nil && !user.HumanView.IsZero() && domain.UserState(user.State).NotDisabled() {
+	if user != nil && !user.HumanView.IsZero() && (domain.UserState(user.State).NotDisabled() || lockExpired(ctx, repo.LockoutPolicyViewProvider, user.ResourceOwner, user.LockedAt)) {
 		request.SetUserInfo(user.ID, loginName, user.PreferredLoginName, "", "", user.ResourceOwner)
 		return nil
 	}
@@ -834,6 +954,7 @@ func queryLoginPolicyToDomain(policy *query.LoginPolicy) *domain.LoginPolicy {
 		MFAInitSkipLifetime:        policy.MFAInitSkipLifetime,
 		SecondFactorCheckLifetime:  policy.SecondFactorCheckLifetime,
 		MultiFactorCheckLifetime:   policy.MultiFactorCheckLifetime,
+		TrustedDeviceLifetime:      policy.TrustedDeviceLifetime,
 		DisableLoginWithEmail:      policy.DisableLoginWithEmail,
 		DisableLoginWithPhone:      policy.DisableLoginWithPhone,
 	}
@@ -939,7 +1060,7 @@ func (repo *AuthRequestRepo) nextSteps(ctx context.Context, request *domain.Auth
 		}
 	}
 
-	step, ok, err := repo.mfaChecked(userSession, request, user)
+	step, ok, err := repo.mfaChecked(ctx, userSession, request, user)
 	if err != nil {
 		return nil, err
 	}
@@ -947,8 +1068,10 @@ func (repo *AuthRequestRepo) nextSteps(ctx context.Context, request *domain.Auth
 		return append(steps, step), nil
 	}
 
-	if user.PasswordChangeRequired {
-		steps = append(steps, &domain.ChangePasswordStep{})
+	now := time.Now()
+	passwordExpired := user.PasswordSet && request.PasswordAgePolicy.IsExpired(user.PasswordChanged, now)
+	if user.PasswordChangeRequired || passwordExpired {
+		steps = append(steps, &domain.ChangePasswordStep{Expired: passwordExpired})
 	}
 	if !user.IsEmailVerified {
 		steps = append(steps, &domain.VerifyEMailStep{})
@@ -957,10 +1080,16 @@ func (repo *AuthRequestRepo) nextSteps(ctx context.Context, request *domain.Auth
 		steps = append(steps, &domain.ChangeUsernameStep{})
 	}
 
-	if user.PasswordChangeRequired || !user.IsEmailVerified || user.UsernameChangeRequired {
+	if user.PasswordChangeRequired || passwordExpired || !user.IsEmailVerified || user.UsernameChangeRequired {
 		return steps, nil
 	}
 
+	if !request.PasswordExpiryWarned && user.PasswordSet && request.PasswordAgePolicy.IsWarnDue(user.PasswordChanged, now) {
+		return append(steps, &domain.PasswordExpiryWarningStep{
+			ExpirationDate: request.PasswordAgePolicy.ExpirationDate(user.PasswordChanged),
+		}), nil
+	}
+
 	if request.LinkingUsers != nil && len(request.LinkingUsers) != 0 {
 		return append(steps, &domain.LinkUsersStep{}), nil
 	}
@@ -1058,7 +1187,7 @@ func (repo *AuthRequestRepo) firstFactorChecked(request *domain.AuthRequest, use
 	return &domain.PasswordStep{}
 }
 
-func (repo *AuthRequestRepo) mfaChecked(userSession *user_model.UserSessionView, request *domain.AuthRequest, user *user_model.UserView) (domain.NextStep, bool, error) {
+func (repo *AuthRequestRepo) mfaChecked(ctx context.Context, userSession *user_model.UserSessionView, request *domain.AuthRequest, user *user_model.UserView) (domain.NextStep, bool, error) {
 	mfaLevel := request.MFALevel()
 	allowedProviders, required := user.MFATypesAllowed(mfaLevel, request.LoginPolicy)
 	promptRequired := (user.MFAMaxSetUp < mfaLevel) || (len(allowedProviders) == 0 && required)
@@ -1097,11 +1226,38 @@ func (repo *AuthRequestRepo) mfaChecked(userSession *user_model.UserSessionView,
 			return nil, true, nil
 		}
 	}
+	trusted, err := repo.deviceTrusted(ctx, request, mfaLevel, user.ID)
+	if err != nil {
+		return nil, false, err
+	}
+	if trusted {
+		return nil, true, nil
+	}
 	return &domain.MFAVerificationStep{
 		MFAProviders: allowedProviders,
 	}, false, nil
 }
 
+// deviceTrusted checks if the user trusted the user agent of the request inside the trusted device lifetime,
+// no mfa is added to the verified ones as none was verified on this login.
+// The trust only replaces a second factor and is ignored if the request forces a new login.
+func (repo *AuthRequestRepo) deviceTrusted(ctx context.Context, request *domain.AuthRequest, mfaLevel domain.MFALevel, userID string) (bool, error) {
+	if request.LoginPolicy.TrustedDeviceLifetime <= 0 || request.AgentID == "" {
+		return false, nil
+	}
+	if mfaLevel > domain.MFALevelSecondFactor || domain.IsPrompt(request.Prompt, domain.PromptLogin) {
+		return false, nil
+	}
+	device, err := repo.TrustedDeviceProvider.UserTrustedDeviceByID(ctx, false, userID, request.AgentID)
+	if errors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return checkVerificationTimeMaxAge(device.ChangeDate, request.LoginPolicy.TrustedDeviceLifetime, request), nil
+}
+
 func (repo *AuthRequestRepo) mfaSkippedOrSetUp(user *user_model.UserView, request *domain.AuthRequest) bool {
 	if user.MFAMaxSetUp > domain.MFALevelNotSetUp {
 		return true
@@ -1318,7 +1474,6 @@ func userSessionByIDs(ctx context.Context, provider userSessionViewProvider, eve
 }
 
 func activeUserByID(ctx context.Context, userViewProvider userViewProvider, userEventProvider userEventProvider, queries orgViewProvider, lockoutPolicyProvider lockoutPolicyViewProvider, userID string, ignoreUnknownUsernames bool) (user *user_model.UserView, err error) {
-	// PLANNED: Check LockoutPolicy
 	user, err = userByID(ctx, userViewProvider, userEventProvider, userID)
 	if err != nil {
 		if ignoreUnknownUsernames && errors.IsNotFound(err) {
@@ -1333,6 +1488,10 @@ func activeUserByID(ctx context.Context, userViewProvider userViewProvider, user
 	if user.HumanView == nil {
 		return nil, errors.ThrowPreconditionFailed(nil, "EVENT-Lm69x", "Errors.User.NotHuman")
 	}
+	// the lock of users locked because of failed checks is lifted by the next check after it expired
+	if user.State == user_model.UserStateLocked && lockExpired(ctx, lockoutPolicyProvider, user.ResourceOwner, user.LockedAt) {
+		user.State = user_model.UserStateActive
+	}
 	if user.State == user_model.UserStateLocked || user.State == user_model.UserStateSuspend {
 		return nil, errors.ThrowPreconditionFailed(nil, "EVENT-FJ262", "Errors.User.Locked")
 	}
@@ -1349,6 +1508,20 @@ func activeUserByID(ctx context.Context, userViewProvider userViewProvider, user
 	return user, nil
 }
 
+// lockExpired returns true if the user was locked because of failed checks
+// and the auto unlock duration of the lockout policy passed
+func lockExpired(ctx context.Context, lockoutPolicyProvider lockoutPolicyViewProvider, resourceOwner string, lockedAt time.Time) bool {
+	if lockedAt.IsZero() {
+		return false
+	}
+	policy, err := lockoutPolicyProvider.LockoutPolicyByOrg(ctx, false, resourceOwner)
+	if err != nil {
+		logging.WithFields("resourceOwner", resourceOwner).WithError(err).Warn("unable to get lockout policy for auto unlock")
+		return false
+	}
+	return lockoutPolicyToDomain(policy).IsAutoUnlocked(lockedAt, time.Now())
+}
+
 func userByID(ctx context.Context, viewProvider userViewProvider, eventProvider userEventProvider, userID string) (*user_model.UserView, error) {
 	user, viewErr := viewProvider.UserByID(userID, authz.GetInstance(ctx).InstanceID())
 	if viewErr != nil && !errors.IsNotFound(viewErr) {
@@ -1416,7 +1589,7 @@ func linkingIDPConfigExistingInAllowedIDPs(linkingUsers []*domain.ExternalUser,
 func userGrantRequired(ctx context.Context, request *domain.AuthRequest, user *user_model.UserView, userGrantProvider userGrantProvider) (_ bool, err error) {
 	var project *query.Project
 	switch request.Request.Type() {
-	case domain.AuthRequestTypeOIDC, domain.AuthRequestTypeSAML:
+	case domain.AuthRequestTypeOIDC, domain.AuthRequestTypeSAML, domain.AuthRequestTypeDevice:
 		project, err = userGrantProvider.ProjectByClientID(ctx, request.ApplicationID)
 		if err != nil {
 			return false, err
@@ -1437,7 +1610,7 @@ func userGrantRequired(ctx context.Context, request *domain.AuthRequest, user *u
 func projectRequired(ctx context.Context, request *domain.AuthRequest, projectProvider projectProvider) (_ bool, err error) {
 	var project *query.Project
 	switch request.Request.Type() {
-	case domain.AuthRequestTypeOIDC, domain.AuthRequestTypeSAML:
+	case domain.AuthRequestTypeOIDC, domain.AuthRequestTypeSAML, domain.AuthRequestTypeDevice:
 		project, err = projectProvider.ProjectByClientID(ctx, request.ApplicationID)
 		if err != nil {
 			return false, err
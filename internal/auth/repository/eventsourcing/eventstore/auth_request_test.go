@@ -120,6 +120,7 @@ type mockViewUser struct {
 	PasswordInitRequired     bool
 	PasswordSet              bool
 	PasswordChangeRequired   bool
+	PasswordChanged          time.Time
 	IsEmailVerified          bool
 	OTPState                 int32
 	MFAMaxSetUp              int32
@@ -144,6 +145,17 @@ func (m *mockLockoutPolicy) LockoutPolicyByOrg(context.Context, bool, string) (*
 	return m.policy, nil
 }
 
+type mockTrustedDevice struct {
+	device *query.UserTrustedDevice
+}
+
+func (m *mockTrustedDevice) UserTrustedDeviceByID(context.Context, bool, string, string) (*query.UserTrustedDevice, error) {
+	if m.device == nil {
+		return nil, errors.ThrowNotFound(nil, "id", "device not found")
+	}
+	return m.device, nil
+}
+
 func (m *mockViewUser) UserByID(string, string) (*user_view_model.UserView, error) {
 	return &user_view_model.UserView{
 		State:    int32(user_model.UserStateActive),
@@ -154,6 +166,7 @@ func (m *mockViewUser) UserByID(string, string) (*user_view_model.UserView, erro
 			PasswordInitRequired:     m.PasswordInitRequired,
 			PasswordSet:              m.PasswordSet,
 			PasswordChangeRequired:   m.PasswordChangeRequired,
+			PasswordChanged:          m.PasswordChanged,
 			IsEmailVerified:          m.IsEmailVerified,
 			OTPState:                 m.OTPState,
 			MFAMaxSetUp:              m.MFAMaxSetUp,
@@ -461,6 +474,56 @@ func TestAuthRequestRepo_nextSteps(t *testing.T) {
 			nil,
 			errors.IsPreconditionFailed,
 		},
+		{
+			"user locked by lockout, auto unlock not passed, precondition failed error",
+			fields{
+				userViewProvider: &mockViewUser{},
+				userEventProvider: &mockEventUser{
+					&es_models.Event{
+						AggregateType: user_repo.AggregateType,
+						Type:          es_models.EventType(user_repo.UserLockedType),
+						CreationDate:  time.Now().Add(-time.Minute),
+						Data:          []byte(`{"autoUnlock":true}`),
+					},
+				},
+				orgViewProvider: &mockViewOrg{State: domain.OrgStateActive},
+				lockoutPolicyProvider: &mockLockoutPolicy{
+					policy: &query.LockoutPolicy{
+						AutoUnlockAfter: time.Hour,
+					},
+				},
+			},
+			args{&domain.AuthRequest{UserID: "UserID", LoginPolicy: &domain.LoginPolicy{}}, false},
+			nil,
+			errors.IsPreconditionFailed,
+		},
+		{
+			"user locked by lockout, auto unlock passed, password step",
+			fields{
+				userSessionViewProvider: &mockViewNoUserSession{},
+				userViewProvider: &mockViewUser{
+					PasswordSet: true,
+				},
+				userEventProvider: &mockEventUser{
+					&es_models.Event{
+						AggregateType: user_repo.AggregateType,
+						Type:          es_models.EventType(user_repo.UserLockedType),
+						CreationDate:  time.Now().Add(-2 * time.Hour),
+						Data:          []byte(`{"autoUnlock":true}`),
+					},
+				},
+				orgViewProvider: &mockViewOrg{State: domain.OrgStateActive},
+				lockoutPolicyProvider: &mockLockoutPolicy{
+					policy: &query.LockoutPolicy{
+						AutoUnlockAfter: time.Hour,
+					},
+				},
+				idpUserLinksProvider: &mockIDPUserLinks{},
+			},
+			args{&domain.AuthRequest{UserID: "UserID", LoginPolicy: &domain.LoginPolicy{}}, false},
+			[]domain.NextStep{&domain.PasswordStep{}},
+			nil,
+		},
 		{
 			"org error, internal error",
 			fields{
@@ -990,6 +1053,125 @@ func TestAuthRequestRepo_nextSteps(t *testing.T) {
 			[]domain.NextStep{&domain.ChangePasswordStep{}},
 			nil,
 		},
+		{
+			"password expired, password change step",
+			fields{
+				userSessionViewProvider: &mockViewUserSession{
+					PasswordVerification:     testNow.Add(-5 * time.Minute),
+					SecondFactorVerification: testNow.Add(-5 * time.Minute),
+				},
+				userViewProvider: &mockViewUser{
+					PasswordSet:     true,
+					PasswordChanged: testNow.AddDate(0, 0, -31),
+					IsEmailVerified: true,
+					MFAMaxSetUp:     int32(domain.MFALevelSecondFactor),
+				},
+				userEventProvider: &mockEventUser{},
+				orgViewProvider:   &mockViewOrg{State: domain.OrgStateActive},
+				lockoutPolicyProvider: &mockLockoutPolicy{
+					policy: &query.LockoutPolicy{
+						ShowFailures: true,
+					},
+				},
+				idpUserLinksProvider: &mockIDPUserLinks{},
+			},
+			args{
+				&domain.AuthRequest{
+					UserID: "UserID",
+					LoginPolicy: &domain.LoginPolicy{
+						SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeOTP},
+						PasswordCheckLifetime:     10 * 24 * time.Hour,
+						SecondFactorCheckLifetime: 18 * time.Hour,
+					},
+					PasswordAgePolicy: &domain.PasswordAgePolicy{
+						MaxAgeDays:     30,
+						ExpireWarnDays: 5,
+					},
+				}, false},
+			[]domain.NextStep{&domain.ChangePasswordStep{Expired: true}},
+			nil,
+		},
+		{
+			"password expires soon, password expiry warning step",
+			fields{
+				userSessionViewProvider: &mockViewUserSession{
+					PasswordVerification:     testNow.Add(-5 * time.Minute),
+					SecondFactorVerification: testNow.Add(-5 * time.Minute),
+				},
+				userViewProvider: &mockViewUser{
+					PasswordSet:     true,
+					PasswordChanged: testNow.AddDate(0, 0, -27),
+					IsEmailVerified: true,
+					MFAMaxSetUp:     int32(domain.MFALevelSecondFactor),
+				},
+				userEventProvider: &mockEventUser{},
+				orgViewProvider:   &mockViewOrg{State: domain.OrgStateActive},
+				lockoutPolicyProvider: &mockLockoutPolicy{
+					policy: &query.LockoutPolicy{
+						ShowFailures: true,
+					},
+				},
+				idpUserLinksProvider: &mockIDPUserLinks{},
+			},
+			args{
+				&domain.AuthRequest{
+					UserID: "UserID",
+					LoginPolicy: &domain.LoginPolicy{
+						SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeOTP},
+						PasswordCheckLifetime:     10 * 24 * time.Hour,
+						SecondFactorCheckLifetime: 18 * time.Hour,
+					},
+					PasswordAgePolicy: &domain.PasswordAgePolicy{
+						MaxAgeDays:     30,
+						ExpireWarnDays: 5,
+					},
+				}, false},
+			[]domain.NextStep{&domain.PasswordExpiryWarningStep{ExpirationDate: testNow.AddDate(0, 0, 3)}},
+			nil,
+		},
+		{
+			"password expires soon and warning skipped, redirect to callback step",
+			fields{
+				userSessionViewProvider: &mockViewUserSession{
+					PasswordVerification:     testNow.Add(-5 * time.Minute),
+					SecondFactorVerification: testNow.Add(-5 * time.Minute),
+				},
+				userViewProvider: &mockViewUser{
+					PasswordSet:     true,
+					PasswordChanged: testNow.AddDate(0, 0, -27),
+					IsEmailVerified: true,
+					MFAMaxSetUp:     int32(domain.MFALevelSecondFactor),
+				},
+				userEventProvider:   &mockEventUser{},
+				orgViewProvider:     &mockViewOrg{State: domain.OrgStateActive},
+				userGrantProvider:   &mockUserGrants{},
+				projectProvider:     &mockProject{},
+				applicationProvider: &mockApp{app: &query.App{OIDCConfig: &query.OIDCApp{AppType: domain.OIDCApplicationTypeWeb}}},
+				lockoutPolicyProvider: &mockLockoutPolicy{
+					policy: &query.LockoutPolicy{
+						ShowFailures: true,
+					},
+				},
+				idpUserLinksProvider: &mockIDPUserLinks{},
+			},
+			args{
+				&domain.AuthRequest{
+					UserID:  "UserID",
+					Request: &domain.AuthRequestOIDC{},
+					LoginPolicy: &domain.LoginPolicy{
+						SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeOTP},
+						PasswordCheckLifetime:     10 * 24 * time.Hour,
+						SecondFactorCheckLifetime: 18 * time.Hour,
+					},
+					PasswordAgePolicy: &domain.PasswordAgePolicy{
+						MaxAgeDays:     30,
+						ExpireWarnDays: 5,
+					},
+					PasswordExpiryWarned: true,
+				}, false},
+			[]domain.NextStep{&domain.RedirectToCallbackStep{}},
+			nil,
+		},
 		{
 			"email not verified and no password change required, mail verification step",
 			fields{
@@ -1420,9 +1602,10 @@ func TestAuthRequestRepo_nextSteps(t *testing.T) {
 
 func TestAuthRequestRepo_mfaChecked(t *testing.T) {
 	type args struct {
-		userSession *user_model.UserSessionView
-		request     *domain.AuthRequest
-		user        *user_model.UserView
+		userSession   *user_model.UserSessionView
+		request       *domain.AuthRequest
+		user          *user_model.UserView
+		trustedDevice trustedDeviceProvider
 	}
 	tests := []struct {
 		name        string
@@ -1591,11 +1774,93 @@ func TestAuthRequestRepo_mfaChecked(t *testing.T) {
 			false,
 			nil,
 		},
+		{
+			"not checked, device trusted, true",
+			args{
+				request: &domain.AuthRequest{
+					AgentID: "agentID",
+					LoginPolicy: &domain.LoginPolicy{
+						SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeOTP},
+						SecondFactorCheckLifetime: 18 * time.Hour,
+						TrustedDeviceLifetime:     30 * 24 * time.Hour,
+					},
+				},
+				user: &user_model.UserView{
+					HumanView: &user_model.HumanView{
+						MFAMaxSetUp: domain.MFALevelSecondFactor,
+						OTPState:    user_model.MFAStateReady,
+					},
+				},
+				userSession: &user_model.UserSessionView{},
+				trustedDevice: &mockTrustedDevice{
+					device: &query.UserTrustedDevice{ChangeDate: testNow.Add(-5 * 24 * time.Hour)},
+				},
+			},
+			nil,
+			true,
+			nil,
+		},
+		{
+			"not checked, device trust expired, check and false",
+			args{
+				request: &domain.AuthRequest{
+					AgentID: "agentID",
+					LoginPolicy: &domain.LoginPolicy{
+						SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeOTP},
+						SecondFactorCheckLifetime: 18 * time.Hour,
+						TrustedDeviceLifetime:     30 * 24 * time.Hour,
+					},
+				},
+				user: &user_model.UserView{
+					HumanView: &user_model.HumanView{
+						MFAMaxSetUp: domain.MFALevelSecondFactor,
+						OTPState:    user_model.MFAStateReady,
+					},
+				},
+				userSession: &user_model.UserSessionView{},
+				trustedDevice: &mockTrustedDevice{
+					device: &query.UserTrustedDevice{ChangeDate: testNow.Add(-31 * 24 * time.Hour)},
+				},
+			},
+			&domain.MFAVerificationStep{
+				MFAProviders: []domain.MFAType{domain.MFATypeOTP},
+			},
+			false,
+			nil,
+		},
+		{
+			"not checked, device not trusted, check and false",
+			args{
+				request: &domain.AuthRequest{
+					AgentID: "agentID",
+					LoginPolicy: &domain.LoginPolicy{
+						SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeOTP},
+						SecondFactorCheckLifetime: 18 * time.Hour,
+						TrustedDeviceLifetime:     30 * 24 * time.Hour,
+					},
+				},
+				user: &user_model.UserView{
+					HumanView: &user_model.HumanView{
+						MFAMaxSetUp: domain.MFALevelSecondFactor,
+						OTPState:    user_model.MFAStateReady,
+					},
+				},
+				userSession:   &user_model.UserSessionView{},
+				trustedDevice: &mockTrustedDevice{},
+			},
+			&domain.MFAVerificationStep{
+				MFAProviders: []domain.MFAType{domain.MFATypeOTP},
+			},
+			false,
+			nil,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			repo := &AuthRequestRepo{}
-			got, ok, err := repo.mfaChecked(tt.args.userSession, tt.args.request, tt.args.user)
+			repo := &AuthRequestRepo{
+				TrustedDeviceProvider: tt.args.trustedDevice,
+			}
+			got, ok, err := repo.mfaChecked(context.Background(), tt.args.userSession, tt.args.request, tt.args.user)
 			if (tt.errFunc != nil && !tt.errFunc(err)) || (err != nil && tt.errFunc == nil) {
 				t.Errorf("got wrong err: %v ", err)
 				return
@@ -1608,6 +1873,109 @@ func TestAuthRequestRepo_mfaChecked(t *testing.T) {
 	}
 }
 
+func TestAuthRequestRepo_deviceTrusted(t *testing.T) {
+	maxAge := time.Hour
+	trusted := &mockTrustedDevice{
+		device: &query.UserTrustedDevice{ChangeDate: testNow.Add(-5 * time.Hour)},
+	}
+	type args struct {
+		request  *domain.AuthRequest
+		mfaLevel domain.MFALevel
+	}
+	tests := []struct {
+		name          string
+		trustedDevice trustedDeviceProvider
+		args          args
+		want          bool
+	}{
+		{
+			"lifetime not set, false",
+			trusted,
+			args{
+				request: &domain.AuthRequest{
+					AgentID:     "agentID",
+					LoginPolicy: &domain.LoginPolicy{},
+				},
+				mfaLevel: domain.MFALevelSecondFactor,
+			},
+			false,
+		},
+		{
+			"device not trusted, false",
+			&mockTrustedDevice{},
+			args{
+				request: &domain.AuthRequest{
+					AgentID:     "agentID",
+					LoginPolicy: &domain.LoginPolicy{TrustedDeviceLifetime: 24 * time.Hour},
+				},
+				mfaLevel: domain.MFALevelSecondFactor,
+			},
+			false,
+		},
+		{
+			"multi factor required, false",
+			trusted,
+			args{
+				request: &domain.AuthRequest{
+					AgentID:     "agentID",
+					LoginPolicy: &domain.LoginPolicy{TrustedDeviceLifetime: 24 * time.Hour},
+				},
+				mfaLevel: domain.MFALevelMultiFactor,
+			},
+			false,
+		},
+		{
+			"prompt login, false",
+			trusted,
+			args{
+				request: &domain.AuthRequest{
+					AgentID:     "agentID",
+					Prompt:      []domain.Prompt{domain.PromptLogin},
+					LoginPolicy: &domain.LoginPolicy{TrustedDeviceLifetime: 24 * time.Hour},
+				},
+				mfaLevel: domain.MFALevelSecondFactor,
+			},
+			false,
+		},
+		{
+			"trusted before max age, false",
+			trusted,
+			args{
+				request: &domain.AuthRequest{
+					AgentID:      "agentID",
+					CreationDate: testNow,
+					MaxAuthAge:   &maxAge,
+					LoginPolicy:  &domain.LoginPolicy{TrustedDeviceLifetime: 24 * time.Hour},
+				},
+				mfaLevel: domain.MFALevelSecondFactor,
+			},
+			false,
+		},
+		{
+			"trusted inside lifetime, true",
+			trusted,
+			args{
+				request: &domain.AuthRequest{
+					AgentID:     "agentID",
+					LoginPolicy: &domain.LoginPolicy{TrustedDeviceLifetime: 24 * time.Hour},
+				},
+				mfaLevel: domain.MFALevelSecondFactor,
+			},
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &AuthRequestRepo{
+				TrustedDeviceProvider: tt.trustedDevice,
+			}
+			got, err := repo.deviceTrusted(context.Background(), tt.args.request, tt.args.mfaLevel, "userID")
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func TestAuthRequestRepo_mfaSkippedOrSetUp(t *testing.T) {
 	type fields struct {
 		MFAInitSkippedLifeTime time.Duration
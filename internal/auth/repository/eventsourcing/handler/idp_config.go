@@ -90,7 +90,13 @@ func (i *IDPConfig) processIdpConfig(providerType iam_model.IDPProviderType, eve
 		org.IDPOIDCConfigAddedEventType, instance.IDPOIDCConfigAddedEventType,
 		org.IDPOIDCConfigChangedEventType, instance.IDPOIDCConfigChangedEventType,
 		org.IDPJWTConfigAddedEventType, instance.IDPJWTConfigAddedEventType,
-		org.IDPJWTConfigChangedEventType, instance.IDPJWTConfigChangedEventType:
+		org.IDPJWTConfigChangedEventType, instance.IDPJWTConfigChangedEventType,
+		org.IDPSAMLConfigAddedEventType, instance.IDPSAMLConfigAddedEventType,
+		org.IDPSAMLConfigChangedEventType, instance.IDPSAMLConfigChangedEventType,
+		org.IDPLDAPConfigAddedEventType, instance.IDPLDAPConfigAddedEventType,
+		org.IDPLDAPConfigChangedEventType, instance.IDPLDAPConfigChangedEventType,
+		org.IDPOAuth2ConfigAddedEventType, instance.IDPOAuth2ConfigAddedEventType,
+		org.IDPOAuth2ConfigChangedEventType, instance.IDPOAuth2ConfigChangedEventType:
 		err = idp.SetData(event)
 		if err != nil {
 			return err
@@ -93,6 +93,12 @@ func (u *UserSession) Reduce(event *models.Event) (err error) {
 		user.UserIDPLoginCheckSucceededType,
 		user.HumanMFAOTPCheckSucceededType,
 		user.HumanMFAOTPCheckFailedType,
+		user.HumanOTPSMSCheckSucceededType,
+		user.HumanOTPSMSCheckFailedType,
+		user.HumanOTPEmailCheckSucceededType,
+		user.HumanOTPEmailCheckFailedType,
+		user.HumanRecoveryCodeCheckSucceededType,
+		user.HumanRecoveryCodeCheckFailedType,
 		user.HumanU2FTokenCheckSucceededType,
 		user.HumanU2FTokenCheckFailedType,
 		user.HumanPasswordlessTokenCheckSucceededType,
@@ -124,6 +130,8 @@ func (u *UserSession) Reduce(event *models.Event) (err error) {
 		user.UserDeactivatedType,
 		user.HumanPasswordChangedType,
 		user.HumanMFAOTPRemovedType,
+		user.HumanOTPSMSRemovedType,
+		user.HumanOTPEmailRemovedType,
 		user.HumanProfileChangedType,
 		user.HumanAvatarAddedType,
 		user.HumanAvatarRemovedType,
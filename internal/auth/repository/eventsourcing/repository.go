@@ -82,6 +82,8 @@ func Start(conf Config, systemDefaults sd.SystemDefaults, command *command.Comma
 			IDPProviderViewProvider:   view,
 			IDPUserLinksProvider:      queries,
 			LockoutPolicyViewProvider: queries,
+			TrustedDeviceProvider:     queries,
+			PasswordAgePolicyProvider: queries,
 			LoginPolicyViewProvider:   queries,
 			UserGrantProvider:         queryView,
 			ProjectProvider:           queryView,
@@ -84,32 +84,42 @@ func (repo *TokenVerifierRepo) tokenByID(ctx context.Context, tokenID, userID st
 	return model.TokenViewToModel(token), nil
 }
 
-func (repo *TokenVerifierRepo) VerifyAccessToken(ctx context.Context, tokenString, verifierClientID, projectID string) (userID string, agentID string, clientID, prefLang, resourceOwner string, err error) {
+func (repo *TokenVerifierRepo) VerifyAccessToken(ctx context.Context, tokenString, verifierClientID, projectID string) (userID string, agentID string, clientID, prefLang, resourceOwner string, confirmation *authz.TokenConfirmation, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
 	tokenID, subject, ok := repo.getTokenIDAndSubject(ctx, tokenString)
 	if !ok {
-		return "", "", "", "", "", caos_errs.ThrowUnauthenticated(nil, "APP-Reb32", "invalid token")
+		return "", "", "", "", "", nil, caos_errs.ThrowUnauthenticated(nil, "APP-Reb32", "invalid token")
 	}
 	_, tokenSpan := tracing.NewNamedSpan(ctx, "token")
 	token, err := repo.tokenByID(ctx, tokenID, subject)
 	tokenSpan.EndWithError(err)
 	if err != nil {
-		return "", "", "", "", "", caos_errs.ThrowUnauthenticated(err, "APP-BxUSiL", "invalid token")
+		return "", "", "", "", "", nil, caos_errs.ThrowUnauthenticated(err, "APP-BxUSiL", "invalid token")
 	}
 	if !token.Expiration.After(time.Now().UTC()) {
-		return "", "", "", "", "", caos_errs.ThrowUnauthenticated(err, "APP-k9KS0", "invalid token")
+		return "", "", "", "", "", nil, caos_errs.ThrowUnauthenticated(err, "APP-k9KS0", "invalid token")
 	}
 	if token.IsPAT {
-		return token.UserID, "", "", "", token.ResourceOwner, nil
+		return token.UserID, "", "", "", token.ResourceOwner, nil, nil
 	}
 	for _, aud := range token.Audience {
 		if verifierClientID == aud || projectID == aud {
-			return token.UserID, token.UserAgentID, token.ApplicationID, token.PreferredLanguage, token.ResourceOwner, nil
+			return token.UserID, token.UserAgentID, token.ApplicationID, token.PreferredLanguage, token.ResourceOwner, tokenConfirmation(token), nil
 		}
 	}
-	return "", "", "", "", "", caos_errs.ThrowUnauthenticated(nil, "APP-Zxfako", "invalid audience")
+	return "", "", "", "", "", nil, caos_errs.ThrowUnauthenticated(nil, "APP-Zxfako", "invalid audience")
+}
+
+func tokenConfirmation(token *usr_model.TokenView) *authz.TokenConfirmation {
+	if token.DPoPJKT == "" && token.CertificateThumbprint == "" {
+		return nil
+	}
+	return &authz.TokenConfirmation{
+		DPoPJKT:               token.DPoPJKT,
+		CertificateThumbprint: token.CertificateThumbprint,
+	}
 }
 
 func (repo *TokenVerifierRepo) ProjectIDAndOriginsByClientID(ctx context.Context, clientID string) (projectID string, origins []string, err error) {
@@ -15,18 +15,24 @@ import (
 	"github.com/dennigogo/zitadel/internal/eventstore"
 	"github.com/dennigogo/zitadel/internal/id"
 	"github.com/dennigogo/zitadel/internal/repository/action"
+	"github.com/dennigogo/zitadel/internal/repository/deviceauth"
 	instance_repo "github.com/dennigogo/zitadel/internal/repository/instance"
 	"github.com/dennigogo/zitadel/internal/repository/keypair"
+	"github.com/dennigogo/zitadel/internal/repository/kvstore"
 	"github.com/dennigogo/zitadel/internal/repository/org"
 	proj_repo "github.com/dennigogo/zitadel/internal/repository/project"
+	"github.com/dennigogo/zitadel/internal/repository/pushedauthrequest"
+	"github.com/dennigogo/zitadel/internal/repository/quota"
 	usr_repo "github.com/dennigogo/zitadel/internal/repository/user"
 	usr_grant_repo "github.com/dennigogo/zitadel/internal/repository/usergrant"
+	"github.com/dennigogo/zitadel/internal/repository/webhook"
 	"github.com/dennigogo/zitadel/internal/static"
 	webauthn_helper "github.com/dennigogo/zitadel/internal/webauthn"
 )
 
 type Commands struct {
 	httpClient *http.Client
+	quotas     QuotaEnforcer
 
 	eventstore     *eventstore.Eventstore
 	static         static.Storage
@@ -41,11 +47,14 @@ type Commands struct {
 	smsEncryption               crypto.EncryptionAlgorithm
 	userEncryption              crypto.EncryptionAlgorithm
 	userPasswordAlg             crypto.HashAlgorithm
+	breachedPasswords           crypto.BreachedPasswordChecker
 	machineKeySize              int
 	applicationKeySize          int
 	domainVerificationAlg       crypto.EncryptionAlgorithm
 	domainVerificationGenerator crypto.Generator
 	domainVerificationValidator func(domain, token, verifier string, checkType api_http.CheckType) error
+	webhookSecretGenerator      crypto.Generator
+	recoveryCodeGenerator       crypto.Generator
 
 	multifactors         domain.MultifactorConfigs
 	webauthnConfig       *webauthn_helper.Config
@@ -56,6 +65,8 @@ type Commands struct {
 	privateKeyLifetime   time.Duration
 	publicKeyLifetime    time.Duration
 	certificateLifetime  time.Duration
+
+	samlCertificateAndKeyGenerator func(id string) ([]byte, []byte, error)
 }
 
 func StartCommands(es *eventstore.Eventstore,
@@ -73,8 +84,10 @@ func StartCommands(es *eventstore.Eventstore,
 	userEncryption,
 	domainVerificationEncryption,
 	oidcEncryption,
-	samlEncryption crypto.EncryptionAlgorithm,
+	samlEncryption,
+	webhookEncryption crypto.EncryptionAlgorithm,
 	httpClient *http.Client,
+	quotas QuotaEnforcer,
 ) (repo *Commands, err error) {
 	if externalDomain == "" {
 		return nil, errors.ThrowInvalidArgument(nil, "COMMAND-Df21s", "no external domain specified")
@@ -101,6 +114,7 @@ func StartCommands(es *eventstore.Eventstore,
 		certificateAlgorithm:  samlEncryption,
 		webauthnConfig:        webAuthN,
 		httpClient:            httpClient,
+		quotas:                quotas,
 	}
 
 	instance_repo.RegisterEventMappers(repo.eventstore)
@@ -110,8 +124,20 @@ func StartCommands(es *eventstore.Eventstore,
 	proj_repo.RegisterEventMappers(repo.eventstore)
 	keypair.RegisterEventMappers(repo.eventstore)
 	action.RegisterEventMappers(repo.eventstore)
+	webhook.RegisterEventMappers(repo.eventstore)
+	kvstore.RegisterEventMappers(repo.eventstore)
+	deviceauth.RegisterEventMappers(repo.eventstore)
+	pushedauthrequest.RegisterEventMappers(repo.eventstore)
+	quota.RegisterEventMappers(repo.eventstore)
 
-	repo.userPasswordAlg = crypto.NewBCrypt(defaults.SecretGenerators.PasswordSaltCost)
+	repo.userPasswordAlg, err = defaults.PasswordHasher.NewHasher()
+	if err != nil {
+		return nil, err
+	}
+	repo.breachedPasswords, err = defaults.BreachedPasswords.NewChecker()
+	if err != nil {
+		return nil, err
+	}
 	repo.machineKeySize = int(defaults.SecretGenerators.MachineKeySize)
 	repo.applicationKeySize = int(defaults.SecretGenerators.ApplicationKeySize)
 
@@ -124,6 +150,9 @@ func StartCommands(es *eventstore.Eventstore,
 
 	repo.domainVerificationGenerator = crypto.NewEncryptionGenerator(defaults.DomainVerification.VerificationGenerator, repo.domainVerificationAlg)
 	repo.domainVerificationValidator = api_http.ValidateDomain
+	repo.webhookSecretGenerator = crypto.NewEncryptionGenerator(defaults.Webhooks.SecretGenerator, webhookEncryption)
+	repo.recoveryCodeGenerator = crypto.NewHashGenerator(recoveryCodeGeneratorConfig, crypto.NewSHA256())
+	repo.samlCertificateAndKeyGenerator = samlCertificateAndKeyGenerator(defaults.KeyConfig.Size, defaults.KeyConfig.CertificateLifetime)
 	return repo, nil
 }
 
@@ -0,0 +1,189 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/dennigogo/zitadel/internal/api/authz"
+	"github.com/dennigogo/zitadel/internal/domain"
+	caos_errs "github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/repository/deviceauth"
+	"github.com/dennigogo/zitadel/internal/telemetry/tracing"
+)
+
+// AddDeviceAuth starts a device authorization (RFC 8628) of the client,
+// it returns the id of the created authorization
+func (c *Commands) AddDeviceAuth(ctx context.Context, clientID, deviceCode, userCode string, expires time.Time, scopes []string) (_ string, _ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if clientID == "" || deviceCode == "" || userCode == "" {
+		return "", nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Dv2k9", "Errors.DeviceAuth.Invalid")
+	}
+	id, err := c.idGenerator.Next()
+	if err != nil {
+		return "", nil, err
+	}
+	writeModel := NewDeviceAuthWriteModel(id, authz.GetInstance(ctx).InstanceID())
+	pushedEvents, err := c.eventstore.Push(ctx, deviceauth.NewAddedEvent(
+		ctx,
+		DeviceAuthAggregateFromWriteModel(&writeModel.WriteModel),
+		clientID,
+		deviceCode,
+		userCode,
+		expires,
+		scopes,
+	))
+	if err != nil {
+		return "", nil, err
+	}
+	err = AppendAndReduce(writeModel, pushedEvents...)
+	if err != nil {
+		return "", nil, err
+	}
+	return id, writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// ApproveDeviceAuth approves the device authorization for the authenticated user
+func (c *Commands) ApproveDeviceAuth(ctx context.Context, id, subject, userOrgID string, amr []string, authTime time.Time) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if subject == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Dv8s1", "Errors.User.UserIDMissing")
+	}
+	writeModel, err := c.getPendingDeviceAuthWriteModel(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, deviceauth.NewApprovedEvent(
+		ctx,
+		DeviceAuthAggregateFromWriteModel(&writeModel.WriteModel),
+		subject,
+		userOrgID,
+		amr,
+		authTime,
+	))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(writeModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// DenyDeviceAuth denies the device authorization, the device will receive an access_denied on the next poll
+func (c *Commands) DenyDeviceAuth(ctx context.Context, id string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel, err := c.getPendingDeviceAuthWriteModel(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, deviceauth.NewDeniedEvent(ctx, DeviceAuthAggregateFromWriteModel(&writeModel.WriteModel)))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(writeModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// RemoveDeviceAuth removes the device authorization (e.g. after the tokens were issued)
+// and releases its device and user code
+func (c *Commands) RemoveDeviceAuth(ctx context.Context, id string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel, err := c.getDeviceAuthWriteModel(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.State.Exists() {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Dv4m7", "Errors.DeviceAuth.NotFound")
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, deviceauth.NewRemovedEvent(
+		ctx,
+		DeviceAuthAggregateFromWriteModel(&writeModel.WriteModel),
+		writeModel.DeviceCode,
+		writeModel.UserCode,
+	))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(writeModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// ExchangeDeviceAuth removes the approved device authorization before the tokens are issued to the device.
+// Concurrent exchanges could both push the removed event,
+// so only the one whose event removed the authorization first may issue the tokens.
+func (c *Commands) ExchangeDeviceAuth(ctx context.Context, id string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel, err := c.getDeviceAuthWriteModel(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.State.Exists() {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Dv5k1", "Errors.DeviceAuth.NotFound")
+	}
+	if writeModel.State != domain.DeviceAuthStateApproved {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Dv9w3", "Errors.DeviceAuth.NotApproved")
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, deviceauth.NewRemovedEvent(
+		ctx,
+		DeviceAuthAggregateFromWriteModel(&writeModel.WriteModel),
+		writeModel.DeviceCode,
+		writeModel.UserCode,
+	))
+	if err != nil {
+		return nil, err
+	}
+	exchanged, err := c.getDeviceAuthWriteModel(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if exchanged.RemovedSequence != pushedEvents[0].Sequence() {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Dv2j6", "Errors.DeviceAuth.NotFound")
+	}
+	return writeModelToObjectDetails(&exchanged.WriteModel), nil
+}
+
+func (c *Commands) getPendingDeviceAuthWriteModel(ctx context.Context, id string) (*DeviceAuthWriteModel, error) {
+	writeModel, err := c.getDeviceAuthWriteModel(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.State.Exists() {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Dv6n2", "Errors.DeviceAuth.NotFound")
+	}
+	if writeModel.State != domain.DeviceAuthStateInitiated {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Dv3p5", "Errors.DeviceAuth.AlreadyHandled")
+	}
+	if writeModel.isExpired() {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Dv7x4", "Errors.DeviceAuth.Expired")
+	}
+	return writeModel, nil
+}
+
+func (c *Commands) getDeviceAuthWriteModel(ctx context.Context, id string) (*DeviceAuthWriteModel, error) {
+	if id == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Dv1q8", "Errors.IDMissing")
+	}
+	writeModel := NewDeviceAuthWriteModel(id, authz.GetInstance(ctx).InstanceID())
+	err := c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
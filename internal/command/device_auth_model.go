@@ -0,0 +1,78 @@
+package command
+
+import (
+	"time"
+
+	"github.com/dennigogo/zitadel/internal/domain"
+	"github.com/dennigogo/zitadel/internal/eventstore"
+	"github.com/dennigogo/zitadel/internal/repository/deviceauth"
+)
+
+type DeviceAuthWriteModel struct {
+	eventstore.WriteModel
+
+	ClientID   string
+	DeviceCode string
+	UserCode   string
+	Expires    time.Time
+	Scopes     []string
+	State      domain.DeviceAuthState
+	// RemovedSequence is the sequence of the first removed event,
+	// the following ones were pushed concurrently
+	RemovedSequence uint64
+}
+
+func NewDeviceAuthWriteModel(id, instanceID string) *DeviceAuthWriteModel {
+	return &DeviceAuthWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   id,
+			ResourceOwner: instanceID,
+		},
+	}
+}
+
+func (wm *DeviceAuthWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *deviceauth.AddedEvent:
+			wm.ClientID = e.ClientID
+			wm.DeviceCode = e.DeviceCode
+			wm.UserCode = e.UserCode
+			wm.Expires = e.Expires
+			wm.Scopes = e.Scopes
+			wm.State = domain.DeviceAuthStateInitiated
+		case *deviceauth.ApprovedEvent:
+			wm.State = domain.DeviceAuthStateApproved
+		case *deviceauth.DeniedEvent:
+			wm.State = domain.DeviceAuthStateDenied
+		case *deviceauth.RemovedEvent:
+			if wm.State != domain.DeviceAuthStateRemoved {
+				wm.RemovedSequence = e.Sequence()
+			}
+			wm.State = domain.DeviceAuthStateRemoved
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *DeviceAuthWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(deviceauth.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			deviceauth.AddedEventType,
+			deviceauth.ApprovedEventType,
+			deviceauth.DeniedEventType,
+			deviceauth.RemovedEventType).
+		Builder()
+}
+
+func (wm *DeviceAuthWriteModel) isExpired() bool {
+	return wm.Expires.Before(time.Now())
+}
+
+func DeviceAuthAggregateFromWriteModel(wm *eventstore.WriteModel) *eventstore.Aggregate {
+	return eventstore.AggregateFromWriteModel(wm, deviceauth.AggregateType, deviceauth.AggregateVersion)
+}
@@ -0,0 +1,566 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dennigogo/zitadel/internal/api/authz"
+	"github.com/dennigogo/zitadel/internal/domain"
+	caos_errs "github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/eventstore"
+	"github.com/dennigogo/zitadel/internal/eventstore/repository"
+	"github.com/dennigogo/zitadel/internal/id"
+	id_mock "github.com/dennigogo/zitadel/internal/id/mock"
+	"github.com/dennigogo/zitadel/internal/repository/deviceauth"
+)
+
+func TestCommandSide_AddDeviceAuth(t *testing.T) {
+	expires := time.Now().Add(5 * time.Minute).UTC()
+	type fields struct {
+		eventstore  *eventstore.Eventstore
+		idGenerator id.Generator
+	}
+	type args struct {
+		ctx        context.Context
+		clientID   string
+		deviceCode string
+		userCode   string
+	}
+	type res struct {
+		id   string
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "user code missing, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx:        authz.WithInstanceID(context.Background(), "instance1"),
+				clientID:   "client1",
+				deviceCode: "devicecode",
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "add device auth, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusherWithInstanceID("instance1",
+								deviceauth.NewAddedEvent(context.Background(),
+									&deviceauth.NewAggregate("device1", "instance1").Aggregate,
+									"client1",
+									"devicecode",
+									"ABCD-EFGH",
+									expires,
+									[]string{"openid"},
+								),
+							),
+						},
+						uniqueConstraintsFromEventConstraintWithInstanceID("instance1", deviceauth.NewAddUniqueConstraints("devicecode", "ABCD-EFGH")[0]),
+						uniqueConstraintsFromEventConstraintWithInstanceID("instance1", deviceauth.NewAddUniqueConstraints("devicecode", "ABCD-EFGH")[1]),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "device1"),
+			},
+			args: args{
+				ctx:        authz.WithInstanceID(context.Background(), "instance1"),
+				clientID:   "client1",
+				deviceCode: "devicecode",
+				userCode:   "ABCD-EFGH",
+			},
+			res: res{
+				id: "device1",
+				want: &domain.ObjectDetails{
+					ResourceOwner: "instance1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:  tt.fields.eventstore,
+				idGenerator: tt.fields.idGenerator,
+			}
+			gotID, got, err := r.AddDeviceAuth(tt.args.ctx, tt.args.clientID, tt.args.deviceCode, tt.args.userCode, expires, []string{"openid"})
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.id, gotID)
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_ApproveDeviceAuth(t *testing.T) {
+	authTime := time.Now().UTC()
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx     context.Context
+		id      string
+		subject string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "not found, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:     authz.WithInstanceID(context.Background(), "instance1"),
+				id:      "device1",
+				subject: "user1",
+			},
+			res: res{
+				err: caos_errs.IsNotFound,
+			},
+		},
+		{
+			name: "expired, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							deviceAuthAddedEvent(time.Now().Add(-time.Minute)),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:     authz.WithInstanceID(context.Background(), "instance1"),
+				id:      "device1",
+				subject: "user1",
+			},
+			res: res{
+				err: caos_errs.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "already denied, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							deviceAuthAddedEvent(time.Now().Add(time.Minute)),
+						),
+						eventFromEventPusher(
+							deviceauth.NewDeniedEvent(context.Background(),
+								&deviceauth.NewAggregate("device1", "instance1").Aggregate,
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:     authz.WithInstanceID(context.Background(), "instance1"),
+				id:      "device1",
+				subject: "user1",
+			},
+			res: res{
+				err: caos_errs.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "approve, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							deviceAuthAddedEvent(time.Now().Add(time.Minute)),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusherWithInstanceID("instance1",
+								deviceauth.NewApprovedEvent(context.Background(),
+									&deviceauth.NewAggregate("device1", "instance1").Aggregate,
+									"user1",
+									"org1",
+									[]string{"pwd"},
+									authTime,
+								),
+							),
+						},
+					),
+				),
+			},
+			args: args{
+				ctx:     authz.WithInstanceID(context.Background(), "instance1"),
+				id:      "device1",
+				subject: "user1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "instance1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := r.ApproveDeviceAuth(tt.args.ctx, tt.args.id, tt.args.subject, "org1", []string{"pwd"}, authTime)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_RemoveDeviceAuth(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx context.Context
+		id  string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "id missing, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "instance1"),
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "already removed, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							deviceAuthAddedEvent(time.Now().Add(time.Minute)),
+						),
+						eventFromEventPusher(
+							deviceauth.NewRemovedEvent(context.Background(),
+								&deviceauth.NewAggregate("device1", "instance1").Aggregate,
+								"devicecode",
+								"ABCD-EFGH",
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "instance1"),
+				id:  "device1",
+			},
+			res: res{
+				err: caos_errs.IsNotFound,
+			},
+		},
+		{
+			name: "remove, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							deviceAuthAddedEvent(time.Now().Add(time.Minute)),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusherWithInstanceID("instance1",
+								deviceauth.NewRemovedEvent(context.Background(),
+									&deviceauth.NewAggregate("device1", "instance1").Aggregate,
+									"devicecode",
+									"ABCD-EFGH",
+								),
+							),
+						},
+						uniqueConstraintsFromEventConstraintWithInstanceID("instance1", deviceauth.NewRemoveUniqueConstraints("devicecode", "ABCD-EFGH")[0]),
+						uniqueConstraintsFromEventConstraintWithInstanceID("instance1", deviceauth.NewRemoveUniqueConstraints("devicecode", "ABCD-EFGH")[1]),
+					),
+				),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "instance1"),
+				id:  "device1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "instance1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := r.RemoveDeviceAuth(tt.args.ctx, tt.args.id)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_ExchangeDeviceAuth(t *testing.T) {
+	authTime := time.Now().UTC()
+	approvedEvent := func() *repository.Event {
+		return eventFromEventPusher(
+			deviceauth.NewApprovedEvent(context.Background(),
+				&deviceauth.NewAggregate("device1", "instance1").Aggregate,
+				"user1",
+				"org1",
+				[]string{"pwd"},
+				authTime,
+			),
+		)
+	}
+	removedEvent := func(sequence uint64) *repository.Event {
+		event := eventFromEventPusher(
+			deviceauth.NewRemovedEvent(context.Background(),
+				&deviceauth.NewAggregate("device1", "instance1").Aggregate,
+				"devicecode",
+				"ABCD-EFGH",
+			),
+		)
+		event.Sequence = sequence
+		return event
+	}
+	expectRemovedPush := expectPush(
+		[]*repository.Event{
+			eventFromEventPusherWithInstanceID("instance1",
+				deviceauth.NewRemovedEvent(context.Background(),
+					&deviceauth.NewAggregate("device1", "instance1").Aggregate,
+					"devicecode",
+					"ABCD-EFGH",
+				),
+			),
+		},
+		uniqueConstraintsFromEventConstraintWithInstanceID("instance1", deviceauth.NewRemoveUniqueConstraints("devicecode", "ABCD-EFGH")[0]),
+		uniqueConstraintsFromEventConstraintWithInstanceID("instance1", deviceauth.NewRemoveUniqueConstraints("devicecode", "ABCD-EFGH")[1]),
+	)
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx context.Context
+		id  string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "id missing, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "instance1"),
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "already exchanged, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							deviceAuthAddedEvent(time.Now().Add(time.Minute)),
+						),
+						approvedEvent(),
+						removedEvent(5),
+					),
+				),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "instance1"),
+				id:  "device1",
+			},
+			res: res{
+				err: caos_errs.IsNotFound,
+			},
+		},
+		{
+			name: "not approved, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							deviceAuthAddedEvent(time.Now().Add(time.Minute)),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "instance1"),
+				id:  "device1",
+			},
+			res: res{
+				err: caos_errs.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "exchanged concurrently, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							deviceAuthAddedEvent(time.Now().Add(time.Minute)),
+						),
+						approvedEvent(),
+					),
+					expectRemovedPush,
+					expectFilter(
+						eventFromEventPusher(
+							deviceAuthAddedEvent(time.Now().Add(time.Minute)),
+						),
+						approvedEvent(),
+						removedEvent(5),
+						removedEvent(0),
+					),
+				),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "instance1"),
+				id:  "device1",
+			},
+			res: res{
+				err: caos_errs.IsNotFound,
+			},
+		},
+		{
+			name: "exchange, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							deviceAuthAddedEvent(time.Now().Add(time.Minute)),
+						),
+						approvedEvent(),
+					),
+					expectRemovedPush,
+					expectFilter(
+						eventFromEventPusher(
+							deviceAuthAddedEvent(time.Now().Add(time.Minute)),
+						),
+						approvedEvent(),
+						removedEvent(0),
+					),
+				),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "instance1"),
+				id:  "device1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "instance1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := r.ExchangeDeviceAuth(tt.args.ctx, tt.args.id)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func deviceAuthAddedEvent(expires time.Time) *deviceauth.AddedEvent {
+	return deviceauth.NewAddedEvent(context.Background(),
+		&deviceauth.NewAggregate("device1", "instance1").Aggregate,
+		"client1",
+		"devicecode",
+		"ABCD-EFGH",
+		expires,
+		[]string{"openid"},
+	)
+}
@@ -0,0 +1,75 @@
+package command
+
+import (
+	"crypto/rand"
+	"crypto/x509"
+	"math/big"
+	"time"
+
+	"github.com/zitadel/saml/pkg/provider/xml"
+
+	"github.com/dennigogo/zitadel/internal/crypto"
+	"github.com/dennigogo/zitadel/internal/domain"
+	caos_errs "github.com/dennigogo/zitadel/internal/errors"
+)
+
+// samlIDPMetadata resolves the metadata of the SAML identity provider,
+// if only a URL is provided the metadata is read from it
+// either way the metadata has to contain an IDPSSODescriptor
+func (c *Commands) samlIDPMetadata(config *domain.SAMLIDPConfig) ([]byte, error) {
+	if !config.IsValid() {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "IDP-3nd9f", "Errors.IDPConfig.SAMLMetadataMissing")
+	}
+	metadata := config.Metadata
+	if config.MetadataURL != "" {
+		data, err := xml.ReadMetadataFromURL(c.httpClient, config.MetadataURL)
+		if err != nil {
+			return nil, caos_errs.ThrowInvalidArgument(err, "IDP-9fk3s", "Errors.IDPConfig.SAMLMetadataMissing")
+		}
+		metadata = data
+	}
+	entity, err := xml.ParseMetadataXmlIntoStruct(metadata)
+	if err != nil {
+		return nil, caos_errs.ThrowInvalidArgument(err, "IDP-2mf0s", "Errors.IDPConfig.SAMLMetadataFormat")
+	}
+	if entity.IDPSSODescriptor == nil {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "IDP-m0f3s", "Errors.IDPConfig.SAMLMetadataFormat")
+	}
+	return metadata, nil
+}
+
+// samlIDPKeyPair generates the key pair and certificate used by ZITADEL as service provider
+// the private key is stored encrypted
+func (c *Commands) samlIDPKeyPair(idpConfigID string) (*crypto.CryptoValue, []byte, error) {
+	key, certificate, err := c.samlCertificateAndKeyGenerator(idpConfigID)
+	if err != nil {
+		return nil, nil, err
+	}
+	encryptedKey, err := crypto.Encrypt(key, c.idpConfigEncryption)
+	if err != nil {
+		return nil, nil, err
+	}
+	return encryptedKey, certificate, nil
+}
+
+func samlCertificateAndKeyGenerator(keySize int, lifetime time.Duration) func(id string) ([]byte, []byte, error) {
+	return func(id string) ([]byte, []byte, error) {
+		serial, err := rand.Int(rand.Reader, big.NewInt(1000))
+		if err != nil {
+			return nil, nil, err
+		}
+		now := time.Now().UTC()
+		privateKey, _, certificate, err := crypto.GenerateCACertificate(keySize, &crypto.CertificateInformations{
+			SerialNumber: serial,
+			Organisation: []string{"ZITADEL"},
+			CommonName:   id,
+			NotBefore:    now,
+			NotAfter:     now.Add(lifetime),
+			KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		return crypto.PrivateKeyToBytes(privateKey), certificate, nil
+	}
+}
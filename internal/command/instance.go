@@ -47,6 +47,8 @@ type InstanceSetup struct {
 		PasswordVerificationCode *crypto.GeneratorConfig
 		PasswordlessInitCode     *crypto.GeneratorConfig
 		DomainVerification       *crypto.GeneratorConfig
+		OTPSMS                   *crypto.GeneratorConfig
+		OTPEmail                 *crypto.GeneratorConfig
 	}
 	PasswordComplexityPolicy struct {
 		MinLength    uint64
@@ -54,10 +56,13 @@ type InstanceSetup struct {
 		HasUppercase bool
 		HasNumber    bool
 		HasSymbol    bool
+		// BreachedPasswordCheck is 0 (disabled), 1 (warn) or 2 (reject)
+		BreachedPasswordCheck domain.BreachedPasswordCheck
 	}
 	PasswordAgePolicy struct {
 		ExpireWarnDays uint64
 		MaxAgeDays     uint64
+		HistoryCount   uint64
 	}
 	DomainPolicy struct {
 		UserLoginMustBeDomain                  bool
@@ -81,6 +86,7 @@ type InstanceSetup struct {
 		MfaInitSkipLifetime        time.Duration
 		SecondFactorCheckLifetime  time.Duration
 		MultiFactorCheckLifetime   time.Duration
+		TrustedDeviceLifetime      time.Duration
 	}
 	PrivacyPolicy struct {
 		TOSLink     string
@@ -102,7 +108,12 @@ type InstanceSetup struct {
 	}
 	LockoutPolicy struct {
 		MaxAttempts              uint64
+		MaxOTPAttempts           uint64
+		MaxU2FAttempts           uint64
 		ShouldShowLockoutFailure bool
+		AutoUnlockAfter          time.Duration
+		AttemptDelay             time.Duration
+		MaxAttemptDelay          time.Duration
 	}
 	EmailTemplate     []byte
 	MessageTexts      []*domain.CustomMessageText
@@ -192,6 +203,8 @@ func (c *Commands) SetUpInstance(ctx context.Context, setup *InstanceSetup) (str
 		prepareAddSecretGeneratorConfig(instanceAgg, domain.SecretGeneratorTypePasswordResetCode, setup.SecretGenerators.PasswordVerificationCode),
 		prepareAddSecretGeneratorConfig(instanceAgg, domain.SecretGeneratorTypePasswordlessInitCode, setup.SecretGenerators.PasswordlessInitCode),
 		prepareAddSecretGeneratorConfig(instanceAgg, domain.SecretGeneratorTypeVerifyDomain, setup.SecretGenerators.DomainVerification),
+		prepareAddSecretGeneratorConfig(instanceAgg, domain.SecretGeneratorTypeOTPSMS, setup.SecretGenerators.OTPSMS),
+		prepareAddSecretGeneratorConfig(instanceAgg, domain.SecretGeneratorTypeOTPEmail, setup.SecretGenerators.OTPEmail),
 
 		prepareAddDefaultPasswordComplexityPolicy(
 			instanceAgg,
@@ -200,11 +213,14 @@ func (c *Commands) SetUpInstance(ctx context.Context, setup *InstanceSetup) (str
 			setup.PasswordComplexityPolicy.HasUppercase,
 			setup.PasswordComplexityPolicy.HasNumber,
 			setup.PasswordComplexityPolicy.HasSymbol,
+			setup.PasswordComplexityPolicy.BreachedPasswordCheck,
+			c.breachedPasswords,
 		),
 		prepareAddDefaultPasswordAgePolicy(
 			instanceAgg,
 			setup.PasswordAgePolicy.ExpireWarnDays,
 			setup.PasswordAgePolicy.MaxAgeDays,
+			setup.PasswordAgePolicy.HistoryCount,
 		),
 		prepareAddDefaultDomainPolicy(
 			instanceAgg,
@@ -230,13 +246,23 @@ func (c *Commands) SetUpInstance(ctx context.Context, setup *InstanceSetup) (str
 			setup.LoginPolicy.MfaInitSkipLifetime,
 			setup.LoginPolicy.SecondFactorCheckLifetime,
 			setup.LoginPolicy.MultiFactorCheckLifetime,
+			setup.LoginPolicy.TrustedDeviceLifetime,
 		),
 		prepareAddSecondFactorToDefaultLoginPolicy(instanceAgg, domain.SecondFactorTypeOTP),
 		prepareAddSecondFactorToDefaultLoginPolicy(instanceAgg, domain.SecondFactorTypeU2F),
 		prepareAddMultiFactorToDefaultLoginPolicy(instanceAgg, domain.MultiFactorTypeU2FWithPIN),
 
 		prepareAddDefaultPrivacyPolicy(instanceAgg, setup.PrivacyPolicy.TOSLink, setup.PrivacyPolicy.PrivacyLink, setup.PrivacyPolicy.HelpLink),
-		prepareAddDefaultLockoutPolicy(instanceAgg, setup.LockoutPolicy.MaxAttempts, setup.LockoutPolicy.ShouldShowLockoutFailure),
+		prepareAddDefaultLockoutPolicy(
+			instanceAgg,
+			setup.LockoutPolicy.MaxAttempts,
+			setup.LockoutPolicy.MaxOTPAttempts,
+			setup.LockoutPolicy.MaxU2FAttempts,
+			setup.LockoutPolicy.ShouldShowLockoutFailure,
+			setup.LockoutPolicy.AutoUnlockAfter,
+			setup.LockoutPolicy.AttemptDelay,
+			setup.LockoutPolicy.MaxAttemptDelay,
+		),
 
 		prepareAddDefaultLabelPolicy(
 			instanceAgg,
@@ -283,9 +309,11 @@ func (c *Commands) SetUpInstance(ctx context.Context, setup *InstanceSetup) (str
 	}
 
 	validations = append(validations,
-		AddOrgCommand(ctx, orgAgg, setup.Org.Name),
+		AddOrgCommand(ctx, orgAgg, setup.Org.Name, nil),
 		c.prepareSetDefaultOrg(instanceAgg, orgAgg.ID),
-		AddHumanCommand(userAgg, &setup.Org.Human, c.userPasswordAlg, c.userEncryption),
+		// the initial password of the first user is configured and has to be changed on the first login,
+		// so it's not checked against the breached passwords
+		AddHumanCommand(userAgg, &setup.Org.Human, c.userPasswordAlg, c.userEncryption, nil, nil),
 		c.AddOrgMemberCommand(orgAgg, userID, domain.RoleOrgOwner),
 		c.AddInstanceMemberCommand(instanceAgg, userID, domain.RoleIAMOwner),
 
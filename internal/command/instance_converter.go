@@ -41,6 +41,7 @@ func writeModelToLoginPolicy(wm *LoginPolicyWriteModel) *domain.LoginPolicy {
 		MFAInitSkipLifetime:        wm.MFAInitSkipLifetime,
 		SecondFactorCheckLifetime:  wm.SecondFactorCheckLifetime,
 		MultiFactorCheckLifetime:   wm.MultiFactorCheckLifetime,
+		TrustedDeviceLifetime:      wm.TrustedDeviceLifetime,
 	}
 }
 
@@ -99,6 +100,7 @@ func writeModelToPasswordAgePolicy(wm *PasswordAgePolicyWriteModel) *domain.Pass
 		ObjectRoot:     writeModelToObjectRoot(wm.WriteModel),
 		MaxAgeDays:     wm.MaxAgeDays,
 		ExpireWarnDays: wm.ExpireWarnDays,
+		HistoryCount:   wm.HistoryCount,
 	}
 }
 
@@ -110,6 +112,8 @@ func writeModelToPasswordComplexityPolicy(wm *PasswordComplexityPolicyWriteModel
 		HasUppercase: wm.HasUppercase,
 		HasNumber:    wm.HasNumber,
 		HasSymbol:    wm.HasSymbol,
+
+		BreachedPasswordCheck: wm.BreachedPasswordCheck,
 	}
 }
 
@@ -117,7 +121,12 @@ func writeModelToLockoutPolicy(wm *LockoutPolicyWriteModel) *domain.LockoutPolic
 	return &domain.LockoutPolicy{
 		ObjectRoot:          writeModelToObjectRoot(wm.WriteModel),
 		MaxPasswordAttempts: wm.MaxPasswordAttempts,
+		MaxOTPAttempts:      wm.MaxOTPAttempts,
+		MaxU2FAttempts:      wm.MaxU2FAttempts,
 		ShowLockOutFailures: wm.ShowLockOutFailures,
+		AutoUnlockAfter:     wm.AutoUnlockAfter,
+		AttemptDelay:        wm.AttemptDelay,
+		MaxAttemptDelay:     wm.MaxAttemptDelay,
 	}
 }
 
@@ -166,6 +175,43 @@ func writeModelToIDPJWTConfig(wm *JWTConfigWriteModel) *domain.JWTIDPConfig {
 	}
 }
 
+func writeModelToIDPSAMLConfig(wm *SAMLConfigWriteModel) *domain.SAMLIDPConfig {
+	return &domain.SAMLIDPConfig{
+		ObjectRoot:        writeModelToObjectRoot(wm.WriteModel),
+		IDPConfigID:       wm.IDPConfigID,
+		Metadata:          wm.Metadata,
+		MetadataURL:       wm.MetadataURL,
+		Certificate:       wm.Certificate,
+		WithSignedRequest: wm.WithSignedRequest,
+	}
+}
+
+func writeModelToIDPLDAPConfig(wm *LDAPConfigWriteModel) *domain.LDAPIDPConfig {
+	return &domain.LDAPIDPConfig{
+		ObjectRoot:  writeModelToObjectRoot(wm.WriteModel),
+		IDPConfigID: wm.IDPConfigID,
+		URL:         wm.URL,
+		StartTLS:    wm.StartTLS,
+		BaseDN:      wm.BaseDN,
+		BindDN:      wm.BindDN,
+		UserFilter:  wm.UserFilter,
+		Attributes:  wm.Attributes,
+	}
+}
+
+func writeModelToIDPOAuth2Config(wm *OAuth2ConfigWriteModel) *domain.OAuth2IDPConfig {
+	return &domain.OAuth2IDPConfig{
+		ObjectRoot:            writeModelToObjectRoot(wm.WriteModel),
+		IDPConfigID:           wm.IDPConfigID,
+		ClientID:              wm.ClientID,
+		AuthorizationEndpoint: wm.AuthorizationEndpoint,
+		TokenEndpoint:         wm.TokenEndpoint,
+		UserEndpoint:          wm.UserEndpoint,
+		Scopes:                wm.Scopes,
+		Mapping:               wm.Mapping,
+	}
+}
+
 func writeModelToIDPProvider(wm *IdentityProviderWriteModel) *domain.IDPProvider {
 	return &domain.IDPProvider{
 		ObjectRoot:  writeModelToObjectRoot(wm.WriteModel),
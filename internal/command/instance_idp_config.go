@@ -13,7 +13,7 @@ import (
 )
 
 func (c *Commands) AddDefaultIDPConfig(ctx context.Context, config *domain.IDPConfig) (*domain.IDPConfig, error) {
-	if config.OIDCConfig == nil && config.JWTConfig == nil {
+	if config.OIDCConfig == nil && config.JWTConfig == nil && config.SAMLConfig == nil && config.LDAPConfig == nil && config.OAuth2Config == nil {
 		return nil, errors.ThrowInvalidArgument(nil, "IDP-s8nn3", "Errors.IDPConfig.Invalid")
 	}
 	idpConfigID, err := c.idGenerator.Next()
@@ -63,6 +63,65 @@ func (c *Commands) AddDefaultIDPConfig(ctx context.Context, config *domain.IDPCo
 			config.JWTConfig.KeysEndpoint,
 			config.JWTConfig.HeaderName,
 		))
+	} else if config.SAMLConfig != nil {
+		metadata, err := c.samlIDPMetadata(config.SAMLConfig)
+		if err != nil {
+			return nil, err
+		}
+		key, certificate, err := c.samlIDPKeyPair(idpConfigID)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, instance.NewIDPSAMLConfigAddedEvent(
+			ctx,
+			instanceAgg,
+			idpConfigID,
+			metadata,
+			config.SAMLConfig.MetadataURL,
+			key,
+			certificate,
+			config.SAMLConfig.WithSignedRequest,
+		))
+	} else if config.LDAPConfig != nil {
+		if !config.LDAPConfig.IsValid() {
+			return nil, errors.ThrowInvalidArgument(nil, "INSTANCE-Lf7s2", "Errors.IDPConfig.Invalid")
+		}
+		bindPassword, err := crypto.Crypt([]byte(config.LDAPConfig.BindPasswordString), c.idpConfigEncryption)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, instance.NewIDPLDAPConfigAddedEvent(
+			ctx,
+			instanceAgg,
+			idpConfigID,
+			config.LDAPConfig.URL,
+			config.LDAPConfig.StartTLS,
+			config.LDAPConfig.BaseDN,
+			config.LDAPConfig.BindDN,
+			bindPassword,
+			config.LDAPConfig.UserFilter,
+			ldapAttributesFromDomain(config.LDAPConfig.Attributes),
+		))
+	} else if config.OAuth2Config != nil {
+		if !config.OAuth2Config.IsValid() {
+			return nil, errors.ThrowInvalidArgument(nil, "INSTANCE-Ob8n3", "Errors.IDPConfig.Invalid")
+		}
+		clientSecret, err := crypto.Crypt([]byte(config.OAuth2Config.ClientSecretString), c.idpConfigEncryption)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, instance.NewIDPOAuth2ConfigAddedEvent(
+			ctx,
+			instanceAgg,
+			idpConfigID,
+			config.OAuth2Config.ClientID,
+			clientSecret,
+			config.OAuth2Config.AuthorizationEndpoint,
+			config.OAuth2Config.TokenEndpoint,
+			config.OAuth2Config.UserEndpoint,
+			config.OAuth2Config.Scopes,
+			oauth2UserMappingFromDomain(config.OAuth2Config.Mapping),
+		))
 	}
 	pushedEvents, err := c.eventstore.Push(ctx, events...)
 	if err != nil {
@@ -25,6 +25,7 @@ func TestCommandSide_AddDefaultIDPConfig(t *testing.T) {
 		eventstore   *eventstore.Eventstore
 		idGenerator  id.Generator
 		secretCrypto crypto.EncryptionAlgorithm
+		samlKeyPair  func(id string) ([]byte, []byte, error)
 	}
 	type args struct {
 		ctx    context.Context
@@ -195,6 +196,287 @@ func TestCommandSide_AddDefaultIDPConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "idp config saml invalid metadata, invalid argument error",
+			fields: fields{
+				eventstore:   eventstoreExpect(t),
+				idGenerator:  id_mock.NewIDGeneratorExpectIDs(t, "config1"),
+				secretCrypto: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				config: &domain.IDPConfig{
+					Name:        "name1",
+					StylingType: domain.IDPConfigStylingTypeGoogle,
+					SAMLConfig: &domain.SAMLIDPConfig{
+						Metadata: []byte("metadata"),
+					},
+				},
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "idp config saml add, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusherWithInstanceID(
+								"INSTANCE",
+								instance.NewIDPConfigAddedEvent(context.Background(),
+									&instance.NewAggregate("INSTANCE").Aggregate,
+									"config1",
+									"name1",
+									domain.IDPConfigTypeSAML,
+									domain.IDPConfigStylingTypeGoogle,
+									false,
+								),
+							),
+							eventFromEventPusherWithInstanceID(
+								"INSTANCE",
+								instance.NewIDPSAMLConfigAddedEvent(context.Background(),
+									&instance.NewAggregate("INSTANCE").Aggregate,
+									"config1",
+									testIDPMetadata,
+									"",
+									&crypto.CryptoValue{
+										CryptoType: crypto.TypeEncryption,
+										Algorithm:  "enc",
+										KeyID:      "id",
+										Crypted:    []byte("key"),
+									},
+									[]byte("certificate"),
+									true,
+								),
+							),
+						},
+						uniqueConstraintsFromEventConstraintWithInstanceID("INSTANCE", idpconfig.NewAddIDPConfigNameUniqueConstraint("name1", "INSTANCE")),
+					),
+				),
+				idGenerator:  id_mock.NewIDGeneratorExpectIDs(t, "config1"),
+				secretCrypto: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+				samlKeyPair:  testSAMLIDPKeyPair,
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				config: &domain.IDPConfig{
+					Name:        "name1",
+					Type:        domain.IDPConfigTypeSAML,
+					StylingType: domain.IDPConfigStylingTypeGoogle,
+					SAMLConfig: &domain.SAMLIDPConfig{
+						Metadata:          testIDPMetadata,
+						WithSignedRequest: true,
+					},
+				},
+			},
+			res: res{
+				want: &domain.IDPConfig{
+					ObjectRoot: models.ObjectRoot{
+						InstanceID:    "INSTANCE",
+						AggregateID:   "INSTANCE",
+						ResourceOwner: "INSTANCE",
+					},
+					IDPConfigID: "config1",
+					Name:        "name1",
+					StylingType: domain.IDPConfigStylingTypeGoogle,
+					State:       domain.IDPConfigStateActive,
+				},
+			},
+		},
+		{
+			name: "idp config ldap invalid, invalid argument error",
+			fields: fields{
+				eventstore:   eventstoreExpect(t),
+				idGenerator:  id_mock.NewIDGeneratorExpectIDs(t, "config1"),
+				secretCrypto: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				config: &domain.IDPConfig{
+					Name:        "name1",
+					StylingType: domain.IDPConfigStylingTypeGoogle,
+					LDAPConfig: &domain.LDAPIDPConfig{
+						URL: "ldaps://ldap.test.com",
+					},
+				},
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "idp config ldap add, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusherWithInstanceID(
+								"INSTANCE",
+								instance.NewIDPConfigAddedEvent(context.Background(),
+									&instance.NewAggregate("INSTANCE").Aggregate,
+									"config1",
+									"name1",
+									domain.IDPConfigTypeLDAP,
+									domain.IDPConfigStylingTypeGoogle,
+									false,
+								),
+							),
+							eventFromEventPusherWithInstanceID(
+								"INSTANCE",
+								instance.NewIDPLDAPConfigAddedEvent(context.Background(),
+									&instance.NewAggregate("INSTANCE").Aggregate,
+									"config1",
+									"ldaps://ldap.test.com",
+									false,
+									"dc=test,dc=com",
+									"cn=admin,dc=test,dc=com",
+									&crypto.CryptoValue{
+										CryptoType: crypto.TypeEncryption,
+										Algorithm:  "enc",
+										KeyID:      "id",
+										Crypted:    []byte("password"),
+									},
+									"(uid=%s)",
+									testLDAPAttributes,
+								),
+							),
+						},
+						uniqueConstraintsFromEventConstraintWithInstanceID("INSTANCE", idpconfig.NewAddIDPConfigNameUniqueConstraint("name1", "INSTANCE")),
+					),
+				),
+				idGenerator:  id_mock.NewIDGeneratorExpectIDs(t, "config1"),
+				secretCrypto: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				config: &domain.IDPConfig{
+					Name:        "name1",
+					Type:        domain.IDPConfigTypeLDAP,
+					StylingType: domain.IDPConfigStylingTypeGoogle,
+					LDAPConfig: &domain.LDAPIDPConfig{
+						URL:                "ldaps://ldap.test.com",
+						BaseDN:             "dc=test,dc=com",
+						BindDN:             "cn=admin,dc=test,dc=com",
+						BindPasswordString: "password",
+						UserFilter:         "(uid=%s)",
+						Attributes:         ldapAttributesToDomain(testLDAPAttributes),
+					},
+				},
+			},
+			res: res{
+				want: &domain.IDPConfig{
+					ObjectRoot: models.ObjectRoot{
+						InstanceID:    "INSTANCE",
+						AggregateID:   "INSTANCE",
+						ResourceOwner: "INSTANCE",
+					},
+					IDPConfigID: "config1",
+					Name:        "name1",
+					StylingType: domain.IDPConfigStylingTypeGoogle,
+					State:       domain.IDPConfigStateActive,
+				},
+			},
+		},
+		{
+			name: "idp config oauth2 invalid, invalid argument error",
+			fields: fields{
+				eventstore:   eventstoreExpect(t),
+				idGenerator:  id_mock.NewIDGeneratorExpectIDs(t, "config1"),
+				secretCrypto: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				config: &domain.IDPConfig{
+					Name:        "name1",
+					StylingType: domain.IDPConfigStylingTypeGoogle,
+					OAuth2Config: &domain.OAuth2IDPConfig{
+						ClientID: "client-id",
+					},
+				},
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "idp config oauth2 add, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusherWithInstanceID(
+								"INSTANCE",
+								instance.NewIDPConfigAddedEvent(context.Background(),
+									&instance.NewAggregate("INSTANCE").Aggregate,
+									"config1",
+									"name1",
+									domain.IDPConfigTypeOAuth2,
+									domain.IDPConfigStylingTypeGoogle,
+									false,
+								),
+							),
+							eventFromEventPusherWithInstanceID(
+								"INSTANCE",
+								instance.NewIDPOAuth2ConfigAddedEvent(context.Background(),
+									&instance.NewAggregate("INSTANCE").Aggregate,
+									"config1",
+									"client-id",
+									&crypto.CryptoValue{
+										CryptoType: crypto.TypeEncryption,
+										Algorithm:  "enc",
+										KeyID:      "id",
+										Crypted:    []byte("secret"),
+									},
+									"https://oauth.test.com/authorize",
+									"https://oauth.test.com/token",
+									"https://oauth.test.com/user",
+									[]string{"user"},
+									testOAuth2Mapping,
+								),
+							),
+						},
+						uniqueConstraintsFromEventConstraintWithInstanceID("INSTANCE", idpconfig.NewAddIDPConfigNameUniqueConstraint("name1", "INSTANCE")),
+					),
+				),
+				idGenerator:  id_mock.NewIDGeneratorExpectIDs(t, "config1"),
+				secretCrypto: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				config: &domain.IDPConfig{
+					Name:        "name1",
+					Type:        domain.IDPConfigTypeOAuth2,
+					StylingType: domain.IDPConfigStylingTypeGoogle,
+					OAuth2Config: &domain.OAuth2IDPConfig{
+						ClientID:              "client-id",
+						ClientSecretString:    "secret",
+						AuthorizationEndpoint: "https://oauth.test.com/authorize",
+						TokenEndpoint:         "https://oauth.test.com/token",
+						UserEndpoint:          "https://oauth.test.com/user",
+						Scopes:                []string{"user"},
+						Mapping:               oauth2UserMappingToDomain(testOAuth2Mapping),
+					},
+				},
+			},
+			res: res{
+				want: &domain.IDPConfig{
+					ObjectRoot: models.ObjectRoot{
+						InstanceID:    "INSTANCE",
+						AggregateID:   "INSTANCE",
+						ResourceOwner: "INSTANCE",
+					},
+					IDPConfigID: "config1",
+					Name:        "name1",
+					StylingType: domain.IDPConfigStylingTypeGoogle,
+					State:       domain.IDPConfigStateActive,
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -202,6 +484,8 @@ func TestCommandSide_AddDefaultIDPConfig(t *testing.T) {
 				eventstore:          tt.fields.eventstore,
 				idGenerator:         tt.fields.idGenerator,
 				idpConfigEncryption: tt.fields.secretCrypto,
+
+				samlCertificateAndKeyGenerator: tt.fields.samlKeyPair,
 			}
 			got, err := r.AddDefaultIDPConfig(tt.args.ctx, tt.args.config)
 			if tt.res.err == nil {
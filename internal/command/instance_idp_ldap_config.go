@@ -0,0 +1,50 @@
+package command
+
+import (
+	"context"
+
+	"github.com/dennigogo/zitadel/internal/domain"
+	caos_errs "github.com/dennigogo/zitadel/internal/errors"
+)
+
+func (c *Commands) ChangeDefaultIDPLDAPConfig(ctx context.Context, config *domain.LDAPIDPConfig) (*domain.LDAPIDPConfig, error) {
+	if config.IDPConfigID == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "INSTANCE-Lq2n8", "Errors.IDMissing")
+	}
+	existingConfig := NewInstanceIDPLDAPConfigWriteModel(ctx, config.IDPConfigID)
+	err := c.eventstore.FilterToQueryReducer(ctx, existingConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	if existingConfig.State == domain.IDPConfigStateRemoved || existingConfig.State == domain.IDPConfigStateUnspecified {
+		return nil, caos_errs.ThrowNotFound(nil, "INSTANCE-Lp0wm", "Errors.IDPConfig.NotExisting")
+	}
+
+	if !config.IsValid() {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "INSTANCE-Lk3vz", "Errors.IDPConfig.Invalid")
+	}
+
+	instanceAgg := InstanceAggregateFromWriteModel(&existingConfig.WriteModel)
+	changedEvent, hasChanged, err := existingConfig.NewChangedEvent(
+		ctx,
+		instanceAgg,
+		config,
+		c.idpConfigEncryption)
+	if err != nil {
+		return nil, err
+	}
+	if !hasChanged {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "INSTANCE-Lx9bd", "Errors.IAM.IDPConfig.NotChanged")
+	}
+
+	pushedEvents, err := c.eventstore.Push(ctx, changedEvent)
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingConfig, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToIDPLDAPConfig(&existingConfig.LDAPConfigWriteModel), nil
+}
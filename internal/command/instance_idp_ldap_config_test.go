@@ -0,0 +1,288 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dennigogo/zitadel/internal/crypto"
+	"github.com/dennigogo/zitadel/internal/domain"
+	caos_errs "github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/eventstore"
+	"github.com/dennigogo/zitadel/internal/eventstore/repository"
+	"github.com/dennigogo/zitadel/internal/eventstore/v1/models"
+	"github.com/dennigogo/zitadel/internal/repository/idpconfig"
+	"github.com/dennigogo/zitadel/internal/repository/instance"
+)
+
+var testLDAPAttributes = idpconfig.LDAPAttributes{
+	IDAttribute:                "uid",
+	PreferredUsernameAttribute: "uid",
+	FirstNameAttribute:         "givenName",
+	LastNameAttribute:          "sn",
+	DisplayNameAttribute:       "cn",
+	EmailAttribute:             "mail",
+	PhoneAttribute:             "telephoneNumber",
+}
+
+func TestCommandSide_ChangeDefaultIDPLDAPConfig(t *testing.T) {
+	type fields struct {
+		eventstore   *eventstore.Eventstore
+		secretCrypto crypto.EncryptionAlgorithm
+	}
+	type (
+		args struct {
+			ctx    context.Context
+			config *domain.LDAPIDPConfig
+		}
+	)
+	type res struct {
+		want *domain.LDAPIDPConfig
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "invalid config, error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+				),
+			},
+			args: args{
+				ctx:    context.Background(),
+				config: &domain.LDAPIDPConfig{},
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "idp config not existing, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				config: &domain.LDAPIDPConfig{
+					IDPConfigID: "config1",
+				},
+			},
+			res: res{
+				err: caos_errs.IsNotFound,
+			},
+		},
+		{
+			name: "missing user filter, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							newDefaultIDPLDAPConfigAddedEvent(),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				config: &domain.LDAPIDPConfig{
+					IDPConfigID: "config1",
+					URL:         "ldaps://ldap.test.com",
+					BaseDN:      "dc=test,dc=com",
+					Attributes:  ldapAttributesToDomain(testLDAPAttributes),
+				},
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "user filter without username placeholder, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							newDefaultIDPLDAPConfigAddedEvent(),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				config: &domain.LDAPIDPConfig{
+					IDPConfigID: "config1",
+					URL:         "ldaps://ldap.test.com",
+					BaseDN:      "dc=test,dc=com",
+					UserFilter:  "(uid=admin)",
+					Attributes:  ldapAttributesToDomain(testLDAPAttributes),
+				},
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "no changes, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							newDefaultIDPLDAPConfigAddedEvent(),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				config: &domain.LDAPIDPConfig{
+					IDPConfigID: "config1",
+					URL:         "ldaps://ldap.test.com",
+					BaseDN:      "dc=test,dc=com",
+					BindDN:      "cn=admin,dc=test,dc=com",
+					UserFilter:  "(uid=%s)",
+					Attributes:  ldapAttributesToDomain(testLDAPAttributes),
+				},
+			},
+			res: res{
+				err: caos_errs.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "idp config ldap change, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							newDefaultIDPLDAPConfigAddedEvent(),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								newDefaultIDPLDAPConfigChangedEvent(context.Background(),
+									"config1",
+									[]idpconfig.LDAPConfigChanges{
+										idpconfig.ChangeLDAPBindPassword(&crypto.CryptoValue{
+											CryptoType: crypto.TypeEncryption,
+											Algorithm:  "enc",
+											KeyID:      "id",
+											Crypted:    []byte("password-changed"),
+										}),
+										idpconfig.ChangeLDAPURL("ldap://ldap2.test.com"),
+										idpconfig.ChangeLDAPStartTLS(true),
+										idpconfig.ChangeLDAPUserFilter("(mail=%s)"),
+										idpconfig.ChangeLDAPEmailAttribute("email"),
+									},
+								),
+							),
+						},
+					),
+				),
+				secretCrypto: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				ctx: context.Background(),
+				config: &domain.LDAPIDPConfig{
+					IDPConfigID:        "config1",
+					URL:                "ldap://ldap2.test.com",
+					StartTLS:           true,
+					BaseDN:             "dc=test,dc=com",
+					BindDN:             "cn=admin,dc=test,dc=com",
+					BindPasswordString: "password-changed",
+					UserFilter:         "(mail=%s)",
+					Attributes: domain.LDAPAttributes{
+						IDAttribute:                "uid",
+						PreferredUsernameAttribute: "uid",
+						FirstNameAttribute:         "givenName",
+						LastNameAttribute:          "sn",
+						DisplayNameAttribute:       "cn",
+						EmailAttribute:             "email",
+						PhoneAttribute:             "telephoneNumber",
+					},
+				},
+			},
+			res: res{
+				want: &domain.LDAPIDPConfig{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "INSTANCE",
+						ResourceOwner: "INSTANCE",
+					},
+					IDPConfigID: "config1",
+					URL:         "ldap://ldap2.test.com",
+					StartTLS:    true,
+					BaseDN:      "dc=test,dc=com",
+					BindDN:      "cn=admin,dc=test,dc=com",
+					UserFilter:  "(mail=%s)",
+					Attributes: domain.LDAPAttributes{
+						IDAttribute:                "uid",
+						PreferredUsernameAttribute: "uid",
+						FirstNameAttribute:         "givenName",
+						LastNameAttribute:          "sn",
+						DisplayNameAttribute:       "cn",
+						EmailAttribute:             "email",
+						PhoneAttribute:             "telephoneNumber",
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:          tt.fields.eventstore,
+				idpConfigEncryption: tt.fields.secretCrypto,
+			}
+			got, err := r.ChangeDefaultIDPLDAPConfig(tt.args.ctx, tt.args.config)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func newDefaultIDPLDAPConfigAddedEvent() *instance.IDPLDAPConfigAddedEvent {
+	return instance.NewIDPLDAPConfigAddedEvent(context.Background(),
+		&instance.NewAggregate("INSTANCE").Aggregate,
+		"config1",
+		"ldaps://ldap.test.com",
+		false,
+		"dc=test,dc=com",
+		"cn=admin,dc=test,dc=com",
+		&crypto.CryptoValue{
+			CryptoType: crypto.TypeEncryption,
+			Algorithm:  "enc",
+			KeyID:      "id",
+			Crypted:    []byte("password"),
+		},
+		"(uid=%s)",
+		testLDAPAttributes,
+	)
+}
+
+func newDefaultIDPLDAPConfigChangedEvent(ctx context.Context, configID string, changes []idpconfig.LDAPConfigChanges) *instance.IDPLDAPConfigChangedEvent {
+	event, _ := instance.NewIDPLDAPConfigChangedEvent(ctx,
+		&instance.NewAggregate("INSTANCE").Aggregate,
+		configID,
+		changes,
+	)
+	return event
+}
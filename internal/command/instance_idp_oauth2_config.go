@@ -0,0 +1,50 @@
+package command
+
+import (
+	"context"
+
+	"github.com/dennigogo/zitadel/internal/domain"
+	caos_errs "github.com/dennigogo/zitadel/internal/errors"
+)
+
+func (c *Commands) ChangeDefaultIDPOAuth2Config(ctx context.Context, config *domain.OAuth2IDPConfig) (*domain.OAuth2IDPConfig, error) {
+	if config.IDPConfigID == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "INSTANCE-Oq3m7", "Errors.IDMissing")
+	}
+	existingConfig := NewInstanceIDPOAuth2ConfigWriteModel(ctx, config.IDPConfigID)
+	err := c.eventstore.FilterToQueryReducer(ctx, existingConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	if existingConfig.State == domain.IDPConfigStateRemoved || existingConfig.State == domain.IDPConfigStateUnspecified {
+		return nil, caos_errs.ThrowNotFound(nil, "INSTANCE-Op8wn", "Errors.IDPConfig.NotExisting")
+	}
+
+	if !config.IsValid() {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "INSTANCE-Ok4vy", "Errors.IDPConfig.Invalid")
+	}
+
+	instanceAgg := InstanceAggregateFromWriteModel(&existingConfig.WriteModel)
+	changedEvent, hasChanged, err := existingConfig.NewChangedEvent(
+		ctx,
+		instanceAgg,
+		config,
+		c.idpConfigEncryption)
+	if err != nil {
+		return nil, err
+	}
+	if !hasChanged {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "INSTANCE-Ox2bc", "Errors.IAM.IDPConfig.NotChanged")
+	}
+
+	pushedEvents, err := c.eventstore.Push(ctx, changedEvent)
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingConfig, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToIDPOAuth2Config(&existingConfig.OAuth2ConfigWriteModel), nil
+}
@@ -0,0 +1,103 @@
+package command
+
+import (
+	"context"
+
+	"github.com/dennigogo/zitadel/internal/api/authz"
+	"github.com/dennigogo/zitadel/internal/crypto"
+	"github.com/dennigogo/zitadel/internal/domain"
+	"github.com/dennigogo/zitadel/internal/eventstore"
+	"github.com/dennigogo/zitadel/internal/repository/instance"
+)
+
+type InstanceIDPOAuth2ConfigWriteModel struct {
+	OAuth2ConfigWriteModel
+}
+
+func NewInstanceIDPOAuth2ConfigWriteModel(ctx context.Context, idpConfigID string) *InstanceIDPOAuth2ConfigWriteModel {
+	return &InstanceIDPOAuth2ConfigWriteModel{
+		OAuth2ConfigWriteModel{
+			WriteModel: eventstore.WriteModel{
+				AggregateID:   authz.GetInstance(ctx).InstanceID(),
+				ResourceOwner: authz.GetInstance(ctx).InstanceID(),
+			},
+			IDPConfigID: idpConfigID,
+		},
+	}
+}
+
+func (wm *InstanceIDPOAuth2ConfigWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *instance.IDPOAuth2ConfigAddedEvent:
+			if wm.IDPConfigID != e.IDPConfigID {
+				continue
+			}
+			wm.OAuth2ConfigWriteModel.AppendEvents(&e.OAuth2ConfigAddedEvent)
+		case *instance.IDPOAuth2ConfigChangedEvent:
+			if wm.IDPConfigID != e.IDPConfigID {
+				continue
+			}
+			wm.OAuth2ConfigWriteModel.AppendEvents(&e.OAuth2ConfigChangedEvent)
+		case *instance.IDPConfigReactivatedEvent:
+			if wm.IDPConfigID != e.ConfigID {
+				continue
+			}
+			wm.OAuth2ConfigWriteModel.AppendEvents(&e.IDPConfigReactivatedEvent)
+		case *instance.IDPConfigDeactivatedEvent:
+			if wm.IDPConfigID != e.ConfigID {
+				continue
+			}
+			wm.OAuth2ConfigWriteModel.AppendEvents(&e.IDPConfigDeactivatedEvent)
+		case *instance.IDPConfigRemovedEvent:
+			if wm.IDPConfigID != e.ConfigID {
+				continue
+			}
+			wm.OAuth2ConfigWriteModel.AppendEvents(&e.IDPConfigRemovedEvent)
+		default:
+			wm.OAuth2ConfigWriteModel.AppendEvents(e)
+		}
+	}
+}
+
+func (wm *InstanceIDPOAuth2ConfigWriteModel) Reduce() error {
+	if err := wm.OAuth2ConfigWriteModel.Reduce(); err != nil {
+		return err
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *InstanceIDPOAuth2ConfigWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(instance.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			instance.IDPOAuth2ConfigAddedEventType,
+			instance.IDPOAuth2ConfigChangedEventType,
+			instance.IDPConfigReactivatedEventType,
+			instance.IDPConfigDeactivatedEventType,
+			instance.IDPConfigRemovedEventType).
+		Builder()
+}
+
+func (wm *InstanceIDPOAuth2ConfigWriteModel) NewChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	config *domain.OAuth2IDPConfig,
+	secretCrypto crypto.Crypto,
+) (*instance.IDPOAuth2ConfigChangedEvent, bool, error) {
+	changes, err := wm.changes(config, secretCrypto)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(changes) == 0 {
+		return nil, false, nil
+	}
+	changeEvent, err := instance.NewIDPOAuth2ConfigChangedEvent(ctx, aggregate, config.IDPConfigID, changes)
+	if err != nil {
+		return nil, false, err
+	}
+	return changeEvent, true, nil
+}
@@ -0,0 +1,53 @@
+package command
+
+import (
+	"context"
+
+	"github.com/dennigogo/zitadel/internal/domain"
+	caos_errs "github.com/dennigogo/zitadel/internal/errors"
+)
+
+func (c *Commands) ChangeDefaultIDPSAMLConfig(ctx context.Context, config *domain.SAMLIDPConfig) (*domain.SAMLIDPConfig, error) {
+	if config.IDPConfigID == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "INSTANCE-2kf0s", "Errors.IDMissing")
+	}
+	existingConfig := NewInstanceIDPSAMLConfigWriteModel(ctx, config.IDPConfigID)
+	err := c.eventstore.FilterToQueryReducer(ctx, existingConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	if existingConfig.State == domain.IDPConfigStateRemoved || existingConfig.State == domain.IDPConfigStateUnspecified {
+		return nil, caos_errs.ThrowNotFound(nil, "INSTANCE-3mf9s", "Errors.IDPConfig.NotExisting")
+	}
+
+	metadata, err := c.samlIDPMetadata(config)
+	if err != nil {
+		return nil, err
+	}
+
+	instanceAgg := InstanceAggregateFromWriteModel(&existingConfig.WriteModel)
+	changedEvent, hasChanged, err := existingConfig.NewChangedEvent(
+		ctx,
+		instanceAgg,
+		config.IDPConfigID,
+		metadata,
+		config.MetadataURL,
+		config.WithSignedRequest)
+	if err != nil {
+		return nil, err
+	}
+	if !hasChanged {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "INSTANCE-9fk3d", "Errors.IAM.IDPConfig.NotChanged")
+	}
+
+	pushedEvents, err := c.eventstore.Push(ctx, changedEvent)
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingConfig, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToIDPSAMLConfig(&existingConfig.SAMLConfigWriteModel), nil
+}
@@ -0,0 +1,100 @@
+package command
+
+import (
+	"context"
+
+	"github.com/dennigogo/zitadel/internal/api/authz"
+	"github.com/dennigogo/zitadel/internal/eventstore"
+	"github.com/dennigogo/zitadel/internal/repository/instance"
+)
+
+type InstanceIDPSAMLConfigWriteModel struct {
+	SAMLConfigWriteModel
+}
+
+func NewInstanceIDPSAMLConfigWriteModel(ctx context.Context, idpConfigID string) *InstanceIDPSAMLConfigWriteModel {
+	return &InstanceIDPSAMLConfigWriteModel{
+		SAMLConfigWriteModel{
+			WriteModel: eventstore.WriteModel{
+				AggregateID:   authz.GetInstance(ctx).InstanceID(),
+				ResourceOwner: authz.GetInstance(ctx).InstanceID(),
+			},
+			IDPConfigID: idpConfigID,
+		},
+	}
+}
+
+func (wm *InstanceIDPSAMLConfigWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *instance.IDPSAMLConfigAddedEvent:
+			if wm.IDPConfigID != e.IDPConfigID {
+				continue
+			}
+			wm.SAMLConfigWriteModel.AppendEvents(&e.SAMLConfigAddedEvent)
+		case *instance.IDPSAMLConfigChangedEvent:
+			if wm.IDPConfigID != e.IDPConfigID {
+				continue
+			}
+			wm.SAMLConfigWriteModel.AppendEvents(&e.SAMLConfigChangedEvent)
+		case *instance.IDPConfigReactivatedEvent:
+			if wm.IDPConfigID != e.ConfigID {
+				continue
+			}
+			wm.SAMLConfigWriteModel.AppendEvents(&e.IDPConfigReactivatedEvent)
+		case *instance.IDPConfigDeactivatedEvent:
+			if wm.IDPConfigID != e.ConfigID {
+				continue
+			}
+			wm.SAMLConfigWriteModel.AppendEvents(&e.IDPConfigDeactivatedEvent)
+		case *instance.IDPConfigRemovedEvent:
+			if wm.IDPConfigID != e.ConfigID {
+				continue
+			}
+			wm.SAMLConfigWriteModel.AppendEvents(&e.IDPConfigRemovedEvent)
+		default:
+			wm.SAMLConfigWriteModel.AppendEvents(e)
+		}
+	}
+}
+
+func (wm *InstanceIDPSAMLConfigWriteModel) Reduce() error {
+	if err := wm.SAMLConfigWriteModel.Reduce(); err != nil {
+		return err
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *InstanceIDPSAMLConfigWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(instance.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			instance.IDPSAMLConfigAddedEventType,
+			instance.IDPSAMLConfigChangedEventType,
+			instance.IDPConfigReactivatedEventType,
+			instance.IDPConfigDeactivatedEventType,
+			instance.IDPConfigRemovedEventType).
+		Builder()
+}
+
+func (wm *InstanceIDPSAMLConfigWriteModel) NewChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	idpConfigID string,
+	metadata []byte,
+	metadataURL string,
+	withSignedRequest bool,
+) (*instance.IDPSAMLConfigChangedEvent, bool, error) {
+	changes := wm.changes(metadata, metadataURL, withSignedRequest)
+	if len(changes) == 0 {
+		return nil, false, nil
+	}
+	changeEvent, err := instance.NewIDPSAMLConfigChangedEvent(ctx, aggregate, idpConfigID, changes)
+	if err != nil {
+		return nil, false, err
+	}
+	return changeEvent, true, nil
+}
@@ -245,7 +245,8 @@ func prepareChangeDefaultLoginPolicy(a *instance.Aggregate, policy *ChangeLoginP
 				policy.ExternalLoginCheckLifetime,
 				policy.MFAInitSkipLifetime,
 				policy.SecondFactorCheckLifetime,
-				policy.MultiFactorCheckLifetime)
+				policy.MultiFactorCheckLifetime,
+				policy.TrustedDeviceLifetime)
 			if !hasChanged {
 				return nil, caos_errs.ThrowPreconditionFailed(nil, "INSTANCE-5M9vdd", "Errors.IAM.LoginPolicy.NotChanged")
 			}
@@ -272,6 +273,7 @@ func prepareAddDefaultLoginPolicy(
 	mfaInitSkipLifetime time.Duration,
 	secondFactorCheckLifetime time.Duration,
 	multiFactorCheckLifetime time.Duration,
+	trustedDeviceLifetime time.Duration,
 ) preparation.Validation {
 	return func() (preparation.CreateCommands, error) {
 		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
@@ -305,6 +307,7 @@ func prepareAddDefaultLoginPolicy(
 					mfaInitSkipLifetime,
 					secondFactorCheckLifetime,
 					multiFactorCheckLifetime,
+					trustedDeviceLifetime,
 				),
 			}, nil
 		}, nil
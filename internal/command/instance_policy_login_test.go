@@ -80,6 +80,7 @@ func TestCommandSide_ChangeDefaultLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
 							),
 						),
 					),
@@ -136,6 +137,7 @@ func TestCommandSide_ChangeDefaultLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
 							),
 						),
 					),
@@ -288,6 +290,7 @@ func TestCommandSide_AddIDPProviderDefaultLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
 							),
 						),
 					),
@@ -329,6 +332,7 @@ func TestCommandSide_AddIDPProviderDefaultLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
 							),
 						),
 					),
@@ -390,6 +394,7 @@ func TestCommandSide_AddIDPProviderDefaultLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
 							),
 						),
 					),
@@ -533,6 +538,7 @@ func TestCommandSide_RemoveIDPProviderDefaultLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
 							),
 						),
 					),
@@ -574,6 +580,7 @@ func TestCommandSide_RemoveIDPProviderDefaultLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
 							),
 						),
 					),
@@ -628,6 +635,7 @@ func TestCommandSide_RemoveIDPProviderDefaultLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
 							),
 						),
 					),
@@ -687,6 +695,7 @@ func TestCommandSide_RemoveIDPProviderDefaultLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
 							),
 						),
 					),
@@ -754,6 +763,7 @@ func TestCommandSide_RemoveIDPProviderDefaultLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
 							),
 						),
 					),
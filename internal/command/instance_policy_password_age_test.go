@@ -24,6 +24,7 @@ func TestCommandSide_AddDefaultPasswordAgePolicy(t *testing.T) {
 		ctx            context.Context
 		maxAgeDays     uint64
 		expireWarnDays uint64
+		historyCount   uint64
 	}
 	type res struct {
 		want *domain.ObjectDetails
@@ -46,6 +47,7 @@ func TestCommandSide_AddDefaultPasswordAgePolicy(t *testing.T) {
 								&instance.NewAggregate("INSTANCE").Aggregate,
 								365,
 								10,
+								0,
 							),
 						),
 					),
@@ -74,6 +76,7 @@ func TestCommandSide_AddDefaultPasswordAgePolicy(t *testing.T) {
 									&instance.NewAggregate("INSTANCE").Aggregate,
 									365,
 									10,
+									5,
 								),
 							),
 						},
@@ -84,6 +87,7 @@ func TestCommandSide_AddDefaultPasswordAgePolicy(t *testing.T) {
 				ctx:            authz.WithInstanceID(context.Background(), "INSTANCE"),
 				expireWarnDays: 365,
 				maxAgeDays:     10,
+				historyCount:   5,
 			},
 			res: res{
 				want: &domain.ObjectDetails{
@@ -97,7 +101,7 @@ func TestCommandSide_AddDefaultPasswordAgePolicy(t *testing.T) {
 			r := &Commands{
 				eventstore: tt.fields.eventstore,
 			}
-			got, err := r.AddDefaultPasswordAgePolicy(tt.args.ctx, tt.args.expireWarnDays, tt.args.maxAgeDays)
+			got, err := r.AddDefaultPasswordAgePolicy(tt.args.ctx, tt.args.expireWarnDays, tt.args.maxAgeDays, tt.args.historyCount)
 			if tt.res.err == nil {
 				assert.NoError(t, err)
 			}
@@ -159,6 +163,7 @@ func TestCommandSide_ChangeDefaultPasswordAgePolicy(t *testing.T) {
 								&instance.NewAggregate("INSTANCE").Aggregate,
 								365,
 								10,
+								0,
 							),
 						),
 					),
@@ -186,13 +191,14 @@ func TestCommandSide_ChangeDefaultPasswordAgePolicy(t *testing.T) {
 								&instance.NewAggregate("INSTANCE").Aggregate,
 								365,
 								10,
+								0,
 							),
 						),
 					),
 					expectPush(
 						[]*repository.Event{
 							eventFromEventPusher(
-								newDefaultPasswordAgePolicyChangedEvent(context.Background(), 125, 5),
+								newDefaultPasswordAgePolicyChangedEvent(context.Background(), 125, 5, 3),
 							),
 						},
 					),
@@ -203,6 +209,7 @@ func TestCommandSide_ChangeDefaultPasswordAgePolicy(t *testing.T) {
 				policy: &domain.PasswordAgePolicy{
 					MaxAgeDays:     125,
 					ExpireWarnDays: 5,
+					HistoryCount:   3,
 				},
 			},
 			res: res{
@@ -213,6 +220,7 @@ func TestCommandSide_ChangeDefaultPasswordAgePolicy(t *testing.T) {
 					},
 					MaxAgeDays:     125,
 					ExpireWarnDays: 5,
+					HistoryCount:   3,
 				},
 			},
 		},
@@ -236,12 +244,13 @@ func TestCommandSide_ChangeDefaultPasswordAgePolicy(t *testing.T) {
 	}
 }
 
-func newDefaultPasswordAgePolicyChangedEvent(ctx context.Context, maxAgeDays, expiryWarnDays uint64) *instance.PasswordAgePolicyChangedEvent {
+func newDefaultPasswordAgePolicyChangedEvent(ctx context.Context, maxAgeDays, expiryWarnDays, historyCount uint64) *instance.PasswordAgePolicyChangedEvent {
 	event, _ := instance.NewPasswordAgePolicyChangedEvent(ctx,
 		&instance.NewAggregate("INSTANCE").Aggregate,
 		[]policy.PasswordAgePolicyChanges{
 			policy.ChangeExpireWarnDays(expiryWarnDays),
 			policy.ChangeMaxAgeDays(maxAgeDays),
+			policy.ChangeHistoryCount(historyCount),
 		},
 	)
 	return event
@@ -5,6 +5,7 @@ import (
 
 	"github.com/dennigogo/zitadel/internal/api/authz"
 	"github.com/dennigogo/zitadel/internal/command/preparation"
+	"github.com/dennigogo/zitadel/internal/crypto"
 	"github.com/dennigogo/zitadel/internal/domain"
 	caos_errs "github.com/dennigogo/zitadel/internal/errors"
 	"github.com/dennigogo/zitadel/internal/eventstore"
@@ -12,9 +13,9 @@ import (
 	"github.com/dennigogo/zitadel/internal/telemetry/tracing"
 )
 
-func (c *Commands) AddDefaultPasswordComplexityPolicy(ctx context.Context, minLength uint64, hasLowercase, hasUppercase, hasNumber, hasSymbol bool) (*domain.ObjectDetails, error) {
+func (c *Commands) AddDefaultPasswordComplexityPolicy(ctx context.Context, minLength uint64, hasLowercase, hasUppercase, hasNumber, hasSymbol bool, breachedPasswordCheck domain.BreachedPasswordCheck) (*domain.ObjectDetails, error) {
 	instanceAgg := instance.NewAggregate(authz.GetInstance(ctx).InstanceID())
-	cmds, err := preparation.PrepareCommands(ctx, c.eventstore.Filter, prepareAddDefaultPasswordComplexityPolicy(instanceAgg, minLength, hasLowercase, hasUppercase, hasNumber, hasSymbol))
+	cmds, err := preparation.PrepareCommands(ctx, c.eventstore.Filter, prepareAddDefaultPasswordComplexityPolicy(instanceAgg, minLength, hasLowercase, hasUppercase, hasNumber, hasSymbol, breachedPasswordCheck, c.breachedPasswords))
 	if err != nil {
 		return nil, err
 	}
@@ -29,6 +30,9 @@ func (c *Commands) ChangeDefaultPasswordComplexityPolicy(ctx context.Context, po
 	if err := policy.IsValid(); err != nil {
 		return nil, err
 	}
+	if !policy.BreachedPasswordCheck.Configured(c.breachedPasswords) {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "INSTANCE-Br6nc", "Errors.User.PasswordComplexityPolicy.BreachedPasswordsNotConfigured")
+	}
 
 	existingPolicy, err := c.defaultPasswordComplexityPolicyWriteModelByID(ctx)
 	if err != nil {
@@ -39,7 +43,7 @@ func (c *Commands) ChangeDefaultPasswordComplexityPolicy(ctx context.Context, po
 	}
 
 	instanceAgg := InstanceAggregateFromWriteModel(&existingPolicy.PasswordComplexityPolicyWriteModel.WriteModel)
-	changedEvent, hasChanged := existingPolicy.NewChangedEvent(ctx, instanceAgg, policy.MinLength, policy.HasLowercase, policy.HasUppercase, policy.HasNumber, policy.HasSymbol)
+	changedEvent, hasChanged := existingPolicy.NewChangedEvent(ctx, instanceAgg, policy.MinLength, policy.HasLowercase, policy.HasUppercase, policy.HasNumber, policy.HasSymbol, policy.BreachedPasswordCheck)
 	if !hasChanged {
 		return nil, caos_errs.ThrowPreconditionFailed(nil, "INSTANCE-9jlsf", "Errors.IAM.PasswordComplexityPolicy.NotChanged")
 	}
@@ -61,11 +65,19 @@ func prepareAddDefaultPasswordComplexityPolicy(
 	hasUppercase,
 	hasNumber,
 	hasSymbol bool,
+	breachedPasswordCheck domain.BreachedPasswordCheck,
+	breachedPasswords crypto.BreachedPasswordChecker,
 ) preparation.Validation {
 	return func() (preparation.CreateCommands, error) {
 		if minLength == 0 || minLength > 72 {
 			return nil, caos_errs.ThrowInvalidArgument(nil, "INSTANCE-Lsp0e", "Errors.Instance.PasswordComplexityPolicy.MinLengthNotAllowed")
 		}
+		if !breachedPasswordCheck.Valid() {
+			return nil, caos_errs.ThrowInvalidArgument(nil, "INSTANCE-Br3vd", "Errors.IAM.PasswordComplexityPolicy.BreachedPasswordCheckInvalid")
+		}
+		if !breachedPasswordCheck.Configured(breachedPasswords) {
+			return nil, caos_errs.ThrowPreconditionFailed(nil, "INSTANCE-Br4nc", "Errors.User.PasswordComplexityPolicy.BreachedPasswordsNotConfigured")
+		}
 		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
 			writeModel := NewInstancePasswordComplexityPolicyWriteModel(ctx)
 			events, err := filter(ctx, writeModel.Query())
@@ -86,6 +98,7 @@ func prepareAddDefaultPasswordComplexityPolicy(
 					hasUppercase,
 					hasNumber,
 					hasSymbol,
+					breachedPasswordCheck,
 				),
 			}, nil
 		}, nil
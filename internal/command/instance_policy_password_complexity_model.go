@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/dennigogo/zitadel/internal/api/authz"
+	"github.com/dennigogo/zitadel/internal/domain"
 	"github.com/dennigogo/zitadel/internal/eventstore"
 
 	"github.com/dennigogo/zitadel/internal/repository/instance"
@@ -60,6 +61,7 @@ func (wm *InstancePasswordComplexityPolicyWriteModel) NewChangedEvent(
 	hasUppercase,
 	hasNumber,
 	hasSymbol bool,
+	breachedPasswordCheck domain.BreachedPasswordCheck,
 ) (*instance.PasswordComplexityPolicyChangedEvent, bool) {
 
 	changes := make([]policy.PasswordComplexityPolicyChanges, 0)
@@ -78,6 +80,9 @@ func (wm *InstancePasswordComplexityPolicyWriteModel) NewChangedEvent(
 	if wm.HasSymbol != hasSymbol {
 		changes = append(changes, policy.ChangeHasSymbol(hasSymbol))
 	}
+	if wm.BreachedPasswordCheck != breachedPasswordCheck {
+		changes = append(changes, policy.ChangeBreachedPasswordCheck(breachedPasswordCheck))
+	}
 	if len(changes) == 0 {
 		return nil, false
 	}
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/dennigogo/zitadel/internal/api/authz"
+	"github.com/dennigogo/zitadel/internal/crypto"
 	"github.com/dennigogo/zitadel/internal/domain"
 	caos_errs "github.com/dennigogo/zitadel/internal/errors"
 	"github.com/dennigogo/zitadel/internal/eventstore"
@@ -18,15 +19,17 @@ import (
 
 func TestCommandSide_AddDefaultPasswordComplexityPolicy(t *testing.T) {
 	type fields struct {
-		eventstore *eventstore.Eventstore
+		eventstore        *eventstore.Eventstore
+		breachedPasswords crypto.BreachedPasswordChecker
 	}
 	type args struct {
-		ctx          context.Context
-		minLength    uint64
-		hasLowercase bool
-		hasUppercase bool
-		hasNumber    bool
-		hasSymbol    bool
+		ctx                   context.Context
+		minLength             uint64
+		hasLowercase          bool
+		hasUppercase          bool
+		hasNumber             bool
+		hasSymbol             bool
+		breachedPasswordCheck domain.BreachedPasswordCheck
 	}
 	type res struct {
 		want *domain.ObjectDetails
@@ -68,6 +71,7 @@ func TestCommandSide_AddDefaultPasswordComplexityPolicy(t *testing.T) {
 								&instance.NewAggregate("INSTANCE").Aggregate,
 								8,
 								true, true, true, true,
+								domain.BreachedPasswordCheckDisabled,
 							),
 						),
 					),
@@ -85,6 +89,26 @@ func TestCommandSide_AddDefaultPasswordComplexityPolicy(t *testing.T) {
 				err: caos_errs.IsErrorAlreadyExists,
 			},
 		},
+		{
+			name: "breached passwords not configured, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+				),
+			},
+			args: args{
+				ctx:                   authz.WithInstanceID(context.Background(), "INSTANCE"),
+				minLength:             8,
+				hasUppercase:          true,
+				hasLowercase:          true,
+				hasNumber:             true,
+				hasSymbol:             true,
+				breachedPasswordCheck: domain.BreachedPasswordCheckReject,
+			},
+			res: res{
+				err: caos_errs.IsPreconditionFailed,
+			},
+		},
 		{
 			name: "add policy,ok",
 			fields: fields{
@@ -99,19 +123,22 @@ func TestCommandSide_AddDefaultPasswordComplexityPolicy(t *testing.T) {
 									&instance.NewAggregate("INSTANCE").Aggregate,
 									8,
 									true, true, true, true,
+									domain.BreachedPasswordCheckReject,
 								),
 							),
 						},
 					),
 				),
+				breachedPasswords: breachedPasswords{},
 			},
 			args: args{
-				ctx:          authz.WithInstanceID(context.Background(), "INSTANCE"),
-				minLength:    8,
-				hasUppercase: true,
-				hasLowercase: true,
-				hasNumber:    true,
-				hasSymbol:    true,
+				ctx:                   authz.WithInstanceID(context.Background(), "INSTANCE"),
+				minLength:             8,
+				hasUppercase:          true,
+				hasLowercase:          true,
+				hasNumber:             true,
+				hasSymbol:             true,
+				breachedPasswordCheck: domain.BreachedPasswordCheckReject,
 			},
 			res: res{
 				want: &domain.ObjectDetails{
@@ -123,9 +150,10 @@ func TestCommandSide_AddDefaultPasswordComplexityPolicy(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			r := &Commands{
-				eventstore: tt.fields.eventstore,
+				eventstore:        tt.fields.eventstore,
+				breachedPasswords: tt.fields.breachedPasswords,
 			}
-			got, err := r.AddDefaultPasswordComplexityPolicy(tt.args.ctx, tt.args.minLength, tt.args.hasLowercase, tt.args.hasUppercase, tt.args.hasNumber, tt.args.hasSymbol)
+			got, err := r.AddDefaultPasswordComplexityPolicy(tt.args.ctx, tt.args.minLength, tt.args.hasLowercase, tt.args.hasUppercase, tt.args.hasNumber, tt.args.hasSymbol, tt.args.breachedPasswordCheck)
 			if tt.res.err == nil {
 				assert.NoError(t, err)
 			}
@@ -211,6 +239,7 @@ func TestCommandSide_ChangeDefaultPasswordComplexityPolicy(t *testing.T) {
 								&instance.NewAggregate("INSTANCE").Aggregate,
 								8,
 								true, true, true, true,
+								domain.BreachedPasswordCheckDisabled,
 							),
 						),
 					),
@@ -241,6 +270,7 @@ func TestCommandSide_ChangeDefaultPasswordComplexityPolicy(t *testing.T) {
 								&instance.NewAggregate("INSTANCE").Aggregate,
 								8,
 								true, true, true, true,
+								domain.BreachedPasswordCheckDisabled,
 							),
 						),
 					),
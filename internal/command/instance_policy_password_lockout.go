@@ -2,6 +2,7 @@ package command
 
 import (
 	"context"
+	"time"
 
 	"github.com/dennigogo/zitadel/internal/api/authz"
 	"github.com/dennigogo/zitadel/internal/command/preparation"
@@ -12,9 +13,9 @@ import (
 	"github.com/dennigogo/zitadel/internal/telemetry/tracing"
 )
 
-func (c *Commands) AddDefaultLockoutPolicy(ctx context.Context, maxAttempts uint64, showLockoutFailure bool) (*domain.ObjectDetails, error) {
+func (c *Commands) AddDefaultLockoutPolicy(ctx context.Context, maxAttempts, maxOTPAttempts, maxU2FAttempts uint64, showLockoutFailure bool, autoUnlockAfter, attemptDelay, maxAttemptDelay time.Duration) (*domain.ObjectDetails, error) {
 	instanceAgg := instance.NewAggregate(authz.GetInstance(ctx).InstanceID())
-	cmds, err := preparation.PrepareCommands(ctx, c.eventstore.Filter, prepareAddDefaultLockoutPolicy(instanceAgg, maxAttempts, showLockoutFailure))
+	cmds, err := preparation.PrepareCommands(ctx, c.eventstore.Filter, prepareAddDefaultLockoutPolicy(instanceAgg, maxAttempts, maxOTPAttempts, maxU2FAttempts, showLockoutFailure, autoUnlockAfter, attemptDelay, maxAttemptDelay))
 	if err != nil {
 		return nil, err
 	}
@@ -26,6 +27,9 @@ func (c *Commands) AddDefaultLockoutPolicy(ctx context.Context, maxAttempts uint
 }
 
 func (c *Commands) ChangeDefaultLockoutPolicy(ctx context.Context, policy *domain.LockoutPolicy) (*domain.LockoutPolicy, error) {
+	if err := policy.IsValid(); err != nil {
+		return nil, err
+	}
 	existingPolicy, err := c.defaultLockoutPolicyWriteModelByID(ctx)
 	if err != nil {
 		return nil, err
@@ -35,7 +39,17 @@ func (c *Commands) ChangeDefaultLockoutPolicy(ctx context.Context, policy *domai
 	}
 
 	instanceAgg := InstanceAggregateFromWriteModel(&existingPolicy.LockoutPolicyWriteModel.WriteModel)
-	changedEvent, hasChanged := existingPolicy.NewChangedEvent(ctx, instanceAgg, policy.MaxPasswordAttempts, policy.ShowLockOutFailures)
+	changedEvent, hasChanged := existingPolicy.NewChangedEvent(
+		ctx,
+		instanceAgg,
+		policy.MaxPasswordAttempts,
+		policy.MaxOTPAttempts,
+		policy.MaxU2FAttempts,
+		policy.ShowLockOutFailures,
+		policy.AutoUnlockAfter,
+		policy.AttemptDelay,
+		policy.MaxAttemptDelay,
+	)
 	if !hasChanged {
 		return nil, caos_errs.ThrowPreconditionFailed(nil, "INSTANCE-0psjF", "Errors.IAM.LockoutPolicy.NotChanged")
 	}
@@ -65,10 +79,23 @@ func (c *Commands) defaultLockoutPolicyWriteModelByID(ctx context.Context) (poli
 
 func prepareAddDefaultLockoutPolicy(
 	a *instance.Aggregate,
-	maxAttempts uint64,
+	maxAttempts,
+	maxOTPAttempts,
+	maxU2FAttempts uint64,
 	showLockoutFailure bool,
+	autoUnlockAfter,
+	attemptDelay,
+	maxAttemptDelay time.Duration,
 ) preparation.Validation {
 	return func() (preparation.CreateCommands, error) {
+		policy := &domain.LockoutPolicy{
+			AutoUnlockAfter: autoUnlockAfter,
+			AttemptDelay:    attemptDelay,
+			MaxAttemptDelay: maxAttemptDelay,
+		}
+		if err := policy.IsValid(); err != nil {
+			return nil, err
+		}
 		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
 			writeModel := NewInstanceLockoutPolicyWriteModel(ctx)
 			events, err := filter(ctx, writeModel.Query())
@@ -83,7 +110,7 @@ func prepareAddDefaultLockoutPolicy(
 				return nil, caos_errs.ThrowAlreadyExists(nil, "INSTANCE-0olDf", "Errors.Instance.LockoutPolicy.AlreadyExists")
 			}
 			return []eventstore.Command{
-				instance.NewLockoutPolicyAddedEvent(ctx, &a.Aggregate, maxAttempts, showLockoutFailure),
+				instance.NewLockoutPolicyAddedEvent(ctx, &a.Aggregate, maxAttempts, maxOTPAttempts, maxU2FAttempts, showLockoutFailure, autoUnlockAfter, attemptDelay, maxAttemptDelay),
 			}, nil
 		}, nil
 	}
@@ -2,6 +2,7 @@ package command
 
 import (
 	"context"
+	"time"
 
 	"github.com/dennigogo/zitadel/internal/api/authz"
 	"github.com/dennigogo/zitadel/internal/eventstore"
@@ -55,15 +56,35 @@ func (wm *InstanceLockoutPolicyWriteModel) Query() *eventstore.SearchQueryBuilde
 func (wm *InstanceLockoutPolicyWriteModel) NewChangedEvent(
 	ctx context.Context,
 	aggregate *eventstore.Aggregate,
-	maxAttempts uint64,
-	showLockoutFailure bool) (*instance.LockoutPolicyChangedEvent, bool) {
+	maxAttempts,
+	maxOTPAttempts,
+	maxU2FAttempts uint64,
+	showLockoutFailure bool,
+	autoUnlockAfter,
+	attemptDelay,
+	maxAttemptDelay time.Duration) (*instance.LockoutPolicyChangedEvent, bool) {
 	changes := make([]policy.LockoutPolicyChanges, 0)
 	if wm.MaxPasswordAttempts != maxAttempts {
 		changes = append(changes, policy.ChangeMaxAttempts(maxAttempts))
 	}
+	if wm.MaxOTPAttempts != maxOTPAttempts {
+		changes = append(changes, policy.ChangeMaxOTPAttempts(maxOTPAttempts))
+	}
+	if wm.MaxU2FAttempts != maxU2FAttempts {
+		changes = append(changes, policy.ChangeMaxU2FAttempts(maxU2FAttempts))
+	}
 	if wm.ShowLockOutFailures != showLockoutFailure {
 		changes = append(changes, policy.ChangeShowLockOutFailures(showLockoutFailure))
 	}
+	if wm.AutoUnlockAfter != autoUnlockAfter {
+		changes = append(changes, policy.ChangeAutoUnlockAfter(autoUnlockAfter))
+	}
+	if wm.AttemptDelay != attemptDelay {
+		changes = append(changes, policy.ChangeAttemptDelay(attemptDelay))
+	}
+	if wm.MaxAttemptDelay != maxAttemptDelay {
+		changes = append(changes, policy.ChangeMaxAttemptDelay(maxAttemptDelay))
+	}
 	if len(changes) == 0 {
 		return nil, false
 	}
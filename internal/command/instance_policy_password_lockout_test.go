@@ -3,6 +3,7 @@ package command
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -23,7 +24,12 @@ func TestCommandSide_AddDefaultLockoutPolicy(t *testing.T) {
 	type args struct {
 		ctx                 context.Context
 		maxPasswordAttempts uint64
+		maxOTPAttempts      uint64
+		maxU2FAttempts      uint64
 		showLockOutFailures bool
+		autoUnlockAfter     time.Duration
+		attemptDelay        time.Duration
+		maxAttemptDelay     time.Duration
 	}
 	type res struct {
 		want *domain.ObjectDetails
@@ -35,6 +41,23 @@ func TestCommandSide_AddDefaultLockoutPolicy(t *testing.T) {
 		args   args
 		res    res
 	}{
+		{
+			name: "max attempt delay smaller than attempt delay, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+				),
+			},
+			args: args{
+				ctx:                 context.Background(),
+				maxPasswordAttempts: 10,
+				attemptDelay:        time.Minute,
+				maxAttemptDelay:     time.Second,
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
 		{
 			name: "lockout policy already existing, already exists error",
 			fields: fields{
@@ -45,7 +68,12 @@ func TestCommandSide_AddDefaultLockoutPolicy(t *testing.T) {
 							instance.NewLockoutPolicyAddedEvent(context.Background(),
 								&instance.NewAggregate("INSTANCE").Aggregate,
 								10,
+								0,
+								0,
 								true,
+								0,
+								0,
+								0,
 							),
 						),
 					),
@@ -73,7 +101,48 @@ func TestCommandSide_AddDefaultLockoutPolicy(t *testing.T) {
 								instance.NewLockoutPolicyAddedEvent(context.Background(),
 									&instance.NewAggregate("INSTANCE").Aggregate,
 									10,
+									0,
+									0,
+									true,
+									0,
+									0,
+									0,
+								),
+							),
+						},
+					),
+				),
+			},
+			args: args{
+				ctx:                 authz.WithInstanceID(context.Background(), "INSTANCE"),
+				maxPasswordAttempts: 10,
+				showLockOutFailures: true,
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+		{
+			name: "add policy with auto unlock and attempt delay,ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusherWithInstanceID(
+								"INSTANCE",
+								instance.NewLockoutPolicyAddedEvent(context.Background(),
+									&instance.NewAggregate("INSTANCE").Aggregate,
+									10,
+									5,
+									3,
 									true,
+									time.Hour,
+									time.Second,
+									time.Minute,
 								),
 							),
 						},
@@ -83,7 +152,12 @@ func TestCommandSide_AddDefaultLockoutPolicy(t *testing.T) {
 			args: args{
 				ctx:                 authz.WithInstanceID(context.Background(), "INSTANCE"),
 				maxPasswordAttempts: 10,
+				maxOTPAttempts:      5,
+				maxU2FAttempts:      3,
 				showLockOutFailures: true,
+				autoUnlockAfter:     time.Hour,
+				attemptDelay:        time.Second,
+				maxAttemptDelay:     time.Minute,
 			},
 			res: res{
 				want: &domain.ObjectDetails{
@@ -97,7 +171,7 @@ func TestCommandSide_AddDefaultLockoutPolicy(t *testing.T) {
 			r := &Commands{
 				eventstore: tt.fields.eventstore,
 			}
-			got, err := r.AddDefaultLockoutPolicy(tt.args.ctx, tt.args.maxPasswordAttempts, tt.args.showLockOutFailures)
+			got, err := r.AddDefaultLockoutPolicy(tt.args.ctx, tt.args.maxPasswordAttempts, tt.args.maxOTPAttempts, tt.args.maxU2FAttempts, tt.args.showLockOutFailures, tt.args.autoUnlockAfter, tt.args.attemptDelay, tt.args.maxAttemptDelay)
 			if tt.res.err == nil {
 				assert.NoError(t, err)
 			}
@@ -158,7 +232,12 @@ func TestCommandSide_ChangeDefaultLockoutPolicy(t *testing.T) {
 							instance.NewLockoutPolicyAddedEvent(context.Background(),
 								&instance.NewAggregate("INSTANCE").Aggregate,
 								10,
+								0,
+								0,
 								true,
+								0,
+								0,
+								0,
 							),
 						),
 					),
@@ -185,7 +264,12 @@ func TestCommandSide_ChangeDefaultLockoutPolicy(t *testing.T) {
 							instance.NewLockoutPolicyAddedEvent(context.Background(),
 								&instance.NewAggregate("INSTANCE").Aggregate,
 								10,
+								0,
+								0,
 								true,
+								0,
+								0,
+								0,
 							),
 						),
 					),
@@ -0,0 +1,88 @@
+package command
+
+import (
+	"context"
+
+	"github.com/dennigogo/zitadel/internal/api/authz"
+	"github.com/dennigogo/zitadel/internal/domain"
+	caos_errs "github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/repository/instance"
+)
+
+// SetRateLimits overrides the rate limits of the runtime configuration for the instance in the context
+func (c *Commands) SetRateLimits(ctx context.Context, limits *domain.RateLimits) (*domain.ObjectDetails, error) {
+	if !limits.IsValid() {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Rl2v8", "Errors.RateLimit.Invalid")
+	}
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	if instanceID == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Rl5i1", "Errors.IDMissing")
+	}
+	existingLimits, err := c.getRateLimitsWriteModel(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	if existingLimits.Exists && existingLimits.RateLimits == *limits {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Rl7n3", "Errors.NoChangesFound")
+	}
+
+	instanceAgg := InstanceAggregateFromWriteModel(&existingLimits.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, instance.NewRateLimitsSetEvent(
+		ctx,
+		instanceAgg,
+		rateLimitToEvent(limits.Instance),
+		rateLimitToEvent(limits.Client),
+		rateLimitToEvent(limits.IP),
+	))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingLimits, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingLimits.WriteModel), nil
+}
+
+// ResetRateLimits removes the overrides of the instance in the context,
+// so the rate limits of the runtime configuration apply again
+func (c *Commands) ResetRateLimits(ctx context.Context) (*domain.ObjectDetails, error) {
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	if instanceID == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Rl4r6", "Errors.IDMissing")
+	}
+	existingLimits, err := c.getRateLimitsWriteModel(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	if !existingLimits.Exists {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Rl9f2", "Errors.RateLimit.NotFound")
+	}
+
+	instanceAgg := InstanceAggregateFromWriteModel(&existingLimits.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, instance.NewRateLimitsResetEvent(ctx, instanceAgg))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingLimits, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingLimits.WriteModel), nil
+}
+
+func rateLimitToEvent(limit domain.RateLimit) instance.RateLimit {
+	return instance.RateLimit{
+		Requests: limit.Requests,
+		Interval: limit.Interval,
+	}
+}
+
+func (c *Commands) getRateLimitsWriteModel(ctx context.Context, instanceID string) (*InstanceRateLimitsWriteModel, error) {
+	writeModel := NewInstanceRateLimitsWriteModel(instanceID)
+	err := c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
@@ -0,0 +1,61 @@
+package command
+
+import (
+	"github.com/dennigogo/zitadel/internal/domain"
+	"github.com/dennigogo/zitadel/internal/eventstore"
+	"github.com/dennigogo/zitadel/internal/repository/instance"
+)
+
+type InstanceRateLimitsWriteModel struct {
+	eventstore.WriteModel
+
+	domain.RateLimits
+	Exists bool
+}
+
+func NewInstanceRateLimitsWriteModel(instanceID string) *InstanceRateLimitsWriteModel {
+	return &InstanceRateLimitsWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   instanceID,
+			ResourceOwner: instanceID,
+		},
+	}
+}
+
+func (wm *InstanceRateLimitsWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *instance.RateLimitsSetEvent:
+			wm.RateLimits = domain.RateLimits{
+				Instance: rateLimitFromEvent(e.Instance),
+				Client:   rateLimitFromEvent(e.Client),
+				IP:       rateLimitFromEvent(e.IP),
+			}
+			wm.Exists = true
+		case *instance.RateLimitsResetEvent:
+			wm.RateLimits = domain.RateLimits{}
+			wm.Exists = false
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *InstanceRateLimitsWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(instance.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			instance.RateLimitsSetEventType,
+			instance.RateLimitsResetEventType,
+		).
+		Builder()
+}
+
+func rateLimitFromEvent(limit instance.RateLimit) domain.RateLimit {
+	return domain.RateLimit{
+		Requests: limit.Requests,
+		Interval: limit.Interval,
+	}
+}
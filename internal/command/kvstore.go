@@ -0,0 +1,84 @@
+package command
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/dennigogo/zitadel/internal/domain"
+	caos_errs "github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/repository/kvstore"
+	"github.com/dennigogo/zitadel/internal/telemetry/tracing"
+)
+
+// SetKeyValue sets the value of the key in the key value store of the organisation
+func (c *Commands) SetKeyValue(ctx context.Context, orgID, key string, value []byte) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if orgID == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Kv2s8", "Errors.IDMissing")
+	}
+	if key == "" || len(key) > domain.KeyValueMaxKeyLength {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Kv5m1", "Errors.KeyValue.InvalidKey")
+	}
+	if len(value) == 0 || len(value) > domain.KeyValueMaxValueSize {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Kv8d3", "Errors.KeyValue.InvalidValue")
+	}
+	writeModel, err := c.getKeyValueStoreWriteModel(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	existing, ok := writeModel.Entries[key]
+	if ok && bytes.Equal(existing, value) {
+		return writeModelToObjectDetails(&writeModel.WriteModel), nil
+	}
+	if !ok && len(writeModel.Entries) >= domain.KeyValueMaxEntries {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Kv4n7", "Errors.KeyValue.MaxEntriesReached")
+	}
+
+	pushedEvents, err := c.eventstore.Push(ctx, kvstore.NewEntrySetEvent(ctx, KeyValueStoreAggregateFromWriteModel(&writeModel.WriteModel), key, value))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(writeModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// RemoveKeyValue removes the key from the key value store of the organisation
+func (c *Commands) RemoveKeyValue(ctx context.Context, orgID, key string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if orgID == "" || key == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Kv6p2", "Errors.IDMissing")
+	}
+	writeModel, err := c.getKeyValueStoreWriteModel(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := writeModel.Entries[key]; !ok {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Kv1r9", "Errors.KeyValue.NotFound")
+	}
+
+	pushedEvents, err := c.eventstore.Push(ctx, kvstore.NewEntryRemovedEvent(ctx, KeyValueStoreAggregateFromWriteModel(&writeModel.WriteModel), key))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(writeModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+func (c *Commands) getKeyValueStoreWriteModel(ctx context.Context, orgID string) (*KeyValueStoreWriteModel, error) {
+	writeModel := NewKeyValueStoreWriteModel(orgID)
+	err := c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
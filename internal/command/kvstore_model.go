@@ -0,0 +1,50 @@
+package command
+
+import (
+	"github.com/dennigogo/zitadel/internal/eventstore"
+	"github.com/dennigogo/zitadel/internal/repository/kvstore"
+)
+
+type KeyValueStoreWriteModel struct {
+	eventstore.WriteModel
+
+	Entries map[string][]byte
+}
+
+func NewKeyValueStoreWriteModel(orgID string) *KeyValueStoreWriteModel {
+	return &KeyValueStoreWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   orgID,
+			ResourceOwner: orgID,
+		},
+		Entries: make(map[string][]byte),
+	}
+}
+
+func (wm *KeyValueStoreWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *kvstore.EntrySetEvent:
+			wm.Entries[e.Key] = e.Value
+		case *kvstore.EntryRemovedEvent:
+			delete(wm.Entries, e.Key)
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *KeyValueStoreWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(kvstore.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			kvstore.EntrySetEventType,
+			kvstore.EntryRemovedEventType).
+		Builder()
+}
+
+func KeyValueStoreAggregateFromWriteModel(wm *eventstore.WriteModel) *eventstore.Aggregate {
+	return eventstore.AggregateFromWriteModel(wm, kvstore.AggregateType, kvstore.AggregateVersion)
+}
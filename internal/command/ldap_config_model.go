@@ -0,0 +1,169 @@
+package command
+
+import (
+	"github.com/dennigogo/zitadel/internal/crypto"
+	"github.com/dennigogo/zitadel/internal/domain"
+	"github.com/dennigogo/zitadel/internal/eventstore"
+	"github.com/dennigogo/zitadel/internal/repository/idpconfig"
+)
+
+type LDAPConfigWriteModel struct {
+	eventstore.WriteModel
+
+	IDPConfigID  string
+	URL          string
+	StartTLS     bool
+	BaseDN       string
+	BindDN       string
+	BindPassword *crypto.CryptoValue
+	UserFilter   string
+	Attributes   domain.LDAPAttributes
+	State        domain.IDPConfigState
+}
+
+func (wm *LDAPConfigWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *idpconfig.LDAPConfigAddedEvent:
+			wm.reduceConfigAddedEvent(e)
+		case *idpconfig.LDAPConfigChangedEvent:
+			wm.reduceConfigChangedEvent(e)
+		case *idpconfig.IDPConfigDeactivatedEvent:
+			wm.State = domain.IDPConfigStateInactive
+		case *idpconfig.IDPConfigReactivatedEvent:
+			wm.State = domain.IDPConfigStateActive
+		case *idpconfig.IDPConfigRemovedEvent:
+			wm.State = domain.IDPConfigStateRemoved
+		}
+	}
+
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *LDAPConfigWriteModel) reduceConfigAddedEvent(e *idpconfig.LDAPConfigAddedEvent) {
+	wm.IDPConfigID = e.IDPConfigID
+	wm.URL = e.URL
+	wm.StartTLS = e.StartTLS
+	wm.BaseDN = e.BaseDN
+	wm.BindDN = e.BindDN
+	wm.BindPassword = e.BindPassword
+	wm.UserFilter = e.UserFilter
+	wm.Attributes = ldapAttributesToDomain(e.LDAPAttributes)
+	wm.State = domain.IDPConfigStateActive
+}
+
+func (wm *LDAPConfigWriteModel) reduceConfigChangedEvent(e *idpconfig.LDAPConfigChangedEvent) {
+	if e.URL != nil {
+		wm.URL = *e.URL
+	}
+	if e.StartTLS != nil {
+		wm.StartTLS = *e.StartTLS
+	}
+	if e.BaseDN != nil {
+		wm.BaseDN = *e.BaseDN
+	}
+	if e.BindDN != nil {
+		wm.BindDN = *e.BindDN
+	}
+	if e.BindPassword != nil {
+		wm.BindPassword = e.BindPassword
+	}
+	if e.UserFilter != nil {
+		wm.UserFilter = *e.UserFilter
+	}
+	if e.IDAttribute != nil {
+		wm.Attributes.IDAttribute = *e.IDAttribute
+	}
+	if e.PreferredUsernameAttribute != nil {
+		wm.Attributes.PreferredUsernameAttribute = *e.PreferredUsernameAttribute
+	}
+	if e.FirstNameAttribute != nil {
+		wm.Attributes.FirstNameAttribute = *e.FirstNameAttribute
+	}
+	if e.LastNameAttribute != nil {
+		wm.Attributes.LastNameAttribute = *e.LastNameAttribute
+	}
+	if e.DisplayNameAttribute != nil {
+		wm.Attributes.DisplayNameAttribute = *e.DisplayNameAttribute
+	}
+	if e.EmailAttribute != nil {
+		wm.Attributes.EmailAttribute = *e.EmailAttribute
+	}
+	if e.PhoneAttribute != nil {
+		wm.Attributes.PhoneAttribute = *e.PhoneAttribute
+	}
+}
+
+// changes compares the config with the write model
+// the bind password is only changed if a new one is provided
+func (wm *LDAPConfigWriteModel) changes(config *domain.LDAPIDPConfig, secretCrypto crypto.Crypto) ([]idpconfig.LDAPConfigChanges, error) {
+	changes := make([]idpconfig.LDAPConfigChanges, 0)
+	if config.BindPasswordString != "" {
+		bindPassword, err := crypto.Crypt([]byte(config.BindPasswordString), secretCrypto)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, idpconfig.ChangeLDAPBindPassword(bindPassword))
+	}
+	if wm.URL != config.URL {
+		changes = append(changes, idpconfig.ChangeLDAPURL(config.URL))
+	}
+	if wm.StartTLS != config.StartTLS {
+		changes = append(changes, idpconfig.ChangeLDAPStartTLS(config.StartTLS))
+	}
+	if wm.BaseDN != config.BaseDN {
+		changes = append(changes, idpconfig.ChangeLDAPBaseDN(config.BaseDN))
+	}
+	if wm.BindDN != config.BindDN {
+		changes = append(changes, idpconfig.ChangeLDAPBindDN(config.BindDN))
+	}
+	if wm.UserFilter != config.UserFilter {
+		changes = append(changes, idpconfig.ChangeLDAPUserFilter(config.UserFilter))
+	}
+	if wm.Attributes.IDAttribute != config.Attributes.IDAttribute {
+		changes = append(changes, idpconfig.ChangeLDAPIDAttribute(config.Attributes.IDAttribute))
+	}
+	if wm.Attributes.PreferredUsernameAttribute != config.Attributes.PreferredUsernameAttribute {
+		changes = append(changes, idpconfig.ChangeLDAPPreferredUsernameAttribute(config.Attributes.PreferredUsernameAttribute))
+	}
+	if wm.Attributes.FirstNameAttribute != config.Attributes.FirstNameAttribute {
+		changes = append(changes, idpconfig.ChangeLDAPFirstNameAttribute(config.Attributes.FirstNameAttribute))
+	}
+	if wm.Attributes.LastNameAttribute != config.Attributes.LastNameAttribute {
+		changes = append(changes, idpconfig.ChangeLDAPLastNameAttribute(config.Attributes.LastNameAttribute))
+	}
+	if wm.Attributes.DisplayNameAttribute != config.Attributes.DisplayNameAttribute {
+		changes = append(changes, idpconfig.ChangeLDAPDisplayNameAttribute(config.Attributes.DisplayNameAttribute))
+	}
+	if wm.Attributes.EmailAttribute != config.Attributes.EmailAttribute {
+		changes = append(changes, idpconfig.ChangeLDAPEmailAttribute(config.Attributes.EmailAttribute))
+	}
+	if wm.Attributes.PhoneAttribute != config.Attributes.PhoneAttribute {
+		changes = append(changes, idpconfig.ChangeLDAPPhoneAttribute(config.Attributes.PhoneAttribute))
+	}
+	return changes, nil
+}
+
+func ldapAttributesToDomain(attributes idpconfig.LDAPAttributes) domain.LDAPAttributes {
+	return domain.LDAPAttributes{
+		IDAttribute:                attributes.IDAttribute,
+		PreferredUsernameAttribute: attributes.PreferredUsernameAttribute,
+		FirstNameAttribute:         attributes.FirstNameAttribute,
+		LastNameAttribute:          attributes.LastNameAttribute,
+		DisplayNameAttribute:       attributes.DisplayNameAttribute,
+		EmailAttribute:             attributes.EmailAttribute,
+		PhoneAttribute:             attributes.PhoneAttribute,
+	}
+}
+
+func ldapAttributesFromDomain(attributes domain.LDAPAttributes) idpconfig.LDAPAttributes {
+	return idpconfig.LDAPAttributes{
+		IDAttribute:                attributes.IDAttribute,
+		PreferredUsernameAttribute: attributes.PreferredUsernameAttribute,
+		FirstNameAttribute:         attributes.FirstNameAttribute,
+		LastNameAttribute:          attributes.LastNameAttribute,
+		DisplayNameAttribute:       attributes.DisplayNameAttribute,
+		EmailAttribute:             attributes.EmailAttribute,
+		PhoneAttribute:             attributes.PhoneAttribute,
+	}
+}
@@ -14,12 +14,17 @@ import (
 	"github.com/dennigogo/zitadel/internal/eventstore/repository"
 	"github.com/dennigogo/zitadel/internal/eventstore/repository/mock"
 	action_repo "github.com/dennigogo/zitadel/internal/repository/action"
+	deviceauth_repo "github.com/dennigogo/zitadel/internal/repository/deviceauth"
 	iam_repo "github.com/dennigogo/zitadel/internal/repository/instance"
 	key_repo "github.com/dennigogo/zitadel/internal/repository/keypair"
+	kvstore_repo "github.com/dennigogo/zitadel/internal/repository/kvstore"
 	"github.com/dennigogo/zitadel/internal/repository/org"
 	proj_repo "github.com/dennigogo/zitadel/internal/repository/project"
+	par_repo "github.com/dennigogo/zitadel/internal/repository/pushedauthrequest"
+	quota_repo "github.com/dennigogo/zitadel/internal/repository/quota"
 	usr_repo "github.com/dennigogo/zitadel/internal/repository/user"
 	"github.com/dennigogo/zitadel/internal/repository/usergrant"
+	webhook_repo "github.com/dennigogo/zitadel/internal/repository/webhook"
 )
 
 type expect func(mockRepository *mock.MockRepository)
@@ -37,6 +42,11 @@ func eventstoreExpect(t *testing.T, expects ...expect) *eventstore.Eventstore {
 	usergrant.RegisterEventMappers(es)
 	key_repo.RegisterEventMappers(es)
 	action_repo.RegisterEventMappers(es)
+	webhook_repo.RegisterEventMappers(es)
+	kvstore_repo.RegisterEventMappers(es)
+	deviceauth_repo.RegisterEventMappers(es)
+	par_repo.RegisterEventMappers(es)
+	quota_repo.RegisterEventMappers(es)
 	return es
 }
 
@@ -182,6 +192,12 @@ func eventFromEventPusherWithCreationDateNow(event eventstore.Command) *reposito
 	return e
 }
 
+func eventFromEventPusherWithCreationDate(event eventstore.Command, creationDate time.Time) *repository.Event {
+	e := eventFromEventPusher(event)
+	e.CreationDate = creationDate
+	return e
+}
+
 func uniqueConstraintsFromEventConstraint(constraint *eventstore.EventUniqueConstraint) *repository.UniqueConstraint {
 	return &repository.UniqueConstraint{
 		UniqueType:   constraint.UniqueType,
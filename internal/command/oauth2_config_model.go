@@ -0,0 +1,171 @@
+package command
+
+import (
+	"reflect"
+
+	"github.com/dennigogo/zitadel/internal/crypto"
+	"github.com/dennigogo/zitadel/internal/domain"
+	"github.com/dennigogo/zitadel/internal/eventstore"
+	"github.com/dennigogo/zitadel/internal/repository/idpconfig"
+)
+
+type OAuth2ConfigWriteModel struct {
+	eventstore.WriteModel
+
+	IDPConfigID           string
+	ClientID              string
+	ClientSecret          *crypto.CryptoValue
+	AuthorizationEndpoint string
+	TokenEndpoint         string
+	UserEndpoint          string
+	Scopes                []string
+	Mapping               domain.OAuth2UserMapping
+	State                 domain.IDPConfigState
+}
+
+func (wm *OAuth2ConfigWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *idpconfig.OAuth2ConfigAddedEvent:
+			wm.reduceConfigAddedEvent(e)
+		case *idpconfig.OAuth2ConfigChangedEvent:
+			wm.reduceConfigChangedEvent(e)
+		case *idpconfig.IDPConfigDeactivatedEvent:
+			wm.State = domain.IDPConfigStateInactive
+		case *idpconfig.IDPConfigReactivatedEvent:
+			wm.State = domain.IDPConfigStateActive
+		case *idpconfig.IDPConfigRemovedEvent:
+			wm.State = domain.IDPConfigStateRemoved
+		}
+	}
+
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *OAuth2ConfigWriteModel) reduceConfigAddedEvent(e *idpconfig.OAuth2ConfigAddedEvent) {
+	wm.IDPConfigID = e.IDPConfigID
+	wm.ClientID = e.ClientID
+	wm.ClientSecret = e.ClientSecret
+	wm.AuthorizationEndpoint = e.AuthorizationEndpoint
+	wm.TokenEndpoint = e.TokenEndpoint
+	wm.UserEndpoint = e.UserEndpoint
+	wm.Scopes = e.Scopes
+	wm.Mapping = oauth2UserMappingToDomain(e.OAuth2UserMapping)
+	wm.State = domain.IDPConfigStateActive
+}
+
+func (wm *OAuth2ConfigWriteModel) reduceConfigChangedEvent(e *idpconfig.OAuth2ConfigChangedEvent) {
+	if e.ClientID != nil {
+		wm.ClientID = *e.ClientID
+	}
+	if e.ClientSecret != nil {
+		wm.ClientSecret = e.ClientSecret
+	}
+	if e.AuthorizationEndpoint != nil {
+		wm.AuthorizationEndpoint = *e.AuthorizationEndpoint
+	}
+	if e.TokenEndpoint != nil {
+		wm.TokenEndpoint = *e.TokenEndpoint
+	}
+	if e.UserEndpoint != nil {
+		wm.UserEndpoint = *e.UserEndpoint
+	}
+	if len(e.Scopes) > 0 {
+		wm.Scopes = e.Scopes
+	}
+	if e.IDPath != nil {
+		wm.Mapping.IDPath = *e.IDPath
+	}
+	if e.PreferredUsernamePath != nil {
+		wm.Mapping.PreferredUsernamePath = *e.PreferredUsernamePath
+	}
+	if e.FirstNamePath != nil {
+		wm.Mapping.FirstNamePath = *e.FirstNamePath
+	}
+	if e.LastNamePath != nil {
+		wm.Mapping.LastNamePath = *e.LastNamePath
+	}
+	if e.DisplayNamePath != nil {
+		wm.Mapping.DisplayNamePath = *e.DisplayNamePath
+	}
+	if e.EmailPath != nil {
+		wm.Mapping.EmailPath = *e.EmailPath
+	}
+	if e.PhonePath != nil {
+		wm.Mapping.PhonePath = *e.PhonePath
+	}
+}
+
+// changes compares the config with the write model
+// the client secret is only changed if a new one is provided
+func (wm *OAuth2ConfigWriteModel) changes(config *domain.OAuth2IDPConfig, secretCrypto crypto.Crypto) ([]idpconfig.OAuth2ConfigChanges, error) {
+	changes := make([]idpconfig.OAuth2ConfigChanges, 0)
+	if config.ClientSecretString != "" {
+		clientSecret, err := crypto.Crypt([]byte(config.ClientSecretString), secretCrypto)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, idpconfig.ChangeOAuth2ClientSecret(clientSecret))
+	}
+	if wm.ClientID != config.ClientID {
+		changes = append(changes, idpconfig.ChangeOAuth2ClientID(config.ClientID))
+	}
+	if wm.AuthorizationEndpoint != config.AuthorizationEndpoint {
+		changes = append(changes, idpconfig.ChangeOAuth2AuthorizationEndpoint(config.AuthorizationEndpoint))
+	}
+	if wm.TokenEndpoint != config.TokenEndpoint {
+		changes = append(changes, idpconfig.ChangeOAuth2TokenEndpoint(config.TokenEndpoint))
+	}
+	if wm.UserEndpoint != config.UserEndpoint {
+		changes = append(changes, idpconfig.ChangeOAuth2UserEndpoint(config.UserEndpoint))
+	}
+	if !reflect.DeepEqual(wm.Scopes, config.Scopes) {
+		changes = append(changes, idpconfig.ChangeOAuth2Scopes(config.Scopes))
+	}
+	if wm.Mapping.IDPath != config.Mapping.IDPath {
+		changes = append(changes, idpconfig.ChangeOAuth2IDPath(config.Mapping.IDPath))
+	}
+	if wm.Mapping.PreferredUsernamePath != config.Mapping.PreferredUsernamePath {
+		changes = append(changes, idpconfig.ChangeOAuth2PreferredUsernamePath(config.Mapping.PreferredUsernamePath))
+	}
+	if wm.Mapping.FirstNamePath != config.Mapping.FirstNamePath {
+		changes = append(changes, idpconfig.ChangeOAuth2FirstNamePath(config.Mapping.FirstNamePath))
+	}
+	if wm.Mapping.LastNamePath != config.Mapping.LastNamePath {
+		changes = append(changes, idpconfig.ChangeOAuth2LastNamePath(config.Mapping.LastNamePath))
+	}
+	if wm.Mapping.DisplayNamePath != config.Mapping.DisplayNamePath {
+		changes = append(changes, idpconfig.ChangeOAuth2DisplayNamePath(config.Mapping.DisplayNamePath))
+	}
+	if wm.Mapping.EmailPath != config.Mapping.EmailPath {
+		changes = append(changes, idpconfig.ChangeOAuth2EmailPath(config.Mapping.EmailPath))
+	}
+	if wm.Mapping.PhonePath != config.Mapping.PhonePath {
+		changes = append(changes, idpconfig.ChangeOAuth2PhonePath(config.Mapping.PhonePath))
+	}
+	return changes, nil
+}
+
+func oauth2UserMappingToDomain(mapping idpconfig.OAuth2UserMapping) domain.OAuth2UserMapping {
+	return domain.OAuth2UserMapping{
+		IDPath:                mapping.IDPath,
+		PreferredUsernamePath: mapping.PreferredUsernamePath,
+		FirstNamePath:         mapping.FirstNamePath,
+		LastNamePath:          mapping.LastNamePath,
+		DisplayNamePath:       mapping.DisplayNamePath,
+		EmailPath:             mapping.EmailPath,
+		PhonePath:             mapping.PhonePath,
+	}
+}
+
+func oauth2UserMappingFromDomain(mapping domain.OAuth2UserMapping) idpconfig.OAuth2UserMapping {
+	return idpconfig.OAuth2UserMapping{
+		IDPath:                mapping.IDPath,
+		PreferredUsernamePath: mapping.PreferredUsernamePath,
+		FirstNamePath:         mapping.FirstNamePath,
+		LastNamePath:          mapping.LastNamePath,
+		DisplayNamePath:       mapping.DisplayNamePath,
+		EmailPath:             mapping.EmailPath,
+		PhonePath:             mapping.PhonePath,
+	}
+}
@@ -43,8 +43,8 @@ func (c *Commands) setUpOrgWithIDs(ctx context.Context, o *OrgSetup, orgID, user
 	}
 
 	validations := []preparation.Validation{
-		AddOrgCommand(ctx, orgAgg, o.Name, userIDs...),
-		AddHumanCommand(userAgg, &o.Human, c.userPasswordAlg, c.userEncryption),
+		AddOrgCommand(ctx, orgAgg, o.Name, c.quotas, userIDs...),
+		AddHumanCommand(userAgg, &o.Human, c.userPasswordAlg, c.userEncryption, c.breachedPasswords, c.quotas),
 		c.AddOrgMemberCommand(orgAgg, userID, roles...),
 	}
 	if o.CustomDomain != "" {
@@ -82,14 +82,18 @@ func (c *Commands) SetUpOrg(ctx context.Context, o *OrgSetup, userIDs ...string)
 }
 
 // AddOrgCommand defines the commands to create a new org,
-// this includes the verified default domain
-func AddOrgCommand(ctx context.Context, a *org.Aggregate, name string, userIDs ...string) preparation.Validation {
+// this includes the verified default domain,
+// the org is only created if the limit of the orgs quota is not reached
+func AddOrgCommand(ctx context.Context, a *org.Aggregate, name string, quotas QuotaEnforcer, userIDs ...string) preparation.Validation {
 	return func() (preparation.CreateCommands, error) {
 		if name = strings.TrimSpace(name); name == "" {
 			return nil, errors.ThrowInvalidArgument(nil, "ORG-mruNY", "Errors.Invalid.Argument")
 		}
 		defaultDomain := domain.NewIAMDomainName(name, authz.GetInstance(ctx).RequestedDomain())
 		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
+			if err := checkQuota(ctx, quotas, domain.QuotaUnitOrgs); err != nil {
+				return nil, err
+			}
 			return []eventstore.Command{
 				org.NewOrgAddedEvent(ctx, &a.Aggregate, name),
 				org.NewDomainAddedEvent(ctx, &a.Aggregate, defaultDomain),
@@ -289,6 +293,9 @@ func (c *Commands) addOrgWithID(ctx context.Context, organisation *domain.Org, o
 	if !organisation.IsValid() {
 		return nil, nil, nil, caos_errs.ThrowInvalidArgument(nil, "COMM-deLSk", "Errors.Org.Invalid")
 	}
+	if err := checkQuota(ctx, c.quotas, domain.QuotaUnitOrgs); err != nil {
+		return nil, nil, nil, err
+	}
 
 	organisation.AggregateID = orgID
 	organisation.AddIAMDomain(authz.GetInstance(ctx).RequestedDomain())
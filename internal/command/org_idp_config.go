@@ -26,7 +26,7 @@ func (c *Commands) AddIDPConfig(ctx context.Context, config *domain.IDPConfig, r
 	if resourceOwner == "" {
 		return nil, errors.ThrowInvalidArgument(nil, "Org-0j8gs", "Errors.ResourceOwnerMissing")
 	}
-	if config.OIDCConfig == nil && config.JWTConfig == nil {
+	if config.OIDCConfig == nil && config.JWTConfig == nil && config.SAMLConfig == nil && config.LDAPConfig == nil && config.OAuth2Config == nil {
 		return nil, errors.ThrowInvalidArgument(nil, "Org-eUpQU", "Errors.idp.config.notset")
 	}
 	idpConfigID, err := c.idGenerator.Next()
@@ -80,6 +80,65 @@ func (c *Commands) addIDPConfig(ctx context.Context, config *domain.IDPConfig, i
 			config.JWTConfig.KeysEndpoint,
 			config.JWTConfig.HeaderName,
 		))
+	} else if config.SAMLConfig != nil {
+		metadata, err := c.samlIDPMetadata(config.SAMLConfig)
+		if err != nil {
+			return nil, err
+		}
+		key, certificate, err := c.samlIDPKeyPair(idpConfigID)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, org_repo.NewIDPSAMLConfigAddedEvent(
+			ctx,
+			orgAgg,
+			idpConfigID,
+			metadata,
+			config.SAMLConfig.MetadataURL,
+			key,
+			certificate,
+			config.SAMLConfig.WithSignedRequest,
+		))
+	} else if config.LDAPConfig != nil {
+		if !config.LDAPConfig.IsValid() {
+			return nil, errors.ThrowInvalidArgument(nil, "Org-Lg5n1", "Errors.IDPConfig.Invalid")
+		}
+		bindPassword, err := crypto.Crypt([]byte(config.LDAPConfig.BindPasswordString), c.idpConfigEncryption)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, org_repo.NewIDPLDAPConfigAddedEvent(
+			ctx,
+			orgAgg,
+			idpConfigID,
+			config.LDAPConfig.URL,
+			config.LDAPConfig.StartTLS,
+			config.LDAPConfig.BaseDN,
+			config.LDAPConfig.BindDN,
+			bindPassword,
+			config.LDAPConfig.UserFilter,
+			ldapAttributesFromDomain(config.LDAPConfig.Attributes),
+		))
+	} else if config.OAuth2Config != nil {
+		if !config.OAuth2Config.IsValid() {
+			return nil, errors.ThrowInvalidArgument(nil, "Org-Oc6m1", "Errors.IDPConfig.Invalid")
+		}
+		clientSecret, err := crypto.Crypt([]byte(config.OAuth2Config.ClientSecretString), c.idpConfigEncryption)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, org_repo.NewIDPOAuth2ConfigAddedEvent(
+			ctx,
+			orgAgg,
+			idpConfigID,
+			config.OAuth2Config.ClientID,
+			clientSecret,
+			config.OAuth2Config.AuthorizationEndpoint,
+			config.OAuth2Config.TokenEndpoint,
+			config.OAuth2Config.UserEndpoint,
+			config.OAuth2Config.Scopes,
+			oauth2UserMappingFromDomain(config.OAuth2Config.Mapping),
+		))
 	}
 	pushedEvents, err := c.eventstore.Push(ctx, events...)
 	if err != nil {
@@ -0,0 +1,54 @@
+package command
+
+import (
+	"context"
+
+	"github.com/dennigogo/zitadel/internal/domain"
+	caos_errs "github.com/dennigogo/zitadel/internal/errors"
+)
+
+func (c *Commands) ChangeIDPLDAPConfig(ctx context.Context, config *domain.LDAPIDPConfig, resourceOwner string) (*domain.LDAPIDPConfig, error) {
+	if resourceOwner == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "Org-Lm2c7", "Errors.ResourceOwnerMissing")
+	}
+	if config.IDPConfigID == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "Org-Ld8sq", "Errors.IDMissing")
+	}
+	existingConfig := NewOrgIDPLDAPConfigWriteModel(config.IDPConfigID, resourceOwner)
+	err := c.eventstore.FilterToQueryReducer(ctx, existingConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	if existingConfig.State == domain.IDPConfigStateRemoved || existingConfig.State == domain.IDPConfigStateUnspecified {
+		return nil, caos_errs.ThrowNotFound(nil, "Org-Lw4rt", "Errors.IDPConfig.NotExisting")
+	}
+
+	if !config.IsValid() {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "Org-Lz6hy", "Errors.IDPConfig.Invalid")
+	}
+
+	orgAgg := OrgAggregateFromWriteModel(&existingConfig.WriteModel)
+	changedEvent, hasChanged, err := existingConfig.NewChangedEvent(
+		ctx,
+		orgAgg,
+		config,
+		c.idpConfigEncryption)
+	if err != nil {
+		return nil, err
+	}
+	if !hasChanged {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "Org-Lv1kp", "Errors.Org.IDPConfig.NotChanged")
+	}
+
+	pushedEvents, err := c.eventstore.Push(ctx, changedEvent)
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingConfig, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+
+	return writeModelToIDPLDAPConfig(&existingConfig.LDAPConfigWriteModel), nil
+}
@@ -0,0 +1,54 @@
+package command
+
+import (
+	"context"
+
+	"github.com/dennigogo/zitadel/internal/domain"
+	caos_errs "github.com/dennigogo/zitadel/internal/errors"
+)
+
+func (c *Commands) ChangeIDPOAuth2Config(ctx context.Context, config *domain.OAuth2IDPConfig, resourceOwner string) (*domain.OAuth2IDPConfig, error) {
+	if resourceOwner == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "Org-Om3c6", "Errors.ResourceOwnerMissing")
+	}
+	if config.IDPConfigID == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "Org-Od9sp", "Errors.IDMissing")
+	}
+	existingConfig := NewOrgIDPOAuth2ConfigWriteModel(config.IDPConfigID, resourceOwner)
+	err := c.eventstore.FilterToQueryReducer(ctx, existingConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	if existingConfig.State == domain.IDPConfigStateRemoved || existingConfig.State == domain.IDPConfigStateUnspecified {
+		return nil, caos_errs.ThrowNotFound(nil, "Org-Ow5rs", "Errors.IDPConfig.NotExisting")
+	}
+
+	if !config.IsValid() {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "Org-Oz7hx", "Errors.IDPConfig.Invalid")
+	}
+
+	orgAgg := OrgAggregateFromWriteModel(&existingConfig.WriteModel)
+	changedEvent, hasChanged, err := existingConfig.NewChangedEvent(
+		ctx,
+		orgAgg,
+		config,
+		c.idpConfigEncryption)
+	if err != nil {
+		return nil, err
+	}
+	if !hasChanged {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "Org-Ov2kn", "Errors.Org.IDPConfig.NotChanged")
+	}
+
+	pushedEvents, err := c.eventstore.Push(ctx, changedEvent)
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingConfig, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+
+	return writeModelToIDPOAuth2Config(&existingConfig.OAuth2ConfigWriteModel), nil
+}
@@ -0,0 +1,253 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dennigogo/zitadel/internal/crypto"
+	"github.com/dennigogo/zitadel/internal/domain"
+	caos_errs "github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/eventstore"
+	"github.com/dennigogo/zitadel/internal/eventstore/repository"
+	"github.com/dennigogo/zitadel/internal/eventstore/v1/models"
+	"github.com/dennigogo/zitadel/internal/repository/idpconfig"
+	"github.com/dennigogo/zitadel/internal/repository/org"
+)
+
+func TestCommandSide_ChangeIDPOAuth2Config(t *testing.T) {
+	type fields struct {
+		eventstore   *eventstore.Eventstore
+		secretCrypto crypto.EncryptionAlgorithm
+	}
+	type (
+		args struct {
+			ctx           context.Context
+			resourceOwner string
+			config        *domain.OAuth2IDPConfig
+		}
+	)
+	type res struct {
+		want *domain.OAuth2IDPConfig
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "invalid config, error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				config:        &domain.OAuth2IDPConfig{},
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "idp config not existing, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				config: &domain.OAuth2IDPConfig{
+					IDPConfigID: "config1",
+				},
+			},
+			res: res{
+				err: caos_errs.IsNotFound,
+			},
+		},
+		{
+			name: "missing user endpoint, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							newIDPOAuth2ConfigAddedEvent(),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				config: &domain.OAuth2IDPConfig{
+					IDPConfigID:           "config1",
+					ClientID:              "client-id",
+					AuthorizationEndpoint: "https://oauth.test.com/authorize",
+					TokenEndpoint:         "https://oauth.test.com/token",
+					Mapping:               oauth2UserMappingToDomain(testOAuth2Mapping),
+				},
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "no changes, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							newIDPOAuth2ConfigAddedEvent(),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				config: &domain.OAuth2IDPConfig{
+					IDPConfigID:           "config1",
+					ClientID:              "client-id",
+					AuthorizationEndpoint: "https://oauth.test.com/authorize",
+					TokenEndpoint:         "https://oauth.test.com/token",
+					UserEndpoint:          "https://oauth.test.com/user",
+					Scopes:                []string{"user"},
+					Mapping:               oauth2UserMappingToDomain(testOAuth2Mapping),
+				},
+			},
+			res: res{
+				err: caos_errs.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "idp config oauth2 change, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							newIDPOAuth2ConfigAddedEvent(),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								newIDPOAuth2ConfigChangedEvent(context.Background(),
+									"config1",
+									[]idpconfig.OAuth2ConfigChanges{
+										idpconfig.ChangeOAuth2ClientSecret(&crypto.CryptoValue{
+											CryptoType: crypto.TypeEncryption,
+											Algorithm:  "enc",
+											KeyID:      "id",
+											Crypted:    []byte("secret-changed"),
+										}),
+										idpconfig.ChangeOAuth2UserEndpoint("https://api.test.com/user"),
+										idpconfig.ChangeOAuth2Scopes([]string{"user", "email"}),
+										idpconfig.ChangeOAuth2EmailPath("emails.0.value"),
+									},
+								),
+							),
+						},
+					),
+				),
+				secretCrypto: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				config: &domain.OAuth2IDPConfig{
+					IDPConfigID:           "config1",
+					ClientID:              "client-id",
+					ClientSecretString:    "secret-changed",
+					AuthorizationEndpoint: "https://oauth.test.com/authorize",
+					TokenEndpoint:         "https://oauth.test.com/token",
+					UserEndpoint:          "https://api.test.com/user",
+					Scopes:                []string{"user", "email"},
+					Mapping: domain.OAuth2UserMapping{
+						IDPath:                "id",
+						PreferredUsernamePath: "login",
+						DisplayNamePath:       "name",
+						EmailPath:             "emails.0.value",
+					},
+				},
+			},
+			res: res{
+				want: &domain.OAuth2IDPConfig{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "org1",
+						ResourceOwner: "org1",
+					},
+					IDPConfigID:           "config1",
+					ClientID:              "client-id",
+					AuthorizationEndpoint: "https://oauth.test.com/authorize",
+					TokenEndpoint:         "https://oauth.test.com/token",
+					UserEndpoint:          "https://api.test.com/user",
+					Scopes:                []string{"user", "email"},
+					Mapping: domain.OAuth2UserMapping{
+						IDPath:                "id",
+						PreferredUsernamePath: "login",
+						DisplayNamePath:       "name",
+						EmailPath:             "emails.0.value",
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:          tt.fields.eventstore,
+				idpConfigEncryption: tt.fields.secretCrypto,
+			}
+			got, err := r.ChangeIDPOAuth2Config(tt.args.ctx, tt.args.config, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func newIDPOAuth2ConfigAddedEvent() *org.IDPOAuth2ConfigAddedEvent {
+	return org.NewIDPOAuth2ConfigAddedEvent(context.Background(),
+		&org.NewAggregate("org1").Aggregate,
+		"config1",
+		"client-id",
+		&crypto.CryptoValue{
+			CryptoType: crypto.TypeEncryption,
+			Algorithm:  "enc",
+			KeyID:      "id",
+			Crypted:    []byte("secret"),
+		},
+		"https://oauth.test.com/authorize",
+		"https://oauth.test.com/token",
+		"https://oauth.test.com/user",
+		[]string{"user"},
+		testOAuth2Mapping,
+	)
+}
+
+func newIDPOAuth2ConfigChangedEvent(ctx context.Context, configID string, changes []idpconfig.OAuth2ConfigChanges) *org.IDPOAuth2ConfigChangedEvent {
+	event, _ := org.NewIDPOAuth2ConfigChangedEvent(ctx,
+		&org.NewAggregate("org1").Aggregate,
+		configID,
+		changes,
+	)
+	return event
+}
@@ -0,0 +1,99 @@
+package command
+
+import (
+	"context"
+
+	"github.com/dennigogo/zitadel/internal/eventstore"
+	"github.com/dennigogo/zitadel/internal/repository/org"
+)
+
+type IDPSAMLConfigWriteModel struct {
+	SAMLConfigWriteModel
+}
+
+func NewOrgIDPSAMLConfigWriteModel(idpConfigID, orgID string) *IDPSAMLConfigWriteModel {
+	return &IDPSAMLConfigWriteModel{
+		SAMLConfigWriteModel{
+			WriteModel: eventstore.WriteModel{
+				AggregateID:   orgID,
+				ResourceOwner: orgID,
+			},
+			IDPConfigID: idpConfigID,
+		},
+	}
+}
+
+func (wm *IDPSAMLConfigWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *org.IDPSAMLConfigAddedEvent:
+			if wm.IDPConfigID != e.IDPConfigID {
+				continue
+			}
+			wm.SAMLConfigWriteModel.AppendEvents(&e.SAMLConfigAddedEvent)
+		case *org.IDPSAMLConfigChangedEvent:
+			if wm.IDPConfigID != e.IDPConfigID {
+				continue
+			}
+			wm.SAMLConfigWriteModel.AppendEvents(&e.SAMLConfigChangedEvent)
+		case *org.IDPConfigReactivatedEvent:
+			if wm.IDPConfigID != e.ConfigID {
+				continue
+			}
+			wm.SAMLConfigWriteModel.AppendEvents(&e.IDPConfigReactivatedEvent)
+		case *org.IDPConfigDeactivatedEvent:
+			if wm.IDPConfigID != e.ConfigID {
+				continue
+			}
+			wm.SAMLConfigWriteModel.AppendEvents(&e.IDPConfigDeactivatedEvent)
+		case *org.IDPConfigRemovedEvent:
+			if wm.IDPConfigID != e.ConfigID {
+				continue
+			}
+			wm.SAMLConfigWriteModel.AppendEvents(&e.IDPConfigRemovedEvent)
+		default:
+			wm.SAMLConfigWriteModel.AppendEvents(e)
+		}
+	}
+}
+
+func (wm *IDPSAMLConfigWriteModel) Reduce() error {
+	if err := wm.SAMLConfigWriteModel.Reduce(); err != nil {
+		return err
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *IDPSAMLConfigWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(org.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			org.IDPSAMLConfigAddedEventType,
+			org.IDPSAMLConfigChangedEventType,
+			org.IDPConfigReactivatedEventType,
+			org.IDPConfigDeactivatedEventType,
+			org.IDPConfigRemovedEventType).
+		Builder()
+}
+
+func (wm *IDPSAMLConfigWriteModel) NewChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	idpConfigID string,
+	metadata []byte,
+	metadataURL string,
+	withSignedRequest bool,
+) (*org.IDPSAMLConfigChangedEvent, bool, error) {
+	changes := wm.changes(metadata, metadataURL, withSignedRequest)
+	if len(changes) == 0 {
+		return nil, false, nil
+	}
+	changeEvent, err := org.NewIDPSAMLConfigChangedEvent(ctx, aggregate, idpConfigID, changes)
+	if err != nil {
+		return nil, false, err
+	}
+	return changeEvent, true, nil
+}
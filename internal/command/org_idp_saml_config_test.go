@@ -0,0 +1,220 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dennigogo/zitadel/internal/crypto"
+	"github.com/dennigogo/zitadel/internal/domain"
+	caos_errs "github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/eventstore"
+	"github.com/dennigogo/zitadel/internal/eventstore/repository"
+	"github.com/dennigogo/zitadel/internal/eventstore/v1/models"
+	"github.com/dennigogo/zitadel/internal/repository/idpconfig"
+	"github.com/dennigogo/zitadel/internal/repository/org"
+)
+
+func TestCommandSide_ChangeIDPSAMLConfig(t *testing.T) {
+	type fields struct {
+		eventstore   *eventstore.Eventstore
+		secretCrypto crypto.EncryptionAlgorithm
+	}
+	type (
+		args struct {
+			ctx           context.Context
+			resourceOwner string
+			config        *domain.SAMLIDPConfig
+		}
+	)
+	type res struct {
+		want *domain.SAMLIDPConfig
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "invalid config, error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				config:        &domain.SAMLIDPConfig{},
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "idp config not existing, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				config: &domain.SAMLIDPConfig{
+					IDPConfigID: "config1",
+					Metadata:    testIDPMetadata,
+				},
+			},
+			res: res{
+				err: caos_errs.IsNotFound,
+			},
+		},
+		{
+			name: "invalid metadata, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							newIDPSAMLConfigAddedEvent(),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				config: &domain.SAMLIDPConfig{
+					IDPConfigID: "config1",
+					Metadata:    []byte("metadata"),
+				},
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "no changes, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							newIDPSAMLConfigAddedEvent(),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				config: &domain.SAMLIDPConfig{
+					IDPConfigID: "config1",
+					Metadata:    testIDPMetadata,
+				},
+			},
+			res: res{
+				err: caos_errs.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "idp config saml change, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							newIDPSAMLConfigAddedEvent(),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								newIDPSAMLConfigChangedEvent(context.Background(),
+									"config1",
+									testIDPMetadataChanged,
+									true,
+								),
+							),
+						},
+					),
+				),
+				secretCrypto: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				config: &domain.SAMLIDPConfig{
+					IDPConfigID:       "config1",
+					Metadata:          testIDPMetadataChanged,
+					WithSignedRequest: true,
+				},
+			},
+			res: res{
+				want: &domain.SAMLIDPConfig{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "org1",
+						ResourceOwner: "org1",
+					},
+					IDPConfigID:       "config1",
+					Metadata:          testIDPMetadataChanged,
+					Certificate:       []byte("certificate"),
+					WithSignedRequest: true,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:          tt.fields.eventstore,
+				idpConfigEncryption: tt.fields.secretCrypto,
+			}
+			got, err := r.ChangeIDPSAMLConfig(tt.args.ctx, tt.args.config, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func newIDPSAMLConfigAddedEvent() *org.IDPSAMLConfigAddedEvent {
+	return org.NewIDPSAMLConfigAddedEvent(context.Background(),
+		&org.NewAggregate("org1").Aggregate,
+		"config1",
+		testIDPMetadata,
+		"",
+		&crypto.CryptoValue{
+			CryptoType: crypto.TypeEncryption,
+			Algorithm:  "enc",
+			KeyID:      "id",
+			Crypted:    []byte("key"),
+		},
+		[]byte("certificate"),
+		false,
+	)
+}
+
+func newIDPSAMLConfigChangedEvent(ctx context.Context, configID string, metadata []byte, withSignedRequest bool) *org.IDPSAMLConfigChangedEvent {
+	event, _ := org.NewIDPSAMLConfigChangedEvent(ctx,
+		&org.NewAggregate("org1").Aggregate,
+		configID,
+		[]idpconfig.SAMLConfigChanges{
+			idpconfig.ChangeSAMLMetadata(metadata),
+			idpconfig.ChangeSAMLWithSignedRequest(withSignedRequest),
+		},
+	)
+	return event
+}
@@ -2,6 +2,7 @@ package command
 
 import (
 	"context"
+	"time"
 
 	"github.com/dennigogo/zitadel/internal/eventstore"
 
@@ -56,15 +57,35 @@ func (wm *OrgLockoutPolicyWriteModel) Query() *eventstore.SearchQueryBuilder {
 func (wm *OrgLockoutPolicyWriteModel) NewChangedEvent(
 	ctx context.Context,
 	aggregate *eventstore.Aggregate,
-	maxAttempts uint64,
-	showLockoutFailure bool) (*org.LockoutPolicyChangedEvent, bool) {
+	maxAttempts,
+	maxOTPAttempts,
+	maxU2FAttempts uint64,
+	showLockoutFailure bool,
+	autoUnlockAfter,
+	attemptDelay,
+	maxAttemptDelay time.Duration) (*org.LockoutPolicyChangedEvent, bool) {
 	changes := make([]policy.LockoutPolicyChanges, 0)
 	if wm.MaxPasswordAttempts != maxAttempts {
 		changes = append(changes, policy.ChangeMaxAttempts(maxAttempts))
 	}
+	if wm.MaxOTPAttempts != maxOTPAttempts {
+		changes = append(changes, policy.ChangeMaxOTPAttempts(maxOTPAttempts))
+	}
+	if wm.MaxU2FAttempts != maxU2FAttempts {
+		changes = append(changes, policy.ChangeMaxU2FAttempts(maxU2FAttempts))
+	}
 	if wm.ShowLockOutFailures != showLockoutFailure {
 		changes = append(changes, policy.ChangeShowLockOutFailures(showLockoutFailure))
 	}
+	if wm.AutoUnlockAfter != autoUnlockAfter {
+		changes = append(changes, policy.ChangeAutoUnlockAfter(autoUnlockAfter))
+	}
+	if wm.AttemptDelay != attemptDelay {
+		changes = append(changes, policy.ChangeAttemptDelay(attemptDelay))
+	}
+	if wm.MaxAttemptDelay != maxAttemptDelay {
+		changes = append(changes, policy.ChangeMaxAttemptDelay(maxAttemptDelay))
+	}
 	if len(changes) == 0 {
 		return nil, false
 	}
@@ -3,6 +3,7 @@ package command
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -52,6 +53,25 @@ func TestCommandSide_AddPasswordLockoutPolicy(t *testing.T) {
 				err: caos_errs.IsErrorInvalidArgument,
 			},
 		},
+		{
+			name: "negative auto unlock, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				policy: &domain.LockoutPolicy{
+					MaxPasswordAttempts: 10,
+					AutoUnlockAfter:     -time.Hour,
+				},
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
 		{
 			name: "mail template already existing, already exists error",
 			fields: fields{
@@ -62,7 +82,12 @@ func TestCommandSide_AddPasswordLockoutPolicy(t *testing.T) {
 							org.NewLockoutPolicyAddedEvent(context.Background(),
 								&org.NewAggregate("org1").Aggregate,
 								10,
+								0,
+								0,
 								true,
+								0,
+								0,
+								0,
 							),
 						),
 					),
@@ -92,7 +117,12 @@ func TestCommandSide_AddPasswordLockoutPolicy(t *testing.T) {
 								org.NewLockoutPolicyAddedEvent(context.Background(),
 									&org.NewAggregate("org1").Aggregate,
 									10,
+									0,
+									0,
 									true,
+									0,
+									0,
+									0,
 								),
 							),
 						},
@@ -205,7 +235,12 @@ func TestCommandSide_ChangePasswordLockoutPolicy(t *testing.T) {
 							org.NewLockoutPolicyAddedEvent(context.Background(),
 								&org.NewAggregate("org1").Aggregate,
 								10,
+								0,
+								0,
 								true,
+								0,
+								0,
+								0,
 							),
 						),
 					),
@@ -233,7 +268,12 @@ func TestCommandSide_ChangePasswordLockoutPolicy(t *testing.T) {
 							org.NewLockoutPolicyAddedEvent(context.Background(),
 								&org.NewAggregate("org1").Aggregate,
 								10,
+								0,
+								0,
 								true,
+								0,
+								0,
+								0,
 							),
 						),
 					),
@@ -343,7 +383,12 @@ func TestCommandSide_RemovePasswordLockoutPolicy(t *testing.T) {
 							org.NewLockoutPolicyAddedEvent(context.Background(),
 								&org.NewAggregate("org1").Aggregate,
 								10,
+								0,
+								0,
 								true,
+								0,
+								0,
+								0,
 							),
 						),
 					),
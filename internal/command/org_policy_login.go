@@ -33,6 +33,7 @@ type AddLoginPolicy struct {
 	MFAInitSkipLifetime        time.Duration
 	SecondFactorCheckLifetime  time.Duration
 	MultiFactorCheckLifetime   time.Duration
+	TrustedDeviceLifetime      time.Duration
 	DisableLoginWithEmail      bool
 	DisableLoginWithPhone      bool
 }
@@ -57,6 +58,7 @@ type ChangeLoginPolicy struct {
 	MFAInitSkipLifetime        time.Duration
 	SecondFactorCheckLifetime  time.Duration
 	MultiFactorCheckLifetime   time.Duration
+	TrustedDeviceLifetime      time.Duration
 	DisableLoginWithEmail      bool
 	DisableLoginWithPhone      bool
 }
@@ -436,6 +438,7 @@ func prepareAddLoginPolicy(a *org.Aggregate, policy *AddLoginPolicy) preparation
 				policy.MFAInitSkipLifetime,
 				policy.SecondFactorCheckLifetime,
 				policy.MultiFactorCheckLifetime,
+				policy.TrustedDeviceLifetime,
 			))
 			for _, factor := range policy.SecondFactors {
 				cmds = append(cmds, org.NewLoginPolicySecondFactorAddedEvent(ctx, &a.Aggregate, factor))
@@ -480,7 +483,8 @@ func prepareChangeLoginPolicy(a *org.Aggregate, policy *ChangeLoginPolicy) prepa
 				policy.ExternalLoginCheckLifetime,
 				policy.MFAInitSkipLifetime,
 				policy.SecondFactorCheckLifetime,
-				policy.MultiFactorCheckLifetime)
+				policy.MultiFactorCheckLifetime,
+				policy.TrustedDeviceLifetime)
 			if !hasChanged {
 				return nil, caos_errs.ThrowPreconditionFailed(nil, "Org-5M9vdd", "Errors.Org.LoginPolicy.NotChanged")
 			}
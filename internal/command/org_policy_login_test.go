@@ -70,6 +70,7 @@ func TestCommandSide_AddLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
 							),
 						),
 					),
@@ -125,6 +126,7 @@ func TestCommandSide_AddLoginPolicy(t *testing.T) {
 									time.Hour*3,
 									time.Hour*4,
 									time.Hour*5,
+									0,
 								),
 							),
 						},
@@ -220,6 +222,7 @@ func TestCommandSide_AddLoginPolicy(t *testing.T) {
 									time.Hour*3,
 									time.Hour*4,
 									time.Hour*5,
+									0,
 								),
 							),
 							eventFromEventPusher(
@@ -348,6 +351,7 @@ func TestCommandSide_AddLoginPolicy(t *testing.T) {
 									time.Hour*3,
 									time.Hour*4,
 									time.Hour*5,
+									0,
 								),
 							),
 							eventFromEventPusher(
@@ -487,6 +491,7 @@ func TestCommandSide_ChangeLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
 							),
 						),
 					),
@@ -543,6 +548,7 @@ func TestCommandSide_ChangeLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
 							),
 						),
 					),
@@ -693,6 +699,7 @@ func TestCommandSide_RemoveLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
 							),
 						),
 					),
@@ -836,6 +843,7 @@ func TestCommandSide_AddIDPProviderLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
 							),
 						),
 					),
@@ -880,6 +888,7 @@ func TestCommandSide_AddIDPProviderLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
 							),
 						),
 					),
@@ -944,6 +953,7 @@ func TestCommandSide_AddIDPProviderLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
 							),
 						),
 					),
@@ -1111,6 +1121,7 @@ func TestCommandSide_RemoveIDPProviderLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
 							),
 						),
 					),
@@ -1155,6 +1166,7 @@ func TestCommandSide_RemoveIDPProviderLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
 							),
 						),
 					),
@@ -1211,6 +1223,7 @@ func TestCommandSide_RemoveIDPProviderLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
 							),
 						),
 					),
@@ -1274,6 +1287,7 @@ func TestCommandSide_RemoveIDPProviderLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
 							),
 						),
 					),
@@ -1345,6 +1359,7 @@ func TestCommandSide_RemoveIDPProviderLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
 							),
 						),
 					),
@@ -63,6 +63,7 @@ func TestCommandSide_AddPasswordAgePolicy(t *testing.T) {
 								&org.NewAggregate("org1").Aggregate,
 								365,
 								10,
+								0,
 							),
 						),
 					),
@@ -93,6 +94,7 @@ func TestCommandSide_AddPasswordAgePolicy(t *testing.T) {
 									&org.NewAggregate("org1").Aggregate,
 									10,
 									365,
+									5,
 								),
 							),
 						},
@@ -105,6 +107,7 @@ func TestCommandSide_AddPasswordAgePolicy(t *testing.T) {
 				policy: &domain.PasswordAgePolicy{
 					MaxAgeDays:     365,
 					ExpireWarnDays: 10,
+					HistoryCount:   5,
 				},
 			},
 			res: res{
@@ -115,6 +118,7 @@ func TestCommandSide_AddPasswordAgePolicy(t *testing.T) {
 					},
 					MaxAgeDays:     365,
 					ExpireWarnDays: 10,
+					HistoryCount:   5,
 				},
 			},
 		},
@@ -206,6 +210,7 @@ func TestCommandSide_ChangePasswordAgePolicy(t *testing.T) {
 								&org.NewAggregate("org1").Aggregate,
 								10,
 								365,
+								0,
 							),
 						),
 					),
@@ -234,13 +239,14 @@ func TestCommandSide_ChangePasswordAgePolicy(t *testing.T) {
 								&org.NewAggregate("org1").Aggregate,
 								10,
 								365,
+								0,
 							),
 						),
 					),
 					expectPush(
 						[]*repository.Event{
 							eventFromEventPusher(
-								newPasswordAgePolicyChangedEvent(context.Background(), "org1", 150, 5),
+								newPasswordAgePolicyChangedEvent(context.Background(), "org1", 150, 5, 3),
 							),
 						},
 					),
@@ -252,6 +258,7 @@ func TestCommandSide_ChangePasswordAgePolicy(t *testing.T) {
 				policy: &domain.PasswordAgePolicy{
 					MaxAgeDays:     150,
 					ExpireWarnDays: 5,
+					HistoryCount:   3,
 				},
 			},
 			res: res{
@@ -262,6 +269,7 @@ func TestCommandSide_ChangePasswordAgePolicy(t *testing.T) {
 					},
 					MaxAgeDays:     150,
 					ExpireWarnDays: 5,
+					HistoryCount:   3,
 				},
 			},
 		},
@@ -344,6 +352,7 @@ func TestCommandSide_RemovePasswordAgePolicy(t *testing.T) {
 								&org.NewAggregate("org1").Aggregate,
 								10,
 								365,
+								0,
 							),
 						),
 					),
@@ -387,12 +396,13 @@ func TestCommandSide_RemovePasswordAgePolicy(t *testing.T) {
 	}
 }
 
-func newPasswordAgePolicyChangedEvent(ctx context.Context, orgID string, maxAgeDays, expireWarnDays uint64) *org.PasswordAgePolicyChangedEvent {
+func newPasswordAgePolicyChangedEvent(ctx context.Context, orgID string, maxAgeDays, expireWarnDays, historyCount uint64) *org.PasswordAgePolicyChangedEvent {
 	event, _ := org.NewPasswordAgePolicyChangedEvent(ctx,
 		&org.NewAggregate(orgID).Aggregate,
 		[]policy.PasswordAgePolicyChanges{
 			policy.ChangeMaxAgeDays(maxAgeDays),
 			policy.ChangeExpireWarnDays(expireWarnDays),
+			policy.ChangeHistoryCount(historyCount),
 		},
 	)
 	return event
@@ -35,6 +35,9 @@ func (c *Commands) AddPasswordComplexityPolicy(ctx context.Context, resourceOwne
 	if err := policy.IsValid(); err != nil {
 		return nil, err
 	}
+	if !policy.BreachedPasswordCheck.Configured(c.breachedPasswords) {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "Org-Br2nc", "Errors.User.PasswordComplexityPolicy.BreachedPasswordsNotConfigured")
+	}
 	addedPolicy := NewOrgPasswordComplexityPolicyWriteModel(resourceOwner)
 	err := c.eventstore.FilterToQueryReducer(ctx, addedPolicy)
 	if err != nil {
@@ -54,7 +57,8 @@ func (c *Commands) AddPasswordComplexityPolicy(ctx context.Context, resourceOwne
 			policy.HasLowercase,
 			policy.HasUppercase,
 			policy.HasNumber,
-			policy.HasSymbol))
+			policy.HasSymbol,
+			policy.BreachedPasswordCheck))
 	if err != nil {
 		return nil, err
 	}
@@ -72,6 +76,9 @@ func (c *Commands) ChangePasswordComplexityPolicy(ctx context.Context, resourceO
 	if err := policy.IsValid(); err != nil {
 		return nil, err
 	}
+	if !policy.BreachedPasswordCheck.Configured(c.breachedPasswords) {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "Org-Br3nc", "Errors.User.PasswordComplexityPolicy.BreachedPasswordsNotConfigured")
+	}
 
 	existingPolicy := NewOrgPasswordComplexityPolicyWriteModel(resourceOwner)
 	err := c.eventstore.FilterToQueryReducer(ctx, existingPolicy)
@@ -83,7 +90,7 @@ func (c *Commands) ChangePasswordComplexityPolicy(ctx context.Context, resourceO
 	}
 
 	orgAgg := OrgAggregateFromWriteModel(&existingPolicy.PasswordComplexityPolicyWriteModel.WriteModel)
-	changedEvent, hasChanged := existingPolicy.NewChangedEvent(ctx, orgAgg, policy.MinLength, policy.HasLowercase, policy.HasUppercase, policy.HasNumber, policy.HasSymbol)
+	changedEvent, hasChanged := existingPolicy.NewChangedEvent(ctx, orgAgg, policy.MinLength, policy.HasLowercase, policy.HasUppercase, policy.HasNumber, policy.HasSymbol, policy.BreachedPasswordCheck)
 	if !hasChanged {
 		return nil, caos_errs.ThrowPreconditionFailed(nil, "Org-DAs21", "Errors.Org.PasswordComplexityPolicy.NotChanged")
 	}
@@ -3,6 +3,7 @@ package command
 import (
 	"context"
 
+	"github.com/dennigogo/zitadel/internal/domain"
 	"github.com/dennigogo/zitadel/internal/eventstore"
 
 	"github.com/dennigogo/zitadel/internal/repository/org"
@@ -61,6 +62,7 @@ func (wm *OrgPasswordComplexityPolicyWriteModel) NewChangedEvent(
 	hasUppercase,
 	hasNumber,
 	hasSymbol bool,
+	breachedPasswordCheck domain.BreachedPasswordCheck,
 ) (*org.PasswordComplexityPolicyChangedEvent, bool) {
 
 	changes := make([]policy.PasswordComplexityPolicyChanges, 0)
@@ -79,6 +81,9 @@ func (wm *OrgPasswordComplexityPolicyWriteModel) NewChangedEvent(
 	if wm.HasSymbol != hasSymbol {
 		changes = append(changes, policy.ChangeHasSymbol(hasSymbol))
 	}
+	if wm.BreachedPasswordCheck != breachedPasswordCheck {
+		changes = append(changes, policy.ChangeBreachedPasswordCheck(breachedPasswordCheck))
+	}
 	if len(changes) == 0 {
 		return nil, false
 	}
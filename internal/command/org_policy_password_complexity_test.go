@@ -55,6 +55,29 @@ func TestCommandSide_AddPasswordComplexityPolicy(t *testing.T) {
 				err: caos_errs.IsErrorInvalidArgument,
 			},
 		},
+		{
+			name: "breached passwords not configured, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				policy: &domain.PasswordComplexityPolicy{
+					MinLength:             8,
+					HasUppercase:          true,
+					HasLowercase:          true,
+					HasNumber:             true,
+					HasSymbol:             true,
+					BreachedPasswordCheck: domain.BreachedPasswordCheckWarn,
+				},
+			},
+			res: res{
+				err: caos_errs.IsPreconditionFailed,
+			},
+		},
 		{
 			name: "policy already existing, already exists error",
 			fields: fields{
@@ -66,6 +89,7 @@ func TestCommandSide_AddPasswordComplexityPolicy(t *testing.T) {
 								&org.NewAggregate("org1").Aggregate,
 								8,
 								true, true, true, true,
+								domain.BreachedPasswordCheckDisabled,
 							),
 						),
 					),
@@ -99,6 +123,7 @@ func TestCommandSide_AddPasswordComplexityPolicy(t *testing.T) {
 									&org.NewAggregate("org1").Aggregate,
 									8,
 									true, true, true, true,
+									domain.BreachedPasswordCheckDisabled,
 								),
 							),
 						},
@@ -224,6 +249,7 @@ func TestCommandSide_ChangePasswordComplexityPolicy(t *testing.T) {
 								&org.NewAggregate("org1").Aggregate,
 								8,
 								true, true, true, true,
+								domain.BreachedPasswordCheckDisabled,
 							),
 						),
 					),
@@ -255,6 +281,7 @@ func TestCommandSide_ChangePasswordComplexityPolicy(t *testing.T) {
 								&org.NewAggregate("org1").Aggregate,
 								8,
 								true, true, true, true,
+								domain.BreachedPasswordCheckDisabled,
 							),
 						),
 					),
@@ -371,6 +398,7 @@ func TestCommandSide_RemovePasswordComplexityPolicy(t *testing.T) {
 								&org.NewAggregate("org1").Aggregate,
 								8,
 								true, true, true, true,
+								domain.BreachedPasswordCheckDisabled,
 							),
 						),
 					),
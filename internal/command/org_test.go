@@ -22,8 +22,9 @@ import (
 
 func TestAddOrg(t *testing.T) {
 	type args struct {
-		a    *org.Aggregate
-		name string
+		a      *org.Aggregate
+		name   string
+		quotas QuotaEnforcer
 	}
 
 	ctx := context.Background()
@@ -44,6 +45,17 @@ func TestAddOrg(t *testing.T) {
 				ValidationErr: errors.ThrowInvalidArgument(nil, "ORG-mruNY", "Errors.Invalid.Argument"),
 			},
 		},
+		{
+			name: "orgs quota exhausted",
+			args: args{
+				a:      agg,
+				name:   "caos ag",
+				quotas: testQuotas{domain.QuotaUnitOrgs: true},
+			},
+			want: Want{
+				CreateErr: errors.ThrowPreconditionFailed(nil, "COMMAND-Qu0ta", "Errors.Quota.Exhausted"),
+			},
+		},
 		{
 			name: "correct",
 			args: args{
@@ -62,7 +74,7 @@ func TestAddOrg(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			AssertValidation(t, context.Background(), AddOrgCommand(authz.WithRequestedDomain(context.Background(), "localhost"), tt.args.a, tt.args.name), nil, tt.want)
+			AssertValidation(t, context.Background(), AddOrgCommand(authz.WithRequestedDomain(context.Background(), "localhost"), tt.args.a, tt.args.name, tt.args.quotas), nil, tt.want)
 		})
 	}
 }
@@ -72,6 +84,7 @@ func TestCommandSide_AddOrg(t *testing.T) {
 		eventstore   *eventstore.Eventstore
 		idGenerator  id.Generator
 		zitadelRoles []authz.RoleMapping
+		quotas       QuotaEnforcer
 	}
 	type args struct {
 		ctx            context.Context
@@ -123,6 +136,25 @@ func TestCommandSide_AddOrg(t *testing.T) {
 				err: errors.IsErrorInvalidArgument,
 			},
 		},
+		{
+			name: "orgs quota exhausted, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "org2"),
+				quotas:      testQuotas{domain.QuotaUnitOrgs: true},
+			},
+			args: args{
+				ctx:           authz.WithRequestedDomain(context.Background(), "zitadel.ch"),
+				name:          "Org",
+				userID:        "user1",
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: errors.IsPreconditionFailed,
+			},
+		},
 		{
 			name: "user removed, error",
 			fields: fields{
@@ -462,6 +494,7 @@ func TestCommandSide_AddOrg(t *testing.T) {
 				eventstore:   tt.fields.eventstore,
 				idGenerator:  tt.fields.idGenerator,
 				zitadelRoles: tt.fields.zitadelRoles,
+				quotas:       tt.fields.quotas,
 			}
 			got, err := r.AddOrg(tt.args.ctx, tt.args.name, tt.args.userID, tt.args.resourceOwner, tt.args.claimedUserIDs)
 			if tt.res.err == nil {
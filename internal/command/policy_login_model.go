@@ -27,6 +27,7 @@ type LoginPolicyWriteModel struct {
 	MFAInitSkipLifetime        time.Duration
 	SecondFactorCheckLifetime  time.Duration
 	MultiFactorCheckLifetime   time.Duration
+	TrustedDeviceLifetime      time.Duration
 	State                      domain.PolicyState
 }
 
@@ -50,6 +51,7 @@ func (wm *LoginPolicyWriteModel) Reduce() error {
 			wm.MFAInitSkipLifetime = e.MFAInitSkipLifetime
 			wm.SecondFactorCheckLifetime = e.SecondFactorCheckLifetime
 			wm.MultiFactorCheckLifetime = e.MultiFactorCheckLifetime
+			wm.TrustedDeviceLifetime = e.TrustedDeviceLifetime
 			wm.State = domain.PolicyStateActive
 		case *policy.LoginPolicyChangedEvent:
 			if e.AllowRegister != nil {
@@ -94,6 +96,9 @@ func (wm *LoginPolicyWriteModel) Reduce() error {
 			if e.MultiFactorCheckLifetime != nil {
 				wm.MultiFactorCheckLifetime = *e.MultiFactorCheckLifetime
 			}
+			if e.TrustedDeviceLifetime != nil {
+				wm.TrustedDeviceLifetime = *e.TrustedDeviceLifetime
+			}
 			if e.DisableLoginWithEmail != nil {
 				wm.DisableLoginWithEmail = *e.DisableLoginWithEmail
 			}
@@ -24,7 +24,10 @@ type PasswordComplexityPolicyWriteModel struct {
 	HasUppercase bool
 	HasNumber    bool
 	HasSymbol    bool
-	State        domain.PolicyState
+
+	BreachedPasswordCheck domain.BreachedPasswordCheck
+
+	State domain.PolicyState
 }
 
 func (wm *PasswordComplexityPolicyWriteModel) Reduce() error {
@@ -36,6 +39,7 @@ func (wm *PasswordComplexityPolicyWriteModel) Reduce() error {
 			wm.HasUppercase = e.HasUppercase
 			wm.HasNumber = e.HasNumber
 			wm.HasSymbol = e.HasSymbol
+			wm.BreachedPasswordCheck = e.BreachedPasswordCheck
 			wm.State = domain.PolicyStateActive
 		case *policy.PasswordComplexityPolicyChangedEvent:
 			if e.MinLength != nil {
@@ -53,6 +57,9 @@ func (wm *PasswordComplexityPolicyWriteModel) Reduce() error {
 			if e.HasSymbol != nil {
 				wm.HasSymbol = *e.HasSymbol
 			}
+			if e.BreachedPasswordCheck != nil {
+				wm.BreachedPasswordCheck = *e.BreachedPasswordCheck
+			}
 		case *policy.PasswordComplexityPolicyRemovedEvent:
 			wm.State = domain.PolicyStateRemoved
 		}
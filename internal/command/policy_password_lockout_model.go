@@ -1,6 +1,8 @@
 package command
 
 import (
+	"time"
+
 	"github.com/dennigogo/zitadel/internal/domain"
 	"github.com/dennigogo/zitadel/internal/eventstore"
 	"github.com/dennigogo/zitadel/internal/repository/policy"
@@ -10,7 +12,12 @@ type LockoutPolicyWriteModel struct {
 	eventstore.WriteModel
 
 	MaxPasswordAttempts uint64
+	MaxOTPAttempts      uint64
+	MaxU2FAttempts      uint64
 	ShowLockOutFailures bool
+	AutoUnlockAfter     time.Duration
+	AttemptDelay        time.Duration
+	MaxAttemptDelay     time.Duration
 	State               domain.PolicyState
 }
 
@@ -19,15 +26,35 @@ func (wm *LockoutPolicyWriteModel) Reduce() error {
 		switch e := event.(type) {
 		case *policy.LockoutPolicyAddedEvent:
 			wm.MaxPasswordAttempts = e.MaxPasswordAttempts
+			wm.MaxOTPAttempts = e.MaxOTPAttempts
+			wm.MaxU2FAttempts = e.MaxU2FAttempts
 			wm.ShowLockOutFailures = e.ShowLockOutFailures
+			wm.AutoUnlockAfter = e.AutoUnlockAfter
+			wm.AttemptDelay = e.AttemptDelay
+			wm.MaxAttemptDelay = e.MaxAttemptDelay
 			wm.State = domain.PolicyStateActive
 		case *policy.LockoutPolicyChangedEvent:
 			if e.MaxPasswordAttempts != nil {
 				wm.MaxPasswordAttempts = *e.MaxPasswordAttempts
 			}
+			if e.MaxOTPAttempts != nil {
+				wm.MaxOTPAttempts = *e.MaxOTPAttempts
+			}
+			if e.MaxU2FAttempts != nil {
+				wm.MaxU2FAttempts = *e.MaxU2FAttempts
+			}
 			if e.ShowLockOutFailures != nil {
 				wm.ShowLockOutFailures = *e.ShowLockOutFailures
 			}
+			if e.AutoUnlockAfter != nil {
+				wm.AutoUnlockAfter = *e.AutoUnlockAfter
+			}
+			if e.AttemptDelay != nil {
+				wm.AttemptDelay = *e.AttemptDelay
+			}
+			if e.MaxAttemptDelay != nil {
+				wm.MaxAttemptDelay = *e.MaxAttemptDelay
+			}
 		case *policy.LockoutPolicyRemovedEvent:
 			wm.State = domain.PolicyStateRemoved
 		}
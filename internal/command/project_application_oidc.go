@@ -20,20 +20,24 @@ import (
 
 type addOIDCApp struct {
 	AddApp
-	Version                  domain.OIDCVersion
-	RedirectUris             []string
-	ResponseTypes            []domain.OIDCResponseType
-	GrantTypes               []domain.OIDCGrantType
-	ApplicationType          domain.OIDCApplicationType
-	AuthMethodType           domain.OIDCAuthMethodType
-	PostLogoutRedirectUris   []string
-	DevMode                  bool
-	AccessTokenType          domain.OIDCTokenType
-	AccessTokenRoleAssertion bool
-	IDTokenRoleAssertion     bool
-	IDTokenUserinfoAssertion bool
-	ClockSkew                time.Duration
-	AdditionalOrigins        []string
+	Version                    domain.OIDCVersion
+	RedirectUris               []string
+	ResponseTypes              []domain.OIDCResponseType
+	GrantTypes                 []domain.OIDCGrantType
+	ApplicationType            domain.OIDCApplicationType
+	AuthMethodType             domain.OIDCAuthMethodType
+	PostLogoutRedirectUris     []string
+	DevMode                    bool
+	AccessTokenType            domain.OIDCTokenType
+	AccessTokenRoleAssertion   bool
+	IDTokenRoleAssertion       bool
+	IDTokenUserinfoAssertion   bool
+	ClockSkew                  time.Duration
+	AdditionalOrigins          []string
+	BackChannelLogoutURI       string
+	FrontChannelLogoutURI      string
+	RequirePushedAuthRequests  bool
+	RequireSignedRequestObject bool
 
 	ClientID          string
 	ClientSecret      *crypto.CryptoValue
@@ -61,6 +65,10 @@ func (c *Commands) AddOIDCAppCommand(app *addOIDCApp, clientSecretAlg crypto.Has
 			}
 		}
 
+		if !domain.IsValidLogoutURI(app.BackChannelLogoutURI) || !domain.IsValidLogoutURI(app.FrontChannelLogoutURI) {
+			return nil, errors.ThrowInvalidArgument(nil, "V2-Lg8wN", "Errors.Invalid.Argument")
+		}
+
 		if !domain.ContainsRequiredGrantTypes(app.ResponseTypes, app.GrantTypes) {
 			return nil, errors.ThrowInvalidArgument(nil, "V2-sLpW1", "Errors.Invalid.Argument")
 		}
@@ -110,6 +118,10 @@ func (c *Commands) AddOIDCAppCommand(app *addOIDCApp, clientSecretAlg crypto.Has
 					app.IDTokenUserinfoAssertion,
 					app.ClockSkew,
 					app.AdditionalOrigins,
+					app.BackChannelLogoutURI,
+					app.FrontChannelLogoutURI,
+					app.RequirePushedAuthRequests,
+					app.RequireSignedRequestObject,
 				),
 			}, nil
 		}, nil
@@ -192,7 +204,11 @@ func (c *Commands) addOIDCApplicationWithID(ctx context.Context, oidcApp *domain
 		oidcApp.IDTokenRoleAssertion,
 		oidcApp.IDTokenUserinfoAssertion,
 		oidcApp.ClockSkew,
-		oidcApp.AdditionalOrigins))
+		oidcApp.AdditionalOrigins,
+		oidcApp.BackChannelLogoutURI,
+		oidcApp.FrontChannelLogoutURI,
+		oidcApp.RequirePushedAuthRequests,
+		oidcApp.RequireSignedRequestObject))
 
 	addedApplication.AppID = oidcApp.AppID
 	pushedEvents, err := c.eventstore.Push(ctx, events...)
@@ -242,7 +258,11 @@ func (c *Commands) ChangeOIDCApplication(ctx context.Context, oidc *domain.OIDCA
 		oidc.IDTokenRoleAssertion,
 		oidc.IDTokenUserinfoAssertion,
 		oidc.ClockSkew,
-		oidc.AdditionalOrigins)
+		oidc.AdditionalOrigins,
+		oidc.BackChannelLogoutURI,
+		oidc.FrontChannelLogoutURI,
+		oidc.RequirePushedAuthRequests,
+		oidc.RequireSignedRequestObject)
 	if err != nil {
 		return nil, err
 	}
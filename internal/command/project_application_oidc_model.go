@@ -14,28 +14,32 @@ import (
 type OIDCApplicationWriteModel struct {
 	eventstore.WriteModel
 
-	AppID                    string
-	AppName                  string
-	ClientID                 string
-	ClientSecret             *crypto.CryptoValue
-	ClientSecretString       string
-	RedirectUris             []string
-	ResponseTypes            []domain.OIDCResponseType
-	GrantTypes               []domain.OIDCGrantType
-	ApplicationType          domain.OIDCApplicationType
-	AuthMethodType           domain.OIDCAuthMethodType
-	PostLogoutRedirectUris   []string
-	OIDCVersion              domain.OIDCVersion
-	Compliance               *domain.Compliance
-	DevMode                  bool
-	AccessTokenType          domain.OIDCTokenType
-	AccessTokenRoleAssertion bool
-	IDTokenRoleAssertion     bool
-	IDTokenUserinfoAssertion bool
-	ClockSkew                time.Duration
-	State                    domain.AppState
-	AdditionalOrigins        []string
-	oidc                     bool
+	AppID                      string
+	AppName                    string
+	ClientID                   string
+	ClientSecret               *crypto.CryptoValue
+	ClientSecretString         string
+	RedirectUris               []string
+	ResponseTypes              []domain.OIDCResponseType
+	GrantTypes                 []domain.OIDCGrantType
+	ApplicationType            domain.OIDCApplicationType
+	AuthMethodType             domain.OIDCAuthMethodType
+	PostLogoutRedirectUris     []string
+	OIDCVersion                domain.OIDCVersion
+	Compliance                 *domain.Compliance
+	DevMode                    bool
+	AccessTokenType            domain.OIDCTokenType
+	AccessTokenRoleAssertion   bool
+	IDTokenRoleAssertion       bool
+	IDTokenUserinfoAssertion   bool
+	ClockSkew                  time.Duration
+	State                      domain.AppState
+	AdditionalOrigins          []string
+	BackChannelLogoutURI       string
+	FrontChannelLogoutURI      string
+	RequirePushedAuthRequests  bool
+	RequireSignedRequestObject bool
+	oidc                       bool
 }
 
 func NewOIDCApplicationWriteModelWithAppID(projectID, appID, resourceOwner string) *OIDCApplicationWriteModel {
@@ -156,6 +160,10 @@ func (wm *OIDCApplicationWriteModel) appendAddOIDCEvent(e *project.OIDCConfigAdd
 	wm.IDTokenUserinfoAssertion = e.IDTokenUserinfoAssertion
 	wm.ClockSkew = e.ClockSkew
 	wm.AdditionalOrigins = e.AdditionalOrigins
+	wm.BackChannelLogoutURI = e.BackChannelLogoutURI
+	wm.FrontChannelLogoutURI = e.FrontChannelLogoutURI
+	wm.RequirePushedAuthRequests = e.RequirePushedAuthRequests
+	wm.RequireSignedRequestObject = e.RequireSignedRequestObject
 }
 
 func (wm *OIDCApplicationWriteModel) appendChangeOIDCEvent(e *project.OIDCConfigChangedEvent) {
@@ -201,6 +209,18 @@ func (wm *OIDCApplicationWriteModel) appendChangeOIDCEvent(e *project.OIDCConfig
 	if e.AdditionalOrigins != nil {
 		wm.AdditionalOrigins = *e.AdditionalOrigins
 	}
+	if e.BackChannelLogoutURI != nil {
+		wm.BackChannelLogoutURI = *e.BackChannelLogoutURI
+	}
+	if e.FrontChannelLogoutURI != nil {
+		wm.FrontChannelLogoutURI = *e.FrontChannelLogoutURI
+	}
+	if e.RequirePushedAuthRequests != nil {
+		wm.RequirePushedAuthRequests = *e.RequirePushedAuthRequests
+	}
+	if e.RequireSignedRequestObject != nil {
+		wm.RequireSignedRequestObject = *e.RequireSignedRequestObject
+	}
 }
 
 func (wm *OIDCApplicationWriteModel) Query() *eventstore.SearchQueryBuilder {
@@ -240,6 +260,10 @@ func (wm *OIDCApplicationWriteModel) NewChangedEvent(
 	idTokenUserinfoAssertion bool,
 	clockSkew time.Duration,
 	additionalOrigins []string,
+	backChannelLogoutURI,
+	frontChannelLogoutURI string,
+	requirePushedAuthRequests,
+	requireSignedRequestObject bool,
 ) (*project.OIDCConfigChangedEvent, bool, error) {
 	changes := make([]project.OIDCConfigChanges, 0)
 	var err error
@@ -286,6 +310,18 @@ func (wm *OIDCApplicationWriteModel) NewChangedEvent(
 	if !reflect.DeepEqual(wm.AdditionalOrigins, additionalOrigins) {
 		changes = append(changes, project.ChangeAdditionalOrigins(additionalOrigins))
 	}
+	if wm.BackChannelLogoutURI != backChannelLogoutURI {
+		changes = append(changes, project.ChangeBackChannelLogoutURI(backChannelLogoutURI))
+	}
+	if wm.FrontChannelLogoutURI != frontChannelLogoutURI {
+		changes = append(changes, project.ChangeFrontChannelLogoutURI(frontChannelLogoutURI))
+	}
+	if wm.RequirePushedAuthRequests != requirePushedAuthRequests {
+		changes = append(changes, project.ChangeRequirePushedAuthRequests(requirePushedAuthRequests))
+	}
+	if wm.RequireSignedRequestObject != requireSignedRequestObject {
+		changes = append(changes, project.ChangeRequireSignedRequestObject(requireSignedRequestObject))
+	}
 	if len(changes) == 0 {
 		return nil, false, nil
 	}
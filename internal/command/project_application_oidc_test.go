@@ -169,6 +169,10 @@ func TestAddOIDCApp(t *testing.T) {
 						false,
 						0,
 						nil,
+						"",
+						"",
+						false,
+						false,
 					),
 				},
 			},
@@ -325,7 +329,11 @@ func TestCommandSide_AddOIDCApplication(t *testing.T) {
 									true,
 									true,
 									time.Second*1,
-									[]string{"https://sub.test.ch"}),
+									[]string{"https://sub.test.ch"},
+									"https://test.ch/backchannel",
+									"https://test.ch/frontchannel",
+									true,
+									true),
 							),
 						},
 						uniqueConstraintsFromEventConstraint(project.NewAddApplicationUniqueConstraint("app", "project1")),
@@ -339,21 +347,25 @@ func TestCommandSide_AddOIDCApplication(t *testing.T) {
 					ObjectRoot: models.ObjectRoot{
 						AggregateID: "project1",
 					},
-					AppName:                  "app",
-					AuthMethodType:           domain.OIDCAuthMethodTypePost,
-					OIDCVersion:              domain.OIDCVersionV1,
-					RedirectUris:             []string{"https://test.ch"},
-					ResponseTypes:            []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
-					GrantTypes:               []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
-					ApplicationType:          domain.OIDCApplicationTypeWeb,
-					PostLogoutRedirectUris:   []string{"https://test.ch/logout"},
-					DevMode:                  true,
-					AccessTokenType:          domain.OIDCTokenTypeBearer,
-					AccessTokenRoleAssertion: true,
-					IDTokenRoleAssertion:     true,
-					IDTokenUserinfoAssertion: true,
-					ClockSkew:                time.Second * 1,
-					AdditionalOrigins:        []string{"https://sub.test.ch"},
+					AppName:                    "app",
+					AuthMethodType:             domain.OIDCAuthMethodTypePost,
+					OIDCVersion:                domain.OIDCVersionV1,
+					RedirectUris:               []string{"https://test.ch"},
+					ResponseTypes:              []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+					GrantTypes:                 []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+					ApplicationType:            domain.OIDCApplicationTypeWeb,
+					PostLogoutRedirectUris:     []string{"https://test.ch/logout"},
+					DevMode:                    true,
+					AccessTokenType:            domain.OIDCTokenTypeBearer,
+					AccessTokenRoleAssertion:   true,
+					IDTokenRoleAssertion:       true,
+					IDTokenUserinfoAssertion:   true,
+					ClockSkew:                  time.Second * 1,
+					AdditionalOrigins:          []string{"https://sub.test.ch"},
+					BackChannelLogoutURI:       "https://test.ch/backchannel",
+					FrontChannelLogoutURI:      "https://test.ch/frontchannel",
+					RequirePushedAuthRequests:  true,
+					RequireSignedRequestObject: true,
 				},
 				resourceOwner:   "org1",
 				secretGenerator: GetMockSecretGenerator(t),
@@ -364,26 +376,30 @@ func TestCommandSide_AddOIDCApplication(t *testing.T) {
 						AggregateID:   "project1",
 						ResourceOwner: "org1",
 					},
-					AppID:                    "app1",
-					AppName:                  "app",
-					ClientID:                 "client1@project",
-					ClientSecretString:       "a",
-					AuthMethodType:           domain.OIDCAuthMethodTypePost,
-					OIDCVersion:              domain.OIDCVersionV1,
-					RedirectUris:             []string{"https://test.ch"},
-					ResponseTypes:            []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
-					GrantTypes:               []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
-					ApplicationType:          domain.OIDCApplicationTypeWeb,
-					PostLogoutRedirectUris:   []string{"https://test.ch/logout"},
-					DevMode:                  true,
-					AccessTokenType:          domain.OIDCTokenTypeBearer,
-					AccessTokenRoleAssertion: true,
-					IDTokenRoleAssertion:     true,
-					IDTokenUserinfoAssertion: true,
-					ClockSkew:                time.Second * 1,
-					AdditionalOrigins:        []string{"https://sub.test.ch"},
-					State:                    domain.AppStateActive,
-					Compliance:               &domain.Compliance{},
+					AppID:                      "app1",
+					AppName:                    "app",
+					ClientID:                   "client1@project",
+					ClientSecretString:         "a",
+					AuthMethodType:             domain.OIDCAuthMethodTypePost,
+					OIDCVersion:                domain.OIDCVersionV1,
+					RedirectUris:               []string{"https://test.ch"},
+					ResponseTypes:              []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+					GrantTypes:                 []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+					ApplicationType:            domain.OIDCApplicationTypeWeb,
+					PostLogoutRedirectUris:     []string{"https://test.ch/logout"},
+					DevMode:                    true,
+					AccessTokenType:            domain.OIDCTokenTypeBearer,
+					AccessTokenRoleAssertion:   true,
+					IDTokenRoleAssertion:       true,
+					IDTokenUserinfoAssertion:   true,
+					ClockSkew:                  time.Second * 1,
+					AdditionalOrigins:          []string{"https://sub.test.ch"},
+					BackChannelLogoutURI:       "https://test.ch/backchannel",
+					FrontChannelLogoutURI:      "https://test.ch/frontchannel",
+					RequirePushedAuthRequests:  true,
+					RequireSignedRequestObject: true,
+					State:                      domain.AppStateActive,
+					Compliance:                 &domain.Compliance{},
 				},
 			},
 		},
@@ -558,7 +574,11 @@ func TestCommandSide_ChangeOIDCApplication(t *testing.T) {
 								true,
 								true,
 								time.Second*1,
-								[]string{"https://sub.test.ch"}),
+								[]string{"https://sub.test.ch"},
+								"",
+								"",
+								false,
+								false),
 						),
 					),
 				),
@@ -629,7 +649,11 @@ func TestCommandSide_ChangeOIDCApplication(t *testing.T) {
 								true,
 								true,
 								time.Second*1,
-								[]string{"https://sub.test.ch"}),
+								[]string{"https://sub.test.ch"},
+								"",
+								"",
+								false,
+								false),
 						),
 					),
 					expectPush(
@@ -650,22 +674,24 @@ func TestCommandSide_ChangeOIDCApplication(t *testing.T) {
 					ObjectRoot: models.ObjectRoot{
 						AggregateID: "project1",
 					},
-					AppID:                    "app1",
-					AppName:                  "app",
-					AuthMethodType:           domain.OIDCAuthMethodTypePost,
-					OIDCVersion:              domain.OIDCVersionV1,
-					RedirectUris:             []string{"https://test-change.ch"},
-					ResponseTypes:            []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
-					GrantTypes:               []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
-					ApplicationType:          domain.OIDCApplicationTypeWeb,
-					PostLogoutRedirectUris:   []string{"https://test-change.ch/logout"},
-					DevMode:                  true,
-					AccessTokenType:          domain.OIDCTokenTypeJWT,
-					AccessTokenRoleAssertion: false,
-					IDTokenRoleAssertion:     false,
-					IDTokenUserinfoAssertion: false,
-					ClockSkew:                time.Second * 2,
-					AdditionalOrigins:        []string{"https://sub.test.ch"},
+					AppID:                     "app1",
+					AppName:                   "app",
+					AuthMethodType:            domain.OIDCAuthMethodTypePost,
+					OIDCVersion:               domain.OIDCVersionV1,
+					RedirectUris:              []string{"https://test-change.ch"},
+					ResponseTypes:             []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+					GrantTypes:                []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+					ApplicationType:           domain.OIDCApplicationTypeWeb,
+					PostLogoutRedirectUris:    []string{"https://test-change.ch/logout"},
+					DevMode:                   true,
+					AccessTokenType:           domain.OIDCTokenTypeJWT,
+					AccessTokenRoleAssertion:  false,
+					IDTokenRoleAssertion:      false,
+					IDTokenUserinfoAssertion:  false,
+					ClockSkew:                 time.Second * 2,
+					AdditionalOrigins:         []string{"https://sub.test.ch"},
+					BackChannelLogoutURI:      "https://test-change.ch/backchannel",
+					RequirePushedAuthRequests: true,
 				},
 				resourceOwner: "org1",
 			},
@@ -675,25 +701,27 @@ func TestCommandSide_ChangeOIDCApplication(t *testing.T) {
 						AggregateID:   "project1",
 						ResourceOwner: "org1",
 					},
-					AppID:                    "app1",
-					ClientID:                 "client1@project",
-					AppName:                  "app",
-					AuthMethodType:           domain.OIDCAuthMethodTypePost,
-					OIDCVersion:              domain.OIDCVersionV1,
-					RedirectUris:             []string{"https://test-change.ch"},
-					ResponseTypes:            []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
-					GrantTypes:               []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
-					ApplicationType:          domain.OIDCApplicationTypeWeb,
-					PostLogoutRedirectUris:   []string{"https://test-change.ch/logout"},
-					DevMode:                  true,
-					AccessTokenType:          domain.OIDCTokenTypeJWT,
-					AccessTokenRoleAssertion: false,
-					IDTokenRoleAssertion:     false,
-					IDTokenUserinfoAssertion: false,
-					ClockSkew:                time.Second * 2,
-					AdditionalOrigins:        []string{"https://sub.test.ch"},
-					Compliance:               &domain.Compliance{},
-					State:                    domain.AppStateActive,
+					AppID:                     "app1",
+					ClientID:                  "client1@project",
+					AppName:                   "app",
+					AuthMethodType:            domain.OIDCAuthMethodTypePost,
+					OIDCVersion:               domain.OIDCVersionV1,
+					RedirectUris:              []string{"https://test-change.ch"},
+					ResponseTypes:             []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+					GrantTypes:                []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+					ApplicationType:           domain.OIDCApplicationTypeWeb,
+					PostLogoutRedirectUris:    []string{"https://test-change.ch/logout"},
+					DevMode:                   true,
+					AccessTokenType:           domain.OIDCTokenTypeJWT,
+					AccessTokenRoleAssertion:  false,
+					IDTokenRoleAssertion:      false,
+					IDTokenUserinfoAssertion:  false,
+					ClockSkew:                 time.Second * 2,
+					AdditionalOrigins:         []string{"https://sub.test.ch"},
+					BackChannelLogoutURI:      "https://test-change.ch/backchannel",
+					RequirePushedAuthRequests: true,
+					Compliance:                &domain.Compliance{},
+					State:                     domain.AppStateActive,
 				},
 			},
 		},
@@ -826,7 +854,11 @@ func TestCommandSide_ChangeOIDCApplicationSecret(t *testing.T) {
 								true,
 								true,
 								time.Second*1,
-								[]string{"https://sub.test.ch"}),
+								[]string{"https://sub.test.ch"},
+								"",
+								"",
+								false,
+								false),
 						),
 					),
 					expectPush(
@@ -911,6 +943,8 @@ func newOIDCAppChangedEvent(ctx context.Context, appID, projectID, resourceOwner
 		project.ChangeIDTokenRoleAssertion(false),
 		project.ChangeIDTokenUserinfoAssertion(false),
 		project.ChangeClockSkew(time.Second * 2),
+		project.ChangeBackChannelLogoutURI("https://test-change.ch/backchannel"),
+		project.ChangeRequirePushedAuthRequests(true),
 	}
 	event, _ := project.NewOIDCConfigChangedEvent(ctx,
 		&project.NewAggregate(projectID, resourceOwner).Aggregate,
@@ -35,25 +35,29 @@ func applicationWriteModelToApplication(writeModel *ApplicationWriteModel) domai
 
 func oidcWriteModelToOIDCConfig(writeModel *OIDCApplicationWriteModel) *domain.OIDCApp {
 	return &domain.OIDCApp{
-		ObjectRoot:               writeModelToObjectRoot(writeModel.WriteModel),
-		AppID:                    writeModel.AppID,
-		AppName:                  writeModel.AppName,
-		State:                    writeModel.State,
-		ClientID:                 writeModel.ClientID,
-		RedirectUris:             writeModel.RedirectUris,
-		ResponseTypes:            writeModel.ResponseTypes,
-		GrantTypes:               writeModel.GrantTypes,
-		ApplicationType:          writeModel.ApplicationType,
-		AuthMethodType:           writeModel.AuthMethodType,
-		PostLogoutRedirectUris:   writeModel.PostLogoutRedirectUris,
-		OIDCVersion:              writeModel.OIDCVersion,
-		DevMode:                  writeModel.DevMode,
-		AccessTokenType:          writeModel.AccessTokenType,
-		AccessTokenRoleAssertion: writeModel.AccessTokenRoleAssertion,
-		IDTokenRoleAssertion:     writeModel.IDTokenRoleAssertion,
-		IDTokenUserinfoAssertion: writeModel.IDTokenUserinfoAssertion,
-		ClockSkew:                writeModel.ClockSkew,
-		AdditionalOrigins:        writeModel.AdditionalOrigins,
+		ObjectRoot:                 writeModelToObjectRoot(writeModel.WriteModel),
+		AppID:                      writeModel.AppID,
+		AppName:                    writeModel.AppName,
+		State:                      writeModel.State,
+		ClientID:                   writeModel.ClientID,
+		RedirectUris:               writeModel.RedirectUris,
+		ResponseTypes:              writeModel.ResponseTypes,
+		GrantTypes:                 writeModel.GrantTypes,
+		ApplicationType:            writeModel.ApplicationType,
+		AuthMethodType:             writeModel.AuthMethodType,
+		PostLogoutRedirectUris:     writeModel.PostLogoutRedirectUris,
+		OIDCVersion:                writeModel.OIDCVersion,
+		DevMode:                    writeModel.DevMode,
+		AccessTokenType:            writeModel.AccessTokenType,
+		AccessTokenRoleAssertion:   writeModel.AccessTokenRoleAssertion,
+		IDTokenRoleAssertion:       writeModel.IDTokenRoleAssertion,
+		IDTokenUserinfoAssertion:   writeModel.IDTokenUserinfoAssertion,
+		ClockSkew:                  writeModel.ClockSkew,
+		AdditionalOrigins:          writeModel.AdditionalOrigins,
+		BackChannelLogoutURI:       writeModel.BackChannelLogoutURI,
+		FrontChannelLogoutURI:      writeModel.FrontChannelLogoutURI,
+		RequirePushedAuthRequests:  writeModel.RequirePushedAuthRequests,
+		RequireSignedRequestObject: writeModel.RequireSignedRequestObject,
 	}
 }
 
@@ -0,0 +1,101 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/dennigogo/zitadel/internal/api/authz"
+	"github.com/dennigogo/zitadel/internal/domain"
+	caos_errs "github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/repository/pushedauthrequest"
+	"github.com/dennigogo/zitadel/internal/telemetry/tracing"
+)
+
+// AddPushedAuthRequest stores the (already validated) parameters of an authorization request
+// pushed by the client (RFC 9126), it returns the id of the request to be used in the request_uri
+func (c *Commands) AddPushedAuthRequest(ctx context.Context, clientID string, params map[string][]string, expires time.Time) (_ string, _ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if clientID == "" || len(params) == 0 {
+		return "", nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Pr3ks", "Errors.PushedAuthRequest.Invalid")
+	}
+	id, err := c.idGenerator.Next()
+	if err != nil {
+		return "", nil, err
+	}
+	writeModel := NewPushedAuthRequestWriteModel(id, authz.GetInstance(ctx).InstanceID())
+	pushedEvents, err := c.eventstore.Push(ctx, pushedauthrequest.NewAddedEvent(
+		ctx,
+		PushedAuthRequestAggregateFromWriteModel(&writeModel.WriteModel),
+		clientID,
+		params,
+		expires,
+	))
+	if err != nil {
+		return "", nil, err
+	}
+	err = AppendAndReduce(writeModel, pushedEvents...)
+	if err != nil {
+		return "", nil, err
+	}
+	return id, writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// UsePushedAuthRequest returns the parameters of the pushed authorization request of the client
+// and marks it as used, so it can't be used for a second authorization request.
+// The unique constraint of the used event prevents that concurrent requests both redeem it.
+func (c *Commands) UsePushedAuthRequest(ctx context.Context, id, clientID string) (_ map[string][]string, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if id == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Pr8mw", "Errors.IDMissing")
+	}
+	writeModel := NewPushedAuthRequestWriteModel(id, authz.GetInstance(ctx).InstanceID())
+	err = c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.State.Exists() || writeModel.ClientID != clientID {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Pr2fn", "Errors.PushedAuthRequest.NotFound")
+	}
+	if writeModel.State == domain.PushedAuthRequestStateUsed {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Pr6tz", "Errors.PushedAuthRequest.AlreadyUsed")
+	}
+	if writeModel.isExpired() {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Pr9qd", "Errors.PushedAuthRequest.Expired")
+	}
+	_, err = c.eventstore.Push(ctx, pushedauthrequest.NewUsedEvent(ctx, PushedAuthRequestAggregateFromWriteModel(&writeModel.WriteModel)))
+	if caos_errs.IsErrorAlreadyExists(err) {
+		return nil, caos_errs.ThrowPreconditionFailed(err, "COMMAND-Pr4xe", "Errors.PushedAuthRequest.AlreadyUsed")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return writeModel.Params, nil
+}
+
+// UseRequestObject marks the request object (RFC 9101) of the client as used by its jti,
+// so it can't be replayed for another authorization request
+func (c *Commands) UseRequestObject(ctx context.Context, clientID, jwtID string, expires time.Time) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if clientID == "" || jwtID == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Ro2kd", "Errors.RequestObject.Invalid")
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, pushedauthrequest.NewRequestObjectUsedEvent(
+		ctx,
+		&pushedauthrequest.NewRequestObjectAggregate(clientID, authz.GetInstance(ctx).InstanceID()).Aggregate,
+		jwtID,
+		expires,
+	))
+	if caos_errs.IsErrorAlreadyExists(err) {
+		return nil, caos_errs.ThrowPreconditionFailed(err, "COMMAND-Ro5jw", "Errors.RequestObject.AlreadyUsed")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return pushedEventsToObjectDetails(pushedEvents), nil
+}
@@ -0,0 +1,62 @@
+package command
+
+import (
+	"time"
+
+	"github.com/dennigogo/zitadel/internal/domain"
+	"github.com/dennigogo/zitadel/internal/eventstore"
+	"github.com/dennigogo/zitadel/internal/repository/pushedauthrequest"
+)
+
+type PushedAuthRequestWriteModel struct {
+	eventstore.WriteModel
+
+	ClientID string
+	Params   map[string][]string
+	Expires  time.Time
+	State    domain.PushedAuthRequestState
+}
+
+func NewPushedAuthRequestWriteModel(id, instanceID string) *PushedAuthRequestWriteModel {
+	return &PushedAuthRequestWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   id,
+			ResourceOwner: instanceID,
+		},
+	}
+}
+
+func (wm *PushedAuthRequestWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *pushedauthrequest.AddedEvent:
+			wm.ClientID = e.ClientID
+			wm.Params = e.Params
+			wm.Expires = e.Expires
+			wm.State = domain.PushedAuthRequestStateActive
+		case *pushedauthrequest.UsedEvent:
+			wm.State = domain.PushedAuthRequestStateUsed
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *PushedAuthRequestWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(pushedauthrequest.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			pushedauthrequest.AddedEventType,
+			pushedauthrequest.UsedEventType).
+		Builder()
+}
+
+func (wm *PushedAuthRequestWriteModel) isExpired() bool {
+	return wm.Expires.Before(time.Now())
+}
+
+func PushedAuthRequestAggregateFromWriteModel(wm *eventstore.WriteModel) *eventstore.Aggregate {
+	return eventstore.AggregateFromWriteModel(wm, pushedauthrequest.AggregateType, pushedauthrequest.AggregateVersion)
+}
@@ -0,0 +1,428 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dennigogo/zitadel/internal/api/authz"
+	"github.com/dennigogo/zitadel/internal/domain"
+	caos_errs "github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/eventstore"
+	"github.com/dennigogo/zitadel/internal/eventstore/repository"
+	"github.com/dennigogo/zitadel/internal/id"
+	id_mock "github.com/dennigogo/zitadel/internal/id/mock"
+	"github.com/dennigogo/zitadel/internal/repository/pushedauthrequest"
+)
+
+func TestCommandSide_AddPushedAuthRequest(t *testing.T) {
+	expires := time.Now().Add(time.Minute).UTC()
+	type fields struct {
+		eventstore  *eventstore.Eventstore
+		idGenerator id.Generator
+	}
+	type args struct {
+		ctx      context.Context
+		clientID string
+		params   map[string][]string
+	}
+	type res struct {
+		id   string
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "params missing, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx:      authz.WithInstanceID(context.Background(), "instance1"),
+				clientID: "client1",
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "add pushed auth request, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusherWithInstanceID("instance1",
+								pushedauthrequest.NewAddedEvent(context.Background(),
+									&pushedauthrequest.NewAggregate("par1", "instance1").Aggregate,
+									"client1",
+									map[string][]string{"scope": {"openid"}},
+									expires,
+								),
+							),
+						},
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "par1"),
+			},
+			args: args{
+				ctx:      authz.WithInstanceID(context.Background(), "instance1"),
+				clientID: "client1",
+				params:   map[string][]string{"scope": {"openid"}},
+			},
+			res: res{
+				id: "par1",
+				want: &domain.ObjectDetails{
+					ResourceOwner: "instance1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:  tt.fields.eventstore,
+				idGenerator: tt.fields.idGenerator,
+			}
+			gotID, got, err := r.AddPushedAuthRequest(tt.args.ctx, tt.args.clientID, tt.args.params, expires)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.id, gotID)
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_UsePushedAuthRequest(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx      context.Context
+		id       string
+		clientID string
+	}
+	type res struct {
+		want map[string][]string
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "id missing, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx:      authz.WithInstanceID(context.Background(), "instance1"),
+				clientID: "client1",
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "not found, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:      authz.WithInstanceID(context.Background(), "instance1"),
+				id:       "par1",
+				clientID: "client1",
+			},
+			res: res{
+				err: caos_errs.IsNotFound,
+			},
+		},
+		{
+			name: "other client, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							pushedAuthRequestAddedEvent(time.Now().Add(time.Minute)),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:      authz.WithInstanceID(context.Background(), "instance1"),
+				id:       "par1",
+				clientID: "client2",
+			},
+			res: res{
+				err: caos_errs.IsNotFound,
+			},
+		},
+		{
+			name: "already used, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							pushedAuthRequestAddedEvent(time.Now().Add(time.Minute)),
+						),
+						eventFromEventPusher(
+							pushedauthrequest.NewUsedEvent(context.Background(),
+								&pushedauthrequest.NewAggregate("par1", "instance1").Aggregate,
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:      authz.WithInstanceID(context.Background(), "instance1"),
+				id:       "par1",
+				clientID: "client1",
+			},
+			res: res{
+				err: caos_errs.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "expired, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							pushedAuthRequestAddedEvent(time.Now().Add(-time.Minute)),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:      authz.WithInstanceID(context.Background(), "instance1"),
+				id:       "par1",
+				clientID: "client1",
+			},
+			res: res{
+				err: caos_errs.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "used concurrently, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							pushedAuthRequestAddedEvent(time.Now().Add(time.Minute)),
+						),
+					),
+					expectPushFailed(
+						caos_errs.ThrowAlreadyExists(nil, "ERROR", "internal"),
+						[]*repository.Event{
+							eventFromEventPusherWithInstanceID("instance1",
+								pushedauthrequest.NewUsedEvent(context.Background(),
+									&pushedauthrequest.NewAggregate("par1", "instance1").Aggregate,
+								),
+							),
+						},
+						uniqueConstraintsFromEventConstraintWithInstanceID("instance1", pushedauthrequest.NewUsedUniqueConstraint("par1")),
+					),
+				),
+			},
+			args: args{
+				ctx:      authz.WithInstanceID(context.Background(), "instance1"),
+				id:       "par1",
+				clientID: "client1",
+			},
+			res: res{
+				err: caos_errs.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "use pushed auth request, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							pushedAuthRequestAddedEvent(time.Now().Add(time.Minute)),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusherWithInstanceID("instance1",
+								pushedauthrequest.NewUsedEvent(context.Background(),
+									&pushedauthrequest.NewAggregate("par1", "instance1").Aggregate,
+								),
+							),
+						},
+						uniqueConstraintsFromEventConstraintWithInstanceID("instance1", pushedauthrequest.NewUsedUniqueConstraint("par1")),
+					),
+				),
+			},
+			args: args{
+				ctx:      authz.WithInstanceID(context.Background(), "instance1"),
+				id:       "par1",
+				clientID: "client1",
+			},
+			res: res{
+				want: map[string][]string{"scope": {"openid"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := r.UsePushedAuthRequest(tt.args.ctx, tt.args.id, tt.args.clientID)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_UseRequestObject(t *testing.T) {
+	expires := time.Now().Add(time.Minute).UTC()
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx      context.Context
+		clientID string
+		jwtID    string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "jti missing, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx:      authz.WithInstanceID(context.Background(), "instance1"),
+				clientID: "client1",
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "already used, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectPushFailed(
+						caos_errs.ThrowAlreadyExists(nil, "ERROR", "internal"),
+						[]*repository.Event{
+							eventFromEventPusherWithInstanceID("instance1",
+								pushedauthrequest.NewRequestObjectUsedEvent(context.Background(),
+									&pushedauthrequest.NewRequestObjectAggregate("client1", "instance1").Aggregate,
+									"jti1",
+									expires,
+								),
+							),
+						},
+						uniqueConstraintsFromEventConstraintWithInstanceID("instance1", pushedauthrequest.NewRequestObjectUniqueConstraint("client1", "jti1")),
+					),
+				),
+			},
+			args: args{
+				ctx:      authz.WithInstanceID(context.Background(), "instance1"),
+				clientID: "client1",
+				jwtID:    "jti1",
+			},
+			res: res{
+				err: caos_errs.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "use request object, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusherWithInstanceID("instance1",
+								pushedauthrequest.NewRequestObjectUsedEvent(context.Background(),
+									&pushedauthrequest.NewRequestObjectAggregate("client1", "instance1").Aggregate,
+									"jti1",
+									expires,
+								),
+							),
+						},
+						uniqueConstraintsFromEventConstraintWithInstanceID("instance1", pushedauthrequest.NewRequestObjectUniqueConstraint("client1", "jti1")),
+					),
+				),
+			},
+			args: args{
+				ctx:      authz.WithInstanceID(context.Background(), "instance1"),
+				clientID: "client1",
+				jwtID:    "jti1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "instance1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := r.UseRequestObject(tt.args.ctx, tt.args.clientID, tt.args.jwtID, expires)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func pushedAuthRequestAddedEvent(expires time.Time) *pushedauthrequest.AddedEvent {
+	return pushedauthrequest.NewAddedEvent(context.Background(),
+		&pushedauthrequest.NewAggregate("par1", "instance1").Aggregate,
+		"client1",
+		map[string][]string{"scope": {"openid"}},
+		expires,
+	)
+}
@@ -0,0 +1,133 @@
+package command
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/dennigogo/zitadel/internal/actions"
+	"github.com/dennigogo/zitadel/internal/api/authz"
+	"github.com/dennigogo/zitadel/internal/domain"
+	caos_errs "github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/repository/quota"
+)
+
+// SetQuota adds or replaces the quota of the unit for the instance in the context
+func (c *Commands) SetQuota(ctx context.Context, q *domain.Quota) (*domain.ObjectDetails, error) {
+	if err := validateQuota(q); err != nil {
+		return nil, err
+	}
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	if instanceID == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Qu2i8", "Errors.IDMissing")
+	}
+	existingQuota, err := c.getQuotaWriteModel(ctx, instanceID, q.Unit)
+	if err != nil {
+		return nil, err
+	}
+	notifications := quotaNotificationsToEvent(q.Notifications)
+	if !existingQuota.isChanged(q, notifications) {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Qu5n1", "Errors.NoChangesFound")
+	}
+
+	quotaAgg := QuotaAggregateFromWriteModel(&existingQuota.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, quota.NewSetEvent(
+		ctx,
+		quotaAgg,
+		q.Unit,
+		q.From,
+		q.ResetInterval,
+		q.Amount,
+		q.Limit,
+		notifications,
+	))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingQuota, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingQuota.WriteModel), nil
+}
+
+// RemoveQuota removes the quota of the unit for the instance in the context
+func (c *Commands) RemoveQuota(ctx context.Context, unit domain.QuotaUnit) (*domain.ObjectDetails, error) {
+	if !unit.Valid() {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Qu7u3", "Errors.Quota.Invalid")
+	}
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	if instanceID == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Qu3r9", "Errors.IDMissing")
+	}
+	existingQuota, err := c.getQuotaWriteModel(ctx, instanceID, unit)
+	if err != nil {
+		return nil, err
+	}
+	if !existingQuota.Exists {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Qu8f4", "Errors.Quota.NotFound")
+	}
+
+	quotaAgg := QuotaAggregateFromWriteModel(&existingQuota.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, quota.NewRemovedEvent(ctx, quotaAgg, unit))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingQuota, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingQuota.WriteModel), nil
+}
+
+func validateQuota(q *domain.Quota) error {
+	if !q.IsValid() {
+		return caos_errs.ThrowInvalidArgument(nil, "COMMAND-Qu4v6", "Errors.Quota.Invalid")
+	}
+	for _, notification := range q.Notifications {
+		target, err := url.Parse(notification.CallURL)
+		if err != nil || (target.Scheme != "http" && target.Scheme != "https") || target.Host == "" {
+			return caos_errs.ThrowInvalidArgument(err, "COMMAND-Qu6c2", "Errors.Quota.Invalid")
+		}
+		if actions.IsHostBlocked(target) {
+			return caos_errs.ThrowInvalidArgument(nil, "COMMAND-Qu9b5", "Errors.Quota.URLNotAllowed")
+		}
+	}
+	return nil
+}
+
+func quotaNotificationsToEvent(notifications []*domain.QuotaNotification) []*quota.Notification {
+	if len(notifications) == 0 {
+		return nil
+	}
+	converted := make([]*quota.Notification, len(notifications))
+	for i, notification := range notifications {
+		converted[i] = &quota.Notification{
+			Percent: notification.Percent,
+			Repeat:  notification.Repeat,
+			CallURL: notification.CallURL,
+		}
+	}
+	return converted
+}
+
+func (c *Commands) getQuotaWriteModel(ctx context.Context, instanceID string, unit domain.QuotaUnit) (*QuotaWriteModel, error) {
+	quotaWriteModel := NewQuotaWriteModel(instanceID, unit)
+	err := c.eventstore.FilterToQueryReducer(ctx, quotaWriteModel)
+	if err != nil {
+		return nil, err
+	}
+	return quotaWriteModel, nil
+}
+
+// QuotaEnforcer checks the limits of the quotas of the instance in the context
+type QuotaEnforcer interface {
+	Exhausted(ctx context.Context, unit domain.QuotaUnit) bool
+}
+
+// checkQuota prevents the creation of resources of the unit if the limit of its quota is reached
+func checkQuota(ctx context.Context, quotas QuotaEnforcer, unit domain.QuotaUnit) error {
+	if quotas == nil || !quotas.Exhausted(ctx, unit) {
+		return nil
+	}
+	return caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Qu0ta", "Errors.Quota.Exhausted")
+}
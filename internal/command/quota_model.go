@@ -0,0 +1,106 @@
+package command
+
+import (
+	"time"
+
+	"github.com/dennigogo/zitadel/internal/domain"
+	"github.com/dennigogo/zitadel/internal/eventstore"
+	"github.com/dennigogo/zitadel/internal/repository/quota"
+)
+
+type QuotaWriteModel struct {
+	eventstore.WriteModel
+
+	Unit          domain.QuotaUnit
+	From          time.Time
+	ResetInterval time.Duration
+	Amount        uint64
+	Limit         bool
+	Notifications []*quota.Notification
+	Exists        bool
+}
+
+// NewQuotaWriteModel returns the write model of the quota of the unit of an instance
+func NewQuotaWriteModel(instanceID string, unit domain.QuotaUnit) *QuotaWriteModel {
+	return &QuotaWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   instanceID,
+			ResourceOwner: instanceID,
+		},
+		Unit: unit,
+	}
+}
+
+func (wm *QuotaWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *quota.SetEvent:
+			if e.Unit != wm.Unit {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *quota.RemovedEvent:
+			if e.Unit != wm.Unit {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		}
+	}
+}
+
+func (wm *QuotaWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *quota.SetEvent:
+			wm.From = e.From
+			wm.ResetInterval = e.ResetInterval
+			wm.Amount = e.Amount
+			wm.Limit = e.Limit
+			wm.Notifications = e.Notifications
+			wm.Exists = true
+		case *quota.RemovedEvent:
+			wm.From = time.Time{}
+			wm.ResetInterval = 0
+			wm.Amount = 0
+			wm.Limit = false
+			wm.Notifications = nil
+			wm.Exists = false
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *QuotaWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(quota.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			quota.SetEventType,
+			quota.RemovedEventType,
+		).
+		Builder()
+}
+
+// isChanged returns true if the quota differs from the current state
+func (wm *QuotaWriteModel) isChanged(q *domain.Quota, notifications []*quota.Notification) bool {
+	if !wm.Exists ||
+		!wm.From.Equal(q.From) ||
+		wm.ResetInterval != q.ResetInterval ||
+		wm.Amount != q.Amount ||
+		wm.Limit != q.Limit ||
+		len(wm.Notifications) != len(notifications) {
+		return true
+	}
+	for i, notification := range notifications {
+		if *wm.Notifications[i] != *notification {
+			return true
+		}
+	}
+	return false
+}
+
+func QuotaAggregateFromWriteModel(wm *eventstore.WriteModel) *eventstore.Aggregate {
+	return eventstore.AggregateFromWriteModel(wm, quota.AggregateType, quota.AggregateVersion)
+}
@@ -0,0 +1,379 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dennigogo/zitadel/internal/api/authz"
+	"github.com/dennigogo/zitadel/internal/domain"
+	"github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/eventstore"
+	"github.com/dennigogo/zitadel/internal/eventstore/repository"
+	"github.com/dennigogo/zitadel/internal/repository/quota"
+)
+
+func TestCommands_SetQuota(t *testing.T) {
+	from := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx   context.Context
+		quota *domain.Quota
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "invalid unit, error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				quota: &domain.Quota{
+					Amount: 10,
+				},
+			},
+			res: res{
+				err: errors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "periodic quota without interval, error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				quota: &domain.Quota{
+					Unit:   domain.QuotaUnitRequestsAllAuthenticated,
+					From:   from,
+					Amount: 10,
+				},
+			},
+			res: res{
+				err: errors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "notification of amount quota, error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				quota: &domain.Quota{
+					Unit:   domain.QuotaUnitUsers,
+					Amount: 10,
+					Notifications: []*domain.QuotaNotification{
+						{Percent: 80, CallURL: "https://example.com/quota"},
+					},
+				},
+			},
+			res: res{
+				err: errors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "invalid notification url, error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				quota: &domain.Quota{
+					Unit:          domain.QuotaUnitRequestsAllAuthenticated,
+					From:          from,
+					ResetInterval: 24 * time.Hour,
+					Amount:        10,
+					Notifications: []*domain.QuotaNotification{
+						{Percent: 80, CallURL: "ftp://example.com/quota"},
+					},
+				},
+			},
+			res: res{
+				err: errors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "no changes, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusherWithInstanceID(
+							"INSTANCE",
+							quota.NewSetEvent(context.Background(),
+								&quota.NewAggregate("INSTANCE").Aggregate,
+								domain.QuotaUnitUsers,
+								time.Time{},
+								0,
+								10,
+								true,
+								nil,
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				quota: &domain.Quota{
+					Unit:   domain.QuotaUnitUsers,
+					Amount: 10,
+					Limit:  true,
+				},
+			},
+			res: res{
+				err: errors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "set, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusherWithInstanceID(
+								"INSTANCE",
+								quota.NewSetEvent(context.Background(),
+									&quota.NewAggregate("INSTANCE").Aggregate,
+									domain.QuotaUnitRequestsAllAuthenticated,
+									from,
+									24*time.Hour,
+									1000,
+									true,
+									[]*quota.Notification{
+										{Percent: 80, Repeat: true, CallURL: "https://example.com/quota"},
+									},
+								),
+							),
+						},
+					),
+				),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				quota: &domain.Quota{
+					Unit:          domain.QuotaUnitRequestsAllAuthenticated,
+					From:          from,
+					ResetInterval: 24 * time.Hour,
+					Amount:        1000,
+					Limit:         true,
+					Notifications: []*domain.QuotaNotification{
+						{Percent: 80, Repeat: true, CallURL: "https://example.com/quota"},
+					},
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+		{
+			name: "replace, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusherWithInstanceID(
+							"INSTANCE",
+							quota.NewSetEvent(context.Background(),
+								&quota.NewAggregate("INSTANCE").Aggregate,
+								domain.QuotaUnitUsers,
+								time.Time{},
+								0,
+								10,
+								true,
+								nil,
+							),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusherWithInstanceID(
+								"INSTANCE",
+								quota.NewSetEvent(context.Background(),
+									&quota.NewAggregate("INSTANCE").Aggregate,
+									domain.QuotaUnitUsers,
+									time.Time{},
+									0,
+									20,
+									true,
+									nil,
+								),
+							),
+						},
+					),
+				),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				quota: &domain.Quota{
+					Unit:   domain.QuotaUnitUsers,
+					Amount: 20,
+					Limit:  true,
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := r.SetQuota(tt.args.ctx, tt.args.quota)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommands_RemoveQuota(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx  context.Context
+		unit domain.QuotaUnit
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "invalid unit, error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx:  authz.WithInstanceID(context.Background(), "INSTANCE"),
+				unit: domain.QuotaUnitUnspecified,
+			},
+			res: res{
+				err: errors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "not found, error",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusherWithInstanceID(
+							"INSTANCE",
+							quota.NewSetEvent(context.Background(),
+								&quota.NewAggregate("INSTANCE").Aggregate,
+								domain.QuotaUnitOrgs,
+								time.Time{},
+								0,
+								10,
+								true,
+								nil,
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:  authz.WithInstanceID(context.Background(), "INSTANCE"),
+				unit: domain.QuotaUnitUsers,
+			},
+			res: res{
+				err: errors.IsNotFound,
+			},
+		},
+		{
+			name: "remove, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusherWithInstanceID(
+							"INSTANCE",
+							quota.NewSetEvent(context.Background(),
+								&quota.NewAggregate("INSTANCE").Aggregate,
+								domain.QuotaUnitUsers,
+								time.Time{},
+								0,
+								10,
+								true,
+								nil,
+							),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusherWithInstanceID(
+								"INSTANCE",
+								quota.NewRemovedEvent(context.Background(),
+									&quota.NewAggregate("INSTANCE").Aggregate,
+									domain.QuotaUnitUsers,
+								),
+							),
+						},
+					),
+				),
+			},
+			args: args{
+				ctx:  authz.WithInstanceID(context.Background(), "INSTANCE"),
+				unit: domain.QuotaUnitUsers,
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := r.RemoveQuota(tt.args.ctx, tt.args.unit)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+type testQuotas map[domain.QuotaUnit]bool
+
+func (q testQuotas) Exhausted(_ context.Context, unit domain.QuotaUnit) bool {
+	return q[unit]
+}
@@ -0,0 +1,87 @@
+package command
+
+import (
+	"bytes"
+
+	"github.com/dennigogo/zitadel/internal/crypto"
+	"github.com/dennigogo/zitadel/internal/domain"
+	"github.com/dennigogo/zitadel/internal/eventstore"
+	"github.com/dennigogo/zitadel/internal/repository/idpconfig"
+)
+
+type SAMLConfigWriteModel struct {
+	eventstore.WriteModel
+
+	IDPConfigID       string
+	Metadata          []byte
+	MetadataURL       string
+	Key               *crypto.CryptoValue
+	Certificate       []byte
+	WithSignedRequest bool
+	State             domain.IDPConfigState
+}
+
+func (wm *SAMLConfigWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *idpconfig.SAMLConfigAddedEvent:
+			wm.reduceConfigAddedEvent(e)
+		case *idpconfig.SAMLConfigChangedEvent:
+			wm.reduceConfigChangedEvent(e)
+		case *idpconfig.IDPConfigDeactivatedEvent:
+			wm.State = domain.IDPConfigStateInactive
+		case *idpconfig.IDPConfigReactivatedEvent:
+			wm.State = domain.IDPConfigStateActive
+		case *idpconfig.IDPConfigRemovedEvent:
+			wm.State = domain.IDPConfigStateRemoved
+		}
+	}
+
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *SAMLConfigWriteModel) reduceConfigAddedEvent(e *idpconfig.SAMLConfigAddedEvent) {
+	wm.IDPConfigID = e.IDPConfigID
+	wm.Metadata = e.Metadata
+	wm.MetadataURL = e.MetadataURL
+	wm.Key = e.Key
+	wm.Certificate = e.Certificate
+	wm.WithSignedRequest = e.WithSignedRequest
+	wm.State = domain.IDPConfigStateActive
+}
+
+func (wm *SAMLConfigWriteModel) reduceConfigChangedEvent(e *idpconfig.SAMLConfigChangedEvent) {
+	if e.Metadata != nil {
+		wm.Metadata = e.Metadata
+	}
+	if e.MetadataURL != nil {
+		wm.MetadataURL = *e.MetadataURL
+	}
+	if e.Key != nil {
+		wm.Key = e.Key
+	}
+	if e.Certificate != nil {
+		wm.Certificate = e.Certificate
+	}
+	if e.WithSignedRequest != nil {
+		wm.WithSignedRequest = *e.WithSignedRequest
+	}
+}
+
+func (wm *SAMLConfigWriteModel) changes(
+	metadata []byte,
+	metadataURL string,
+	withSignedRequest bool,
+) []idpconfig.SAMLConfigChanges {
+	changes := make([]idpconfig.SAMLConfigChanges, 0)
+	if !bytes.Equal(wm.Metadata, metadata) {
+		changes = append(changes, idpconfig.ChangeSAMLMetadata(metadata))
+	}
+	if wm.MetadataURL != metadataURL {
+		changes = append(changes, idpconfig.ChangeSAMLMetadataURL(metadataURL))
+	}
+	if wm.WithSignedRequest != withSignedRequest {
+		changes = append(changes, idpconfig.ChangeSAMLWithSignedRequest(withSignedRequest))
+	}
+	return changes
+}
@@ -2,10 +2,13 @@ package command
 
 import (
 	"context"
+	"net/url"
 
 	"github.com/dennigogo/zitadel/internal/crypto"
 	"github.com/dennigogo/zitadel/internal/domain"
 	caos_errs "github.com/dennigogo/zitadel/internal/errors"
+	sms_http "github.com/dennigogo/zitadel/internal/notification/channels/http"
+	"github.com/dennigogo/zitadel/internal/notification/channels/smpp"
 	"github.com/dennigogo/zitadel/internal/notification/channels/twilio"
 	"github.com/dennigogo/zitadel/internal/repository/instance"
 )
@@ -205,3 +208,237 @@ func (c *Commands) getSMSConfig(ctx context.Context, instanceID, id string) (_ *
 
 	return writeModel, nil
 }
+
+func (c *Commands) AddSMSConfigHTTP(ctx context.Context, instanceID string, config *sms_http.HTTPConfig) (string, *domain.ObjectDetails, error) {
+	if err := validateSMSConfigHTTP(config); err != nil {
+		return "", nil, err
+	}
+	id, err := c.idGenerator.Next()
+	if err != nil {
+		return "", nil, err
+	}
+	smsConfigWriteModel, err := c.getSMSConfig(ctx, instanceID, id)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var authHeaderValue *crypto.CryptoValue
+	if config.AuthHeaderValue != "" {
+		authHeaderValue, err = crypto.Encrypt([]byte(config.AuthHeaderValue), c.smsEncryption)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+
+	iamAgg := InstanceAggregateFromWriteModel(&smsConfigWriteModel.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, instance.NewSMSConfigHTTPAddedEvent(
+		ctx,
+		iamAgg,
+		id,
+		config.Endpoint,
+		config.Method,
+		config.ContentType,
+		config.BodyTemplate,
+		config.AuthHeaderName,
+		authHeaderValue,
+		config.SuccessStatusCodes,
+		config.SenderNumber))
+	if err != nil {
+		return "", nil, err
+	}
+	err = AppendAndReduce(smsConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return "", nil, err
+	}
+	return id, writeModelToObjectDetails(&smsConfigWriteModel.WriteModel), nil
+}
+
+func (c *Commands) ChangeSMSConfigHTTP(ctx context.Context, instanceID, id string, config *sms_http.HTTPConfig) (*domain.ObjectDetails, error) {
+	if id == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "SMS-Hf8w2", "Errors.IDMissing")
+	}
+	if err := validateSMSConfigHTTP(config); err != nil {
+		return nil, err
+	}
+	smsConfigWriteModel, err := c.getSMSConfig(ctx, instanceID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !smsConfigWriteModel.State.Exists() || smsConfigWriteModel.HTTP == nil {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Hn3k8", "Errors.SMSConfig.NotFound")
+	}
+	iamAgg := InstanceAggregateFromWriteModel(&smsConfigWriteModel.WriteModel)
+
+	changedEvent, hasChanged, err := smsConfigWriteModel.NewHTTPChangedEvent(ctx, iamAgg, id, config)
+	if err != nil {
+		return nil, err
+	}
+	if !hasChanged {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Hp9s4", "Errors.NoChangesFound")
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, changedEvent)
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(smsConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smsConfigWriteModel.WriteModel), nil
+}
+
+func (c *Commands) ChangeSMSConfigHTTPAuthHeader(ctx context.Context, instanceID, id, authHeaderValue string) (*domain.ObjectDetails, error) {
+	if id == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "SMS-Ha5d1", "Errors.IDMissing")
+	}
+	smsConfigWriteModel, err := c.getSMSConfig(ctx, instanceID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !smsConfigWriteModel.State.Exists() || smsConfigWriteModel.HTTP == nil {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Hc2m7", "Errors.SMSConfig.NotFound")
+	}
+	iamAgg := InstanceAggregateFromWriteModel(&smsConfigWriteModel.WriteModel)
+	var newAuthHeaderValue *crypto.CryptoValue
+	if authHeaderValue != "" {
+		newAuthHeaderValue, err = crypto.Encrypt([]byte(authHeaderValue), c.smsEncryption)
+		if err != nil {
+			return nil, err
+		}
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, instance.NewSMSConfigHTTPAuthHeaderChangedEvent(
+		ctx,
+		iamAgg,
+		id,
+		newAuthHeaderValue))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(smsConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smsConfigWriteModel.WriteModel), nil
+}
+
+func validateSMSConfigHTTP(config *sms_http.HTTPConfig) error {
+	if !config.IsValid() {
+		return caos_errs.ThrowInvalidArgument(nil, "SMS-Hv6q3", "Errors.SMSConfig.HTTP.Invalid")
+	}
+	if _, err := url.ParseRequestURI(config.Endpoint); err != nil {
+		return caos_errs.ThrowInvalidArgument(err, "SMS-Hu1x8", "Errors.SMSConfig.HTTP.Invalid")
+	}
+	_, err := sms_http.ParseBodyTemplate(config.BodyTemplate)
+	return err
+}
+
+func (c *Commands) AddSMSConfigSMPP(ctx context.Context, instanceID string, config *smpp.SMPPConfig) (string, *domain.ObjectDetails, error) {
+	if !config.IsValid() {
+		return "", nil, caos_errs.ThrowInvalidArgument(nil, "SMS-Sv4n2", "Errors.SMSConfig.SMPP.Invalid")
+	}
+	id, err := c.idGenerator.Next()
+	if err != nil {
+		return "", nil, err
+	}
+	smsConfigWriteModel, err := c.getSMSConfig(ctx, instanceID, id)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var password *crypto.CryptoValue
+	if config.Password != "" {
+		password, err = crypto.Encrypt([]byte(config.Password), c.smsEncryption)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+
+	iamAgg := InstanceAggregateFromWriteModel(&smsConfigWriteModel.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, instance.NewSMSConfigSMPPAddedEvent(
+		ctx,
+		iamAgg,
+		id,
+		config.Host,
+		config.Port,
+		config.TLS,
+		config.SystemID,
+		password,
+		config.SystemType,
+		config.SenderNumber))
+	if err != nil {
+		return "", nil, err
+	}
+	err = AppendAndReduce(smsConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return "", nil, err
+	}
+	return id, writeModelToObjectDetails(&smsConfigWriteModel.WriteModel), nil
+}
+
+func (c *Commands) ChangeSMSConfigSMPP(ctx context.Context, instanceID, id string, config *smpp.SMPPConfig) (*domain.ObjectDetails, error) {
+	if id == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "SMS-Sj2f9", "Errors.IDMissing")
+	}
+	if !config.IsValid() {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "SMS-Sb7k1", "Errors.SMSConfig.SMPP.Invalid")
+	}
+	smsConfigWriteModel, err := c.getSMSConfig(ctx, instanceID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !smsConfigWriteModel.State.Exists() || smsConfigWriteModel.SMPP == nil {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Sg5d3", "Errors.SMSConfig.NotFound")
+	}
+	iamAgg := InstanceAggregateFromWriteModel(&smsConfigWriteModel.WriteModel)
+
+	changedEvent, hasChanged, err := smsConfigWriteModel.NewSMPPChangedEvent(ctx, iamAgg, id, config)
+	if err != nil {
+		return nil, err
+	}
+	if !hasChanged {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Sh8q6", "Errors.NoChangesFound")
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, changedEvent)
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(smsConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smsConfigWriteModel.WriteModel), nil
+}
+
+func (c *Commands) ChangeSMSConfigSMPPPassword(ctx context.Context, instanceID, id, password string) (*domain.ObjectDetails, error) {
+	if id == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "SMS-Sm1w4", "Errors.IDMissing")
+	}
+	smsConfigWriteModel, err := c.getSMSConfig(ctx, instanceID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !smsConfigWriteModel.State.Exists() || smsConfigWriteModel.SMPP == nil {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Sn4z7", "Errors.SMSConfig.NotFound")
+	}
+	iamAgg := InstanceAggregateFromWriteModel(&smsConfigWriteModel.WriteModel)
+	var newPassword *crypto.CryptoValue
+	if password != "" {
+		newPassword, err = crypto.Encrypt([]byte(password), c.smsEncryption)
+		if err != nil {
+			return nil, err
+		}
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, instance.NewSMSConfigSMPPPasswordChangedEvent(
+		ctx,
+		iamAgg,
+		id,
+		newPassword))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(smsConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smsConfigWriteModel.WriteModel), nil
+}
@@ -6,6 +6,8 @@ import (
 	"github.com/dennigogo/zitadel/internal/crypto"
 	"github.com/dennigogo/zitadel/internal/domain"
 	"github.com/dennigogo/zitadel/internal/eventstore"
+	sms_http "github.com/dennigogo/zitadel/internal/notification/channels/http"
+	"github.com/dennigogo/zitadel/internal/notification/channels/smpp"
 	"github.com/dennigogo/zitadel/internal/repository/instance"
 )
 
@@ -14,6 +16,8 @@ type IAMSMSConfigWriteModel struct {
 
 	ID     string
 	Twilio *TwilioConfig
+	HTTP   *SMSHTTPConfig
+	SMPP   *SMSSMPPConfig
 	State  domain.SMSConfigState
 }
 
@@ -23,6 +27,27 @@ type TwilioConfig struct {
 	SenderNumber string
 }
 
+type SMSHTTPConfig struct {
+	Endpoint           string
+	Method             string
+	ContentType        string
+	BodyTemplate       string
+	AuthHeaderName     string
+	AuthHeaderValue    *crypto.CryptoValue
+	SuccessStatusCodes []int32
+	SenderNumber       string
+}
+
+type SMSSMPPConfig struct {
+	Host         string
+	Port         uint16
+	TLS          bool
+	SystemID     string
+	Password     *crypto.CryptoValue
+	SystemType   string
+	SenderNumber string
+}
+
 func NewIAMSMSConfigWriteModel(instanceID, id string) *IAMSMSConfigWriteModel {
 	return &IAMSMSConfigWriteModel{
 		WriteModel: eventstore.WriteModel{
@@ -61,6 +86,92 @@ func (wm *IAMSMSConfigWriteModel) Reduce() error {
 				continue
 			}
 			wm.Twilio.Token = e.Token
+		case *instance.SMSConfigHTTPAddedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.HTTP = &SMSHTTPConfig{
+				Endpoint:           e.Endpoint,
+				Method:             e.Method,
+				ContentType:        e.ContentType,
+				BodyTemplate:       e.BodyTemplate,
+				AuthHeaderName:     e.AuthHeaderName,
+				AuthHeaderValue:    e.AuthHeaderValue,
+				SuccessStatusCodes: e.SuccessStatusCodes,
+				SenderNumber:       e.SenderNumber,
+			}
+			wm.State = domain.SMSConfigStateInactive
+		case *instance.SMSConfigHTTPChangedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			if e.Endpoint != nil {
+				wm.HTTP.Endpoint = *e.Endpoint
+			}
+			if e.Method != nil {
+				wm.HTTP.Method = *e.Method
+			}
+			if e.ContentType != nil {
+				wm.HTTP.ContentType = *e.ContentType
+			}
+			if e.BodyTemplate != nil {
+				wm.HTTP.BodyTemplate = *e.BodyTemplate
+			}
+			if e.AuthHeaderName != nil {
+				wm.HTTP.AuthHeaderName = *e.AuthHeaderName
+			}
+			if e.SuccessStatusCodes != nil {
+				wm.HTTP.SuccessStatusCodes = *e.SuccessStatusCodes
+			}
+			if e.SenderNumber != nil {
+				wm.HTTP.SenderNumber = *e.SenderNumber
+			}
+		case *instance.SMSConfigHTTPAuthHeaderChangedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.HTTP.AuthHeaderValue = e.AuthHeaderValue
+		case *instance.SMSConfigSMPPAddedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.SMPP = &SMSSMPPConfig{
+				Host:         e.Host,
+				Port:         e.Port,
+				TLS:          e.TLS,
+				SystemID:     e.SystemID,
+				Password:     e.Password,
+				SystemType:   e.SystemType,
+				SenderNumber: e.SenderNumber,
+			}
+			wm.State = domain.SMSConfigStateInactive
+		case *instance.SMSConfigSMPPChangedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			if e.Host != nil {
+				wm.SMPP.Host = *e.Host
+			}
+			if e.Port != nil {
+				wm.SMPP.Port = *e.Port
+			}
+			if e.TLS != nil {
+				wm.SMPP.TLS = *e.TLS
+			}
+			if e.SystemID != nil {
+				wm.SMPP.SystemID = *e.SystemID
+			}
+			if e.SystemType != nil {
+				wm.SMPP.SystemType = *e.SystemType
+			}
+			if e.SenderNumber != nil {
+				wm.SMPP.SenderNumber = *e.SenderNumber
+			}
+		case *instance.SMSConfigSMPPPasswordChangedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.SMPP.Password = e.Password
 		case *instance.SMSConfigActivatedEvent:
 			if wm.ID != e.ID {
 				continue
@@ -76,6 +187,8 @@ func (wm *IAMSMSConfigWriteModel) Reduce() error {
 				continue
 			}
 			wm.Twilio = nil
+			wm.HTTP = nil
+			wm.SMPP = nil
 			wm.State = domain.SMSConfigStateRemoved
 		}
 	}
@@ -91,6 +204,12 @@ func (wm *IAMSMSConfigWriteModel) Query() *eventstore.SearchQueryBuilder {
 			instance.SMSConfigTwilioAddedEventType,
 			instance.SMSConfigTwilioChangedEventType,
 			instance.SMSConfigTwilioTokenChangedEventType,
+			instance.SMSConfigHTTPAddedEventType,
+			instance.SMSConfigHTTPChangedEventType,
+			instance.SMSConfigHTTPAuthHeaderChangedEventType,
+			instance.SMSConfigSMPPAddedEventType,
+			instance.SMSConfigSMPPChangedEventType,
+			instance.SMSConfigSMPPPasswordChangedEventType,
 			instance.SMSConfigActivatedEventType,
 			instance.SMSConfigDeactivatedEventType,
 			instance.SMSConfigRemovedEventType).
@@ -117,3 +236,82 @@ func (wm *IAMSMSConfigWriteModel) NewChangedEvent(ctx context.Context, aggregate
 	}
 	return changeEvent, true, nil
 }
+
+func (wm *IAMSMSConfigWriteModel) NewHTTPChangedEvent(ctx context.Context, aggregate *eventstore.Aggregate, id string, config *sms_http.HTTPConfig) (*instance.SMSConfigHTTPChangedEvent, bool, error) {
+	changes := make([]instance.SMSConfigHTTPChanges, 0)
+
+	if wm.HTTP.Endpoint != config.Endpoint {
+		changes = append(changes, instance.ChangeSMSConfigHTTPEndpoint(config.Endpoint))
+	}
+	if wm.HTTP.Method != config.Method {
+		changes = append(changes, instance.ChangeSMSConfigHTTPMethod(config.Method))
+	}
+	if wm.HTTP.ContentType != config.ContentType {
+		changes = append(changes, instance.ChangeSMSConfigHTTPContentType(config.ContentType))
+	}
+	if wm.HTTP.BodyTemplate != config.BodyTemplate {
+		changes = append(changes, instance.ChangeSMSConfigHTTPBodyTemplate(config.BodyTemplate))
+	}
+	if wm.HTTP.AuthHeaderName != config.AuthHeaderName {
+		changes = append(changes, instance.ChangeSMSConfigHTTPAuthHeaderName(config.AuthHeaderName))
+	}
+	if !equalStatusCodes(wm.HTTP.SuccessStatusCodes, config.SuccessStatusCodes) {
+		changes = append(changes, instance.ChangeSMSConfigHTTPSuccessStatusCodes(config.SuccessStatusCodes))
+	}
+	if wm.HTTP.SenderNumber != config.SenderNumber {
+		changes = append(changes, instance.ChangeSMSConfigHTTPSenderNumber(config.SenderNumber))
+	}
+
+	if len(changes) == 0 {
+		return nil, false, nil
+	}
+	changeEvent, err := instance.NewSMSConfigHTTPChangedEvent(ctx, aggregate, id, changes)
+	if err != nil {
+		return nil, false, err
+	}
+	return changeEvent, true, nil
+}
+
+func equalStatusCodes(a, b []int32) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (wm *IAMSMSConfigWriteModel) NewSMPPChangedEvent(ctx context.Context, aggregate *eventstore.Aggregate, id string, config *smpp.SMPPConfig) (*instance.SMSConfigSMPPChangedEvent, bool, error) {
+	changes := make([]instance.SMSConfigSMPPChanges, 0)
+
+	if wm.SMPP.Host != config.Host {
+		changes = append(changes, instance.ChangeSMSConfigSMPPHost(config.Host))
+	}
+	if wm.SMPP.Port != config.Port {
+		changes = append(changes, instance.ChangeSMSConfigSMPPPort(config.Port))
+	}
+	if wm.SMPP.TLS != config.TLS {
+		changes = append(changes, instance.ChangeSMSConfigSMPPTLS(config.TLS))
+	}
+	if wm.SMPP.SystemID != config.SystemID {
+		changes = append(changes, instance.ChangeSMSConfigSMPPSystemID(config.SystemID))
+	}
+	if wm.SMPP.SystemType != config.SystemType {
+		changes = append(changes, instance.ChangeSMSConfigSMPPSystemType(config.SystemType))
+	}
+	if wm.SMPP.SenderNumber != config.SenderNumber {
+		changes = append(changes, instance.ChangeSMSConfigSMPPSenderNumber(config.SenderNumber))
+	}
+
+	if len(changes) == 0 {
+		return nil, false, nil
+	}
+	changeEvent, err := instance.NewSMSConfigSMPPChangedEvent(ctx, aggregate, id, changes)
+	if err != nil {
+		return nil, false, err
+	}
+	return changeEvent, true, nil
+}
@@ -14,6 +14,8 @@ import (
 	"github.com/dennigogo/zitadel/internal/eventstore/repository"
 	"github.com/dennigogo/zitadel/internal/id"
 	id_mock "github.com/dennigogo/zitadel/internal/id/mock"
+	sms_http "github.com/dennigogo/zitadel/internal/notification/channels/http"
+	"github.com/dennigogo/zitadel/internal/notification/channels/smpp"
 	"github.com/dennigogo/zitadel/internal/notification/channels/twilio"
 	"github.com/dennigogo/zitadel/internal/repository/instance"
 )
@@ -267,6 +269,546 @@ func TestCommandSide_ChangeSMSConfigTwilio(t *testing.T) {
 	}
 }
 
+func TestCommandSide_AddSMSConfigHTTP(t *testing.T) {
+	type fields struct {
+		eventstore  *eventstore.Eventstore
+		idGenerator id.Generator
+		alg         crypto.EncryptionAlgorithm
+	}
+	type args struct {
+		ctx        context.Context
+		instanceID string
+		sms        *sms_http.HTTPConfig
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "endpoint missing, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+				),
+			},
+			args: args{
+				ctx:        context.Background(),
+				instanceID: "INSTANCE",
+				sms: &sms_http.HTTPConfig{
+					BodyTemplate: "{{.Content}}",
+				},
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "invalid body template, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+				),
+			},
+			args: args{
+				ctx:        context.Background(),
+				instanceID: "INSTANCE",
+				sms: &sms_http.HTTPConfig{
+					Endpoint:     "https://sms.example.com",
+					BodyTemplate: "{{.Content",
+				},
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "add sms config http, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(instance.NewSMSConfigHTTPAddedEvent(
+								context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"providerid",
+								"https://sms.example.com",
+								"POST",
+								"application/json",
+								`{"to": {{json .RecipientNumber}}, "text": {{json .Content}}}`,
+								"Authorization",
+								&crypto.CryptoValue{
+									CryptoType: crypto.TypeEncryption,
+									Algorithm:  "enc",
+									KeyID:      "id",
+									Crypted:    []byte("Bearer token"),
+								},
+								[]int32{200, 202},
+								"senderName",
+							),
+							),
+						},
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "providerid"),
+				alg:         crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				ctx:        context.Background(),
+				instanceID: "INSTANCE",
+				sms: &sms_http.HTTPConfig{
+					Endpoint:           "https://sms.example.com",
+					Method:             "POST",
+					ContentType:        "application/json",
+					BodyTemplate:       `{"to": {{json .RecipientNumber}}, "text": {{json .Content}}}`,
+					AuthHeaderName:     "Authorization",
+					AuthHeaderValue:    "Bearer token",
+					SuccessStatusCodes: []int32{200, 202},
+					SenderNumber:       "senderName",
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:    tt.fields.eventstore,
+				idGenerator:   tt.fields.idGenerator,
+				smsEncryption: tt.fields.alg,
+			}
+			_, got, err := r.AddSMSConfigHTTP(tt.args.ctx, tt.args.instanceID, tt.args.sms)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_ChangeSMSConfigHTTP(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx        context.Context
+		instanceID string
+		id         string
+		sms        *sms_http.HTTPConfig
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "id empty, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				sms: &sms_http.HTTPConfig{},
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "sms not existing, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				sms: &sms_http.HTTPConfig{
+					Endpoint:     "https://sms.example.com",
+					BodyTemplate: "{{.Content}}",
+				},
+				instanceID: "INSTANCE",
+				id:         "id",
+			},
+			res: res{
+				err: caos_errs.IsNotFound,
+			},
+		},
+		{
+			name: "twilio config, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewSMSConfigTwilioAddedEvent(
+								context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"providerid",
+								"sid",
+								"senderName",
+								nil,
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				sms: &sms_http.HTTPConfig{
+					Endpoint:     "https://sms.example.com",
+					BodyTemplate: "{{.Content}}",
+				},
+				instanceID: "INSTANCE",
+				id:         "providerid",
+			},
+			res: res{
+				err: caos_errs.IsNotFound,
+			},
+		},
+		{
+			name: "no changes, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							newSMSConfigHTTPAddedEvent(context.Background(), "providerid"),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				sms: &sms_http.HTTPConfig{
+					Endpoint:     "https://sms.example.com",
+					Method:       "POST",
+					BodyTemplate: "{{.Content}}",
+					SenderNumber: "senderName",
+				},
+				instanceID: "INSTANCE",
+				id:         "providerid",
+			},
+			res: res{
+				err: caos_errs.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "sms config http change, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							newSMSConfigHTTPAddedEvent(context.Background(), "providerid"),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								newSMSConfigHTTPChangedEvent(
+									context.Background(),
+									"providerid",
+									instance.ChangeSMSConfigHTTPEndpoint("https://sms2.example.com"),
+									instance.ChangeSMSConfigHTTPSuccessStatusCodes([]int32{201}),
+								),
+							),
+						},
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				sms: &sms_http.HTTPConfig{
+					Endpoint:           "https://sms2.example.com",
+					Method:             "POST",
+					BodyTemplate:       "{{.Content}}",
+					SuccessStatusCodes: []int32{201},
+					SenderNumber:       "senderName",
+				},
+				instanceID: "INSTANCE",
+				id:         "providerid",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := r.ChangeSMSConfigHTTP(tt.args.ctx, tt.args.instanceID, tt.args.id, tt.args.sms)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_AddSMSConfigSMPP(t *testing.T) {
+	type fields struct {
+		eventstore  *eventstore.Eventstore
+		idGenerator id.Generator
+		alg         crypto.EncryptionAlgorithm
+	}
+	type args struct {
+		ctx        context.Context
+		instanceID string
+		sms        *smpp.SMPPConfig
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "host missing, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+				),
+			},
+			args: args{
+				ctx:        context.Background(),
+				instanceID: "INSTANCE",
+				sms: &smpp.SMPPConfig{
+					Port:         2775,
+					SystemID:     "systemID",
+					SenderNumber: "senderName",
+				},
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "add sms config smpp, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(instance.NewSMSConfigSMPPAddedEvent(
+								context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"providerid",
+								"smpp.example.com",
+								2775,
+								true,
+								"systemID",
+								&crypto.CryptoValue{
+									CryptoType: crypto.TypeEncryption,
+									Algorithm:  "enc",
+									KeyID:      "id",
+									Crypted:    []byte("password"),
+								},
+								"systemType",
+								"senderName",
+							),
+							),
+						},
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "providerid"),
+				alg:         crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				ctx:        context.Background(),
+				instanceID: "INSTANCE",
+				sms: &smpp.SMPPConfig{
+					Host:         "smpp.example.com",
+					Port:         2775,
+					TLS:          true,
+					SystemID:     "systemID",
+					Password:     "password",
+					SystemType:   "systemType",
+					SenderNumber: "senderName",
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:    tt.fields.eventstore,
+				idGenerator:   tt.fields.idGenerator,
+				smsEncryption: tt.fields.alg,
+			}
+			_, got, err := r.AddSMSConfigSMPP(tt.args.ctx, tt.args.instanceID, tt.args.sms)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_ChangeSMSConfigSMPP(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx        context.Context
+		instanceID string
+		id         string
+		sms        *smpp.SMPPConfig
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "sms not existing, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				sms: &smpp.SMPPConfig{
+					Host:         "smpp.example.com",
+					Port:         2775,
+					SystemID:     "systemID",
+					SenderNumber: "senderName",
+				},
+				instanceID: "INSTANCE",
+				id:         "id",
+			},
+			res: res{
+				err: caos_errs.IsNotFound,
+			},
+		},
+		{
+			name: "sms config smpp change, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewSMSConfigSMPPAddedEvent(
+								context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"providerid",
+								"smpp.example.com",
+								2775,
+								false,
+								"systemID",
+								nil,
+								"",
+								"senderName",
+							),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								newSMSConfigSMPPChangedEvent(
+									context.Background(),
+									"providerid",
+									instance.ChangeSMSConfigSMPPPort(3550),
+									instance.ChangeSMSConfigSMPPTLS(true),
+								),
+							),
+						},
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				sms: &smpp.SMPPConfig{
+					Host:         "smpp.example.com",
+					Port:         3550,
+					TLS:          true,
+					SystemID:     "systemID",
+					SenderNumber: "senderName",
+				},
+				instanceID: "INSTANCE",
+				id:         "providerid",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := r.ChangeSMSConfigSMPP(tt.args.ctx, tt.args.instanceID, tt.args.id, tt.args.sms)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
 func TestCommandSide_ActivateSMSConfigTwilio(t *testing.T) {
 	type fields struct {
 		eventstore *eventstore.Eventstore
@@ -619,3 +1161,36 @@ func newSMSConfigTwilioChangedEvent(ctx context.Context, id, sid, senderName str
 	)
 	return event
 }
+
+func newSMSConfigHTTPAddedEvent(ctx context.Context, id string) *instance.SMSConfigHTTPAddedEvent {
+	return instance.NewSMSConfigHTTPAddedEvent(ctx,
+		&instance.NewAggregate("INSTANCE").Aggregate,
+		id,
+		"https://sms.example.com",
+		"POST",
+		"",
+		"{{.Content}}",
+		"",
+		nil,
+		nil,
+		"senderName",
+	)
+}
+
+func newSMSConfigHTTPChangedEvent(ctx context.Context, id string, changes ...instance.SMSConfigHTTPChanges) *instance.SMSConfigHTTPChangedEvent {
+	event, _ := instance.NewSMSConfigHTTPChangedEvent(ctx,
+		&instance.NewAggregate("INSTANCE").Aggregate,
+		id,
+		changes,
+	)
+	return event
+}
+
+func newSMSConfigSMPPChangedEvent(ctx context.Context, id string, changes ...instance.SMSConfigSMPPChanges) *instance.SMSConfigSMPPChangedEvent {
+	event, _ := instance.NewSMSConfigSMPPChangedEvent(ctx,
+		&instance.NewAggregate("INSTANCE").Aggregate,
+		id,
+		changes,
+	)
+	return event
+}
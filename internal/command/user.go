@@ -224,12 +224,15 @@ func (c *Commands) RemoveUser(ctx context.Context, userID, resourceOwner string,
 	return writeModelToObjectDetails(&existingUser.WriteModel), nil
 }
 
-func (c *Commands) AddUserToken(ctx context.Context, orgID, agentID, clientID, userID string, audience, scopes []string, lifetime time.Duration) (*domain.Token, error) {
+// AddUserToken adds an access token for the user,
+// which is sender-constrained if a confirmation (DPoP key or mTLS client certificate) is provided.
+// The actor is only set for tokens issued by a token exchange with an acting party.
+func (c *Commands) AddUserToken(ctx context.Context, orgID, agentID, clientID, userID string, audience, scopes []string, lifetime time.Duration, confirmation *authz.TokenConfirmation, actor *domain.TokenActor) (*domain.Token, error) {
 	if userID == "" { //do not check for empty orgID (JWT Profile requests won't provide it, so service user requests fail)
 		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Dbge4", "Errors.IDMissing")
 	}
 	userWriteModel := NewUserWriteModel(userID, orgID)
-	event, accessToken, err := c.addUserToken(ctx, userWriteModel, agentID, clientID, "", audience, scopes, lifetime)
+	event, accessToken, err := c.addUserToken(ctx, userWriteModel, agentID, clientID, "", audience, scopes, lifetime, confirmation, actor)
 	if err != nil {
 		return nil, err
 	}
@@ -240,6 +243,42 @@ func (c *Commands) AddUserToken(ctx context.Context, orgID, agentID, clientID, u
 	return accessToken, nil
 }
 
+// ImpersonateUser records that the actor acts as the user (e.g. using token exchange),
+// so it's visible in the changes of the user
+func (c *Commands) ImpersonateUser(ctx context.Context, userID, resourceOwner, clientID string, actor *domain.TokenActor) (*domain.ObjectDetails, error) {
+	if userID == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Imp2d", "Errors.User.UserIDMissing")
+	}
+	if actor == nil || actor.UserID == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Imp5s", "Errors.User.Impersonation.ActorMissing")
+	}
+	if actor.UserID == userID {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Imp8k", "Errors.User.Impersonation.Self")
+	}
+
+	existingUser, err := c.userWriteModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !isUserStateExists(existingUser.UserState) {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Imp3n", "Errors.User.NotFound")
+	}
+	if !hasUserState(existingUser.UserState, domain.UserStateActive, domain.UserStateInitial) {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Imp6q", "Errors.User.ShouldBeActiveOrInitial")
+	}
+
+	pushedEvents, err := c.eventstore.Push(ctx,
+		user.NewUserImpersonatedEvent(ctx, UserAggregateFromWriteModel(&existingUser.WriteModel), clientID, actor))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingUser, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingUser.WriteModel), nil
+}
+
 func (c *Commands) RevokeAccessToken(ctx context.Context, userID, orgID, tokenID string) (*domain.ObjectDetails, error) {
 	removeEvent, accessTokenWriteModel, err := c.removeAccessToken(ctx, userID, orgID, tokenID)
 	if err != nil {
@@ -256,7 +295,7 @@ func (c *Commands) RevokeAccessToken(ctx context.Context, userID, orgID, tokenID
 	return writeModelToObjectDetails(&accessTokenWriteModel.WriteModel), nil
 }
 
-func (c *Commands) addUserToken(ctx context.Context, userWriteModel *UserWriteModel, agentID, clientID, refreshTokenID string, audience, scopes []string, lifetime time.Duration) (*user.UserTokenAddedEvent, *domain.Token, error) {
+func (c *Commands) addUserToken(ctx context.Context, userWriteModel *UserWriteModel, agentID, clientID, refreshTokenID string, audience, scopes []string, lifetime time.Duration, confirmation *authz.TokenConfirmation, actor *domain.TokenActor) (*user.UserTokenAddedEvent, *domain.Token, error) {
 	err := c.eventstore.FilterToQueryReducer(ctx, userWriteModel)
 	if err != nil {
 		return nil, nil, err
@@ -278,8 +317,12 @@ func (c *Commands) addUserToken(ctx context.Context, userWriteModel *UserWriteMo
 		return nil, nil, err
 	}
 
+	if confirmation == nil {
+		confirmation = new(authz.TokenConfirmation)
+	}
+
 	userAgg := UserAggregateFromWriteModel(&userWriteModel.WriteModel)
-	return user.NewUserTokenAddedEvent(ctx, userAgg, tokenID, clientID, agentID, preferredLanguage, refreshTokenID, audience, scopes, expiration),
+	return user.NewUserTokenAddedEvent(ctx, userAgg, tokenID, clientID, agentID, preferredLanguage, refreshTokenID, audience, scopes, expiration, confirmation.DPoPJKT, confirmation.CertificateThumbprint, actor),
 		&domain.Token{
 			ObjectRoot: models.ObjectRoot{
 				AggregateID: userWriteModel.AggregateID,
@@ -385,6 +428,23 @@ func (c *Commands) UserDomainClaimedSent(ctx context.Context, orgID, userID stri
 	return err
 }
 
+func (c *Commands) UserLockedNotificationSent(ctx context.Context, orgID, userID string) (err error) {
+	if userID == "" {
+		return caos_errs.ThrowInvalidArgument(nil, "COMMAND-Lo4wq", "Errors.IDMissing")
+	}
+	existingUser, err := c.userWriteModelByID(ctx, userID, orgID)
+	if err != nil {
+		return err
+	}
+	if !isUserStateExists(existingUser.UserState) {
+		return caos_errs.ThrowNotFound(nil, "COMMAND-Lo6vz", "Errors.User.NotFound")
+	}
+
+	_, err = c.eventstore.Push(ctx,
+		user.NewUserLockedNotificationSentEvent(ctx, UserAggregateFromWriteModel(&existingUser.WriteModel)))
+	return err
+}
+
 func (c *Commands) checkUserExists(ctx context.Context, userID, resourceOwner string) error {
 	existingUser, err := c.userWriteModelByID(ctx, userID, resourceOwner)
 	if err != nil {
@@ -220,6 +220,36 @@ func (c *Commands) RemoveUserGrant(ctx context.Context, grantID, resourceOwner s
 	return writeModelToObjectDetails(&existingUserGrant.WriteModel), nil
 }
 
+// RemoveUserGrantOfResourceOwner removes the user grant on behalf of the resource owner itself (e.g. by an action of the organisation).
+// Unlike RemoveUserGrant it does not require explicit project permissions of the caller.
+func (c *Commands) RemoveUserGrantOfResourceOwner(ctx context.Context, grantID, resourceOwner string) (objectDetails *domain.ObjectDetails, err error) {
+	if grantID == "" || resourceOwner == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Ug7r2", "Errors.UserGrant.IDMissing")
+	}
+	existingUserGrant, err := c.userGrantWriteModelByID(ctx, grantID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if existingUserGrant.State == domain.UserGrantStateUnspecified || existingUserGrant.State == domain.UserGrantStateRemoved {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Ug3n8", "Errors.UserGrant.NotFound")
+	}
+
+	pushedEvents, err := c.eventstore.Push(ctx, usergrant.NewUserGrantRemovedEvent(
+		ctx,
+		UserGrantAggregateFromWriteModel(&existingUserGrant.WriteModel),
+		existingUserGrant.UserID,
+		existingUserGrant.ProjectID,
+		existingUserGrant.ProjectGrantID))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingUserGrant, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingUserGrant.WriteModel), nil
+}
+
 func (c *Commands) BulkRemoveUserGrant(ctx context.Context, grantIDs []string, resourceOwner string) (err error) {
 	if len(grantIDs) == 0 {
 		return caos_errs.ThrowInvalidArgument(nil, "COMMAND-5M0sd", "Errors.UserGrant.IDMissing")
@@ -70,7 +70,7 @@ func (c *Commands) AddHumanWithID(ctx context.Context, resourceOwner string, use
 
 func (c *Commands) addHumanWithID(ctx context.Context, resourceOwner string, userID string, human *AddHuman) (*domain.HumanDetails, error) {
 	agg := user.NewAggregate(userID, resourceOwner)
-	cmds, err := preparation.PrepareCommands(ctx, c.eventstore.Filter, AddHumanCommand(agg, human, c.userPasswordAlg, c.userEncryption))
+	cmds, err := preparation.PrepareCommands(ctx, c.eventstore.Filter, AddHumanCommand(agg, human, c.userPasswordAlg, c.userEncryption, c.breachedPasswords, c.quotas))
 	if err != nil {
 		return nil, err
 	}
@@ -108,7 +108,7 @@ type humanCreationCommand interface {
 	AddPasswordData(secret *crypto.CryptoValue, changeRequired bool)
 }
 
-func AddHumanCommand(a *user.Aggregate, human *AddHuman, passwordAlg crypto.HashAlgorithm, codeAlg crypto.EncryptionAlgorithm) preparation.Validation {
+func AddHumanCommand(a *user.Aggregate, human *AddHuman, passwordAlg crypto.HashAlgorithm, codeAlg crypto.EncryptionAlgorithm, breachedPasswords crypto.BreachedPasswordChecker, quotas QuotaEnforcer) preparation.Validation {
 	return func() (_ preparation.CreateCommands, err error) {
 		if !human.Email.Valid() {
 			return nil, errors.ThrowInvalidArgument(nil, "USER-Ec7dM", "Errors.Invalid.Argument")
@@ -130,6 +130,9 @@ func AddHumanCommand(a *user.Aggregate, human *AddHuman, passwordAlg crypto.Hash
 		}
 
 		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
+			if err := checkQuota(ctx, quotas, domain.QuotaUnitUsers); err != nil {
+				return nil, err
+			}
 			domainPolicy, err := domainPolicyWriteModel(ctx, filter)
 			if err != nil {
 				return nil, err
@@ -175,7 +178,7 @@ func AddHumanCommand(a *user.Aggregate, human *AddHuman, passwordAlg crypto.Hash
 			}
 
 			if human.Password != "" {
-				if err = humanValidatePassword(ctx, filter, human.Password); err != nil {
+				if err = humanValidatePassword(ctx, filter, a.ID, human.Password, breachedPasswords); err != nil {
 					return nil, err
 				}
 
@@ -256,13 +259,16 @@ func userValidateDomain(ctx context.Context, a *user.Aggregate, username string,
 	return nil
 }
 
-func humanValidatePassword(ctx context.Context, filter preparation.FilterToQueryReducer, password string) error {
+func humanValidatePassword(ctx context.Context, filter preparation.FilterToQueryReducer, userID, password string, breachedPasswords crypto.BreachedPasswordChecker) error {
 	passwordComplexity, err := passwordComplexityPolicyWriteModel(ctx, filter)
 	if err != nil {
 		return err
 	}
 
-	return passwordComplexity.Validate(password)
+	if err = passwordComplexity.Validate(password); err != nil {
+		return err
+	}
+	return checkBreachedPassword(passwordComplexity.BreachedPasswordCheck, breachedPasswords, userID, password)
 }
 
 func (h *AddHuman) ensureDisplayName() {
@@ -435,6 +441,9 @@ func (c *Commands) createHuman(ctx context.Context, orgID string, human *domain.
 	if err := human.CheckDomainPolicy(domainPolicy); err != nil {
 		return nil, nil, err
 	}
+	if err := checkQuota(ctx, c.quotas, domain.QuotaUnitUsers); err != nil {
+		return nil, nil, err
+	}
 	human.Username = strings.TrimSpace(human.Username)
 	human.EmailAddress = strings.TrimSpace(human.EmailAddress)
 	if !domainPolicy.UserLoginMustBeDomain {
@@ -461,10 +470,20 @@ func (c *Commands) createHuman(ctx context.Context, orgID string, human *domain.
 
 	human.SetNamesAsDisplayname()
 	if human.Password != nil {
+		if pwPolicy != nil {
+			if err := checkBreachedPassword(pwPolicy.BreachedPasswordCheck, c.breachedPasswords, human.AggregateID, human.Password.SecretString); err != nil {
+				return nil, nil, err
+			}
+		}
 		if err := human.HashPasswordIfExisting(pwPolicy, c.userPasswordAlg, human.Password.ChangeRequired); err != nil {
 			return nil, nil, err
 		}
 	}
+	if human.HashedPassword != nil {
+		if err := human.HashedPassword.CheckHash(c.userPasswordAlg); err != nil {
+			return nil, nil, err
+		}
+	}
 
 	addedHuman = NewHumanWriteModel(human.AggregateID, orgID)
 	//TODO: adlerhurst maybe we could simplify the code below
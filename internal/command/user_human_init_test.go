@@ -571,6 +571,7 @@ func TestCommandSide_VerifyInitCode(t *testing.T) {
 								false,
 								false,
 								false,
+								domain.BreachedPasswordCheckDisabled,
 							),
 						),
 					),
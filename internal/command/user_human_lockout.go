@@ -0,0 +1,61 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/dennigogo/zitadel/internal/domain"
+	caos_errs "github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/eventstore"
+	"github.com/dennigogo/zitadel/internal/repository/user"
+	"github.com/dennigogo/zitadel/internal/telemetry/tracing"
+)
+
+// checkLockout rejects checks of locked users and checks before the attempt delay of the lockout policy passed.
+// If the lock of the user expired, the returned events unlock the user and the failed checks are reset
+func checkLockout(ctx context.Context, userAgg *eventstore.Aggregate, lockout *HumanLockoutWriteModel, checks *failedChecks, lockoutPolicy *domain.LockoutPolicy) ([]eventstore.Command, error) {
+	now := time.Now()
+	if lockout.Locked {
+		if !lockoutPolicy.IsAutoUnlocked(lockout.LockedAt, now) {
+			return nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Lo3nf", "Errors.User.Locked")
+		}
+		checks.reset()
+		return []eventstore.Command{user.NewUserUnlockedEvent(ctx, userAgg)}, nil
+	}
+	if now.Before(lockoutPolicy.NextAttempt(checks.Count, checks.LastFailed)) {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Lo9sd", "Errors.User.AttemptDelayed")
+	}
+	return nil, nil
+}
+
+// lockOnFailedCheck returns the event locking the user if the failed check exceeds the max attempts
+func lockOnFailedCheck(ctx context.Context, userAgg *eventstore.Aggregate, maxAttempts uint64, checks failedChecks) eventstore.Command {
+	if !domain.ExceedsAttempts(maxAttempts, checks.Count) {
+		return nil
+	}
+	return user.NewUserLockedByLockoutEvent(ctx, userAgg)
+}
+
+// appendOTPLockout appends the event locking the user if the failed otp check exceeds the max otp attempts,
+// the failed checks of all otp factors are counted together
+func appendOTPLockout(ctx context.Context, events []eventstore.Command, userAgg *eventstore.Aggregate, lockout *HumanLockoutWriteModel, lockoutPolicy *domain.LockoutPolicy) []eventstore.Command {
+	if lockoutPolicy == nil {
+		return events
+	}
+	if locked := lockOnFailedCheck(ctx, userAgg, lockoutPolicy.MaxOTPAttempts, lockout.OTPChecks); locked != nil {
+		events = append(events, locked)
+	}
+	return events
+}
+
+func (c *Commands) lockoutWriteModel(ctx context.Context, userID, resourceOwner string) (writeModel *HumanLockoutWriteModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel = NewHumanLockoutWriteModel(userID, resourceOwner)
+	err = c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
@@ -0,0 +1,122 @@
+package command
+
+import (
+	"time"
+
+	"github.com/dennigogo/zitadel/internal/eventstore"
+	"github.com/dennigogo/zitadel/internal/repository/user"
+)
+
+// HumanLockoutWriteModel contains the lock of the user
+// and the consecutive failed checks of the factors limited by the lockout policy
+type HumanLockoutWriteModel struct {
+	eventstore.WriteModel
+
+	Locked bool
+	// LockedAt is only set if the user was locked because of failed checks
+	// and might therefore be unlocked automatically
+	LockedAt time.Time
+
+	PasswordChecks failedChecks
+	OTPChecks      failedChecks
+	U2FChecks      failedChecks
+}
+
+type failedChecks struct {
+	Count      uint64
+	LastFailed time.Time
+}
+
+func (c *failedChecks) failed(at time.Time) {
+	c.Count++
+	c.LastFailed = at
+}
+
+func (c *failedChecks) reset() {
+	c.Count = 0
+	c.LastFailed = time.Time{}
+}
+
+func NewHumanLockoutWriteModel(userID, resourceOwner string) *HumanLockoutWriteModel {
+	return &HumanLockoutWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   userID,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (wm *HumanLockoutWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *user.UserLockedEvent:
+			wm.Locked = true
+			wm.LockedAt = time.Time{}
+			if e.AutoUnlock {
+				wm.LockedAt = e.CreationDate()
+			}
+		case *user.UserUnlockedEvent:
+			wm.Locked = false
+			wm.LockedAt = time.Time{}
+			wm.PasswordChecks.reset()
+			wm.OTPChecks.reset()
+			wm.U2FChecks.reset()
+		case *user.HumanPasswordCheckFailedEvent:
+			wm.PasswordChecks.failed(e.CreationDate())
+		case *user.HumanPasswordCheckSucceededEvent,
+			*user.HumanPasswordChangedEvent:
+			wm.PasswordChecks.reset()
+		case *user.HumanOTPCheckFailedEvent:
+			wm.OTPChecks.failed(e.CreationDate())
+		case *user.HumanOTPSMSCheckFailedEvent:
+			wm.OTPChecks.failed(e.CreationDate())
+		case *user.HumanOTPEmailCheckFailedEvent:
+			wm.OTPChecks.failed(e.CreationDate())
+		case *user.HumanRecoveryCodeCheckFailedEvent:
+			wm.OTPChecks.failed(e.CreationDate())
+		case *user.HumanOTPCheckSucceededEvent,
+			*user.HumanOTPSMSCheckSucceededEvent,
+			*user.HumanOTPEmailCheckSucceededEvent,
+			*user.HumanRecoveryCodeCheckSucceededEvent:
+			wm.OTPChecks.reset()
+		case *user.HumanU2FCheckFailedEvent:
+			wm.U2FChecks.failed(e.CreationDate())
+		case *user.HumanU2FCheckSucceededEvent:
+			wm.U2FChecks.reset()
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *HumanLockoutWriteModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(user.UserLockedType,
+			user.UserUnlockedType,
+			user.HumanPasswordCheckFailedType,
+			user.HumanPasswordCheckSucceededType,
+			user.HumanPasswordChangedType,
+			user.HumanMFAOTPCheckFailedType,
+			user.HumanMFAOTPCheckSucceededType,
+			user.HumanOTPSMSCheckFailedType,
+			user.HumanOTPSMSCheckSucceededType,
+			user.HumanOTPEmailCheckFailedType,
+			user.HumanOTPEmailCheckSucceededType,
+			user.HumanRecoveryCodeCheckFailedType,
+			user.HumanRecoveryCodeCheckSucceededType,
+			user.HumanU2FTokenCheckFailedType,
+			user.HumanU2FTokenCheckSucceededType,
+			user.UserV1PasswordCheckFailedType,
+			user.UserV1PasswordCheckSucceededType,
+			user.UserV1PasswordChangedType,
+			user.UserV1MFAOTPCheckFailedType,
+			user.UserV1MFAOTPCheckSucceededType).
+		Builder()
+
+	if wm.ResourceOwner != "" {
+		query.ResourceOwner(wm.ResourceOwner)
+	}
+	return query
+}
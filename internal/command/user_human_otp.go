@@ -132,12 +132,23 @@ func (c *Commands) HumanCheckMFAOTP(ctx context.Context, userID, code, resourceo
 		return caos_errs.ThrowPreconditionFailed(nil, "COMMAND-3Mif9s", "Errors.User.MFA.OTP.NotReady")
 	}
 	userAgg := UserAggregateFromWriteModel(&existingOTP.WriteModel)
+	lockout, err := c.lockoutWriteModel(ctx, userID, resourceowner)
+	if err != nil {
+		return err
+	}
+	events, err := checkLockout(ctx, userAgg, lockout, &lockout.OTPChecks, authRequest.LockoutPolicy)
+	if err != nil {
+		return err
+	}
 	err = domain.VerifyMFAOTP(code, existingOTP.Secret, c.multifactors.OTP.CryptoMFA)
 	if err == nil {
-		_, err = c.eventstore.Push(ctx, user.NewHumanOTPCheckSucceededEvent(ctx, userAgg, authRequestDomainToAuthRequestInfo(authRequest)))
+		events = append(events, user.NewHumanOTPCheckSucceededEvent(ctx, userAgg, authRequestDomainToAuthRequestInfo(authRequest)))
+		_, err = c.eventstore.Push(ctx, events...)
 		return err
 	}
-	_, pushErr := c.eventstore.Push(ctx, user.NewHumanOTPCheckFailedEvent(ctx, userAgg, authRequestDomainToAuthRequestInfo(authRequest)))
+	events = append(events, user.NewHumanOTPCheckFailedEvent(ctx, userAgg, authRequestDomainToAuthRequestInfo(authRequest)))
+	events = appendOTPLockout(ctx, events, userAgg, lockout, authRequest.LockoutPolicy)
+	_, pushErr := c.eventstore.Push(ctx, events...)
 	logging.Log("COMMAND-9fj7s").OnError(pushErr).Error("error create password check failed event")
 	return err
 }
@@ -177,3 +188,277 @@ func (c *Commands) otpWriteModelByID(ctx context.Context, userID, resourceOwner
 	}
 	return writeModel, nil
 }
+
+func (c *Commands) AddHumanOTPSMS(ctx context.Context, userID, resourceOwner string) (*domain.ObjectDetails, error) {
+	if userID == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-QSF2s", "Errors.User.UserIDMissing")
+	}
+	otpWriteModel, err := c.otpSMSWriteModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if otpWriteModel.State == domain.MFAStateReady {
+		return nil, caos_errs.ThrowAlreadyExists(nil, "COMMAND-Ad3g2", "Errors.User.MFA.OTPSMS.AlreadyReady")
+	}
+	if !otpWriteModel.PhoneVerified {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Q54j2", "Errors.User.MFA.OTPSMS.NotVerified")
+	}
+	userAgg := UserAggregateFromWriteModel(&otpWriteModel.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, user.NewHumanOTPSMSAddedEvent(ctx, userAgg))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(otpWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&otpWriteModel.WriteModel), nil
+}
+
+func (c *Commands) RemoveHumanOTPSMS(ctx context.Context, userID, resourceOwner string) (*domain.ObjectDetails, error) {
+	if userID == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-S3br2", "Errors.User.UserIDMissing")
+	}
+	otpWriteModel, err := c.otpSMSWriteModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if otpWriteModel.State != domain.MFAStateReady {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Sr3h3", "Errors.User.MFA.OTPSMS.NotExisting")
+	}
+	userAgg := UserAggregateFromWriteModel(&otpWriteModel.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, user.NewHumanOTPSMSRemovedEvent(ctx, userAgg))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(otpWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&otpWriteModel.WriteModel), nil
+}
+
+// HumanSendOTPSMS creates a new code which will be sent to the verified phone of the user by the notification handler
+func (c *Commands) HumanSendOTPSMS(ctx context.Context, userID, resourceOwner string, authRequest *domain.AuthRequest, codeGenerator crypto.Generator) error {
+	if userID == "" {
+		return caos_errs.ThrowInvalidArgument(nil, "COMMAND-S3SF1", "Errors.User.UserIDMissing")
+	}
+	otpWriteModel, err := c.otpSMSWriteModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return err
+	}
+	if otpWriteModel.State != domain.MFAStateReady || !otpWriteModel.PhoneVerified {
+		return caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Sf3n2", "Errors.User.MFA.OTPSMS.NotExisting")
+	}
+	code, _, err := crypto.NewCode(codeGenerator)
+	if err != nil {
+		return err
+	}
+	userAgg := UserAggregateFromWriteModel(&otpWriteModel.WriteModel)
+	_, err = c.eventstore.Push(ctx, user.NewHumanOTPSMSCodeAddedEvent(ctx, userAgg, code, codeGenerator.Expiry(), authRequestDomainToAuthRequestInfo(authRequest)))
+	return err
+}
+
+func (c *Commands) HumanOTPSMSCodeSent(ctx context.Context, userID, resourceOwner string) error {
+	if userID == "" {
+		return caos_errs.ThrowInvalidArgument(nil, "COMMAND-AE2h2", "Errors.User.UserIDMissing")
+	}
+	otpWriteModel, err := c.otpSMSWriteModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return err
+	}
+	if otpWriteModel.State != domain.MFAStateReady {
+		return caos_errs.ThrowPreconditionFailed(nil, "COMMAND-G3t31", "Errors.User.MFA.OTPSMS.NotExisting")
+	}
+	userAgg := UserAggregateFromWriteModel(&otpWriteModel.WriteModel)
+	_, err = c.eventstore.Push(ctx, user.NewHumanOTPSMSCodeSentEvent(ctx, userAgg))
+	return err
+}
+
+func (c *Commands) HumanCheckOTPSMS(ctx context.Context, userID, code, resourceOwner string, authRequest *domain.AuthRequest, codeGenerator crypto.Generator) error {
+	if userID == "" {
+		return caos_errs.ThrowInvalidArgument(nil, "COMMAND-VDrh3", "Errors.User.UserIDMissing")
+	}
+	if code == "" {
+		return caos_errs.ThrowInvalidArgument(nil, "COMMAND-SJl2g", "Errors.User.Code.Empty")
+	}
+	otpWriteModel, err := c.otpSMSWriteModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return err
+	}
+	if otpWriteModel.State != domain.MFAStateReady || !otpWriteModel.PhoneVerified {
+		return caos_errs.ThrowPreconditionFailed(nil, "COMMAND-d2r52", "Errors.User.MFA.OTPSMS.NotExisting")
+	}
+	if otpWriteModel.Code == nil {
+		return caos_errs.ThrowPreconditionFailed(nil, "COMMAND-S34gh", "Errors.User.Code.NotFound")
+	}
+	userAgg := UserAggregateFromWriteModel(&otpWriteModel.WriteModel)
+	lockout, err := c.lockoutWriteModel(ctx, userID, resourceOwner)
+	if err != nil {
+		return err
+	}
+	events, err := checkLockout(ctx, userAgg, lockout, &lockout.OTPChecks, authRequest.LockoutPolicy)
+	if err != nil {
+		return err
+	}
+	err = crypto.VerifyCode(otpWriteModel.CodeCreationDate, otpWriteModel.CodeExpiry, otpWriteModel.Code, code, codeGenerator)
+	if err == nil {
+		events = append(events, user.NewHumanOTPSMSCheckSucceededEvent(ctx, userAgg, authRequestDomainToAuthRequestInfo(authRequest)))
+		_, err = c.eventstore.Push(ctx, events...)
+		return err
+	}
+	events = append(events, user.NewHumanOTPSMSCheckFailedEvent(ctx, userAgg, authRequestDomainToAuthRequestInfo(authRequest)))
+	events = appendOTPLockout(ctx, events, userAgg, lockout, authRequest.LockoutPolicy)
+	_, pushErr := c.eventstore.Push(ctx, events...)
+	logging.WithFields("userID", userID).OnError(pushErr).Error("otp sms failure check push failed")
+	return err
+}
+
+func (c *Commands) AddHumanOTPEmail(ctx context.Context, userID, resourceOwner string) (*domain.ObjectDetails, error) {
+	if userID == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Sg1hz", "Errors.User.UserIDMissing")
+	}
+	otpWriteModel, err := c.otpEmailWriteModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if otpWriteModel.State == domain.MFAStateReady {
+		return nil, caos_errs.ThrowAlreadyExists(nil, "COMMAND-MKL2s", "Errors.User.MFA.OTPEmail.AlreadyReady")
+	}
+	if !otpWriteModel.EmailVerified {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-KLJ2d", "Errors.User.MFA.OTPEmail.NotVerified")
+	}
+	userAgg := UserAggregateFromWriteModel(&otpWriteModel.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, user.NewHumanOTPEmailAddedEvent(ctx, userAgg))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(otpWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&otpWriteModel.WriteModel), nil
+}
+
+func (c *Commands) RemoveHumanOTPEmail(ctx context.Context, userID, resourceOwner string) (*domain.ObjectDetails, error) {
+	if userID == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-S2h11", "Errors.User.UserIDMissing")
+	}
+	otpWriteModel, err := c.otpEmailWriteModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if otpWriteModel.State != domain.MFAStateReady {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-b312D", "Errors.User.MFA.OTPEmail.NotExisting")
+	}
+	userAgg := UserAggregateFromWriteModel(&otpWriteModel.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, user.NewHumanOTPEmailRemovedEvent(ctx, userAgg))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(otpWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&otpWriteModel.WriteModel), nil
+}
+
+// HumanSendOTPEmail creates a new code which will be sent to the verified email of the user by the notification handler
+func (c *Commands) HumanSendOTPEmail(ctx context.Context, userID, resourceOwner string, authRequest *domain.AuthRequest, codeGenerator crypto.Generator) error {
+	if userID == "" {
+		return caos_errs.ThrowInvalidArgument(nil, "COMMAND-Fd3g1", "Errors.User.UserIDMissing")
+	}
+	otpWriteModel, err := c.otpEmailWriteModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return err
+	}
+	if otpWriteModel.State != domain.MFAStateReady || !otpWriteModel.EmailVerified {
+		return caos_errs.ThrowPreconditionFailed(nil, "COMMAND-F3h2s", "Errors.User.MFA.OTPEmail.NotExisting")
+	}
+	code, _, err := crypto.NewCode(codeGenerator)
+	if err != nil {
+		return err
+	}
+	userAgg := UserAggregateFromWriteModel(&otpWriteModel.WriteModel)
+	_, err = c.eventstore.Push(ctx, user.NewHumanOTPEmailCodeAddedEvent(ctx, userAgg, code, codeGenerator.Expiry(), authRequestDomainToAuthRequestInfo(authRequest)))
+	return err
+}
+
+func (c *Commands) HumanOTPEmailCodeSent(ctx context.Context, userID, resourceOwner string) error {
+	if userID == "" {
+		return caos_errs.ThrowInvalidArgument(nil, "COMMAND-Hs2gs", "Errors.User.UserIDMissing")
+	}
+	otpWriteModel, err := c.otpEmailWriteModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return err
+	}
+	if otpWriteModel.State != domain.MFAStateReady {
+		return caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Ag3wr", "Errors.User.MFA.OTPEmail.NotExisting")
+	}
+	userAgg := UserAggregateFromWriteModel(&otpWriteModel.WriteModel)
+	_, err = c.eventstore.Push(ctx, user.NewHumanOTPEmailCodeSentEvent(ctx, userAgg))
+	return err
+}
+
+func (c *Commands) HumanCheckOTPEmail(ctx context.Context, userID, code, resourceOwner string, authRequest *domain.AuthRequest, codeGenerator crypto.Generator) error {
+	if userID == "" {
+		return caos_errs.ThrowInvalidArgument(nil, "COMMAND-Hdrr1", "Errors.User.UserIDMissing")
+	}
+	if code == "" {
+		return caos_errs.ThrowInvalidArgument(nil, "COMMAND-ShWd2", "Errors.User.Code.Empty")
+	}
+	otpWriteModel, err := c.otpEmailWriteModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return err
+	}
+	if otpWriteModel.State != domain.MFAStateReady || !otpWriteModel.EmailVerified {
+		return caos_errs.ThrowPreconditionFailed(nil, "COMMAND-hgfq3", "Errors.User.MFA.OTPEmail.NotExisting")
+	}
+	if otpWriteModel.Code == nil {
+		return caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Jn4f2", "Errors.User.Code.NotFound")
+	}
+	userAgg := UserAggregateFromWriteModel(&otpWriteModel.WriteModel)
+	lockout, err := c.lockoutWriteModel(ctx, userID, resourceOwner)
+	if err != nil {
+		return err
+	}
+	events, err := checkLockout(ctx, userAgg, lockout, &lockout.OTPChecks, authRequest.LockoutPolicy)
+	if err != nil {
+		return err
+	}
+	err = crypto.VerifyCode(otpWriteModel.CodeCreationDate, otpWriteModel.CodeExpiry, otpWriteModel.Code, code, codeGenerator)
+	if err == nil {
+		events = append(events, user.NewHumanOTPEmailCheckSucceededEvent(ctx, userAgg, authRequestDomainToAuthRequestInfo(authRequest)))
+		_, err = c.eventstore.Push(ctx, events...)
+		return err
+	}
+	events = append(events, user.NewHumanOTPEmailCheckFailedEvent(ctx, userAgg, authRequestDomainToAuthRequestInfo(authRequest)))
+	events = appendOTPLockout(ctx, events, userAgg, lockout, authRequest.LockoutPolicy)
+	_, pushErr := c.eventstore.Push(ctx, events...)
+	logging.WithFields("userID", userID).OnError(pushErr).Error("otp email failure check push failed")
+	return err
+}
+
+func (c *Commands) otpSMSWriteModelByID(ctx context.Context, userID, resourceOwner string) (writeModel *HumanOTPSMSWriteModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel = NewHumanOTPSMSWriteModel(userID, resourceOwner)
+	err = c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
+
+func (c *Commands) otpEmailWriteModelByID(ctx context.Context, userID, resourceOwner string) (writeModel *HumanOTPEmailWriteModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel = NewHumanOTPEmailWriteModel(userID, resourceOwner)
+	err = c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
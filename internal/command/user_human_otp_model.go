@@ -1,6 +1,8 @@
 package command
 
 import (
+	"time"
+
 	"github.com/dennigogo/zitadel/internal/crypto"
 	"github.com/dennigogo/zitadel/internal/domain"
 	"github.com/dennigogo/zitadel/internal/eventstore"
@@ -59,3 +61,144 @@ func (wm *HumanOTPWriteModel) Query() *eventstore.SearchQueryBuilder {
 	}
 	return query
 }
+
+type HumanOTPSMSWriteModel struct {
+	eventstore.WriteModel
+
+	PhoneVerified bool
+	State         domain.MFAState
+
+	Code             *crypto.CryptoValue
+	CodeCreationDate time.Time
+	CodeExpiry       time.Duration
+}
+
+func NewHumanOTPSMSWriteModel(userID, resourceOwner string) *HumanOTPSMSWriteModel {
+	return &HumanOTPSMSWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   userID,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (wm *HumanOTPSMSWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *user.HumanPhoneVerifiedEvent:
+			wm.PhoneVerified = true
+		case *user.HumanPhoneChangedEvent,
+			*user.HumanPhoneRemovedEvent:
+			wm.PhoneVerified = false
+		case *user.HumanOTPSMSAddedEvent:
+			wm.State = domain.MFAStateReady
+		case *user.HumanOTPSMSRemovedEvent:
+			wm.State = domain.MFAStateRemoved
+			wm.Code = nil
+		case *user.HumanOTPSMSCodeAddedEvent:
+			wm.Code = e.Code
+			wm.CodeCreationDate = e.CreationDate()
+			wm.CodeExpiry = e.Expiry
+		case *user.HumanOTPSMSCheckSucceededEvent:
+			wm.Code = nil
+		case *user.UserRemovedEvent:
+			wm.PhoneVerified = false
+			wm.State = domain.MFAStateRemoved
+			wm.Code = nil
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *HumanOTPSMSWriteModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(user.HumanPhoneVerifiedType,
+			user.HumanPhoneChangedType,
+			user.HumanPhoneRemovedType,
+			user.HumanOTPSMSAddedType,
+			user.HumanOTPSMSRemovedType,
+			user.HumanOTPSMSCodeAddedType,
+			user.HumanOTPSMSCheckSucceededType,
+			user.UserRemovedType,
+			user.UserV1PhoneVerifiedType,
+			user.UserV1PhoneChangedType,
+			user.UserV1PhoneRemovedType).
+		Builder()
+
+	if wm.ResourceOwner != "" {
+		query.ResourceOwner(wm.ResourceOwner)
+	}
+	return query
+}
+
+type HumanOTPEmailWriteModel struct {
+	eventstore.WriteModel
+
+	EmailVerified bool
+	State         domain.MFAState
+
+	Code             *crypto.CryptoValue
+	CodeCreationDate time.Time
+	CodeExpiry       time.Duration
+}
+
+func NewHumanOTPEmailWriteModel(userID, resourceOwner string) *HumanOTPEmailWriteModel {
+	return &HumanOTPEmailWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   userID,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (wm *HumanOTPEmailWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *user.HumanEmailVerifiedEvent:
+			wm.EmailVerified = true
+		case *user.HumanEmailChangedEvent:
+			wm.EmailVerified = false
+		case *user.HumanOTPEmailAddedEvent:
+			wm.State = domain.MFAStateReady
+		case *user.HumanOTPEmailRemovedEvent:
+			wm.State = domain.MFAStateRemoved
+			wm.Code = nil
+		case *user.HumanOTPEmailCodeAddedEvent:
+			wm.Code = e.Code
+			wm.CodeCreationDate = e.CreationDate()
+			wm.CodeExpiry = e.Expiry
+		case *user.HumanOTPEmailCheckSucceededEvent:
+			wm.Code = nil
+		case *user.UserRemovedEvent:
+			wm.EmailVerified = false
+			wm.State = domain.MFAStateRemoved
+			wm.Code = nil
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *HumanOTPEmailWriteModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(user.HumanEmailVerifiedType,
+			user.HumanEmailChangedType,
+			user.HumanOTPEmailAddedType,
+			user.HumanOTPEmailRemovedType,
+			user.HumanOTPEmailCodeAddedType,
+			user.HumanOTPEmailCheckSucceededType,
+			user.UserRemovedType,
+			user.UserV1EmailVerifiedType,
+			user.UserV1EmailChangedType).
+		Builder()
+
+	if wm.ResourceOwner != "" {
+		query.ResourceOwner(wm.ResourceOwner)
+	}
+	return query
+}
@@ -2,6 +2,7 @@ package command
 
 import (
 	"context"
+	"time"
 
 	"github.com/zitadel/logging"
 
@@ -142,12 +143,65 @@ func (c *Commands) changePassword(ctx context.Context, userAgentID string, passw
 	if err != nil {
 		return nil, err
 	}
+	if err := checkBreachedPassword(pwPolicy.BreachedPasswordCheck, c.breachedPasswords, userAgg.ID, password.SecretString); err != nil {
+		return nil, err
+	}
+	if err := c.checkPasswordHistory(ctx, userAgg.ResourceOwner, password.SecretString, existingPassword); err != nil {
+		return nil, err
+	}
 	if err := password.HashPasswordIfExisting(pwPolicy, c.userPasswordAlg); err != nil {
 		return nil, err
 	}
 	return user.NewHumanPasswordChangedEvent(ctx, userAgg, password.SecretCrypto, password.ChangeRequired, userAgentID), nil
 }
 
+// checkPasswordHistory prevents the reuse of the passwords defined by the history count of the password age policy
+func (c *Commands) checkPasswordHistory(ctx context.Context, resourceOwner, password string, existingPassword *HumanPasswordWriteModel) (err error) {
+	if password == "" || len(existingPassword.SecretHistory) == 0 {
+		return nil
+	}
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	agePolicy, err := c.getOrgPasswordAgePolicy(ctx, resourceOwner)
+	if err != nil {
+		return err
+	}
+	for _, secret := range existingPassword.LastSecrets(agePolicy.HistoryCount) {
+		if crypto.CompareHash(secret, []byte(password), c.userPasswordAlg) == nil {
+			return caos_errs.ThrowInvalidArgument(nil, "COMMAND-Pw7hs", "Errors.User.Password.UsedBefore")
+		}
+	}
+	return nil
+}
+
+// checkBreachedPassword looks up the password in the corpus of breached passwords,
+// depending on the policy a breached password is rejected or only logged
+func checkBreachedPassword(check domain.BreachedPasswordCheck, breachedPasswords crypto.BreachedPasswordChecker, userID, password string) error {
+	breached, err := check.Check(password, breachedPasswords)
+	if err != nil {
+		return err
+	}
+	if breached {
+		logging.WithFields("userID", userID).Warn("password found in breached passwords")
+	}
+	return nil
+}
+
+// BreachedPasswordWarning returns true if the password was found in the corpus of breached passwords
+// and the password complexity policy of the organisation only warns about it
+func (c *Commands) BreachedPasswordWarning(ctx context.Context, orgID, password string) bool {
+	if c.breachedPasswords == nil {
+		return false
+	}
+	pwPolicy, err := c.getOrgPasswordComplexityPolicy(ctx, orgID)
+	if err != nil || pwPolicy.BreachedPasswordCheck != domain.BreachedPasswordCheckWarn {
+		return false
+	}
+	breached, _ := pwPolicy.BreachedPasswordCheck.Check(password, c.breachedPasswords)
+	return breached
+}
+
 func (c *Commands) RequestSetPassword(ctx context.Context, userID, resourceOwner string, notifyType domain.NotificationType, passwordVerificationCode crypto.Generator) (objectDetails *domain.ObjectDetails, err error) {
 	if userID == "" {
 		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-M00oL", "Errors.User.UserIDMissing")
@@ -196,6 +250,50 @@ func (c *Commands) PasswordCodeSent(ctx context.Context, orgID, userID string) (
 	return err
 }
 
+// RequestHumanPasswordExpiryNotification requests the notification of the user about the expiration of the password,
+// which is sent by the notifications projection. It's requested only once per password.
+func (c *Commands) RequestHumanPasswordExpiryNotification(ctx context.Context, orgID, userID string, expirationDate time.Time) (err error) {
+	if userID == "" {
+		return caos_errs.ThrowInvalidArgument(nil, "COMMAND-Pe3nq", "Errors.User.UserIDMissing")
+	}
+
+	existingPassword, err := c.passwordWriteModel(ctx, userID, orgID)
+	if err != nil {
+		return err
+	}
+	if existingPassword.UserState == domain.UserStateUnspecified || existingPassword.UserState == domain.UserStateDeleted {
+		return caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Pe5rw", "Errors.User.NotFound")
+	}
+	if existingPassword.ExpiryRequested.Equal(expirationDate) || existingPassword.ExpiryNotified.Equal(expirationDate) {
+		return caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Pe7qx", "Errors.User.Password.ExpiryNotificationAlreadySent")
+	}
+	userAgg := UserAggregateFromWriteModel(&existingPassword.WriteModel)
+	_, err = c.eventstore.Push(ctx, user.NewHumanPasswordExpiryNotificationRequestedEvent(ctx, userAgg, expirationDate))
+	return err
+}
+
+// HumanPasswordExpiryNotificationSent records the notification of the user about the expiration of the password,
+// so the user is notified only once per password
+func (c *Commands) HumanPasswordExpiryNotificationSent(ctx context.Context, orgID, userID string, expirationDate time.Time) (err error) {
+	if userID == "" {
+		return caos_errs.ThrowInvalidArgument(nil, "COMMAND-Pe4kd", "Errors.User.UserIDMissing")
+	}
+
+	existingPassword, err := c.passwordWriteModel(ctx, userID, orgID)
+	if err != nil {
+		return err
+	}
+	if existingPassword.UserState == domain.UserStateUnspecified || existingPassword.UserState == domain.UserStateDeleted {
+		return caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Pe8sw", "Errors.User.NotFound")
+	}
+	if existingPassword.ExpiryNotified.Equal(expirationDate) {
+		return caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Pe2mv", "Errors.User.Password.ExpiryNotificationAlreadySent")
+	}
+	userAgg := UserAggregateFromWriteModel(&existingPassword.WriteModel)
+	_, err = c.eventstore.Push(ctx, user.NewHumanPasswordExpiryNotificationSentEvent(ctx, userAgg, expirationDate))
+	return err
+}
+
 func (c *Commands) HumanCheckPassword(ctx context.Context, orgID, userID, password string, authRequest *domain.AuthRequest, lockoutPolicy *domain.LockoutPolicy) (err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
@@ -228,20 +326,30 @@ func (c *Commands) HumanCheckPassword(ctx context.Context, orgID, userID, passwo
 	}
 
 	userAgg := UserAggregateFromWriteModel(&existingPassword.WriteModel)
+	lockout, err := c.lockoutWriteModel(ctx, userID, orgID)
+	if err != nil {
+		return err
+	}
+	events, err := checkLockout(ctx, userAgg, lockout, &lockout.PasswordChecks, lockoutPolicy)
+	if err != nil {
+		return err
+	}
 	ctx, spanPasswordComparison := tracing.NewNamedSpan(ctx, "crypto.CompareHash")
 	err = crypto.CompareHash(existingPassword.Secret, []byte(password), c.userPasswordAlg)
 	spanPasswordComparison.EndWithError(err)
 	if err == nil {
-		_, err = c.eventstore.Push(ctx, user.NewHumanPasswordCheckSucceededEvent(ctx, userAgg, authRequestDomainToAuthRequestInfo(authRequest)))
+		events = append(events, user.NewHumanPasswordCheckSucceededEvent(ctx, userAgg, authRequestDomainToAuthRequestInfo(authRequest)))
+		if hashUpdated := c.updatePasswordHash(ctx, userAgg, existingPassword.Secret, password); hashUpdated != nil {
+			events = append(events, hashUpdated)
+		}
+		_, err = c.eventstore.Push(ctx, events...)
 		return err
 	}
-	events := make([]eventstore.Command, 0)
 	events = append(events, user.NewHumanPasswordCheckFailedEvent(ctx, userAgg, authRequestDomainToAuthRequestInfo(authRequest)))
-	if lockoutPolicy != nil && lockoutPolicy.MaxPasswordAttempts > 0 {
-		if existingPassword.PasswordCheckFailedCount+1 >= lockoutPolicy.MaxPasswordAttempts {
-			events = append(events, user.NewUserLockedEvent(ctx, userAgg))
+	if lockoutPolicy != nil {
+		if locked := lockOnFailedCheck(ctx, userAgg, lockoutPolicy.MaxPasswordAttempts, lockout.PasswordChecks); locked != nil {
+			events = append(events, locked)
 		}
-
 	}
 	_, err = c.eventstore.Push(ctx, events...)
 	logging.Log("COMMAND-9fj7s").OnError(err).Error("error create password check failed event")
@@ -259,3 +367,20 @@ func (c *Commands) passwordWriteModel(ctx context.Context, userID, resourceOwner
 	}
 	return writeModel, nil
 }
+
+// updatePasswordHash returns the event to update the hash of the (verified) password,
+// if it was not hashed with the preferred algorithm and cost
+// an error during hashing must not prevent the login, the hash will be updated on the next one
+func (c *Commands) updatePasswordHash(ctx context.Context, userAgg *eventstore.Aggregate, secret *crypto.CryptoValue, password string) eventstore.Command {
+	if !crypto.NeedsRehash(secret, c.userPasswordAlg) {
+		return nil
+	}
+	ctx, spanRehash := tracing.NewNamedSpan(ctx, "crypto.Hash")
+	updatedSecret, err := crypto.Hash([]byte(password), c.userPasswordAlg)
+	spanRehash.EndWithError(err)
+	if err != nil {
+		logging.WithFields("userid", userAgg.ID).WithError(err).Warn("unable to update password hash")
+		return nil
+	}
+	return user.NewHumanPasswordHashUpdatedEvent(ctx, userAgg, updatedSecret)
+}
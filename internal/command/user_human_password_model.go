@@ -15,11 +15,16 @@ type HumanPasswordWriteModel struct {
 
 	Secret               *crypto.CryptoValue
 	SecretChangeRequired bool
+	// SecretHistory contains the hashes of all passwords of the user, the current one is last
+	SecretHistory []*crypto.CryptoValue
 
-	Code                     *crypto.CryptoValue
-	CodeCreationDate         time.Time
-	CodeExpiry               time.Duration
-	PasswordCheckFailedCount uint64
+	Code             *crypto.CryptoValue
+	CodeCreationDate time.Time
+	CodeExpiry       time.Duration
+	// ExpiryRequested is the expiration date of the password the notification was last requested for
+	ExpiryRequested time.Time
+	// ExpiryNotified is the expiration date of the password the user was last notified about
+	ExpiryNotified time.Time
 
 	UserState domain.UserState
 }
@@ -40,10 +45,12 @@ func (wm *HumanPasswordWriteModel) Reduce() error {
 			wm.Secret = e.Secret
 			wm.SecretChangeRequired = e.ChangeRequired
 			wm.UserState = domain.UserStateActive
+			wm.appendSecretHistory(e.Secret)
 		case *user.HumanRegisteredEvent:
 			wm.Secret = e.Secret
 			wm.SecretChangeRequired = e.ChangeRequired
 			wm.UserState = domain.UserStateActive
+			wm.appendSecretHistory(e.Secret)
 		case *user.HumanInitialCodeAddedEvent:
 			wm.UserState = domain.UserStateInitial
 		case *user.HumanInitializedCheckSucceededEvent:
@@ -52,7 +59,18 @@ func (wm *HumanPasswordWriteModel) Reduce() error {
 			wm.Secret = e.Secret
 			wm.SecretChangeRequired = e.ChangeRequired
 			wm.Code = nil
-			wm.PasswordCheckFailedCount = 0
+			wm.ExpiryRequested = time.Time{}
+			wm.ExpiryNotified = time.Time{}
+			wm.appendSecretHistory(e.Secret)
+		case *user.HumanPasswordExpiryNotificationRequestedEvent:
+			wm.ExpiryRequested = e.ExpirationDate
+		case *user.HumanPasswordExpiryNotificationSentEvent:
+			wm.ExpiryNotified = e.ExpirationDate
+		case *user.HumanPasswordHashUpdatedEvent:
+			wm.Secret = e.Secret
+			if len(wm.SecretHistory) > 0 {
+				wm.SecretHistory[len(wm.SecretHistory)-1] = e.Secret
+			}
 		case *user.HumanPasswordCodeAddedEvent:
 			wm.Code = e.Code
 			wm.CodeCreationDate = e.CreationDate()
@@ -61,12 +79,6 @@ func (wm *HumanPasswordWriteModel) Reduce() error {
 			if wm.UserState == domain.UserStateInitial {
 				wm.UserState = domain.UserStateActive
 			}
-		case *user.HumanPasswordCheckFailedEvent:
-			wm.PasswordCheckFailedCount += 1
-		case *user.HumanPasswordCheckSucceededEvent:
-			wm.PasswordCheckFailedCount = 0
-		case *user.UserUnlockedEvent:
-			wm.PasswordCheckFailedCount = 0
 		case *user.UserRemovedEvent:
 			wm.UserState = domain.UserStateDeleted
 		}
@@ -74,6 +86,21 @@ func (wm *HumanPasswordWriteModel) Reduce() error {
 	return wm.WriteModel.Reduce()
 }
 
+func (wm *HumanPasswordWriteModel) appendSecretHistory(secret *crypto.CryptoValue) {
+	if secret == nil {
+		return
+	}
+	wm.SecretHistory = append(wm.SecretHistory, secret)
+}
+
+// LastSecrets returns the hashes of the last count passwords of the user, including the current one
+func (wm *HumanPasswordWriteModel) LastSecrets(count uint64) []*crypto.CryptoValue {
+	if uint64(len(wm.SecretHistory)) <= count {
+		return wm.SecretHistory
+	}
+	return wm.SecretHistory[uint64(len(wm.SecretHistory))-count:]
+}
+
 func (wm *HumanPasswordWriteModel) Query() *eventstore.SearchQueryBuilder {
 	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
 		AddQuery().
@@ -84,21 +111,19 @@ func (wm *HumanPasswordWriteModel) Query() *eventstore.SearchQueryBuilder {
 			user.HumanInitialCodeAddedType,
 			user.HumanInitializedCheckSucceededType,
 			user.HumanPasswordChangedType,
+			user.HumanPasswordHashUpdatedType,
+			user.HumanPasswordExpiryNotificationRequestedType,
+			user.HumanPasswordExpiryNotificationSentType,
 			user.HumanPasswordCodeAddedType,
 			user.HumanEmailVerifiedType,
-			user.HumanPasswordCheckFailedType,
-			user.HumanPasswordCheckSucceededType,
 			user.UserRemovedType,
-			user.UserUnlockedType,
 			user.UserV1AddedType,
 			user.UserV1RegisteredType,
 			user.UserV1InitialCodeAddedType,
 			user.UserV1InitializedCheckSucceededType,
 			user.UserV1PasswordChangedType,
 			user.UserV1PasswordCodeAddedType,
-			user.UserV1EmailVerifiedType,
-			user.UserV1PasswordCheckFailedType,
-			user.UserV1PasswordCheckSucceededType).
+			user.UserV1EmailVerifiedType).
 		Builder()
 
 	if wm.ResourceOwner != "" {
@@ -0,0 +1,164 @@
+package command
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/dennigogo/zitadel/internal/crypto"
+	"github.com/dennigogo/zitadel/internal/domain"
+	caos_errs "github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/repository/user"
+	"github.com/dennigogo/zitadel/internal/telemetry/tracing"
+)
+
+const (
+	recoveryCodesCount = 10
+)
+
+var recoveryCodeGeneratorConfig = crypto.GeneratorConfig{
+	Length:              10,
+	IncludeUpperLetters: true,
+	IncludeDigits:       true,
+}
+
+// AddHumanRecoveryCodes generates the recovery codes of the user if none remain
+// the plain codes are returned once and must be shown to the user
+func (c *Commands) AddHumanRecoveryCodes(ctx context.Context, userID, resourceOwner string) ([]string, *domain.ObjectDetails, error) {
+	return c.addHumanRecoveryCodes(ctx, userID, resourceOwner, false)
+}
+
+// RegenerateHumanRecoveryCodes replaces the existing recovery codes of the user by new ones
+func (c *Commands) RegenerateHumanRecoveryCodes(ctx context.Context, userID, resourceOwner string) ([]string, *domain.ObjectDetails, error) {
+	return c.addHumanRecoveryCodes(ctx, userID, resourceOwner, true)
+}
+
+func (c *Commands) addHumanRecoveryCodes(ctx context.Context, userID, resourceOwner string, replace bool) ([]string, *domain.ObjectDetails, error) {
+	if userID == "" {
+		return nil, nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Rc5gA", "Errors.User.UserIDMissing")
+	}
+	writeModel, err := c.recoveryCodesWriteModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !writeModel.HasMFA() {
+		return nil, nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Rc2mF", "Errors.User.MFA.RecoveryCodes.NoMFA")
+	}
+	if !replace && writeModel.RemainingCodes() > 0 {
+		return nil, nil, caos_errs.ThrowAlreadyExists(nil, "COMMAND-Rc7eX", "Errors.User.MFA.RecoveryCodes.AlreadyExisting")
+	}
+	codes := make([]string, recoveryCodesCount)
+	hashedCodes := make([]*crypto.CryptoValue, recoveryCodesCount)
+	for i := range codes {
+		code, err := crypto.GenerateRandomString(c.recoveryCodeGenerator.Length(), c.recoveryCodeGenerator.Runes())
+		if err != nil {
+			return nil, nil, err
+		}
+		hashedCodes[i], err = crypto.Crypt([]byte(normalizeRecoveryCode(code)), c.recoveryCodeGenerator.Alg())
+		if err != nil {
+			return nil, nil, err
+		}
+		codes[i] = formatRecoveryCode(code)
+	}
+	pushedEvents, err := c.eventstore.Push(ctx,
+		user.NewHumanRecoveryCodesAddedEvent(ctx, UserAggregateFromWriteModel(&writeModel.WriteModel), hashedCodes))
+	if err != nil {
+		return nil, nil, err
+	}
+	err = AppendAndReduce(writeModel, pushedEvents...)
+	if err != nil {
+		return nil, nil, err
+	}
+	return codes, writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// HumanCheckRecoveryCode verifies the code against the remaining recovery codes,
+// a successfully checked code can't be used again
+func (c *Commands) HumanCheckRecoveryCode(ctx context.Context, userID, code, resourceOwner string, authRequest *domain.AuthRequest) error {
+	if userID == "" {
+		return caos_errs.ThrowInvalidArgument(nil, "COMMAND-Rc9sW", "Errors.User.UserIDMissing")
+	}
+	if code == "" {
+		return caos_errs.ThrowInvalidArgument(nil, "COMMAND-Rc1kD", "Errors.User.Code.Empty")
+	}
+	writeModel, err := c.recoveryCodesWriteModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return err
+	}
+	remaining := writeModel.RemainingCodes()
+	if remaining == 0 {
+		return caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Rc4hN", "Errors.User.MFA.RecoveryCodes.NotExisting")
+	}
+	userAgg := UserAggregateFromWriteModel(&writeModel.WriteModel)
+	var lockoutPolicy *domain.LockoutPolicy
+	if authRequest != nil {
+		lockoutPolicy = authRequest.LockoutPolicy
+	}
+	// recovery codes are counted together with the otp factors, so they can't be used to bypass the otp lockout
+	lockout, err := c.lockoutWriteModel(ctx, userID, resourceOwner)
+	if err != nil {
+		return err
+	}
+	events, err := checkLockout(ctx, userAgg, lockout, &lockout.OTPChecks, lockoutPolicy)
+	if err != nil {
+		return err
+	}
+	code = normalizeRecoveryCode(code)
+	for i, hashedCode := range writeModel.Codes {
+		if hashedCode == nil {
+			continue
+		}
+		if crypto.VerifyCode(time.Time{}, 0, hashedCode, code, c.recoveryCodeGenerator) != nil {
+			continue
+		}
+		events = append(events, user.NewHumanRecoveryCodeCheckSucceededEvent(ctx, userAgg, i, remaining-1, authRequestDomainToAuthRequestInfo(authRequest)))
+		_, err = c.eventstore.Push(ctx, events...)
+		return err
+	}
+	events = append(events, user.NewHumanRecoveryCodeCheckFailedEvent(ctx, userAgg, authRequestDomainToAuthRequestInfo(authRequest)))
+	events = appendOTPLockout(ctx, events, userAgg, lockout, lockoutPolicy)
+	_, pushErr := c.eventstore.Push(ctx, events...)
+	logging.WithFields("userID", userID).OnError(pushErr).Error("recovery code failure check push failed")
+	return caos_errs.ThrowInvalidArgument(nil, "COMMAND-Rc6vB", "Errors.User.MFA.RecoveryCodes.InvalidCode")
+}
+
+func (c *Commands) HumanRecoveryCodeNotificationSent(ctx context.Context, userID, resourceOwner string) error {
+	if userID == "" {
+		return caos_errs.ThrowInvalidArgument(nil, "COMMAND-Rc3pQ", "Errors.User.UserIDMissing")
+	}
+	writeModel, err := c.recoveryCodesWriteModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return err
+	}
+	_, err = c.eventstore.Push(ctx, user.NewHumanRecoveryCodeNotificationSentEvent(ctx, UserAggregateFromWriteModel(&writeModel.WriteModel)))
+	return err
+}
+
+func (c *Commands) recoveryCodesWriteModelByID(ctx context.Context, userID, resourceOwner string) (writeModel *HumanRecoveryCodesWriteModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel = NewHumanRecoveryCodesWriteModel(userID, resourceOwner)
+	err = c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
+
+// formatRecoveryCode splits the code into two groups for better readability
+func formatRecoveryCode(code string) string {
+	if len(code) < 8 {
+		return code
+	}
+	return code[:len(code)/2] + "-" + code[len(code)/2:]
+}
+
+// normalizeRecoveryCode removes the formatting of the code, so users can enter it with or without separator
+func normalizeRecoveryCode(code string) string {
+	code = strings.ReplaceAll(code, "-", "")
+	code = strings.ReplaceAll(code, " ", "")
+	return strings.ToUpper(code)
+}
@@ -0,0 +1,114 @@
+package command
+
+import (
+	"github.com/dennigogo/zitadel/internal/crypto"
+	"github.com/dennigogo/zitadel/internal/eventstore"
+	"github.com/dennigogo/zitadel/internal/repository/user"
+)
+
+type HumanRecoveryCodesWriteModel struct {
+	eventstore.WriteModel
+
+	// Codes contains the hashed codes of the last added event,
+	// used codes are set to nil so the index of the remaining codes stays the same
+	Codes []*crypto.CryptoValue
+
+	otpReady       bool
+	otpSMSAdded    bool
+	otpEmailAdded  bool
+	webAuthNTokens map[string]struct{}
+}
+
+func NewHumanRecoveryCodesWriteModel(userID, resourceOwner string) *HumanRecoveryCodesWriteModel {
+	return &HumanRecoveryCodesWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   userID,
+			ResourceOwner: resourceOwner,
+		},
+		webAuthNTokens: make(map[string]struct{}),
+	}
+}
+
+func (wm *HumanRecoveryCodesWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *user.HumanOTPVerifiedEvent:
+			wm.otpReady = true
+		case *user.HumanOTPRemovedEvent:
+			wm.otpReady = false
+		case *user.HumanOTPSMSAddedEvent:
+			wm.otpSMSAdded = true
+		case *user.HumanOTPSMSRemovedEvent:
+			wm.otpSMSAdded = false
+		case *user.HumanOTPEmailAddedEvent:
+			wm.otpEmailAdded = true
+		case *user.HumanOTPEmailRemovedEvent:
+			wm.otpEmailAdded = false
+		case *user.HumanU2FVerifiedEvent:
+			wm.webAuthNTokens[e.WebAuthNTokenID] = struct{}{}
+		case *user.HumanU2FRemovedEvent:
+			delete(wm.webAuthNTokens, e.WebAuthNTokenID)
+		case *user.HumanPasswordlessVerifiedEvent:
+			wm.webAuthNTokens[e.WebAuthNTokenID] = struct{}{}
+		case *user.HumanPasswordlessRemovedEvent:
+			delete(wm.webAuthNTokens, e.WebAuthNTokenID)
+		case *user.HumanRecoveryCodesAddedEvent:
+			wm.Codes = e.Codes
+		case *user.HumanRecoveryCodeCheckSucceededEvent:
+			if e.CodeIndex >= 0 && e.CodeIndex < len(wm.Codes) {
+				wm.Codes[e.CodeIndex] = nil
+			}
+		case *user.UserRemovedEvent:
+			wm.otpReady = false
+			wm.otpSMSAdded = false
+			wm.otpEmailAdded = false
+			wm.webAuthNTokens = make(map[string]struct{})
+			wm.Codes = nil
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *HumanRecoveryCodesWriteModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(user.HumanMFAOTPVerifiedType,
+			user.HumanMFAOTPRemovedType,
+			user.HumanOTPSMSAddedType,
+			user.HumanOTPSMSRemovedType,
+			user.HumanOTPEmailAddedType,
+			user.HumanOTPEmailRemovedType,
+			user.HumanU2FTokenVerifiedType,
+			user.HumanU2FTokenRemovedType,
+			user.HumanPasswordlessTokenVerifiedType,
+			user.HumanPasswordlessTokenRemovedType,
+			user.HumanRecoveryCodesAddedType,
+			user.HumanRecoveryCodeCheckSucceededType,
+			user.UserRemovedType,
+			user.UserV1MFAOTPVerifiedType,
+			user.UserV1MFAOTPRemovedType).
+		Builder()
+
+	if wm.ResourceOwner != "" {
+		query.ResourceOwner(wm.ResourceOwner)
+	}
+	return query
+}
+
+// HasMFA returns true if the user has at least one factor set up,
+// which can be recovered by the codes
+func (wm *HumanRecoveryCodesWriteModel) HasMFA() bool {
+	return wm.otpReady || wm.otpSMSAdded || wm.otpEmailAdded || len(wm.webAuthNTokens) > 0
+}
+
+func (wm *HumanRecoveryCodesWriteModel) RemainingCodes() int {
+	remaining := 0
+	for _, code := range wm.Codes {
+		if code != nil {
+			remaining++
+		}
+	}
+	return remaining
+}
@@ -0,0 +1,461 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dennigogo/zitadel/internal/crypto"
+	"github.com/dennigogo/zitadel/internal/domain"
+	caos_errs "github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/eventstore"
+	"github.com/dennigogo/zitadel/internal/eventstore/repository"
+	"github.com/dennigogo/zitadel/internal/repository/user"
+)
+
+func mockRecoveryCodeGenerator(t *testing.T) crypto.Generator {
+	ctrl := gomock.NewController(t)
+	generator := crypto.NewMockGenerator(ctrl)
+	generator.EXPECT().Length().Return(uint(1)).AnyTimes()
+	generator.EXPECT().Runes().Return([]rune("aa")).AnyTimes()
+	generator.EXPECT().Alg().Return(crypto.CreateMockHashAlg(ctrl)).AnyTimes()
+	return generator
+}
+
+func mockHashedRecoveryCodes(codes ...string) []*crypto.CryptoValue {
+	hashed := make([]*crypto.CryptoValue, len(codes))
+	for i, code := range codes {
+		hashed[i] = &crypto.CryptoValue{
+			CryptoType: crypto.TypeHash,
+			Algorithm:  "hash",
+			Crypted:    []byte(code),
+		}
+	}
+	return hashed
+}
+
+func TestCommandSide_AddHumanRecoveryCodes(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		userID        string
+		resourceOwner string
+	}
+	type res struct {
+		codes []string
+		want  *domain.ObjectDetails
+		err   func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "userid missing, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "no mfa, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanOTPVerifiedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"agent1"),
+						),
+						eventFromEventPusher(
+							user.NewHumanOTPRemovedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: caos_errs.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "codes remaining, already exists error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanOTPVerifiedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"agent1"),
+						),
+						eventFromEventPusher(
+							user.NewHumanRecoveryCodesAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								mockHashedRecoveryCodes("A", "B"),
+							),
+						),
+						eventFromEventPusher(
+							user.NewHumanRecoveryCodeCheckSucceededEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								0,
+								1,
+								nil,
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: caos_errs.IsErrorAlreadyExists,
+			},
+		},
+		{
+			name: "all codes used, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanOTPSMSAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate),
+						),
+						eventFromEventPusher(
+							user.NewHumanRecoveryCodesAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								mockHashedRecoveryCodes("A"),
+							),
+						),
+						eventFromEventPusher(
+							user.NewHumanRecoveryCodeCheckSucceededEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								0,
+								0,
+								nil,
+							),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								user.NewHumanRecoveryCodesAddedEvent(context.Background(),
+									&user.NewAggregate("user1", "org1").Aggregate,
+									mockHashedRecoveryCodes("A", "A", "A", "A", "A", "A", "A", "A", "A", "A"),
+								),
+							),
+						},
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				resourceOwner: "org1",
+			},
+			res: res{
+				codes: []string{"a", "a", "a", "a", "a", "a", "a", "a", "a", "a"},
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:            tt.fields.eventstore,
+				recoveryCodeGenerator: mockRecoveryCodeGenerator(t),
+			}
+			codes, got, err := r.AddHumanRecoveryCodes(tt.args.ctx, tt.args.userID, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.codes, codes)
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_HumanCheckRecoveryCode(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		userID        string
+		code          string
+		resourceOwner string
+		authRequest   *domain.AuthRequest
+	}
+	type res struct {
+		err func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "code missing, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "no codes, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanOTPVerifiedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"agent1"),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				code:          "A",
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: caos_errs.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "used code, check failed",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanRecoveryCodesAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								mockHashedRecoveryCodes("AAAA", "BBBB"),
+							),
+						),
+						eventFromEventPusher(
+							user.NewHumanRecoveryCodeCheckSucceededEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								0,
+								1,
+								nil,
+							),
+						),
+					),
+					expectFilter(),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								user.NewHumanRecoveryCodeCheckFailedEvent(context.Background(),
+									&user.NewAggregate("user1", "org1").Aggregate,
+									&user.AuthRequestInfo{
+										ID:          "request1",
+										UserAgentID: "agent1",
+									},
+								),
+							),
+						},
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				code:          "AAAA",
+				resourceOwner: "org1",
+				authRequest:   &domain.AuthRequest{ID: "request1", AgentID: "agent1"},
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "formatted code, check succeeded",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanRecoveryCodesAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								mockHashedRecoveryCodes("AAAA", "BBBBCCCC", "DDDD"),
+							),
+						),
+					),
+					expectFilter(),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								user.NewHumanRecoveryCodeCheckSucceededEvent(context.Background(),
+									&user.NewAggregate("user1", "org1").Aggregate,
+									1,
+									2,
+									&user.AuthRequestInfo{
+										ID:          "request1",
+										UserAgentID: "agent1",
+									},
+								),
+							),
+						},
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				code:          "bbbb-cccc",
+				resourceOwner: "org1",
+				authRequest:   &domain.AuthRequest{ID: "request1", AgentID: "agent1"},
+			},
+		},
+		{
+			name: "locked user, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanRecoveryCodesAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								mockHashedRecoveryCodes("AAAA"),
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							user.NewUserLockedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				code:          "AAAA",
+				resourceOwner: "org1",
+				authRequest:   &domain.AuthRequest{ID: "request1", AgentID: "agent1"},
+			},
+			res: res{
+				err: caos_errs.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "failed otp checks, max otp attempts exceeded, user locked",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanRecoveryCodesAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								mockHashedRecoveryCodes("AAAA"),
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanOTPCheckFailedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								nil,
+							),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								user.NewHumanRecoveryCodeCheckFailedEvent(context.Background(),
+									&user.NewAggregate("user1", "org1").Aggregate,
+									&user.AuthRequestInfo{
+										ID:          "request1",
+										UserAgentID: "agent1",
+									},
+								),
+							),
+							eventFromEventPusher(
+								user.NewUserLockedByLockoutEvent(context.Background(),
+									&user.NewAggregate("user1", "org1").Aggregate,
+								),
+							),
+						},
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				code:          "BBBB",
+				resourceOwner: "org1",
+				authRequest: &domain.AuthRequest{
+					ID:            "request1",
+					AgentID:       "agent1",
+					LockoutPolicy: &domain.LockoutPolicy{MaxOTPAttempts: 2},
+				},
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:            tt.fields.eventstore,
+				recoveryCodeGenerator: mockRecoveryCodeGenerator(t),
+			}
+			err := r.HumanCheckRecoveryCode(tt.args.ctx, tt.args.userID, tt.args.code, tt.args.resourceOwner, tt.args.authRequest)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/dennigogo/zitadel/internal/api/authz"
 	"github.com/dennigogo/zitadel/internal/domain"
 	caos_errs "github.com/dennigogo/zitadel/internal/errors"
 	"github.com/dennigogo/zitadel/internal/eventstore"
@@ -24,11 +25,12 @@ func (c *Commands) AddAccessAndRefreshToken(
 	refreshIdleExpiration,
 	refreshExpiration time.Duration,
 	authTime time.Time,
+	confirmation *authz.TokenConfirmation,
 ) (accessToken *domain.Token, newRefreshToken string, err error) {
 	if refreshToken == "" {
-		return c.AddNewRefreshTokenAndAccessToken(ctx, userID, orgID, agentID, clientID, audience, scopes, authMethodsReferences, refreshExpiration, accessLifetime, refreshIdleExpiration, authTime)
+		return c.AddNewRefreshTokenAndAccessToken(ctx, userID, orgID, agentID, clientID, audience, scopes, authMethodsReferences, refreshExpiration, accessLifetime, refreshIdleExpiration, authTime, confirmation)
 	}
-	return c.RenewRefreshTokenAndAccessToken(ctx, userID, orgID, refreshToken, agentID, clientID, audience, scopes, refreshIdleExpiration, accessLifetime)
+	return c.RenewRefreshTokenAndAccessToken(ctx, userID, orgID, refreshToken, agentID, clientID, audience, scopes, refreshIdleExpiration, accessLifetime, confirmation)
 }
 
 func (c *Commands) AddNewRefreshTokenAndAccessToken(
@@ -44,6 +46,7 @@ func (c *Commands) AddNewRefreshTokenAndAccessToken(
 	accessLifetime,
 	refreshIdleExpiration time.Duration,
 	authTime time.Time,
+	confirmation *authz.TokenConfirmation,
 ) (accessToken *domain.Token, newRefreshToken string, err error) {
 	if userID == "" || agentID == "" || clientID == "" {
 		return nil, "", caos_errs.ThrowInvalidArgument(nil, "COMMAND-adg4r", "Errors.IDMissing")
@@ -53,7 +56,7 @@ func (c *Commands) AddNewRefreshTokenAndAccessToken(
 	if err != nil {
 		return nil, "", err
 	}
-	accessTokenEvent, accessToken, err := c.addUserToken(ctx, userWriteModel, agentID, clientID, refreshTokenID, audience, scopes, accessLifetime)
+	accessTokenEvent, accessToken, err := c.addUserToken(ctx, userWriteModel, agentID, clientID, refreshTokenID, audience, scopes, accessLifetime, confirmation, nil)
 	if err != nil {
 		return nil, "", err
 	}
@@ -79,13 +82,14 @@ func (c *Commands) RenewRefreshTokenAndAccessToken(
 	scopes []string,
 	idleExpiration,
 	accessLifetime time.Duration,
+	confirmation *authz.TokenConfirmation,
 ) (accessToken *domain.Token, newRefreshToken string, err error) {
 	refreshTokenEvent, refreshTokenID, newRefreshToken, err := c.renewRefreshToken(ctx, userID, orgID, refreshToken, idleExpiration)
 	if err != nil {
 		return nil, "", err
 	}
 	userWriteModel := NewUserWriteModel(userID, orgID)
-	accessTokenEvent, accessToken, err := c.addUserToken(ctx, userWriteModel, agentID, clientID, refreshTokenID, audience, scopes, accessLifetime)
+	accessTokenEvent, accessToken, err := c.addUserToken(ctx, userWriteModel, agentID, clientID, refreshTokenID, audience, scopes, accessLifetime, confirmation, nil)
 	if err != nil {
 		return nil, "", err
 	}
@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/zitadel/oidc/v2/pkg/oidc"
 
+	"github.com/dennigogo/zitadel/internal/api/authz"
 	"github.com/dennigogo/zitadel/internal/crypto"
 	"github.com/dennigogo/zitadel/internal/domain"
 	caos_errs "github.com/dennigogo/zitadel/internal/errors"
@@ -41,6 +42,7 @@ func TestCommands_AddAccessAndRefreshToken(t *testing.T) {
 		authTime              time.Time
 		refreshIdleExpiration time.Duration
 		refreshExpiration     time.Duration
+		confirmation          *authz.TokenConfirmation
 	}
 	type res struct {
 		token        *domain.Token
@@ -247,7 +249,7 @@ func TestCommands_AddAccessAndRefreshToken(t *testing.T) {
 		//						[]string{"clientID1"},
 		//						[]string{oidc.ScopeOpenID, oidc.ScopeProfile, oidc.ScopeEmail, oidc.ScopeOfflineAccess},
 		//						time.Now().Add(5*time.Minute),
-		//					)),
+		//, "", ""					)),
 		//					eventFromEventPusher(user.NewHumanRefreshTokenRenewedEvent(
 		//						context.Background(),
 		//						&user.NewAggregate("userID", "orgID").Aggregate,
@@ -287,7 +289,7 @@ func TestCommands_AddAccessAndRefreshToken(t *testing.T) {
 				keyAlgorithm: tt.fields.keyAlgorithm,
 			}
 			got, gotRefresh, err := c.AddAccessAndRefreshToken(tt.args.ctx, tt.args.orgID, tt.args.agentID, tt.args.clientID, tt.args.userID, tt.args.refreshToken,
-				tt.args.audience, tt.args.scopes, tt.args.authMethodsReferences, tt.args.lifetime, tt.args.refreshIdleExpiration, tt.args.refreshExpiration, tt.args.authTime)
+				tt.args.audience, tt.args.scopes, tt.args.authMethodsReferences, tt.args.lifetime, tt.args.refreshIdleExpiration, tt.args.refreshExpiration, tt.args.authTime, tt.args.confirmation)
 			if tt.res.err == nil {
 				assert.NoError(t, err)
 			}
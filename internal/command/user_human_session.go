@@ -0,0 +1,43 @@
+package command
+
+import (
+	"context"
+
+	"github.com/dennigogo/zitadel/internal/domain"
+	caos_errs "github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/eventstore"
+	"github.com/dennigogo/zitadel/internal/repository/user"
+)
+
+// TerminateHumanSessions signs the user out on all user agents with an active session.
+// The relying parties which participated in the sessions are notified by the logout mechanisms of OIDC.
+func (c *Commands) TerminateHumanSessions(ctx context.Context, userID, resourceOwner string) (*domain.ObjectDetails, error) {
+	if userID == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Tq2sd", "Errors.User.UserIDMissing")
+	}
+	sessionsWriteModel := NewHumanSessionsWriteModel(userID, resourceOwner)
+	err := c.eventstore.FilterToQueryReducer(ctx, sessionsWriteModel)
+	if err != nil {
+		return nil, err
+	}
+	if !isUserStateExists(sessionsWriteModel.UserState) {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Tm3fd", "Errors.User.NotFound")
+	}
+	if len(sessionsWriteModel.UserAgentIDs) == 0 {
+		return writeModelToObjectDetails(&sessionsWriteModel.WriteModel), nil
+	}
+	userAgg := UserAggregateFromWriteModel(&sessionsWriteModel.WriteModel)
+	events := make([]eventstore.Command, len(sessionsWriteModel.UserAgentIDs))
+	for i, userAgentID := range sessionsWriteModel.UserAgentIDs {
+		events[i] = user.NewHumanSignedOutEvent(ctx, userAgg, userAgentID)
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, events...)
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(sessionsWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&sessionsWriteModel.WriteModel), nil
+}
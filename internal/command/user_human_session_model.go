@@ -0,0 +1,99 @@
+package command
+
+import (
+	"github.com/dennigogo/zitadel/internal/domain"
+	"github.com/dennigogo/zitadel/internal/eventstore"
+	"github.com/dennigogo/zitadel/internal/repository/user"
+)
+
+type HumanSessionsWriteModel struct {
+	eventstore.WriteModel
+
+	// UserAgentIDs contains the user agents with an active session in the order of the sign in
+	UserAgentIDs []string
+	UserState    domain.UserState
+}
+
+func NewHumanSessionsWriteModel(userID, resourceOwner string) *HumanSessionsWriteModel {
+	return &HumanSessionsWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   userID,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (wm *HumanSessionsWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *user.HumanAddedEvent, *user.HumanRegisteredEvent:
+			wm.UserState = domain.UserStateActive
+		case *user.HumanPasswordCheckSucceededEvent:
+			if e.AuthRequestInfo != nil {
+				wm.addUserAgentID(e.UserAgentID)
+			}
+		case *user.HumanPasswordlessCheckSucceededEvent:
+			if e.AuthRequestInfo != nil {
+				wm.addUserAgentID(e.UserAgentID)
+			}
+		case *user.UserIDPCheckSucceededEvent:
+			if e.AuthRequestInfo != nil {
+				wm.addUserAgentID(e.UserAgentID)
+			}
+		case *user.UserTokenAddedEvent:
+			wm.addUserAgentID(e.UserAgentID)
+		case *user.HumanSignedOutEvent:
+			wm.removeUserAgentID(e.UserAgentID)
+		case *user.UserRemovedEvent:
+			wm.UserState = domain.UserStateDeleted
+			wm.UserAgentIDs = nil
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *HumanSessionsWriteModel) addUserAgentID(userAgentID string) {
+	if userAgentID == "" {
+		return
+	}
+	for _, id := range wm.UserAgentIDs {
+		if id == userAgentID {
+			return
+		}
+	}
+	wm.UserAgentIDs = append(wm.UserAgentIDs, userAgentID)
+}
+
+func (wm *HumanSessionsWriteModel) removeUserAgentID(userAgentID string) {
+	for i, id := range wm.UserAgentIDs {
+		if id == userAgentID {
+			wm.UserAgentIDs = append(wm.UserAgentIDs[:i], wm.UserAgentIDs[i+1:]...)
+			return
+		}
+	}
+}
+
+func (wm *HumanSessionsWriteModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(user.HumanAddedType,
+			user.HumanRegisteredType,
+			user.UserV1AddedType,
+			user.UserV1RegisteredType,
+			user.HumanPasswordCheckSucceededType,
+			user.UserV1PasswordCheckSucceededType,
+			user.HumanPasswordlessTokenCheckSucceededType,
+			user.UserIDPLoginCheckSucceededType,
+			user.UserTokenAddedType,
+			user.HumanSignedOutType,
+			user.UserV1SignedOutType,
+			user.UserRemovedType).
+		Builder()
+
+	if wm.ResourceOwner != "" {
+		query.ResourceOwner(wm.ResourceOwner)
+	}
+	return query
+}
@@ -0,0 +1,214 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dennigogo/zitadel/internal/domain"
+	caos_errs "github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/eventstore"
+	"github.com/dennigogo/zitadel/internal/eventstore/repository"
+	"github.com/dennigogo/zitadel/internal/repository/user"
+)
+
+func TestCommandSide_TerminateHumanSessions(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type (
+		args struct {
+			ctx    context.Context
+			orgID  string
+			userID string
+		}
+	)
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "userid missing, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+				),
+			},
+			args: args{
+				ctx:    context.Background(),
+				orgID:  "org1",
+				userID: "",
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "user not existing, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:    context.Background(),
+				orgID:  "org1",
+				userID: "user1",
+			},
+			res: res{
+				err: caos_errs.IsNotFound,
+			},
+		},
+		{
+			name: "no active sessions, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							newAddHumanEvent("", false, ""),
+						),
+						eventFromEventPusher(
+							user.NewUserTokenAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"tokenID",
+								"clientID",
+								"agentID",
+								"de",
+								"",
+								[]string{"clientID"},
+								[]string{"openid"},
+								time.Now(),
+								"",
+								"",
+								nil,
+							),
+						),
+						eventFromEventPusher(
+							user.NewHumanSignedOutEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"agentID",
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:    context.Background(),
+				orgID:  "org1",
+				userID: "user1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			name: "terminate sessions, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							newAddHumanEvent("", false, ""),
+						),
+						eventFromEventPusher(
+							user.NewHumanPasswordCheckSucceededEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								&user.AuthRequestInfo{
+									ID:          "authRequestID",
+									UserAgentID: "agentID1",
+								},
+							),
+						),
+						eventFromEventPusher(
+							user.NewUserTokenAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"tokenID",
+								"clientID",
+								"agentID1",
+								"de",
+								"",
+								[]string{"clientID"},
+								[]string{"openid"},
+								time.Now(),
+								"",
+								"",
+								nil,
+							),
+						),
+						eventFromEventPusher(
+							user.NewUserTokenAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"tokenID2",
+								"clientID",
+								"agentID2",
+								"de",
+								"",
+								[]string{"clientID"},
+								[]string{"openid"},
+								time.Now(),
+								"",
+								"",
+								nil,
+							),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								user.NewHumanSignedOutEvent(context.Background(),
+									&user.NewAggregate("user1", "org1").Aggregate,
+									"agentID1",
+								),
+							),
+							eventFromEventPusher(
+								user.NewHumanSignedOutEvent(context.Background(),
+									&user.NewAggregate("user1", "org1").Aggregate,
+									"agentID2",
+								),
+							),
+						},
+					),
+				),
+			},
+			args: args{
+				ctx:    context.Background(),
+				orgID:  "org1",
+				userID: "user1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := r.TerminateHumanSessions(tt.args.ctx, tt.args.userID, tt.args.orgID)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
@@ -273,6 +273,7 @@ func TestCommandSide_AddHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								domain.BreachedPasswordCheckDisabled,
 							),
 						),
 					),
@@ -364,6 +365,7 @@ func TestCommandSide_AddHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								domain.BreachedPasswordCheckDisabled,
 							),
 						),
 					),
@@ -434,6 +436,7 @@ func TestCommandSide_AddHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								domain.BreachedPasswordCheckDisabled,
 							),
 						),
 					),
@@ -504,6 +507,7 @@ func TestCommandSide_AddHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								domain.BreachedPasswordCheckDisabled,
 							),
 						),
 					),
@@ -697,6 +701,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 		eventstore      *eventstore.Eventstore
 		idGenerator     id.Generator
 		userPasswordAlg crypto.HashAlgorithm
+		quotas          QuotaEnforcer
 	}
 	type args struct {
 		ctx                  context.Context
@@ -830,6 +835,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								domain.BreachedPasswordCheckDisabled,
 							),
 						),
 					),
@@ -849,6 +855,55 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 				err: errors.IsErrorInvalidArgument,
 			},
 		},
+		{
+			name: "users quota exhausted, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							org.NewDomainPolicyAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								true,
+								true,
+								true,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewPasswordComplexityPolicyAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								1,
+								false,
+								false,
+								false,
+								false,
+								domain.BreachedPasswordCheckDisabled,
+							),
+						),
+					),
+				),
+				quotas: testQuotas{domain.QuotaUnitUsers: true},
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				human: &domain.Human{
+					Username: "username",
+					Profile: &domain.Profile{
+						FirstName: "firstname",
+						LastName:  "lastname",
+					},
+					Email: &domain.Email{
+						EmailAddress: "email@test.ch",
+					},
+				},
+			},
+			res: res{
+				err: errors.IsPreconditionFailed,
+			},
+		},
 		{
 			name: "add human (with password and initial code), ok",
 			fields: fields{
@@ -873,6 +928,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								domain.BreachedPasswordCheckDisabled,
 							),
 						),
 					),
@@ -964,6 +1020,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								domain.BreachedPasswordCheckDisabled,
 							),
 						),
 					),
@@ -1049,6 +1106,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								domain.BreachedPasswordCheckDisabled,
 							),
 						),
 					),
@@ -1156,6 +1214,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								domain.BreachedPasswordCheckDisabled,
 							),
 						),
 					),
@@ -1267,6 +1326,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								domain.BreachedPasswordCheckDisabled,
 							),
 						),
 					),
@@ -1374,6 +1434,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								domain.BreachedPasswordCheckDisabled,
 							),
 						),
 					),
@@ -1452,6 +1513,151 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "add human hashed password not supported, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							org.NewDomainPolicyAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								true,
+								true,
+								true,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewPasswordComplexityPolicyAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								1,
+								false,
+								false,
+								false,
+								false,
+								domain.BreachedPasswordCheckDisabled,
+							),
+						),
+					),
+				),
+				idGenerator:     id_mock.NewIDGeneratorExpectIDs(t, "user1"),
+				userPasswordAlg: crypto.NewPasswordHasher(crypto.NewBCrypt(4), crypto.NewScrypt(0, 0, 0)),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				human: &domain.Human{
+					Username:       "username",
+					HashedPassword: domain.NewHashedPassword("plain", ""),
+					Profile: &domain.Profile{
+						FirstName:         "firstname",
+						LastName:          "lastname",
+						PreferredLanguage: language.English,
+					},
+					Email: &domain.Email{
+						EmailAddress:    "email@test.ch",
+						IsEmailVerified: true,
+					},
+				},
+				secretGenerator: GetMockSecretGenerator(t),
+			},
+			res: res{
+				err: errors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "add human with hashed password of other algorithm, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							org.NewDomainPolicyAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								true,
+								true,
+								true,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewPasswordComplexityPolicyAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								1,
+								false,
+								false,
+								false,
+								false,
+								domain.BreachedPasswordCheckDisabled,
+							),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								func() *user.HumanAddedEvent {
+									event := newAddHumanEvent("", false, "")
+									event.AddPasswordData(&crypto.CryptoValue{
+										CryptoType: crypto.TypeHash,
+										Algorithm:  "scrypt",
+										Crypted:    []byte("$scrypt$ln=4,r=8,p=1$c2FsdHNhbHRzYWx0c2FsdA$5f/Vi+XRWGUNGScbsma6KJ4zLFIke/NJsrvr7lQLAyA"),
+									}, false)
+									return event
+								}(),
+							),
+							eventFromEventPusher(
+								user.NewHumanEmailVerifiedEvent(context.Background(),
+									&user.NewAggregate("user1", "org1").Aggregate),
+							),
+						},
+						uniqueConstraintsFromEventConstraint(user.NewAddUsernameUniqueConstraint("username", "org1", true)),
+					),
+				),
+				idGenerator:     id_mock.NewIDGeneratorExpectIDs(t, "user1"),
+				userPasswordAlg: crypto.NewPasswordHasher(crypto.NewBCrypt(4), crypto.NewScrypt(0, 0, 0)),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				human: &domain.Human{
+					Username:       "username",
+					HashedPassword: domain.NewHashedPassword("$scrypt$ln=4,r=8,p=1$c2FsdHNhbHRzYWx0c2FsdA$5f/Vi+XRWGUNGScbsma6KJ4zLFIke/NJsrvr7lQLAyA", ""),
+					Profile: &domain.Profile{
+						FirstName:         "firstname",
+						LastName:          "lastname",
+						PreferredLanguage: language.English,
+					},
+					Email: &domain.Email{
+						EmailAddress:    "email@test.ch",
+						IsEmailVerified: true,
+					},
+				},
+				secretGenerator: GetMockSecretGenerator(t),
+			},
+			res: res{
+				wantHuman: &domain.Human{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "user1",
+						ResourceOwner: "org1",
+					},
+					Username: "username",
+					Profile: &domain.Profile{
+						FirstName:         "firstname",
+						LastName:          "lastname",
+						DisplayName:       "firstname lastname",
+						PreferredLanguage: language.English,
+					},
+					Email: &domain.Email{
+						EmailAddress:    "email@test.ch",
+						IsEmailVerified: true,
+					},
+					State: domain.UserStateActive,
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1459,6 +1665,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 				eventstore:      tt.fields.eventstore,
 				idGenerator:     tt.fields.idGenerator,
 				userPasswordAlg: tt.fields.userPasswordAlg,
+				quotas:          tt.fields.quotas,
 			}
 			gotHuman, gotCode, err := r.ImportHuman(tt.args.ctx, tt.args.orgID, tt.args.human, tt.args.passwordless, tt.args.secretGenerator, tt.args.secretGenerator, tt.args.secretGenerator, tt.args.secretGenerator)
 			if tt.res.err == nil {
@@ -1618,6 +1825,7 @@ func TestCommandSide_RegisterHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								domain.BreachedPasswordCheckDisabled,
 							),
 						),
 					),
@@ -1666,6 +1874,7 @@ func TestCommandSide_RegisterHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								domain.BreachedPasswordCheckDisabled,
 							),
 						),
 					),
@@ -1689,6 +1898,7 @@ func TestCommandSide_RegisterHuman(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
 							),
 						),
 					),
@@ -1735,6 +1945,7 @@ func TestCommandSide_RegisterHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								domain.BreachedPasswordCheckDisabled,
 							),
 						),
 					),
@@ -1758,6 +1969,7 @@ func TestCommandSide_RegisterHuman(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
 							),
 						),
 					),
@@ -1804,6 +2016,7 @@ func TestCommandSide_RegisterHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								domain.BreachedPasswordCheckDisabled,
 							),
 						),
 					),
@@ -1827,6 +2040,7 @@ func TestCommandSide_RegisterHuman(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
 							),
 						),
 					),
@@ -1890,6 +2104,7 @@ func TestCommandSide_RegisterHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								domain.BreachedPasswordCheckDisabled,
 							),
 						),
 					),
@@ -1913,6 +2128,7 @@ func TestCommandSide_RegisterHuman(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
 							),
 						),
 					),
@@ -2034,6 +2250,7 @@ func TestCommandSide_RegisterHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								domain.BreachedPasswordCheckDisabled,
 							),
 						),
 					),
@@ -2057,6 +2274,7 @@ func TestCommandSide_RegisterHuman(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
 							),
 						),
 					),
@@ -2146,6 +2364,7 @@ func TestCommandSide_RegisterHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								domain.BreachedPasswordCheckDisabled,
 							),
 						),
 					),
@@ -2169,6 +2388,7 @@ func TestCommandSide_RegisterHuman(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
 							),
 						),
 					),
@@ -2252,6 +2472,7 @@ func TestCommandSide_RegisterHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								domain.BreachedPasswordCheckDisabled,
 							),
 						),
 					),
@@ -2275,6 +2496,7 @@ func TestCommandSide_RegisterHuman(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
 							),
 						),
 					),
@@ -2380,6 +2602,7 @@ func TestCommandSide_RegisterHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								domain.BreachedPasswordCheckDisabled,
 							),
 						),
 					),
@@ -2403,6 +2626,7 @@ func TestCommandSide_RegisterHuman(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
 							),
 						),
 					),
@@ -2873,6 +3097,7 @@ func TestAddHumanCommand(t *testing.T) {
 		passwordAlg crypto.HashAlgorithm
 		filter      preparation.FilterToQueryReducer
 		codeAlg     crypto.EncryptionAlgorithm
+		quotas      QuotaEnforcer
 	}
 	agg := user.NewAggregate("id", "ro")
 	tests := []struct {
@@ -2960,6 +3185,7 @@ func TestAddHumanCommand(t *testing.T) {
 									true,
 									true,
 									true,
+									domain.BreachedPasswordCheckDisabled,
 								),
 							}, nil
 						}).
@@ -2969,6 +3195,23 @@ func TestAddHumanCommand(t *testing.T) {
 				CreateErr: errors.ThrowInvalidArgument(nil, "COMMA-HuJf6", "Errors.User.PasswordComplexityPolicy.MinLength"),
 			},
 		},
+		{
+			name: "users quota exhausted",
+			args: args{
+				a: agg,
+				human: &AddHuman{
+					Email:             Email{Address: "support@zitadel.com", Verified: true},
+					PreferredLanguage: language.English,
+					FirstName:         "gigi",
+					LastName:          "giraffe",
+					Username:          "username",
+				},
+				quotas: testQuotas{domain.QuotaUnitUsers: true},
+			},
+			want: Want{
+				CreateErr: errors.ThrowPreconditionFailed(nil, "COMMAND-Qu0ta", "Errors.Quota.Exhausted"),
+			},
+		},
 		{
 			name: "correct",
 			args: args{
@@ -3006,6 +3249,7 @@ func TestAddHumanCommand(t *testing.T) {
 									false,
 									false,
 									false,
+									domain.BreachedPasswordCheckDisabled,
 								),
 							}, nil
 						}).
@@ -3042,7 +3286,7 @@ func TestAddHumanCommand(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			AssertValidation(t, context.Background(), AddHumanCommand(tt.args.a, tt.args.human, tt.args.passwordAlg, tt.args.codeAlg), tt.args.filter, tt.want)
+			AssertValidation(t, context.Background(), AddHumanCommand(tt.args.a, tt.args.human, tt.args.passwordAlg, tt.args.codeAlg, nil, tt.args.quotas), tt.args.filter, tt.want)
 		})
 	}
 }
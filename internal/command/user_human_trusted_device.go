@@ -0,0 +1,82 @@
+package command
+
+import (
+	"context"
+
+	"github.com/dennigogo/zitadel/internal/domain"
+	caos_errs "github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/repository/user"
+	"github.com/dennigogo/zitadel/internal/telemetry/tracing"
+)
+
+// HumanTrustMFADevice marks the user agent as trusted,
+// mfa is skipped on it as long as the trusted device lifetime of the login policy allows.
+// The user agent can only be trusted if a second factor was checked successfully on it in the auth request
+func (c *Commands) HumanTrustMFADevice(ctx context.Context, userID, resourceOwner, userAgentID, authRequestID, description string) (err error) {
+	if userID == "" {
+		return caos_errs.ThrowInvalidArgument(nil, "COMMAND-Td2nf", "Errors.User.UserIDMissing")
+	}
+	if userAgentID == "" {
+		return caos_errs.ThrowInvalidArgument(nil, "COMMAND-Td5sa", "Errors.User.MFA.TrustedDevice.UserAgentIDMissing")
+	}
+	if authRequestID == "" {
+		return caos_errs.ThrowInvalidArgument(nil, "COMMAND-Td6ar", "Errors.AuthRequest.NotFound")
+	}
+	existingHuman, err := c.getHumanWriteModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return err
+	}
+	if !isUserStateExists(existingHuman.UserState) {
+		return caos_errs.ThrowNotFound(nil, "COMMAND-Td8wq", "Errors.User.NotFound")
+	}
+	writeModel := NewHumanTrustedDeviceAuthRequestWriteModel(userID, userAgentID, authRequestID, resourceOwner)
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return err
+	}
+	if !writeModel.MFAChecked {
+		return caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Td3mc", "Errors.User.MFA.TrustedDevice.MFANotChecked")
+	}
+	_, err = c.eventstore.Push(ctx,
+		user.NewHumanMFADeviceTrustedEvent(ctx, UserAggregateFromWriteModel(&existingHuman.WriteModel), userAgentID, description))
+	return err
+}
+
+// HumanRevokeMFADevice removes the trust of the user agent,
+// the next login on it has to be verified by mfa again
+func (c *Commands) HumanRevokeMFADevice(ctx context.Context, userID, resourceOwner, userAgentID string) (*domain.ObjectDetails, error) {
+	if userID == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Td4kw", "Errors.User.UserIDMissing")
+	}
+	if userAgentID == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Td7vb", "Errors.User.MFA.TrustedDevice.UserAgentIDMissing")
+	}
+	writeModel, err := c.trustedDeviceWriteModelByID(ctx, userID, userAgentID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.Trusted {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Td1mx", "Errors.User.MFA.TrustedDevice.NotExisting")
+	}
+	pushedEvents, err := c.eventstore.Push(ctx,
+		user.NewHumanMFADeviceRevokedEvent(ctx, UserAggregateFromWriteModel(&writeModel.WriteModel), userAgentID))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(writeModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+func (c *Commands) trustedDeviceWriteModelByID(ctx context.Context, userID, userAgentID, resourceOwner string) (writeModel *HumanTrustedDeviceWriteModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel = NewHumanTrustedDeviceWriteModel(userID, userAgentID, resourceOwner)
+	err = c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
@@ -0,0 +1,120 @@
+package command
+
+import (
+	"github.com/dennigogo/zitadel/internal/eventstore"
+	"github.com/dennigogo/zitadel/internal/repository/user"
+)
+
+// HumanTrustedDeviceWriteModel contains the trust of a single user agent of the user.
+// If the auth request is set, it also contains if a second factor was checked successfully
+// on the user agent during the auth request
+type HumanTrustedDeviceWriteModel struct {
+	eventstore.WriteModel
+
+	UserAgentID   string
+	AuthRequestID string
+	Trusted       bool
+	MFAChecked    bool
+}
+
+func NewHumanTrustedDeviceWriteModel(userID, userAgentID, resourceOwner string) *HumanTrustedDeviceWriteModel {
+	return &HumanTrustedDeviceWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   userID,
+			ResourceOwner: resourceOwner,
+		},
+		UserAgentID: userAgentID,
+	}
+}
+
+func NewHumanTrustedDeviceAuthRequestWriteModel(userID, userAgentID, authRequestID, resourceOwner string) *HumanTrustedDeviceWriteModel {
+	writeModel := NewHumanTrustedDeviceWriteModel(userID, userAgentID, resourceOwner)
+	writeModel.AuthRequestID = authRequestID
+	return writeModel
+}
+
+func (wm *HumanTrustedDeviceWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *user.HumanMFADeviceTrustedEvent:
+			if e.UserAgentID == wm.UserAgentID {
+				wm.WriteModel.AppendEvents(e)
+			}
+		case *user.HumanMFADeviceRevokedEvent:
+			if e.UserAgentID == wm.UserAgentID {
+				wm.WriteModel.AppendEvents(e)
+			}
+		case *user.HumanOTPCheckSucceededEvent:
+			wm.appendMFACheck(e, e.AuthRequestInfo)
+		case *user.HumanOTPSMSCheckSucceededEvent:
+			wm.appendMFACheck(e, e.AuthRequestInfo)
+		case *user.HumanOTPEmailCheckSucceededEvent:
+			wm.appendMFACheck(e, e.AuthRequestInfo)
+		case *user.HumanU2FCheckSucceededEvent:
+			wm.appendMFACheck(e, e.AuthRequestInfo)
+		case *user.HumanRecoveryCodeCheckSucceededEvent:
+			wm.appendMFACheck(e, e.AuthRequestInfo)
+		case *user.UserRemovedEvent:
+			wm.WriteModel.AppendEvents(e)
+		}
+	}
+}
+
+// appendMFACheck only appends checks of the auth request on the user agent
+func (wm *HumanTrustedDeviceWriteModel) appendMFACheck(event eventstore.Event, info *user.AuthRequestInfo) {
+	if wm.AuthRequestID == "" || info == nil {
+		return
+	}
+	if info.ID == wm.AuthRequestID && info.UserAgentID == wm.UserAgentID {
+		wm.WriteModel.AppendEvents(event)
+	}
+}
+
+func (wm *HumanTrustedDeviceWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch event.(type) {
+		case *user.HumanMFADeviceTrustedEvent:
+			wm.Trusted = true
+		case *user.HumanMFADeviceRevokedEvent:
+			wm.Trusted = false
+		case *user.HumanOTPCheckSucceededEvent,
+			*user.HumanOTPSMSCheckSucceededEvent,
+			*user.HumanOTPEmailCheckSucceededEvent,
+			*user.HumanU2FCheckSucceededEvent,
+			*user.HumanRecoveryCodeCheckSucceededEvent:
+			wm.MFAChecked = true
+		case *user.UserRemovedEvent:
+			wm.Trusted = false
+			wm.MFAChecked = false
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *HumanTrustedDeviceWriteModel) Query() *eventstore.SearchQueryBuilder {
+	eventTypes := []eventstore.EventType{
+		user.HumanMFADeviceTrustedType,
+		user.HumanMFADeviceRevokedType,
+		user.UserRemovedType,
+	}
+	if wm.AuthRequestID != "" {
+		eventTypes = append(eventTypes,
+			user.HumanMFAOTPCheckSucceededType,
+			user.HumanOTPSMSCheckSucceededType,
+			user.HumanOTPEmailCheckSucceededType,
+			user.HumanU2FTokenCheckSucceededType,
+			user.HumanRecoveryCodeCheckSucceededType,
+		)
+	}
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(eventTypes...).
+		Builder()
+
+	if wm.ResourceOwner != "" {
+		query.ResourceOwner(wm.ResourceOwner)
+	}
+	return query
+}
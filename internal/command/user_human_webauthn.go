@@ -366,6 +366,14 @@ func (c *Commands) HumanFinishU2FLogin(ctx context.Context, userID, resourceOwne
 	if err != nil {
 		return err
 	}
+	lockout, err := c.lockoutWriteModel(ctx, userID, resourceOwner)
+	if err != nil {
+		return err
+	}
+	events, err := checkLockout(ctx, UserAggregateFromWriteModel(&lockout.WriteModel), lockout, &lockout.U2FChecks, authRequest.LockoutPolicy)
+	if err != nil {
+		return err
+	}
 
 	userAgg, token, signCount, err := c.finishWebAuthNLogin(ctx, userID, resourceOwner, credentialData, webAuthNLogin, u2fTokens)
 	if err != nil {
@@ -373,18 +381,24 @@ func (c *Commands) HumanFinishU2FLogin(ctx context.Context, userID, resourceOwne
 			logging.WithFields("userID", userID, "resourceOwner", resourceOwner).WithError(err).Warn("missing userAggregate for pushing failed u2f check event")
 			return err
 		}
-		_, pushErr := c.eventstore.Push(ctx,
+		events = append(events,
 			usr_repo.NewHumanU2FCheckFailedEvent(
 				ctx,
 				userAgg,
 				authRequestDomainToAuthRequestInfo(authRequest),
 			),
 		)
+		if authRequest.LockoutPolicy != nil {
+			if locked := lockOnFailedCheck(ctx, userAgg, authRequest.LockoutPolicy.MaxU2FAttempts, lockout.U2FChecks); locked != nil {
+				events = append(events, locked)
+			}
+		}
+		_, pushErr := c.eventstore.Push(ctx, events...)
 		logging.WithFields("userID", userID, "resourceOwner", resourceOwner).OnError(pushErr).Warn("could not push failed u2f check event")
 		return err
 	}
 
-	_, err = c.eventstore.Push(ctx,
+	_, err = c.eventstore.Push(ctx, append(events,
 		usr_repo.NewHumanU2FCheckSucceededEvent(
 			ctx,
 			userAgg,
@@ -396,7 +410,7 @@ func (c *Commands) HumanFinishU2FLogin(ctx context.Context, userID, resourceOwne
 			token.WebAuthNTokenID,
 			signCount,
 		),
-	)
+	)...)
 
 	return err
 }
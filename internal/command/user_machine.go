@@ -42,6 +42,9 @@ func (c *Commands) AddMachineWithID(ctx context.Context, orgID string, userID st
 }
 
 func (c *Commands) addMachineWithID(ctx context.Context, orgID string, userID string, machine *domain.Machine, domainPolicy *domain.DomainPolicy) (*domain.Machine, error) {
+	if err := checkQuota(ctx, c.quotas, domain.QuotaUnitUsers); err != nil {
+		return nil, err
+	}
 
 	machine.AggregateID = userID
 	addedMachine := NewMachineWriteModel(machine.AggregateID, orgID)
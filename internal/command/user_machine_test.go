@@ -21,6 +21,7 @@ func TestCommandSide_AddMachine(t *testing.T) {
 	type fields struct {
 		eventstore  *eventstore.Eventstore
 		idGenerator id.Generator
+		quotas      QuotaEnforcer
 	}
 	type args struct {
 		ctx     context.Context
@@ -76,6 +77,37 @@ func TestCommandSide_AddMachine(t *testing.T) {
 				err: caos_errs.IsPreconditionFailed,
 			},
 		},
+		{
+			name: "users quota exhausted, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							org.NewDomainPolicyAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								true,
+								true,
+								true,
+							),
+						),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "user1"),
+				quotas:      testQuotas{domain.QuotaUnitUsers: true},
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				machine: &domain.Machine{
+					Username: "username",
+					Name:     "name",
+				},
+			},
+			res: res{
+				err: caos_errs.IsPreconditionFailed,
+			},
+		},
 		{
 			name: "add machine, ok",
 			fields: fields{
@@ -136,6 +168,7 @@ func TestCommandSide_AddMachine(t *testing.T) {
 			r := &Commands{
 				eventstore:  tt.fields.eventstore,
 				idGenerator: tt.fields.idGenerator,
+				quotas:      tt.fields.quotas,
 			}
 			got, err := r.AddMachine(tt.args.ctx, tt.args.orgID, tt.args.machine)
 			if tt.res.err == nil {
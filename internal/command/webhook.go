@@ -0,0 +1,221 @@
+package command
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/dennigogo/zitadel/internal/actions"
+	"github.com/dennigogo/zitadel/internal/crypto"
+	"github.com/dennigogo/zitadel/internal/domain"
+	caos_errs "github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/repository/webhook"
+)
+
+func (c *Commands) AddWebhook(ctx context.Context, addWebhook *domain.Webhook, resourceOwner string) (_, _ string, _ *domain.ObjectDetails, err error) {
+	if err := validateWebhook(addWebhook); err != nil {
+		return "", "", nil, err
+	}
+	webhookID, err := c.idGenerator.Next()
+	if err != nil {
+		return "", "", nil, err
+	}
+	secret, plainSecret, err := crypto.NewCode(c.webhookSecretGenerator)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	webhookModel := NewWebhookWriteModel(webhookID, resourceOwner)
+	webhookAgg := WebhookAggregateFromWriteModel(&webhookModel.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, webhook.NewAddedEvent(
+		ctx,
+		webhookAgg,
+		addWebhook.Name,
+		addWebhook.URL,
+		addWebhook.EventTypes,
+		secret,
+	))
+	if err != nil {
+		return "", "", nil, err
+	}
+	err = AppendAndReduce(webhookModel, pushedEvents...)
+	if err != nil {
+		return "", "", nil, err
+	}
+	return webhookModel.AggregateID, plainSecret, writeModelToObjectDetails(&webhookModel.WriteModel), nil
+}
+
+func (c *Commands) ChangeWebhook(ctx context.Context, webhookChange *domain.Webhook, resourceOwner string) (*domain.ObjectDetails, error) {
+	if webhookChange.AggregateID == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Wh3k8", "Errors.IDMissing")
+	}
+	if err := validateWebhook(webhookChange); err != nil {
+		return nil, err
+	}
+
+	existingWebhook, err := c.getWebhookWriteModelByID(ctx, webhookChange.AggregateID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !existingWebhook.State.Exists() {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Wh9d2", "Errors.Webhook.NotFound")
+	}
+
+	webhookAgg := WebhookAggregateFromWriteModel(&existingWebhook.WriteModel)
+	changedEvent, err := existingWebhook.NewChangedEvent(
+		ctx,
+		webhookAgg,
+		webhookChange.Name,
+		webhookChange.URL,
+		webhookChange.EventTypes,
+	)
+	if err != nil {
+		return nil, err
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, changedEvent)
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingWebhook, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingWebhook.WriteModel), nil
+}
+
+func (c *Commands) RegenerateWebhookSecret(ctx context.Context, webhookID, resourceOwner string) (_ string, _ *domain.ObjectDetails, err error) {
+	if webhookID == "" || resourceOwner == "" {
+		return "", nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Wf2m5", "Errors.IDMissing")
+	}
+
+	existingWebhook, err := c.getWebhookWriteModelByID(ctx, webhookID, resourceOwner)
+	if err != nil {
+		return "", nil, err
+	}
+	if !existingWebhook.State.Exists() {
+		return "", nil, caos_errs.ThrowNotFound(nil, "COMMAND-Wq7s4", "Errors.Webhook.NotFound")
+	}
+	secret, plainSecret, err := crypto.NewCode(c.webhookSecretGenerator)
+	if err != nil {
+		return "", nil, err
+	}
+
+	webhookAgg := WebhookAggregateFromWriteModel(&existingWebhook.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, webhook.NewSecretChangedEvent(ctx, webhookAgg, secret))
+	if err != nil {
+		return "", nil, err
+	}
+	err = AppendAndReduce(existingWebhook, pushedEvents...)
+	if err != nil {
+		return "", nil, err
+	}
+	return plainSecret, writeModelToObjectDetails(&existingWebhook.WriteModel), nil
+}
+
+func (c *Commands) DeactivateWebhook(ctx context.Context, webhookID, resourceOwner string) (*domain.ObjectDetails, error) {
+	if webhookID == "" || resourceOwner == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Wk4n1", "Errors.IDMissing")
+	}
+
+	existingWebhook, err := c.getWebhookWriteModelByID(ctx, webhookID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !existingWebhook.State.Exists() {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Wz6c3", "Errors.Webhook.NotFound")
+	}
+	if existingWebhook.State != domain.WebhookStateActive {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Wd1x8", "Errors.Webhook.NotActive")
+	}
+
+	webhookAgg := WebhookAggregateFromWriteModel(&existingWebhook.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, webhook.NewDeactivatedEvent(ctx, webhookAgg))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingWebhook, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingWebhook.WriteModel), nil
+}
+
+func (c *Commands) ReactivateWebhook(ctx context.Context, webhookID, resourceOwner string) (*domain.ObjectDetails, error) {
+	if webhookID == "" || resourceOwner == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Wr5j2", "Errors.IDMissing")
+	}
+
+	existingWebhook, err := c.getWebhookWriteModelByID(ctx, webhookID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !existingWebhook.State.Exists() {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Wv8b6", "Errors.Webhook.NotFound")
+	}
+	if existingWebhook.State != domain.WebhookStateInactive {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Wp3g9", "Errors.Webhook.NotInactive")
+	}
+
+	webhookAgg := WebhookAggregateFromWriteModel(&existingWebhook.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, webhook.NewReactivatedEvent(ctx, webhookAgg))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingWebhook, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingWebhook.WriteModel), nil
+}
+
+func (c *Commands) RemoveWebhook(ctx context.Context, webhookID, resourceOwner string) (*domain.ObjectDetails, error) {
+	if webhookID == "" || resourceOwner == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Wy2h7", "Errors.IDMissing")
+	}
+
+	existingWebhook, err := c.getWebhookWriteModelByID(ctx, webhookID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !existingWebhook.State.Exists() {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Wt4l5", "Errors.Webhook.NotFound")
+	}
+
+	webhookAgg := WebhookAggregateFromWriteModel(&existingWebhook.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, webhook.NewRemovedEvent(ctx, webhookAgg, existingWebhook.Name))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingWebhook, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingWebhook.WriteModel), nil
+}
+
+func validateWebhook(w *domain.Webhook) error {
+	if !w.IsValid() {
+		return caos_errs.ThrowInvalidArgument(nil, "COMMAND-Wb5n2", "Errors.Webhook.Invalid")
+	}
+	target, err := url.Parse(w.URL)
+	if err != nil || (target.Scheme != "http" && target.Scheme != "https") || target.Host == "" {
+		return caos_errs.ThrowInvalidArgument(err, "COMMAND-Wc8m3", "Errors.Webhook.Invalid")
+	}
+	if actions.IsHostBlocked(target) {
+		return caos_errs.ThrowInvalidArgument(nil, "COMMAND-Wg2k6", "Errors.Webhook.URLNotAllowed")
+	}
+	for _, eventType := range w.EventTypes {
+		if !webhook.IsSubscribable(eventType) {
+			return caos_errs.ThrowInvalidArgument(nil, "COMMAND-Wj9f4", "Errors.Webhook.EventTypeNotSupported")
+		}
+	}
+	return nil
+}
+
+func (c *Commands) getWebhookWriteModelByID(ctx context.Context, webhookID, resourceOwner string) (*WebhookWriteModel, error) {
+	webhookWriteModel := NewWebhookWriteModel(webhookID, resourceOwner)
+	err := c.eventstore.FilterToQueryReducer(ctx, webhookWriteModel)
+	if err != nil {
+		return nil, err
+	}
+	return webhookWriteModel, nil
+}
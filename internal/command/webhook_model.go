@@ -0,0 +1,112 @@
+package command
+
+import (
+	"context"
+
+	"github.com/dennigogo/zitadel/internal/crypto"
+	"github.com/dennigogo/zitadel/internal/domain"
+	"github.com/dennigogo/zitadel/internal/eventstore"
+	"github.com/dennigogo/zitadel/internal/repository/webhook"
+)
+
+type WebhookWriteModel struct {
+	eventstore.WriteModel
+
+	Name       string
+	URL        string
+	EventTypes []string
+	Secret     *crypto.CryptoValue
+	State      domain.WebhookState
+}
+
+func NewWebhookWriteModel(webhookID string, resourceOwner string) *WebhookWriteModel {
+	return &WebhookWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   webhookID,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (wm *WebhookWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *webhook.AddedEvent:
+			wm.Name = e.Name
+			wm.URL = e.URL
+			wm.EventTypes = e.EventTypes
+			wm.Secret = e.Secret
+			wm.State = domain.WebhookStateActive
+		case *webhook.ChangedEvent:
+			if e.Name != nil {
+				wm.Name = *e.Name
+			}
+			if e.URL != nil {
+				wm.URL = *e.URL
+			}
+			if e.EventTypes != nil {
+				wm.EventTypes = e.EventTypes
+			}
+		case *webhook.SecretChangedEvent:
+			wm.Secret = e.Secret
+		case *webhook.DeactivatedEvent:
+			wm.State = domain.WebhookStateInactive
+		case *webhook.ReactivatedEvent:
+			wm.State = domain.WebhookStateActive
+		case *webhook.RemovedEvent:
+			wm.State = domain.WebhookStateRemoved
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *WebhookWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(webhook.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(webhook.AddedEventType,
+			webhook.ChangedEventType,
+			webhook.SecretChangedEventType,
+			webhook.DeactivatedEventType,
+			webhook.ReactivatedEventType,
+			webhook.RemovedEventType).
+		Builder()
+}
+
+func (wm *WebhookWriteModel) NewChangedEvent(
+	ctx context.Context,
+	agg *eventstore.Aggregate,
+	name,
+	url string,
+	eventTypes []string,
+) (*webhook.ChangedEvent, error) {
+	changes := make([]webhook.WebhookChanges, 0)
+	if wm.Name != name {
+		changes = append(changes, webhook.ChangeName(name, wm.Name))
+	}
+	if wm.URL != url {
+		changes = append(changes, webhook.ChangeURL(url))
+	}
+	if !equalStrings(wm.EventTypes, eventTypes) {
+		changes = append(changes, webhook.ChangeEventTypes(eventTypes))
+	}
+	return webhook.NewChangedEvent(ctx, agg, changes)
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func WebhookAggregateFromWriteModel(wm *eventstore.WriteModel) *eventstore.Aggregate {
+	return eventstore.AggregateFromWriteModel(wm, webhook.AggregateType, webhook.AggregateVersion)
+}
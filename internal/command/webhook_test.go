@@ -0,0 +1,514 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dennigogo/zitadel/internal/crypto"
+	"github.com/dennigogo/zitadel/internal/domain"
+	"github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/eventstore"
+	"github.com/dennigogo/zitadel/internal/eventstore/repository"
+	"github.com/dennigogo/zitadel/internal/eventstore/v1/models"
+	"github.com/dennigogo/zitadel/internal/id"
+	"github.com/dennigogo/zitadel/internal/id/mock"
+	"github.com/dennigogo/zitadel/internal/repository/webhook"
+)
+
+func TestCommands_AddWebhook(t *testing.T) {
+	type fields struct {
+		eventstore      *eventstore.Eventstore
+		idGenerator     id.Generator
+		secretGenerator crypto.Generator
+	}
+	type args struct {
+		ctx           context.Context
+		addWebhook    *domain.Webhook
+		resourceOwner string
+	}
+	type res struct {
+		id      string
+		secret  string
+		details *domain.ObjectDetails
+		err     func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"no event types, error",
+			fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args{
+				ctx: context.Background(),
+				addWebhook: &domain.Webhook{
+					Name: "name",
+					URL:  "https://example.com/hook",
+				},
+				resourceOwner: "org1",
+			},
+			res{
+				err: errors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"invalid url, error",
+			fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args{
+				ctx: context.Background(),
+				addWebhook: &domain.Webhook{
+					Name:       "name",
+					URL:        "ftp://example.com/hook",
+					EventTypes: []string{"user.human.added"},
+				},
+				resourceOwner: "org1",
+			},
+			res{
+				err: errors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"unsupported event type, error",
+			fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args{
+				ctx: context.Background(),
+				addWebhook: &domain.Webhook{
+					Name:       "name",
+					URL:        "https://example.com/hook",
+					EventTypes: []string{"user.human.password.changed"},
+				},
+				resourceOwner: "org1",
+			},
+			res{
+				err: errors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"push ok",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								webhook.NewAddedEvent(context.Background(),
+									&webhook.NewAggregate("id1", "org1").Aggregate,
+									"name",
+									"https://example.com/hook",
+									[]string{"user.human.added", "user.grant.changed"},
+									&crypto.CryptoValue{
+										CryptoType: crypto.TypeEncryption,
+										Algorithm:  "enc",
+										KeyID:      "id",
+										Crypted:    []byte("a"),
+									},
+								),
+							),
+						},
+						uniqueConstraintsFromEventConstraint(webhook.NewAddWebhookNameUniqueConstraint("name", "org1")),
+					),
+				),
+				idGenerator:     mock.ExpectID(t, "id1"),
+				secretGenerator: GetMockSecretGenerator(t),
+			},
+			args{
+				ctx: context.Background(),
+				addWebhook: &domain.Webhook{
+					Name:       "name",
+					URL:        "https://example.com/hook",
+					EventTypes: []string{"user.human.added", "user.grant.changed"},
+				},
+				resourceOwner: "org1",
+			},
+			res{
+				id:     "id1",
+				secret: "a",
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:             tt.fields.eventstore,
+				idGenerator:            tt.fields.idGenerator,
+				webhookSecretGenerator: tt.fields.secretGenerator,
+			}
+			id, secret, details, err := c.AddWebhook(tt.args.ctx, tt.args.addWebhook, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.id, id)
+				assert.Equal(t, tt.res.secret, secret)
+				assert.Equal(t, tt.res.details, details)
+			}
+		})
+	}
+}
+
+func TestCommands_ChangeWebhook(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		changeWebhook *domain.Webhook
+		resourceOwner string
+	}
+	type res struct {
+		details *domain.ObjectDetails
+		err     func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"id missing, error",
+			fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args{
+				ctx: context.Background(),
+				changeWebhook: &domain.Webhook{
+					Name:       "name",
+					URL:        "https://example.com/hook",
+					EventTypes: []string{"user.human.added"},
+				},
+				resourceOwner: "org1",
+			},
+			res{
+				err: errors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"not found, error",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(),
+				),
+			},
+			args{
+				ctx: context.Background(),
+				changeWebhook: &domain.Webhook{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "id1",
+					},
+					Name:       "name",
+					URL:        "https://example.com/hook",
+					EventTypes: []string{"user.human.added"},
+				},
+				resourceOwner: "org1",
+			},
+			res{
+				err: errors.IsNotFound,
+			},
+		},
+		{
+			"no changes, error",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							newWebhookAddedEvent("id1", "org1"),
+						),
+					),
+				),
+			},
+			args{
+				ctx: context.Background(),
+				changeWebhook: &domain.Webhook{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "id1",
+					},
+					Name:       "name",
+					URL:        "https://example.com/hook",
+					EventTypes: []string{"user.human.added"},
+				},
+				resourceOwner: "org1",
+			},
+			res{
+				err: errors.IsPreconditionFailed,
+			},
+		},
+		{
+			"push ok",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							newWebhookAddedEvent("id1", "org1"),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								func() eventstore.Command {
+									event, _ := webhook.NewChangedEvent(context.Background(),
+										&webhook.NewAggregate("id1", "org1").Aggregate,
+										[]webhook.WebhookChanges{
+											webhook.ChangeName("name2", "name"),
+											webhook.ChangeEventTypes([]string{"user.human.added", "user.removed"}),
+										},
+									)
+									return event
+								}(),
+							),
+						},
+						uniqueConstraintsFromEventConstraint(webhook.NewRemoveWebhookNameUniqueConstraint("name", "org1")),
+						uniqueConstraintsFromEventConstraint(webhook.NewAddWebhookNameUniqueConstraint("name2", "org1")),
+					),
+				),
+			},
+			args{
+				ctx: context.Background(),
+				changeWebhook: &domain.Webhook{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "id1",
+					},
+					Name:       "name2",
+					URL:        "https://example.com/hook",
+					EventTypes: []string{"user.human.added", "user.removed"},
+				},
+				resourceOwner: "org1",
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			details, err := c.ChangeWebhook(tt.args.ctx, tt.args.changeWebhook, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.details, details)
+			}
+		})
+	}
+}
+
+func TestCommands_DeactivateWebhook(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		webhookID     string
+		resourceOwner string
+	}
+	type res struct {
+		details *domain.ObjectDetails
+		err     func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"not active, error",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							newWebhookAddedEvent("id1", "org1"),
+						),
+						eventFromEventPusher(
+							webhook.NewDeactivatedEvent(context.Background(),
+								&webhook.NewAggregate("id1", "org1").Aggregate,
+							),
+						),
+					),
+				),
+			},
+			args{
+				ctx:           context.Background(),
+				webhookID:     "id1",
+				resourceOwner: "org1",
+			},
+			res{
+				err: errors.IsPreconditionFailed,
+			},
+		},
+		{
+			"push ok",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							newWebhookAddedEvent("id1", "org1"),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								webhook.NewDeactivatedEvent(context.Background(),
+									&webhook.NewAggregate("id1", "org1").Aggregate,
+								),
+							),
+						},
+					),
+				),
+			},
+			args{
+				ctx:           context.Background(),
+				webhookID:     "id1",
+				resourceOwner: "org1",
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			details, err := c.DeactivateWebhook(tt.args.ctx, tt.args.webhookID, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.details, details)
+			}
+		})
+	}
+}
+
+func TestCommands_RemoveWebhook(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		webhookID     string
+		resourceOwner string
+	}
+	type res struct {
+		details *domain.ObjectDetails
+		err     func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"not found, error",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(),
+				),
+			},
+			args{
+				ctx:           context.Background(),
+				webhookID:     "id1",
+				resourceOwner: "org1",
+			},
+			res{
+				err: errors.IsNotFound,
+			},
+		},
+		{
+			"push ok",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							newWebhookAddedEvent("id1", "org1"),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								webhook.NewRemovedEvent(context.Background(),
+									&webhook.NewAggregate("id1", "org1").Aggregate,
+									"name",
+								),
+							),
+						},
+						uniqueConstraintsFromEventConstraint(webhook.NewRemoveWebhookNameUniqueConstraint("name", "org1")),
+					),
+				),
+			},
+			args{
+				ctx:           context.Background(),
+				webhookID:     "id1",
+				resourceOwner: "org1",
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			details, err := c.RemoveWebhook(tt.args.ctx, tt.args.webhookID, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.details, details)
+			}
+		})
+	}
+}
+
+func newWebhookAddedEvent(id, resourceOwner string) *webhook.AddedEvent {
+	return webhook.NewAddedEvent(context.Background(),
+		&webhook.NewAggregate(id, resourceOwner).Aggregate,
+		"name",
+		"https://example.com/hook",
+		[]string{"user.human.added"},
+		&crypto.CryptoValue{
+			CryptoType: crypto.TypeEncryption,
+			Algorithm:  "enc",
+			KeyID:      "id",
+			Crypted:    []byte("a"),
+		},
+	)
+}
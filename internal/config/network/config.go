@@ -25,6 +25,12 @@ type TLS struct {
 	Key []byte
 	//Certificate for the TLS connection (CertPath will this overwrite, if specified)
 	Cert []byte
+	//If enabled, clients are asked for a certificate, which is used to bind access tokens (mTLS)
+	//the certificate is not verified against any CA, as only its thumbprint is bound to the token
+	RequestClientCertificate bool
+	//If enabled, the client certificate is read from the x-zitadel-client-cert header, if ZITADEL doesn't terminate TLS itself
+	//only enable it if the TLS terminating proxy sets the header and removes it from the requests of the clients
+	TrustClientCertificateHeader bool
 }
 
 func (t *TLS) Config() (_ *tls.Config, err error) {
@@ -50,7 +56,12 @@ func (t *TLS) Config() (_ *tls.Config, err error) {
 	if err != nil {
 		return nil, err
 	}
+	clientAuth := tls.NoClientCert
+	if t.RequestClientCertificate {
+		clientAuth = tls.RequestClientCert
+	}
 	return &tls.Config{
 		Certificates: []tls.Certificate{tlsCert},
+		ClientAuth:   clientAuth,
 	}, nil
 }
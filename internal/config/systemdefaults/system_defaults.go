@@ -8,10 +8,13 @@ import (
 
 type SystemDefaults struct {
 	SecretGenerators   SecretGenerators
+	PasswordHasher     crypto.PasswordHashConfig
+	BreachedPasswords  crypto.BreachedPasswordsConfig
 	Multifactors       MultifactorConfig
 	DomainVerification DomainVerification
 	Notifications      Notifications
 	KeyConfig          KeyConfig
+	Webhooks           Webhooks
 }
 
 type SecretGenerators struct {
@@ -34,6 +37,22 @@ type DomainVerification struct {
 
 type Notifications struct {
 	FileSystemPath string
+	// PasswordExpiryCheckInterval defines how often users are checked for expiring passwords to notify them, 0 disables the notifications
+	PasswordExpiryCheckInterval time.Duration
+}
+
+type Webhooks struct {
+	SecretGenerator crypto.GeneratorConfig
+	Delivery        WebhookDelivery
+}
+
+type WebhookDelivery struct {
+	Timeout        time.Duration
+	MaxAttempts    uint8
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	Interval       time.Duration
+	BatchSize      uint16
 }
 
 type KeyConfig struct {
@@ -0,0 +1,125 @@
+package crypto
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"fmt"
+	"strconv"
+
+	"golang.org/x/crypto/argon2"
+
+	"github.com/dennigogo/zitadel/internal/errors"
+)
+
+var _ PasswordHashAlgorithm = (*Argon2id)(nil)
+
+const (
+	argon2idID         = "argon2id"
+	argon2idSaltLength = 16
+	argon2idKeyLength  = 32
+	// the upper bounds prevent imported hashes from exhausting memory and cpu on verification
+	argon2idMaxTime   = 16
+	argon2idMaxMemory = 256 * 1024
+)
+
+type Argon2id struct {
+	time    uint32
+	memory  uint32
+	threads uint8
+}
+
+// NewArgon2id creates an argon2id hasher
+// memory is defined in KiB
+func NewArgon2id(time, memory uint32, threads uint8) *Argon2id {
+	return &Argon2id{
+		time:    time,
+		memory:  memory,
+		threads: threads,
+	}
+}
+
+func (a *Argon2id) Algorithm() string {
+	return argon2idID
+}
+
+func (a *Argon2id) Hash(value []byte) ([]byte, error) {
+	salt, err := randomSalt(argon2idSaltLength)
+	if err != nil {
+		return nil, err
+	}
+	key := argon2.IDKey(value, salt, a.time, a.memory, a.threads, argon2idKeyLength)
+	return []byte(fmt.Sprintf("$%s$v=%d$m=%d,t=%d,p=%d$%s$%s", argon2idID, argon2.Version, a.memory, a.time, a.threads, encodePHCBase64(salt), encodePHCBase64(key))), nil
+}
+
+func (a *Argon2id) CompareHash(hashed, value []byte) error {
+	h, params, err := parseArgon2id(hashed)
+	if err != nil {
+		return err
+	}
+	key := argon2.IDKey(value, h.salt, params.time, params.memory, params.threads, uint32(len(h.hash)))
+	if subtle.ConstantTimeCompare(key, h.hash) != 1 {
+		return errors.ThrowInvalidArgument(nil, "CRYPT-Ar7k2", "hash does not match")
+	}
+	return nil
+}
+
+func (a *Argon2id) Identifies(encoded []byte) bool {
+	return bytes.HasPrefix(encoded, []byte(phcSeparator+argon2idID+phcSeparator))
+}
+
+func (a *Argon2id) Validate(encoded []byte) error {
+	_, _, err := parseArgon2id(encoded)
+	return err
+}
+
+func (a *Argon2id) NeedsRehash(encoded []byte) bool {
+	h, params, err := parseArgon2id(encoded)
+	if err != nil {
+		return true
+	}
+	return *params != *a || len(h.hash) != argon2idKeyLength
+}
+
+func parseArgon2id(encoded []byte) (*phcHash, *Argon2id, error) {
+	h, err := parsePHC(encoded)
+	if err != nil {
+		return nil, nil, err
+	}
+	if h.id != argon2idID {
+		return nil, nil, errors.ThrowInvalidArgument(nil, "CRYPT-Ah2d9", "hash is not an argon2id hash")
+	}
+	if h.version != strconv.Itoa(argon2.Version) {
+		return nil, nil, errors.ThrowInvalidArgument(nil, "CRYPT-As6f1", "argon2 version not supported")
+	}
+	memory, err := strconv.ParseUint(h.params["m"], 10, 32)
+	if err != nil {
+		return nil, nil, errors.ThrowInvalidArgument(err, "CRYPT-Am3x8", "invalid argon2 memory parameter")
+	}
+	time, err := strconv.ParseUint(h.params["t"], 10, 32)
+	if err != nil {
+		return nil, nil, errors.ThrowInvalidArgument(err, "CRYPT-At5q4", "invalid argon2 time parameter")
+	}
+	threads, err := strconv.ParseUint(h.params["p"], 10, 8)
+	if err != nil {
+		return nil, nil, errors.ThrowInvalidArgument(err, "CRYPT-Ap9w3", "invalid argon2 threads parameter")
+	}
+	params := NewArgon2id(uint32(time), uint32(memory), uint8(threads))
+	if err = params.validate(); err != nil {
+		return nil, nil, err
+	}
+	return h, params, nil
+}
+
+// validate ensures the parameters are accepted by argon2 and within the supported bounds
+func (a *Argon2id) validate() error {
+	if a.time < 1 || a.time > argon2idMaxTime {
+		return errors.ThrowInvalidArgument(nil, "CRYPT-At8b2", "argon2 time parameter out of range")
+	}
+	if a.threads < 1 {
+		return errors.ThrowInvalidArgument(nil, "CRYPT-Ap4b6", "argon2 threads parameter out of range")
+	}
+	if a.memory < 8*uint32(a.threads) || a.memory > argon2idMaxMemory {
+		return errors.ThrowInvalidArgument(nil, "CRYPT-Am7b1", "argon2 memory parameter out of range")
+	}
+	return nil
+}
@@ -1,10 +1,19 @@
 package crypto
 
 import (
+	"bytes"
+
 	"golang.org/x/crypto/bcrypt"
+
+	"github.com/dennigogo/zitadel/internal/errors"
 )
 
-var _ HashAlgorithm = (*BCrypt)(nil)
+var _ PasswordHashAlgorithm = (*BCrypt)(nil)
+
+// bcryptMaxCost prevents imported hashes from exhausting the cpu on verification
+const bcryptMaxCost = 18
+
+var bcryptPrefixes = [][]byte{[]byte("$2a$"), []byte("$2b$"), []byte("$2y$")}
 
 type BCrypt struct {
 	cost int
@@ -23,5 +32,33 @@ func (b *BCrypt) Hash(value []byte) ([]byte, error) {
 }
 
 func (b *BCrypt) CompareHash(hashed, value []byte) error {
+	if err := b.Validate(hashed); err != nil {
+		return err
+	}
 	return bcrypt.CompareHashAndPassword(hashed, value)
 }
+
+func (b *BCrypt) Identifies(encoded []byte) bool {
+	for _, prefix := range bcryptPrefixes {
+		if bytes.HasPrefix(encoded, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func (b *BCrypt) Validate(encoded []byte) error {
+	cost, err := bcrypt.Cost(encoded)
+	if err != nil {
+		return errors.ThrowInvalidArgument(err, "CRYPT-Bc3v7", "invalid bcrypt hash")
+	}
+	if cost > bcryptMaxCost {
+		return errors.ThrowInvalidArgument(nil, "CRYPT-Bc8b2", "bcrypt cost parameter out of range")
+	}
+	return nil
+}
+
+func (b *BCrypt) NeedsRehash(encoded []byte) bool {
+	cost, err := bcrypt.Cost(encoded)
+	return err != nil || cost != b.cost
+}
@@ -0,0 +1,189 @@
+package crypto
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/binary"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/dennigogo/zitadel/internal/errors"
+)
+
+const (
+	// BreachedPasswordsFormatSHA1Prefix is a directory of files named by the first 5 characters
+	// of the upper case hex encoded SHA-1 hash (e.g. 5BAA6.txt), each line contains the remaining
+	// 35 characters of a hash optionally followed by a colon and the count of occurrences,
+	// as returned by the range api of Have I Been Pwned
+	BreachedPasswordsFormatSHA1Prefix = "sha1-prefix"
+	// BreachedPasswordsFormatBloom is a bloom filter file, see BloomFilter
+	BreachedPasswordsFormatBloom = "bloom"
+
+	sha1PrefixLength = 5
+)
+
+// BreachedPasswordChecker checks passwords against a corpus of passwords known from data breaches
+type BreachedPasswordChecker interface {
+	IsBreached(password string) (bool, error)
+}
+
+type BreachedPasswordsConfig struct {
+	// Format is one of sha1-prefix or bloom, empty disables the check
+	Format string
+	// Path of the directory of the prefix files or of the bloom filter file
+	Path string
+}
+
+// NewChecker returns the checker of the configured dataset,
+// nil is returned if no dataset is configured
+func (c *BreachedPasswordsConfig) NewChecker() (BreachedPasswordChecker, error) {
+	switch c.Format {
+	case "":
+		return nil, nil
+	case BreachedPasswordsFormatSHA1Prefix:
+		checker, err := NewSHA1PrefixChecker(c.Path)
+		if err != nil {
+			return nil, err
+		}
+		return checker, nil
+	case BreachedPasswordsFormatBloom:
+		filter, err := LoadBloomFilter(c.Path)
+		if err != nil {
+			return nil, err
+		}
+		return filter, nil
+	default:
+		return nil, errors.ThrowInvalidArgumentf(nil, "CRYPT-Br2f8", "breached passwords format %q not supported", c.Format)
+	}
+}
+
+// SHA1PrefixChecker looks up passwords in the prefix files of a directory,
+// only the file of the prefix of the password is read
+type SHA1PrefixChecker struct {
+	dir string
+}
+
+func NewSHA1PrefixChecker(dir string) (*SHA1PrefixChecker, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, errors.ThrowInvalidArgument(err, "CRYPT-Br5kd", "unable to open breached passwords directory")
+	}
+	if !info.IsDir() {
+		return nil, errors.ThrowInvalidArgument(nil, "CRYPT-Br6sj", "breached passwords path is not a directory")
+	}
+	return &SHA1PrefixChecker{dir: dir}, nil
+}
+
+func (c *SHA1PrefixChecker) IsBreached(password string) (bool, error) {
+	sum := sha1.Sum([]byte(password))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+	file, err := os.Open(filepath.Join(c.dir, hash[:sha1PrefixLength]+".txt"))
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.ThrowInternal(err, "CRYPT-Br8aw", "unable to open breached passwords file")
+	}
+	defer file.Close()
+
+	suffix := hash[sha1PrefixLength:]
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if i := strings.IndexByte(line, ':'); i >= 0 {
+			line = line[:i]
+		}
+		if strings.EqualFold(line, suffix) {
+			return true, nil
+		}
+	}
+	if err = scanner.Err(); err != nil {
+		return false, errors.ThrowInternal(err, "CRYPT-Br1xq", "unable to read breached passwords file")
+	}
+	return false, nil
+}
+
+// BloomFilter is a probabilistic set of breached passwords which is held in memory,
+// it never misses a breached password but might report a password as breached which is not
+//
+// the file format is the number of bits and the number of hash functions (both big endian uint64)
+// followed by the bits, the positions of a password are derived from its SHA-1 hash
+// by double hashing: (h1 + i*h2) mod bits, where h1 and h2 are the first two big endian uint64 of the hash
+type BloomFilter struct {
+	bits   uint64
+	hashes uint64
+	set    []byte
+}
+
+func NewBloomFilter(bits, hashes uint64) *BloomFilter {
+	return &BloomFilter{
+		bits:   bits,
+		hashes: hashes,
+		set:    make([]byte, (bits+7)/8),
+	}
+}
+
+func LoadBloomFilter(path string) (*BloomFilter, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errors.ThrowInvalidArgument(err, "CRYPT-Br3nv", "unable to open breached passwords bloom filter")
+	}
+	defer file.Close()
+	return ReadBloomFilter(bufio.NewReader(file))
+}
+
+func ReadBloomFilter(r io.Reader) (*BloomFilter, error) {
+	header := make([]byte, 16)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, errors.ThrowInvalidArgument(err, "CRYPT-Br7hd", "invalid bloom filter header")
+	}
+	filter := NewBloomFilter(binary.BigEndian.Uint64(header[:8]), binary.BigEndian.Uint64(header[8:]))
+	if filter.bits == 0 || filter.hashes == 0 {
+		return nil, errors.ThrowInvalidArgument(nil, "CRYPT-Br9mc", "invalid bloom filter header")
+	}
+	if _, err := io.ReadFull(r, filter.set); err != nil {
+		return nil, errors.ThrowInvalidArgument(err, "CRYPT-Br4wl", "bloom filter is truncated")
+	}
+	return filter, nil
+}
+
+func (f *BloomFilter) WriteTo(w io.Writer) (int64, error) {
+	header := make([]byte, 16)
+	binary.BigEndian.PutUint64(header[:8], f.bits)
+	binary.BigEndian.PutUint64(header[8:], f.hashes)
+	n, err := w.Write(header)
+	if err != nil {
+		return int64(n), err
+	}
+	m, err := w.Write(f.set)
+	return int64(n + m), err
+}
+
+func (f *BloomFilter) Add(password string) {
+	for _, position := range f.positions(password) {
+		f.set[position/8] |= 1 << (position % 8)
+	}
+}
+
+func (f *BloomFilter) IsBreached(password string) (bool, error) {
+	for _, position := range f.positions(password) {
+		if f.set[position/8]&(1<<(position%8)) == 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (f *BloomFilter) positions(password string) []uint64 {
+	sum := sha1.Sum([]byte(password))
+	h1 := binary.BigEndian.Uint64(sum[:8])
+	h2 := binary.BigEndian.Uint64(sum[8:16])
+	positions := make([]uint64, f.hashes)
+	for i := uint64(0); i < f.hashes; i++ {
+		positions[i] = (h1 + i*h2) % f.bits
+	}
+	return positions
+}
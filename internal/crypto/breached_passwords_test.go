@@ -0,0 +1,105 @@
+package crypto
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSHA1PrefixChecker_IsBreached(t *testing.T) {
+	dir := t.TempDir()
+	// SHA-1 of "password" is 5BAA61E4C9B93F3F0682250B6CF8331B7EE68FD8
+	err := os.WriteFile(filepath.Join(dir, "5BAA6.txt"), []byte("003D68EB55068C33ACE09247EE4C639306B:3\r\n1E4C9B93F3F0682250B6CF8331B7EE68FD8:9545824\r\n"), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checker, err := (&BreachedPasswordsConfig{Format: BreachedPasswordsFormatSHA1Prefix, Path: dir}).NewChecker()
+	if err != nil {
+		t.Fatalf("unable to create checker: %v", err)
+	}
+	tests := []struct {
+		name     string
+		password string
+		want     bool
+	}{
+		{"breached", "password", true},
+		{"prefix file missing", "not breached", false},
+		{"prefix file without hash", "password1", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := checker.IsBreached(tt.password)
+			if err != nil {
+				t.Fatalf("IsBreached() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("IsBreached() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBloomFilter_IsBreached(t *testing.T) {
+	filter := NewBloomFilter(1<<16, 7)
+	filter.Add("password")
+	filter.Add("123456")
+
+	file := new(bytes.Buffer)
+	if _, err := filter.WriteTo(file); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "breached.bloom")
+	if err := os.WriteFile(path, file.Bytes(), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	checker, err := (&BreachedPasswordsConfig{Format: BreachedPasswordsFormatBloom, Path: path}).NewChecker()
+	if err != nil {
+		t.Fatalf("unable to load bloom filter: %v", err)
+	}
+	tests := []struct {
+		name     string
+		password string
+		want     bool
+	}{
+		{"breached", "password", true},
+		{"breached numbers", "123456", true},
+		{"not breached", "correct horse battery staple", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := checker.IsBreached(tt.password)
+			if err != nil {
+				t.Fatalf("IsBreached() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("IsBreached() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBreachedPasswordsConfig_NewChecker(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  BreachedPasswordsConfig
+		wantNil bool
+		wantErr bool
+	}{
+		{"disabled", BreachedPasswordsConfig{}, true, false},
+		{"unsupported format", BreachedPasswordsConfig{Format: "md5"}, true, true},
+		{"prefix directory missing", BreachedPasswordsConfig{Format: BreachedPasswordsFormatSHA1Prefix, Path: filepath.Join(t.TempDir(), "missing")}, true, true},
+		{"bloom filter missing", BreachedPasswordsConfig{Format: BreachedPasswordsFormatBloom, Path: filepath.Join(t.TempDir(), "missing")}, true, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checker, err := tt.config.NewChecker()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewChecker() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if (checker == nil) != tt.wantNil {
+				t.Errorf("NewChecker() = %v, wantNil %v", checker, tt.wantNil)
+			}
+		})
+	}
+}
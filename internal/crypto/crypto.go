@@ -31,6 +31,18 @@ type HashAlgorithm interface {
 	CompareHash(hashed, comparer []byte) error
 }
 
+// HashIdentifier is implemented by hash algorithms which are able to verify hashes of multiple algorithms
+type HashIdentifier interface {
+	// Identify returns the name of the algorithm which created the encoded hash
+	Identify(encoded []byte) (algorithm string, ok bool)
+}
+
+// HashValidator is implemented by hash algorithms which are able to verify the parameters of an encoded hash
+type HashValidator interface {
+	// Validate returns an error if the encoded hash is malformed or its parameters are out of the supported bounds
+	Validate(encoded []byte) error
+}
+
 type CryptoValue struct {
 	CryptoType CryptoType
 	Algorithm  string
@@ -119,12 +131,55 @@ func Hash(value []byte, alg HashAlgorithm) (*CryptoValue, error) {
 }
 
 func CompareHash(value *CryptoValue, comparer []byte, alg HashAlgorithm) error {
-	if value.Algorithm != alg.Algorithm() {
+	if value.Algorithm != alg.Algorithm() && !identifiesHash(value, alg) {
 		return errors.ThrowInvalidArgument(nil, "CRYPT-HF32f", "value was hashed with a different algorithm")
 	}
 	return alg.CompareHash(value.Crypted, comparer)
 }
 
+// NeedsRehash returns true if the algorithm prefers another algorithm or other parameters
+// than the ones used to create the hashed value
+func NeedsRehash(value *CryptoValue, alg HashAlgorithm) bool {
+	passwordAlg, ok := alg.(PasswordHashAlgorithm)
+	if !ok {
+		return false
+	}
+	return passwordAlg.NeedsRehash(value.Crypted)
+}
+
+// IdentifyHash checks if an already hashed value (e.g. of an import) can be verified by the algorithm
+// the hash is parsed completely, so malformed hashes or hashes with out of bound parameters are rejected
+// if the algorithm is able to identify the hash, the algorithm of the returned value is set to the one which created the hash
+func IdentifyHash(value *CryptoValue, alg HashAlgorithm) (*CryptoValue, error) {
+	identifier, ok := alg.(HashIdentifier)
+	if !ok {
+		return value, nil
+	}
+	algorithm, ok := identifier.Identify(value.Crypted)
+	if !ok {
+		return nil, errors.ThrowInvalidArgument(nil, "CRYPT-Hd8s2", "Errors.User.Password.HashNotSupported")
+	}
+	if validator, ok := alg.(HashValidator); ok {
+		if err := validator.Validate(value.Crypted); err != nil {
+			return nil, errors.ThrowInvalidArgument(err, "CRYPT-Hd3v9", "Errors.User.Password.HashNotSupported")
+		}
+	}
+	return &CryptoValue{
+		CryptoType: TypeHash,
+		Algorithm:  algorithm,
+		Crypted:    value.Crypted,
+	}, nil
+}
+
+func identifiesHash(value *CryptoValue, alg HashAlgorithm) bool {
+	identifier, ok := alg.(HashIdentifier)
+	if !ok {
+		return false
+	}
+	_, ok = identifier.Identify(value.Crypted)
+	return ok
+}
+
 func FillHash(value []byte, alg HashAlgorithm) *CryptoValue {
 	return &CryptoValue{
 		CryptoType: TypeHash,
@@ -0,0 +1,165 @@
+package crypto
+
+import (
+	"crypto/rand"
+
+	"github.com/dennigogo/zitadel/internal/errors"
+)
+
+var _ PasswordHashAlgorithm = (*PasswordHasher)(nil)
+
+// PasswordHashAlgorithm is a HashAlgorithm which encodes its parameters into the hash,
+// so hashes created with other parameters can still be verified
+type PasswordHashAlgorithm interface {
+	HashAlgorithm
+	// Identifies returns true if the encoded hash was created by the algorithm
+	Identifies(encoded []byte) bool
+	// Validate returns an error if the encoded hash is malformed or its parameters are out of the supported bounds
+	Validate(encoded []byte) error
+	// NeedsRehash returns true if the encoded hash was created with other parameters than the configured ones
+	NeedsRehash(encoded []byte) bool
+}
+
+// PasswordHasher hashes new passwords with the preferred algorithm
+// and verifies passwords hashed by any of the verifiers
+type PasswordHasher struct {
+	hasher    PasswordHashAlgorithm
+	verifiers []PasswordHashAlgorithm
+}
+
+func NewPasswordHasher(hasher PasswordHashAlgorithm, verifiers ...PasswordHashAlgorithm) *PasswordHasher {
+	return &PasswordHasher{
+		hasher:    hasher,
+		verifiers: verifiers,
+	}
+}
+
+func (h *PasswordHasher) Algorithm() string {
+	return h.hasher.Algorithm()
+}
+
+func (h *PasswordHasher) Hash(value []byte) ([]byte, error) {
+	return h.hasher.Hash(value)
+}
+
+func (h *PasswordHasher) CompareHash(hashed, comparer []byte) error {
+	alg := h.identify(hashed)
+	if alg == nil {
+		return errors.ThrowInvalidArgument(nil, "CRYPT-Ph4s8", "hash algorithm not supported")
+	}
+	return alg.CompareHash(hashed, comparer)
+}
+
+func (h *PasswordHasher) Identifies(encoded []byte) bool {
+	return h.identify(encoded) != nil
+}
+
+func (h *PasswordHasher) Validate(encoded []byte) error {
+	alg := h.identify(encoded)
+	if alg == nil {
+		return errors.ThrowInvalidArgument(nil, "CRYPT-Ph6v1", "hash algorithm not supported")
+	}
+	return alg.Validate(encoded)
+}
+
+// NeedsRehash returns true if the encoded hash was not created by the preferred algorithm
+// or with other parameters than the configured ones
+func (h *PasswordHasher) NeedsRehash(encoded []byte) bool {
+	return !h.hasher.Identifies(encoded) || h.hasher.NeedsRehash(encoded)
+}
+
+// Identify returns the name of the algorithm which created the encoded hash
+func (h *PasswordHasher) Identify(encoded []byte) (string, bool) {
+	alg := h.identify(encoded)
+	if alg == nil {
+		return "", false
+	}
+	return alg.Algorithm(), true
+}
+
+func (h *PasswordHasher) identify(encoded []byte) PasswordHashAlgorithm {
+	if h.hasher.Identifies(encoded) {
+		return h.hasher
+	}
+	for _, verifier := range h.verifiers {
+		if verifier.Identifies(encoded) {
+			return verifier
+		}
+	}
+	return nil
+}
+
+const (
+	PasswordHashAlgorithmBCrypt   = "bcrypt"
+	PasswordHashAlgorithmArgon2id = "argon2id"
+	PasswordHashAlgorithmScrypt   = "scrypt"
+	PasswordHashAlgorithmPBKDF2   = "pbkdf2"
+)
+
+// PasswordHashConfig defines the algorithm and its parameters used to hash new passwords
+// hashes of all supported algorithms are verified independent of the configuration
+type PasswordHashConfig struct {
+	// Algorithm is one of bcrypt, argon2id, scrypt or pbkdf2
+	Algorithm string
+	// Cost of bcrypt and scrypt (base 2 logarithm of N)
+	Cost int
+	// Iterations of argon2id (time) and pbkdf2
+	Iterations uint32
+	// Memory of argon2id in KiB
+	Memory uint32
+	// Parallelism of argon2id and scrypt
+	Parallelism uint8
+	// BlockSize of scrypt
+	BlockSize int
+	// Hash function of pbkdf2: sha1, sha256 or sha512
+	Hash PBKDF2Hash
+}
+
+func (c *PasswordHashConfig) NewHasher() (*PasswordHasher, error) {
+	var hasher PasswordHashAlgorithm
+	switch c.Algorithm {
+	case PasswordHashAlgorithmBCrypt, "":
+		if c.Cost > bcryptMaxCost {
+			return nil, errors.ThrowInvalidArgumentf(nil, "CRYPT-Ph3c8", "bcrypt cost must not exceed %d", bcryptMaxCost)
+		}
+		hasher = NewBCrypt(c.Cost)
+	case PasswordHashAlgorithmArgon2id:
+		params := NewArgon2id(c.Iterations, c.Memory, c.Parallelism)
+		if err := params.validate(); err != nil {
+			return nil, err
+		}
+		hasher = params
+	case PasswordHashAlgorithmScrypt:
+		params := NewScrypt(c.Cost, c.BlockSize, int(c.Parallelism))
+		if err := params.validate(); err != nil {
+			return nil, err
+		}
+		hasher = params
+	case PasswordHashAlgorithmPBKDF2:
+		if _, ok := pbkdf2Hashes[c.Hash]; !ok {
+			return nil, errors.ThrowInvalidArgumentf(nil, "CRYPT-Ph7d2", "pbkdf2 hash function %q not supported", c.Hash)
+		}
+		params := NewPBKDF2(c.Hash, int(c.Iterations))
+		if err := params.validate(); err != nil {
+			return nil, err
+		}
+		hasher = params
+	default:
+		return nil, errors.ThrowInvalidArgumentf(nil, "CRYPT-Ph2k5", "password hash algorithm %q not supported", c.Algorithm)
+	}
+	// the parameters of the verifiers are irrelevant, they are read from the hash
+	return NewPasswordHasher(hasher,
+		NewBCrypt(0),
+		NewArgon2id(0, 0, 0),
+		NewScrypt(0, 0, 0),
+		NewPBKDF2(PBKDF2SHA256, 0),
+	), nil
+}
+
+func randomSalt(length int) ([]byte, error) {
+	salt := make([]byte, length)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, errors.ThrowInternal(err, "CRYPT-Ph9x3", "unable to generate salt")
+	}
+	return salt, nil
+}
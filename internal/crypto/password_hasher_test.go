@@ -0,0 +1,189 @@
+package crypto
+
+import (
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	// generated with the reference implementations (python hashlib, phc-winner-argon2)
+	testPBKDF2SHA256Hash = "$pbkdf2-sha256$i=1000$c2FsdHNhbHRzYWx0c2FsdA$8nX7hwFEzIB8aPajJTYK8weHQc5Ngz0pFVAKvSu4jQA"
+	testPBKDF2SHA512Hash = "$pbkdf2-sha512$i=1000$c2FsdHNhbHRzYWx0c2FsdA$715rqIr5dXOVPpBhqqsugl037zT5bWJTWYmZtIcK8hBnisKpwfY7kokvwjDrNHqHhF50Pb7MD6HvkJwiDQw4ww"
+	testScryptHash       = "$scrypt$ln=4,r=8,p=1$c2FsdHNhbHRzYWx0c2FsdA$5f/Vi+XRWGUNGScbsma6KJ4zLFIke/NJsrvr7lQLAyA"
+	testArgon2idHash     = "$argon2id$v=19$m=64,t=2,p=1$c29tZXNhbHQ$Bo1ismRVk2qm6+YAYLCmWHDb+j3fjUH3"
+)
+
+func testPasswordHasher(t *testing.T, config PasswordHashConfig) *PasswordHasher {
+	t.Helper()
+	hasher, err := config.NewHasher()
+	if err != nil {
+		t.Fatalf("unable to create hasher: %v", err)
+	}
+	return hasher
+}
+
+func TestPasswordHasher_CompareHash(t *testing.T) {
+	bcryptHash, err := bcrypt.GenerateFromPassword([]byte("password"), 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hasher := testPasswordHasher(t, PasswordHashConfig{Algorithm: PasswordHashAlgorithmBCrypt, Cost: 4})
+	tests := []struct {
+		name      string
+		hashed    string
+		password  string
+		algorithm string
+		wantErr   bool
+	}{
+		{"bcrypt", string(bcryptHash), "password", "bcrypt", false},
+		{"bcrypt wrong password", string(bcryptHash), "wrong", "bcrypt", true},
+		{"pbkdf2 sha256", testPBKDF2SHA256Hash, "password", "pbkdf2", false},
+		{"pbkdf2 sha256 padded", "$pbkdf2-sha256$i=27500$c2FsdHNhbHRzYWx0c2FsdA==$FLAKrylANDgDgtPBQiUvp4/v52EpM6tnb3b2ZEP26EQ=", "password", "pbkdf2", false},
+		{"pbkdf2 sha512", testPBKDF2SHA512Hash, "password", "pbkdf2", false},
+		{"pbkdf2 wrong password", testPBKDF2SHA256Hash, "wrong", "pbkdf2", true},
+		{"scrypt", testScryptHash, "password", "scrypt", false},
+		{"scrypt wrong password", testScryptHash, "wrong", "scrypt", true},
+		{"argon2id", testArgon2idHash, "password", "argon2id", false},
+		{"argon2id wrong password", testArgon2idHash, "wrong", "argon2id", true},
+		{"unsupported", "$md5$c2FsdA$aGFzaA", "password", "", true},
+		{"malformed", "$argon2id$v=19$m=64$c29tZXNhbHQ", "password", "argon2id", true},
+		{"argon2id time zero", "$argon2id$v=19$m=64,t=0,p=1$c29tZXNhbHQ$Bo1ismRVk2qm6+YAYLCmWHDb+j3fjUH3", "password", "argon2id", true},
+		{"argon2id threads zero", "$argon2id$v=19$m=64,t=2,p=0$c29tZXNhbHQ$Bo1ismRVk2qm6+YAYLCmWHDb+j3fjUH3", "password", "argon2id", true},
+		{"scrypt cost too high", "$scrypt$ln=31,r=8,p=1$c2FsdHNhbHRzYWx0c2FsdA$5f/Vi+XRWGUNGScbsma6KJ4zLFIke/NJsrvr7lQLAyA", "password", "scrypt", true},
+		{"pbkdf2 iterations too high", "$pbkdf2-sha256$i=2147483647$c2FsdHNhbHRzYWx0c2FsdA$8nX7hwFEzIB8aPajJTYK8weHQc5Ngz0pFVAKvSu4jQA", "password", "pbkdf2", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			algorithm, ok := hasher.Identify([]byte(tt.hashed))
+			if algorithm != tt.algorithm || ok != (tt.algorithm != "") {
+				t.Errorf("Identify() = %q, %v, want %q", algorithm, ok, tt.algorithm)
+			}
+			if err := hasher.CompareHash([]byte(tt.hashed), []byte(tt.password)); (err != nil) != tt.wantErr {
+				t.Errorf("CompareHash() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPasswordHasher_Hash(t *testing.T) {
+	tests := []struct {
+		name   string
+		config PasswordHashConfig
+	}{
+		{"bcrypt", PasswordHashConfig{Algorithm: PasswordHashAlgorithmBCrypt, Cost: 4}},
+		{"argon2id", PasswordHashConfig{Algorithm: PasswordHashAlgorithmArgon2id, Iterations: 1, Memory: 64, Parallelism: 1}},
+		{"scrypt", PasswordHashConfig{Algorithm: PasswordHashAlgorithmScrypt, Cost: 4, BlockSize: 8, Parallelism: 1}},
+		{"pbkdf2", PasswordHashConfig{Algorithm: PasswordHashAlgorithmPBKDF2, Iterations: 1000, Hash: PBKDF2SHA256}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hasher := testPasswordHasher(t, tt.config)
+			hashed, err := Hash([]byte("password"), hasher)
+			if err != nil {
+				t.Fatalf("Hash() error = %v", err)
+			}
+			if hashed.Algorithm != tt.config.Algorithm {
+				t.Errorf("Hash() algorithm = %q, want %q", hashed.Algorithm, tt.config.Algorithm)
+			}
+			if err = CompareHash(hashed, []byte("password"), hasher); err != nil {
+				t.Errorf("CompareHash() error = %v", err)
+			}
+			if err = CompareHash(hashed, []byte("wrong"), hasher); err == nil {
+				t.Error("CompareHash() expected error on wrong password")
+			}
+			if NeedsRehash(hashed, hasher) {
+				t.Error("NeedsRehash() of own hash must be false")
+			}
+		})
+	}
+}
+
+func TestNeedsRehash(t *testing.T) {
+	bcryptHash, err := bcrypt.GenerateFromPassword([]byte("password"), 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name   string
+		config PasswordHashConfig
+		hashed string
+		want   bool
+	}{
+		{"bcrypt same cost", PasswordHashConfig{Algorithm: PasswordHashAlgorithmBCrypt, Cost: 4}, string(bcryptHash), false},
+		{"bcrypt higher cost", PasswordHashConfig{Algorithm: PasswordHashAlgorithmBCrypt, Cost: 5}, string(bcryptHash), true},
+		{"other algorithm", PasswordHashConfig{Algorithm: PasswordHashAlgorithmArgon2id, Iterations: 2, Memory: 64, Parallelism: 1}, string(bcryptHash), true},
+		{"pbkdf2 other hash", PasswordHashConfig{Algorithm: PasswordHashAlgorithmPBKDF2, Iterations: 1000, Hash: PBKDF2SHA512}, testPBKDF2SHA256Hash, true},
+		{"pbkdf2 same parameters", PasswordHashConfig{Algorithm: PasswordHashAlgorithmPBKDF2, Iterations: 1000, Hash: PBKDF2SHA256}, testPBKDF2SHA256Hash, false},
+		{"scrypt other cost", PasswordHashConfig{Algorithm: PasswordHashAlgorithmScrypt, Cost: 5, BlockSize: 8, Parallelism: 1}, testScryptHash, true},
+		// the reference hash has a key length of 24 bytes instead of 32
+		{"argon2id other key length", PasswordHashConfig{Algorithm: PasswordHashAlgorithmArgon2id, Iterations: 2, Memory: 64, Parallelism: 1}, testArgon2idHash, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hasher := testPasswordHasher(t, tt.config)
+			value := &CryptoValue{CryptoType: TypeHash, Crypted: []byte(tt.hashed)}
+			if got := NeedsRehash(value, hasher); got != tt.want {
+				t.Errorf("NeedsRehash() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPasswordHashConfig_NewHasher(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  PasswordHashConfig
+		wantErr bool
+	}{
+		{"default bcrypt", PasswordHashConfig{Cost: 4}, false},
+		{"unknown algorithm", PasswordHashConfig{Algorithm: "md5"}, true},
+		{"unknown pbkdf2 hash", PasswordHashConfig{Algorithm: PasswordHashAlgorithmPBKDF2, Iterations: 1000, Hash: "md5"}, true},
+		{"bcrypt cost too high", PasswordHashConfig{Algorithm: PasswordHashAlgorithmBCrypt, Cost: 31}, true},
+		{"argon2id without threads", PasswordHashConfig{Algorithm: PasswordHashAlgorithmArgon2id, Iterations: 1, Memory: 64}, true},
+		{"scrypt cost too high", PasswordHashConfig{Algorithm: PasswordHashAlgorithmScrypt, Cost: 31, BlockSize: 8, Parallelism: 1}, true},
+		{"pbkdf2 without iterations", PasswordHashConfig{Algorithm: PasswordHashAlgorithmPBKDF2, Hash: PBKDF2SHA256}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.config.NewHasher(); (err != nil) != tt.wantErr {
+				t.Errorf("NewHasher() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestIdentifyHash(t *testing.T) {
+	hasher := testPasswordHasher(t, PasswordHashConfig{Algorithm: PasswordHashAlgorithmBCrypt, Cost: 4})
+	tests := []struct {
+		name      string
+		hashed    string
+		algorithm string
+		wantErr   bool
+	}{
+		{"scrypt", testScryptHash, "scrypt", false},
+		{"argon2id", testArgon2idHash, "argon2id", false},
+		{"unsupported", "plain", "", true},
+		{"malformed", "$argon2id$v=19$m=64$c29tZXNhbHQ", "", true},
+		{"argon2id time zero", "$argon2id$v=19$m=64,t=0,p=1$c29tZXNhbHQ$Bo1ismRVk2qm6+YAYLCmWHDb+j3fjUH3", "", true},
+		{"argon2id threads zero", "$argon2id$v=19$m=64,t=2,p=0$c29tZXNhbHQ$Bo1ismRVk2qm6+YAYLCmWHDb+j3fjUH3", "", true},
+		{"argon2id memory too high", "$argon2id$v=19$m=4294967295,t=2,p=1$c29tZXNhbHQ$Bo1ismRVk2qm6+YAYLCmWHDb+j3fjUH3", "", true},
+		{"argon2id time too high", "$argon2id$v=19$m=64,t=4294967295,p=1$c29tZXNhbHQ$Bo1ismRVk2qm6+YAYLCmWHDb+j3fjUH3", "", true},
+		{"scrypt cost too high", "$scrypt$ln=31,r=8,p=1$c2FsdHNhbHRzYWx0c2FsdA$5f/Vi+XRWGUNGScbsma6KJ4zLFIke/NJsrvr7lQLAyA", "", true},
+		{"scrypt block size too high", "$scrypt$ln=4,r=1073741823,p=1$c2FsdHNhbHRzYWx0c2FsdA$5f/Vi+XRWGUNGScbsma6KJ4zLFIke/NJsrvr7lQLAyA", "", true},
+		{"scrypt parallelism zero", "$scrypt$ln=4,r=8,p=0$c2FsdHNhbHRzYWx0c2FsdA$5f/Vi+XRWGUNGScbsma6KJ4zLFIke/NJsrvr7lQLAyA", "", true},
+		{"pbkdf2 iterations too high", "$pbkdf2-sha256$i=2147483647$c2FsdHNhbHRzYWx0c2FsdA$8nX7hwFEzIB8aPajJTYK8weHQc5Ngz0pFVAKvSu4jQA", "", true},
+		{"bcrypt cost too high", "$2a$31$N9qo8uLOickgx2ZMRZoMyeIjZAgcfl7p92ldGxad68LJZdL17lhWy", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, err := IdentifyHash(&CryptoValue{CryptoType: TypeHash, Crypted: []byte(tt.hashed)}, hasher)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("IdentifyHash() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && value.Algorithm != tt.algorithm {
+				t.Errorf("IdentifyHash() algorithm = %q, want %q", value.Algorithm, tt.algorithm)
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package crypto
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/subtle"
+	"fmt"
+	"hash"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/pbkdf2"
+
+	"github.com/dennigogo/zitadel/internal/errors"
+)
+
+var _ PasswordHashAlgorithm = (*PBKDF2)(nil)
+
+const (
+	pbkdf2Algorithm  = "pbkdf2"
+	pbkdf2SaltLength = 16
+	// pbkdf2MaxIterations prevents imported hashes from exhausting the cpu on verification
+	pbkdf2MaxIterations = 2_000_000
+)
+
+type PBKDF2Hash string
+
+const (
+	PBKDF2SHA1   PBKDF2Hash = "sha1"
+	PBKDF2SHA256 PBKDF2Hash = "sha256"
+	PBKDF2SHA512 PBKDF2Hash = "sha512"
+)
+
+var pbkdf2Hashes = map[PBKDF2Hash]struct {
+	new    func() hash.Hash
+	keyLen int
+}{
+	PBKDF2SHA1:   {new: sha1.New, keyLen: sha1.Size},
+	PBKDF2SHA256: {new: sha256.New, keyLen: sha256.Size},
+	PBKDF2SHA512: {new: sha512.New, keyLen: sha512.Size},
+}
+
+type PBKDF2 struct {
+	hash       PBKDF2Hash
+	iterations int
+}
+
+func NewPBKDF2(hash PBKDF2Hash, iterations int) *PBKDF2 {
+	return &PBKDF2{
+		hash:       hash,
+		iterations: iterations,
+	}
+}
+
+func (p *PBKDF2) Algorithm() string {
+	return pbkdf2Algorithm
+}
+
+func (p *PBKDF2) Hash(value []byte) ([]byte, error) {
+	hashFunc, ok := pbkdf2Hashes[p.hash]
+	if !ok {
+		return nil, errors.ThrowInternal(nil, "CRYPT-Pb3k8", "pbkdf2 hash function not supported")
+	}
+	salt, err := randomSalt(pbkdf2SaltLength)
+	if err != nil {
+		return nil, err
+	}
+	key := pbkdf2.Key(value, salt, p.iterations, hashFunc.keyLen, hashFunc.new)
+	return []byte(fmt.Sprintf("$%s-%s$i=%d$%s$%s", pbkdf2Algorithm, p.hash, p.iterations, encodePHCBase64(salt), encodePHCBase64(key))), nil
+}
+
+func (p *PBKDF2) CompareHash(hashed, value []byte) error {
+	h, params, err := parsePBKDF2(hashed)
+	if err != nil {
+		return err
+	}
+	key := pbkdf2.Key(value, h.salt, params.iterations, len(h.hash), pbkdf2Hashes[params.hash].new)
+	if subtle.ConstantTimeCompare(key, h.hash) != 1 {
+		return errors.ThrowInvalidArgument(nil, "CRYPT-Pb9s2", "hash does not match")
+	}
+	return nil
+}
+
+func (p *PBKDF2) Identifies(encoded []byte) bool {
+	for hash := range pbkdf2Hashes {
+		if bytes.HasPrefix(encoded, []byte(phcSeparator+pbkdf2Algorithm+"-"+string(hash)+phcSeparator)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *PBKDF2) Validate(encoded []byte) error {
+	_, _, err := parsePBKDF2(encoded)
+	return err
+}
+
+func (p *PBKDF2) NeedsRehash(encoded []byte) bool {
+	h, params, err := parsePBKDF2(encoded)
+	if err != nil {
+		return true
+	}
+	return *params != *p || len(h.hash) != pbkdf2Hashes[p.hash].keyLen
+}
+
+func parsePBKDF2(encoded []byte) (*phcHash, *PBKDF2, error) {
+	h, err := parsePHC(encoded)
+	if err != nil {
+		return nil, nil, err
+	}
+	hash := PBKDF2Hash(strings.TrimPrefix(h.id, pbkdf2Algorithm+"-"))
+	if _, ok := pbkdf2Hashes[hash]; !ok || !strings.HasPrefix(h.id, pbkdf2Algorithm+"-") {
+		return nil, nil, errors.ThrowInvalidArgument(nil, "CRYPT-Pb5d1", "hash is not a supported pbkdf2 hash")
+	}
+	iterations, err := strconv.Atoi(h.params["i"])
+	if err != nil {
+		return nil, nil, errors.ThrowInvalidArgument(err, "CRYPT-Pb7i4", "invalid pbkdf2 iterations parameter")
+	}
+	params := NewPBKDF2(hash, iterations)
+	if err = params.validate(); err != nil {
+		return nil, nil, err
+	}
+	return h, params, nil
+}
+
+func (p *PBKDF2) validate() error {
+	if p.iterations < 1 || p.iterations > pbkdf2MaxIterations {
+		return errors.ThrowInvalidArgument(nil, "CRYPT-Pb2b9", "pbkdf2 iterations parameter out of range")
+	}
+	return nil
+}
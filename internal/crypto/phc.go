@@ -0,0 +1,72 @@
+package crypto
+
+import (
+	"encoding/base64"
+	"strings"
+
+	"github.com/dennigogo/zitadel/internal/errors"
+)
+
+const (
+	phcSeparator = "$"
+	// phcMaxHashLength limits the length of the derived key,
+	// as the cost of some algorithms (e.g. pbkdf2) grows with it
+	phcMaxHashLength = 64
+)
+
+// phcHash represents a hash in the PHC string format:
+// $<id>[$v=<version>][$<param>=<value>(,<param>=<value>)*][$<salt>[$<hash>]]
+type phcHash struct {
+	id      string
+	version string
+	params  map[string]string
+	salt    []byte
+	hash    []byte
+}
+
+func parsePHC(encoded []byte) (*phcHash, error) {
+	parts := strings.Split(string(encoded), phcSeparator)
+	if len(parts) < 2 || parts[0] != "" || parts[1] == "" {
+		return nil, errors.ThrowInvalidArgument(nil, "CRYPT-Pk3s9", "hash is not in phc format")
+	}
+	h := &phcHash{
+		id:     parts[1],
+		params: make(map[string]string),
+	}
+	parts = parts[2:]
+	if len(parts) > 0 && strings.HasPrefix(parts[0], "v=") {
+		h.version = strings.TrimPrefix(parts[0], "v=")
+		parts = parts[1:]
+	}
+	if len(parts) > 0 && strings.Contains(parts[0], "=") {
+		for _, param := range strings.Split(parts[0], ",") {
+			key, value, ok := strings.Cut(param, "=")
+			if !ok {
+				return nil, errors.ThrowInvalidArgument(nil, "CRYPT-Pm2d8", "hash contains invalid parameters")
+			}
+			h.params[key] = value
+		}
+		parts = parts[1:]
+	}
+	if len(parts) != 2 {
+		return nil, errors.ThrowInvalidArgument(nil, "CRYPT-Pw8c1", "hash must contain salt and hash")
+	}
+	var err error
+	if h.salt, err = decodePHCBase64(parts[0]); err != nil {
+		return nil, errors.ThrowInvalidArgument(err, "CRYPT-Pq4n6", "unable to decode salt")
+	}
+	if h.hash, err = decodePHCBase64(parts[1]); err != nil || len(h.hash) == 0 || len(h.hash) > phcMaxHashLength {
+		return nil, errors.ThrowInvalidArgument(err, "CRYPT-Pz7v2", "unable to decode hash")
+	}
+	return h, nil
+}
+
+// decodePHCBase64 decodes the value as specified in the phc format (without padding)
+// padded values are accepted as well because most exports of other systems are padded
+func decodePHCBase64(value string) ([]byte, error) {
+	return base64.RawStdEncoding.DecodeString(strings.TrimRight(value, "="))
+}
+
+func encodePHCBase64(value []byte) string {
+	return base64.RawStdEncoding.EncodeToString(value)
+}
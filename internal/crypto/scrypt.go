@@ -0,0 +1,130 @@
+package crypto
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"fmt"
+	"strconv"
+
+	"golang.org/x/crypto/scrypt"
+
+	"github.com/dennigogo/zitadel/internal/errors"
+)
+
+var _ PasswordHashAlgorithm = (*Scrypt)(nil)
+
+const (
+	scryptID         = "scrypt"
+	scryptSaltLength = 16
+	scryptKeyLength  = 32
+	// the upper bounds prevent imported hashes from exhausting memory and cpu on verification
+	scryptMaxCost        = 20
+	scryptMaxMemory      = 256 * 1024 * 1024
+	scryptMaxParallelism = 16
+)
+
+type Scrypt struct {
+	cost        int
+	blockSize   int
+	parallelism int
+}
+
+// NewScrypt creates a scrypt hasher
+// cost is the base 2 logarithm of the cpu/memory cost parameter N
+func NewScrypt(cost, blockSize, parallelism int) *Scrypt {
+	return &Scrypt{
+		cost:        cost,
+		blockSize:   blockSize,
+		parallelism: parallelism,
+	}
+}
+
+func (s *Scrypt) Algorithm() string {
+	return scryptID
+}
+
+func (s *Scrypt) Hash(value []byte) ([]byte, error) {
+	salt, err := randomSalt(scryptSaltLength)
+	if err != nil {
+		return nil, err
+	}
+	key, err := scrypt.Key(value, salt, 1<<s.cost, s.blockSize, s.parallelism, scryptKeyLength)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(fmt.Sprintf("$%s$ln=%d,r=%d,p=%d$%s$%s", scryptID, s.cost, s.blockSize, s.parallelism, encodePHCBase64(salt), encodePHCBase64(key))), nil
+}
+
+func (s *Scrypt) CompareHash(hashed, value []byte) error {
+	h, params, err := parseScrypt(hashed)
+	if err != nil {
+		return err
+	}
+	key, err := scrypt.Key(value, h.salt, 1<<params.cost, params.blockSize, params.parallelism, len(h.hash))
+	if err != nil {
+		return err
+	}
+	if subtle.ConstantTimeCompare(key, h.hash) != 1 {
+		return errors.ThrowInvalidArgument(nil, "CRYPT-Sc4m8", "hash does not match")
+	}
+	return nil
+}
+
+func (s *Scrypt) Identifies(encoded []byte) bool {
+	return bytes.HasPrefix(encoded, []byte(phcSeparator+scryptID+phcSeparator))
+}
+
+func (s *Scrypt) Validate(encoded []byte) error {
+	_, _, err := parseScrypt(encoded)
+	return err
+}
+
+func (s *Scrypt) NeedsRehash(encoded []byte) bool {
+	h, params, err := parseScrypt(encoded)
+	if err != nil {
+		return true
+	}
+	return *params != *s || len(h.hash) != scryptKeyLength
+}
+
+func parseScrypt(encoded []byte) (*phcHash, *Scrypt, error) {
+	h, err := parsePHC(encoded)
+	if err != nil {
+		return nil, nil, err
+	}
+	if h.id != scryptID {
+		return nil, nil, errors.ThrowInvalidArgument(nil, "CRYPT-Sd8f2", "hash is not a scrypt hash")
+	}
+	cost, err := strconv.Atoi(h.params["ln"])
+	if err != nil {
+		return nil, nil, errors.ThrowInvalidArgument(err, "CRYPT-Sl2k9", "invalid scrypt cost parameter")
+	}
+	blockSize, err := strconv.Atoi(h.params["r"])
+	if err != nil {
+		return nil, nil, errors.ThrowInvalidArgument(err, "CRYPT-Sr6n3", "invalid scrypt block size parameter")
+	}
+	parallelism, err := strconv.Atoi(h.params["p"])
+	if err != nil {
+		return nil, nil, errors.ThrowInvalidArgument(err, "CRYPT-Sp1x7", "invalid scrypt parallelism parameter")
+	}
+	params := NewScrypt(cost, blockSize, parallelism)
+	if err = params.validate(); err != nil {
+		return nil, nil, err
+	}
+	return h, params, nil
+}
+
+// validate ensures the parameters are accepted by scrypt and within the supported bounds
+// the memory used by scrypt is 128 * N * r bytes
+func (s *Scrypt) validate() error {
+	if s.cost < 1 || s.cost > scryptMaxCost {
+		return errors.ThrowInvalidArgument(nil, "CRYPT-Sl5b3", "scrypt cost parameter out of range")
+	}
+	if s.blockSize < 1 || 128*(1<<s.cost)*s.blockSize > scryptMaxMemory {
+		return errors.ThrowInvalidArgument(nil, "CRYPT-Sr2b8", "scrypt block size parameter out of range")
+	}
+	if s.parallelism < 1 || s.parallelism > scryptMaxParallelism {
+		return errors.ThrowInvalidArgument(nil, "CRYPT-Sp6b4", "scrypt parallelism parameter out of range")
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package crypto
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+
+	"github.com/dennigogo/zitadel/internal/errors"
+)
+
+var _ HashAlgorithm = (*SHA256)(nil)
+
+const sha256Algorithm = "sha256"
+
+// SHA256 hashes without salt and must therefore only be used
+// for random values with high entropy (e.g. recovery codes), never for passwords
+type SHA256 struct{}
+
+func NewSHA256() *SHA256 {
+	return &SHA256{}
+}
+
+func (h *SHA256) Algorithm() string {
+	return sha256Algorithm
+}
+
+func (h *SHA256) Hash(value []byte) ([]byte, error) {
+	hashed := sha256.Sum256(value)
+	return hashed[:], nil
+}
+
+func (h *SHA256) CompareHash(hashed, comparer []byte) error {
+	compared := sha256.Sum256(comparer)
+	if subtle.ConstantTimeCompare(hashed, compared[:]) != 1 {
+		return errors.ThrowInvalidArgument(nil, "CRYPT-Sh2c5", "hash mismatch")
+	}
+	return nil
+}
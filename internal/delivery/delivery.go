@@ -0,0 +1,197 @@
+package delivery
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/dennigogo/zitadel/internal/actions"
+	"github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/eventstore"
+	"github.com/dennigogo/zitadel/internal/eventstore/handler"
+	"github.com/dennigogo/zitadel/internal/eventstore/handler/crdb"
+)
+
+const (
+	Table = "system.deliveries"
+
+	InstanceIDCol   = "instance_id"
+	KindCol         = "kind"
+	IDCol           = "id"
+	CreationDateCol = "creation_date"
+	PayloadCol      = "payload"
+	AttemptsCol     = "attempts"
+	NextAttemptCol  = "next_attempt"
+
+	defaultTimeout    = 10 * time.Second
+	defaultMaxBackoff = time.Hour
+	defaultInterval   = time.Second
+	defaultBatchSize  = 100
+)
+
+// Kind distinguishes the deliveries of the different features (e.g. webhooks and back-channel logouts)
+// each kind is sent by its own worker
+type Kind string
+
+// Config of the delivery of a kind
+type Config struct {
+	// Timeout of a single delivery attempt
+	Timeout time.Duration
+	// MaxAttempts until the delivery is given up
+	MaxAttempts uint8
+	// InitialBackoff is doubled after each failed attempt up to the MaxBackoff
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// Interval in which the due deliveries are sent
+	Interval time.Duration
+	// BatchSize is the maximum amount of deliveries sent per interval
+	BatchSize uint16
+}
+
+// Delivery is a pending delivery of the queue
+type Delivery struct {
+	InstanceID   string
+	ID           string
+	CreationDate time.Time
+	Payload      []byte
+	// Attempts which already failed
+	Attempts uint32
+}
+
+// Result of an attempt of a delivery
+type Result struct {
+	// Attempts including the current one, it's not increased if nothing was sent
+	Attempts   uint32
+	StatusCode int
+	Err        error
+	// Final is true if the delivery succeeded or was given up,
+	// otherwise it's attempted again after the backoff
+	Final bool
+}
+
+func (r *Result) Succeeded() bool {
+	return r.Err == nil
+}
+
+// Handler creates the requests of the deliveries of a kind and processes their results
+type Handler interface {
+	// Request is called for every attempt, so signatures and tokens are issued at the time they are sent.
+	// The delivery is given up if a not found, precondition failed, invalid argument or permission denied error is returned,
+	// other errors are retried.
+	Request(ctx context.Context, delivery *Delivery) (*http.Request, error)
+	// Attempted is called with the result of every attempt
+	Attempted(ctx context.Context, delivery *Delivery, result *Result) error
+}
+
+// AddEnqueueStatement adds a delivery to the queue in the transaction of the projection,
+// so it's sent asynchronously once the statement is committed.
+// An existing delivery with the same id is kept, so it's not sent twice if the event is reduced again.
+func AddEnqueueStatement(kind Kind, id string, payload []byte) func(eventstore.Event) crdb.Exec {
+	return func(event eventstore.Event) crdb.Exec {
+		return func(ex handler.Executer, _ string) error {
+			_, err := ex.Exec(enqueueStmt,
+				event.Aggregate().InstanceID,
+				kind,
+				id,
+				event.CreationDate(),
+				payload,
+			)
+			if err != nil {
+				return errors.ThrowInternal(err, "DELIV-Eq2n5", "unable to enqueue delivery")
+			}
+			return nil
+		}
+	}
+}
+
+const enqueueStmt = "INSERT INTO " + Table + " (" +
+	InstanceIDCol + ", " + KindCol + ", " + IDCol + ", " + CreationDateCol + ", " + PayloadCol + ", " + NextAttemptCol +
+	") VALUES ($1, $2, $3, $4, $5, $4) ON CONFLICT (" + InstanceIDCol + ", " + KindCol + ", " + IDCol + ") DO NOTHING"
+
+// Retryable returns if the delivery should be attempted again
+// network errors (no status code), server errors, timeouts and rate limits are retried
+func Retryable(statusCode int) bool {
+	return statusCode == 0 ||
+		statusCode >= 500 ||
+		statusCode == http.StatusRequestTimeout ||
+		statusCode == http.StatusTooManyRequests
+}
+
+// Backoff returns the wait time after the failed attempts,
+// it grows exponentially from the initial up to the max backoff
+func Backoff(config Config, attempts uint32) time.Duration {
+	maxBackoff := config.MaxBackoff
+	if maxBackoff <= 0 {
+		maxBackoff = defaultMaxBackoff
+	}
+	backoff := config.InitialBackoff
+	for i := uint32(1); i < attempts && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxBackoff {
+		return maxBackoff
+	}
+	return backoff
+}
+
+func newClient(timeout time.Duration) *http.Client {
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	return &http.Client{
+		Timeout: timeout,
+		// redirects are not followed, the target might not pass the deny list
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// send executes a single attempt, every 2xx status code is a success
+func send(client *http.Client, req *http.Request) (int, error) {
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, errors.ThrowUnavailable(err, "DELIV-Sd4m1", "unable to send request")
+	}
+	defer resp.Body.Close()
+	// drain the body so the connection can be reused
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, errors.ThrowUnavailablef(nil, "DELIV-Sd7k3", "unexpected status code %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// attempt sends the delivery once, requests to denied hosts are not sent and given up
+func attempt(ctx context.Context, client *http.Client, h Handler, d *Delivery, maxAttempts uint32) *Result {
+	result := &Result{Attempts: d.Attempts, Final: true}
+	req, err := h.Request(ctx, d)
+	if err != nil {
+		result.Err = err
+		if permanent(err) {
+			return result
+		}
+		// e.g. the database is not reachable, the delivery is retried like a failed attempt
+		result.Attempts++
+		result.Final = result.Attempts >= maxAttempts
+		return result
+	}
+	if actions.IsHostBlocked(req.URL) {
+		result.Err = errors.ThrowPermissionDenied(nil, "DELIV-At3b8", "host is denied")
+		return result
+	}
+	result.Attempts++
+	result.StatusCode, result.Err = send(client, req)
+	result.Final = result.Err == nil || !Retryable(result.StatusCode) || result.Attempts >= maxAttempts
+	return result
+}
+
+// permanent returns if the request can't be created on a later attempt either
+// (e.g. the target was removed or is invalid)
+func permanent(err error) bool {
+	return errors.IsNotFound(err) ||
+		errors.IsPreconditionFailed(err) ||
+		errors.IsErrorInvalidArgument(err) ||
+		errors.IsPermissionDenied(err)
+}
@@ -0,0 +1,220 @@
+package delivery
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dennigogo/zitadel/internal/actions"
+	"github.com/dennigogo/zitadel/internal/errors"
+)
+
+type testHandler struct {
+	url        string
+	requestErr error
+	results    []*Result
+}
+
+func (h *testHandler) Request(ctx context.Context, _ *Delivery) (*http.Request, error) {
+	if h.requestErr != nil {
+		return nil, h.requestErr
+	}
+	return http.NewRequestWithContext(ctx, http.MethodPost, h.url, nil)
+}
+
+func (h *testHandler) Attempted(_ context.Context, _ *Delivery, result *Result) error {
+	h.results = append(h.results, result)
+	return nil
+}
+
+func Test_attempt(t *testing.T) {
+	type args struct {
+		statusCode  int
+		requestErr  error
+		attempts    uint32
+		maxAttempts uint32
+	}
+	tests := []struct {
+		name         string
+		args         args
+		wantAttempts uint32
+		wantFinal    bool
+		wantErr      bool
+		wantCalled   bool
+	}{
+		{
+			name: "delivered",
+			args: args{
+				statusCode:  http.StatusNoContent,
+				maxAttempts: 3,
+			},
+			wantAttempts: 1,
+			wantFinal:    true,
+			wantCalled:   true,
+		},
+		{
+			name: "server error, retried",
+			args: args{
+				statusCode:  http.StatusServiceUnavailable,
+				maxAttempts: 3,
+			},
+			wantAttempts: 1,
+			wantErr:      true,
+			wantCalled:   true,
+		},
+		{
+			name: "server error, attempts exhausted",
+			args: args{
+				statusCode:  http.StatusBadGateway,
+				attempts:    2,
+				maxAttempts: 3,
+			},
+			wantAttempts: 3,
+			wantFinal:    true,
+			wantErr:      true,
+			wantCalled:   true,
+		},
+		{
+			name: "client error, not retried",
+			args: args{
+				statusCode:  http.StatusBadRequest,
+				maxAttempts: 3,
+			},
+			wantAttempts: 1,
+			wantFinal:    true,
+			wantErr:      true,
+			wantCalled:   true,
+		},
+		{
+			name: "target removed, given up",
+			args: args{
+				requestErr:  errors.ThrowNotFound(nil, "DELIV-Test1", "not found"),
+				maxAttempts: 3,
+			},
+			wantAttempts: 0,
+			wantFinal:    true,
+			wantErr:      true,
+		},
+		{
+			name: "request failed, retried",
+			args: args{
+				requestErr:  errors.ThrowInternal(nil, "DELIV-Test2", "internal"),
+				maxAttempts: 3,
+			},
+			wantAttempts: 1,
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var called bool
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				called = true
+				w.WriteHeader(tt.args.statusCode)
+			}))
+			defer server.Close()
+
+			h := &testHandler{url: server.URL, requestErr: tt.args.requestErr}
+			got := attempt(context.Background(), newClient(time.Second), h, &Delivery{Attempts: tt.args.attempts}, tt.args.maxAttempts)
+			assert.Equal(t, tt.wantAttempts, got.Attempts)
+			assert.Equal(t, tt.wantFinal, got.Final)
+			assert.Equal(t, tt.wantErr, got.Err != nil)
+			assert.Equal(t, tt.wantCalled, called)
+		})
+	}
+}
+
+func Test_attempt_denied(t *testing.T) {
+	var called bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer server.Close()
+
+	checker, err := actions.NewIPChecker("127.0.0.1")
+	require.NoError(t, err)
+	actions.SetHTTPConfig(&actions.HTTPConfig{DenyList: []actions.AddressChecker{checker}})
+	defer actions.SetHTTPConfig(nil)
+
+	got := attempt(context.Background(), newClient(time.Second), &testHandler{url: server.URL}, &Delivery{}, 3)
+	assert.True(t, got.Final)
+	assert.True(t, errors.IsPermissionDenied(got.Err))
+	assert.Equal(t, uint32(0), got.Attempts)
+	assert.False(t, called)
+}
+
+func TestBackoff(t *testing.T) {
+	config := Config{
+		InitialBackoff: time.Second,
+		MaxBackoff:     5 * time.Second,
+	}
+	tests := []struct {
+		attempts uint32
+		want     time.Duration
+	}{
+		{attempts: 1, want: time.Second},
+		{attempts: 2, want: 2 * time.Second},
+		{attempts: 3, want: 4 * time.Second},
+		{attempts: 4, want: 5 * time.Second},
+		{attempts: 255, want: 5 * time.Second},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, Backoff(config, tt.attempts), "attempts %d", tt.attempts)
+	}
+	assert.Equal(t, defaultMaxBackoff, Backoff(Config{InitialBackoff: time.Second}, 255))
+}
+
+func TestWorker_Trigger(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer client.Close()
+
+	h := &testHandler{url: server.URL}
+	w := NewWorker(client, "test", Config{MaxAttempts: 3, InitialBackoff: time.Minute, BatchSize: 10}, h)
+
+	mock.ExpectQuery(regexp.QuoteMeta("UPDATE system.deliveries SET next_attempt = $1 WHERE (instance_id, kind, id) IN (")).
+		WithArgs(sqlmock.AnyArg(), Kind("test"), sqlmock.AnyArg(), uint16(10)).
+		WillReturnRows(
+			sqlmock.NewRows([]string{InstanceIDCol, IDCol, CreationDateCol, PayloadCol, AttemptsCol}).
+				AddRow("instance1", "delivery1", time.Now(), []byte(`{}`), 0),
+		)
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE system.deliveries SET attempts = $1, next_attempt = $2")).
+		WithArgs(1, sqlmock.AnyArg(), "instance1", Kind("test"), "delivery1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	require.NoError(t, w.Trigger(context.Background()))
+	require.Len(t, h.results, 1)
+	assert.False(t, h.results[0].Final)
+
+	mock.ExpectQuery(regexp.QuoteMeta("UPDATE system.deliveries SET next_attempt = $1")).
+		WillReturnRows(
+			sqlmock.NewRows([]string{InstanceIDCol, IDCol, CreationDateCol, PayloadCol, AttemptsCol}).
+				AddRow("instance1", "delivery1", time.Now(), []byte(`{}`), 1),
+		)
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM system.deliveries")).
+		WithArgs("instance1", Kind("test"), "delivery1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	require.NoError(t, w.Trigger(context.Background()))
+	require.Len(t, h.results, 2)
+	assert.True(t, h.results[1].Succeeded())
+	assert.Equal(t, uint32(2), h.results[1].Attempts)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
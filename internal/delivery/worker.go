@@ -0,0 +1,154 @@
+package delivery
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/dennigogo/zitadel/internal/api/authz"
+	"github.com/dennigogo/zitadel/internal/errors"
+)
+
+// Worker sends the pending deliveries of a kind and retries the failed ones after the backoff.
+// The deliveries are leased while they are sent, so multiple instances of ZITADEL can run a worker of the same kind.
+type Worker struct {
+	client      *sql.DB
+	httpClient  *http.Client
+	kind        Kind
+	handler     Handler
+	config      Config
+	maxAttempts uint32
+}
+
+// Start sends the due deliveries of the kind every interval until the context is done
+func Start(ctx context.Context, client *sql.DB, kind Kind, config Config, handler Handler) *Worker {
+	w := NewWorker(client, kind, config, handler)
+	go w.run(ctx)
+	return w
+}
+
+func NewWorker(client *sql.DB, kind Kind, config Config, handler Handler) *Worker {
+	if config.Interval <= 0 {
+		config.Interval = defaultInterval
+	}
+	if config.BatchSize == 0 {
+		config.BatchSize = defaultBatchSize
+	}
+	maxAttempts := uint32(config.MaxAttempts)
+	if maxAttempts == 0 {
+		maxAttempts = 1
+	}
+	return &Worker{
+		client:      client,
+		httpClient:  newClient(config.Timeout),
+		kind:        kind,
+		handler:     handler,
+		config:      config,
+		maxAttempts: maxAttempts,
+	}
+}
+
+func (w *Worker) run(ctx context.Context) {
+	ticker := time.NewTicker(w.config.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := w.Trigger(ctx)
+			logging.WithFields("kind", w.kind).OnError(err).Warn("unable to send deliveries")
+		}
+	}
+}
+
+// Trigger sends a batch of the due deliveries concurrently
+func (w *Worker) Trigger(ctx context.Context) error {
+	deliveries, err := w.claim(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+	var wg sync.WaitGroup
+	for _, d := range deliveries {
+		wg.Add(1)
+		go func(d *Delivery) {
+			defer wg.Done()
+			w.deliver(ctx, d)
+		}(d)
+	}
+	wg.Wait()
+	return nil
+}
+
+func (w *Worker) deliver(ctx context.Context, d *Delivery) {
+	ctx = authz.WithInstanceID(ctx, d.InstanceID)
+	logger := logging.WithFields("kind", w.kind, "instance", d.InstanceID, "id", d.ID)
+
+	result := attempt(ctx, w.httpClient, w.handler, d, w.maxAttempts)
+	err := w.handler.Attempted(ctx, d, result)
+	logger.OnError(err).Warn("unable to process delivery result")
+
+	if result.Final {
+		err = w.remove(ctx, d)
+	} else {
+		err = w.reschedule(ctx, d, result.Attempts, time.Now().Add(Backoff(w.config, result.Attempts)))
+	}
+	logger.OnError(err).Warn("unable to update delivery")
+}
+
+// lease is the time a claimed delivery is reserved for the worker,
+// it's attempted again if the worker stopped before it was sent
+func (w *Worker) lease() time.Duration {
+	return 2*w.httpClient.Timeout + time.Minute
+}
+
+// claim leases the due deliveries by moving their next attempt after the lease.
+// The condition on the next attempt is checked again by the update,
+// so a delivery claimed concurrently by another worker is skipped.
+func (w *Worker) claim(ctx context.Context, now time.Time) ([]*Delivery, error) {
+	rows, err := w.client.QueryContext(ctx, claimStmt, now.Add(w.lease()), w.kind, now, w.config.BatchSize)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "DELIV-Cl3m8", "unable to claim deliveries")
+	}
+	defer rows.Close()
+	deliveries := make([]*Delivery, 0, w.config.BatchSize)
+	for rows.Next() {
+		d := new(Delivery)
+		if err = rows.Scan(&d.InstanceID, &d.ID, &d.CreationDate, &d.Payload, &d.Attempts); err != nil {
+			return nil, errors.ThrowInternal(err, "DELIV-Cl5s2", "unable to scan delivery")
+		}
+		deliveries = append(deliveries, d)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, errors.ThrowInternal(err, "DELIV-Cl7r4", "unable to claim deliveries")
+	}
+	return deliveries, nil
+}
+
+func (w *Worker) reschedule(ctx context.Context, d *Delivery, attempts uint32, next time.Time) error {
+	_, err := w.client.ExecContext(ctx, rescheduleStmt, attempts, next, d.InstanceID, w.kind, d.ID)
+	return err
+}
+
+func (w *Worker) remove(ctx context.Context, d *Delivery) error {
+	_, err := w.client.ExecContext(ctx, removeStmt, d.InstanceID, w.kind, d.ID)
+	return err
+}
+
+const (
+	claimStmt = "UPDATE " + Table + " SET " + NextAttemptCol + " = $1" +
+		" WHERE (" + InstanceIDCol + ", " + KindCol + ", " + IDCol + ") IN (" +
+		"SELECT " + InstanceIDCol + ", " + KindCol + ", " + IDCol + " FROM " + Table +
+		" WHERE " + KindCol + " = $2 AND " + NextAttemptCol + " <= $3" +
+		" ORDER BY " + NextAttemptCol + " LIMIT $4" +
+		") AND " + NextAttemptCol + " <= $3" +
+		" RETURNING " + InstanceIDCol + ", " + IDCol + ", " + CreationDateCol + ", " + PayloadCol + ", " + AttemptsCol
+	rescheduleStmt = "UPDATE " + Table + " SET " + AttemptsCol + " = $1, " + NextAttemptCol + " = $2" +
+		" WHERE " + InstanceIDCol + " = $3 AND " + KindCol + " = $4 AND " + IDCol + " = $5"
+	removeStmt = "DELETE FROM " + Table +
+		" WHERE " + InstanceIDCol + " = $1 AND " + KindCol + " = $2 AND " + IDCol + " = $3"
+)
@@ -45,3 +45,18 @@ const (
 	ActionsMaxAllowed
 	ActionsAllowedUnlimited
 )
+
+type ActionExecutionOutcome int32
+
+const (
+	ActionExecutionOutcomeUnspecified ActionExecutionOutcome = iota
+	ActionExecutionOutcomeSucceeded
+	ActionExecutionOutcomeFailed
+	// ActionExecutionOutcomeFailureIgnored is set if the action failed but is allowed to fail
+	ActionExecutionOutcomeFailureIgnored
+	actionExecutionOutcomeCount
+)
+
+func (o ActionExecutionOutcome) Valid() bool {
+	return o >= 0 && o < actionExecutionOutcomeCount
+}
@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"net/url"
 	"strings"
 	"time"
 
@@ -25,26 +26,30 @@ const (
 type OIDCApp struct {
 	models.ObjectRoot
 
-	AppID                    string
-	AppName                  string
-	ClientID                 string
-	ClientSecret             *crypto.CryptoValue
-	ClientSecretString       string
-	RedirectUris             []string
-	ResponseTypes            []OIDCResponseType
-	GrantTypes               []OIDCGrantType
-	ApplicationType          OIDCApplicationType
-	AuthMethodType           OIDCAuthMethodType
-	PostLogoutRedirectUris   []string
-	OIDCVersion              OIDCVersion
-	Compliance               *Compliance
-	DevMode                  bool
-	AccessTokenType          OIDCTokenType
-	AccessTokenRoleAssertion bool
-	IDTokenRoleAssertion     bool
-	IDTokenUserinfoAssertion bool
-	ClockSkew                time.Duration
-	AdditionalOrigins        []string
+	AppID                      string
+	AppName                    string
+	ClientID                   string
+	ClientSecret               *crypto.CryptoValue
+	ClientSecretString         string
+	RedirectUris               []string
+	ResponseTypes              []OIDCResponseType
+	GrantTypes                 []OIDCGrantType
+	ApplicationType            OIDCApplicationType
+	AuthMethodType             OIDCAuthMethodType
+	PostLogoutRedirectUris     []string
+	OIDCVersion                OIDCVersion
+	Compliance                 *Compliance
+	DevMode                    bool
+	AccessTokenType            OIDCTokenType
+	AccessTokenRoleAssertion   bool
+	IDTokenRoleAssertion       bool
+	IDTokenUserinfoAssertion   bool
+	ClockSkew                  time.Duration
+	AdditionalOrigins          []string
+	BackChannelLogoutURI       string
+	FrontChannelLogoutURI      string
+	RequirePushedAuthRequests  bool
+	RequireSignedRequestObject bool
 
 	State AppState
 }
@@ -89,6 +94,8 @@ const (
 	OIDCGrantTypeAuthorizationCode OIDCGrantType = iota
 	OIDCGrantTypeImplicit
 	OIDCGrantTypeRefreshToken
+	OIDCGrantTypeDeviceCode
+	OIDCGrantTypeTokenExchange
 )
 
 type OIDCApplicationType int32
@@ -121,7 +128,7 @@ const (
 )
 
 func (a *OIDCApp) IsValid() bool {
-	if a.ClockSkew > time.Second*5 || a.ClockSkew < time.Second*0 || !a.OriginsValid() {
+	if a.ClockSkew > time.Second*5 || a.ClockSkew < time.Second*0 || !a.OriginsValid() || !a.LogoutURIsValid() {
 		return false
 	}
 	grantTypes := a.getRequiredGrantTypes()
@@ -146,6 +153,24 @@ func (a *OIDCApp) OriginsValid() bool {
 	return true
 }
 
+// LogoutURIsValid checks that the back- and front-channel logout uris are absolute http(s) urls without a fragment
+func (a *OIDCApp) LogoutURIsValid() bool {
+	return IsValidLogoutURI(a.BackChannelLogoutURI) && IsValidLogoutURI(a.FrontChannelLogoutURI)
+}
+
+// IsValidLogoutURI returns true for an empty uri (no logout notification)
+// or an absolute http(s) url without a fragment
+func IsValidLogoutURI(uri string) bool {
+	if uri == "" {
+		return true
+	}
+	u, err := url.Parse(uri)
+	if err != nil {
+		return false
+	}
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != "" && u.Fragment == ""
+}
+
 func ContainsRequiredGrantTypes(responseTypes []OIDCResponseType, grantTypes []OIDCGrantType) bool {
 	required := RequiredOIDCGrantTypes(responseTypes)
 	return ContainsOIDCGrantTypes(required, grantTypes)
@@ -220,7 +245,9 @@ func GetOIDCV1Compliance(appType OIDCApplicationType, grantTypes []OIDCGrantType
 }
 
 func checkGrantTypesCombination(compliance *Compliance, grantTypes []OIDCGrantType) {
-	if containsOIDCGrantType(grantTypes, OIDCGrantTypeRefreshToken) && !containsOIDCGrantType(grantTypes, OIDCGrantTypeAuthorizationCode) {
+	if containsOIDCGrantType(grantTypes, OIDCGrantTypeRefreshToken) &&
+		!containsOIDCGrantType(grantTypes, OIDCGrantTypeAuthorizationCode) &&
+		!containsOIDCGrantType(grantTypes, OIDCGrantTypeDeviceCode) {
 		compliance.NoneCompliant = true
 		compliance.Problems = append(compliance.Problems, "Application.OIDC.V1.GrantType.Refresh.NoAuthCode")
 	}
@@ -46,6 +46,49 @@ func TestApplicationValid(t *testing.T) {
 			},
 			result: false,
 		},
+		{
+			name: "invalid back-channel logout uri with fragment",
+			args: args{
+				app: &OIDCApp{
+					ObjectRoot:           models.ObjectRoot{AggregateID: "AggregateID"},
+					AppID:                "AppID",
+					AppName:              "Name",
+					ResponseTypes:        []OIDCResponseType{OIDCResponseTypeCode},
+					GrantTypes:           []OIDCGrantType{OIDCGrantTypeAuthorizationCode},
+					BackChannelLogoutURI: "https://rp.example.com/logout#fragment",
+				},
+			},
+			result: false,
+		},
+		{
+			name: "invalid front-channel logout uri relative",
+			args: args{
+				app: &OIDCApp{
+					ObjectRoot:            models.ObjectRoot{AggregateID: "AggregateID"},
+					AppID:                 "AppID",
+					AppName:               "Name",
+					ResponseTypes:         []OIDCResponseType{OIDCResponseTypeCode},
+					GrantTypes:            []OIDCGrantType{OIDCGrantTypeAuthorizationCode},
+					FrontChannelLogoutURI: "/logout",
+				},
+			},
+			result: false,
+		},
+		{
+			name: "valid oidc application: logout uris",
+			args: args{
+				app: &OIDCApp{
+					ObjectRoot:            models.ObjectRoot{AggregateID: "AggregateID"},
+					AppID:                 "AppID",
+					AppName:               "Name",
+					ResponseTypes:         []OIDCResponseType{OIDCResponseTypeCode},
+					GrantTypes:            []OIDCGrantType{OIDCGrantTypeAuthorizationCode},
+					BackChannelLogoutURI:  "https://rp.example.com/backchannel?tenant=1",
+					FrontChannelLogoutURI: "https://rp.example.com/frontchannel",
+				},
+			},
+			result: true,
+		},
 		{
 			name: "valid oidc application: responsetype code",
 			args: args{
@@ -44,6 +44,7 @@ type AuthRequest struct {
 	LinkingUsers             []*ExternalUser
 	PossibleSteps            []NextStep
 	PasswordVerified         bool
+	PasswordExpiryWarned     bool
 	MFAsVerified             []MFAType
 	Audience                 []string
 	AuthTime                 time.Time
@@ -53,6 +54,7 @@ type AuthRequest struct {
 	LabelPolicy              *LabelPolicy
 	PrivacyPolicy            *PrivacyPolicy
 	LockoutPolicy            *LockoutPolicy
+	PasswordAgePolicy        *PasswordAgePolicy
 	DefaultTranslations      []*CustomText
 	OrgTranslations          []*CustomText
 }
@@ -105,6 +107,9 @@ const (
 	MFATypeOTP MFAType = iota
 	MFATypeU2F
 	MFATypeU2FUserVerification
+	MFATypeOTPEmail
+	MFATypeOTPSMS
+	MFATypeRecoveryCode
 )
 
 type MFALevel int
@@ -122,6 +127,8 @@ func NewAuthRequestFromType(requestType AuthRequestType) (*AuthRequest, error) {
 		return &AuthRequest{Request: &AuthRequestOIDC{}}, nil
 	case AuthRequestTypeSAML:
 		return &AuthRequest{Request: &AuthRequestSAML{}}, nil
+	case AuthRequestTypeDevice:
+		return &AuthRequest{Request: &AuthRequestDevice{}}, nil
 	}
 	return nil, errors.ThrowInvalidArgument(nil, "DOMAIN-ds2kl", "invalid request type")
 }
@@ -162,24 +169,27 @@ func (a *AuthRequest) AppendAudIfNotExisting(aud string) {
 }
 
 func (a *AuthRequest) GetScopeOrgPrimaryDomain() string {
-	switch request := a.Request.(type) {
-	case *AuthRequestOIDC:
-		for _, scope := range request.Scopes {
-			if strings.HasPrefix(scope, OrgDomainPrimaryScope) {
-				return strings.TrimPrefix(scope, OrgDomainPrimaryScope)
-			}
-		}
-	}
-	return ""
+	return scopeValue(a.scopes(), OrgDomainPrimaryScope)
 }
 
 func (a *AuthRequest) GetScopeOrgID() string {
+	return scopeValue(a.scopes(), OrgIDScope)
+}
+
+func (a *AuthRequest) scopes() []string {
 	switch request := a.Request.(type) {
 	case *AuthRequestOIDC:
-		for _, scope := range request.Scopes {
-			if strings.HasPrefix(scope, OrgIDScope) {
-				return strings.TrimPrefix(scope, OrgIDScope)
-			}
+		return request.Scopes
+	case *AuthRequestDevice:
+		return request.Scopes
+	}
+	return nil
+}
+
+func scopeValue(scopes []string, prefix string) string {
+	for _, scope := range scopes {
+		if strings.HasPrefix(scope, prefix) {
+			return strings.TrimPrefix(scope, prefix)
 		}
 	}
 	return ""
@@ -13,6 +13,12 @@ const (
 	VerifyPhoneMessageType              = "VerifyPhone"
 	DomainClaimedMessageType            = "DomainClaimed"
 	PasswordlessRegistrationMessageType = "PasswordlessRegistration"
+	VerifyEmailOTPMessageType           = "VerifyEmailOTP"
+	VerifySMSOTPMessageType             = "VerifySMSOTP"
+	RecoveryCodeUsedMessageType         = "RecoveryCodeUsed"
+	RecoveryCodesLowMessageType         = "RecoveryCodesLow"
+	PasswordExpiryMessageType           = "PasswordExpiry"
+	UserLockedMessageType               = "UserLocked"
 	MessageTitle                        = "Title"
 	MessagePreHeader                    = "PreHeader"
 	MessageSubject                      = "Subject"
@@ -29,6 +35,12 @@ type MessageTexts struct {
 	VerifyPhone              CustomMessageText
 	DomainClaimed            CustomMessageText
 	PasswordlessRegistration CustomMessageText
+	VerifyEmailOTP           CustomMessageText
+	VerifySMSOTP             CustomMessageText
+	RecoveryCodeUsed         CustomMessageText
+	RecoveryCodesLow         CustomMessageText
+	PasswordExpiry           CustomMessageText
+	UserLocked               CustomMessageText
 }
 
 type CustomMessageText struct {
@@ -65,6 +77,18 @@ func (m *MessageTexts) GetMessageTextByType(msgType string) *CustomMessageText {
 		return &m.DomainClaimed
 	case PasswordlessRegistrationMessageType:
 		return &m.PasswordlessRegistration
+	case VerifyEmailOTPMessageType:
+		return &m.VerifyEmailOTP
+	case VerifySMSOTPMessageType:
+		return &m.VerifySMSOTP
+	case RecoveryCodeUsedMessageType:
+		return &m.RecoveryCodeUsed
+	case RecoveryCodesLowMessageType:
+		return &m.RecoveryCodesLow
+	case PasswordExpiryMessageType:
+		return &m.PasswordExpiry
+	case UserLockedMessageType:
+		return &m.UserLocked
 	}
 	return nil
 }
@@ -75,5 +99,11 @@ func IsMessageTextType(textType string) bool {
 		textType == VerifyEmailMessageType ||
 		textType == VerifyPhoneMessageType ||
 		textType == DomainClaimedMessageType ||
-		textType == PasswordlessRegistrationMessageType
+		textType == PasswordlessRegistrationMessageType ||
+		textType == VerifyEmailOTPMessageType ||
+		textType == VerifySMSOTPMessageType ||
+		textType == RecoveryCodeUsedMessageType ||
+		textType == RecoveryCodesLowMessageType ||
+		textType == PasswordExpiryMessageType ||
+		textType == UserLockedMessageType
 }
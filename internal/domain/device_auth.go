@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"strings"
+	"time"
+	"unicode"
+)
+
+type DeviceAuthState int32
+
+const (
+	DeviceAuthStateUndefined DeviceAuthState = iota
+	DeviceAuthStateInitiated
+	DeviceAuthStateApproved
+	DeviceAuthStateDenied
+	DeviceAuthStateRemoved
+)
+
+func (s DeviceAuthState) Exists() bool {
+	return s != DeviceAuthStateUndefined && s != DeviceAuthStateRemoved
+}
+
+// DeviceAuth is a pending authorization of a device (RFC 8628),
+// which is confirmed by the user in the login using the UserCode
+type DeviceAuth struct {
+	ID         string
+	ClientID   string
+	DeviceCode string
+	UserCode   string
+	Expires    time.Time
+	Scopes     []string
+	State      DeviceAuthState
+
+	Subject               string
+	UserOrgID             string
+	AuthMethodsReferences []string
+	AuthTime              time.Time
+}
+
+func (d *DeviceAuth) IsExpired() bool {
+	return d.Expires.Before(time.Now())
+}
+
+// NormalizeUserCode removes the dashes and whitespaces of a user code entered by the user,
+// the user code itself is stored without them
+func NormalizeUserCode(code string) string {
+	return strings.ToUpper(strings.Map(func(r rune) rune {
+		if r == '-' || unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, code))
+}
@@ -20,6 +20,7 @@ const (
 	FlowTypeUnspecified FlowType = iota
 	FlowTypeExternalAuthentication
 	FlowTypeCustomiseToken
+	FlowTypeInternalAuthentication
 	flowTypeCount
 )
 
@@ -48,6 +49,13 @@ func (s FlowType) TriggerTypes() []TriggerType {
 		return []TriggerType{
 			TriggerTypePreUserinfoCreation,
 			TriggerTypePreAccessTokenCreation,
+			TriggerTypePreSAMLResponseCreation,
+		}
+	case FlowTypeInternalAuthentication:
+		return []TriggerType{
+			TriggerTypePostAuthentication,
+			TriggerTypePreCreation,
+			TriggerTypePostCreation,
 		}
 	default:
 		return nil
@@ -71,6 +79,8 @@ func (s FlowType) LocalizationKey() string {
 		return "Action.Flow.Type.ExternalAuthentication"
 	case FlowTypeCustomiseToken:
 		return "Action.Flow.Type.CustomiseToken"
+	case FlowTypeInternalAuthentication:
+		return "Action.Flow.Type.InternalAuthentication"
 	default:
 		return "Action.Flow.Type.Unspecified"
 	}
@@ -85,6 +95,7 @@ const (
 	TriggerTypePostCreation
 	TriggerTypePreUserinfoCreation
 	TriggerTypePreAccessTokenCreation
+	TriggerTypePreSAMLResponseCreation
 	triggerTypeCount
 )
 
@@ -115,6 +126,8 @@ func (s TriggerType) LocalizationKey() string {
 		return "Action.TriggerType.PreUserinfoCreation"
 	case TriggerTypePreAccessTokenCreation:
 		return "Action.TriggerType.PreAccessTokenCreation"
+	case TriggerTypePreSAMLResponseCreation:
+		return "Action.TriggerType.PreSAMLResponseCreation"
 	default:
 		return "Action.TriggerType.Unspecified"
 	}
@@ -22,3 +22,17 @@ func NewHashedPassword(password, algorithm string) *HashedPassword {
 		},
 	}
 }
+
+// CheckHash ensures the already hashed password can be verified by the password algorithm
+// and sets the algorithm which created the hash
+func (p *HashedPassword) CheckHash(passwordAlg crypto.HashAlgorithm) error {
+	if p.SecretCrypto == nil {
+		return nil
+	}
+	secret, err := crypto.IdentifyHash(p.SecretCrypto, passwordAlg)
+	if err != nil {
+		return err
+	}
+	p.SecretCrypto = secret
+	return nil
+}
@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"strings"
 	"time"
 
 	"github.com/dennigogo/zitadel/internal/crypto"
@@ -16,6 +17,9 @@ type IDPConfig struct {
 	State        IDPConfigState
 	OIDCConfig   *OIDCIDPConfig
 	JWTConfig    *JWTIDPConfig
+	SAMLConfig   *SAMLIDPConfig
+	LDAPConfig   *LDAPIDPConfig
+	OAuth2Config *OAuth2IDPConfig
 	AutoRegister bool
 }
 
@@ -69,12 +73,96 @@ type JWTIDPConfig struct {
 	HeaderName   string
 }
 
+type SAMLIDPConfig struct {
+	es_models.ObjectRoot
+	IDPConfigID       string
+	Metadata          []byte
+	MetadataURL       string
+	Key               *crypto.CryptoValue
+	Certificate       []byte
+	WithSignedRequest bool
+}
+
+func (c *SAMLIDPConfig) IsValid() bool {
+	if c.MetadataURL == "" && c.Metadata == nil {
+		return false
+	}
+	return true
+}
+
+type LDAPIDPConfig struct {
+	es_models.ObjectRoot
+	IDPConfigID        string
+	URL                string
+	StartTLS           bool
+	BaseDN             string
+	BindDN             string
+	BindPassword       *crypto.CryptoValue
+	BindPasswordString string
+	UserFilter         string
+	Attributes         LDAPAttributes
+}
+
+// LDAPAttributes defines the names of the ldap attributes which are mapped to the user
+type LDAPAttributes struct {
+	IDAttribute                string
+	PreferredUsernameAttribute string
+	FirstNameAttribute         string
+	LastNameAttribute          string
+	DisplayNameAttribute       string
+	EmailAttribute             string
+	PhoneAttribute             string
+}
+
+// LDAPUsernamePlaceholder is replaced by the username in the user filter of the ldap config
+const LDAPUsernamePlaceholder = "%s"
+
+// IsValid requires the user filter to contain the username placeholder,
+// otherwise every login would search the same entries
+func (c *LDAPIDPConfig) IsValid() bool {
+	return c.URL != "" &&
+		c.BaseDN != "" &&
+		strings.Contains(c.UserFilter, LDAPUsernamePlaceholder) &&
+		c.Attributes.IDAttribute != ""
+}
+
+type OAuth2IDPConfig struct {
+	es_models.ObjectRoot
+	IDPConfigID           string
+	ClientID              string
+	ClientSecret          *crypto.CryptoValue
+	ClientSecretString    string
+	AuthorizationEndpoint string
+	TokenEndpoint         string
+	UserEndpoint          string
+	Scopes                []string
+	Mapping               OAuth2UserMapping
+}
+
+// OAuth2UserMapping defines the paths (e.g. `user.emails.0.value`)
+// of the values in the userinfo response which are mapped to the user
+type OAuth2UserMapping struct {
+	IDPath                string
+	PreferredUsernamePath string
+	FirstNamePath         string
+	LastNamePath          string
+	DisplayNamePath       string
+	EmailPath             string
+	PhonePath             string
+}
+
+func (c *OAuth2IDPConfig) IsValid() bool {
+	return c.ClientID != "" && c.AuthorizationEndpoint != "" && c.TokenEndpoint != "" && c.UserEndpoint != "" && c.Mapping.IDPath != ""
+}
+
 type IDPConfigType int32
 
 const (
 	IDPConfigTypeOIDC IDPConfigType = iota
 	IDPConfigTypeSAML
 	IDPConfigTypeJWT
+	IDPConfigTypeLDAP
+	IDPConfigTypeOAuth2
 
 	//count is for validation
 	idpConfigTypeCount
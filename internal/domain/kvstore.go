@@ -0,0 +1,10 @@
+package domain
+
+const (
+	// KeyValueMaxKeyLength is the maximum length of a key in the key value store of an organisation
+	KeyValueMaxKeyLength = 200
+	// KeyValueMaxValueSize is the maximum size of a (json encoded) value in bytes
+	KeyValueMaxValueSize = 4096
+	// KeyValueMaxEntries is the maximum count of entries per organisation
+	KeyValueMaxEntries = 1000
+)
@@ -1,5 +1,9 @@
 package domain
 
+import (
+	"time"
+)
+
 type NextStep interface {
 	Type() NextStepType
 }
@@ -29,6 +33,7 @@ const (
 	NextStepProjectRequired
 	NextStepRedirectToExternalIDP
 	NextStepLoginSucceeded
+	NextStepPasswordExpiryWarning
 )
 
 type LoginStep struct{}
@@ -117,7 +122,9 @@ func (s *PasswordlessRegistrationPromptStep) Type() NextStepType {
 	return NextStepPasswordlessRegistrationPrompt
 }
 
-type ChangePasswordStep struct{}
+type ChangePasswordStep struct {
+	Expired bool
+}
 
 func (s *ChangePasswordStep) Type() NextStepType {
 	return NextStepChangePassword
@@ -129,6 +136,14 @@ func (s *InitPasswordStep) Type() NextStepType {
 	return NextStepInitPassword
 }
 
+type PasswordExpiryWarningStep struct {
+	ExpirationDate time.Time
+}
+
+func (s *PasswordExpiryWarningStep) Type() NextStepType {
+	return NextStepPasswordExpiryWarning
+}
+
 type ChangeUsernameStep struct{}
 
 func (s *ChangeUsernameStep) Type() NextStepType {
@@ -0,0 +1,16 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+)
+
+// OIDCSessionID returns the session id (`sid` claim) of the user session on the user agent.
+// It's derived from both ids so the user agent id itself is never exposed to the relying parties.
+func OIDCSessionID(userAgentID, userID string) string {
+	if userAgentID == "" || userID == "" {
+		return ""
+	}
+	hash := sha256.Sum256([]byte(userAgentID + ":" + userID))
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
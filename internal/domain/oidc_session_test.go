@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"testing"
+)
+
+func TestOIDCSessionID(t *testing.T) {
+	type args struct {
+		userAgentID string
+		userID      string
+	}
+	tests := []struct {
+		name  string
+		args  args
+		empty bool
+	}{
+		{
+			"no user agent, empty",
+			args{"", "userID"},
+			true,
+		},
+		{
+			"no user, empty",
+			args{"agentID", ""},
+			true,
+		},
+		{
+			"session, ok",
+			args{"agentID", "userID"},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := OIDCSessionID(tt.args.userAgentID, tt.args.userID)
+			if (got == "") != tt.empty {
+				t.Errorf("OIDCSessionID() = %q, want empty %v", got, tt.empty)
+			}
+			if got != "" && got != OIDCSessionID(tt.args.userAgentID, tt.args.userID) {
+				t.Errorf("OIDCSessionID() not stable")
+			}
+			if got != "" && got == OIDCSessionID(tt.args.userAgentID, "otherUserID") {
+				t.Errorf("OIDCSessionID() must differ per user")
+			}
+		})
+	}
+}
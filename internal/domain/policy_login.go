@@ -28,6 +28,7 @@ type LoginPolicy struct {
 	MFAInitSkipLifetime        time.Duration
 	SecondFactorCheckLifetime  time.Duration
 	MultiFactorCheckLifetime   time.Duration
+	TrustedDeviceLifetime      time.Duration
 	DisableLoginWithEmail      bool
 	DisableLoginWithPhone      bool
 }
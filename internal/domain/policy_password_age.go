@@ -1,6 +1,8 @@
 package domain
 
 import (
+	"time"
+
 	"github.com/dennigogo/zitadel/internal/eventstore/v1/models"
 )
 
@@ -9,4 +11,37 @@ type PasswordAgePolicy struct {
 
 	MaxAgeDays     uint64
 	ExpireWarnDays uint64
+	// HistoryCount is the number of previous passwords which must not be reused
+	HistoryCount uint64
+}
+
+// ExpirationDate returns the date the password changed at the given time expires,
+// the zero time is returned if passwords don't expire
+func (p *PasswordAgePolicy) ExpirationDate(changed time.Time) time.Time {
+	if p == nil || p.MaxAgeDays == 0 || changed.IsZero() {
+		return time.Time{}
+	}
+	return changed.AddDate(0, 0, int(p.MaxAgeDays))
+}
+
+// IsExpired returns true if the password changed at the given time has to be changed now
+func (p *PasswordAgePolicy) IsExpired(changed, now time.Time) bool {
+	expiration := p.ExpirationDate(changed)
+	return !expiration.IsZero() && !now.Before(expiration)
+}
+
+// WarnDate returns the date from which on the user is warned about the expiration of the password,
+// the zero time is returned if no warning is configured
+func (p *PasswordAgePolicy) WarnDate(changed time.Time) time.Time {
+	expiration := p.ExpirationDate(changed)
+	if expiration.IsZero() || p.ExpireWarnDays == 0 {
+		return time.Time{}
+	}
+	return expiration.AddDate(0, 0, -int(p.ExpireWarnDays))
+}
+
+// IsWarnDue returns true if the password changed at the given time expires soon
+func (p *PasswordAgePolicy) IsWarnDue(changed, now time.Time) bool {
+	warn := p.WarnDate(changed)
+	return !warn.IsZero() && !now.Before(warn) && !p.IsExpired(changed, now)
 }
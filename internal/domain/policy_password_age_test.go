@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPasswordAgePolicy_IsExpired(t *testing.T) {
+	changed := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		policy  *PasswordAgePolicy
+		changed time.Time
+		now     time.Time
+		want    bool
+	}{
+		{
+			"no policy, false",
+			nil,
+			changed,
+			changed.AddDate(1, 0, 0),
+			false,
+		},
+		{
+			"no max age, false",
+			&PasswordAgePolicy{},
+			changed,
+			changed.AddDate(1, 0, 0),
+			false,
+		},
+		{
+			"unknown change date, false",
+			&PasswordAgePolicy{MaxAgeDays: 30},
+			time.Time{},
+			changed,
+			false,
+		},
+		{
+			"not expired, false",
+			&PasswordAgePolicy{MaxAgeDays: 30},
+			changed,
+			changed.AddDate(0, 0, 29),
+			false,
+		},
+		{
+			"expired, true",
+			&PasswordAgePolicy{MaxAgeDays: 30},
+			changed,
+			changed.AddDate(0, 0, 30),
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.policy.IsExpired(tt.changed, tt.now))
+		})
+	}
+}
+
+func TestPasswordAgePolicy_IsWarnDue(t *testing.T) {
+	changed := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		policy *PasswordAgePolicy
+		now    time.Time
+		want   bool
+	}{
+		{
+			"no warn days, false",
+			&PasswordAgePolicy{MaxAgeDays: 30},
+			changed.AddDate(0, 0, 29),
+			false,
+		},
+		{
+			"before warn date, false",
+			&PasswordAgePolicy{MaxAgeDays: 30, ExpireWarnDays: 5},
+			changed.AddDate(0, 0, 24),
+			false,
+		},
+		{
+			"warn date reached, true",
+			&PasswordAgePolicy{MaxAgeDays: 30, ExpireWarnDays: 5},
+			changed.AddDate(0, 0, 25),
+			true,
+		},
+		{
+			"expired, false",
+			&PasswordAgePolicy{MaxAgeDays: 30, ExpireWarnDays: 5},
+			changed.AddDate(0, 0, 30),
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.policy.IsWarnDue(changed, tt.now))
+		})
+	}
+}
@@ -3,6 +3,7 @@ package domain
 import (
 	"regexp"
 
+	"github.com/dennigogo/zitadel/internal/crypto"
 	caos_errs "github.com/dennigogo/zitadel/internal/errors"
 	"github.com/dennigogo/zitadel/internal/eventstore/v1/models"
 )
@@ -23,13 +24,65 @@ type PasswordComplexityPolicy struct {
 	HasNumber    bool
 	HasSymbol    bool
 
+	BreachedPasswordCheck BreachedPasswordCheck
+
 	Default bool
 }
 
+// BreachedPasswordCheck defines how passwords found in the corpus of breached passwords are handled
+type BreachedPasswordCheck int32
+
+const (
+	BreachedPasswordCheckDisabled BreachedPasswordCheck = iota
+	BreachedPasswordCheckWarn
+	BreachedPasswordCheckReject
+
+	breachedPasswordCheckCount
+)
+
+func (c BreachedPasswordCheck) Valid() bool {
+	return c >= 0 && c < breachedPasswordCheckCount
+}
+
+// Configured returns false if breached passwords have to be warned about or rejected
+// but no corpus of breached passwords is configured
+func (c BreachedPasswordCheck) Configured(checker crypto.BreachedPasswordChecker) bool {
+	return c == BreachedPasswordCheckDisabled || checker != nil
+}
+
+// Check looks up the password in the corpus of breached passwords,
+// an error is only returned if breached passwords are rejected
+// breached is returned so the caller can warn about the password
+func (c BreachedPasswordCheck) Check(password string, checker crypto.BreachedPasswordChecker) (breached bool, err error) {
+	if c == BreachedPasswordCheckDisabled || password == "" {
+		return false, nil
+	}
+	if checker == nil {
+		if c == BreachedPasswordCheckReject {
+			return false, caos_errs.ThrowPreconditionFailed(nil, "DOMAIN-Br8nc", "Errors.User.PasswordComplexityPolicy.BreachedPasswordsNotConfigured")
+		}
+		return false, nil
+	}
+	breached, err = checker.IsBreached(password)
+	if err != nil {
+		if c == BreachedPasswordCheckReject {
+			return false, caos_errs.ThrowInternal(err, "DOMAIN-Br2md", "Errors.Internal")
+		}
+		return false, nil
+	}
+	if breached && c == BreachedPasswordCheckReject {
+		return true, caos_errs.ThrowInvalidArgument(nil, "DOMAIN-Br5ks", "Errors.User.PasswordComplexityPolicy.Breached")
+	}
+	return breached, nil
+}
+
 func (p *PasswordComplexityPolicy) IsValid() error {
 	if p.MinLength == 0 || p.MinLength > 72 {
 		return caos_errs.ThrowInvalidArgument(nil, "MODEL-Lsp0e", "Errors.User.PasswordComplexityPolicy.MinLengthNotAllowed")
 	}
+	if !p.BreachedPasswordCheck.Valid() {
+		return caos_errs.ThrowInvalidArgument(nil, "MODEL-Br7fw", "Errors.User.PasswordComplexityPolicy.BreachedPasswordCheckInvalid")
+	}
 	return nil
 }
 
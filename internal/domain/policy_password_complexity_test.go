@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dennigogo/zitadel/internal/crypto"
+	caos_errs "github.com/dennigogo/zitadel/internal/errors"
+)
+
+type breachedPasswordsMock struct {
+	breached bool
+	err      error
+}
+
+func (m breachedPasswordsMock) IsBreached(string) (bool, error) {
+	return m.breached, m.err
+}
+
+func TestBreachedPasswordCheck_Check(t *testing.T) {
+	tests := []struct {
+		name         string
+		check        BreachedPasswordCheck
+		checker      crypto.BreachedPasswordChecker
+		wantBreached bool
+		wantErr      func(error) bool
+	}{
+		{
+			"disabled, not checked",
+			BreachedPasswordCheckDisabled,
+			breachedPasswordsMock{breached: true},
+			false,
+			nil,
+		},
+		{
+			"warn, breached",
+			BreachedPasswordCheckWarn,
+			breachedPasswordsMock{breached: true},
+			true,
+			nil,
+		},
+		{
+			"warn, checker error ignored",
+			BreachedPasswordCheckWarn,
+			breachedPasswordsMock{err: errors.New("unavailable")},
+			false,
+			nil,
+		},
+		{
+			"reject, not breached",
+			BreachedPasswordCheckReject,
+			breachedPasswordsMock{},
+			false,
+			nil,
+		},
+		{
+			"reject, breached, invalid argument error",
+			BreachedPasswordCheckReject,
+			breachedPasswordsMock{breached: true},
+			true,
+			caos_errs.IsErrorInvalidArgument,
+		},
+		{
+			"reject, checker error, internal error",
+			BreachedPasswordCheckReject,
+			breachedPasswordsMock{err: errors.New("unavailable")},
+			false,
+			caos_errs.IsInternal,
+		},
+		{
+			"warn, not configured",
+			BreachedPasswordCheckWarn,
+			nil,
+			false,
+			nil,
+		},
+		{
+			"reject, not configured, precondition error",
+			BreachedPasswordCheckReject,
+			nil,
+			false,
+			caos_errs.IsPreconditionFailed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			breached, err := tt.check.Check("password", tt.checker)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+			} else if !tt.wantErr(err) {
+				t.Errorf("got wrong err: %v", err)
+			}
+			assert.Equal(t, tt.wantBreached, breached)
+		})
+	}
+}
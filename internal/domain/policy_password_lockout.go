@@ -1,6 +1,9 @@
 package domain
 
 import (
+	"time"
+
+	caos_errs "github.com/dennigogo/zitadel/internal/errors"
 	"github.com/dennigogo/zitadel/internal/eventstore/v1/models"
 )
 
@@ -9,5 +12,77 @@ type LockoutPolicy struct {
 
 	Default             bool
 	MaxPasswordAttempts uint64
+	MaxOTPAttempts      uint64
+	MaxU2FAttempts      uint64
 	ShowLockOutFailures bool
+	// AutoUnlockAfter is the duration after which users locked because of failed checks are unlocked again,
+	// 0 keeps them locked until they are unlocked manually
+	AutoUnlockAfter time.Duration
+	// AttemptDelay is the delay after a failed check, it doubles with every further consecutive failed check
+	AttemptDelay time.Duration
+	// MaxAttemptDelay caps the doubled AttemptDelay, 0 doesn't cap it
+	MaxAttemptDelay time.Duration
+}
+
+func (p *LockoutPolicy) IsValid() error {
+	if p.AutoUnlockAfter < 0 || p.AttemptDelay < 0 || p.MaxAttemptDelay < 0 {
+		return caos_errs.ThrowInvalidArgument(nil, "DOMAIN-Lo4kx", "Errors.Policy.Lockout.DurationInvalid")
+	}
+	if p.MaxAttemptDelay > 0 && p.MaxAttemptDelay < p.AttemptDelay {
+		return caos_errs.ThrowInvalidArgument(nil, "DOMAIN-Lo7mv", "Errors.Policy.Lockout.MaxAttemptDelayInvalid")
+	}
+	return nil
+}
+
+// AutoUnlockDate returns the date the user locked at the given time is unlocked automatically,
+// the zero time is returned if locked users are not unlocked automatically
+func (p *LockoutPolicy) AutoUnlockDate(lockedAt time.Time) time.Time {
+	if p == nil || p.AutoUnlockAfter == 0 || lockedAt.IsZero() {
+		return time.Time{}
+	}
+	return lockedAt.Add(p.AutoUnlockAfter)
+}
+
+// IsAutoUnlocked returns true if the lock of the user locked at the given time expired
+func (p *LockoutPolicy) IsAutoUnlocked(lockedAt, now time.Time) bool {
+	unlock := p.AutoUnlockDate(lockedAt)
+	return !unlock.IsZero() && !now.Before(unlock)
+}
+
+// Delay returns the duration the user has to wait after the given number of consecutive failed checks
+func (p *LockoutPolicy) Delay(failedChecks uint64) time.Duration {
+	if p == nil || p.AttemptDelay <= 0 || failedChecks == 0 {
+		return 0
+	}
+	delay := p.AttemptDelay
+	for i := uint64(1); i < failedChecks; i++ {
+		if p.MaxAttemptDelay > 0 && delay >= p.MaxAttemptDelay {
+			break
+		}
+		// stop doubling before the duration overflows
+		if delay > time.Duration(1<<62) {
+			break
+		}
+		delay *= 2
+	}
+	if p.MaxAttemptDelay > 0 && delay > p.MaxAttemptDelay {
+		return p.MaxAttemptDelay
+	}
+	return delay
+}
+
+// NextAttempt returns the earliest time the next check is allowed
+// after the given number of consecutive failed checks, the last one at lastFailed
+func (p *LockoutPolicy) NextAttempt(failedChecks uint64, lastFailed time.Time) time.Time {
+	delay := p.Delay(failedChecks)
+	if delay == 0 || lastFailed.IsZero() {
+		return time.Time{}
+	}
+	return lastFailed.Add(delay)
+}
+
+// ExceedsAttempts returns true if the user has to be locked after a further failed check,
+// a max of 0 never locks the user
+func ExceedsAttempts(max, failedChecks uint64) bool {
+	return max > 0 && failedChecks+1 >= max
 }
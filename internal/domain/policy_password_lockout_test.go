@@ -0,0 +1,168 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	caos_errs "github.com/dennigogo/zitadel/internal/errors"
+)
+
+func TestLockoutPolicy_IsValid(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  *LockoutPolicy
+		wantErr func(error) bool
+	}{
+		{
+			"empty, ok",
+			&LockoutPolicy{},
+			nil,
+		},
+		{
+			"negative auto unlock, invalid argument error",
+			&LockoutPolicy{AutoUnlockAfter: -time.Minute},
+			caos_errs.IsErrorInvalidArgument,
+		},
+		{
+			"max attempt delay smaller than attempt delay, invalid argument error",
+			&LockoutPolicy{AttemptDelay: time.Minute, MaxAttemptDelay: time.Second},
+			caos_errs.IsErrorInvalidArgument,
+		},
+		{
+			"uncapped attempt delay, ok",
+			&LockoutPolicy{AttemptDelay: time.Minute},
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.policy.IsValid()
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+			} else if !tt.wantErr(err) {
+				t.Errorf("got wrong err: %v", err)
+			}
+		})
+	}
+}
+
+func TestLockoutPolicy_IsAutoUnlocked(t *testing.T) {
+	lockedAt := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		policy   *LockoutPolicy
+		lockedAt time.Time
+		now      time.Time
+		want     bool
+	}{
+		{
+			"no policy, false",
+			nil,
+			lockedAt,
+			lockedAt.AddDate(1, 0, 0),
+			false,
+		},
+		{
+			"no auto unlock, false",
+			&LockoutPolicy{},
+			lockedAt,
+			lockedAt.AddDate(1, 0, 0),
+			false,
+		},
+		{
+			"locked manually, false",
+			&LockoutPolicy{AutoUnlockAfter: time.Hour},
+			time.Time{},
+			lockedAt,
+			false,
+		},
+		{
+			"not passed, false",
+			&LockoutPolicy{AutoUnlockAfter: time.Hour},
+			lockedAt,
+			lockedAt.Add(59 * time.Minute),
+			false,
+		},
+		{
+			"passed, true",
+			&LockoutPolicy{AutoUnlockAfter: time.Hour},
+			lockedAt,
+			lockedAt.Add(time.Hour),
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.policy.IsAutoUnlocked(tt.lockedAt, tt.now))
+		})
+	}
+}
+
+func TestLockoutPolicy_Delay(t *testing.T) {
+	tests := []struct {
+		name         string
+		policy       *LockoutPolicy
+		failedChecks uint64
+		want         time.Duration
+	}{
+		{
+			"no policy, no delay",
+			nil,
+			3,
+			0,
+		},
+		{
+			"no failed checks, no delay",
+			&LockoutPolicy{AttemptDelay: time.Second},
+			0,
+			0,
+		},
+		{
+			"first failed check, attempt delay",
+			&LockoutPolicy{AttemptDelay: time.Second},
+			1,
+			time.Second,
+		},
+		{
+			"third failed check, doubled twice",
+			&LockoutPolicy{AttemptDelay: time.Second},
+			3,
+			4 * time.Second,
+		},
+		{
+			"capped by max attempt delay",
+			&LockoutPolicy{AttemptDelay: time.Second, MaxAttemptDelay: 5 * time.Second},
+			10,
+			5 * time.Second,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.policy.Delay(tt.failedChecks))
+		})
+	}
+	t.Run("uncapped, no overflow", func(t *testing.T) {
+		policy := &LockoutPolicy{AttemptDelay: time.Second}
+		assert.Greater(t, policy.Delay(200), time.Duration(0))
+	})
+}
+
+func TestExceedsAttempts(t *testing.T) {
+	tests := []struct {
+		name         string
+		max          uint64
+		failedChecks uint64
+		want         bool
+	}{
+		{"no max, false", 0, 10, false},
+		{"below max, false", 3, 1, false},
+		{"reaching max, true", 3, 2, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ExceedsAttempts(tt.max, tt.failedChecks))
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package domain
+
+import "strings"
+
+// PushedAuthRequestURIPrefix is the prefix of the request_uri returned by the pushed authorization request endpoint (RFC 9126)
+const PushedAuthRequestURIPrefix = "urn:ietf:params:oauth:request_uri:"
+
+type PushedAuthRequestState int32
+
+const (
+	PushedAuthRequestStateUndefined PushedAuthRequestState = iota
+	PushedAuthRequestStateActive
+	PushedAuthRequestStateUsed
+)
+
+func (s PushedAuthRequestState) Exists() bool {
+	return s != PushedAuthRequestStateUndefined
+}
+
+// PushedAuthRequestURI returns the request_uri of the pushed authorization request with the given id
+func PushedAuthRequestURI(id string) string {
+	return PushedAuthRequestURIPrefix + id
+}
+
+// PushedAuthRequestIDFromURI returns the id of the pushed authorization request,
+// it returns false if the request_uri was not issued by the pushed authorization request endpoint
+func PushedAuthRequestIDFromURI(requestURI string) (string, bool) {
+	id := strings.TrimPrefix(requestURI, PushedAuthRequestURIPrefix)
+	if id == "" || id == requestURI {
+		return "", false
+	}
+	return id, true
+}
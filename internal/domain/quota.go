@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"time"
+)
+
+type QuotaUnit int32
+
+const (
+	QuotaUnitUnspecified QuotaUnit = iota
+	// QuotaUnitRequestsAllAuthenticated counts the requests to the APIs which send an authorization header
+	QuotaUnitRequestsAllAuthenticated
+	// QuotaUnitActionsAllRunsSeconds counts the started seconds of all action runs
+	QuotaUnitActionsAllRunsSeconds
+	// QuotaUnitNotificationsAll counts the sent emails and sms
+	QuotaUnitNotificationsAll
+	// QuotaUnitUsers is the amount of users of the instance
+	QuotaUnitUsers
+	// QuotaUnitOrgs is the amount of organisations of the instance
+	QuotaUnitOrgs
+	quotaUnitCount
+)
+
+func (u QuotaUnit) Valid() bool {
+	return u > QuotaUnitUnspecified && u < quotaUnitCount
+}
+
+// IsPeriodic returns true if the usage of the unit is metered and reset after each interval,
+// the usage of the other units is the current amount of resources
+func (u QuotaUnit) IsPeriodic() bool {
+	return u == QuotaUnitRequestsAllAuthenticated ||
+		u == QuotaUnitActionsAllRunsSeconds ||
+		u == QuotaUnitNotificationsAll
+}
+
+type Quota struct {
+	Unit QuotaUnit
+	// From is the start of the first period of periodic quotas
+	From          time.Time
+	ResetInterval time.Duration
+	Amount        uint64
+	// Limit defines if further usage is blocked after the amount is reached
+	Limit         bool
+	Notifications []*QuotaNotification
+}
+
+// QuotaNotification calls the CallURL as soon as the usage reaches the percentage of the amount
+// (and every multiple of it, if it's repeated)
+type QuotaNotification struct {
+	Percent uint16
+	Repeat  bool
+	CallURL string
+}
+
+func (q *Quota) IsValid() bool {
+	if !q.Unit.Valid() || q.Amount == 0 {
+		return false
+	}
+	if !q.Unit.IsPeriodic() {
+		return q.ResetInterval == 0 && len(q.Notifications) == 0
+	}
+	if q.ResetInterval <= 0 || q.From.IsZero() {
+		return false
+	}
+	for _, notification := range q.Notifications {
+		if notification.Percent == 0 || notification.CallURL == "" {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"time"
+)
+
+// RateLimit allows Requests per Interval,
+// the zero value means the default of the runtime configuration applies
+type RateLimit struct {
+	Requests uint64
+	Interval time.Duration
+}
+
+func (l RateLimit) IsZero() bool {
+	return l.Requests == 0 && l.Interval == 0
+}
+
+func (l RateLimit) IsValid() bool {
+	return l.IsZero() || (l.Requests > 0 && l.Interval > 0)
+}
+
+// RateLimits of an instance, which override the limits of the runtime configuration
+type RateLimits struct {
+	// Instance limits all requests to the instance
+	Instance RateLimit
+	// Client limits the requests of an authenticated user or client
+	Client RateLimit
+	// IP limits the requests of a single ip address
+	IP RateLimit
+}
+
+func (l *RateLimits) IsValid() bool {
+	return l != nil && l.Instance.IsValid() && l.Client.IsValid() && l.IP.IsValid()
+}
@@ -22,6 +22,7 @@ type AuthRequestType int32
 const (
 	AuthRequestTypeOIDC AuthRequestType = iota
 	AuthRequestTypeSAML
+	AuthRequestTypeDevice
 )
 
 type AuthRequestOIDC struct {
@@ -48,6 +49,8 @@ type AuthRequestSAML struct {
 	Issuer      string
 	IssuerName  string
 	Destination string
+	// NameIDFormat is the format of the NameID policy requested by the service provider
+	NameIDFormat string
 }
 
 func (a *AuthRequestSAML) Type() AuthRequestType {
@@ -57,3 +60,19 @@ func (a *AuthRequestSAML) Type() AuthRequestType {
 func (a *AuthRequestSAML) IsValid() bool {
 	return true
 }
+
+// AuthRequestDevice is the login of a user for a device authorization (RFC 8628),
+// ID is the id of the device authorization
+type AuthRequestDevice struct {
+	ID       string
+	UserCode string
+	Scopes   []string
+}
+
+func (a *AuthRequestDevice) Type() AuthRequestType {
+	return AuthRequestTypeDevice
+}
+
+func (a *AuthRequestDevice) IsValid() bool {
+	return a.ID != "" && a.UserCode != ""
+}
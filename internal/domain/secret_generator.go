@@ -11,6 +11,8 @@ const (
 	SecretGeneratorTypePasswordResetCode
 	SecretGeneratorTypePasswordlessInitCode
 	SecretGeneratorTypeAppSecret
+	SecretGeneratorTypeOTPEmail
+	SecretGeneratorTypeOTPSMS
 
 	secretGeneratorTypeCount
 )
@@ -22,6 +22,15 @@ type Token struct {
 	PreferredLanguage string
 }
 
+// TokenActor is the user acting on behalf of the subject of a token,
+// it's represented as `act` claim (RFC 8693)
+type TokenActor struct {
+	// Actor is set if the actor itself acted on behalf of someone else
+	Actor  *TokenActor `json:"act,omitempty"`
+	UserID string      `json:"sub,omitempty"`
+	Issuer string      `json:"iss,omitempty"`
+}
+
 func AddAudScopeToAudience(ctx context.Context, audience, scopes []string) []string {
 	for _, scope := range scopes {
 		if !(strings.HasPrefix(scope, ProjectIDScope) && strings.HasSuffix(scope, AudSuffix)) {
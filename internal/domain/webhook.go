@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"github.com/dennigogo/zitadel/internal/eventstore/v1/models"
+)
+
+type Webhook struct {
+	models.ObjectRoot
+
+	Name       string
+	URL        string
+	EventTypes []string
+	State      WebhookState
+}
+
+func (w *Webhook) IsValid() bool {
+	return w.Name != "" && w.URL != "" && len(w.EventTypes) > 0
+}
+
+type WebhookState int32
+
+const (
+	WebhookStateUnspecified WebhookState = iota
+	WebhookStateActive
+	WebhookStateInactive
+	WebhookStateRemoved
+	webhookStateCount
+)
+
+func (s WebhookState) Valid() bool {
+	return s >= 0 && s < webhookStateCount
+}
+
+func (s WebhookState) Exists() bool {
+	return s != WebhookStateUnspecified && s != WebhookStateRemoved
+}
+
+type WebhookDeliveryState int32
+
+const (
+	WebhookDeliveryStateUnspecified WebhookDeliveryState = iota
+	WebhookDeliveryStateDelivered
+	// WebhookDeliveryStateDeadLetter marks deliveries which were given up
+	// after all attempts failed or the target is not allowed to be called
+	WebhookDeliveryStateDeadLetter
+	// WebhookDeliveryStatePending marks deliveries which are not sent yet
+	// or will be attempted again
+	WebhookDeliveryStatePending
+	webhookDeliveryStateCount
+)
+
+func (s WebhookDeliveryState) Valid() bool {
+	return s >= 0 && s < webhookDeliveryStateCount
+}
@@ -42,6 +42,8 @@ const (
 	IDPConfigTypeOIDC IdpConfigType = iota
 	IDPConfigTypeSAML
 	IDPConfigTypeJWT
+	IDPConfigTypeLDAP
+	IDPConfigTypeOAuth2
 )
 
 type IDPConfigState int32
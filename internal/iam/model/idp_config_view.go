@@ -33,6 +33,34 @@ type IDPConfigView struct {
 	JWTIssuer                  string
 	JWTKeysEndpoint            string
 	JWTHeaderName              string
+	IsSAML                     bool
+	SAMLMetadata               []byte
+	SAMLKey                    *crypto.CryptoValue
+	SAMLCertificate            []byte
+	SAMLWithSignedRequest      bool
+	IsLDAP                     bool
+	LDAPURL                    string
+	LDAPStartTLS               bool
+	LDAPBaseDN                 string
+	LDAPBindDN                 string
+	LDAPBindPassword           *crypto.CryptoValue
+	LDAPUserFilter             string
+	LDAPIDAttribute            string
+	LDAPUsernameAttribute      string
+	LDAPFirstNameAttribute     string
+	LDAPLastNameAttribute      string
+	LDAPDisplayNameAttribute   string
+	LDAPEmailAttribute         string
+	LDAPPhoneAttribute         string
+	IsOAuth2                   bool
+	OAuth2UserEndpoint         string
+	OAuth2IDPath               string
+	OAuth2UsernamePath         string
+	OAuth2FirstNamePath        string
+	OAuth2LastNamePath         string
+	OAuth2DisplayNamePath      string
+	OAuth2EmailPath            string
+	OAuth2PhonePath            string
 }
 
 type IDPConfigSearchRequest struct {
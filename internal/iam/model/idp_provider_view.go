@@ -103,6 +103,10 @@ func idpConfigTypeToDomain(idpType IdpConfigType) domain.IDPConfigType {
 		return domain.IDPConfigTypeSAML
 	case IDPConfigTypeJWT:
 		return domain.IDPConfigTypeJWT
+	case IDPConfigTypeLDAP:
+		return domain.IDPConfigTypeLDAP
+	case IDPConfigTypeOAuth2:
+		return domain.IDPConfigTypeOAuth2
 	default:
 		return domain.IDPConfigTypeOIDC
 	}
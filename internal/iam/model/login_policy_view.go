@@ -108,6 +108,10 @@ func secondFactorsToDomain(types []domain.SecondFactorType) []domain.SecondFacto
 			secondfactors[i] = domain.SecondFactorTypeU2F
 		case domain.SecondFactorTypeOTP:
 			secondfactors[i] = domain.SecondFactorTypeOTP
+		case domain.SecondFactorTypeOTPEmail:
+			secondfactors[i] = domain.SecondFactorTypeOTPEmail
+		case domain.SecondFactorTypeOTPSMS:
+			secondfactors[i] = domain.SecondFactorTypeOTPSMS
 		}
 	}
 	return secondfactors
@@ -48,6 +48,34 @@ type IDPConfigView struct {
 	JWTEndpoint                string               `json:"jwtEndpoint" gorm:"jwt_endpoint"`
 	JWTKeysEndpoint            string               `json:"keysEndpoint" gorm:"jwt_keys_endpoint"`
 	JWTHeaderName              string               `json:"headerName" gorm:"jwt_header_name"`
+	IsSAML                     bool                 `json:"-" gorm:"column:is_saml"`
+	SAMLMetadata               []byte               `json:"metadata" gorm:"column:saml_metadata"`
+	SAMLKey                    *crypto.CryptoValue  `json:"key" gorm:"column:saml_key"`
+	SAMLCertificate            []byte               `json:"certificate" gorm:"column:saml_certificate"`
+	SAMLWithSignedRequest      bool                 `json:"withSignedRequest" gorm:"column:saml_with_signed_request"`
+	IsLDAP                     bool                 `json:"-" gorm:"column:is_ldap"`
+	LDAPURL                    string               `json:"url" gorm:"column:ldap_url"`
+	LDAPStartTLS               bool                 `json:"startTls" gorm:"column:ldap_start_tls"`
+	LDAPBaseDN                 string               `json:"baseDn" gorm:"column:ldap_base_dn"`
+	LDAPBindDN                 string               `json:"bindDn" gorm:"column:ldap_bind_dn"`
+	LDAPBindPassword           *crypto.CryptoValue  `json:"bindPassword" gorm:"column:ldap_bind_password"`
+	LDAPUserFilter             string               `json:"userFilter" gorm:"column:ldap_user_filter"`
+	LDAPIDAttribute            string               `json:"idAttribute" gorm:"column:ldap_id_attribute"`
+	LDAPUsernameAttribute      string               `json:"preferredUsernameAttribute" gorm:"column:ldap_preferred_username_attribute"`
+	LDAPFirstNameAttribute     string               `json:"firstNameAttribute" gorm:"column:ldap_first_name_attribute"`
+	LDAPLastNameAttribute      string               `json:"lastNameAttribute" gorm:"column:ldap_last_name_attribute"`
+	LDAPDisplayNameAttribute   string               `json:"displayNameAttribute" gorm:"column:ldap_display_name_attribute"`
+	LDAPEmailAttribute         string               `json:"emailAttribute" gorm:"column:ldap_email_attribute"`
+	LDAPPhoneAttribute         string               `json:"phoneAttribute" gorm:"column:ldap_phone_attribute"`
+	IsOAuth2                   bool                 `json:"-" gorm:"column:is_oauth2"`
+	OAuth2UserEndpoint         string               `json:"userEndpoint" gorm:"column:oauth2_user_endpoint"`
+	OAuth2IDPath               string               `json:"idPath" gorm:"column:oauth2_id_path"`
+	OAuth2UsernamePath         string               `json:"preferredUsernamePath" gorm:"column:oauth2_preferred_username_path"`
+	OAuth2FirstNamePath        string               `json:"firstNamePath" gorm:"column:oauth2_first_name_path"`
+	OAuth2LastNamePath         string               `json:"lastNamePath" gorm:"column:oauth2_last_name_path"`
+	OAuth2DisplayNamePath      string               `json:"displayNamePath" gorm:"column:oauth2_display_name_path"`
+	OAuth2EmailPath            string               `json:"emailPath" gorm:"column:oauth2_email_path"`
+	OAuth2PhonePath            string               `json:"phonePath" gorm:"column:oauth2_phone_path"`
 
 	Sequence   uint64 `json:"-" gorm:"column:sequence"`
 	InstanceID string `json:"instanceID" gorm:"column:instance_id;primary_key"`
@@ -78,6 +106,43 @@ func IDPConfigViewToModel(idp *IDPConfigView) *model.IDPConfigView {
 		view.OIDCIssuer = idp.OIDCIssuer
 		return view
 	}
+	if idp.IsSAML {
+		view.IsSAML = true
+		view.SAMLMetadata = idp.SAMLMetadata
+		view.SAMLKey = idp.SAMLKey
+		view.SAMLCertificate = idp.SAMLCertificate
+		view.SAMLWithSignedRequest = idp.SAMLWithSignedRequest
+		return view
+	}
+	if idp.IsLDAP {
+		view.IsLDAP = true
+		view.LDAPURL = idp.LDAPURL
+		view.LDAPStartTLS = idp.LDAPStartTLS
+		view.LDAPBaseDN = idp.LDAPBaseDN
+		view.LDAPBindDN = idp.LDAPBindDN
+		view.LDAPBindPassword = idp.LDAPBindPassword
+		view.LDAPUserFilter = idp.LDAPUserFilter
+		view.LDAPIDAttribute = idp.LDAPIDAttribute
+		view.LDAPUsernameAttribute = idp.LDAPUsernameAttribute
+		view.LDAPFirstNameAttribute = idp.LDAPFirstNameAttribute
+		view.LDAPLastNameAttribute = idp.LDAPLastNameAttribute
+		view.LDAPDisplayNameAttribute = idp.LDAPDisplayNameAttribute
+		view.LDAPEmailAttribute = idp.LDAPEmailAttribute
+		view.LDAPPhoneAttribute = idp.LDAPPhoneAttribute
+		return view
+	}
+	if idp.IsOAuth2 {
+		view.IsOAuth2 = true
+		view.OAuth2UserEndpoint = idp.OAuth2UserEndpoint
+		view.OAuth2IDPath = idp.OAuth2IDPath
+		view.OAuth2UsernamePath = idp.OAuth2UsernamePath
+		view.OAuth2FirstNamePath = idp.OAuth2FirstNamePath
+		view.OAuth2LastNamePath = idp.OAuth2LastNamePath
+		view.OAuth2DisplayNamePath = idp.OAuth2DisplayNamePath
+		view.OAuth2EmailPath = idp.OAuth2EmailPath
+		view.OAuth2PhonePath = idp.OAuth2PhonePath
+		return view
+	}
 	view.JWTEndpoint = idp.JWTEndpoint
 	view.JWTIssuer = idp.OIDCIssuer
 	view.JWTKeysEndpoint = idp.JWTKeysEndpoint
@@ -97,10 +162,22 @@ func (i *IDPConfigView) AppendEvent(providerType model.IDPProviderType, event *m
 	case instance.IDPOIDCConfigAddedEventType, org.IDPOIDCConfigAddedEventType:
 		i.IsOIDC = true
 		err = i.SetData(event)
+	case instance.IDPSAMLConfigAddedEventType, org.IDPSAMLConfigAddedEventType:
+		i.IsSAML = true
+		err = i.SetData(event)
+	case instance.IDPLDAPConfigAddedEventType, org.IDPLDAPConfigAddedEventType:
+		i.IsLDAP = true
+		err = i.SetData(event)
+	case instance.IDPOAuth2ConfigAddedEventType, org.IDPOAuth2ConfigAddedEventType:
+		i.IsOAuth2 = true
+		err = i.SetData(event)
 	case instance.IDPOIDCConfigChangedEventType, org.IDPOIDCConfigChangedEventType,
 		instance.IDPConfigChangedEventType, org.IDPConfigChangedEventType,
 		org.IDPJWTConfigAddedEventType, instance.IDPJWTConfigAddedEventType,
-		org.IDPJWTConfigChangedEventType, instance.IDPJWTConfigChangedEventType:
+		org.IDPJWTConfigChangedEventType, instance.IDPJWTConfigChangedEventType,
+		org.IDPSAMLConfigChangedEventType, instance.IDPSAMLConfigChangedEventType,
+		org.IDPLDAPConfigChangedEventType, instance.IDPLDAPConfigChangedEventType,
+		org.IDPOAuth2ConfigChangedEventType, instance.IDPOAuth2ConfigChangedEventType:
 		err = i.SetData(event)
 	case instance.IDPConfigDeactivatedEventType, org.IDPConfigDeactivatedEventType:
 		i.IDPState = int32(model.IDPConfigStateInactive)
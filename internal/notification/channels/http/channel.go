@@ -0,0 +1,64 @@
+package http
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	caos_errs "github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/notification/channels"
+	"github.com/dennigogo/zitadel/internal/notification/messages"
+)
+
+const requestTimeout = 10 * time.Second
+
+func InitHTTPChannel(config HTTPConfig) channels.NotificationChannel {
+	client := &http.Client{Timeout: requestTimeout}
+
+	logging.Debug("successfully initialized http sms channel")
+
+	return channels.HandleMessageFunc(func(message channels.Message) error {
+		smsMsg, ok := message.(*messages.SMS)
+		if !ok {
+			return caos_errs.ThrowInternal(nil, "HTTP-Kd8s1", "message is not SMS")
+		}
+		tmpl, err := ParseBodyTemplate(config.BodyTemplate)
+		if err != nil {
+			return err
+		}
+		body := new(bytes.Buffer)
+		err = tmpl.Execute(body, &TemplateData{
+			SenderNumber:    smsMsg.SenderPhoneNumber,
+			RecipientNumber: smsMsg.RecipientPhoneNumber,
+			Content:         smsMsg.GetContent(),
+		})
+		if err != nil {
+			return caos_errs.ThrowInternal(err, "HTTP-Ls9d3", "could not render request body")
+		}
+		req, err := http.NewRequest(config.method(), config.Endpoint, body)
+		if err != nil {
+			return caos_errs.ThrowInternal(err, "HTTP-Mw2k4", "could not create request")
+		}
+		if config.ContentType != "" {
+			req.Header.Set("Content-Type", config.ContentType)
+		}
+		if config.AuthHeaderName != "" {
+			req.Header.Set(config.AuthHeaderName, config.AuthHeaderValue)
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return caos_errs.ThrowInternal(err, "HTTP-Nq5f8", "could not send message")
+		}
+		defer resp.Body.Close()
+		// the body is drained so the connection can be reused
+		_, _ = io.Copy(io.Discard, resp.Body)
+		if !config.isSuccess(resp.StatusCode) {
+			return caos_errs.ThrowInternalf(nil, "HTTP-Pz7c2", "could not send message: unexpected status code %d", resp.StatusCode)
+		}
+		logging.WithFields("status", resp.StatusCode).Debug("sms sent")
+		return nil
+	})
+}
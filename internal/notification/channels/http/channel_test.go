@@ -0,0 +1,177 @@
+package http
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/dennigogo/zitadel/internal/notification/messages"
+)
+
+type testRequest struct {
+	method      string
+	contentType string
+	auth        string
+	body        string
+}
+
+// testServer answers every request with the status and records the received request
+func testServer(t *testing.T, status int) (string, <-chan *testRequest) {
+	t.Helper()
+	received := make(chan *testRequest, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received <- &testRequest{
+			method:      r.Method,
+			contentType: r.Header.Get("Content-Type"),
+			auth:        r.Header.Get("Authorization"),
+			body:        string(body),
+		}
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(server.Close)
+	return server.URL, received
+}
+
+func TestHTTPChannel(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  HTTPConfig
+		status  int
+		content string
+		want    *testRequest
+		wantErr bool
+	}{
+		{
+			name: "default method and status",
+			config: HTTPConfig{
+				BodyTemplate: `{"from": {{json .SenderNumber}}, "to": {{json .RecipientNumber}}, "text": {{json .Content}}}`,
+			},
+			status:  http.StatusAccepted,
+			content: `code "123"`,
+			want: &testRequest{
+				method: http.MethodPost,
+				body:   `{"from": "+41790000000", "to": "+41791234567", "text": "code \"123\""}`,
+			},
+		},
+		{
+			name: "method, headers and success status",
+			config: HTTPConfig{
+				Method:             http.MethodPut,
+				ContentType:        "text/plain",
+				BodyTemplate:       `{{.RecipientNumber}}:{{.Content}}`,
+				AuthHeaderName:     "Authorization",
+				AuthHeaderValue:    "Bearer token",
+				SuccessStatusCodes: []int32{http.StatusOK},
+			},
+			status:  http.StatusOK,
+			content: "code",
+			want: &testRequest{
+				method:      http.MethodPut,
+				contentType: "text/plain",
+				auth:        "Bearer token",
+				body:        "+41791234567:code",
+			},
+		},
+		{
+			name: "unexpected status",
+			config: HTTPConfig{
+				BodyTemplate: `{{.Content}}`,
+			},
+			status:  http.StatusBadRequest,
+			content: "code",
+			want: &testRequest{
+				method: http.MethodPost,
+				body:   "code",
+			},
+			wantErr: true,
+		},
+		{
+			name: "status not in success status codes",
+			config: HTTPConfig{
+				BodyTemplate:       `{{.Content}}`,
+				SuccessStatusCodes: []int32{http.StatusCreated},
+			},
+			status:  http.StatusOK,
+			content: "code",
+			want: &testRequest{
+				method: http.MethodPost,
+				body:   "code",
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid template",
+			config: HTTPConfig{
+				BodyTemplate: `{{.Content`,
+			},
+			status:  http.StatusOK,
+			content: "code",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			endpoint, received := testServer(t, tt.status)
+			tt.config.Endpoint = endpoint
+			err := InitHTTPChannel(tt.config).HandleMessage(&messages.SMS{
+				SenderPhoneNumber:    "+41790000000",
+				RecipientPhoneNumber: "+41791234567",
+				Content:              tt.content,
+			})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("HandleMessage() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			var got *testRequest
+			select {
+			case got = <-received:
+			default:
+			}
+			if tt.want == nil {
+				if got != nil {
+					t.Fatalf("unexpected request %+v", got)
+				}
+				return
+			}
+			if got == nil {
+				t.Fatal("no request received")
+			}
+			if *got != *tt.want {
+				t.Errorf("got request %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHTTPChannel_messageNotSMS(t *testing.T) {
+	endpoint, received := testServer(t, http.StatusOK)
+	err := InitHTTPChannel(HTTPConfig{Endpoint: endpoint, BodyTemplate: `{{.Content}}`}).HandleMessage(&messages.Email{})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	select {
+	case got := <-received:
+		t.Fatalf("unexpected request %+v", got)
+	default:
+	}
+}
+
+func TestHTTPConfig_IsValid(t *testing.T) {
+	tests := []struct {
+		name   string
+		config HTTPConfig
+		want   bool
+	}{
+		{name: "endpoint missing", config: HTTPConfig{BodyTemplate: "{{.Content}}"}, want: false},
+		{name: "body template missing", config: HTTPConfig{Endpoint: "https://sms.example.com"}, want: false},
+		{name: "valid", config: HTTPConfig{Endpoint: "https://sms.example.com", BodyTemplate: "{{.Content}}"}, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.config.IsValid(); got != tt.want {
+				t.Errorf("IsValid() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"text/template"
+
+	caos_errs "github.com/dennigogo/zitadel/internal/errors"
+)
+
+// HTTPConfig defines a generic http endpoint which sends the sms
+// the request body is rendered from BodyTemplate with the fields of TemplateData
+type HTTPConfig struct {
+	Endpoint     string
+	Method       string
+	ContentType  string
+	BodyTemplate string
+	// AuthHeaderName and AuthHeaderValue are set as header on every request (e.g. Authorization: Bearer ...)
+	AuthHeaderName  string
+	AuthHeaderValue string
+	// SuccessStatusCodes are the http status codes treated as success, any 2xx code if empty
+	SuccessStatusCodes []int32
+	SenderNumber       string
+}
+
+// TemplateData is passed to the body template
+type TemplateData struct {
+	SenderNumber    string
+	RecipientNumber string
+	Content         string
+}
+
+var templateFuncs = template.FuncMap{
+	// json encodes the value as json, e.g. {"text": {{json .Content}}}
+	"json": func(value interface{}) (string, error) {
+		encoded, err := json.Marshal(value)
+		return string(encoded), err
+	},
+}
+
+func (h *HTTPConfig) IsValid() bool {
+	return h.Endpoint != "" && h.BodyTemplate != ""
+}
+
+// ParseBodyTemplate parses the template of the request body
+func ParseBodyTemplate(bodyTemplate string) (*template.Template, error) {
+	tmpl, err := template.New("body").Funcs(templateFuncs).Parse(bodyTemplate)
+	if err != nil {
+		return nil, caos_errs.ThrowInvalidArgument(err, "HTTP-Tz8d2", "Errors.SMSConfig.HTTP.InvalidTemplate")
+	}
+	return tmpl, nil
+}
+
+func (h *HTTPConfig) method() string {
+	if h.Method == "" {
+		return http.MethodPost
+	}
+	return h.Method
+}
+
+func (h *HTTPConfig) isSuccess(statusCode int) bool {
+	if len(h.SuccessStatusCodes) == 0 {
+		return statusCode >= 200 && statusCode < 300
+	}
+	for _, code := range h.SuccessStatusCodes {
+		if int(code) == statusCode {
+			return true
+		}
+	}
+	return false
+}
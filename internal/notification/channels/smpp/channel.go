@@ -0,0 +1,91 @@
+package smpp
+
+import (
+	"bufio"
+	"crypto/tls"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	caos_errs "github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/notification/channels"
+	"github.com/dennigogo/zitadel/internal/notification/messages"
+)
+
+const timeout = 10 * time.Second
+
+func InitSMPPChannel(config SMPPConfig) channels.NotificationChannel {
+	logging.Debug("successfully initialized smpp sms channel")
+
+	return channels.HandleMessageFunc(func(message channels.Message) error {
+		smsMsg, ok := message.(*messages.SMS)
+		if !ok {
+			return caos_errs.ThrowInternal(nil, "SMPP-Ks8d1", "message is not SMS")
+		}
+		conn, err := dial(&config)
+		if err != nil {
+			return caos_errs.ThrowInternal(err, "SMPP-Dw2k9", "could not connect to smpp server")
+		}
+		defer conn.Close()
+		if err = conn.SetDeadline(time.Now().Add(timeout)); err != nil {
+			return caos_errs.ThrowInternal(err, "SMPP-Dl4f7", "could not set deadline")
+		}
+		session := &session{conn: conn, reader: bufio.NewReader(conn)}
+		if err = session.call(commandBindTransmitter, commandBindTransmitterResp, bindTransmitterBody(&config)); err != nil {
+			return caos_errs.ThrowInternal(err, "SMPP-Bq5n3", "could not bind to smpp server")
+		}
+		defer func() {
+			err := session.call(commandUnbind, commandUnbindResp, nil)
+			logging.OnError(err).Debug("unable to unbind from smpp server")
+		}()
+		err = session.call(commandSubmitSM, commandSubmitSMResp, submitSMBody(smsMsg.SenderPhoneNumber, smsMsg.RecipientPhoneNumber, smsMsg.GetContent()))
+		if err != nil {
+			return caos_errs.ThrowInternal(err, "SMPP-Sm7x2", "could not send message")
+		}
+		logging.Debug("sms sent")
+		return nil
+	})
+}
+
+func dial(config *SMPPConfig) (net.Conn, error) {
+	address := net.JoinHostPort(config.Host, strconv.Itoa(int(config.Port)))
+	dialer := &net.Dialer{Timeout: timeout}
+	if config.TLS {
+		return tls.DialWithDialer(dialer, "tcp", address, &tls.Config{ServerName: config.Host})
+	}
+	return dialer.Dial("tcp", address)
+}
+
+type session struct {
+	conn     net.Conn
+	reader   *bufio.Reader
+	sequence uint32
+}
+
+// call sends the request and waits for the corresponding response
+func (s *session) call(commandID, respID uint32, body []byte) error {
+	s.sequence++
+	request := &pdu{commandID: commandID, sequence: s.sequence, body: body}
+	if err := request.write(s.conn); err != nil {
+		return err
+	}
+	for {
+		resp, err := readPDU(s.reader)
+		if err != nil {
+			return err
+		}
+		if resp.sequence != request.sequence {
+			// e.g. enquire_link of the server, which is not required for a short lived session
+			continue
+		}
+		if resp.commandID != respID && resp.commandID != commandGenericNack {
+			return caos_errs.ThrowInternalf(nil, "SMPP-Rz4c8", "unexpected response command 0x%08x", resp.commandID)
+		}
+		if resp.status != 0 {
+			return caos_errs.ThrowInternalf(nil, "SMPP-Rs1v6", "smpp command status 0x%08x", resp.status)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,117 @@
+package smpp
+
+import (
+	"bufio"
+	"bytes"
+	"net"
+	"strconv"
+	"testing"
+
+	"github.com/dennigogo/zitadel/internal/notification/messages"
+)
+
+// testServer accepts a single session and answers every request with the status
+func testServer(t *testing.T, submitStatus uint32) (*SMPPConfig, <-chan *pdu) {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { listener.Close() })
+	submitted := make(chan *pdu, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		reader := bufio.NewReader(conn)
+		for {
+			request, err := readPDU(reader)
+			if err != nil {
+				return
+			}
+			resp := &pdu{commandID: request.commandID | commandGenericNack, sequence: request.sequence}
+			switch request.commandID {
+			case commandSubmitSM:
+				submitted <- request
+				resp.status = submitStatus
+				resp.body = []byte("message-id\x00")
+			case commandBindTransmitter:
+				resp.body = []byte("smsc\x00")
+			}
+			if err = resp.write(conn); err != nil {
+				return
+			}
+		}
+	}()
+	host, port, _ := net.SplitHostPort(listener.Addr().String())
+	p, _ := strconv.Atoi(port)
+	return &SMPPConfig{Host: host, Port: uint16(p), SystemID: "zitadel", Password: "password", SenderNumber: "ZITADEL"}, submitted
+}
+
+func TestSMPPChannel(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  uint32
+		content string
+		wantErr bool
+	}{
+		{
+			name:    "ascii",
+			content: "your code is 123456",
+		},
+		{
+			name:    "ucs2",
+			content: "dein Code lautet 123456 ✓",
+		},
+		{
+			name:    "message payload",
+			content: string(bytes.Repeat([]byte("a"), 300)),
+		},
+		{
+			name:    "rejected",
+			status:  0x0000000b, // ESME_RINVDSTADR
+			content: "your code is 123456",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config, submitted := testServer(t, tt.status)
+			err := InitSMPPChannel(*config).HandleMessage(&messages.SMS{
+				SenderPhoneNumber:    config.SenderNumber,
+				RecipientPhoneNumber: "+41791234567",
+				Content:              tt.content,
+			})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("HandleMessage() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			request := <-submitted
+			want := submitSMBody(config.SenderNumber, "+41791234567", tt.content)
+			if !bytes.Equal(request.body, want) {
+				t.Errorf("submitted body = %x, want %x", request.body, want)
+			}
+		})
+	}
+}
+
+func TestAddressType(t *testing.T) {
+	tests := []struct {
+		address string
+		ton     byte
+		npi     byte
+	}{
+		{"+41791234567", tonInternational, npiISDN},
+		{"41791234567", tonInternational, npiISDN},
+		{"ZITADEL", tonAlphanumeric, npiUnknown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.address, func(t *testing.T) {
+			ton, npi := addressType(tt.address)
+			if ton != tt.ton || npi != tt.npi {
+				t.Errorf("addressType() = %d, %d, want %d, %d", ton, npi, tt.ton, tt.npi)
+			}
+		})
+	}
+}
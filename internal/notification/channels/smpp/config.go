@@ -0,0 +1,16 @@
+package smpp
+
+type SMPPConfig struct {
+	Host       string
+	Port       uint16
+	TLS        bool
+	SystemID   string
+	Password   string
+	SystemType string
+	// SenderNumber is used as source address, it may also be alphanumeric
+	SenderNumber string
+}
+
+func (s *SMPPConfig) IsValid() bool {
+	return s.Host != "" && s.Port != 0 && s.SystemID != "" && s.SenderNumber != ""
+}
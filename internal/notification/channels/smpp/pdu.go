@@ -0,0 +1,167 @@
+package smpp
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"io"
+	"strings"
+	"unicode"
+	"unicode/utf16"
+
+	caos_errs "github.com/dennigogo/zitadel/internal/errors"
+)
+
+// subset of the smpp 3.4 protocol needed to submit messages as transmitter
+
+const (
+	commandBindTransmitter     uint32 = 0x00000002
+	commandBindTransmitterResp uint32 = 0x80000002
+	commandSubmitSM            uint32 = 0x00000004
+	commandSubmitSMResp        uint32 = 0x80000004
+	commandUnbind              uint32 = 0x00000006
+	commandUnbindResp          uint32 = 0x80000006
+	commandGenericNack         uint32 = 0x80000000
+
+	interfaceVersion = 0x34
+	headerLength     = 16
+	// maxPDULength limits the responses read from the server
+	maxPDULength = 64 * 1024
+
+	tonInternational  = 0x01
+	tonAlphanumeric   = 0x05
+	npiISDN           = 0x01
+	npiUnknown        = 0x00
+	dataCodingDefault = 0x00
+	dataCodingUCS2    = 0x08
+
+	// messages longer than maxShortMessageLength are sent in the message_payload tlv
+	maxShortMessageLength = 254
+	tagMessagePayload     = 0x0424
+)
+
+type pdu struct {
+	commandID uint32
+	status    uint32
+	sequence  uint32
+	body      []byte
+}
+
+func (p *pdu) write(w io.Writer) error {
+	header := make([]byte, headerLength)
+	binary.BigEndian.PutUint32(header[0:], uint32(headerLength+len(p.body)))
+	binary.BigEndian.PutUint32(header[4:], p.commandID)
+	binary.BigEndian.PutUint32(header[8:], p.status)
+	binary.BigEndian.PutUint32(header[12:], p.sequence)
+	_, err := w.Write(append(header, p.body...))
+	return err
+}
+
+func readPDU(r *bufio.Reader) (*pdu, error) {
+	header := make([]byte, headerLength)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, err
+	}
+	length := binary.BigEndian.Uint32(header[0:])
+	if length < headerLength || length > maxPDULength {
+		return nil, caos_errs.ThrowInternalf(nil, "SMPP-Lk3d8", "invalid pdu length %d", length)
+	}
+	p := &pdu{
+		commandID: binary.BigEndian.Uint32(header[4:]),
+		status:    binary.BigEndian.Uint32(header[8:]),
+		sequence:  binary.BigEndian.Uint32(header[12:]),
+		body:      make([]byte, length-headerLength),
+	}
+	if _, err := io.ReadFull(r, p.body); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+type bodyBuilder struct {
+	bytes.Buffer
+}
+
+func (b *bodyBuilder) cString(value string) {
+	b.WriteString(value)
+	b.WriteByte(0)
+}
+
+func bindTransmitterBody(config *SMPPConfig) []byte {
+	b := new(bodyBuilder)
+	b.cString(config.SystemID)
+	b.cString(config.Password)
+	b.cString(config.SystemType)
+	b.WriteByte(interfaceVersion)
+	b.WriteByte(0) // addr_ton
+	b.WriteByte(0) // addr_npi
+	b.cString("")  // address_range
+	return b.Bytes()
+}
+
+func submitSMBody(sender, recipient, content string) []byte {
+	b := new(bodyBuilder)
+	b.cString("") // service_type
+	senderTON, senderNPI := addressType(sender)
+	b.WriteByte(senderTON)
+	b.WriteByte(senderNPI)
+	b.cString(strings.TrimPrefix(sender, "+"))
+	recipientTON, recipientNPI := addressType(recipient)
+	b.WriteByte(recipientTON)
+	b.WriteByte(recipientNPI)
+	b.cString(strings.TrimPrefix(recipient, "+"))
+	b.WriteByte(0) // esm_class
+	b.WriteByte(0) // protocol_id
+	b.WriteByte(0) // priority_flag
+	b.cString("")  // schedule_delivery_time
+	b.cString("")  // validity_period
+	b.WriteByte(0) // registered_delivery
+	b.WriteByte(0) // replace_if_present_flag
+	dataCoding, message := encodeMessage(content)
+	b.WriteByte(dataCoding)
+	b.WriteByte(0) // sm_default_msg_id
+	if len(message) <= maxShortMessageLength {
+		b.WriteByte(byte(len(message)))
+		b.Write(message)
+		return b.Bytes()
+	}
+	b.WriteByte(0) // sm_length
+	tlv := make([]byte, 4)
+	binary.BigEndian.PutUint16(tlv[0:], tagMessagePayload)
+	binary.BigEndian.PutUint16(tlv[2:], uint16(len(message)))
+	b.Write(tlv)
+	b.Write(message)
+	return b.Bytes()
+}
+
+// addressType returns ton and npi of the address
+// numbers are sent in international format, everything else as alphanumeric sender id
+func addressType(address string) (ton, npi byte) {
+	for _, r := range strings.TrimPrefix(address, "+") {
+		if !unicode.IsDigit(r) {
+			return tonAlphanumeric, npiUnknown
+		}
+	}
+	return tonInternational, npiISDN
+}
+
+// encodeMessage sends ascii content in the default alphabet of the smsc
+// and everything else as ucs2
+func encodeMessage(content string) (byte, []byte) {
+	isASCII := true
+	for _, r := range content {
+		if r > unicode.MaxASCII {
+			isASCII = false
+			break
+		}
+	}
+	if isASCII {
+		return dataCodingDefault, []byte(content)
+	}
+	encoded := utf16.Encode([]rune(content))
+	message := make([]byte, len(encoded)*2)
+	for i, c := range encoded {
+		binary.BigEndian.PutUint16(message[i*2:], c)
+	}
+	return dataCodingUCS2, message
+}
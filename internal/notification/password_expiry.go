@@ -0,0 +1,126 @@
+package notification
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/dennigogo/zitadel/internal/api/authz"
+	"github.com/dennigogo/zitadel/internal/domain"
+	"github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/eventstore"
+	"github.com/dennigogo/zitadel/internal/eventstore/handler"
+	"github.com/dennigogo/zitadel/internal/eventstore/handler/crdb"
+	"github.com/dennigogo/zitadel/internal/notification/types"
+	"github.com/dennigogo/zitadel/internal/repository/user"
+)
+
+const (
+	// passwordExpiryBatchSize limits the notifications requested per instance and check,
+	// the remaining users are requested by the next checks
+	passwordExpiryBatchSize = 100
+	passwordExpiryTimeout   = time.Minute
+)
+
+// notifyPasswordExpiryPeriodically requests the notification of the users,
+// whose password expires within the warn days of their password age policy.
+// The notifications are sent by the projection, so each user is notified once, even if multiple instances of ZITADEL run the check.
+func (p *notificationsProjection) notifyPasswordExpiryPeriodically(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			notifyCtx, cancel := context.WithTimeout(ctx, passwordExpiryTimeout)
+			p.requestPasswordExpiryNotifications(notifyCtx)
+			cancel()
+		}
+	}
+}
+
+// requestPasswordExpiryNotifications marks the requested users as notified,
+// so failing notifications are retried by the projection and don't block the check
+func (p *notificationsProjection) requestPasswordExpiryNotifications(ctx context.Context) {
+	expiries, err := p.queries.PasswordExpiryNotifications(ctx, time.Now(), passwordExpiryBatchSize)
+	if err != nil {
+		logging.WithError(err).Warn("unable to query expiring passwords")
+		return
+	}
+	for _, expiry := range expiries {
+		requestCtx := authz.WithInstanceID(ctx, expiry.InstanceID)
+		requestCtx = authz.SetCtxData(requestCtx, authz.CtxData{UserID: NotifyUserID, OrgID: expiry.ResourceOwner})
+		err = p.commands.RequestHumanPasswordExpiryNotification(requestCtx, expiry.ResourceOwner, expiry.UserID, expiry.ExpirationDate())
+		if errors.IsPreconditionFailed(err) {
+			// already requested by another instance of ZITADEL
+			continue
+		}
+		logging.WithFields("instance", expiry.InstanceID, "user", expiry.UserID).OnError(err).Warn("unable to request password expiry notification")
+	}
+}
+
+func (p *notificationsProjection) reducePasswordExpiryNotificationRequested(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanPasswordExpiryNotificationRequestedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Pe3xr", "reduce.wrong.event.type %s", user.HumanPasswordExpiryNotificationRequestedType)
+	}
+	// the password already expired, the user has to change it on the next login anyway
+	if e.ExpirationDate.Before(time.Now()) {
+		return crdb.NewNoOpStatement(e), nil
+	}
+	ctx := setNotificationContext(event.Aggregate())
+	// the notification is obsolete if it was sent by a previous request or the password was changed since
+	alreadyHandled, err := p.checkIfAlreadyHandled(ctx, event, nil,
+		user.HumanPasswordExpiryNotificationSentType, user.HumanPasswordChangedType, user.UserV1PasswordChangedType)
+	if err != nil {
+		return nil, err
+	}
+	if alreadyHandled || p.quotaExhausted(ctx) {
+		return crdb.NewNoOpStatement(e), nil
+	}
+	colors, err := p.queries.ActiveLabelPolicyByOrg(ctx, e.Aggregate().ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := p.queries.MailTemplateByOrg(ctx, e.Aggregate().ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	notifyUser, err := p.queries.GetNotifyUserByID(ctx, true, e.Aggregate().ID)
+	if err != nil {
+		return nil, err
+	}
+	translator, err := p.getTranslatorWithOrgTexts(ctx, notifyUser.ResourceOwner, domain.PasswordExpiryMessageType)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, origin, err := p.origin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = types.SendEmail(
+		ctx,
+		string(template.Template),
+		translator,
+		notifyUser,
+		p.getSMTPConfig,
+		p.getFileSystemProvider,
+		p.getLogProvider,
+		colors,
+		p.assetsPrefix(ctx),
+	).SendPasswordExpiry(notifyUser, origin, e.ExpirationDate)
+	if err != nil {
+		return nil, err
+	}
+	err = p.commands.HumanPasswordExpiryNotificationSent(ctx, e.Aggregate().ResourceOwner, e.Aggregate().ID, e.ExpirationDate)
+	if err != nil {
+		return nil, err
+	}
+	p.reportQuota(ctx)
+	return crdb.NewNoOpStatement(e), nil
+}
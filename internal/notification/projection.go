@@ -19,26 +19,35 @@ import (
 	"github.com/dennigogo/zitadel/internal/eventstore/handler/crdb"
 	"github.com/dennigogo/zitadel/internal/i18n"
 	"github.com/dennigogo/zitadel/internal/notification/channels/fs"
+	sms_http "github.com/dennigogo/zitadel/internal/notification/channels/http"
 	"github.com/dennigogo/zitadel/internal/notification/channels/log"
+	"github.com/dennigogo/zitadel/internal/notification/channels/smpp"
 	"github.com/dennigogo/zitadel/internal/notification/channels/smtp"
 	"github.com/dennigogo/zitadel/internal/notification/channels/twilio"
+	"github.com/dennigogo/zitadel/internal/notification/senders"
 	_ "github.com/dennigogo/zitadel/internal/notification/statik"
 	"github.com/dennigogo/zitadel/internal/notification/types"
 	"github.com/dennigogo/zitadel/internal/query"
 	"github.com/dennigogo/zitadel/internal/query/projection"
+	"github.com/dennigogo/zitadel/internal/quota"
 	"github.com/dennigogo/zitadel/internal/repository/user"
 )
 
 const (
 	NotificationsProjectionTable = "projections.notifications"
 	NotifyUserID                 = "NOTIFICATION" //TODO: system?
+	recoveryCodesLowThreshold    = 3
 )
 
-func Start(ctx context.Context, customConfig projection.CustomConfig, externalPort uint16, externalSecure bool, commands *command.Commands, queries *query.Queries, es *eventstore.Eventstore, assetsPrefix func(context.Context) string, fileSystemPath string, userEncryption, smtpEncryption, smsEncryption crypto.EncryptionAlgorithm) {
+func Start(ctx context.Context, customConfig projection.CustomConfig, externalPort uint16, externalSecure bool, commands *command.Commands, queries *query.Queries, es *eventstore.Eventstore, assetsPrefix func(context.Context) string, fileSystemPath string, passwordExpiryCheckInterval time.Duration, userEncryption, smtpEncryption, smsEncryption crypto.EncryptionAlgorithm, quotas quota.Enforcer) {
 	statikFS, err := statik_fs.NewWithNamespace("notification")
 	logging.OnError(err).Panic("unable to start listener")
 
-	projection.NotificationsProjection = newNotificationsProjection(ctx, projection.ApplyCustomConfig(customConfig), commands, queries, es, userEncryption, smtpEncryption, smsEncryption, externalSecure, externalPort, fileSystemPath, assetsPrefix, statikFS)
+	p := newNotificationsProjection(ctx, projection.ApplyCustomConfig(customConfig), commands, queries, es, userEncryption, smtpEncryption, smsEncryption, externalSecure, externalPort, fileSystemPath, assetsPrefix, statikFS, quotas)
+	projection.NotificationsProjection = p
+	if passwordExpiryCheckInterval > 0 {
+		go p.notifyPasswordExpiryPeriodically(ctx, passwordExpiryCheckInterval)
+	}
 }
 
 type notificationsProjection struct {
@@ -54,6 +63,7 @@ type notificationsProjection struct {
 	externalPort       uint16
 	externalSecure     bool
 	statikDir          http.FileSystem
+	quotas             quota.Enforcer
 }
 
 func newNotificationsProjection(
@@ -70,6 +80,7 @@ func newNotificationsProjection(
 	fileSystemPath string,
 	assetsPrefix func(context.Context) string,
 	statikDir http.FileSystem,
+	quotas quota.Enforcer,
 ) *notificationsProjection {
 	p := new(notificationsProjection)
 	config.ProjectionName = NotificationsProjectionTable
@@ -86,6 +97,7 @@ func newNotificationsProjection(
 	p.externalSecure = externalSecure
 	p.fileSystemPath = fileSystemPath
 	p.statikDir = statikDir
+	p.quotas = quotas
 
 	return p
 }
@@ -135,6 +147,26 @@ func (p *notificationsProjection) reducers() []handler.AggregateReducer {
 					Event:  user.HumanPhoneCodeAddedType,
 					Reduce: p.reducePhoneCodeAdded,
 				},
+				{
+					Event:  user.HumanOTPSMSCodeAddedType,
+					Reduce: p.reduceOTPSMSCodeAdded,
+				},
+				{
+					Event:  user.HumanOTPEmailCodeAddedType,
+					Reduce: p.reduceOTPEmailCodeAdded,
+				},
+				{
+					Event:  user.HumanRecoveryCodeCheckSucceededType,
+					Reduce: p.reduceRecoveryCodeCheckSucceeded,
+				},
+				{
+					Event:  user.UserLockedType,
+					Reduce: p.reduceUserLocked,
+				},
+				{
+					Event:  user.HumanPasswordExpiryNotificationRequestedType,
+					Reduce: p.reducePasswordExpiryNotificationRequested,
+				},
 			},
 		},
 	}
@@ -152,7 +184,7 @@ func (p *notificationsProjection) reduceInitCodeAdded(event eventstore.Event) (*
 	if err != nil {
 		return nil, err
 	}
-	if alreadyHandled {
+	if alreadyHandled || p.quotaExhausted(ctx) {
 		return crdb.NewNoOpStatement(e), nil
 	}
 	code, err := crypto.DecryptString(e.Code, p.userDataCrypto)
@@ -200,6 +232,7 @@ func (p *notificationsProjection) reduceInitCodeAdded(event eventstore.Event) (*
 	if err != nil {
 		return nil, err
 	}
+	p.reportQuota(ctx)
 	return crdb.NewNoOpStatement(e), nil
 }
 
@@ -215,7 +248,7 @@ func (p *notificationsProjection) reduceEmailCodeAdded(event eventstore.Event) (
 	if err != nil {
 		return nil, err
 	}
-	if alreadyHandled {
+	if alreadyHandled || p.quotaExhausted(ctx) {
 		return crdb.NewNoOpStatement(e), nil
 	}
 	code, err := crypto.DecryptString(e.Code, p.userDataCrypto)
@@ -263,6 +296,7 @@ func (p *notificationsProjection) reduceEmailCodeAdded(event eventstore.Event) (
 	if err != nil {
 		return nil, err
 	}
+	p.reportQuota(ctx)
 	return crdb.NewNoOpStatement(e), nil
 }
 
@@ -278,7 +312,7 @@ func (p *notificationsProjection) reducePasswordCodeAdded(event eventstore.Event
 	if err != nil {
 		return nil, err
 	}
-	if alreadyHandled {
+	if alreadyHandled || p.quotaExhausted(ctx) {
 		return crdb.NewNoOpStatement(e), nil
 	}
 	code, err := crypto.DecryptString(e.Code, p.userDataCrypto)
@@ -320,11 +354,11 @@ func (p *notificationsProjection) reducePasswordCodeAdded(event eventstore.Event
 		p.assetsPrefix(ctx),
 	)
 	if e.NotificationType == domain.NotificationTypeSms {
-		notify = types.SendSMSTwilio(
+		notify = types.SendSMS(
 			ctx,
 			translator,
 			notifyUser,
-			p.getTwilioConfig,
+			p.getSMSConfig,
 			p.getFileSystemProvider,
 			p.getLogProvider,
 			colors,
@@ -339,6 +373,7 @@ func (p *notificationsProjection) reducePasswordCodeAdded(event eventstore.Event
 	if err != nil {
 		return nil, err
 	}
+	p.reportQuota(ctx)
 	return crdb.NewNoOpStatement(e), nil
 }
 
@@ -353,7 +388,7 @@ func (p *notificationsProjection) reduceDomainClaimed(event eventstore.Event) (*
 	if err != nil {
 		return nil, err
 	}
-	if alreadyHandled {
+	if alreadyHandled || p.quotaExhausted(ctx) {
 		return crdb.NewNoOpStatement(e), nil
 	}
 	colors, err := p.queries.ActiveLabelPolicyByOrg(ctx, e.Aggregate().ResourceOwner)
@@ -397,6 +432,7 @@ func (p *notificationsProjection) reduceDomainClaimed(event eventstore.Event) (*
 	if err != nil {
 		return nil, err
 	}
+	p.reportQuota(ctx)
 	return crdb.NewNoOpStatement(e), nil
 }
 
@@ -410,7 +446,7 @@ func (p *notificationsProjection) reducePasswordlessCodeRequested(event eventsto
 	if err != nil {
 		return nil, err
 	}
-	if alreadyHandled {
+	if alreadyHandled || p.quotaExhausted(ctx) {
 		return crdb.NewNoOpStatement(e), nil
 	}
 	code, err := crypto.DecryptString(e.Code, p.userDataCrypto)
@@ -458,6 +494,7 @@ func (p *notificationsProjection) reducePasswordlessCodeRequested(event eventsto
 	if err != nil {
 		return nil, err
 	}
+	p.reportQuota(ctx)
 	return crdb.NewNoOpStatement(e), nil
 }
 
@@ -473,7 +510,7 @@ func (p *notificationsProjection) reducePhoneCodeAdded(event eventstore.Event) (
 	if err != nil {
 		return nil, err
 	}
-	if alreadyHandled {
+	if alreadyHandled || p.quotaExhausted(ctx) {
 		return crdb.NewNoOpStatement(e), nil
 	}
 	code, err := crypto.DecryptString(e.Code, p.userDataCrypto)
@@ -498,11 +535,11 @@ func (p *notificationsProjection) reducePhoneCodeAdded(event eventstore.Event) (
 	if err != nil {
 		return nil, err
 	}
-	err = types.SendSMSTwilio(
+	err = types.SendSMS(
 		ctx,
 		translator,
 		notifyUser,
-		p.getTwilioConfig,
+		p.getSMSConfig,
 		p.getFileSystemProvider,
 		p.getLogProvider,
 		colors,
@@ -515,6 +552,263 @@ func (p *notificationsProjection) reducePhoneCodeAdded(event eventstore.Event) (
 	if err != nil {
 		return nil, err
 	}
+	p.reportQuota(ctx)
+	return crdb.NewNoOpStatement(e), nil
+}
+
+func (p *notificationsProjection) reduceOTPSMSCodeAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanOTPSMSCodeAddedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-ASF3g", "reduce.wrong.event.type %s", user.HumanOTPSMSCodeAddedType)
+	}
+	ctx := setNotificationContext(event.Aggregate())
+	alreadyHandled, err := p.checkIfCodeAlreadyHandledOrExpired(ctx, event, e.Expiry, nil,
+		user.HumanOTPSMSCodeAddedType, user.HumanOTPSMSCodeSentType)
+	if err != nil {
+		return nil, err
+	}
+	if alreadyHandled || p.quotaExhausted(ctx) {
+		return crdb.NewNoOpStatement(e), nil
+	}
+	code, err := crypto.DecryptString(e.Code, p.userDataCrypto)
+	if err != nil {
+		return nil, err
+	}
+	colors, err := p.queries.ActiveLabelPolicyByOrg(ctx, e.Aggregate().ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	notifyUser, err := p.queries.GetNotifyUserByID(ctx, true, e.Aggregate().ID)
+	if err != nil {
+		return nil, err
+	}
+	translator, err := p.getTranslatorWithOrgTexts(ctx, notifyUser.ResourceOwner, domain.VerifySMSOTPMessageType)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, origin, err := p.origin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = types.SendSMS(
+		ctx,
+		translator,
+		notifyUser,
+		p.getSMSConfig,
+		p.getFileSystemProvider,
+		p.getLogProvider,
+		colors,
+		p.assetsPrefix(ctx),
+	).SendOTPSMSCode(notifyUser, origin, code)
+	if err != nil {
+		return nil, err
+	}
+	err = p.commands.HumanOTPSMSCodeSent(ctx, e.Aggregate().ID, e.Aggregate().ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	p.reportQuota(ctx)
+	return crdb.NewNoOpStatement(e), nil
+}
+
+func (p *notificationsProjection) reduceOTPEmailCodeAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanOTPEmailCodeAddedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-JL3hw", "reduce.wrong.event.type %s", user.HumanOTPEmailCodeAddedType)
+	}
+	ctx := setNotificationContext(event.Aggregate())
+	alreadyHandled, err := p.checkIfCodeAlreadyHandledOrExpired(ctx, event, e.Expiry, nil,
+		user.HumanOTPEmailCodeAddedType, user.HumanOTPEmailCodeSentType)
+	if err != nil {
+		return nil, err
+	}
+	if alreadyHandled || p.quotaExhausted(ctx) {
+		return crdb.NewNoOpStatement(e), nil
+	}
+	code, err := crypto.DecryptString(e.Code, p.userDataCrypto)
+	if err != nil {
+		return nil, err
+	}
+	colors, err := p.queries.ActiveLabelPolicyByOrg(ctx, e.Aggregate().ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := p.queries.MailTemplateByOrg(ctx, e.Aggregate().ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	notifyUser, err := p.queries.GetNotifyUserByID(ctx, true, e.Aggregate().ID)
+	if err != nil {
+		return nil, err
+	}
+	translator, err := p.getTranslatorWithOrgTexts(ctx, notifyUser.ResourceOwner, domain.VerifyEmailOTPMessageType)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, origin, err := p.origin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = types.SendEmail(
+		ctx,
+		string(template.Template),
+		translator,
+		notifyUser,
+		p.getSMTPConfig,
+		p.getFileSystemProvider,
+		p.getLogProvider,
+		colors,
+		p.assetsPrefix(ctx),
+	).SendOTPEmailCode(notifyUser, origin, code)
+	if err != nil {
+		return nil, err
+	}
+	err = p.commands.HumanOTPEmailCodeSent(ctx, e.Aggregate().ID, e.Aggregate().ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	p.reportQuota(ctx)
+	return crdb.NewNoOpStatement(e), nil
+}
+
+func (p *notificationsProjection) reduceRecoveryCodeCheckSucceeded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanRecoveryCodeCheckSucceededEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Rc4sM", "reduce.wrong.event.type %s", user.HumanRecoveryCodeCheckSucceededType)
+	}
+	ctx := setNotificationContext(event.Aggregate())
+	alreadyHandled, err := p.checkIfAlreadyHandled(ctx, event, nil,
+		user.HumanRecoveryCodeCheckSucceededType, user.HumanRecoveryCodeNotificationSentType)
+	if err != nil {
+		return nil, err
+	}
+	if alreadyHandled || p.quotaExhausted(ctx) {
+		return crdb.NewNoOpStatement(e), nil
+	}
+	colors, err := p.queries.ActiveLabelPolicyByOrg(ctx, e.Aggregate().ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := p.queries.MailTemplateByOrg(ctx, e.Aggregate().ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	notifyUser, err := p.queries.GetNotifyUserByID(ctx, true, e.Aggregate().ID)
+	if err != nil {
+		return nil, err
+	}
+	messageType := domain.RecoveryCodeUsedMessageType
+	if e.RemainingCodes <= recoveryCodesLowThreshold {
+		messageType = domain.RecoveryCodesLowMessageType
+	}
+	translator, err := p.getTranslatorWithOrgTexts(ctx, notifyUser.ResourceOwner, messageType)
+	if err != nil {
+		return nil, err
+	}
+
+	notify := types.SendEmail(
+		ctx,
+		string(template.Template),
+		translator,
+		notifyUser,
+		p.getSMTPConfig,
+		p.getFileSystemProvider,
+		p.getLogProvider,
+		colors,
+		p.assetsPrefix(ctx),
+	)
+	if messageType == domain.RecoveryCodesLowMessageType {
+		err = notify.SendRecoveryCodesLow(notifyUser, e.RemainingCodes)
+	} else {
+		err = notify.SendRecoveryCodeUsed(notifyUser, e.RemainingCodes)
+	}
+	if err != nil {
+		return nil, err
+	}
+	err = p.commands.HumanRecoveryCodeNotificationSent(ctx, e.Aggregate().ID, e.Aggregate().ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	p.reportQuota(ctx)
+	return crdb.NewNoOpStatement(e), nil
+}
+
+func (p *notificationsProjection) reduceUserLocked(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.UserLockedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Lo3kd", "reduce.wrong.event.type %s", user.UserLockedType)
+	}
+	// users locked manually are not notified, only the ones locked by the lockout policy
+	if !e.AutoUnlock {
+		return crdb.NewNoOpStatement(e), nil
+	}
+	ctx := setNotificationContext(event.Aggregate())
+	alreadyHandled, err := p.checkIfAlreadyHandled(ctx, event, nil,
+		user.UserLockedType, user.UserLockedNotificationSentType)
+	if err != nil {
+		return nil, err
+	}
+	if alreadyHandled || p.quotaExhausted(ctx) {
+		return crdb.NewNoOpStatement(e), nil
+	}
+	colors, err := p.queries.ActiveLabelPolicyByOrg(ctx, e.Aggregate().ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := p.queries.MailTemplateByOrg(ctx, e.Aggregate().ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	lockoutPolicy, err := p.queries.LockoutPolicyByOrg(ctx, true, e.Aggregate().ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	var unlockDate time.Time
+	if lockoutPolicy.AutoUnlockAfter > 0 {
+		unlockDate = e.CreationDate().Add(lockoutPolicy.AutoUnlockAfter)
+	}
+
+	notifyUser, err := p.queries.GetNotifyUserByID(ctx, true, e.Aggregate().ID)
+	if err != nil {
+		return nil, err
+	}
+	translator, err := p.getTranslatorWithOrgTexts(ctx, notifyUser.ResourceOwner, domain.UserLockedMessageType)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, origin, err := p.origin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = types.SendEmail(
+		ctx,
+		string(template.Template),
+		translator,
+		notifyUser,
+		p.getSMTPConfig,
+		p.getFileSystemProvider,
+		p.getLogProvider,
+		colors,
+		p.assetsPrefix(ctx),
+	).SendUserLocked(notifyUser, origin, unlockDate)
+	if err != nil {
+		return nil, err
+	}
+	err = p.commands.UserLockedNotificationSent(ctx, e.Aggregate().ResourceOwner, e.Aggregate().ID)
+	if err != nil {
+		return nil, err
+	}
+	p.reportQuota(ctx)
 	return crdb.NewNoOpStatement(e), nil
 }
 
@@ -564,8 +858,8 @@ func (p *notificationsProjection) getSMTPConfig(ctx context.Context) (*smtp.Emai
 	}, nil
 }
 
-// Read iam twilio config
-func (p *notificationsProjection) getTwilioConfig(ctx context.Context) (*twilio.TwilioConfig, error) {
+// Read iam sms provider config
+func (p *notificationsProjection) getSMSConfig(ctx context.Context) (*senders.SMSConfig, error) {
 	active, err := query.NewSMSProviderStateQuery(domain.SMSConfigStateActive)
 	if err != nil {
 		return nil, err
@@ -574,18 +868,60 @@ func (p *notificationsProjection) getTwilioConfig(ctx context.Context) (*twilio.
 	if err != nil {
 		return nil, err
 	}
-	if config.TwilioConfig == nil {
-		return nil, errors.ThrowNotFound(nil, "HANDLER-8nfow", "Errors.SMS.Twilio.NotFound")
-	}
-	token, err := crypto.DecryptString(config.TwilioConfig.Token, p.smsTokenCrypto)
-	if err != nil {
-		return nil, err
+	switch {
+	case config.TwilioConfig != nil:
+		token, err := crypto.DecryptString(config.TwilioConfig.Token, p.smsTokenCrypto)
+		if err != nil {
+			return nil, err
+		}
+		return &senders.SMSConfig{
+			Twilio: &twilio.TwilioConfig{
+				SID:          config.TwilioConfig.SID,
+				Token:        token,
+				SenderNumber: config.TwilioConfig.SenderNumber,
+			},
+		}, nil
+	case config.HTTPConfig != nil:
+		var authHeaderValue string
+		if config.HTTPConfig.AuthHeaderValue != nil {
+			authHeaderValue, err = crypto.DecryptString(config.HTTPConfig.AuthHeaderValue, p.smsTokenCrypto)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return &senders.SMSConfig{
+			HTTP: &sms_http.HTTPConfig{
+				Endpoint:           config.HTTPConfig.Endpoint,
+				Method:             config.HTTPConfig.Method,
+				ContentType:        config.HTTPConfig.ContentType,
+				BodyTemplate:       config.HTTPConfig.BodyTemplate,
+				AuthHeaderName:     config.HTTPConfig.AuthHeaderName,
+				AuthHeaderValue:    authHeaderValue,
+				SuccessStatusCodes: config.HTTPConfig.SuccessStatusCodes,
+				SenderNumber:       config.HTTPConfig.SenderNumber,
+			},
+		}, nil
+	case config.SMPPConfig != nil:
+		var password string
+		if config.SMPPConfig.Password != nil {
+			password, err = crypto.DecryptString(config.SMPPConfig.Password, p.smsTokenCrypto)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return &senders.SMSConfig{
+			SMPP: &smpp.SMPPConfig{
+				Host:         config.SMPPConfig.Host,
+				Port:         config.SMPPConfig.Port,
+				TLS:          config.SMPPConfig.TLS,
+				SystemID:     config.SMPPConfig.SystemID,
+				Password:     password,
+				SystemType:   config.SMPPConfig.SystemType,
+				SenderNumber: config.SMPPConfig.SenderNumber,
+			},
+		}, nil
 	}
-	return &twilio.TwilioConfig{
-		SID:          config.TwilioConfig.SID,
-		Token:        token,
-		SenderNumber: config.TwilioConfig.SenderNumber,
-	}, nil
+	return nil, errors.ThrowNotFound(nil, "HANDLER-8nfow", "Errors.SMSConfig.NotFound")
 }
 
 // Read iam filesystem provider config
@@ -658,6 +994,19 @@ func (p *notificationsProjection) origin(ctx context.Context) (context.Context,
 	return ctx, http_utils.BuildHTTP(domains.Domains[0].Domain, p.externalPort, p.externalSecure), nil
 }
 
+// quotaExhausted checks the limit of the notifications of the instance,
+// notifications exceeding it are dropped
+func (p *notificationsProjection) quotaExhausted(ctx context.Context) bool {
+	return p.quotas != nil && p.quotas.Exhausted(ctx, domain.QuotaUnitNotificationsAll)
+}
+
+// reportQuota meters a sent notification
+func (p *notificationsProjection) reportQuota(ctx context.Context) {
+	if p.quotas != nil {
+		p.quotas.Report(ctx, domain.QuotaUnitNotificationsAll, 1)
+	}
+}
+
 func setNotificationContext(event eventstore.Aggregate) context.Context {
 	ctx := authz.WithInstanceID(context.Background(), event.InstanceID)
 	return authz.SetCtxData(ctx, authz.CtxData{UserID: NotifyUserID, OrgID: event.ResourceOwner})
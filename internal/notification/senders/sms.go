@@ -5,14 +5,43 @@ import (
 
 	"github.com/dennigogo/zitadel/internal/notification/channels"
 	"github.com/dennigogo/zitadel/internal/notification/channels/fs"
+	sms_http "github.com/dennigogo/zitadel/internal/notification/channels/http"
 	"github.com/dennigogo/zitadel/internal/notification/channels/log"
+	"github.com/dennigogo/zitadel/internal/notification/channels/smpp"
 	"github.com/dennigogo/zitadel/internal/notification/channels/twilio"
 )
 
-func SMSChannels(ctx context.Context, twilioConfig *twilio.TwilioConfig, getFileSystemProvider func(ctx context.Context) (*fs.FSConfig, error), getLogProvider func(ctx context.Context) (*log.LogConfig, error)) (chain *Chain, err error) {
+// SMSConfig is the active sms provider, only one of the configs is set
+type SMSConfig struct {
+	Twilio *twilio.TwilioConfig
+	HTTP   *sms_http.HTTPConfig
+	SMPP   *smpp.SMPPConfig
+}
+
+func (c *SMSConfig) SenderNumber() string {
+	switch {
+	case c.Twilio != nil:
+		return c.Twilio.SenderNumber
+	case c.HTTP != nil:
+		return c.HTTP.SenderNumber
+	case c.SMPP != nil:
+		return c.SMPP.SenderNumber
+	default:
+		return ""
+	}
+}
+
+func SMSChannels(ctx context.Context, smsConfig *SMSConfig, getFileSystemProvider func(ctx context.Context) (*fs.FSConfig, error), getLogProvider func(ctx context.Context) (*log.LogConfig, error)) (chain *Chain, err error) {
 	channels := make([]channels.NotificationChannel, 0, 3)
-	if twilioConfig != nil {
-		channels = append(channels, twilio.InitTwilioChannel(*twilioConfig))
+	if smsConfig != nil {
+		switch {
+		case smsConfig.Twilio != nil:
+			channels = append(channels, twilio.InitTwilioChannel(*smsConfig.Twilio))
+		case smsConfig.HTTP != nil:
+			channels = append(channels, sms_http.InitHTTPChannel(*smsConfig.HTTP))
+		case smsConfig.SMPP != nil:
+			channels = append(channels, smpp.InitSMPPChannel(*smsConfig.SMPP))
+		}
 	}
 	channels = append(channels, debugChannels(ctx, getFileSystemProvider, getLogProvider)...)
 	return chainChannels(channels...), nil
@@ -7,7 +7,7 @@ import (
 	"github.com/dennigogo/zitadel/internal/notification/channels/fs"
 	"github.com/dennigogo/zitadel/internal/notification/channels/log"
 	"github.com/dennigogo/zitadel/internal/notification/channels/smtp"
-	"github.com/dennigogo/zitadel/internal/notification/channels/twilio"
+	"github.com/dennigogo/zitadel/internal/notification/senders"
 	"github.com/dennigogo/zitadel/internal/notification/templates"
 	"github.com/dennigogo/zitadel/internal/query"
 )
@@ -46,11 +46,11 @@ func SendEmail(
 	}
 }
 
-func SendSMSTwilio(
+func SendSMS(
 	ctx context.Context,
 	translator *i18n.Translator,
 	user *query.NotifyUser,
-	twilioConfig func(ctx context.Context) (*twilio.TwilioConfig, error),
+	smsConfig func(ctx context.Context) (*senders.SMSConfig, error),
 	getFileSystemProvider func(ctx context.Context) (*fs.FSConfig, error),
 	getLogProvider func(ctx context.Context) (*log.LogConfig, error),
 	colors *query.LabelPolicy,
@@ -64,7 +64,7 @@ func SendSMSTwilio(
 	) error {
 		args = mapNotifyUserToArgs(user, args)
 		data := GetTemplateData(translator, args, assetsPrefix, url, messageType, user.PreferredLanguage.String(), colors)
-		return generateSms(ctx, user, data.Text, twilioConfig, getFileSystemProvider, getLogProvider, allowUnverifiedNotificationChannel)
+		return generateSms(ctx, user, data.Text, smsConfig, getFileSystemProvider, getLogProvider, allowUnverifiedNotificationChannel)
 	}
 }
 
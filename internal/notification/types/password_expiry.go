@@ -0,0 +1,16 @@
+package types
+
+import (
+	"time"
+
+	"github.com/dennigogo/zitadel/internal/api/ui/login"
+	"github.com/dennigogo/zitadel/internal/domain"
+	"github.com/dennigogo/zitadel/internal/query"
+)
+
+func (notify Notify) SendPasswordExpiry(user *query.NotifyUser, origin string, expirationDate time.Time) error {
+	url := login.LoginLink(origin, user.ResourceOwner)
+	args := make(map[string]interface{})
+	args["ExpirationDate"] = expirationDate.Format(time.RFC1123)
+	return notify(url, args, domain.PasswordExpiryMessageType, true)
+}
@@ -0,0 +1,18 @@
+package types
+
+import (
+	"github.com/dennigogo/zitadel/internal/domain"
+	"github.com/dennigogo/zitadel/internal/query"
+)
+
+func (notify Notify) SendRecoveryCodeUsed(user *query.NotifyUser, remainingCodes int) error {
+	args := make(map[string]interface{})
+	args["RemainingCodes"] = remainingCodes
+	return notify("", args, domain.RecoveryCodeUsedMessageType, true)
+}
+
+func (notify Notify) SendRecoveryCodesLow(user *query.NotifyUser, remainingCodes int) error {
+	args := make(map[string]interface{})
+	args["RemainingCodes"] = remainingCodes
+	return notify("", args, domain.RecoveryCodesLowMessageType, true)
+}
@@ -0,0 +1,18 @@
+package types
+
+import (
+	"time"
+
+	"github.com/dennigogo/zitadel/internal/api/ui/login"
+	"github.com/dennigogo/zitadel/internal/domain"
+	"github.com/dennigogo/zitadel/internal/query"
+)
+
+func (notify Notify) SendUserLocked(user *query.NotifyUser, origin string, unlockDate time.Time) error {
+	url := login.LoginLink(origin, user.ResourceOwner)
+	args := make(map[string]interface{})
+	if !unlockDate.IsZero() {
+		args["UnlockDate"] = unlockDate.Format(time.RFC1123)
+	}
+	return notify(url, args, domain.UserLockedMessageType, true)
+}
@@ -8,17 +8,16 @@ import (
 	caos_errors "github.com/dennigogo/zitadel/internal/errors"
 	"github.com/dennigogo/zitadel/internal/notification/channels/fs"
 	"github.com/dennigogo/zitadel/internal/notification/channels/log"
-	"github.com/dennigogo/zitadel/internal/notification/channels/twilio"
 	"github.com/dennigogo/zitadel/internal/notification/messages"
 	"github.com/dennigogo/zitadel/internal/notification/senders"
 	"github.com/dennigogo/zitadel/internal/query"
 )
 
-func generateSms(ctx context.Context, user *query.NotifyUser, content string, getTwilioProvider func(ctx context.Context) (*twilio.TwilioConfig, error), getFileSystemProvider func(ctx context.Context) (*fs.FSConfig, error), getLogProvider func(ctx context.Context) (*log.LogConfig, error), lastPhone bool) error {
+func generateSms(ctx context.Context, user *query.NotifyUser, content string, getSMSProvider func(ctx context.Context) (*senders.SMSConfig, error), getFileSystemProvider func(ctx context.Context) (*fs.FSConfig, error), getLogProvider func(ctx context.Context) (*log.LogConfig, error), lastPhone bool) error {
 	number := ""
-	twilioConfig, err := getTwilioProvider(ctx)
+	smsConfig, err := getSMSProvider(ctx)
 	if err == nil {
-		number = twilioConfig.SenderNumber
+		number = smsConfig.SenderNumber()
 	}
 	message := &messages.SMS{
 		SenderPhoneNumber:    number,
@@ -29,7 +28,7 @@ func generateSms(ctx context.Context, user *query.NotifyUser, content string, ge
 		message.RecipientPhoneNumber = user.LastPhone
 	}
 
-	channelChain, err := senders.SMSChannels(ctx, twilioConfig, getFileSystemProvider, getLogProvider)
+	channelChain, err := senders.SMSChannels(ctx, smsConfig, getFileSystemProvider, getLogProvider)
 	logging.OnError(err).Error("could not create sms channel")
 
 	if channelChain.Len() == 0 {
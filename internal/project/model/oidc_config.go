@@ -16,24 +16,28 @@ import (
 
 type OIDCConfig struct {
 	es_models.ObjectRoot
-	AppID                    string
-	ClientID                 string
-	ClientSecret             *crypto.CryptoValue
-	ClientSecretString       string
-	RedirectUris             []string
-	ResponseTypes            []OIDCResponseType
-	GrantTypes               []OIDCGrantType
-	ApplicationType          OIDCApplicationType
-	AuthMethodType           OIDCAuthMethodType
-	PostLogoutRedirectUris   []string
-	OIDCVersion              OIDCVersion
-	Compliance               *Compliance
-	DevMode                  bool
-	AccessTokenType          OIDCTokenType
-	AccessTokenRoleAssertion bool
-	IDTokenRoleAssertion     bool
-	IDTokenUserinfoAssertion bool
-	ClockSkew                time.Duration
+	AppID                      string
+	ClientID                   string
+	ClientSecret               *crypto.CryptoValue
+	ClientSecretString         string
+	RedirectUris               []string
+	ResponseTypes              []OIDCResponseType
+	GrantTypes                 []OIDCGrantType
+	ApplicationType            OIDCApplicationType
+	AuthMethodType             OIDCAuthMethodType
+	PostLogoutRedirectUris     []string
+	OIDCVersion                OIDCVersion
+	Compliance                 *Compliance
+	DevMode                    bool
+	AccessTokenType            OIDCTokenType
+	AccessTokenRoleAssertion   bool
+	IDTokenRoleAssertion       bool
+	IDTokenUserinfoAssertion   bool
+	ClockSkew                  time.Duration
+	BackChannelLogoutURI       string
+	FrontChannelLogoutURI      string
+	RequirePushedAuthRequests  bool
+	RequireSignedRequestObject bool
 }
 
 type OIDCVersion int32
@@ -56,6 +60,8 @@ const (
 	OIDCGrantTypeAuthorizationCode OIDCGrantType = iota
 	OIDCGrantTypeImplicit
 	OIDCGrantTypeRefreshToken
+	OIDCGrantTypeDeviceCode
+	OIDCGrantTypeTokenExchange
 )
 
 type OIDCApplicationType int32
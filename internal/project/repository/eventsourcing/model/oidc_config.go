@@ -15,23 +15,27 @@ import (
 
 type OIDCConfig struct {
 	es_models.ObjectRoot
-	Version                  int32               `json:"oidcVersion,omitempty"`
-	AppID                    string              `json:"appId"`
-	ClientID                 string              `json:"clientId,omitempty"`
-	ClientSecret             *crypto.CryptoValue `json:"clientSecret,omitempty"`
-	RedirectUris             []string            `json:"redirectUris,omitempty"`
-	ResponseTypes            []int32             `json:"responseTypes,omitempty"`
-	GrantTypes               []int32             `json:"grantTypes,omitempty"`
-	ApplicationType          int32               `json:"applicationType,omitempty"`
-	AuthMethodType           int32               `json:"authMethodType,omitempty"`
-	PostLogoutRedirectUris   []string            `json:"postLogoutRedirectUris,omitempty"`
-	DevMode                  bool                `json:"devMode,omitempty"`
-	AccessTokenType          int32               `json:"accessTokenType,omitempty"`
-	AccessTokenRoleAssertion bool                `json:"accessTokenRoleAssertion,omitempty"`
-	IDTokenRoleAssertion     bool                `json:"idTokenRoleAssertion,omitempty"`
-	IDTokenUserinfoAssertion bool                `json:"idTokenUserinfoAssertion,omitempty"`
-	ClockSkew                time.Duration       `json:"clockSkew,omitempty"`
-	ClientKeys               []*ClientKey        `json:"-"`
+	Version                    int32               `json:"oidcVersion,omitempty"`
+	AppID                      string              `json:"appId"`
+	ClientID                   string              `json:"clientId,omitempty"`
+	ClientSecret               *crypto.CryptoValue `json:"clientSecret,omitempty"`
+	RedirectUris               []string            `json:"redirectUris,omitempty"`
+	ResponseTypes              []int32             `json:"responseTypes,omitempty"`
+	GrantTypes                 []int32             `json:"grantTypes,omitempty"`
+	ApplicationType            int32               `json:"applicationType,omitempty"`
+	AuthMethodType             int32               `json:"authMethodType,omitempty"`
+	PostLogoutRedirectUris     []string            `json:"postLogoutRedirectUris,omitempty"`
+	DevMode                    bool                `json:"devMode,omitempty"`
+	AccessTokenType            int32               `json:"accessTokenType,omitempty"`
+	AccessTokenRoleAssertion   bool                `json:"accessTokenRoleAssertion,omitempty"`
+	IDTokenRoleAssertion       bool                `json:"idTokenRoleAssertion,omitempty"`
+	IDTokenUserinfoAssertion   bool                `json:"idTokenUserinfoAssertion,omitempty"`
+	ClockSkew                  time.Duration       `json:"clockSkew,omitempty"`
+	BackChannelLogoutURI       string              `json:"backChannelLogoutUri,omitempty"`
+	FrontChannelLogoutURI      string              `json:"frontChannelLogoutUri,omitempty"`
+	RequirePushedAuthRequests  bool                `json:"requirePushedAuthRequests,omitempty"`
+	RequireSignedRequestObject bool                `json:"requireSignedRequestObject,omitempty"`
+	ClientKeys                 []*ClientKey        `json:"-"`
 }
 
 func (o *OIDCConfig) setData(event *es_models.Event) error {
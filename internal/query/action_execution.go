@@ -0,0 +1,232 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/dennigogo/zitadel/internal/api/authz"
+	"github.com/dennigogo/zitadel/internal/domain"
+	"github.com/dennigogo/zitadel/internal/errors"
+)
+
+const (
+	ActionExecutionTable = "system.action_executions"
+
+	ActionExecutionInstanceIDCol    = "instance_id"
+	ActionExecutionResourceOwnerCol = "resource_owner"
+	ActionExecutionIDCol            = "id"
+	ActionExecutionActionIDCol      = "action_id"
+	ActionExecutionActionNameCol    = "action_name"
+	ActionExecutionFlowTypeCol      = "flow_type"
+	ActionExecutionTriggerTypeCol   = "trigger_type"
+	ActionExecutionCreationDateCol  = "creation_date"
+	ActionExecutionDurationCol      = "duration"
+	ActionExecutionOutcomeCol       = "outcome"
+	ActionExecutionErrorCol         = "error"
+	ActionExecutionLogsCol          = "logs"
+)
+
+var (
+	actionExecutionTable = table{
+		name: ActionExecutionTable,
+	}
+	ActionExecutionColumnInstanceID = Column{
+		name:  ActionExecutionInstanceIDCol,
+		table: actionExecutionTable,
+	}
+	ActionExecutionColumnResourceOwner = Column{
+		name:  ActionExecutionResourceOwnerCol,
+		table: actionExecutionTable,
+	}
+	ActionExecutionColumnID = Column{
+		name:  ActionExecutionIDCol,
+		table: actionExecutionTable,
+	}
+	ActionExecutionColumnActionID = Column{
+		name:  ActionExecutionActionIDCol,
+		table: actionExecutionTable,
+	}
+	ActionExecutionColumnActionName = Column{
+		name:  ActionExecutionActionNameCol,
+		table: actionExecutionTable,
+	}
+	ActionExecutionColumnFlowType = Column{
+		name:  ActionExecutionFlowTypeCol,
+		table: actionExecutionTable,
+	}
+	ActionExecutionColumnTriggerType = Column{
+		name:  ActionExecutionTriggerTypeCol,
+		table: actionExecutionTable,
+	}
+	ActionExecutionColumnCreationDate = Column{
+		name:  ActionExecutionCreationDateCol,
+		table: actionExecutionTable,
+	}
+	ActionExecutionColumnDuration = Column{
+		name:  ActionExecutionDurationCol,
+		table: actionExecutionTable,
+	}
+	ActionExecutionColumnOutcome = Column{
+		name:  ActionExecutionOutcomeCol,
+		table: actionExecutionTable,
+	}
+	ActionExecutionColumnError = Column{
+		name:  ActionExecutionErrorCol,
+		table: actionExecutionTable,
+	}
+	ActionExecutionColumnLogs = Column{
+		name:  ActionExecutionLogsCol,
+		table: actionExecutionTable,
+	}
+)
+
+type ActionExecutions struct {
+	SearchResponse
+	Executions []*ActionExecution
+}
+
+type ActionExecution struct {
+	ID            string
+	ResourceOwner string
+	ActionID      string
+	ActionName    string
+	FlowType      domain.FlowType
+	TriggerType   domain.TriggerType
+	CreationDate  time.Time
+	Duration      time.Duration
+	Outcome       domain.ActionExecutionOutcome
+	Error         string
+	Logs          []*ActionExecutionLog
+}
+
+type ActionExecutionLog struct {
+	Level   string `json:"level"`
+	Message string `json:"message"`
+}
+
+type ActionExecutionSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *ActionExecutionSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func (q *ActionExecutionSearchQueries) AppendMyResourceOwnerQuery(orgID string) error {
+	query, err := NewActionExecutionResourceOwnerSearchQuery(orgID)
+	if err != nil {
+		return err
+	}
+	q.Queries = append(q.Queries, query)
+	return nil
+}
+
+func (q *Queries) SearchActionExecutions(ctx context.Context, queries *ActionExecutionSearchQueries) (executions *ActionExecutions, err error) {
+	query, scan := prepareActionExecutionsQuery()
+	stmt, args, err := queries.toQuery(query).
+		Where(sq.Eq{
+			ActionExecutionColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+		}).
+		ToSql()
+	if err != nil {
+		return nil, errors.ThrowInvalidArgument(err, "QUERY-Ae4x8", "Errors.Query.InvalidRequest")
+	}
+
+	rows, err := q.client.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Ae7c2", "Errors.Internal")
+	}
+	return scan(rows)
+}
+
+func NewActionExecutionResourceOwnerSearchQuery(id string) (SearchQuery, error) {
+	return NewTextQuery(ActionExecutionColumnResourceOwner, id, TextEquals)
+}
+
+func NewActionExecutionActionIDSearchQuery(id string) (SearchQuery, error) {
+	return NewTextQuery(ActionExecutionColumnActionID, id, TextEquals)
+}
+
+func NewActionExecutionFlowTypeSearchQuery(value domain.FlowType) (SearchQuery, error) {
+	return NewNumberQuery(ActionExecutionColumnFlowType, int(value), NumberEquals)
+}
+
+func NewActionExecutionTriggerTypeSearchQuery(value domain.TriggerType) (SearchQuery, error) {
+	return NewNumberQuery(ActionExecutionColumnTriggerType, int(value), NumberEquals)
+}
+
+func NewActionExecutionOutcomeSearchQuery(value domain.ActionExecutionOutcome) (SearchQuery, error) {
+	return NewNumberQuery(ActionExecutionColumnOutcome, int(value), NumberEquals)
+}
+
+func prepareActionExecutionsQuery() (sq.SelectBuilder, func(rows *sql.Rows) (*ActionExecutions, error)) {
+	return sq.Select(
+			ActionExecutionColumnID.identifier(),
+			ActionExecutionColumnResourceOwner.identifier(),
+			ActionExecutionColumnActionID.identifier(),
+			ActionExecutionColumnActionName.identifier(),
+			ActionExecutionColumnFlowType.identifier(),
+			ActionExecutionColumnTriggerType.identifier(),
+			ActionExecutionColumnCreationDate.identifier(),
+			ActionExecutionColumnDuration.identifier(),
+			ActionExecutionColumnOutcome.identifier(),
+			ActionExecutionColumnError.identifier(),
+			ActionExecutionColumnLogs.identifier(),
+			countColumn.identifier(),
+		).From(actionExecutionTable.identifier()).PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*ActionExecutions, error) {
+			executions := make([]*ActionExecution, 0)
+			var count uint64
+			for rows.Next() {
+				execution := new(ActionExecution)
+				var (
+					executionErr sql.NullString
+					logs         []byte
+				)
+				err := rows.Scan(
+					&execution.ID,
+					&execution.ResourceOwner,
+					&execution.ActionID,
+					&execution.ActionName,
+					&execution.FlowType,
+					&execution.TriggerType,
+					&execution.CreationDate,
+					&execution.Duration,
+					&execution.Outcome,
+					&executionErr,
+					&logs,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				execution.Error = executionErr.String
+				if len(logs) > 0 {
+					if err = json.Unmarshal(logs, &execution.Logs); err != nil {
+						return nil, errors.ThrowInternal(err, "QUERY-Ae2m9", "Errors.Internal")
+					}
+				}
+				executions = append(executions, execution)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, errors.ThrowInternal(err, "QUERY-Ae5j1", "Errors.Query.CloseRows")
+			}
+
+			return &ActionExecutions{
+				Executions: executions,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
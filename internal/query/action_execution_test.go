@@ -0,0 +1,138 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/dennigogo/zitadel/internal/domain"
+)
+
+var (
+	actionExecutionsQuery = `SELECT system.action_executions.id,` +
+		` system.action_executions.resource_owner,` +
+		` system.action_executions.action_id,` +
+		` system.action_executions.action_name,` +
+		` system.action_executions.flow_type,` +
+		` system.action_executions.trigger_type,` +
+		` system.action_executions.creation_date,` +
+		` system.action_executions.duration,` +
+		` system.action_executions.outcome,` +
+		` system.action_executions.error,` +
+		` system.action_executions.logs,` +
+		` COUNT(*) OVER ()` +
+		` FROM system.action_executions`
+	actionExecutionsCols = []string{
+		"id",
+		"resource_owner",
+		"action_id",
+		"action_name",
+		"flow_type",
+		"trigger_type",
+		"creation_date",
+		"duration",
+		"outcome",
+		"error",
+		"logs",
+		"count",
+	}
+)
+
+func Test_ActionExecutionPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareActionExecutionsQuery no result",
+			prepare: prepareActionExecutionsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(actionExecutionsQuery),
+					nil,
+					nil,
+				),
+			},
+			object: &ActionExecutions{Executions: []*ActionExecution{}},
+		},
+		{
+			name:    "prepareActionExecutionsQuery one result",
+			prepare: prepareActionExecutionsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(actionExecutionsQuery),
+					actionExecutionsCols,
+					[][]driver.Value{
+						{
+							"id",
+							"ro",
+							"action-id",
+							"action",
+							int(domain.FlowTypeExternalAuthentication),
+							int(domain.TriggerTypePostAuthentication),
+							testNow,
+							int64(time.Second),
+							int(domain.ActionExecutionOutcomeFailureIgnored),
+							"some error",
+							[]byte(`[{"level":"log","message":"hello"}]`),
+						},
+					},
+				),
+			},
+			object: &ActionExecutions{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				Executions: []*ActionExecution{
+					{
+						ID:            "id",
+						ResourceOwner: "ro",
+						ActionID:      "action-id",
+						ActionName:    "action",
+						FlowType:      domain.FlowTypeExternalAuthentication,
+						TriggerType:   domain.TriggerTypePostAuthentication,
+						CreationDate:  testNow,
+						Duration:      time.Second,
+						Outcome:       domain.ActionExecutionOutcomeFailureIgnored,
+						Error:         "some error",
+						Logs: []*ActionExecutionLog{
+							{Level: "log", Message: "hello"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareActionExecutionsQuery sql err",
+			prepare: prepareActionExecutionsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(actionExecutionsQuery),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err)
+		})
+	}
+}
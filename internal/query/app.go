@@ -38,23 +38,27 @@ type App struct {
 }
 
 type OIDCApp struct {
-	RedirectURIs           database.StringArray
-	ResponseTypes          database.EnumArray[domain.OIDCResponseType]
-	GrantTypes             database.EnumArray[domain.OIDCGrantType]
-	AppType                domain.OIDCApplicationType
-	ClientID               string
-	AuthMethodType         domain.OIDCAuthMethodType
-	PostLogoutRedirectURIs database.StringArray
-	Version                domain.OIDCVersion
-	ComplianceProblems     database.StringArray
-	IsDevMode              bool
-	AccessTokenType        domain.OIDCTokenType
-	AssertAccessTokenRole  bool
-	AssertIDTokenRole      bool
-	AssertIDTokenUserinfo  bool
-	ClockSkew              time.Duration
-	AdditionalOrigins      database.StringArray
-	AllowedOrigins         database.StringArray
+	RedirectURIs               database.StringArray
+	ResponseTypes              database.EnumArray[domain.OIDCResponseType]
+	GrantTypes                 database.EnumArray[domain.OIDCGrantType]
+	AppType                    domain.OIDCApplicationType
+	ClientID                   string
+	AuthMethodType             domain.OIDCAuthMethodType
+	PostLogoutRedirectURIs     database.StringArray
+	Version                    domain.OIDCVersion
+	ComplianceProblems         database.StringArray
+	IsDevMode                  bool
+	AccessTokenType            domain.OIDCTokenType
+	AssertAccessTokenRole      bool
+	AssertIDTokenRole          bool
+	AssertIDTokenUserinfo      bool
+	ClockSkew                  time.Duration
+	AdditionalOrigins          database.StringArray
+	AllowedOrigins             database.StringArray
+	BackChannelLogoutURI       string
+	FrontChannelLogoutURI      string
+	RequirePushedAuthRequests  bool
+	RequireSignedRequestObject bool
 }
 
 type SAMLApp struct {
@@ -231,6 +235,22 @@ var (
 		name:  projection.AppOIDCConfigColumnAdditionalOrigins,
 		table: appOIDCConfigsTable,
 	}
+	AppOIDCConfigColumnBackChannelLogoutURI = Column{
+		name:  projection.AppOIDCConfigColumnBackChannelLogoutURI,
+		table: appOIDCConfigsTable,
+	}
+	AppOIDCConfigColumnFrontChannelLogoutURI = Column{
+		name:  projection.AppOIDCConfigColumnFrontChannelLogoutURI,
+		table: appOIDCConfigsTable,
+	}
+	AppOIDCConfigColumnRequirePushedAuthRequests = Column{
+		name:  projection.AppOIDCConfigColumnRequirePushedAuthRequests,
+		table: appOIDCConfigsTable,
+	}
+	AppOIDCConfigColumnRequireSignedRequestObject = Column{
+		name:  projection.AppOIDCConfigColumnRequireSignedRequestObject,
+		table: appOIDCConfigsTable,
+	}
 )
 
 func (q *Queries) AppByProjectAndAppID(ctx context.Context, shouldTriggerBulk bool, projectID, appID string) (*App, error) {
@@ -467,6 +487,10 @@ func prepareAppQuery() (sq.SelectBuilder, func(*sql.Row) (*App, error)) {
 			AppOIDCConfigColumnIDTokenUserinfoAssertion.identifier(),
 			AppOIDCConfigColumnClockSkew.identifier(),
 			AppOIDCConfigColumnAdditionalOrigins.identifier(),
+			AppOIDCConfigColumnBackChannelLogoutURI.identifier(),
+			AppOIDCConfigColumnFrontChannelLogoutURI.identifier(),
+			AppOIDCConfigColumnRequirePushedAuthRequests.identifier(),
+			AppOIDCConfigColumnRequireSignedRequestObject.identifier(),
 
 			AppSAMLConfigColumnAppID.identifier(),
 			AppSAMLConfigColumnEntityID.identifier(),
@@ -515,6 +539,10 @@ func prepareAppQuery() (sq.SelectBuilder, func(*sql.Row) (*App, error)) {
 				&oidcConfig.iDTokenUserinfoAssertion,
 				&oidcConfig.clockSkew,
 				&oidcConfig.additionalOrigins,
+				&oidcConfig.backChannelLogoutURI,
+				&oidcConfig.frontChannelLogoutURI,
+				&oidcConfig.requirePushedAuthRequests,
+				&oidcConfig.requireSignedRequestObject,
 
 				&samlConfig.appID,
 				&samlConfig.entityID,
@@ -635,6 +663,10 @@ func prepareAppsQuery() (sq.SelectBuilder, func(*sql.Rows) (*Apps, error)) {
 			AppOIDCConfigColumnIDTokenUserinfoAssertion.identifier(),
 			AppOIDCConfigColumnClockSkew.identifier(),
 			AppOIDCConfigColumnAdditionalOrigins.identifier(),
+			AppOIDCConfigColumnBackChannelLogoutURI.identifier(),
+			AppOIDCConfigColumnFrontChannelLogoutURI.identifier(),
+			AppOIDCConfigColumnRequirePushedAuthRequests.identifier(),
+			AppOIDCConfigColumnRequireSignedRequestObject.identifier(),
 
 			AppSAMLConfigColumnAppID.identifier(),
 			AppSAMLConfigColumnEntityID.identifier(),
@@ -686,6 +718,10 @@ func prepareAppsQuery() (sq.SelectBuilder, func(*sql.Rows) (*Apps, error)) {
 					&oidcConfig.iDTokenUserinfoAssertion,
 					&oidcConfig.clockSkew,
 					&oidcConfig.additionalOrigins,
+					&oidcConfig.backChannelLogoutURI,
+					&oidcConfig.frontChannelLogoutURI,
+					&oidcConfig.requirePushedAuthRequests,
+					&oidcConfig.requireSignedRequestObject,
 
 					&samlConfig.appID,
 					&samlConfig.entityID,
@@ -741,22 +777,26 @@ func prepareClientIDsQuery() (sq.SelectBuilder, func(*sql.Rows) ([]string, error
 }
 
 type sqlOIDCConfig struct {
-	appID                    sql.NullString
-	version                  sql.NullInt32
-	clientID                 sql.NullString
-	redirectUris             database.StringArray
-	applicationType          sql.NullInt16
-	authMethodType           sql.NullInt16
-	postLogoutRedirectUris   database.StringArray
-	devMode                  sql.NullBool
-	accessTokenType          sql.NullInt16
-	accessTokenRoleAssertion sql.NullBool
-	iDTokenRoleAssertion     sql.NullBool
-	iDTokenUserinfoAssertion sql.NullBool
-	clockSkew                sql.NullInt64
-	additionalOrigins        database.StringArray
-	responseTypes            database.EnumArray[domain.OIDCResponseType]
-	grantTypes               database.EnumArray[domain.OIDCGrantType]
+	appID                      sql.NullString
+	version                    sql.NullInt32
+	clientID                   sql.NullString
+	redirectUris               database.StringArray
+	applicationType            sql.NullInt16
+	authMethodType             sql.NullInt16
+	postLogoutRedirectUris     database.StringArray
+	devMode                    sql.NullBool
+	accessTokenType            sql.NullInt16
+	accessTokenRoleAssertion   sql.NullBool
+	iDTokenRoleAssertion       sql.NullBool
+	iDTokenUserinfoAssertion   sql.NullBool
+	clockSkew                  sql.NullInt64
+	additionalOrigins          database.StringArray
+	backChannelLogoutURI       sql.NullString
+	frontChannelLogoutURI      sql.NullString
+	requirePushedAuthRequests  sql.NullBool
+	requireSignedRequestObject sql.NullBool
+	responseTypes              database.EnumArray[domain.OIDCResponseType]
+	grantTypes                 database.EnumArray[domain.OIDCGrantType]
 }
 
 func (c sqlOIDCConfig) set(app *App) {
@@ -764,21 +804,25 @@ func (c sqlOIDCConfig) set(app *App) {
 		return
 	}
 	app.OIDCConfig = &OIDCApp{
-		Version:                domain.OIDCVersion(c.version.Int32),
-		ClientID:               c.clientID.String,
-		RedirectURIs:           c.redirectUris,
-		AppType:                domain.OIDCApplicationType(c.applicationType.Int16),
-		AuthMethodType:         domain.OIDCAuthMethodType(c.authMethodType.Int16),
-		PostLogoutRedirectURIs: c.postLogoutRedirectUris,
-		IsDevMode:              c.devMode.Bool,
-		AccessTokenType:        domain.OIDCTokenType(c.accessTokenType.Int16),
-		AssertAccessTokenRole:  c.accessTokenRoleAssertion.Bool,
-		AssertIDTokenRole:      c.iDTokenRoleAssertion.Bool,
-		AssertIDTokenUserinfo:  c.iDTokenUserinfoAssertion.Bool,
-		ClockSkew:              time.Duration(c.clockSkew.Int64),
-		AdditionalOrigins:      c.additionalOrigins,
-		ResponseTypes:          c.responseTypes,
-		GrantTypes:             c.grantTypes,
+		Version:                    domain.OIDCVersion(c.version.Int32),
+		ClientID:                   c.clientID.String,
+		RedirectURIs:               c.redirectUris,
+		AppType:                    domain.OIDCApplicationType(c.applicationType.Int16),
+		AuthMethodType:             domain.OIDCAuthMethodType(c.authMethodType.Int16),
+		PostLogoutRedirectURIs:     c.postLogoutRedirectUris,
+		IsDevMode:                  c.devMode.Bool,
+		AccessTokenType:            domain.OIDCTokenType(c.accessTokenType.Int16),
+		AssertAccessTokenRole:      c.accessTokenRoleAssertion.Bool,
+		AssertIDTokenRole:          c.iDTokenRoleAssertion.Bool,
+		AssertIDTokenUserinfo:      c.iDTokenUserinfoAssertion.Bool,
+		ClockSkew:                  time.Duration(c.clockSkew.Int64),
+		AdditionalOrigins:          c.additionalOrigins,
+		ResponseTypes:              c.responseTypes,
+		GrantTypes:                 c.grantTypes,
+		BackChannelLogoutURI:       c.backChannelLogoutURI.String,
+		FrontChannelLogoutURI:      c.frontChannelLogoutURI.String,
+		RequirePushedAuthRequests:  c.requirePushedAuthRequests.Bool,
+		RequireSignedRequestObject: c.requireSignedRequestObject.Bool,
 	}
 	compliance := domain.GetOIDCCompliance(app.OIDCConfig.Version, app.OIDCConfig.AppType, app.OIDCConfig.GrantTypes, app.OIDCConfig.ResponseTypes, app.OIDCConfig.AuthMethodType, app.OIDCConfig.RedirectURIs)
 	app.OIDCConfig.ComplianceProblems = compliance.Problems
@@ -44,6 +44,10 @@ var (
 		` projections.apps3_oidc_configs.id_token_userinfo_assertion,` +
 		` projections.apps3_oidc_configs.clock_skew,` +
 		` projections.apps3_oidc_configs.additional_origins,` +
+		` projections.apps3_oidc_configs.back_channel_logout_uri,` +
+		` projections.apps3_oidc_configs.front_channel_logout_uri,` +
+		` projections.apps3_oidc_configs.require_pushed_auth_requests,` +
+		` projections.apps3_oidc_configs.require_signed_request_object,` +
 		//saml config
 		` projections.apps3_saml_configs.app_id,` +
 		` projections.apps3_saml_configs.entity_id,` +
@@ -82,6 +86,10 @@ var (
 		` projections.apps3_oidc_configs.id_token_userinfo_assertion,` +
 		` projections.apps3_oidc_configs.clock_skew,` +
 		` projections.apps3_oidc_configs.additional_origins,` +
+		` projections.apps3_oidc_configs.back_channel_logout_uri,` +
+		` projections.apps3_oidc_configs.front_channel_logout_uri,` +
+		` projections.apps3_oidc_configs.require_pushed_auth_requests,` +
+		` projections.apps3_oidc_configs.require_signed_request_object,` +
 		//saml config
 		` projections.apps3_saml_configs.app_id,` +
 		` projections.apps3_saml_configs.entity_id,` +
@@ -149,6 +157,10 @@ var (
 		"id_token_userinfo_assertion",
 		"clock_skew",
 		"additional_origins",
+		"back_channel_logout_uri",
+		"front_channel_logout_uri",
+		"require_pushed_auth_requests",
+		"require_signed_request_object",
 		//saml config
 		"app_id",
 		"entity_id",
@@ -219,6 +231,10 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -284,6 +300,10 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -352,6 +372,10 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							"app-id",
 							"https://test.com/saml/metadata",
@@ -422,6 +446,10 @@ func Test_AppsPrepare(t *testing.T) {
 							true,
 							1 * time.Second,
 							database.StringArray{"additional.origin"},
+							"https://logout.ch/backchannel",
+							"https://logout.ch/frontchannel",
+							true,
+							false,
 							// saml config
 							nil,
 							nil,
@@ -446,23 +474,26 @@ func Test_AppsPrepare(t *testing.T) {
 						Name:          "app-name",
 						ProjectID:     "project-id",
 						OIDCConfig: &OIDCApp{
-							Version:                domain.OIDCVersionV1,
-							ClientID:               "oidc-client-id",
-							RedirectURIs:           database.StringArray{"https://redirect.to/me"},
-							ResponseTypes:          database.EnumArray[domain.OIDCResponseType]{domain.OIDCResponseTypeIDTokenToken},
-							GrantTypes:             database.EnumArray[domain.OIDCGrantType]{domain.OIDCGrantTypeImplicit},
-							AppType:                domain.OIDCApplicationTypeUserAgent,
-							AuthMethodType:         domain.OIDCAuthMethodTypeNone,
-							PostLogoutRedirectURIs: database.StringArray{"post.logout.ch"},
-							IsDevMode:              true,
-							AccessTokenType:        domain.OIDCTokenTypeJWT,
-							AssertAccessTokenRole:  true,
-							AssertIDTokenRole:      true,
-							AssertIDTokenUserinfo:  true,
-							ClockSkew:              1 * time.Second,
-							AdditionalOrigins:      database.StringArray{"additional.origin"},
-							ComplianceProblems:     nil,
-							AllowedOrigins:         database.StringArray{"https://redirect.to", "additional.origin"},
+							Version:                   domain.OIDCVersionV1,
+							ClientID:                  "oidc-client-id",
+							RedirectURIs:              database.StringArray{"https://redirect.to/me"},
+							ResponseTypes:             database.EnumArray[domain.OIDCResponseType]{domain.OIDCResponseTypeIDTokenToken},
+							GrantTypes:                database.EnumArray[domain.OIDCGrantType]{domain.OIDCGrantTypeImplicit},
+							AppType:                   domain.OIDCApplicationTypeUserAgent,
+							AuthMethodType:            domain.OIDCAuthMethodTypeNone,
+							PostLogoutRedirectURIs:    database.StringArray{"post.logout.ch"},
+							IsDevMode:                 true,
+							AccessTokenType:           domain.OIDCTokenTypeJWT,
+							AssertAccessTokenRole:     true,
+							AssertIDTokenRole:         true,
+							AssertIDTokenUserinfo:     true,
+							ClockSkew:                 1 * time.Second,
+							AdditionalOrigins:         database.StringArray{"additional.origin"},
+							BackChannelLogoutURI:      "https://logout.ch/backchannel",
+							FrontChannelLogoutURI:     "https://logout.ch/frontchannel",
+							RequirePushedAuthRequests: true,
+							ComplianceProblems:        nil,
+							AllowedOrigins:            database.StringArray{"https://redirect.to", "additional.origin"},
 						},
 					},
 				},
@@ -506,6 +537,10 @@ func Test_AppsPrepare(t *testing.T) {
 							true,
 							1 * time.Second,
 							database.StringArray{"additional.origin"},
+							"https://logout.ch/backchannel",
+							"https://logout.ch/frontchannel",
+							true,
+							false,
 							// saml config
 							nil,
 							nil,
@@ -530,23 +565,26 @@ func Test_AppsPrepare(t *testing.T) {
 						Name:          "app-name",
 						ProjectID:     "project-id",
 						OIDCConfig: &OIDCApp{
-							Version:                domain.OIDCVersionV1,
-							ClientID:               "oidc-client-id",
-							RedirectURIs:           database.StringArray{"https://redirect.to/me"},
-							ResponseTypes:          database.EnumArray[domain.OIDCResponseType]{domain.OIDCResponseTypeIDTokenToken},
-							GrantTypes:             database.EnumArray[domain.OIDCGrantType]{domain.OIDCGrantTypeImplicit},
-							AppType:                domain.OIDCApplicationTypeUserAgent,
-							AuthMethodType:         domain.OIDCAuthMethodTypeNone,
-							PostLogoutRedirectURIs: database.StringArray{"post.logout.ch"},
-							IsDevMode:              false,
-							AccessTokenType:        domain.OIDCTokenTypeJWT,
-							AssertAccessTokenRole:  false,
-							AssertIDTokenRole:      false,
-							AssertIDTokenUserinfo:  true,
-							ClockSkew:              1 * time.Second,
-							AdditionalOrigins:      database.StringArray{"additional.origin"},
-							ComplianceProblems:     nil,
-							AllowedOrigins:         database.StringArray{"https://redirect.to", "additional.origin"},
+							Version:                   domain.OIDCVersionV1,
+							ClientID:                  "oidc-client-id",
+							RedirectURIs:              database.StringArray{"https://redirect.to/me"},
+							ResponseTypes:             database.EnumArray[domain.OIDCResponseType]{domain.OIDCResponseTypeIDTokenToken},
+							GrantTypes:                database.EnumArray[domain.OIDCGrantType]{domain.OIDCGrantTypeImplicit},
+							AppType:                   domain.OIDCApplicationTypeUserAgent,
+							AuthMethodType:            domain.OIDCAuthMethodTypeNone,
+							PostLogoutRedirectURIs:    database.StringArray{"post.logout.ch"},
+							IsDevMode:                 false,
+							AccessTokenType:           domain.OIDCTokenTypeJWT,
+							AssertAccessTokenRole:     false,
+							AssertIDTokenRole:         false,
+							AssertIDTokenUserinfo:     true,
+							ClockSkew:                 1 * time.Second,
+							AdditionalOrigins:         database.StringArray{"additional.origin"},
+							BackChannelLogoutURI:      "https://logout.ch/backchannel",
+							FrontChannelLogoutURI:     "https://logout.ch/frontchannel",
+							RequirePushedAuthRequests: true,
+							ComplianceProblems:        nil,
+							AllowedOrigins:            database.StringArray{"https://redirect.to", "additional.origin"},
 						},
 					},
 				},
@@ -590,6 +628,10 @@ func Test_AppsPrepare(t *testing.T) {
 							true,
 							1 * time.Second,
 							database.StringArray{"additional.origin"},
+							"https://logout.ch/backchannel",
+							"https://logout.ch/frontchannel",
+							true,
+							false,
 							// saml config
 							nil,
 							nil,
@@ -614,23 +656,26 @@ func Test_AppsPrepare(t *testing.T) {
 						Name:          "app-name",
 						ProjectID:     "project-id",
 						OIDCConfig: &OIDCApp{
-							Version:                domain.OIDCVersionV1,
-							ClientID:               "oidc-client-id",
-							RedirectURIs:           database.StringArray{"https://redirect.to/me"},
-							ResponseTypes:          database.EnumArray[domain.OIDCResponseType]{domain.OIDCResponseTypeIDTokenToken},
-							GrantTypes:             database.EnumArray[domain.OIDCGrantType]{domain.OIDCGrantTypeImplicit},
-							AppType:                domain.OIDCApplicationTypeUserAgent,
-							AuthMethodType:         domain.OIDCAuthMethodTypeNone,
-							PostLogoutRedirectURIs: database.StringArray{"post.logout.ch"},
-							IsDevMode:              true,
-							AccessTokenType:        domain.OIDCTokenTypeJWT,
-							AssertAccessTokenRole:  true,
-							AssertIDTokenRole:      false,
-							AssertIDTokenUserinfo:  true,
-							ClockSkew:              1 * time.Second,
-							AdditionalOrigins:      database.StringArray{"additional.origin"},
-							ComplianceProblems:     nil,
-							AllowedOrigins:         database.StringArray{"https://redirect.to", "additional.origin"},
+							Version:                   domain.OIDCVersionV1,
+							ClientID:                  "oidc-client-id",
+							RedirectURIs:              database.StringArray{"https://redirect.to/me"},
+							ResponseTypes:             database.EnumArray[domain.OIDCResponseType]{domain.OIDCResponseTypeIDTokenToken},
+							GrantTypes:                database.EnumArray[domain.OIDCGrantType]{domain.OIDCGrantTypeImplicit},
+							AppType:                   domain.OIDCApplicationTypeUserAgent,
+							AuthMethodType:            domain.OIDCAuthMethodTypeNone,
+							PostLogoutRedirectURIs:    database.StringArray{"post.logout.ch"},
+							IsDevMode:                 true,
+							AccessTokenType:           domain.OIDCTokenTypeJWT,
+							AssertAccessTokenRole:     true,
+							AssertIDTokenRole:         false,
+							AssertIDTokenUserinfo:     true,
+							ClockSkew:                 1 * time.Second,
+							AdditionalOrigins:         database.StringArray{"additional.origin"},
+							BackChannelLogoutURI:      "https://logout.ch/backchannel",
+							FrontChannelLogoutURI:     "https://logout.ch/frontchannel",
+							RequirePushedAuthRequests: true,
+							ComplianceProblems:        nil,
+							AllowedOrigins:            database.StringArray{"https://redirect.to", "additional.origin"},
 						},
 					},
 				},
@@ -674,6 +719,10 @@ func Test_AppsPrepare(t *testing.T) {
 							true,
 							1 * time.Second,
 							database.StringArray{"additional.origin"},
+							"https://logout.ch/backchannel",
+							"https://logout.ch/frontchannel",
+							true,
+							false,
 							// saml config
 							nil,
 							nil,
@@ -698,23 +747,26 @@ func Test_AppsPrepare(t *testing.T) {
 						Name:          "app-name",
 						ProjectID:     "project-id",
 						OIDCConfig: &OIDCApp{
-							Version:                domain.OIDCVersionV1,
-							ClientID:               "oidc-client-id",
-							RedirectURIs:           database.StringArray{"https://redirect.to/me"},
-							ResponseTypes:          database.EnumArray[domain.OIDCResponseType]{domain.OIDCResponseTypeIDTokenToken},
-							GrantTypes:             database.EnumArray[domain.OIDCGrantType]{domain.OIDCGrantTypeImplicit},
-							AppType:                domain.OIDCApplicationTypeUserAgent,
-							AuthMethodType:         domain.OIDCAuthMethodTypeNone,
-							PostLogoutRedirectURIs: database.StringArray{"post.logout.ch"},
-							IsDevMode:              false,
-							AccessTokenType:        domain.OIDCTokenTypeJWT,
-							AssertAccessTokenRole:  false,
-							AssertIDTokenRole:      true,
-							AssertIDTokenUserinfo:  true,
-							ClockSkew:              1 * time.Second,
-							AdditionalOrigins:      database.StringArray{"additional.origin"},
-							ComplianceProblems:     nil,
-							AllowedOrigins:         database.StringArray{"https://redirect.to", "additional.origin"},
+							Version:                   domain.OIDCVersionV1,
+							ClientID:                  "oidc-client-id",
+							RedirectURIs:              database.StringArray{"https://redirect.to/me"},
+							ResponseTypes:             database.EnumArray[domain.OIDCResponseType]{domain.OIDCResponseTypeIDTokenToken},
+							GrantTypes:                database.EnumArray[domain.OIDCGrantType]{domain.OIDCGrantTypeImplicit},
+							AppType:                   domain.OIDCApplicationTypeUserAgent,
+							AuthMethodType:            domain.OIDCAuthMethodTypeNone,
+							PostLogoutRedirectURIs:    database.StringArray{"post.logout.ch"},
+							IsDevMode:                 false,
+							AccessTokenType:           domain.OIDCTokenTypeJWT,
+							AssertAccessTokenRole:     false,
+							AssertIDTokenRole:         true,
+							AssertIDTokenUserinfo:     true,
+							ClockSkew:                 1 * time.Second,
+							AdditionalOrigins:         database.StringArray{"additional.origin"},
+							BackChannelLogoutURI:      "https://logout.ch/backchannel",
+							FrontChannelLogoutURI:     "https://logout.ch/frontchannel",
+							RequirePushedAuthRequests: true,
+							ComplianceProblems:        nil,
+							AllowedOrigins:            database.StringArray{"https://redirect.to", "additional.origin"},
 						},
 					},
 				},
@@ -758,6 +810,10 @@ func Test_AppsPrepare(t *testing.T) {
 							true,
 							1 * time.Second,
 							database.StringArray{"additional.origin"},
+							"https://logout.ch/backchannel",
+							"https://logout.ch/frontchannel",
+							true,
+							false,
 							// saml config
 							nil,
 							nil,
@@ -782,23 +838,26 @@ func Test_AppsPrepare(t *testing.T) {
 						Name:          "app-name",
 						ProjectID:     "project-id",
 						OIDCConfig: &OIDCApp{
-							Version:                domain.OIDCVersionV1,
-							ClientID:               "oidc-client-id",
-							RedirectURIs:           database.StringArray{"https://redirect.to/me"},
-							ResponseTypes:          database.EnumArray[domain.OIDCResponseType]{domain.OIDCResponseTypeIDTokenToken},
-							GrantTypes:             database.EnumArray[domain.OIDCGrantType]{domain.OIDCGrantTypeImplicit},
-							AppType:                domain.OIDCApplicationTypeUserAgent,
-							AuthMethodType:         domain.OIDCAuthMethodTypeNone,
-							PostLogoutRedirectURIs: database.StringArray{"post.logout.ch"},
-							IsDevMode:              false,
-							AccessTokenType:        domain.OIDCTokenTypeJWT,
-							AssertAccessTokenRole:  true,
-							AssertIDTokenRole:      true,
-							AssertIDTokenUserinfo:  true,
-							ClockSkew:              1 * time.Second,
-							AdditionalOrigins:      database.StringArray{"additional.origin"},
-							ComplianceProblems:     nil,
-							AllowedOrigins:         database.StringArray{"https://redirect.to", "additional.origin"},
+							Version:                   domain.OIDCVersionV1,
+							ClientID:                  "oidc-client-id",
+							RedirectURIs:              database.StringArray{"https://redirect.to/me"},
+							ResponseTypes:             database.EnumArray[domain.OIDCResponseType]{domain.OIDCResponseTypeIDTokenToken},
+							GrantTypes:                database.EnumArray[domain.OIDCGrantType]{domain.OIDCGrantTypeImplicit},
+							AppType:                   domain.OIDCApplicationTypeUserAgent,
+							AuthMethodType:            domain.OIDCAuthMethodTypeNone,
+							PostLogoutRedirectURIs:    database.StringArray{"post.logout.ch"},
+							IsDevMode:                 false,
+							AccessTokenType:           domain.OIDCTokenTypeJWT,
+							AssertAccessTokenRole:     true,
+							AssertIDTokenRole:         true,
+							AssertIDTokenUserinfo:     true,
+							ClockSkew:                 1 * time.Second,
+							AdditionalOrigins:         database.StringArray{"additional.origin"},
+							BackChannelLogoutURI:      "https://logout.ch/backchannel",
+							FrontChannelLogoutURI:     "https://logout.ch/frontchannel",
+							RequirePushedAuthRequests: true,
+							ComplianceProblems:        nil,
+							AllowedOrigins:            database.StringArray{"https://redirect.to", "additional.origin"},
 						},
 					},
 				},
@@ -842,6 +901,10 @@ func Test_AppsPrepare(t *testing.T) {
 							true,
 							1 * time.Second,
 							database.StringArray{"additional.origin"},
+							"https://logout.ch/backchannel",
+							"https://logout.ch/frontchannel",
+							true,
+							false,
 							// saml config
 							nil,
 							nil,
@@ -878,6 +941,10 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -914,6 +981,10 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							"saml-app-id",
 							"https://test.com/saml/metadata",
@@ -938,23 +1009,26 @@ func Test_AppsPrepare(t *testing.T) {
 						Name:          "app-name",
 						ProjectID:     "project-id",
 						OIDCConfig: &OIDCApp{
-							Version:                domain.OIDCVersionV1,
-							ClientID:               "oidc-client-id",
-							RedirectURIs:           database.StringArray{"https://redirect.to/me"},
-							ResponseTypes:          database.EnumArray[domain.OIDCResponseType]{domain.OIDCResponseTypeIDTokenToken},
-							GrantTypes:             database.EnumArray[domain.OIDCGrantType]{domain.OIDCGrantTypeImplicit},
-							AppType:                domain.OIDCApplicationTypeUserAgent,
-							AuthMethodType:         domain.OIDCAuthMethodTypeNone,
-							PostLogoutRedirectURIs: database.StringArray{"post.logout.ch"},
-							IsDevMode:              true,
-							AccessTokenType:        domain.OIDCTokenTypeJWT,
-							AssertAccessTokenRole:  true,
-							AssertIDTokenRole:      true,
-							AssertIDTokenUserinfo:  true,
-							ClockSkew:              1 * time.Second,
-							AdditionalOrigins:      database.StringArray{"additional.origin"},
-							ComplianceProblems:     nil,
-							AllowedOrigins:         database.StringArray{"https://redirect.to", "additional.origin"},
+							Version:                   domain.OIDCVersionV1,
+							ClientID:                  "oidc-client-id",
+							RedirectURIs:              database.StringArray{"https://redirect.to/me"},
+							ResponseTypes:             database.EnumArray[domain.OIDCResponseType]{domain.OIDCResponseTypeIDTokenToken},
+							GrantTypes:                database.EnumArray[domain.OIDCGrantType]{domain.OIDCGrantTypeImplicit},
+							AppType:                   domain.OIDCApplicationTypeUserAgent,
+							AuthMethodType:            domain.OIDCAuthMethodTypeNone,
+							PostLogoutRedirectURIs:    database.StringArray{"post.logout.ch"},
+							IsDevMode:                 true,
+							AccessTokenType:           domain.OIDCTokenTypeJWT,
+							AssertAccessTokenRole:     true,
+							AssertIDTokenRole:         true,
+							AssertIDTokenUserinfo:     true,
+							ClockSkew:                 1 * time.Second,
+							AdditionalOrigins:         database.StringArray{"additional.origin"},
+							BackChannelLogoutURI:      "https://logout.ch/backchannel",
+							FrontChannelLogoutURI:     "https://logout.ch/frontchannel",
+							RequirePushedAuthRequests: true,
+							ComplianceProblems:        nil,
+							AllowedOrigins:            database.StringArray{"https://redirect.to", "additional.origin"},
 						},
 					},
 					{
@@ -1080,6 +1154,10 @@ func Test_AppPrepare(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 						// saml config
 						nil,
 						nil,
@@ -1137,6 +1215,10 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -1199,6 +1281,10 @@ func Test_AppPrepare(t *testing.T) {
 							true,
 							1 * time.Second,
 							database.StringArray{"additional.origin"},
+							"https://logout.ch/backchannel",
+							"https://logout.ch/frontchannel",
+							true,
+							false,
 							// saml config
 							nil,
 							nil,
@@ -1218,23 +1304,26 @@ func Test_AppPrepare(t *testing.T) {
 				Name:          "app-name",
 				ProjectID:     "project-id",
 				OIDCConfig: &OIDCApp{
-					Version:                domain.OIDCVersionV1,
-					ClientID:               "oidc-client-id",
-					RedirectURIs:           database.StringArray{"https://redirect.to/me"},
-					ResponseTypes:          database.EnumArray[domain.OIDCResponseType]{domain.OIDCResponseTypeIDTokenToken},
-					GrantTypes:             database.EnumArray[domain.OIDCGrantType]{domain.OIDCGrantTypeImplicit},
-					AppType:                domain.OIDCApplicationTypeUserAgent,
-					AuthMethodType:         domain.OIDCAuthMethodTypeNone,
-					PostLogoutRedirectURIs: database.StringArray{"post.logout.ch"},
-					IsDevMode:              true,
-					AccessTokenType:        domain.OIDCTokenTypeJWT,
-					AssertAccessTokenRole:  true,
-					AssertIDTokenRole:      true,
-					AssertIDTokenUserinfo:  true,
-					ClockSkew:              1 * time.Second,
-					AdditionalOrigins:      database.StringArray{"additional.origin"},
-					ComplianceProblems:     nil,
-					AllowedOrigins:         database.StringArray{"https://redirect.to", "additional.origin"},
+					Version:                   domain.OIDCVersionV1,
+					ClientID:                  "oidc-client-id",
+					RedirectURIs:              database.StringArray{"https://redirect.to/me"},
+					ResponseTypes:             database.EnumArray[domain.OIDCResponseType]{domain.OIDCResponseTypeIDTokenToken},
+					GrantTypes:                database.EnumArray[domain.OIDCGrantType]{domain.OIDCGrantTypeImplicit},
+					AppType:                   domain.OIDCApplicationTypeUserAgent,
+					AuthMethodType:            domain.OIDCAuthMethodTypeNone,
+					PostLogoutRedirectURIs:    database.StringArray{"post.logout.ch"},
+					IsDevMode:                 true,
+					AccessTokenType:           domain.OIDCTokenTypeJWT,
+					AssertAccessTokenRole:     true,
+					AssertIDTokenRole:         true,
+					AssertIDTokenUserinfo:     true,
+					ClockSkew:                 1 * time.Second,
+					AdditionalOrigins:         database.StringArray{"additional.origin"},
+					BackChannelLogoutURI:      "https://logout.ch/backchannel",
+					FrontChannelLogoutURI:     "https://logout.ch/frontchannel",
+					RequirePushedAuthRequests: true,
+					ComplianceProblems:        nil,
+					AllowedOrigins:            database.StringArray{"https://redirect.to", "additional.origin"},
 				},
 			},
 		}, {
@@ -1275,6 +1364,10 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							"app-id",
 							"https://test.com/saml/metadata",
@@ -1338,6 +1431,10 @@ func Test_AppPrepare(t *testing.T) {
 							true,
 							1 * time.Second,
 							database.StringArray{"additional.origin"},
+							"https://logout.ch/backchannel",
+							"https://logout.ch/frontchannel",
+							true,
+							false,
 							// saml config
 							nil,
 							nil,
@@ -1357,23 +1454,26 @@ func Test_AppPrepare(t *testing.T) {
 				Name:          "app-name",
 				ProjectID:     "project-id",
 				OIDCConfig: &OIDCApp{
-					Version:                domain.OIDCVersionV1,
-					ClientID:               "oidc-client-id",
-					RedirectURIs:           database.StringArray{"https://redirect.to/me"},
-					ResponseTypes:          database.EnumArray[domain.OIDCResponseType]{domain.OIDCResponseTypeIDTokenToken},
-					GrantTypes:             database.EnumArray[domain.OIDCGrantType]{domain.OIDCGrantTypeImplicit},
-					AppType:                domain.OIDCApplicationTypeUserAgent,
-					AuthMethodType:         domain.OIDCAuthMethodTypeNone,
-					PostLogoutRedirectURIs: database.StringArray{"post.logout.ch"},
-					IsDevMode:              false,
-					AccessTokenType:        domain.OIDCTokenTypeJWT,
-					AssertAccessTokenRole:  true,
-					AssertIDTokenRole:      true,
-					AssertIDTokenUserinfo:  true,
-					ClockSkew:              1 * time.Second,
-					AdditionalOrigins:      database.StringArray{"additional.origin"},
-					ComplianceProblems:     nil,
-					AllowedOrigins:         database.StringArray{"https://redirect.to", "additional.origin"},
+					Version:                   domain.OIDCVersionV1,
+					ClientID:                  "oidc-client-id",
+					RedirectURIs:              database.StringArray{"https://redirect.to/me"},
+					ResponseTypes:             database.EnumArray[domain.OIDCResponseType]{domain.OIDCResponseTypeIDTokenToken},
+					GrantTypes:                database.EnumArray[domain.OIDCGrantType]{domain.OIDCGrantTypeImplicit},
+					AppType:                   domain.OIDCApplicationTypeUserAgent,
+					AuthMethodType:            domain.OIDCAuthMethodTypeNone,
+					PostLogoutRedirectURIs:    database.StringArray{"post.logout.ch"},
+					IsDevMode:                 false,
+					AccessTokenType:           domain.OIDCTokenTypeJWT,
+					AssertAccessTokenRole:     true,
+					AssertIDTokenRole:         true,
+					AssertIDTokenUserinfo:     true,
+					ClockSkew:                 1 * time.Second,
+					AdditionalOrigins:         database.StringArray{"additional.origin"},
+					BackChannelLogoutURI:      "https://logout.ch/backchannel",
+					FrontChannelLogoutURI:     "https://logout.ch/frontchannel",
+					RequirePushedAuthRequests: true,
+					ComplianceProblems:        nil,
+					AllowedOrigins:            database.StringArray{"https://redirect.to", "additional.origin"},
 				},
 			},
 		},
@@ -1415,6 +1515,10 @@ func Test_AppPrepare(t *testing.T) {
 							true,
 							1 * time.Second,
 							database.StringArray{"additional.origin"},
+							"https://logout.ch/backchannel",
+							"https://logout.ch/frontchannel",
+							true,
+							false,
 							// saml config
 							nil,
 							nil,
@@ -1434,23 +1538,26 @@ func Test_AppPrepare(t *testing.T) {
 				Name:          "app-name",
 				ProjectID:     "project-id",
 				OIDCConfig: &OIDCApp{
-					Version:                domain.OIDCVersionV1,
-					ClientID:               "oidc-client-id",
-					RedirectURIs:           database.StringArray{"https://redirect.to/me"},
-					ResponseTypes:          database.EnumArray[domain.OIDCResponseType]{domain.OIDCResponseTypeIDTokenToken},
-					GrantTypes:             database.EnumArray[domain.OIDCGrantType]{domain.OIDCGrantTypeImplicit},
-					AppType:                domain.OIDCApplicationTypeUserAgent,
-					AuthMethodType:         domain.OIDCAuthMethodTypeNone,
-					PostLogoutRedirectURIs: database.StringArray{"post.logout.ch"},
-					IsDevMode:              true,
-					AccessTokenType:        domain.OIDCTokenTypeJWT,
-					AssertAccessTokenRole:  false,
-					AssertIDTokenRole:      true,
-					AssertIDTokenUserinfo:  true,
-					ClockSkew:              1 * time.Second,
-					AdditionalOrigins:      database.StringArray{"additional.origin"},
-					ComplianceProblems:     nil,
-					AllowedOrigins:         database.StringArray{"https://redirect.to", "additional.origin"},
+					Version:                   domain.OIDCVersionV1,
+					ClientID:                  "oidc-client-id",
+					RedirectURIs:              database.StringArray{"https://redirect.to/me"},
+					ResponseTypes:             database.EnumArray[domain.OIDCResponseType]{domain.OIDCResponseTypeIDTokenToken},
+					GrantTypes:                database.EnumArray[domain.OIDCGrantType]{domain.OIDCGrantTypeImplicit},
+					AppType:                   domain.OIDCApplicationTypeUserAgent,
+					AuthMethodType:            domain.OIDCAuthMethodTypeNone,
+					PostLogoutRedirectURIs:    database.StringArray{"post.logout.ch"},
+					IsDevMode:                 true,
+					AccessTokenType:           domain.OIDCTokenTypeJWT,
+					AssertAccessTokenRole:     false,
+					AssertIDTokenRole:         true,
+					AssertIDTokenUserinfo:     true,
+					ClockSkew:                 1 * time.Second,
+					AdditionalOrigins:         database.StringArray{"additional.origin"},
+					BackChannelLogoutURI:      "https://logout.ch/backchannel",
+					FrontChannelLogoutURI:     "https://logout.ch/frontchannel",
+					RequirePushedAuthRequests: true,
+					ComplianceProblems:        nil,
+					AllowedOrigins:            database.StringArray{"https://redirect.to", "additional.origin"},
 				},
 			},
 		},
@@ -1492,6 +1599,10 @@ func Test_AppPrepare(t *testing.T) {
 							true,
 							1 * time.Second,
 							database.StringArray{"additional.origin"},
+							"https://logout.ch/backchannel",
+							"https://logout.ch/frontchannel",
+							true,
+							false,
 							// saml config
 							nil,
 							nil,
@@ -1511,23 +1622,26 @@ func Test_AppPrepare(t *testing.T) {
 				Name:          "app-name",
 				ProjectID:     "project-id",
 				OIDCConfig: &OIDCApp{
-					Version:                domain.OIDCVersionV1,
-					ClientID:               "oidc-client-id",
-					RedirectURIs:           database.StringArray{"https://redirect.to/me"},
-					ResponseTypes:          database.EnumArray[domain.OIDCResponseType]{domain.OIDCResponseTypeIDTokenToken},
-					GrantTypes:             database.EnumArray[domain.OIDCGrantType]{domain.OIDCGrantTypeImplicit},
-					AppType:                domain.OIDCApplicationTypeUserAgent,
-					AuthMethodType:         domain.OIDCAuthMethodTypeNone,
-					PostLogoutRedirectURIs: database.StringArray{"post.logout.ch"},
-					IsDevMode:              true,
-					AccessTokenType:        domain.OIDCTokenTypeJWT,
-					AssertAccessTokenRole:  true,
-					AssertIDTokenRole:      false,
-					AssertIDTokenUserinfo:  true,
-					ClockSkew:              1 * time.Second,
-					AdditionalOrigins:      database.StringArray{"additional.origin"},
-					ComplianceProblems:     nil,
-					AllowedOrigins:         database.StringArray{"https://redirect.to", "additional.origin"},
+					Version:                   domain.OIDCVersionV1,
+					ClientID:                  "oidc-client-id",
+					RedirectURIs:              database.StringArray{"https://redirect.to/me"},
+					ResponseTypes:             database.EnumArray[domain.OIDCResponseType]{domain.OIDCResponseTypeIDTokenToken},
+					GrantTypes:                database.EnumArray[domain.OIDCGrantType]{domain.OIDCGrantTypeImplicit},
+					AppType:                   domain.OIDCApplicationTypeUserAgent,
+					AuthMethodType:            domain.OIDCAuthMethodTypeNone,
+					PostLogoutRedirectURIs:    database.StringArray{"post.logout.ch"},
+					IsDevMode:                 true,
+					AccessTokenType:           domain.OIDCTokenTypeJWT,
+					AssertAccessTokenRole:     true,
+					AssertIDTokenRole:         false,
+					AssertIDTokenUserinfo:     true,
+					ClockSkew:                 1 * time.Second,
+					AdditionalOrigins:         database.StringArray{"additional.origin"},
+					BackChannelLogoutURI:      "https://logout.ch/backchannel",
+					FrontChannelLogoutURI:     "https://logout.ch/frontchannel",
+					RequirePushedAuthRequests: true,
+					ComplianceProblems:        nil,
+					AllowedOrigins:            database.StringArray{"https://redirect.to", "additional.origin"},
 				},
 			},
 		},
@@ -1569,6 +1683,10 @@ func Test_AppPrepare(t *testing.T) {
 							false,
 							1 * time.Second,
 							database.StringArray{"additional.origin"},
+							"https://logout.ch/backchannel",
+							"https://logout.ch/frontchannel",
+							true,
+							false,
 							// saml config
 							nil,
 							nil,
@@ -1588,23 +1706,26 @@ func Test_AppPrepare(t *testing.T) {
 				Name:          "app-name",
 				ProjectID:     "project-id",
 				OIDCConfig: &OIDCApp{
-					Version:                domain.OIDCVersionV1,
-					ClientID:               "oidc-client-id",
-					RedirectURIs:           database.StringArray{"https://redirect.to/me"},
-					ResponseTypes:          database.EnumArray[domain.OIDCResponseType]{domain.OIDCResponseTypeIDTokenToken},
-					GrantTypes:             database.EnumArray[domain.OIDCGrantType]{domain.OIDCGrantTypeImplicit},
-					AppType:                domain.OIDCApplicationTypeUserAgent,
-					AuthMethodType:         domain.OIDCAuthMethodTypeNone,
-					PostLogoutRedirectURIs: database.StringArray{"post.logout.ch"},
-					IsDevMode:              true,
-					AccessTokenType:        domain.OIDCTokenTypeJWT,
-					AssertAccessTokenRole:  true,
-					AssertIDTokenRole:      true,
-					AssertIDTokenUserinfo:  false,
-					ClockSkew:              1 * time.Second,
-					AdditionalOrigins:      database.StringArray{"additional.origin"},
-					ComplianceProblems:     nil,
-					AllowedOrigins:         database.StringArray{"https://redirect.to", "additional.origin"},
+					Version:                   domain.OIDCVersionV1,
+					ClientID:                  "oidc-client-id",
+					RedirectURIs:              database.StringArray{"https://redirect.to/me"},
+					ResponseTypes:             database.EnumArray[domain.OIDCResponseType]{domain.OIDCResponseTypeIDTokenToken},
+					GrantTypes:                database.EnumArray[domain.OIDCGrantType]{domain.OIDCGrantTypeImplicit},
+					AppType:                   domain.OIDCApplicationTypeUserAgent,
+					AuthMethodType:            domain.OIDCAuthMethodTypeNone,
+					PostLogoutRedirectURIs:    database.StringArray{"post.logout.ch"},
+					IsDevMode:                 true,
+					AccessTokenType:           domain.OIDCTokenTypeJWT,
+					AssertAccessTokenRole:     true,
+					AssertIDTokenRole:         true,
+					AssertIDTokenUserinfo:     false,
+					ClockSkew:                 1 * time.Second,
+					AdditionalOrigins:         database.StringArray{"additional.origin"},
+					BackChannelLogoutURI:      "https://logout.ch/backchannel",
+					FrontChannelLogoutURI:     "https://logout.ch/frontchannel",
+					RequirePushedAuthRequests: true,
+					ComplianceProblems:        nil,
+					AllowedOrigins:            database.StringArray{"https://redirect.to", "additional.origin"},
 				},
 			},
 		},
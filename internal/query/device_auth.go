@@ -0,0 +1,199 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	errs "errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/dennigogo/zitadel/internal/api/authz"
+	"github.com/dennigogo/zitadel/internal/database"
+	"github.com/dennigogo/zitadel/internal/domain"
+	"github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/query/projection"
+)
+
+var (
+	deviceAuthTable = table{
+		name: projection.DeviceAuthProjectionTable,
+	}
+	DeviceAuthIDCol = Column{
+		name:  projection.DeviceAuthColumnID,
+		table: deviceAuthTable,
+	}
+	DeviceAuthInstanceIDCol = Column{
+		name:  projection.DeviceAuthColumnInstanceID,
+		table: deviceAuthTable,
+	}
+	DeviceAuthClientIDCol = Column{
+		name:  projection.DeviceAuthColumnClientID,
+		table: deviceAuthTable,
+	}
+	DeviceAuthDeviceCodeCol = Column{
+		name:  projection.DeviceAuthColumnDeviceCode,
+		table: deviceAuthTable,
+	}
+	DeviceAuthUserCodeCol = Column{
+		name:  projection.DeviceAuthColumnUserCode,
+		table: deviceAuthTable,
+	}
+	DeviceAuthExpiresCol = Column{
+		name:  projection.DeviceAuthColumnExpires,
+		table: deviceAuthTable,
+	}
+	DeviceAuthScopesCol = Column{
+		name:  projection.DeviceAuthColumnScopes,
+		table: deviceAuthTable,
+	}
+	DeviceAuthStateCol = Column{
+		name:  projection.DeviceAuthColumnState,
+		table: deviceAuthTable,
+	}
+	DeviceAuthSubjectCol = Column{
+		name:  projection.DeviceAuthColumnSubject,
+		table: deviceAuthTable,
+	}
+	DeviceAuthUserOrgIDCol = Column{
+		name:  projection.DeviceAuthColumnUserOrgID,
+		table: deviceAuthTable,
+	}
+	DeviceAuthAMRCol = Column{
+		name:  projection.DeviceAuthColumnAMR,
+		table: deviceAuthTable,
+	}
+	DeviceAuthAuthTimeCol = Column{
+		name:  projection.DeviceAuthColumnAuthTime,
+		table: deviceAuthTable,
+	}
+)
+
+// DeviceAuthByDeviceCode is used by the token endpoint, where the device polls with its device code
+func (q *Queries) DeviceAuthByDeviceCode(ctx context.Context, shouldTriggerBulk bool, deviceCode string) (*domain.DeviceAuth, error) {
+	return q.deviceAuth(ctx, shouldTriggerBulk, DeviceAuthDeviceCodeCol, deviceCode)
+}
+
+// DeviceAuthByUserCode is used by the login, where the user enters the user code shown on the device
+func (q *Queries) DeviceAuthByUserCode(ctx context.Context, shouldTriggerBulk bool, userCode string) (*domain.DeviceAuth, error) {
+	return q.deviceAuth(ctx, shouldTriggerBulk, DeviceAuthUserCodeCol, userCode)
+}
+
+func (q *Queries) deviceAuth(ctx context.Context, shouldTriggerBulk bool, codeCol Column, code string) (*domain.DeviceAuth, error) {
+	if shouldTriggerBulk {
+		projection.DeviceAuthProjection.Trigger(ctx)
+	}
+
+	query, scan := prepareDeviceAuthQuery()
+	stmt, args, err := query.Where(
+		sq.Eq{
+			codeCol.identifier():                 code,
+			DeviceAuthInstanceIDCol.identifier(): authz.GetInstance(ctx).InstanceID(),
+		}).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Dv3s8", "Errors.Query.SQLStatment")
+	}
+
+	row := q.client.QueryRowContext(ctx, stmt, args...)
+	return scan(row)
+}
+
+func prepareDeviceAuthQuery() (sq.SelectBuilder, func(*sql.Row) (*domain.DeviceAuth, error)) {
+	return sq.Select(
+			DeviceAuthIDCol.identifier(),
+			DeviceAuthClientIDCol.identifier(),
+			DeviceAuthDeviceCodeCol.identifier(),
+			DeviceAuthUserCodeCol.identifier(),
+			DeviceAuthExpiresCol.identifier(),
+			DeviceAuthScopesCol.identifier(),
+			DeviceAuthStateCol.identifier(),
+			DeviceAuthSubjectCol.identifier(),
+			DeviceAuthUserOrgIDCol.identifier(),
+			DeviceAuthAMRCol.identifier(),
+			DeviceAuthAuthTimeCol.identifier(),
+		).
+			From(deviceAuthTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*domain.DeviceAuth, error) {
+			deviceAuth := new(domain.DeviceAuth)
+			var (
+				scopes   database.StringArray
+				amr      database.StringArray
+				authTime sql.NullTime
+			)
+			err := row.Scan(
+				&deviceAuth.ID,
+				&deviceAuth.ClientID,
+				&deviceAuth.DeviceCode,
+				&deviceAuth.UserCode,
+				&deviceAuth.Expires,
+				&scopes,
+				&deviceAuth.State,
+				&deviceAuth.Subject,
+				&deviceAuth.UserOrgID,
+				&amr,
+				&authTime,
+			)
+			if err != nil {
+				if errs.Is(err, sql.ErrNoRows) {
+					return nil, errors.ThrowNotFound(err, "QUERY-Dv7k2", "Errors.DeviceAuth.NotFound")
+				}
+				return nil, errors.ThrowInternal(err, "QUERY-Dv1m5", "Errors.Internal")
+			}
+			deviceAuth.Scopes = scopes
+			deviceAuth.AuthMethodsReferences = amr
+			deviceAuth.AuthTime = authTime.Time
+			return deviceAuth, nil
+		}
+}
+
+// ExpiredDeviceAuth is a device authorization, which expired before the device exchanged it
+type ExpiredDeviceAuth struct {
+	ID         string
+	InstanceID string
+}
+
+// ExpiredDeviceAuths returns at most limit device authorizations of all instances, which expired before now.
+// They must be removed to release their device and user codes.
+func (q *Queries) ExpiredDeviceAuths(ctx context.Context, now time.Time, limit uint64) ([]*ExpiredDeviceAuth, error) {
+	query, scan := prepareExpiredDeviceAuthsQuery()
+	stmt, args, err := query.Where(
+		sq.Lt{
+			DeviceAuthExpiresCol.identifier(): now,
+		}).
+		OrderBy(DeviceAuthExpiresCol.identifier()).
+		Limit(limit).
+		ToSql()
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Dv5x2", "Errors.Query.SQLStatment")
+	}
+
+	rows, err := q.client.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Dv8c4", "Errors.Internal")
+	}
+	return scan(rows)
+}
+
+func prepareExpiredDeviceAuthsQuery() (sq.SelectBuilder, func(*sql.Rows) ([]*ExpiredDeviceAuth, error)) {
+	return sq.Select(
+			DeviceAuthIDCol.identifier(),
+			DeviceAuthInstanceIDCol.identifier(),
+		).
+			From(deviceAuthTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) ([]*ExpiredDeviceAuth, error) {
+			expired := make([]*ExpiredDeviceAuth, 0)
+			for rows.Next() {
+				deviceAuth := new(ExpiredDeviceAuth)
+				if err := rows.Scan(&deviceAuth.ID, &deviceAuth.InstanceID); err != nil {
+					return nil, errors.ThrowInternal(err, "QUERY-Dv2w9", "Errors.Internal")
+				}
+				expired = append(expired, deviceAuth)
+			}
+			if err := rows.Close(); err != nil {
+				return nil, errors.ThrowInternal(err, "QUERY-Dv6j3", "Errors.Query.CloseRows")
+			}
+			return expired, nil
+		}
+}
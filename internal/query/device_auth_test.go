@@ -0,0 +1,186 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/dennigogo/zitadel/internal/database"
+	"github.com/dennigogo/zitadel/internal/domain"
+	errs "github.com/dennigogo/zitadel/internal/errors"
+)
+
+var (
+	deviceAuthQuery = `SELECT projections.device_authorizations.id,` +
+		` projections.device_authorizations.client_id,` +
+		` projections.device_authorizations.device_code,` +
+		` projections.device_authorizations.user_code,` +
+		` projections.device_authorizations.expires,` +
+		` projections.device_authorizations.scopes,` +
+		` projections.device_authorizations.state,` +
+		` projections.device_authorizations.subject,` +
+		` projections.device_authorizations.user_org_id,` +
+		` projections.device_authorizations.amr,` +
+		` projections.device_authorizations.auth_time` +
+		` FROM projections.device_authorizations`
+	deviceAuthCols = []string{
+		"id",
+		"client_id",
+		"device_code",
+		"user_code",
+		"expires",
+		"scopes",
+		"state",
+		"subject",
+		"user_org_id",
+		"amr",
+		"auth_time",
+	}
+	expiredDeviceAuthsQuery = `SELECT projections.device_authorizations.id,` +
+		` projections.device_authorizations.instance_id` +
+		` FROM projections.device_authorizations`
+	expiredDeviceAuthsCols = []string{
+		"id",
+		"instance_id",
+	}
+)
+
+func Test_DeviceAuthPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareDeviceAuthQuery no result",
+			prepare: prepareDeviceAuthQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(deviceAuthQuery),
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !errs.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*domain.DeviceAuth)(nil),
+		},
+		{
+			name:    "prepareDeviceAuthQuery found",
+			prepare: prepareDeviceAuthQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(deviceAuthQuery),
+					deviceAuthCols,
+					[]driver.Value{
+						"id",
+						"client-id",
+						"device-code",
+						"ABCD-EFGH",
+						testNow,
+						database.StringArray{"openid"},
+						domain.DeviceAuthStateApproved,
+						"user-id",
+						"org-id",
+						database.StringArray{"pwd"},
+						testNow,
+					},
+				),
+			},
+			object: &domain.DeviceAuth{
+				ID:                    "id",
+				ClientID:              "client-id",
+				DeviceCode:            "device-code",
+				UserCode:              "ABCD-EFGH",
+				Expires:               testNow,
+				Scopes:                []string{"openid"},
+				State:                 domain.DeviceAuthStateApproved,
+				Subject:               "user-id",
+				UserOrgID:             "org-id",
+				AuthMethodsReferences: []string{"pwd"},
+				AuthTime:              testNow,
+			},
+		},
+		{
+			name:    "prepareExpiredDeviceAuthsQuery no result",
+			prepare: prepareExpiredDeviceAuthsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(expiredDeviceAuthsQuery),
+					nil,
+					nil,
+				),
+			},
+			object: []*ExpiredDeviceAuth{},
+		},
+		{
+			name:    "prepareExpiredDeviceAuthsQuery found",
+			prepare: prepareExpiredDeviceAuthsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(expiredDeviceAuthsQuery),
+					expiredDeviceAuthsCols,
+					[][]driver.Value{
+						{"id1", "instance1"},
+						{"id2", "instance2"},
+					},
+				),
+			},
+			object: []*ExpiredDeviceAuth{
+				{ID: "id1", InstanceID: "instance1"},
+				{ID: "id2", InstanceID: "instance2"},
+			},
+		},
+		{
+			name:    "prepareExpiredDeviceAuthsQuery sql err",
+			prepare: prepareExpiredDeviceAuthsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(expiredDeviceAuthsQuery),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: nil,
+		},
+		{
+			name:    "prepareDeviceAuthQuery sql err",
+			prepare: prepareDeviceAuthQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(deviceAuthQuery),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err)
+		})
+	}
+}
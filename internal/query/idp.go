@@ -30,6 +30,9 @@ type IDP struct {
 	AutoRegister  bool
 	*OIDCIDP
 	*JWTIDP
+	*SAMLIDP
+	*LDAPIDP
+	*OAuth2IDP
 }
 
 type IDPs struct {
@@ -57,6 +60,49 @@ type JWTIDP struct {
 	Endpoint     string
 }
 
+type SAMLIDP struct {
+	IDPID             string
+	Metadata          []byte
+	MetadataURL       string
+	Key               *crypto.CryptoValue
+	Certificate       []byte
+	WithSignedRequest bool
+}
+
+type LDAPIDP struct {
+	IDPID                      string
+	URL                        string
+	StartTLS                   bool
+	BaseDN                     string
+	BindDN                     string
+	BindPassword               *crypto.CryptoValue
+	UserFilter                 string
+	IDAttribute                string
+	PreferredUsernameAttribute string
+	FirstNameAttribute         string
+	LastNameAttribute          string
+	DisplayNameAttribute       string
+	EmailAttribute             string
+	PhoneAttribute             string
+}
+
+type OAuth2IDP struct {
+	IDPID                 string
+	ClientID              string
+	ClientSecret          *crypto.CryptoValue
+	AuthorizationEndpoint string
+	TokenEndpoint         string
+	UserEndpoint          string
+	Scopes                database.StringArray
+	IDPath                string
+	PreferredUsernamePath string
+	FirstNamePath         string
+	LastNamePath          string
+	DisplayNamePath       string
+	EmailPath             string
+	PhonePath             string
+}
+
 var (
 	idpTable = table{
 		name: projection.IDPTable,
@@ -179,6 +225,160 @@ var (
 	}
 )
 
+var (
+	samlIDPTable = table{
+		name: projection.IDPSAMLTable,
+	}
+	SAMLIDPColIDPID = Column{
+		name:  projection.SAMLConfigIDPIDCol,
+		table: samlIDPTable,
+	}
+	SAMLIDPColMetadata = Column{
+		name:  projection.SAMLConfigMetadataCol,
+		table: samlIDPTable,
+	}
+	SAMLIDPColMetadataURL = Column{
+		name:  projection.SAMLConfigMetadataURLCol,
+		table: samlIDPTable,
+	}
+	SAMLIDPColKey = Column{
+		name:  projection.SAMLConfigKeyCol,
+		table: samlIDPTable,
+	}
+	SAMLIDPColCertificate = Column{
+		name:  projection.SAMLConfigCertificateCol,
+		table: samlIDPTable,
+	}
+	SAMLIDPColWithSignedRequest = Column{
+		name:  projection.SAMLConfigWithSignedRequestCol,
+		table: samlIDPTable,
+	}
+)
+
+var (
+	ldapIDPTable = table{
+		name: projection.IDPLDAPTable,
+	}
+	LDAPIDPColIDPID = Column{
+		name:  projection.LDAPConfigIDPIDCol,
+		table: ldapIDPTable,
+	}
+	LDAPIDPColURL = Column{
+		name:  projection.LDAPConfigURLCol,
+		table: ldapIDPTable,
+	}
+	LDAPIDPColStartTLS = Column{
+		name:  projection.LDAPConfigStartTLSCol,
+		table: ldapIDPTable,
+	}
+	LDAPIDPColBaseDN = Column{
+		name:  projection.LDAPConfigBaseDNCol,
+		table: ldapIDPTable,
+	}
+	LDAPIDPColBindDN = Column{
+		name:  projection.LDAPConfigBindDNCol,
+		table: ldapIDPTable,
+	}
+	LDAPIDPColBindPassword = Column{
+		name:  projection.LDAPConfigBindPasswordCol,
+		table: ldapIDPTable,
+	}
+	LDAPIDPColUserFilter = Column{
+		name:  projection.LDAPConfigUserFilterCol,
+		table: ldapIDPTable,
+	}
+	LDAPIDPColIDAttribute = Column{
+		name:  projection.LDAPConfigIDAttributeCol,
+		table: ldapIDPTable,
+	}
+	LDAPIDPColPreferredUsernameAttribute = Column{
+		name:  projection.LDAPConfigPreferredUsernameAttributeCol,
+		table: ldapIDPTable,
+	}
+	LDAPIDPColFirstNameAttribute = Column{
+		name:  projection.LDAPConfigFirstNameAttributeCol,
+		table: ldapIDPTable,
+	}
+	LDAPIDPColLastNameAttribute = Column{
+		name:  projection.LDAPConfigLastNameAttributeCol,
+		table: ldapIDPTable,
+	}
+	LDAPIDPColDisplayNameAttribute = Column{
+		name:  projection.LDAPConfigDisplayNameAttributeCol,
+		table: ldapIDPTable,
+	}
+	LDAPIDPColEmailAttribute = Column{
+		name:  projection.LDAPConfigEmailAttributeCol,
+		table: ldapIDPTable,
+	}
+	LDAPIDPColPhoneAttribute = Column{
+		name:  projection.LDAPConfigPhoneAttributeCol,
+		table: ldapIDPTable,
+	}
+)
+
+var (
+	oauth2IDPTable = table{
+		name: projection.IDPOAuth2Table,
+	}
+	OAuth2IDPColIDPID = Column{
+		name:  projection.OAuth2ConfigIDPIDCol,
+		table: oauth2IDPTable,
+	}
+	OAuth2IDPColClientID = Column{
+		name:  projection.OAuth2ConfigClientIDCol,
+		table: oauth2IDPTable,
+	}
+	OAuth2IDPColClientSecret = Column{
+		name:  projection.OAuth2ConfigClientSecretCol,
+		table: oauth2IDPTable,
+	}
+	OAuth2IDPColAuthorizationEndpoint = Column{
+		name:  projection.OAuth2ConfigAuthorizationEndpointCol,
+		table: oauth2IDPTable,
+	}
+	OAuth2IDPColTokenEndpoint = Column{
+		name:  projection.OAuth2ConfigTokenEndpointCol,
+		table: oauth2IDPTable,
+	}
+	OAuth2IDPColUserEndpoint = Column{
+		name:  projection.OAuth2ConfigUserEndpointCol,
+		table: oauth2IDPTable,
+	}
+	OAuth2IDPColScopes = Column{
+		name:  projection.OAuth2ConfigScopesCol,
+		table: oauth2IDPTable,
+	}
+	OAuth2IDPColIDPath = Column{
+		name:  projection.OAuth2ConfigIDPathCol,
+		table: oauth2IDPTable,
+	}
+	OAuth2IDPColPreferredUsernamePath = Column{
+		name:  projection.OAuth2ConfigPreferredUsernamePathCol,
+		table: oauth2IDPTable,
+	}
+	OAuth2IDPColFirstNamePath = Column{
+		name:  projection.OAuth2ConfigFirstNamePathCol,
+		table: oauth2IDPTable,
+	}
+	OAuth2IDPColLastNamePath = Column{
+		name:  projection.OAuth2ConfigLastNamePathCol,
+		table: oauth2IDPTable,
+	}
+	OAuth2IDPColDisplayNamePath = Column{
+		name:  projection.OAuth2ConfigDisplayNamePathCol,
+		table: oauth2IDPTable,
+	}
+	OAuth2IDPColEmailPath = Column{
+		name:  projection.OAuth2ConfigEmailPathCol,
+		table: oauth2IDPTable,
+	}
+	OAuth2IDPColPhonePath = Column{
+		name:  projection.OAuth2ConfigPhonePathCol,
+		table: oauth2IDPTable,
+	}
+)
+
 // IDPByIDAndResourceOwner searches for the requested id in the context of the resource owner and IAM
 func (q *Queries) IDPByIDAndResourceOwner(ctx context.Context, shouldTriggerBulk bool, id, resourceOwner string) (*IDP, error) {
 	if shouldTriggerBulk {
@@ -296,9 +496,46 @@ func prepareIDPByIDQuery() (sq.SelectBuilder, func(*sql.Row) (*IDP, error)) {
 			JWTIDPColKeysEndpoint.identifier(),
 			JWTIDPColHeaderName.identifier(),
 			JWTIDPColEndpoint.identifier(),
+			SAMLIDPColIDPID.identifier(),
+			SAMLIDPColMetadata.identifier(),
+			SAMLIDPColMetadataURL.identifier(),
+			SAMLIDPColKey.identifier(),
+			SAMLIDPColCertificate.identifier(),
+			SAMLIDPColWithSignedRequest.identifier(),
+			LDAPIDPColIDPID.identifier(),
+			LDAPIDPColURL.identifier(),
+			LDAPIDPColStartTLS.identifier(),
+			LDAPIDPColBaseDN.identifier(),
+			LDAPIDPColBindDN.identifier(),
+			LDAPIDPColBindPassword.identifier(),
+			LDAPIDPColUserFilter.identifier(),
+			LDAPIDPColIDAttribute.identifier(),
+			LDAPIDPColPreferredUsernameAttribute.identifier(),
+			LDAPIDPColFirstNameAttribute.identifier(),
+			LDAPIDPColLastNameAttribute.identifier(),
+			LDAPIDPColDisplayNameAttribute.identifier(),
+			LDAPIDPColEmailAttribute.identifier(),
+			LDAPIDPColPhoneAttribute.identifier(),
+			OAuth2IDPColIDPID.identifier(),
+			OAuth2IDPColClientID.identifier(),
+			OAuth2IDPColClientSecret.identifier(),
+			OAuth2IDPColAuthorizationEndpoint.identifier(),
+			OAuth2IDPColTokenEndpoint.identifier(),
+			OAuth2IDPColUserEndpoint.identifier(),
+			OAuth2IDPColScopes.identifier(),
+			OAuth2IDPColIDPath.identifier(),
+			OAuth2IDPColPreferredUsernamePath.identifier(),
+			OAuth2IDPColFirstNamePath.identifier(),
+			OAuth2IDPColLastNamePath.identifier(),
+			OAuth2IDPColDisplayNamePath.identifier(),
+			OAuth2IDPColEmailPath.identifier(),
+			OAuth2IDPColPhonePath.identifier(),
 		).From(idpTable.identifier()).
 			LeftJoin(join(OIDCIDPColIDPID, IDPIDCol)).
 			LeftJoin(join(JWTIDPColIDPID, IDPIDCol)).
+			LeftJoin(join(SAMLIDPColIDPID, IDPIDCol)).
+			LeftJoin(join(LDAPIDPColIDPID, IDPIDCol)).
+			LeftJoin(join(OAuth2IDPColIDPID, IDPIDCol)).
 			PlaceholderFormat(sq.Dollar),
 		func(row *sql.Row) (*IDP, error) {
 			idp := new(IDP)
@@ -319,6 +556,43 @@ func prepareIDPByIDQuery() (sq.SelectBuilder, func(*sql.Row) (*IDP, error)) {
 			jwtHeaderName := sql.NullString{}
 			jwtEndpoint := sql.NullString{}
 
+			samlIDPID := sql.NullString{}
+			var samlMetadata []byte
+			samlMetadataURL := sql.NullString{}
+			samlKey := new(crypto.CryptoValue)
+			var samlCertificate []byte
+			samlWithSignedRequest := sql.NullBool{}
+
+			ldapIDPID := sql.NullString{}
+			ldapURL := sql.NullString{}
+			ldapStartTLS := sql.NullBool{}
+			ldapBaseDN := sql.NullString{}
+			ldapBindDN := sql.NullString{}
+			ldapBindPassword := new(crypto.CryptoValue)
+			ldapUserFilter := sql.NullString{}
+			ldapIDAttribute := sql.NullString{}
+			ldapPreferredUsernameAttribute := sql.NullString{}
+			ldapFirstNameAttribute := sql.NullString{}
+			ldapLastNameAttribute := sql.NullString{}
+			ldapDisplayNameAttribute := sql.NullString{}
+			ldapEmailAttribute := sql.NullString{}
+			ldapPhoneAttribute := sql.NullString{}
+
+			oauth2IDPID := sql.NullString{}
+			oauth2ClientID := sql.NullString{}
+			oauth2ClientSecret := new(crypto.CryptoValue)
+			oauth2AuthorizationEndpoint := sql.NullString{}
+			oauth2TokenEndpoint := sql.NullString{}
+			oauth2UserEndpoint := sql.NullString{}
+			oauth2Scopes := database.StringArray{}
+			oauth2IDPath := sql.NullString{}
+			oauth2PreferredUsernamePath := sql.NullString{}
+			oauth2FirstNamePath := sql.NullString{}
+			oauth2LastNamePath := sql.NullString{}
+			oauth2DisplayNamePath := sql.NullString{}
+			oauth2EmailPath := sql.NullString{}
+			oauth2PhonePath := sql.NullString{}
+
 			err := row.Scan(
 				&idp.ID,
 				&idp.ResourceOwner,
@@ -344,6 +618,40 @@ func prepareIDPByIDQuery() (sq.SelectBuilder, func(*sql.Row) (*IDP, error)) {
 				&jwtKeysEndpoint,
 				&jwtHeaderName,
 				&jwtEndpoint,
+				&samlIDPID,
+				&samlMetadata,
+				&samlMetadataURL,
+				samlKey,
+				&samlCertificate,
+				&samlWithSignedRequest,
+				&ldapIDPID,
+				&ldapURL,
+				&ldapStartTLS,
+				&ldapBaseDN,
+				&ldapBindDN,
+				ldapBindPassword,
+				&ldapUserFilter,
+				&ldapIDAttribute,
+				&ldapPreferredUsernameAttribute,
+				&ldapFirstNameAttribute,
+				&ldapLastNameAttribute,
+				&ldapDisplayNameAttribute,
+				&ldapEmailAttribute,
+				&ldapPhoneAttribute,
+				&oauth2IDPID,
+				&oauth2ClientID,
+				oauth2ClientSecret,
+				&oauth2AuthorizationEndpoint,
+				&oauth2TokenEndpoint,
+				&oauth2UserEndpoint,
+				&oauth2Scopes,
+				&oauth2IDPath,
+				&oauth2PreferredUsernamePath,
+				&oauth2FirstNamePath,
+				&oauth2LastNamePath,
+				&oauth2DisplayNamePath,
+				&oauth2EmailPath,
+				&oauth2PhonePath,
 			)
 			if err != nil {
 				if errs.Is(err, sql.ErrNoRows) {
@@ -372,6 +680,49 @@ func prepareIDPByIDQuery() (sq.SelectBuilder, func(*sql.Row) (*IDP, error)) {
 					HeaderName:   jwtHeaderName.String,
 					Endpoint:     jwtEndpoint.String,
 				}
+			} else if samlIDPID.Valid {
+				idp.SAMLIDP = &SAMLIDP{
+					IDPID:             samlIDPID.String,
+					Metadata:          samlMetadata,
+					MetadataURL:       samlMetadataURL.String,
+					Key:               samlKey,
+					Certificate:       samlCertificate,
+					WithSignedRequest: samlWithSignedRequest.Bool,
+				}
+			} else if ldapIDPID.Valid {
+				idp.LDAPIDP = &LDAPIDP{
+					IDPID:                      ldapIDPID.String,
+					URL:                        ldapURL.String,
+					StartTLS:                   ldapStartTLS.Bool,
+					BaseDN:                     ldapBaseDN.String,
+					BindDN:                     ldapBindDN.String,
+					BindPassword:               ldapBindPassword,
+					UserFilter:                 ldapUserFilter.String,
+					IDAttribute:                ldapIDAttribute.String,
+					PreferredUsernameAttribute: ldapPreferredUsernameAttribute.String,
+					FirstNameAttribute:         ldapFirstNameAttribute.String,
+					LastNameAttribute:          ldapLastNameAttribute.String,
+					DisplayNameAttribute:       ldapDisplayNameAttribute.String,
+					EmailAttribute:             ldapEmailAttribute.String,
+					PhoneAttribute:             ldapPhoneAttribute.String,
+				}
+			} else if oauth2IDPID.Valid {
+				idp.OAuth2IDP = &OAuth2IDP{
+					IDPID:                 oauth2IDPID.String,
+					ClientID:              oauth2ClientID.String,
+					ClientSecret:          oauth2ClientSecret,
+					AuthorizationEndpoint: oauth2AuthorizationEndpoint.String,
+					TokenEndpoint:         oauth2TokenEndpoint.String,
+					UserEndpoint:          oauth2UserEndpoint.String,
+					Scopes:                oauth2Scopes,
+					IDPath:                oauth2IDPath.String,
+					PreferredUsernamePath: oauth2PreferredUsernamePath.String,
+					FirstNamePath:         oauth2FirstNamePath.String,
+					LastNamePath:          oauth2LastNamePath.String,
+					DisplayNamePath:       oauth2DisplayNamePath.String,
+					EmailPath:             oauth2EmailPath.String,
+					PhonePath:             oauth2PhonePath.String,
+				}
 			}
 
 			return idp, nil
@@ -404,10 +755,47 @@ func prepareIDPsQuery() (sq.SelectBuilder, func(*sql.Rows) (*IDPs, error)) {
 			JWTIDPColKeysEndpoint.identifier(),
 			JWTIDPColHeaderName.identifier(),
 			JWTIDPColEndpoint.identifier(),
+			SAMLIDPColIDPID.identifier(),
+			SAMLIDPColMetadata.identifier(),
+			SAMLIDPColMetadataURL.identifier(),
+			SAMLIDPColKey.identifier(),
+			SAMLIDPColCertificate.identifier(),
+			SAMLIDPColWithSignedRequest.identifier(),
+			LDAPIDPColIDPID.identifier(),
+			LDAPIDPColURL.identifier(),
+			LDAPIDPColStartTLS.identifier(),
+			LDAPIDPColBaseDN.identifier(),
+			LDAPIDPColBindDN.identifier(),
+			LDAPIDPColBindPassword.identifier(),
+			LDAPIDPColUserFilter.identifier(),
+			LDAPIDPColIDAttribute.identifier(),
+			LDAPIDPColPreferredUsernameAttribute.identifier(),
+			LDAPIDPColFirstNameAttribute.identifier(),
+			LDAPIDPColLastNameAttribute.identifier(),
+			LDAPIDPColDisplayNameAttribute.identifier(),
+			LDAPIDPColEmailAttribute.identifier(),
+			LDAPIDPColPhoneAttribute.identifier(),
+			OAuth2IDPColIDPID.identifier(),
+			OAuth2IDPColClientID.identifier(),
+			OAuth2IDPColClientSecret.identifier(),
+			OAuth2IDPColAuthorizationEndpoint.identifier(),
+			OAuth2IDPColTokenEndpoint.identifier(),
+			OAuth2IDPColUserEndpoint.identifier(),
+			OAuth2IDPColScopes.identifier(),
+			OAuth2IDPColIDPath.identifier(),
+			OAuth2IDPColPreferredUsernamePath.identifier(),
+			OAuth2IDPColFirstNamePath.identifier(),
+			OAuth2IDPColLastNamePath.identifier(),
+			OAuth2IDPColDisplayNamePath.identifier(),
+			OAuth2IDPColEmailPath.identifier(),
+			OAuth2IDPColPhonePath.identifier(),
 			countColumn.identifier(),
 		).From(idpTable.identifier()).
 			LeftJoin(join(OIDCIDPColIDPID, IDPIDCol)).
 			LeftJoin(join(JWTIDPColIDPID, IDPIDCol)).
+			LeftJoin(join(SAMLIDPColIDPID, IDPIDCol)).
+			LeftJoin(join(LDAPIDPColIDPID, IDPIDCol)).
+			LeftJoin(join(OAuth2IDPColIDPID, IDPIDCol)).
 			PlaceholderFormat(sq.Dollar),
 		func(rows *sql.Rows) (*IDPs, error) {
 			idps := make([]*IDP, 0)
@@ -431,6 +819,43 @@ func prepareIDPsQuery() (sq.SelectBuilder, func(*sql.Rows) (*IDPs, error)) {
 				jwtHeaderName := sql.NullString{}
 				jwtEndpoint := sql.NullString{}
 
+				samlIDPID := sql.NullString{}
+				var samlMetadata []byte
+				samlMetadataURL := sql.NullString{}
+				samlKey := new(crypto.CryptoValue)
+				var samlCertificate []byte
+				samlWithSignedRequest := sql.NullBool{}
+
+				ldapIDPID := sql.NullString{}
+				ldapURL := sql.NullString{}
+				ldapStartTLS := sql.NullBool{}
+				ldapBaseDN := sql.NullString{}
+				ldapBindDN := sql.NullString{}
+				ldapBindPassword := new(crypto.CryptoValue)
+				ldapUserFilter := sql.NullString{}
+				ldapIDAttribute := sql.NullString{}
+				ldapPreferredUsernameAttribute := sql.NullString{}
+				ldapFirstNameAttribute := sql.NullString{}
+				ldapLastNameAttribute := sql.NullString{}
+				ldapDisplayNameAttribute := sql.NullString{}
+				ldapEmailAttribute := sql.NullString{}
+				ldapPhoneAttribute := sql.NullString{}
+
+				oauth2IDPID := sql.NullString{}
+				oauth2ClientID := sql.NullString{}
+				oauth2ClientSecret := new(crypto.CryptoValue)
+				oauth2AuthorizationEndpoint := sql.NullString{}
+				oauth2TokenEndpoint := sql.NullString{}
+				oauth2UserEndpoint := sql.NullString{}
+				oauth2Scopes := database.StringArray{}
+				oauth2IDPath := sql.NullString{}
+				oauth2PreferredUsernamePath := sql.NullString{}
+				oauth2FirstNamePath := sql.NullString{}
+				oauth2LastNamePath := sql.NullString{}
+				oauth2DisplayNamePath := sql.NullString{}
+				oauth2EmailPath := sql.NullString{}
+				oauth2PhonePath := sql.NullString{}
+
 				err := rows.Scan(
 					&idp.ID,
 					&idp.ResourceOwner,
@@ -458,6 +883,43 @@ func prepareIDPsQuery() (sq.SelectBuilder, func(*sql.Rows) (*IDPs, error)) {
 					&jwtKeysEndpoint,
 					&jwtHeaderName,
 					&jwtEndpoint,
+					// saml config
+					&samlIDPID,
+					&samlMetadata,
+					&samlMetadataURL,
+					samlKey,
+					&samlCertificate,
+					&samlWithSignedRequest,
+					// ldap config
+					&ldapIDPID,
+					&ldapURL,
+					&ldapStartTLS,
+					&ldapBaseDN,
+					&ldapBindDN,
+					ldapBindPassword,
+					&ldapUserFilter,
+					&ldapIDAttribute,
+					&ldapPreferredUsernameAttribute,
+					&ldapFirstNameAttribute,
+					&ldapLastNameAttribute,
+					&ldapDisplayNameAttribute,
+					&ldapEmailAttribute,
+					&ldapPhoneAttribute,
+					// oauth2 config
+					&oauth2IDPID,
+					&oauth2ClientID,
+					oauth2ClientSecret,
+					&oauth2AuthorizationEndpoint,
+					&oauth2TokenEndpoint,
+					&oauth2UserEndpoint,
+					&oauth2Scopes,
+					&oauth2IDPath,
+					&oauth2PreferredUsernamePath,
+					&oauth2FirstNamePath,
+					&oauth2LastNamePath,
+					&oauth2DisplayNamePath,
+					&oauth2EmailPath,
+					&oauth2PhonePath,
 					&count,
 				)
 
@@ -485,6 +947,49 @@ func prepareIDPsQuery() (sq.SelectBuilder, func(*sql.Rows) (*IDPs, error)) {
 						HeaderName:   jwtHeaderName.String,
 						Endpoint:     jwtEndpoint.String,
 					}
+				} else if samlIDPID.Valid {
+					idp.SAMLIDP = &SAMLIDP{
+						IDPID:             samlIDPID.String,
+						Metadata:          samlMetadata,
+						MetadataURL:       samlMetadataURL.String,
+						Key:               samlKey,
+						Certificate:       samlCertificate,
+						WithSignedRequest: samlWithSignedRequest.Bool,
+					}
+				} else if ldapIDPID.Valid {
+					idp.LDAPIDP = &LDAPIDP{
+						IDPID:                      ldapIDPID.String,
+						URL:                        ldapURL.String,
+						StartTLS:                   ldapStartTLS.Bool,
+						BaseDN:                     ldapBaseDN.String,
+						BindDN:                     ldapBindDN.String,
+						BindPassword:               ldapBindPassword,
+						UserFilter:                 ldapUserFilter.String,
+						IDAttribute:                ldapIDAttribute.String,
+						PreferredUsernameAttribute: ldapPreferredUsernameAttribute.String,
+						FirstNameAttribute:         ldapFirstNameAttribute.String,
+						LastNameAttribute:          ldapLastNameAttribute.String,
+						DisplayNameAttribute:       ldapDisplayNameAttribute.String,
+						EmailAttribute:             ldapEmailAttribute.String,
+						PhoneAttribute:             ldapPhoneAttribute.String,
+					}
+				} else if oauth2IDPID.Valid {
+					idp.OAuth2IDP = &OAuth2IDP{
+						IDPID:                 oauth2IDPID.String,
+						ClientID:              oauth2ClientID.String,
+						ClientSecret:          oauth2ClientSecret,
+						AuthorizationEndpoint: oauth2AuthorizationEndpoint.String,
+						TokenEndpoint:         oauth2TokenEndpoint.String,
+						UserEndpoint:          oauth2UserEndpoint.String,
+						Scopes:                oauth2Scopes,
+						IDPath:                oauth2IDPath.String,
+						PreferredUsernamePath: oauth2PreferredUsernamePath.String,
+						FirstNamePath:         oauth2FirstNamePath.String,
+						LastNamePath:          oauth2LastNamePath.String,
+						DisplayNamePath:       oauth2DisplayNamePath.String,
+						EmailPath:             oauth2EmailPath.String,
+						PhonePath:             oauth2PhonePath.String,
+					}
 				}
 
 				idps = append(idps, idp)
@@ -509,8 +1014,8 @@ func (q *Queries) GetOIDCIDPClientSecret(ctx context.Context, shouldRealTime boo
 		return "", err
 	}
 
-	if idp.ClientSecret != nil && idp.ClientSecret.Crypted != nil {
-		return crypto.DecryptString(idp.ClientSecret, q.idpConfigEncryption)
+	if idp.OIDCIDP != nil && idp.OIDCIDP.ClientSecret != nil && idp.OIDCIDP.ClientSecret.Crypted != nil {
+		return crypto.DecryptString(idp.OIDCIDP.ClientSecret, q.idpConfigEncryption)
 	}
 	return "", errors.ThrowNotFound(nil, "QUERY-bsm2o", "Errors.Query.NotFound")
 }
@@ -53,10 +53,47 @@ func Test_IDPPrepares(t *testing.T) {
 						` projections.idps2_jwt_config.issuer,`+
 						` projections.idps2_jwt_config.keys_endpoint,`+
 						` projections.idps2_jwt_config.header_name,`+
-						` projections.idps2_jwt_config.endpoint`+
+						` projections.idps2_jwt_config.endpoint,`+
+						` projections.idps2_saml_config.idp_id,`+
+						` projections.idps2_saml_config.metadata,`+
+						` projections.idps2_saml_config.metadata_url,`+
+						` projections.idps2_saml_config.key,`+
+						` projections.idps2_saml_config.certificate,`+
+						` projections.idps2_saml_config.with_signed_request,`+
+						` projections.idps2_ldap_config.idp_id,`+
+						` projections.idps2_ldap_config.url,`+
+						` projections.idps2_ldap_config.start_tls,`+
+						` projections.idps2_ldap_config.base_dn,`+
+						` projections.idps2_ldap_config.bind_dn,`+
+						` projections.idps2_ldap_config.bind_password,`+
+						` projections.idps2_ldap_config.user_filter,`+
+						` projections.idps2_ldap_config.id_attribute,`+
+						` projections.idps2_ldap_config.preferred_username_attribute,`+
+						` projections.idps2_ldap_config.first_name_attribute,`+
+						` projections.idps2_ldap_config.last_name_attribute,`+
+						` projections.idps2_ldap_config.display_name_attribute,`+
+						` projections.idps2_ldap_config.email_attribute,`+
+						` projections.idps2_ldap_config.phone_attribute,`+
+						` projections.idps2_oauth2_config.idp_id,`+
+						` projections.idps2_oauth2_config.client_id,`+
+						` projections.idps2_oauth2_config.client_secret,`+
+						` projections.idps2_oauth2_config.authorization_endpoint,`+
+						` projections.idps2_oauth2_config.token_endpoint,`+
+						` projections.idps2_oauth2_config.user_endpoint,`+
+						` projections.idps2_oauth2_config.scopes,`+
+						` projections.idps2_oauth2_config.id_path,`+
+						` projections.idps2_oauth2_config.preferred_username_path,`+
+						` projections.idps2_oauth2_config.first_name_path,`+
+						` projections.idps2_oauth2_config.last_name_path,`+
+						` projections.idps2_oauth2_config.display_name_path,`+
+						` projections.idps2_oauth2_config.email_path,`+
+						` projections.idps2_oauth2_config.phone_path`+
 						` FROM projections.idps2`+
 						` LEFT JOIN projections.idps2_oidc_config ON projections.idps2.id = projections.idps2_oidc_config.idp_id`+
-						` LEFT JOIN projections.idps2_jwt_config ON projections.idps2.id = projections.idps2_jwt_config.idp_id`),
+						` LEFT JOIN projections.idps2_jwt_config ON projections.idps2.id = projections.idps2_jwt_config.idp_id`+
+						` LEFT JOIN projections.idps2_saml_config ON projections.idps2.id = projections.idps2_saml_config.idp_id`+
+						` LEFT JOIN projections.idps2_ldap_config ON projections.idps2.id = projections.idps2_ldap_config.idp_id`+
+						` LEFT JOIN projections.idps2_oauth2_config ON projections.idps2.id = projections.idps2_oauth2_config.idp_id`),
 					nil,
 					nil,
 				),
@@ -97,10 +134,47 @@ func Test_IDPPrepares(t *testing.T) {
 						` projections.idps2_jwt_config.issuer,`+
 						` projections.idps2_jwt_config.keys_endpoint,`+
 						` projections.idps2_jwt_config.header_name,`+
-						` projections.idps2_jwt_config.endpoint`+
+						` projections.idps2_jwt_config.endpoint,`+
+						` projections.idps2_saml_config.idp_id,`+
+						` projections.idps2_saml_config.metadata,`+
+						` projections.idps2_saml_config.metadata_url,`+
+						` projections.idps2_saml_config.key,`+
+						` projections.idps2_saml_config.certificate,`+
+						` projections.idps2_saml_config.with_signed_request,`+
+						` projections.idps2_ldap_config.idp_id,`+
+						` projections.idps2_ldap_config.url,`+
+						` projections.idps2_ldap_config.start_tls,`+
+						` projections.idps2_ldap_config.base_dn,`+
+						` projections.idps2_ldap_config.bind_dn,`+
+						` projections.idps2_ldap_config.bind_password,`+
+						` projections.idps2_ldap_config.user_filter,`+
+						` projections.idps2_ldap_config.id_attribute,`+
+						` projections.idps2_ldap_config.preferred_username_attribute,`+
+						` projections.idps2_ldap_config.first_name_attribute,`+
+						` projections.idps2_ldap_config.last_name_attribute,`+
+						` projections.idps2_ldap_config.display_name_attribute,`+
+						` projections.idps2_ldap_config.email_attribute,`+
+						` projections.idps2_ldap_config.phone_attribute,`+
+						` projections.idps2_oauth2_config.idp_id,`+
+						` projections.idps2_oauth2_config.client_id,`+
+						` projections.idps2_oauth2_config.client_secret,`+
+						` projections.idps2_oauth2_config.authorization_endpoint,`+
+						` projections.idps2_oauth2_config.token_endpoint,`+
+						` projections.idps2_oauth2_config.user_endpoint,`+
+						` projections.idps2_oauth2_config.scopes,`+
+						` projections.idps2_oauth2_config.id_path,`+
+						` projections.idps2_oauth2_config.preferred_username_path,`+
+						` projections.idps2_oauth2_config.first_name_path,`+
+						` projections.idps2_oauth2_config.last_name_path,`+
+						` projections.idps2_oauth2_config.display_name_path,`+
+						` projections.idps2_oauth2_config.email_path,`+
+						` projections.idps2_oauth2_config.phone_path`+
 						` FROM projections.idps2`+
 						` LEFT JOIN projections.idps2_oidc_config ON projections.idps2.id = projections.idps2_oidc_config.idp_id`+
-						` LEFT JOIN projections.idps2_jwt_config ON projections.idps2.id = projections.idps2_jwt_config.idp_id`),
+						` LEFT JOIN projections.idps2_jwt_config ON projections.idps2.id = projections.idps2_jwt_config.idp_id`+
+						` LEFT JOIN projections.idps2_saml_config ON projections.idps2.id = projections.idps2_saml_config.idp_id`+
+						` LEFT JOIN projections.idps2_ldap_config ON projections.idps2.id = projections.idps2_ldap_config.idp_id`+
+						` LEFT JOIN projections.idps2_oauth2_config ON projections.idps2.id = projections.idps2_oauth2_config.idp_id`),
 					[]string{
 						"id",
 						"resource_owner",
@@ -128,6 +202,43 @@ func Test_IDPPrepares(t *testing.T) {
 						"keys_endpoint",
 						"header_name",
 						"endpoint",
+						// saml config
+						"idp_id",
+						"metadata",
+						"metadata_url",
+						"key",
+						"certificate",
+						"with_signed_request",
+						// ldap config
+						"idp_id",
+						"url",
+						"start_tls",
+						"base_dn",
+						"bind_dn",
+						"bind_password",
+						"user_filter",
+						"id_attribute",
+						"preferred_username_attribute",
+						"first_name_attribute",
+						"last_name_attribute",
+						"display_name_attribute",
+						"email_attribute",
+						"phone_attribute",
+						// oauth2 config
+						"idp_id",
+						"client_id",
+						"client_secret",
+						"authorization_endpoint",
+						"token_endpoint",
+						"user_endpoint",
+						"scopes",
+						"id_path",
+						"preferred_username_path",
+						"first_name_path",
+						"last_name_path",
+						"display_name_path",
+						"email_path",
+						"phone_path",
 					},
 					[]driver.Value{
 						"idp-id",
@@ -156,6 +267,43 @@ func Test_IDPPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						// saml config
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// ldap config
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// oauth2 config
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 					},
 				),
 			},
@@ -211,10 +359,47 @@ func Test_IDPPrepares(t *testing.T) {
 						` projections.idps2_jwt_config.issuer,`+
 						` projections.idps2_jwt_config.keys_endpoint,`+
 						` projections.idps2_jwt_config.header_name,`+
-						` projections.idps2_jwt_config.endpoint`+
+						` projections.idps2_jwt_config.endpoint,`+
+						` projections.idps2_saml_config.idp_id,`+
+						` projections.idps2_saml_config.metadata,`+
+						` projections.idps2_saml_config.metadata_url,`+
+						` projections.idps2_saml_config.key,`+
+						` projections.idps2_saml_config.certificate,`+
+						` projections.idps2_saml_config.with_signed_request,`+
+						` projections.idps2_ldap_config.idp_id,`+
+						` projections.idps2_ldap_config.url,`+
+						` projections.idps2_ldap_config.start_tls,`+
+						` projections.idps2_ldap_config.base_dn,`+
+						` projections.idps2_ldap_config.bind_dn,`+
+						` projections.idps2_ldap_config.bind_password,`+
+						` projections.idps2_ldap_config.user_filter,`+
+						` projections.idps2_ldap_config.id_attribute,`+
+						` projections.idps2_ldap_config.preferred_username_attribute,`+
+						` projections.idps2_ldap_config.first_name_attribute,`+
+						` projections.idps2_ldap_config.last_name_attribute,`+
+						` projections.idps2_ldap_config.display_name_attribute,`+
+						` projections.idps2_ldap_config.email_attribute,`+
+						` projections.idps2_ldap_config.phone_attribute,`+
+						` projections.idps2_oauth2_config.idp_id,`+
+						` projections.idps2_oauth2_config.client_id,`+
+						` projections.idps2_oauth2_config.client_secret,`+
+						` projections.idps2_oauth2_config.authorization_endpoint,`+
+						` projections.idps2_oauth2_config.token_endpoint,`+
+						` projections.idps2_oauth2_config.user_endpoint,`+
+						` projections.idps2_oauth2_config.scopes,`+
+						` projections.idps2_oauth2_config.id_path,`+
+						` projections.idps2_oauth2_config.preferred_username_path,`+
+						` projections.idps2_oauth2_config.first_name_path,`+
+						` projections.idps2_oauth2_config.last_name_path,`+
+						` projections.idps2_oauth2_config.display_name_path,`+
+						` projections.idps2_oauth2_config.email_path,`+
+						` projections.idps2_oauth2_config.phone_path`+
 						` FROM projections.idps2`+
 						` LEFT JOIN projections.idps2_oidc_config ON projections.idps2.id = projections.idps2_oidc_config.idp_id`+
-						` LEFT JOIN projections.idps2_jwt_config ON projections.idps2.id = projections.idps2_jwt_config.idp_id`),
+						` LEFT JOIN projections.idps2_jwt_config ON projections.idps2.id = projections.idps2_jwt_config.idp_id`+
+						` LEFT JOIN projections.idps2_saml_config ON projections.idps2.id = projections.idps2_saml_config.idp_id`+
+						` LEFT JOIN projections.idps2_ldap_config ON projections.idps2.id = projections.idps2_ldap_config.idp_id`+
+						` LEFT JOIN projections.idps2_oauth2_config ON projections.idps2.id = projections.idps2_oauth2_config.idp_id`),
 					[]string{
 						"id",
 						"resource_owner",
@@ -242,6 +427,43 @@ func Test_IDPPrepares(t *testing.T) {
 						"keys_endpoint",
 						"header_name",
 						"endpoint",
+						// saml config
+						"idp_id",
+						"metadata",
+						"metadata_url",
+						"key",
+						"certificate",
+						"with_signed_request",
+						// ldap config
+						"idp_id",
+						"url",
+						"start_tls",
+						"base_dn",
+						"bind_dn",
+						"bind_password",
+						"user_filter",
+						"id_attribute",
+						"preferred_username_attribute",
+						"first_name_attribute",
+						"last_name_attribute",
+						"display_name_attribute",
+						"email_attribute",
+						"phone_attribute",
+						// oauth2 config
+						"idp_id",
+						"client_id",
+						"client_secret",
+						"authorization_endpoint",
+						"token_endpoint",
+						"user_endpoint",
+						"scopes",
+						"id_path",
+						"preferred_username_path",
+						"first_name_path",
+						"last_name_path",
+						"display_name_path",
+						"email_path",
+						"phone_path",
 					},
 					[]driver.Value{
 						"idp-id",
@@ -270,6 +492,43 @@ func Test_IDPPrepares(t *testing.T) {
 						"key.ch",
 						"x-header-name",
 						"jwt.endpoint.ch",
+						// saml config
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// ldap config
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// oauth2 config
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 					},
 				),
 			},
@@ -294,7 +553,229 @@ func Test_IDPPrepares(t *testing.T) {
 			},
 		},
 		{
-			name:    "prepareIDPByIDQuery no config",
+			name:    "prepareIDPByIDQuery saml config",
+			prepare: prepareIDPByIDQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(`SELECT projections.idps2.id,`+
+						` projections.idps2.resource_owner,`+
+						` projections.idps2.creation_date,`+
+						` projections.idps2.change_date,`+
+						` projections.idps2.sequence,`+
+						` projections.idps2.state,`+
+						` projections.idps2.name,`+
+						` projections.idps2.styling_type,`+
+						` projections.idps2.owner_type,`+
+						` projections.idps2.auto_register,`+
+						` projections.idps2_oidc_config.idp_id,`+
+						` projections.idps2_oidc_config.client_id,`+
+						` projections.idps2_oidc_config.client_secret,`+
+						` projections.idps2_oidc_config.issuer,`+
+						` projections.idps2_oidc_config.scopes,`+
+						` projections.idps2_oidc_config.display_name_mapping,`+
+						` projections.idps2_oidc_config.username_mapping,`+
+						` projections.idps2_oidc_config.authorization_endpoint,`+
+						` projections.idps2_oidc_config.token_endpoint,`+
+						` projections.idps2_jwt_config.idp_id,`+
+						` projections.idps2_jwt_config.issuer,`+
+						` projections.idps2_jwt_config.keys_endpoint,`+
+						` projections.idps2_jwt_config.header_name,`+
+						` projections.idps2_jwt_config.endpoint,`+
+						` projections.idps2_saml_config.idp_id,`+
+						` projections.idps2_saml_config.metadata,`+
+						` projections.idps2_saml_config.metadata_url,`+
+						` projections.idps2_saml_config.key,`+
+						` projections.idps2_saml_config.certificate,`+
+						` projections.idps2_saml_config.with_signed_request,`+
+						` projections.idps2_ldap_config.idp_id,`+
+						` projections.idps2_ldap_config.url,`+
+						` projections.idps2_ldap_config.start_tls,`+
+						` projections.idps2_ldap_config.base_dn,`+
+						` projections.idps2_ldap_config.bind_dn,`+
+						` projections.idps2_ldap_config.bind_password,`+
+						` projections.idps2_ldap_config.user_filter,`+
+						` projections.idps2_ldap_config.id_attribute,`+
+						` projections.idps2_ldap_config.preferred_username_attribute,`+
+						` projections.idps2_ldap_config.first_name_attribute,`+
+						` projections.idps2_ldap_config.last_name_attribute,`+
+						` projections.idps2_ldap_config.display_name_attribute,`+
+						` projections.idps2_ldap_config.email_attribute,`+
+						` projections.idps2_ldap_config.phone_attribute,`+
+						` projections.idps2_oauth2_config.idp_id,`+
+						` projections.idps2_oauth2_config.client_id,`+
+						` projections.idps2_oauth2_config.client_secret,`+
+						` projections.idps2_oauth2_config.authorization_endpoint,`+
+						` projections.idps2_oauth2_config.token_endpoint,`+
+						` projections.idps2_oauth2_config.user_endpoint,`+
+						` projections.idps2_oauth2_config.scopes,`+
+						` projections.idps2_oauth2_config.id_path,`+
+						` projections.idps2_oauth2_config.preferred_username_path,`+
+						` projections.idps2_oauth2_config.first_name_path,`+
+						` projections.idps2_oauth2_config.last_name_path,`+
+						` projections.idps2_oauth2_config.display_name_path,`+
+						` projections.idps2_oauth2_config.email_path,`+
+						` projections.idps2_oauth2_config.phone_path`+
+						` FROM projections.idps2`+
+						` LEFT JOIN projections.idps2_oidc_config ON projections.idps2.id = projections.idps2_oidc_config.idp_id`+
+						` LEFT JOIN projections.idps2_jwt_config ON projections.idps2.id = projections.idps2_jwt_config.idp_id`+
+						` LEFT JOIN projections.idps2_saml_config ON projections.idps2.id = projections.idps2_saml_config.idp_id`+
+						` LEFT JOIN projections.idps2_ldap_config ON projections.idps2.id = projections.idps2_ldap_config.idp_id`+
+						` LEFT JOIN projections.idps2_oauth2_config ON projections.idps2.id = projections.idps2_oauth2_config.idp_id`),
+					[]string{
+						"id",
+						"resource_owner",
+						"creation_date",
+						"change_date",
+						"sequence",
+						"state",
+						"name",
+						"styling_type",
+						"owner_type",
+						"auto_register",
+						// oidc config
+						"idp_id",
+						"client_id",
+						"client_secret",
+						"issuer",
+						"scopes",
+						"display_name_mapping",
+						"username_mapping",
+						"authorization_endpoint",
+						"token_endpoint",
+						// jwt config
+						"idp_id",
+						"issuer",
+						"keys_endpoint",
+						"header_name",
+						"endpoint",
+						// saml config
+						"idp_id",
+						"metadata",
+						"metadata_url",
+						"key",
+						"certificate",
+						"with_signed_request",
+						// ldap config
+						"idp_id",
+						"url",
+						"start_tls",
+						"base_dn",
+						"bind_dn",
+						"bind_password",
+						"user_filter",
+						"id_attribute",
+						"preferred_username_attribute",
+						"first_name_attribute",
+						"last_name_attribute",
+						"display_name_attribute",
+						"email_attribute",
+						"phone_attribute",
+						// oauth2 config
+						"idp_id",
+						"client_id",
+						"client_secret",
+						"authorization_endpoint",
+						"token_endpoint",
+						"user_endpoint",
+						"scopes",
+						"id_path",
+						"preferred_username_path",
+						"first_name_path",
+						"last_name_path",
+						"display_name_path",
+						"email_path",
+						"phone_path",
+					},
+					[]driver.Value{
+						"idp-id",
+						"ro",
+						testNow,
+						testNow,
+						uint64(20211109),
+						domain.IDPConfigStateActive,
+						"idp-name",
+						domain.IDPConfigStylingTypeGoogle,
+						domain.IdentityProviderTypeOrg,
+						true,
+						// oidc config
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// jwt config
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// saml config
+						"idp-id",
+						[]byte("metadata"),
+						"https://idp.zitadel.ch/saml/metadata",
+						nil,
+						[]byte("certificate"),
+						true,
+						// ldap config
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// oauth2 config
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+					},
+				),
+			},
+			object: &IDP{
+				CreationDate:  testNow,
+				ChangeDate:    testNow,
+				Sequence:      20211109,
+				ResourceOwner: "ro",
+				ID:            "idp-id",
+				State:         domain.IDPConfigStateActive,
+				Name:          "idp-name",
+				StylingType:   domain.IDPConfigStylingTypeGoogle,
+				OwnerType:     domain.IdentityProviderTypeOrg,
+				AutoRegister:  true,
+				SAMLIDP: &SAMLIDP{
+					IDPID:             "idp-id",
+					Metadata:          []byte("metadata"),
+					MetadataURL:       "https://idp.zitadel.ch/saml/metadata",
+					Key:               &crypto.CryptoValue{},
+					Certificate:       []byte("certificate"),
+					WithSignedRequest: true,
+				},
+			},
+		},
+		{
+			name:    "prepareIDPByIDQuery ldap config",
 			prepare: prepareIDPByIDQuery,
 			want: want{
 				sqlExpectations: mockQuery(
@@ -321,10 +802,47 @@ func Test_IDPPrepares(t *testing.T) {
 						` projections.idps2_jwt_config.issuer,`+
 						` projections.idps2_jwt_config.keys_endpoint,`+
 						` projections.idps2_jwt_config.header_name,`+
-						` projections.idps2_jwt_config.endpoint`+
+						` projections.idps2_jwt_config.endpoint,`+
+						` projections.idps2_saml_config.idp_id,`+
+						` projections.idps2_saml_config.metadata,`+
+						` projections.idps2_saml_config.metadata_url,`+
+						` projections.idps2_saml_config.key,`+
+						` projections.idps2_saml_config.certificate,`+
+						` projections.idps2_saml_config.with_signed_request,`+
+						` projections.idps2_ldap_config.idp_id,`+
+						` projections.idps2_ldap_config.url,`+
+						` projections.idps2_ldap_config.start_tls,`+
+						` projections.idps2_ldap_config.base_dn,`+
+						` projections.idps2_ldap_config.bind_dn,`+
+						` projections.idps2_ldap_config.bind_password,`+
+						` projections.idps2_ldap_config.user_filter,`+
+						` projections.idps2_ldap_config.id_attribute,`+
+						` projections.idps2_ldap_config.preferred_username_attribute,`+
+						` projections.idps2_ldap_config.first_name_attribute,`+
+						` projections.idps2_ldap_config.last_name_attribute,`+
+						` projections.idps2_ldap_config.display_name_attribute,`+
+						` projections.idps2_ldap_config.email_attribute,`+
+						` projections.idps2_ldap_config.phone_attribute,`+
+						` projections.idps2_oauth2_config.idp_id,`+
+						` projections.idps2_oauth2_config.client_id,`+
+						` projections.idps2_oauth2_config.client_secret,`+
+						` projections.idps2_oauth2_config.authorization_endpoint,`+
+						` projections.idps2_oauth2_config.token_endpoint,`+
+						` projections.idps2_oauth2_config.user_endpoint,`+
+						` projections.idps2_oauth2_config.scopes,`+
+						` projections.idps2_oauth2_config.id_path,`+
+						` projections.idps2_oauth2_config.preferred_username_path,`+
+						` projections.idps2_oauth2_config.first_name_path,`+
+						` projections.idps2_oauth2_config.last_name_path,`+
+						` projections.idps2_oauth2_config.display_name_path,`+
+						` projections.idps2_oauth2_config.email_path,`+
+						` projections.idps2_oauth2_config.phone_path`+
 						` FROM projections.idps2`+
 						` LEFT JOIN projections.idps2_oidc_config ON projections.idps2.id = projections.idps2_oidc_config.idp_id`+
-						` LEFT JOIN projections.idps2_jwt_config ON projections.idps2.id = projections.idps2_jwt_config.idp_id`),
+						` LEFT JOIN projections.idps2_jwt_config ON projections.idps2.id = projections.idps2_jwt_config.idp_id`+
+						` LEFT JOIN projections.idps2_saml_config ON projections.idps2.id = projections.idps2_saml_config.idp_id`+
+						` LEFT JOIN projections.idps2_ldap_config ON projections.idps2.id = projections.idps2_ldap_config.idp_id`+
+						` LEFT JOIN projections.idps2_oauth2_config ON projections.idps2.id = projections.idps2_oauth2_config.idp_id`),
 					[]string{
 						"id",
 						"resource_owner",
@@ -352,6 +870,43 @@ func Test_IDPPrepares(t *testing.T) {
 						"keys_endpoint",
 						"header_name",
 						"endpoint",
+						// saml config
+						"idp_id",
+						"metadata",
+						"metadata_url",
+						"key",
+						"certificate",
+						"with_signed_request",
+						// ldap config
+						"idp_id",
+						"url",
+						"start_tls",
+						"base_dn",
+						"bind_dn",
+						"bind_password",
+						"user_filter",
+						"id_attribute",
+						"preferred_username_attribute",
+						"first_name_attribute",
+						"last_name_attribute",
+						"display_name_attribute",
+						"email_attribute",
+						"phone_attribute",
+						// oauth2 config
+						"idp_id",
+						"client_id",
+						"client_secret",
+						"authorization_endpoint",
+						"token_endpoint",
+						"user_endpoint",
+						"scopes",
+						"id_path",
+						"preferred_username_path",
+						"first_name_path",
+						"last_name_path",
+						"display_name_path",
+						"email_path",
+						"phone_path",
 					},
 					[]driver.Value{
 						"idp-id",
@@ -374,7 +929,501 @@ func Test_IDPPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
-						// jwt config
+						// jwt config
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// saml config
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// ldap config
+						"idp-id",
+						"ldaps://ldap.zitadel.ch",
+						true,
+						"dc=zitadel,dc=ch",
+						"cn=admin,dc=zitadel,dc=ch",
+						nil,
+						"(uid=%s)",
+						"uid",
+						"uid",
+						"givenName",
+						"sn",
+						"cn",
+						"mail",
+						"telephoneNumber",
+						// oauth2 config
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+					},
+				),
+			},
+			object: &IDP{
+				CreationDate:  testNow,
+				ChangeDate:    testNow,
+				Sequence:      20211109,
+				ResourceOwner: "ro",
+				ID:            "idp-id",
+				State:         domain.IDPConfigStateActive,
+				Name:          "idp-name",
+				StylingType:   domain.IDPConfigStylingTypeGoogle,
+				OwnerType:     domain.IdentityProviderTypeOrg,
+				AutoRegister:  true,
+				LDAPIDP: &LDAPIDP{
+					IDPID:                      "idp-id",
+					URL:                        "ldaps://ldap.zitadel.ch",
+					StartTLS:                   true,
+					BaseDN:                     "dc=zitadel,dc=ch",
+					BindDN:                     "cn=admin,dc=zitadel,dc=ch",
+					BindPassword:               &crypto.CryptoValue{},
+					UserFilter:                 "(uid=%s)",
+					IDAttribute:                "uid",
+					PreferredUsernameAttribute: "uid",
+					FirstNameAttribute:         "givenName",
+					LastNameAttribute:          "sn",
+					DisplayNameAttribute:       "cn",
+					EmailAttribute:             "mail",
+					PhoneAttribute:             "telephoneNumber",
+				},
+			},
+		},
+		{
+			name:    "prepareIDPByIDQuery oauth2 config",
+			prepare: prepareIDPByIDQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(`SELECT projections.idps2.id,`+
+						` projections.idps2.resource_owner,`+
+						` projections.idps2.creation_date,`+
+						` projections.idps2.change_date,`+
+						` projections.idps2.sequence,`+
+						` projections.idps2.state,`+
+						` projections.idps2.name,`+
+						` projections.idps2.styling_type,`+
+						` projections.idps2.owner_type,`+
+						` projections.idps2.auto_register,`+
+						` projections.idps2_oidc_config.idp_id,`+
+						` projections.idps2_oidc_config.client_id,`+
+						` projections.idps2_oidc_config.client_secret,`+
+						` projections.idps2_oidc_config.issuer,`+
+						` projections.idps2_oidc_config.scopes,`+
+						` projections.idps2_oidc_config.display_name_mapping,`+
+						` projections.idps2_oidc_config.username_mapping,`+
+						` projections.idps2_oidc_config.authorization_endpoint,`+
+						` projections.idps2_oidc_config.token_endpoint,`+
+						` projections.idps2_jwt_config.idp_id,`+
+						` projections.idps2_jwt_config.issuer,`+
+						` projections.idps2_jwt_config.keys_endpoint,`+
+						` projections.idps2_jwt_config.header_name,`+
+						` projections.idps2_jwt_config.endpoint,`+
+						` projections.idps2_saml_config.idp_id,`+
+						` projections.idps2_saml_config.metadata,`+
+						` projections.idps2_saml_config.metadata_url,`+
+						` projections.idps2_saml_config.key,`+
+						` projections.idps2_saml_config.certificate,`+
+						` projections.idps2_saml_config.with_signed_request,`+
+						` projections.idps2_ldap_config.idp_id,`+
+						` projections.idps2_ldap_config.url,`+
+						` projections.idps2_ldap_config.start_tls,`+
+						` projections.idps2_ldap_config.base_dn,`+
+						` projections.idps2_ldap_config.bind_dn,`+
+						` projections.idps2_ldap_config.bind_password,`+
+						` projections.idps2_ldap_config.user_filter,`+
+						` projections.idps2_ldap_config.id_attribute,`+
+						` projections.idps2_ldap_config.preferred_username_attribute,`+
+						` projections.idps2_ldap_config.first_name_attribute,`+
+						` projections.idps2_ldap_config.last_name_attribute,`+
+						` projections.idps2_ldap_config.display_name_attribute,`+
+						` projections.idps2_ldap_config.email_attribute,`+
+						` projections.idps2_ldap_config.phone_attribute,`+
+						` projections.idps2_oauth2_config.idp_id,`+
+						` projections.idps2_oauth2_config.client_id,`+
+						` projections.idps2_oauth2_config.client_secret,`+
+						` projections.idps2_oauth2_config.authorization_endpoint,`+
+						` projections.idps2_oauth2_config.token_endpoint,`+
+						` projections.idps2_oauth2_config.user_endpoint,`+
+						` projections.idps2_oauth2_config.scopes,`+
+						` projections.idps2_oauth2_config.id_path,`+
+						` projections.idps2_oauth2_config.preferred_username_path,`+
+						` projections.idps2_oauth2_config.first_name_path,`+
+						` projections.idps2_oauth2_config.last_name_path,`+
+						` projections.idps2_oauth2_config.display_name_path,`+
+						` projections.idps2_oauth2_config.email_path,`+
+						` projections.idps2_oauth2_config.phone_path`+
+						` FROM projections.idps2`+
+						` LEFT JOIN projections.idps2_oidc_config ON projections.idps2.id = projections.idps2_oidc_config.idp_id`+
+						` LEFT JOIN projections.idps2_jwt_config ON projections.idps2.id = projections.idps2_jwt_config.idp_id`+
+						` LEFT JOIN projections.idps2_saml_config ON projections.idps2.id = projections.idps2_saml_config.idp_id`+
+						` LEFT JOIN projections.idps2_ldap_config ON projections.idps2.id = projections.idps2_ldap_config.idp_id`+
+						` LEFT JOIN projections.idps2_oauth2_config ON projections.idps2.id = projections.idps2_oauth2_config.idp_id`),
+					[]string{
+						"id",
+						"resource_owner",
+						"creation_date",
+						"change_date",
+						"sequence",
+						"state",
+						"name",
+						"styling_type",
+						"owner_type",
+						"auto_register",
+						// oidc config
+						"idp_id",
+						"client_id",
+						"client_secret",
+						"issuer",
+						"scopes",
+						"display_name_mapping",
+						"username_mapping",
+						"authorization_endpoint",
+						"token_endpoint",
+						// jwt config
+						"idp_id",
+						"issuer",
+						"keys_endpoint",
+						"header_name",
+						"endpoint",
+						// saml config
+						"idp_id",
+						"metadata",
+						"metadata_url",
+						"key",
+						"certificate",
+						"with_signed_request",
+						// ldap config
+						"idp_id",
+						"url",
+						"start_tls",
+						"base_dn",
+						"bind_dn",
+						"bind_password",
+						"user_filter",
+						"id_attribute",
+						"preferred_username_attribute",
+						"first_name_attribute",
+						"last_name_attribute",
+						"display_name_attribute",
+						"email_attribute",
+						"phone_attribute",
+						// oauth2 config
+						"idp_id",
+						"client_id",
+						"client_secret",
+						"authorization_endpoint",
+						"token_endpoint",
+						"user_endpoint",
+						"scopes",
+						"id_path",
+						"preferred_username_path",
+						"first_name_path",
+						"last_name_path",
+						"display_name_path",
+						"email_path",
+						"phone_path",
+					},
+					[]driver.Value{
+						"idp-id",
+						"ro",
+						testNow,
+						testNow,
+						uint64(20211109),
+						domain.IDPConfigStateActive,
+						"idp-name",
+						domain.IDPConfigStylingTypeGoogle,
+						domain.IdentityProviderTypeOrg,
+						true,
+						// oidc config
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// jwt config
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// saml config
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// ldap config
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// oauth2 config
+						"idp-id",
+						"client-id",
+						nil,
+						"https://github.com/login/oauth/authorize",
+						"https://github.com/login/oauth/access_token",
+						"https://api.github.com/user",
+						database.StringArray{"read:user"},
+						"id",
+						"login",
+						nil,
+						nil,
+						"name",
+						"email",
+						nil,
+					},
+				),
+			},
+			object: &IDP{
+				CreationDate:  testNow,
+				ChangeDate:    testNow,
+				Sequence:      20211109,
+				ResourceOwner: "ro",
+				ID:            "idp-id",
+				State:         domain.IDPConfigStateActive,
+				Name:          "idp-name",
+				StylingType:   domain.IDPConfigStylingTypeGoogle,
+				OwnerType:     domain.IdentityProviderTypeOrg,
+				AutoRegister:  true,
+				OAuth2IDP: &OAuth2IDP{
+					IDPID:                 "idp-id",
+					ClientID:              "client-id",
+					ClientSecret:          &crypto.CryptoValue{},
+					AuthorizationEndpoint: "https://github.com/login/oauth/authorize",
+					TokenEndpoint:         "https://github.com/login/oauth/access_token",
+					UserEndpoint:          "https://api.github.com/user",
+					Scopes:                database.StringArray{"read:user"},
+					IDPath:                "id",
+					PreferredUsernamePath: "login",
+					DisplayNamePath:       "name",
+					EmailPath:             "email",
+				},
+			},
+		},
+		{
+			name:    "prepareIDPByIDQuery no config",
+			prepare: prepareIDPByIDQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(`SELECT projections.idps2.id,`+
+						` projections.idps2.resource_owner,`+
+						` projections.idps2.creation_date,`+
+						` projections.idps2.change_date,`+
+						` projections.idps2.sequence,`+
+						` projections.idps2.state,`+
+						` projections.idps2.name,`+
+						` projections.idps2.styling_type,`+
+						` projections.idps2.owner_type,`+
+						` projections.idps2.auto_register,`+
+						` projections.idps2_oidc_config.idp_id,`+
+						` projections.idps2_oidc_config.client_id,`+
+						` projections.idps2_oidc_config.client_secret,`+
+						` projections.idps2_oidc_config.issuer,`+
+						` projections.idps2_oidc_config.scopes,`+
+						` projections.idps2_oidc_config.display_name_mapping,`+
+						` projections.idps2_oidc_config.username_mapping,`+
+						` projections.idps2_oidc_config.authorization_endpoint,`+
+						` projections.idps2_oidc_config.token_endpoint,`+
+						` projections.idps2_jwt_config.idp_id,`+
+						` projections.idps2_jwt_config.issuer,`+
+						` projections.idps2_jwt_config.keys_endpoint,`+
+						` projections.idps2_jwt_config.header_name,`+
+						` projections.idps2_jwt_config.endpoint,`+
+						` projections.idps2_saml_config.idp_id,`+
+						` projections.idps2_saml_config.metadata,`+
+						` projections.idps2_saml_config.metadata_url,`+
+						` projections.idps2_saml_config.key,`+
+						` projections.idps2_saml_config.certificate,`+
+						` projections.idps2_saml_config.with_signed_request,`+
+						` projections.idps2_ldap_config.idp_id,`+
+						` projections.idps2_ldap_config.url,`+
+						` projections.idps2_ldap_config.start_tls,`+
+						` projections.idps2_ldap_config.base_dn,`+
+						` projections.idps2_ldap_config.bind_dn,`+
+						` projections.idps2_ldap_config.bind_password,`+
+						` projections.idps2_ldap_config.user_filter,`+
+						` projections.idps2_ldap_config.id_attribute,`+
+						` projections.idps2_ldap_config.preferred_username_attribute,`+
+						` projections.idps2_ldap_config.first_name_attribute,`+
+						` projections.idps2_ldap_config.last_name_attribute,`+
+						` projections.idps2_ldap_config.display_name_attribute,`+
+						` projections.idps2_ldap_config.email_attribute,`+
+						` projections.idps2_ldap_config.phone_attribute,`+
+						` projections.idps2_oauth2_config.idp_id,`+
+						` projections.idps2_oauth2_config.client_id,`+
+						` projections.idps2_oauth2_config.client_secret,`+
+						` projections.idps2_oauth2_config.authorization_endpoint,`+
+						` projections.idps2_oauth2_config.token_endpoint,`+
+						` projections.idps2_oauth2_config.user_endpoint,`+
+						` projections.idps2_oauth2_config.scopes,`+
+						` projections.idps2_oauth2_config.id_path,`+
+						` projections.idps2_oauth2_config.preferred_username_path,`+
+						` projections.idps2_oauth2_config.first_name_path,`+
+						` projections.idps2_oauth2_config.last_name_path,`+
+						` projections.idps2_oauth2_config.display_name_path,`+
+						` projections.idps2_oauth2_config.email_path,`+
+						` projections.idps2_oauth2_config.phone_path`+
+						` FROM projections.idps2`+
+						` LEFT JOIN projections.idps2_oidc_config ON projections.idps2.id = projections.idps2_oidc_config.idp_id`+
+						` LEFT JOIN projections.idps2_jwt_config ON projections.idps2.id = projections.idps2_jwt_config.idp_id`+
+						` LEFT JOIN projections.idps2_saml_config ON projections.idps2.id = projections.idps2_saml_config.idp_id`+
+						` LEFT JOIN projections.idps2_ldap_config ON projections.idps2.id = projections.idps2_ldap_config.idp_id`+
+						` LEFT JOIN projections.idps2_oauth2_config ON projections.idps2.id = projections.idps2_oauth2_config.idp_id`),
+					[]string{
+						"id",
+						"resource_owner",
+						"creation_date",
+						"change_date",
+						"sequence",
+						"state",
+						"name",
+						"styling_type",
+						"owner_type",
+						"auto_register",
+						// oidc config
+						"idp_id",
+						"client_id",
+						"client_secret",
+						"issuer",
+						"scopes",
+						"display_name_mapping",
+						"username_mapping",
+						"authorization_endpoint",
+						"token_endpoint",
+						// jwt config
+						"idp_id",
+						"issuer",
+						"keys_endpoint",
+						"header_name",
+						"endpoint",
+						// saml config
+						"idp_id",
+						"metadata",
+						"metadata_url",
+						"key",
+						"certificate",
+						"with_signed_request",
+						// ldap config
+						"idp_id",
+						"url",
+						"start_tls",
+						"base_dn",
+						"bind_dn",
+						"bind_password",
+						"user_filter",
+						"id_attribute",
+						"preferred_username_attribute",
+						"first_name_attribute",
+						"last_name_attribute",
+						"display_name_attribute",
+						"email_attribute",
+						"phone_attribute",
+						// oauth2 config
+						"idp_id",
+						"client_id",
+						"client_secret",
+						"authorization_endpoint",
+						"token_endpoint",
+						"user_endpoint",
+						"scopes",
+						"id_path",
+						"preferred_username_path",
+						"first_name_path",
+						"last_name_path",
+						"display_name_path",
+						"email_path",
+						"phone_path",
+					},
+					[]driver.Value{
+						"idp-id",
+						"ro",
+						testNow,
+						testNow,
+						uint64(20211109),
+						domain.IDPConfigStateActive,
+						"idp-name",
+						domain.IDPConfigStylingTypeGoogle,
+						domain.IdentityProviderTypeOrg,
+						true,
+						// oidc config
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// jwt config
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// saml config
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// ldap config
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// oauth2 config
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 						nil,
 						nil,
 						nil,
@@ -424,10 +1473,47 @@ func Test_IDPPrepares(t *testing.T) {
 						` projections.idps2_jwt_config.issuer,`+
 						` projections.idps2_jwt_config.keys_endpoint,`+
 						` projections.idps2_jwt_config.header_name,`+
-						` projections.idps2_jwt_config.endpoint`+
+						` projections.idps2_jwt_config.endpoint,`+
+						` projections.idps2_saml_config.idp_id,`+
+						` projections.idps2_saml_config.metadata,`+
+						` projections.idps2_saml_config.metadata_url,`+
+						` projections.idps2_saml_config.key,`+
+						` projections.idps2_saml_config.certificate,`+
+						` projections.idps2_saml_config.with_signed_request,`+
+						` projections.idps2_ldap_config.idp_id,`+
+						` projections.idps2_ldap_config.url,`+
+						` projections.idps2_ldap_config.start_tls,`+
+						` projections.idps2_ldap_config.base_dn,`+
+						` projections.idps2_ldap_config.bind_dn,`+
+						` projections.idps2_ldap_config.bind_password,`+
+						` projections.idps2_ldap_config.user_filter,`+
+						` projections.idps2_ldap_config.id_attribute,`+
+						` projections.idps2_ldap_config.preferred_username_attribute,`+
+						` projections.idps2_ldap_config.first_name_attribute,`+
+						` projections.idps2_ldap_config.last_name_attribute,`+
+						` projections.idps2_ldap_config.display_name_attribute,`+
+						` projections.idps2_ldap_config.email_attribute,`+
+						` projections.idps2_ldap_config.phone_attribute,`+
+						` projections.idps2_oauth2_config.idp_id,`+
+						` projections.idps2_oauth2_config.client_id,`+
+						` projections.idps2_oauth2_config.client_secret,`+
+						` projections.idps2_oauth2_config.authorization_endpoint,`+
+						` projections.idps2_oauth2_config.token_endpoint,`+
+						` projections.idps2_oauth2_config.user_endpoint,`+
+						` projections.idps2_oauth2_config.scopes,`+
+						` projections.idps2_oauth2_config.id_path,`+
+						` projections.idps2_oauth2_config.preferred_username_path,`+
+						` projections.idps2_oauth2_config.first_name_path,`+
+						` projections.idps2_oauth2_config.last_name_path,`+
+						` projections.idps2_oauth2_config.display_name_path,`+
+						` projections.idps2_oauth2_config.email_path,`+
+						` projections.idps2_oauth2_config.phone_path`+
 						` FROM projections.idps2`+
 						` LEFT JOIN projections.idps2_oidc_config ON projections.idps2.id = projections.idps2_oidc_config.idp_id`+
-						` LEFT JOIN projections.idps2_jwt_config ON projections.idps2.id = projections.idps2_jwt_config.idp_id`),
+						` LEFT JOIN projections.idps2_jwt_config ON projections.idps2.id = projections.idps2_jwt_config.idp_id`+
+						` LEFT JOIN projections.idps2_saml_config ON projections.idps2.id = projections.idps2_saml_config.idp_id`+
+						` LEFT JOIN projections.idps2_ldap_config ON projections.idps2.id = projections.idps2_ldap_config.idp_id`+
+						` LEFT JOIN projections.idps2_oauth2_config ON projections.idps2.id = projections.idps2_oauth2_config.idp_id`),
 					sql.ErrConnDone,
 				),
 				err: func(err error) (error, bool) {
@@ -468,10 +1554,47 @@ func Test_IDPPrepares(t *testing.T) {
 						` projections.idps2_jwt_config.keys_endpoint,`+
 						` projections.idps2_jwt_config.header_name,`+
 						` projections.idps2_jwt_config.endpoint,`+
+						` projections.idps2_saml_config.idp_id,`+
+						` projections.idps2_saml_config.metadata,`+
+						` projections.idps2_saml_config.metadata_url,`+
+						` projections.idps2_saml_config.key,`+
+						` projections.idps2_saml_config.certificate,`+
+						` projections.idps2_saml_config.with_signed_request,`+
+						` projections.idps2_ldap_config.idp_id,`+
+						` projections.idps2_ldap_config.url,`+
+						` projections.idps2_ldap_config.start_tls,`+
+						` projections.idps2_ldap_config.base_dn,`+
+						` projections.idps2_ldap_config.bind_dn,`+
+						` projections.idps2_ldap_config.bind_password,`+
+						` projections.idps2_ldap_config.user_filter,`+
+						` projections.idps2_ldap_config.id_attribute,`+
+						` projections.idps2_ldap_config.preferred_username_attribute,`+
+						` projections.idps2_ldap_config.first_name_attribute,`+
+						` projections.idps2_ldap_config.last_name_attribute,`+
+						` projections.idps2_ldap_config.display_name_attribute,`+
+						` projections.idps2_ldap_config.email_attribute,`+
+						` projections.idps2_ldap_config.phone_attribute,`+
+						` projections.idps2_oauth2_config.idp_id,`+
+						` projections.idps2_oauth2_config.client_id,`+
+						` projections.idps2_oauth2_config.client_secret,`+
+						` projections.idps2_oauth2_config.authorization_endpoint,`+
+						` projections.idps2_oauth2_config.token_endpoint,`+
+						` projections.idps2_oauth2_config.user_endpoint,`+
+						` projections.idps2_oauth2_config.scopes,`+
+						` projections.idps2_oauth2_config.id_path,`+
+						` projections.idps2_oauth2_config.preferred_username_path,`+
+						` projections.idps2_oauth2_config.first_name_path,`+
+						` projections.idps2_oauth2_config.last_name_path,`+
+						` projections.idps2_oauth2_config.display_name_path,`+
+						` projections.idps2_oauth2_config.email_path,`+
+						` projections.idps2_oauth2_config.phone_path,`+
 						` COUNT(*) OVER ()`+
 						` FROM projections.idps2`+
 						` LEFT JOIN projections.idps2_oidc_config ON projections.idps2.id = projections.idps2_oidc_config.idp_id`+
-						` LEFT JOIN projections.idps2_jwt_config ON projections.idps2.id = projections.idps2_jwt_config.idp_id`),
+						` LEFT JOIN projections.idps2_jwt_config ON projections.idps2.id = projections.idps2_jwt_config.idp_id`+
+						` LEFT JOIN projections.idps2_saml_config ON projections.idps2.id = projections.idps2_saml_config.idp_id`+
+						` LEFT JOIN projections.idps2_ldap_config ON projections.idps2.id = projections.idps2_ldap_config.idp_id`+
+						` LEFT JOIN projections.idps2_oauth2_config ON projections.idps2.id = projections.idps2_oauth2_config.idp_id`),
 					nil,
 					nil,
 				),
@@ -513,10 +1636,47 @@ func Test_IDPPrepares(t *testing.T) {
 						` projections.idps2_jwt_config.keys_endpoint,`+
 						` projections.idps2_jwt_config.header_name,`+
 						` projections.idps2_jwt_config.endpoint,`+
+						` projections.idps2_saml_config.idp_id,`+
+						` projections.idps2_saml_config.metadata,`+
+						` projections.idps2_saml_config.metadata_url,`+
+						` projections.idps2_saml_config.key,`+
+						` projections.idps2_saml_config.certificate,`+
+						` projections.idps2_saml_config.with_signed_request,`+
+						` projections.idps2_ldap_config.idp_id,`+
+						` projections.idps2_ldap_config.url,`+
+						` projections.idps2_ldap_config.start_tls,`+
+						` projections.idps2_ldap_config.base_dn,`+
+						` projections.idps2_ldap_config.bind_dn,`+
+						` projections.idps2_ldap_config.bind_password,`+
+						` projections.idps2_ldap_config.user_filter,`+
+						` projections.idps2_ldap_config.id_attribute,`+
+						` projections.idps2_ldap_config.preferred_username_attribute,`+
+						` projections.idps2_ldap_config.first_name_attribute,`+
+						` projections.idps2_ldap_config.last_name_attribute,`+
+						` projections.idps2_ldap_config.display_name_attribute,`+
+						` projections.idps2_ldap_config.email_attribute,`+
+						` projections.idps2_ldap_config.phone_attribute,`+
+						` projections.idps2_oauth2_config.idp_id,`+
+						` projections.idps2_oauth2_config.client_id,`+
+						` projections.idps2_oauth2_config.client_secret,`+
+						` projections.idps2_oauth2_config.authorization_endpoint,`+
+						` projections.idps2_oauth2_config.token_endpoint,`+
+						` projections.idps2_oauth2_config.user_endpoint,`+
+						` projections.idps2_oauth2_config.scopes,`+
+						` projections.idps2_oauth2_config.id_path,`+
+						` projections.idps2_oauth2_config.preferred_username_path,`+
+						` projections.idps2_oauth2_config.first_name_path,`+
+						` projections.idps2_oauth2_config.last_name_path,`+
+						` projections.idps2_oauth2_config.display_name_path,`+
+						` projections.idps2_oauth2_config.email_path,`+
+						` projections.idps2_oauth2_config.phone_path,`+
 						` COUNT(*) OVER ()`+
 						` FROM projections.idps2`+
 						` LEFT JOIN projections.idps2_oidc_config ON projections.idps2.id = projections.idps2_oidc_config.idp_id`+
-						` LEFT JOIN projections.idps2_jwt_config ON projections.idps2.id = projections.idps2_jwt_config.idp_id`),
+						` LEFT JOIN projections.idps2_jwt_config ON projections.idps2.id = projections.idps2_jwt_config.idp_id`+
+						` LEFT JOIN projections.idps2_saml_config ON projections.idps2.id = projections.idps2_saml_config.idp_id`+
+						` LEFT JOIN projections.idps2_ldap_config ON projections.idps2.id = projections.idps2_ldap_config.idp_id`+
+						` LEFT JOIN projections.idps2_oauth2_config ON projections.idps2.id = projections.idps2_oauth2_config.idp_id`),
 					[]string{
 						"id",
 						"resource_owner",
@@ -544,6 +1704,43 @@ func Test_IDPPrepares(t *testing.T) {
 						"keys_endpoint",
 						"header_name",
 						"endpoint",
+						// saml config
+						"idp_id",
+						"metadata",
+						"metadata_url",
+						"key",
+						"certificate",
+						"with_signed_request",
+						// ldap config
+						"idp_id",
+						"url",
+						"start_tls",
+						"base_dn",
+						"bind_dn",
+						"bind_password",
+						"user_filter",
+						"id_attribute",
+						"preferred_username_attribute",
+						"first_name_attribute",
+						"last_name_attribute",
+						"display_name_attribute",
+						"email_attribute",
+						"phone_attribute",
+						// oauth2 config
+						"idp_id",
+						"client_id",
+						"client_secret",
+						"authorization_endpoint",
+						"token_endpoint",
+						"user_endpoint",
+						"scopes",
+						"id_path",
+						"preferred_username_path",
+						"first_name_path",
+						"last_name_path",
+						"display_name_path",
+						"email_path",
+						"phone_path",
 						"count",
 					},
 					[][]driver.Value{
@@ -574,6 +1771,43 @@ func Test_IDPPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							// saml config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// ldap config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// oauth2 config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -638,10 +1872,47 @@ func Test_IDPPrepares(t *testing.T) {
 						` projections.idps2_jwt_config.keys_endpoint,`+
 						` projections.idps2_jwt_config.header_name,`+
 						` projections.idps2_jwt_config.endpoint,`+
+						` projections.idps2_saml_config.idp_id,`+
+						` projections.idps2_saml_config.metadata,`+
+						` projections.idps2_saml_config.metadata_url,`+
+						` projections.idps2_saml_config.key,`+
+						` projections.idps2_saml_config.certificate,`+
+						` projections.idps2_saml_config.with_signed_request,`+
+						` projections.idps2_ldap_config.idp_id,`+
+						` projections.idps2_ldap_config.url,`+
+						` projections.idps2_ldap_config.start_tls,`+
+						` projections.idps2_ldap_config.base_dn,`+
+						` projections.idps2_ldap_config.bind_dn,`+
+						` projections.idps2_ldap_config.bind_password,`+
+						` projections.idps2_ldap_config.user_filter,`+
+						` projections.idps2_ldap_config.id_attribute,`+
+						` projections.idps2_ldap_config.preferred_username_attribute,`+
+						` projections.idps2_ldap_config.first_name_attribute,`+
+						` projections.idps2_ldap_config.last_name_attribute,`+
+						` projections.idps2_ldap_config.display_name_attribute,`+
+						` projections.idps2_ldap_config.email_attribute,`+
+						` projections.idps2_ldap_config.phone_attribute,`+
+						` projections.idps2_oauth2_config.idp_id,`+
+						` projections.idps2_oauth2_config.client_id,`+
+						` projections.idps2_oauth2_config.client_secret,`+
+						` projections.idps2_oauth2_config.authorization_endpoint,`+
+						` projections.idps2_oauth2_config.token_endpoint,`+
+						` projections.idps2_oauth2_config.user_endpoint,`+
+						` projections.idps2_oauth2_config.scopes,`+
+						` projections.idps2_oauth2_config.id_path,`+
+						` projections.idps2_oauth2_config.preferred_username_path,`+
+						` projections.idps2_oauth2_config.first_name_path,`+
+						` projections.idps2_oauth2_config.last_name_path,`+
+						` projections.idps2_oauth2_config.display_name_path,`+
+						` projections.idps2_oauth2_config.email_path,`+
+						` projections.idps2_oauth2_config.phone_path,`+
 						` COUNT(*) OVER ()`+
 						` FROM projections.idps2`+
 						` LEFT JOIN projections.idps2_oidc_config ON projections.idps2.id = projections.idps2_oidc_config.idp_id`+
-						` LEFT JOIN projections.idps2_jwt_config ON projections.idps2.id = projections.idps2_jwt_config.idp_id`),
+						` LEFT JOIN projections.idps2_jwt_config ON projections.idps2.id = projections.idps2_jwt_config.idp_id`+
+						` LEFT JOIN projections.idps2_saml_config ON projections.idps2.id = projections.idps2_saml_config.idp_id`+
+						` LEFT JOIN projections.idps2_ldap_config ON projections.idps2.id = projections.idps2_ldap_config.idp_id`+
+						` LEFT JOIN projections.idps2_oauth2_config ON projections.idps2.id = projections.idps2_oauth2_config.idp_id`),
 					[]string{
 						"id",
 						"resource_owner",
@@ -668,6 +1939,43 @@ func Test_IDPPrepares(t *testing.T) {
 						"keys_endpoint",
 						"header_name",
 						"endpoint",
+						// saml config
+						"idp_id",
+						"metadata",
+						"metadata_url",
+						"key",
+						"certificate",
+						"with_signed_request",
+						// ldap config
+						"idp_id",
+						"url",
+						"start_tls",
+						"base_dn",
+						"bind_dn",
+						"bind_password",
+						"user_filter",
+						"id_attribute",
+						"preferred_username_attribute",
+						"first_name_attribute",
+						"last_name_attribute",
+						"display_name_attribute",
+						"email_attribute",
+						"phone_attribute",
+						// oauth2 config
+						"idp_id",
+						"client_id",
+						"client_secret",
+						"authorization_endpoint",
+						"token_endpoint",
+						"user_endpoint",
+						"scopes",
+						"id_path",
+						"preferred_username_path",
+						"first_name_path",
+						"last_name_path",
+						"display_name_path",
+						"email_path",
+						"phone_path",
 						"count",
 					},
 					[][]driver.Value{
@@ -698,6 +2006,43 @@ func Test_IDPPrepares(t *testing.T) {
 							"key.ch",
 							"x-header-name",
 							"jwt.endpoint.ch",
+							// saml config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// ldap config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// oauth2 config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -758,10 +2103,47 @@ func Test_IDPPrepares(t *testing.T) {
 						` projections.idps2_jwt_config.keys_endpoint,`+
 						` projections.idps2_jwt_config.header_name,`+
 						` projections.idps2_jwt_config.endpoint,`+
+						` projections.idps2_saml_config.idp_id,`+
+						` projections.idps2_saml_config.metadata,`+
+						` projections.idps2_saml_config.metadata_url,`+
+						` projections.idps2_saml_config.key,`+
+						` projections.idps2_saml_config.certificate,`+
+						` projections.idps2_saml_config.with_signed_request,`+
+						` projections.idps2_ldap_config.idp_id,`+
+						` projections.idps2_ldap_config.url,`+
+						` projections.idps2_ldap_config.start_tls,`+
+						` projections.idps2_ldap_config.base_dn,`+
+						` projections.idps2_ldap_config.bind_dn,`+
+						` projections.idps2_ldap_config.bind_password,`+
+						` projections.idps2_ldap_config.user_filter,`+
+						` projections.idps2_ldap_config.id_attribute,`+
+						` projections.idps2_ldap_config.preferred_username_attribute,`+
+						` projections.idps2_ldap_config.first_name_attribute,`+
+						` projections.idps2_ldap_config.last_name_attribute,`+
+						` projections.idps2_ldap_config.display_name_attribute,`+
+						` projections.idps2_ldap_config.email_attribute,`+
+						` projections.idps2_ldap_config.phone_attribute,`+
+						` projections.idps2_oauth2_config.idp_id,`+
+						` projections.idps2_oauth2_config.client_id,`+
+						` projections.idps2_oauth2_config.client_secret,`+
+						` projections.idps2_oauth2_config.authorization_endpoint,`+
+						` projections.idps2_oauth2_config.token_endpoint,`+
+						` projections.idps2_oauth2_config.user_endpoint,`+
+						` projections.idps2_oauth2_config.scopes,`+
+						` projections.idps2_oauth2_config.id_path,`+
+						` projections.idps2_oauth2_config.preferred_username_path,`+
+						` projections.idps2_oauth2_config.first_name_path,`+
+						` projections.idps2_oauth2_config.last_name_path,`+
+						` projections.idps2_oauth2_config.display_name_path,`+
+						` projections.idps2_oauth2_config.email_path,`+
+						` projections.idps2_oauth2_config.phone_path,`+
 						` COUNT(*) OVER ()`+
 						` FROM projections.idps2`+
 						` LEFT JOIN projections.idps2_oidc_config ON projections.idps2.id = projections.idps2_oidc_config.idp_id`+
-						` LEFT JOIN projections.idps2_jwt_config ON projections.idps2.id = projections.idps2_jwt_config.idp_id`),
+						` LEFT JOIN projections.idps2_jwt_config ON projections.idps2.id = projections.idps2_jwt_config.idp_id`+
+						` LEFT JOIN projections.idps2_saml_config ON projections.idps2.id = projections.idps2_saml_config.idp_id`+
+						` LEFT JOIN projections.idps2_ldap_config ON projections.idps2.id = projections.idps2_ldap_config.idp_id`+
+						` LEFT JOIN projections.idps2_oauth2_config ON projections.idps2.id = projections.idps2_oauth2_config.idp_id`),
 					[]string{
 						"id",
 						"resource_owner",
@@ -788,6 +2170,43 @@ func Test_IDPPrepares(t *testing.T) {
 						"keys_endpoint",
 						"header_name",
 						"endpoint",
+						// saml config
+						"idp_id",
+						"metadata",
+						"metadata_url",
+						"key",
+						"certificate",
+						"with_signed_request",
+						// ldap config
+						"idp_id",
+						"url",
+						"start_tls",
+						"base_dn",
+						"bind_dn",
+						"bind_password",
+						"user_filter",
+						"id_attribute",
+						"preferred_username_attribute",
+						"first_name_attribute",
+						"last_name_attribute",
+						"display_name_attribute",
+						"email_attribute",
+						"phone_attribute",
+						// oauth2 config
+						"idp_id",
+						"client_id",
+						"client_secret",
+						"authorization_endpoint",
+						"token_endpoint",
+						"user_endpoint",
+						"scopes",
+						"id_path",
+						"preferred_username_path",
+						"first_name_path",
+						"last_name_path",
+						"display_name_path",
+						"email_path",
+						"phone_path",
 						"count",
 					},
 					[][]driver.Value{
@@ -818,6 +2237,43 @@ func Test_IDPPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							// saml config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// ldap config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// oauth2 config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -871,10 +2327,47 @@ func Test_IDPPrepares(t *testing.T) {
 						` projections.idps2_jwt_config.keys_endpoint,`+
 						` projections.idps2_jwt_config.header_name,`+
 						` projections.idps2_jwt_config.endpoint,`+
+						` projections.idps2_saml_config.idp_id,`+
+						` projections.idps2_saml_config.metadata,`+
+						` projections.idps2_saml_config.metadata_url,`+
+						` projections.idps2_saml_config.key,`+
+						` projections.idps2_saml_config.certificate,`+
+						` projections.idps2_saml_config.with_signed_request,`+
+						` projections.idps2_ldap_config.idp_id,`+
+						` projections.idps2_ldap_config.url,`+
+						` projections.idps2_ldap_config.start_tls,`+
+						` projections.idps2_ldap_config.base_dn,`+
+						` projections.idps2_ldap_config.bind_dn,`+
+						` projections.idps2_ldap_config.bind_password,`+
+						` projections.idps2_ldap_config.user_filter,`+
+						` projections.idps2_ldap_config.id_attribute,`+
+						` projections.idps2_ldap_config.preferred_username_attribute,`+
+						` projections.idps2_ldap_config.first_name_attribute,`+
+						` projections.idps2_ldap_config.last_name_attribute,`+
+						` projections.idps2_ldap_config.display_name_attribute,`+
+						` projections.idps2_ldap_config.email_attribute,`+
+						` projections.idps2_ldap_config.phone_attribute,`+
+						` projections.idps2_oauth2_config.idp_id,`+
+						` projections.idps2_oauth2_config.client_id,`+
+						` projections.idps2_oauth2_config.client_secret,`+
+						` projections.idps2_oauth2_config.authorization_endpoint,`+
+						` projections.idps2_oauth2_config.token_endpoint,`+
+						` projections.idps2_oauth2_config.user_endpoint,`+
+						` projections.idps2_oauth2_config.scopes,`+
+						` projections.idps2_oauth2_config.id_path,`+
+						` projections.idps2_oauth2_config.preferred_username_path,`+
+						` projections.idps2_oauth2_config.first_name_path,`+
+						` projections.idps2_oauth2_config.last_name_path,`+
+						` projections.idps2_oauth2_config.display_name_path,`+
+						` projections.idps2_oauth2_config.email_path,`+
+						` projections.idps2_oauth2_config.phone_path,`+
 						` COUNT(*) OVER ()`+
 						` FROM projections.idps2`+
 						` LEFT JOIN projections.idps2_oidc_config ON projections.idps2.id = projections.idps2_oidc_config.idp_id`+
-						` LEFT JOIN projections.idps2_jwt_config ON projections.idps2.id = projections.idps2_jwt_config.idp_id`),
+						` LEFT JOIN projections.idps2_jwt_config ON projections.idps2.id = projections.idps2_jwt_config.idp_id`+
+						` LEFT JOIN projections.idps2_saml_config ON projections.idps2.id = projections.idps2_saml_config.idp_id`+
+						` LEFT JOIN projections.idps2_ldap_config ON projections.idps2.id = projections.idps2_ldap_config.idp_id`+
+						` LEFT JOIN projections.idps2_oauth2_config ON projections.idps2.id = projections.idps2_oauth2_config.idp_id`),
 					[]string{
 						"id",
 						"resource_owner",
@@ -901,6 +2394,43 @@ func Test_IDPPrepares(t *testing.T) {
 						"keys_endpoint",
 						"header_name",
 						"endpoint",
+						// saml config
+						"idp_id",
+						"metadata",
+						"metadata_url",
+						"key",
+						"certificate",
+						"with_signed_request",
+						// ldap config
+						"idp_id",
+						"url",
+						"start_tls",
+						"base_dn",
+						"bind_dn",
+						"bind_password",
+						"user_filter",
+						"id_attribute",
+						"preferred_username_attribute",
+						"first_name_attribute",
+						"last_name_attribute",
+						"display_name_attribute",
+						"email_attribute",
+						"phone_attribute",
+						// oauth2 config
+						"idp_id",
+						"client_id",
+						"client_secret",
+						"authorization_endpoint",
+						"token_endpoint",
+						"user_endpoint",
+						"scopes",
+						"id_path",
+						"preferred_username_path",
+						"first_name_path",
+						"last_name_path",
+						"display_name_path",
+						"email_path",
+						"phone_path",
 						"count",
 					},
 					[][]driver.Value{
@@ -931,6 +2461,43 @@ func Test_IDPPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							// saml config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// ldap config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// oauth2 config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 						{
 							"idp-id-2",
@@ -959,6 +2526,43 @@ func Test_IDPPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							// saml config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// ldap config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// oauth2 config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 						{
 							"idp-id-3",
@@ -987,6 +2591,43 @@ func Test_IDPPrepares(t *testing.T) {
 							"key.ch",
 							"x-header-name",
 							"jwt.endpoint.ch",
+							// saml config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// ldap config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// oauth2 config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -1082,10 +2723,47 @@ func Test_IDPPrepares(t *testing.T) {
 						` projections.idps2_jwt_config.keys_endpoint,`+
 						` projections.idps2_jwt_config.header_name,`+
 						` projections.idps2_jwt_config.endpoint,`+
+						` projections.idps2_saml_config.idp_id,`+
+						` projections.idps2_saml_config.metadata,`+
+						` projections.idps2_saml_config.metadata_url,`+
+						` projections.idps2_saml_config.key,`+
+						` projections.idps2_saml_config.certificate,`+
+						` projections.idps2_saml_config.with_signed_request,`+
+						` projections.idps2_ldap_config.idp_id,`+
+						` projections.idps2_ldap_config.url,`+
+						` projections.idps2_ldap_config.start_tls,`+
+						` projections.idps2_ldap_config.base_dn,`+
+						` projections.idps2_ldap_config.bind_dn,`+
+						` projections.idps2_ldap_config.bind_password,`+
+						` projections.idps2_ldap_config.user_filter,`+
+						` projections.idps2_ldap_config.id_attribute,`+
+						` projections.idps2_ldap_config.preferred_username_attribute,`+
+						` projections.idps2_ldap_config.first_name_attribute,`+
+						` projections.idps2_ldap_config.last_name_attribute,`+
+						` projections.idps2_ldap_config.display_name_attribute,`+
+						` projections.idps2_ldap_config.email_attribute,`+
+						` projections.idps2_ldap_config.phone_attribute,`+
+						` projections.idps2_oauth2_config.idp_id,`+
+						` projections.idps2_oauth2_config.client_id,`+
+						` projections.idps2_oauth2_config.client_secret,`+
+						` projections.idps2_oauth2_config.authorization_endpoint,`+
+						` projections.idps2_oauth2_config.token_endpoint,`+
+						` projections.idps2_oauth2_config.user_endpoint,`+
+						` projections.idps2_oauth2_config.scopes,`+
+						` projections.idps2_oauth2_config.id_path,`+
+						` projections.idps2_oauth2_config.preferred_username_path,`+
+						` projections.idps2_oauth2_config.first_name_path,`+
+						` projections.idps2_oauth2_config.last_name_path,`+
+						` projections.idps2_oauth2_config.display_name_path,`+
+						` projections.idps2_oauth2_config.email_path,`+
+						` projections.idps2_oauth2_config.phone_path,`+
 						` COUNT(*) OVER ()`+
 						` FROM projections.idps2`+
 						` LEFT JOIN projections.idps2_oidc_config ON projections.idps2.id = projections.idps2_oidc_config.idp_id`+
-						` LEFT JOIN projections.idps2_jwt_config ON projections.idps2.id = projections.idps2_jwt_config.idp_id`),
+						` LEFT JOIN projections.idps2_jwt_config ON projections.idps2.id = projections.idps2_jwt_config.idp_id`+
+						` LEFT JOIN projections.idps2_saml_config ON projections.idps2.id = projections.idps2_saml_config.idp_id`+
+						` LEFT JOIN projections.idps2_ldap_config ON projections.idps2.id = projections.idps2_ldap_config.idp_id`+
+						` LEFT JOIN projections.idps2_oauth2_config ON projections.idps2.id = projections.idps2_oauth2_config.idp_id`),
 					sql.ErrConnDone,
 				),
 				err: func(err error) (error, bool) {
@@ -0,0 +1,113 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	errs "errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/dennigogo/zitadel/internal/api/authz"
+	"github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/query/projection"
+)
+
+type KeyValue struct {
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	ResourceOwner string
+	Sequence      uint64
+	Key           string
+	Value         []byte
+}
+
+var (
+	keyValueTable = table{
+		name: projection.KeyValueProjectionTable,
+	}
+	KeyValueOrgIDCol = Column{
+		name:  projection.KeyValueColumnOrgID,
+		table: keyValueTable,
+	}
+	KeyValueCreationDateCol = Column{
+		name:  projection.KeyValueColumnCreationDate,
+		table: keyValueTable,
+	}
+	KeyValueChangeDateCol = Column{
+		name:  projection.KeyValueColumnChangeDate,
+		table: keyValueTable,
+	}
+	KeyValueResourceOwnerCol = Column{
+		name:  projection.KeyValueColumnResourceOwner,
+		table: keyValueTable,
+	}
+	KeyValueInstanceIDCol = Column{
+		name:  projection.KeyValueColumnInstanceID,
+		table: keyValueTable,
+	}
+	KeyValueSequenceCol = Column{
+		name:  projection.KeyValueColumnSequence,
+		table: keyValueTable,
+	}
+	KeyValueKeyCol = Column{
+		name:  projection.KeyValueColumnKey,
+		table: keyValueTable,
+	}
+	KeyValueValueCol = Column{
+		name:  projection.KeyValueColumnValue,
+		table: keyValueTable,
+	}
+)
+
+func (q *Queries) GetKeyValue(ctx context.Context, shouldTriggerBulk bool, orgID, key string) (*KeyValue, error) {
+	if shouldTriggerBulk {
+		projection.KeyValueProjection.Trigger(ctx)
+	}
+
+	query, scan := prepareKeyValueQuery()
+	stmt, args, err := query.Where(
+		sq.Eq{
+			KeyValueOrgIDCol.identifier():      orgID,
+			KeyValueKeyCol.identifier():        key,
+			KeyValueInstanceIDCol.identifier(): authz.GetInstance(ctx).InstanceID(),
+		}).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Kv7b3", "Errors.Query.SQLStatment")
+	}
+
+	row := q.client.QueryRowContext(ctx, stmt, args...)
+	return scan(row)
+}
+
+func prepareKeyValueQuery() (sq.SelectBuilder, func(*sql.Row) (*KeyValue, error)) {
+	return sq.Select(
+			KeyValueCreationDateCol.identifier(),
+			KeyValueChangeDateCol.identifier(),
+			KeyValueResourceOwnerCol.identifier(),
+			KeyValueSequenceCol.identifier(),
+			KeyValueKeyCol.identifier(),
+			KeyValueValueCol.identifier(),
+		).
+			From(keyValueTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*KeyValue, error) {
+			kv := new(KeyValue)
+			err := row.Scan(
+				&kv.CreationDate,
+				&kv.ChangeDate,
+				&kv.ResourceOwner,
+				&kv.Sequence,
+				&kv.Key,
+				&kv.Value,
+			)
+
+			if err != nil {
+				if errs.Is(err, sql.ErrNoRows) {
+					return nil, errors.ThrowNotFound(err, "QUERY-Kv5h8", "Errors.KeyValue.NotFound")
+				}
+				return nil, errors.ThrowInternal(err, "QUERY-Kv2g4", "Errors.Internal")
+			}
+			return kv, nil
+		}
+}
@@ -0,0 +1,110 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	errs "github.com/dennigogo/zitadel/internal/errors"
+)
+
+var (
+	keyValueQuery = `SELECT projections.kv_store.creation_date,` +
+		` projections.kv_store.change_date,` +
+		` projections.kv_store.resource_owner,` +
+		` projections.kv_store.sequence,` +
+		` projections.kv_store.key,` +
+		` projections.kv_store.value` +
+		` FROM projections.kv_store`
+	keyValueCols = []string{
+		"creation_date",
+		"change_date",
+		"resource_owner",
+		"sequence",
+		"key",
+		"value",
+	}
+)
+
+func Test_KeyValuePrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareKeyValueQuery no result",
+			prepare: prepareKeyValueQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(keyValueQuery),
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !errs.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*KeyValue)(nil),
+		},
+		{
+			name:    "prepareKeyValueQuery found",
+			prepare: prepareKeyValueQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(keyValueQuery),
+					keyValueCols,
+					[]driver.Value{
+						testNow,
+						testNow,
+						"resource_owner",
+						uint64(20211108),
+						"key",
+						[]byte("value"),
+					},
+				),
+			},
+			object: &KeyValue{
+				CreationDate:  testNow,
+				ChangeDate:    testNow,
+				ResourceOwner: "resource_owner",
+				Sequence:      20211108,
+				Key:           "key",
+				Value:         []byte("value"),
+			},
+		},
+		{
+			name:    "prepareKeyValueQuery sql err",
+			prepare: prepareKeyValueQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(keyValueQuery),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err)
+		})
+	}
+}
@@ -24,7 +24,12 @@ type LockoutPolicy struct {
 	State         domain.PolicyState
 
 	MaxPasswordAttempts uint64
+	MaxOTPAttempts      uint64
+	MaxU2FAttempts      uint64
 	ShowFailures        bool
+	AutoUnlockAfter     time.Duration
+	AttemptDelay        time.Duration
+	MaxAttemptDelay     time.Duration
 
 	IsDefault bool
 }
@@ -65,6 +70,26 @@ var (
 		name:  projection.LockoutPolicyMaxPasswordAttemptsCol,
 		table: lockoutTable,
 	}
+	LockoutColMaxOTPAttempts = Column{
+		name:  projection.LockoutPolicyMaxOTPAttemptsCol,
+		table: lockoutTable,
+	}
+	LockoutColMaxU2FAttempts = Column{
+		name:  projection.LockoutPolicyMaxU2FAttemptsCol,
+		table: lockoutTable,
+	}
+	LockoutColAutoUnlockAfter = Column{
+		name:  projection.LockoutPolicyAutoUnlockAfterCol,
+		table: lockoutTable,
+	}
+	LockoutColAttemptDelay = Column{
+		name:  projection.LockoutPolicyAttemptDelayCol,
+		table: lockoutTable,
+	}
+	LockoutColMaxAttemptDelay = Column{
+		name:  projection.LockoutPolicyMaxAttemptDelayCol,
+		table: lockoutTable,
+	}
 	LockoutColIsDefault = Column{
 		name:  projection.LockoutPolicyIsDefaultCol,
 		table: lockoutTable,
@@ -130,6 +155,11 @@ func prepareLockoutPolicyQuery() (sq.SelectBuilder, func(*sql.Row) (*LockoutPoli
 			LockoutColResourceOwner.identifier(),
 			LockoutColShowFailures.identifier(),
 			LockoutColMaxPasswordAttempts.identifier(),
+			LockoutColMaxOTPAttempts.identifier(),
+			LockoutColMaxU2FAttempts.identifier(),
+			LockoutColAutoUnlockAfter.identifier(),
+			LockoutColAttemptDelay.identifier(),
+			LockoutColMaxAttemptDelay.identifier(),
 			LockoutColIsDefault.identifier(),
 			LockoutColState.identifier(),
 		).
@@ -144,6 +174,11 @@ func prepareLockoutPolicyQuery() (sq.SelectBuilder, func(*sql.Row) (*LockoutPoli
 				&policy.ResourceOwner,
 				&policy.ShowFailures,
 				&policy.MaxPasswordAttempts,
+				&policy.MaxOTPAttempts,
+				&policy.MaxU2FAttempts,
+				&policy.AutoUnlockAfter,
+				&policy.AttemptDelay,
+				&policy.MaxAttemptDelay,
 				&policy.IsDefault,
 				&policy.State,
 			)
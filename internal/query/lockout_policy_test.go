@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/dennigogo/zitadel/internal/domain"
 	errs "github.com/dennigogo/zitadel/internal/errors"
@@ -35,6 +36,11 @@ func Test_LockoutPolicyPrepares(t *testing.T) {
 						` projections.lockout_policies.resource_owner,`+
 						` projections.lockout_policies.show_failure,`+
 						` projections.lockout_policies.max_password_attempts,`+
+						` projections.lockout_policies.max_otp_attempts,`+
+						` projections.lockout_policies.max_u2f_attempts,`+
+						` projections.lockout_policies.auto_unlock_after,`+
+						` projections.lockout_policies.attempt_delay,`+
+						` projections.lockout_policies.max_attempt_delay,`+
 						` projections.lockout_policies.is_default,`+
 						` projections.lockout_policies.state`+
 						` FROM projections.lockout_policies`),
@@ -62,6 +68,11 @@ func Test_LockoutPolicyPrepares(t *testing.T) {
 						` projections.lockout_policies.resource_owner,`+
 						` projections.lockout_policies.show_failure,`+
 						` projections.lockout_policies.max_password_attempts,`+
+						` projections.lockout_policies.max_otp_attempts,`+
+						` projections.lockout_policies.max_u2f_attempts,`+
+						` projections.lockout_policies.auto_unlock_after,`+
+						` projections.lockout_policies.attempt_delay,`+
+						` projections.lockout_policies.max_attempt_delay,`+
 						` projections.lockout_policies.is_default,`+
 						` projections.lockout_policies.state`+
 						` FROM projections.lockout_policies`),
@@ -73,6 +84,11 @@ func Test_LockoutPolicyPrepares(t *testing.T) {
 						"resource_owner",
 						"show_failure",
 						"max_password_attempts",
+						"max_otp_attempts",
+						"max_u2f_attempts",
+						"auto_unlock_after",
+						"attempt_delay",
+						"max_attempt_delay",
 						"is_default",
 						"state",
 					},
@@ -84,6 +100,11 @@ func Test_LockoutPolicyPrepares(t *testing.T) {
 						"ro",
 						true,
 						20,
+						5,
+						3,
+						time.Hour,
+						time.Second,
+						time.Minute,
 						true,
 						domain.PolicyStateActive,
 					},
@@ -98,6 +119,11 @@ func Test_LockoutPolicyPrepares(t *testing.T) {
 				State:               domain.PolicyStateActive,
 				ShowFailures:        true,
 				MaxPasswordAttempts: 20,
+				MaxOTPAttempts:      5,
+				MaxU2FAttempts:      3,
+				AutoUnlockAfter:     time.Hour,
+				AttemptDelay:        time.Second,
+				MaxAttemptDelay:     time.Minute,
 				IsDefault:           true,
 			},
 		},
@@ -113,6 +139,11 @@ func Test_LockoutPolicyPrepares(t *testing.T) {
 						` projections.lockout_policies.resource_owner,`+
 						` projections.lockout_policies.show_failure,`+
 						` projections.lockout_policies.max_password_attempts,`+
+						` projections.lockout_policies.max_otp_attempts,`+
+						` projections.lockout_policies.max_u2f_attempts,`+
+						` projections.lockout_policies.auto_unlock_after,`+
+						` projections.lockout_policies.attempt_delay,`+
+						` projections.lockout_policies.max_attempt_delay,`+
 						` projections.lockout_policies.is_default,`+
 						` projections.lockout_policies.state`+
 						` FROM projections.lockout_policies`),
@@ -39,6 +39,7 @@ type LoginPolicy struct {
 	MFAInitSkipLifetime        time.Duration
 	SecondFactorCheckLifetime  time.Duration
 	MultiFactorCheckLifetime   time.Duration
+	TrustedDeviceLifetime      time.Duration
 	IDPLinks                   []*IDPLoginPolicyLink
 }
 
@@ -152,6 +153,10 @@ var (
 		name:  projection.MultiFactorCheckLifetimeCol,
 		table: loginPolicyTable,
 	}
+	LoginPolicyColumnTrustedDeviceLifetime = Column{
+		name:  projection.TrustedDeviceLifetimeCol,
+		table: loginPolicyTable,
+	}
 )
 
 func (q *Queries) LoginPolicyByID(ctx context.Context, shouldTriggerBulk bool, orgID string) (*LoginPolicy, error) {
@@ -329,6 +334,7 @@ func prepareLoginPolicyQuery() (sq.SelectBuilder, func(*sql.Rows) (*LoginPolicy,
 			LoginPolicyColumnMFAInitSkipLifetime.identifier(),
 			LoginPolicyColumnSecondFactorCheckLifetime.identifier(),
 			LoginPolicyColumnMultiFacotrCheckLifetime.identifier(),
+			LoginPolicyColumnTrustedDeviceLifetime.identifier(),
 			IDPLoginPolicyLinkIDPIDCol.identifier(),
 			IDPNameCol.identifier(),
 			IDPTypeCol.identifier(),
@@ -370,6 +376,7 @@ func prepareLoginPolicyQuery() (sq.SelectBuilder, func(*sql.Rows) (*LoginPolicy,
 					&p.MFAInitSkipLifetime,
 					&p.SecondFactorCheckLifetime,
 					&p.MultiFactorCheckLifetime,
+					&p.TrustedDeviceLifetime,
 					&idpID,
 					&idpName,
 					&idpType,
@@ -53,6 +53,7 @@ func Test_LoginPolicyPrepares(t *testing.T) {
 						` projections.login_policies3.mfa_init_skip_lifetime,`+
 						` projections.login_policies3.second_factor_check_lifetime,`+
 						` projections.login_policies3.multi_factor_check_lifetime,`+
+						` projections.login_policies3.trusted_device_lifetime,`+
 						` projections.idp_login_policy_links3.idp_id,`+
 						` projections.idps2.name,`+
 						` projections.idps2.type`+
@@ -101,6 +102,7 @@ func Test_LoginPolicyPrepares(t *testing.T) {
 						` projections.login_policies3.mfa_init_skip_lifetime,`+
 						` projections.login_policies3.second_factor_check_lifetime,`+
 						` projections.login_policies3.multi_factor_check_lifetime,`+
+						` projections.login_policies3.trusted_device_lifetime,`+
 						` projections.idp_login_policy_links3.idp_id,`+
 						` projections.idps2.name,`+
 						` projections.idps2.type`+
@@ -133,6 +135,7 @@ func Test_LoginPolicyPrepares(t *testing.T) {
 						"mfa_init_skip_lifetime",
 						"second_factor_check_lifetime",
 						"multi_factor_check_lifetime",
+						"trusted_device_lifetime",
 						"idp_id",
 						"name",
 						"type",
@@ -161,6 +164,7 @@ func Test_LoginPolicyPrepares(t *testing.T) {
 						time.Hour * 2,
 						time.Hour * 2,
 						time.Hour * 2,
+						time.Hour * 2,
 						"config1",
 						"IDP",
 						domain.IDPConfigTypeJWT,
@@ -191,6 +195,7 @@ func Test_LoginPolicyPrepares(t *testing.T) {
 				MFAInitSkipLifetime:        time.Hour * 2,
 				SecondFactorCheckLifetime:  time.Hour * 2,
 				MultiFactorCheckLifetime:   time.Hour * 2,
+				TrustedDeviceLifetime:      time.Hour * 2,
 				IDPLinks: []*IDPLoginPolicyLink{
 					{
 						IDPID:   "config1",
@@ -228,6 +233,7 @@ func Test_LoginPolicyPrepares(t *testing.T) {
 						` projections.login_policies3.mfa_init_skip_lifetime,`+
 						` projections.login_policies3.second_factor_check_lifetime,`+
 						` projections.login_policies3.multi_factor_check_lifetime,`+
+						` projections.login_policies3.trusted_device_lifetime,`+
 						` projections.idp_login_policy_links3.idp_id,`+
 						` projections.idps2.name,`+
 						` projections.idps2.type`+
@@ -29,6 +29,12 @@ type MessageTexts struct {
 	VerifyPhone              MessageText
 	DomainClaimed            MessageText
 	PasswordlessRegistration MessageText
+	VerifyEmailOTP           MessageText
+	VerifySMSOTP             MessageText
+	RecoveryCodeUsed         MessageText
+	RecoveryCodesLow         MessageText
+	PasswordExpiry           MessageText
+	UserLocked               MessageText
 }
 
 type MessageText struct {
@@ -312,6 +318,18 @@ func (m *MessageTexts) GetMessageTextByType(msgType string) *MessageText {
 		return &m.DomainClaimed
 	case domain.PasswordlessRegistrationMessageType:
 		return &m.PasswordlessRegistration
+	case domain.VerifyEmailOTPMessageType:
+		return &m.VerifyEmailOTP
+	case domain.VerifySMSOTPMessageType:
+		return &m.VerifySMSOTP
+	case domain.RecoveryCodeUsedMessageType:
+		return &m.RecoveryCodeUsed
+	case domain.RecoveryCodesLowMessageType:
+		return &m.RecoveryCodesLow
+	case domain.PasswordExpiryMessageType:
+		return &m.PasswordExpiry
+	case domain.UserLockedMessageType:
+		return &m.UserLocked
 	}
 	return nil
 }
@@ -0,0 +1,101 @@
+package query
+
+import (
+	"context"
+
+	caos_errs "github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/eventstore"
+	"github.com/dennigogo/zitadel/internal/repository/user"
+	"github.com/dennigogo/zitadel/internal/telemetry/tracing"
+)
+
+// OIDCSessionClientIDs returns the ids of all clients which got tokens issued
+// in the session of the user on the user agent.
+// If the sequence is set only events before it are considered,
+// so the clients of a session can still be determined after it ended.
+func (q *Queries) OIDCSessionClientIDs(ctx context.Context, userAgentID, userID string, sequence uint64) (_ []string, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userAgentID == "" || userID == "" {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "QUERY-Sd2fe", "Errors.User.UserIDMissing")
+	}
+	readModel := NewOIDCSessionClientsReadModel(userAgentID, userID, sequence)
+	err = q.eventstore.FilterToQueryReducer(ctx, readModel)
+	if err != nil {
+		return nil, err
+	}
+	return readModel.ClientIDs, nil
+}
+
+type OIDCSessionClientsReadModel struct {
+	eventstore.WriteModel
+
+	UserAgentID string
+	Sequence    uint64
+	ClientIDs   []string
+}
+
+func NewOIDCSessionClientsReadModel(userAgentID, userID string, sequence uint64) *OIDCSessionClientsReadModel {
+	return &OIDCSessionClientsReadModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID: userID,
+		},
+		UserAgentID: userAgentID,
+		Sequence:    sequence,
+	}
+}
+
+func (rm *OIDCSessionClientsReadModel) Reduce() error {
+	for _, event := range rm.Events {
+		switch e := event.(type) {
+		case *user.UserTokenAddedEvent:
+			if e.UserAgentID != rm.UserAgentID {
+				continue
+			}
+			rm.addClientID(e.ApplicationID)
+		case *user.HumanSignedOutEvent:
+			if e.UserAgentID != rm.UserAgentID {
+				continue
+			}
+			rm.ClientIDs = nil
+		}
+	}
+	return rm.WriteModel.Reduce()
+}
+
+func (rm *OIDCSessionClientsReadModel) addClientID(clientID string) {
+	if clientID == "" {
+		return
+	}
+	for _, id := range rm.ClientIDs {
+		if id == clientID {
+			return
+		}
+	}
+	rm.ClientIDs = append(rm.ClientIDs, clientID)
+}
+
+func (rm *OIDCSessionClientsReadModel) Query() *eventstore.SearchQueryBuilder {
+	builder := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent)
+	tokens := builder.AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(rm.AggregateID).
+		EventTypes(user.UserTokenAddedType).
+		EventData(map[string]interface{}{
+			"userAgentId": rm.UserAgentID,
+		})
+	signOuts := builder.AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(rm.AggregateID).
+		EventTypes(user.HumanSignedOutType,
+			user.UserV1SignedOutType).
+		EventData(map[string]interface{}{
+			"userAgentID": rm.UserAgentID,
+		})
+	if rm.Sequence > 0 {
+		tokens.SequenceLess(rm.Sequence)
+		signOuts.SequenceLess(rm.Sequence)
+	}
+	return builder
+}
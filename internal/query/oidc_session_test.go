@@ -0,0 +1,76 @@
+package query
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/dennigogo/zitadel/internal/eventstore"
+	"github.com/dennigogo/zitadel/internal/repository/user"
+)
+
+func TestOIDCSessionClientsReadModel_Reduce(t *testing.T) {
+	ctx := context.Background()
+	agg := &user.NewAggregate("userID", "orgID").Aggregate
+	tokenAdded := func(clientID, agentID string) eventstore.Event {
+		return user.NewUserTokenAddedEvent(ctx, agg, "tokenID", clientID, agentID, "en", "", nil, nil, time.Now(), "", "", nil)
+	}
+	tests := []struct {
+		name   string
+		events []eventstore.Event
+		want   []string
+	}{
+		{
+			"no tokens, empty",
+			nil,
+			nil,
+		},
+		{
+			"tokens of multiple clients, distinct",
+			[]eventstore.Event{
+				tokenAdded("client1", "agentID"),
+				tokenAdded("client2", "agentID"),
+				tokenAdded("client1", "agentID"),
+			},
+			[]string{"client1", "client2"},
+		},
+		{
+			"tokens of other agent, ignored",
+			[]eventstore.Event{
+				tokenAdded("client1", "agentID"),
+				tokenAdded("client2", "otherAgentID"),
+			},
+			[]string{"client1"},
+		},
+		{
+			"signed out, reset",
+			[]eventstore.Event{
+				tokenAdded("client1", "agentID"),
+				user.NewHumanSignedOutEvent(ctx, agg, "agentID"),
+				tokenAdded("client2", "agentID"),
+			},
+			[]string{"client2"},
+		},
+		{
+			"signed out other agent, ignored",
+			[]eventstore.Event{
+				tokenAdded("client1", "agentID"),
+				user.NewHumanSignedOutEvent(ctx, agg, "otherAgentID"),
+			},
+			[]string{"client1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rm := NewOIDCSessionClientsReadModel("agentID", "userID", 0)
+			rm.AppendEvents(tt.events...)
+			if err := rm.Reduce(); err != nil {
+				t.Fatalf("Reduce() error = %v", err)
+			}
+			if !reflect.DeepEqual(rm.ClientIDs, tt.want) {
+				t.Errorf("ClientIDs = %v, want %v", rm.ClientIDs, tt.want)
+			}
+		})
+	}
+}
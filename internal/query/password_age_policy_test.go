@@ -35,6 +35,7 @@ func Test_PasswordAgePolicyPrepares(t *testing.T) {
 						` projections.password_age_policies.resource_owner,`+
 						` projections.password_age_policies.expire_warn_days,`+
 						` projections.password_age_policies.max_age_days,`+
+						` projections.password_age_policies.history_count,`+
 						` projections.password_age_policies.is_default,`+
 						` projections.password_age_policies.state`+
 						` FROM projections.password_age_policies`),
@@ -62,6 +63,7 @@ func Test_PasswordAgePolicyPrepares(t *testing.T) {
 						` projections.password_age_policies.resource_owner,`+
 						` projections.password_age_policies.expire_warn_days,`+
 						` projections.password_age_policies.max_age_days,`+
+						` projections.password_age_policies.history_count,`+
 						` projections.password_age_policies.is_default,`+
 						` projections.password_age_policies.state`+
 						` FROM projections.password_age_policies`),
@@ -73,6 +75,7 @@ func Test_PasswordAgePolicyPrepares(t *testing.T) {
 						"resource_owner",
 						"expire_warn_days",
 						"max_age_days",
+						"history_count",
 						"is_default",
 						"state",
 					},
@@ -84,6 +87,7 @@ func Test_PasswordAgePolicyPrepares(t *testing.T) {
 						"ro",
 						10,
 						20,
+						5,
 						true,
 						domain.PolicyStateActive,
 					},
@@ -98,6 +102,7 @@ func Test_PasswordAgePolicyPrepares(t *testing.T) {
 				State:          domain.PolicyStateActive,
 				ExpireWarnDays: 10,
 				MaxAgeDays:     20,
+				HistoryCount:   5,
 				IsDefault:      true,
 			},
 		},
@@ -113,6 +118,7 @@ func Test_PasswordAgePolicyPrepares(t *testing.T) {
 						` projections.password_age_policies.resource_owner,`+
 						` projections.password_age_policies.expire_warn_days,`+
 						` projections.password_age_policies.max_age_days,`+
+						` projections.password_age_policies.history_count,`+
 						` projections.password_age_policies.is_default,`+
 						` projections.password_age_policies.state`+
 						` FROM projections.password_age_policies`),
@@ -28,6 +28,8 @@ type PasswordComplexityPolicy struct {
 	HasNumber    bool
 	HasSymbol    bool
 
+	BreachedPasswordCheck domain.BreachedPasswordCheck
+
 	IsDefault bool
 }
 
@@ -129,6 +131,10 @@ var (
 		name:  projection.ComplexityPolicyHasSymbolCol,
 		table: passwordComplexityTable,
 	}
+	PasswordComplexityColBreachedPasswordCheck = Column{
+		name:  projection.ComplexityPolicyBreachedCheckCol,
+		table: passwordComplexityTable,
+	}
 	PasswordComplexityColIsDefault = Column{
 		name:  projection.ComplexityPolicyIsDefaultCol,
 		table: passwordComplexityTable,
@@ -151,6 +157,7 @@ func preparePasswordComplexityPolicyQuery() (sq.SelectBuilder, func(*sql.Row) (*
 			PasswordComplexityColHasUpperCase.identifier(),
 			PasswordComplexityColHasNumber.identifier(),
 			PasswordComplexityColHasSymbol.identifier(),
+			PasswordComplexityColBreachedPasswordCheck.identifier(),
 			PasswordComplexityColIsDefault.identifier(),
 			PasswordComplexityColState.identifier(),
 		).
@@ -168,6 +175,7 @@ func preparePasswordComplexityPolicyQuery() (sq.SelectBuilder, func(*sql.Row) (*
 				&policy.HasUppercase,
 				&policy.HasNumber,
 				&policy.HasSymbol,
+				&policy.BreachedPasswordCheck,
 				&policy.IsDefault,
 				&policy.State,
 			)
@@ -38,6 +38,7 @@ func Test_PasswordComplexityPolicyPrepares(t *testing.T) {
 						` projections.password_complexity_policies.has_uppercase,`+
 						` projections.password_complexity_policies.has_number,`+
 						` projections.password_complexity_policies.has_symbol,`+
+						` projections.password_complexity_policies.breached_password_check,`+
 						` projections.password_complexity_policies.is_default,`+
 						` projections.password_complexity_policies.state`+
 						` FROM projections.password_complexity_policies`),
@@ -68,6 +69,7 @@ func Test_PasswordComplexityPolicyPrepares(t *testing.T) {
 						` projections.password_complexity_policies.has_uppercase,`+
 						` projections.password_complexity_policies.has_number,`+
 						` projections.password_complexity_policies.has_symbol,`+
+						` projections.password_complexity_policies.breached_password_check,`+
 						` projections.password_complexity_policies.is_default,`+
 						` projections.password_complexity_policies.state`+
 						` FROM projections.password_complexity_policies`),
@@ -82,6 +84,7 @@ func Test_PasswordComplexityPolicyPrepares(t *testing.T) {
 						"has_uppercase",
 						"has_number",
 						"has_symbol",
+						"breached_password_check",
 						"is_default",
 						"state",
 					},
@@ -96,24 +99,26 @@ func Test_PasswordComplexityPolicyPrepares(t *testing.T) {
 						true,
 						true,
 						true,
+						domain.BreachedPasswordCheckWarn,
 						true,
 						domain.PolicyStateActive,
 					},
 				),
 			},
 			object: &PasswordComplexityPolicy{
-				ID:            "pol-id",
-				CreationDate:  testNow,
-				ChangeDate:    testNow,
-				Sequence:      20211109,
-				ResourceOwner: "ro",
-				State:         domain.PolicyStateActive,
-				MinLength:     8,
-				HasLowercase:  true,
-				HasUppercase:  true,
-				HasNumber:     true,
-				HasSymbol:     true,
-				IsDefault:     true,
+				ID:                    "pol-id",
+				CreationDate:          testNow,
+				ChangeDate:            testNow,
+				Sequence:              20211109,
+				ResourceOwner:         "ro",
+				State:                 domain.PolicyStateActive,
+				MinLength:             8,
+				HasLowercase:          true,
+				HasUppercase:          true,
+				HasNumber:             true,
+				HasSymbol:             true,
+				BreachedPasswordCheck: domain.BreachedPasswordCheckWarn,
+				IsDefault:             true,
 			},
 		},
 		{
@@ -131,6 +136,7 @@ func Test_PasswordComplexityPolicyPrepares(t *testing.T) {
 						` projections.password_complexity_policies.has_uppercase,`+
 						` projections.password_complexity_policies.has_number,`+
 						` projections.password_complexity_policies.has_symbol,`+
+						` projections.password_complexity_policies.breached_password_check,`+
 						` projections.password_complexity_policies.is_default,`+
 						` projections.password_complexity_policies.state`+
 						` FROM projections.password_complexity_policies`),
@@ -0,0 +1,157 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/dennigogo/zitadel/internal/domain"
+	"github.com/dennigogo/zitadel/internal/errors"
+)
+
+const (
+	passwordExpiryOrgPolicyTableAlias     = "org_policy"
+	passwordExpiryDefaultPolicyTableAlias = "default_policy"
+	passwordExpiryTableAlias              = "expiries"
+
+	passwordExpiryUserIDAlias          = "user_id"
+	passwordExpiryInstanceIDAlias      = "instance_id"
+	passwordExpiryResourceOwnerAlias   = "resource_owner"
+	passwordExpiryPasswordChangedAlias = "password_changed"
+	passwordExpiryMaxAgeDaysAlias      = "max_age_days"
+	passwordExpiryWarnDaysAlias        = "expire_warn_days"
+	passwordExpiryInstanceRowAlias     = "instance_row"
+)
+
+var (
+	passwordExpiryOrgPolicyTable     = passwordAgeTable.setAlias(passwordExpiryOrgPolicyTableAlias)
+	passwordExpiryDefaultPolicyTable = passwordAgeTable.setAlias(passwordExpiryDefaultPolicyTableAlias)
+
+	passwordExpiryOrgPolicyIDCol             = PasswordAgeColID.setTable(passwordExpiryOrgPolicyTable)
+	passwordExpiryOrgPolicyInstanceIDCol     = PasswordAgeColInstanceID.setTable(passwordExpiryOrgPolicyTable)
+	passwordExpiryOrgPolicyMaxAgeCol         = PasswordAgeColMaxAge.setTable(passwordExpiryOrgPolicyTable)
+	passwordExpiryOrgPolicyWarnDaysCol       = PasswordAgeColWarnDays.setTable(passwordExpiryOrgPolicyTable)
+	passwordExpiryDefaultPolicyIDCol         = PasswordAgeColID.setTable(passwordExpiryDefaultPolicyTable)
+	passwordExpiryDefaultPolicyMaxAgeCol     = PasswordAgeColMaxAge.setTable(passwordExpiryDefaultPolicyTable)
+	passwordExpiryDefaultPolicyWarnDaysCol   = PasswordAgeColWarnDays.setTable(passwordExpiryDefaultPolicyTable)
+	passwordExpiryDefaultPolicyInstanceIDCol = PasswordAgeColInstanceID.setTable(passwordExpiryDefaultPolicyTable)
+
+	// the policy of the organisation of the user takes precedence over the default policy of the instance
+	passwordExpiryMaxAgeDays = fmt.Sprintf("COALESCE(%s, %s)", passwordExpiryOrgPolicyMaxAgeCol.identifier(), passwordExpiryDefaultPolicyMaxAgeCol.identifier())
+	passwordExpiryWarnDays   = fmt.Sprintf("COALESCE(%s, %s)", passwordExpiryOrgPolicyWarnDaysCol.identifier(), passwordExpiryDefaultPolicyWarnDaysCol.identifier())
+)
+
+// PasswordExpiry is a human user, whose password expires soon
+type PasswordExpiry struct {
+	UserID          string
+	InstanceID      string
+	ResourceOwner   string
+	PasswordChanged time.Time
+	MaxAgeDays      uint64
+	ExpireWarnDays  uint64
+}
+
+// ExpirationDate returns the date the password of the user expires
+func (e *PasswordExpiry) ExpirationDate() time.Time {
+	policy := &domain.PasswordAgePolicy{
+		MaxAgeDays:     e.MaxAgeDays,
+		ExpireWarnDays: e.ExpireWarnDays,
+	}
+	return policy.ExpirationDate(e.PasswordChanged)
+}
+
+// PasswordExpiryNotifications returns the active users of all instances,
+// whose password expires within the warn days of their password age policy at the time now
+// and who weren't notified about it yet.
+// At most limit users are returned per instance, so the users of an instance can't delay the notifications of the others.
+func (q *Queries) PasswordExpiryNotifications(ctx context.Context, now time.Time, limit uint64) ([]*PasswordExpiry, error) {
+	expiries, stmt, scan := preparePasswordExpiryNotificationsQuery()
+	query, args, err := stmt(expiries.Where(
+		sq.And{
+			sq.Expr(NotifyPasswordChangedCol.identifier()+" + ("+passwordExpiryMaxAgeDays+" - "+passwordExpiryWarnDays+") * INTERVAL '1 day' <= ?", now),
+			sq.Expr(NotifyPasswordChangedCol.identifier()+" + "+passwordExpiryMaxAgeDays+" * INTERVAL '1 day' > ?", now),
+		})).
+		Where(sq.LtOrEq{passwordExpiryTableAlias + "." + passwordExpiryInstanceRowAlias: limit}).
+		ToSql()
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Pe2xq", "Errors.Query.SQLStatement")
+	}
+
+	rows, err := q.client.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Pe7wz", "Errors.Internal")
+	}
+	return scan(rows)
+}
+
+// preparePasswordExpiryNotificationsQuery numbers the due users per instance,
+// the numbers are filtered by the second builder, which selects the users
+func preparePasswordExpiryNotificationsQuery() (sq.SelectBuilder, func(sq.SelectBuilder) sq.SelectBuilder, func(*sql.Rows) ([]*PasswordExpiry, error)) {
+	return sq.Select(
+			NotifyUserIDCol.identifier()+" AS "+passwordExpiryUserIDAlias,
+			NotifyInstanceIDCol.identifier()+" AS "+passwordExpiryInstanceIDAlias,
+			UserResourceOwnerCol.identifier()+" AS "+passwordExpiryResourceOwnerAlias,
+			NotifyPasswordChangedCol.identifier()+" AS "+passwordExpiryPasswordChangedAlias,
+			passwordExpiryMaxAgeDays+" AS "+passwordExpiryMaxAgeDaysAlias,
+			passwordExpiryWarnDays+" AS "+passwordExpiryWarnDaysAlias,
+			"ROW_NUMBER() OVER (PARTITION BY "+NotifyInstanceIDCol.identifier()+" ORDER BY "+NotifyPasswordChangedCol.identifier()+") AS "+passwordExpiryInstanceRowAlias,
+		).
+			From(notifyTable.identifier()).
+			Join(join(UserIDCol, NotifyUserIDCol) + " AND " + UserInstanceIDCol.identifier() + " = " + NotifyInstanceIDCol.identifier()).
+			LeftJoin(join(passwordExpiryOrgPolicyIDCol, UserResourceOwnerCol) + " AND " + passwordExpiryOrgPolicyInstanceIDCol.identifier() + " = " + NotifyInstanceIDCol.identifier()).
+			LeftJoin(join(passwordExpiryDefaultPolicyIDCol, NotifyInstanceIDCol) + " AND " + passwordExpiryDefaultPolicyInstanceIDCol.identifier() + " = " + NotifyInstanceIDCol.identifier()).
+			Where(sq.And{
+				sq.Eq{
+					NotifyPasswordSetCol.identifier(): true,
+					UserStateCol.identifier():         domain.UserStateActive,
+				},
+				sq.NotEq{
+					NotifyPasswordChangedCol.identifier(): nil,
+				},
+				sq.Or{
+					sq.Eq{NotifyPasswordExpiryNotifiedCol.identifier(): nil},
+					sq.Expr(NotifyPasswordExpiryNotifiedCol.identifier() + " < " + NotifyPasswordChangedCol.identifier()),
+				},
+				sq.Expr(passwordExpiryMaxAgeDays + " > 0"),
+				sq.Expr(passwordExpiryWarnDays + " > 0"),
+			}),
+		func(builder sq.SelectBuilder) sq.SelectBuilder {
+			return sq.Select(
+				passwordExpiryTableAlias+"."+passwordExpiryUserIDAlias,
+				passwordExpiryTableAlias+"."+passwordExpiryInstanceIDAlias,
+				passwordExpiryTableAlias+"."+passwordExpiryResourceOwnerAlias,
+				passwordExpiryTableAlias+"."+passwordExpiryPasswordChangedAlias,
+				passwordExpiryTableAlias+"."+passwordExpiryMaxAgeDaysAlias,
+				passwordExpiryTableAlias+"."+passwordExpiryWarnDaysAlias,
+			).FromSelect(builder, passwordExpiryTableAlias).
+				OrderBy(passwordExpiryTableAlias + "." + passwordExpiryPasswordChangedAlias).
+				PlaceholderFormat(sq.Dollar)
+		},
+		func(rows *sql.Rows) ([]*PasswordExpiry, error) {
+			expiries := make([]*PasswordExpiry, 0)
+			for rows.Next() {
+				expiry := new(PasswordExpiry)
+				err := rows.Scan(
+					&expiry.UserID,
+					&expiry.InstanceID,
+					&expiry.ResourceOwner,
+					&expiry.PasswordChanged,
+					&expiry.MaxAgeDays,
+					&expiry.ExpireWarnDays,
+				)
+				if err != nil {
+					return nil, errors.ThrowInternal(err, "QUERY-Pe5nd", "Errors.Internal")
+				}
+				expiries = append(expiries, expiry)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, errors.ThrowInternal(err, "QUERY-Pe9rc", "Errors.Query.CloseRows")
+			}
+
+			return expiries, nil
+		}
+}
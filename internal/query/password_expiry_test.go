@@ -0,0 +1,155 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/dennigogo/zitadel/internal/domain"
+)
+
+var (
+	passwordExpiryNotificationsQuery = regexp.QuoteMeta(`SELECT expiries.user_id,` +
+		` expiries.instance_id,` +
+		` expiries.resource_owner,` +
+		` expiries.password_changed,` +
+		` expiries.max_age_days,` +
+		` expiries.expire_warn_days` +
+		` FROM (SELECT projections.users4_notifications.user_id AS user_id,` +
+		` projections.users4_notifications.instance_id AS instance_id,` +
+		` projections.users4.resource_owner AS resource_owner,` +
+		` projections.users4_notifications.password_changed AS password_changed,` +
+		` COALESCE(org_policy.max_age_days, default_policy.max_age_days) AS max_age_days,` +
+		` COALESCE(org_policy.expire_warn_days, default_policy.expire_warn_days) AS expire_warn_days,` +
+		` ROW_NUMBER() OVER (PARTITION BY projections.users4_notifications.instance_id ORDER BY projections.users4_notifications.password_changed) AS instance_row` +
+		` FROM projections.users4_notifications` +
+		` JOIN projections.users4 ON projections.users4_notifications.user_id = projections.users4.id AND projections.users4.instance_id = projections.users4_notifications.instance_id` +
+		` LEFT JOIN projections.password_age_policies AS org_policy ON projections.users4.resource_owner = org_policy.id AND org_policy.instance_id = projections.users4_notifications.instance_id` +
+		` LEFT JOIN projections.password_age_policies AS default_policy ON projections.users4_notifications.instance_id = default_policy.id AND default_policy.instance_id = projections.users4_notifications.instance_id` +
+		` WHERE (projections.users4.state = $1 AND projections.users4_notifications.password_set = $2` +
+		` AND projections.users4_notifications.password_changed IS NOT NULL` +
+		` AND (projections.users4_notifications.password_expiry_notified IS NULL OR projections.users4_notifications.password_expiry_notified < projections.users4_notifications.password_changed)` +
+		` AND COALESCE(org_policy.max_age_days, default_policy.max_age_days) > 0` +
+		` AND COALESCE(org_policy.expire_warn_days, default_policy.expire_warn_days) > 0))` +
+		` AS expiries` +
+		` ORDER BY expiries.password_changed`)
+	passwordExpiryNotificationsCols = []string{
+		"user_id",
+		"instance_id",
+		"resource_owner",
+		"password_changed",
+		"max_age_days",
+		"expire_warn_days",
+	}
+)
+
+func Test_PasswordExpiryPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name: "preparePasswordExpiryNotificationsQuery no result",
+			prepare: func() (sq.SelectBuilder, func(*sql.Rows) ([]*PasswordExpiry, error)) {
+				expiries, query, scan := preparePasswordExpiryNotificationsQuery()
+				return query(expiries), scan
+			},
+			want: want{
+				sqlExpectations: mockQueries(
+					passwordExpiryNotificationsQuery,
+					nil,
+					nil,
+					domain.UserStateActive,
+					true,
+				),
+			},
+			object: []*PasswordExpiry{},
+		},
+		{
+			name: "preparePasswordExpiryNotificationsQuery one result",
+			prepare: func() (sq.SelectBuilder, func(*sql.Rows) ([]*PasswordExpiry, error)) {
+				expiries, query, scan := preparePasswordExpiryNotificationsQuery()
+				return query(expiries), scan
+			},
+			want: want{
+				sqlExpectations: mockQueries(
+					passwordExpiryNotificationsQuery,
+					passwordExpiryNotificationsCols,
+					[][]driver.Value{
+						{
+							"user-id",
+							"instance-id",
+							"ro",
+							testNow,
+							uint64(30),
+							uint64(5),
+						},
+					},
+					domain.UserStateActive,
+					true,
+				),
+			},
+			object: []*PasswordExpiry{
+				{
+					UserID:          "user-id",
+					InstanceID:      "instance-id",
+					ResourceOwner:   "ro",
+					PasswordChanged: testNow,
+					MaxAgeDays:      30,
+					ExpireWarnDays:  5,
+				},
+			},
+		},
+		{
+			name: "preparePasswordExpiryNotificationsQuery sql err",
+			prepare: func() (sq.SelectBuilder, func(*sql.Rows) ([]*PasswordExpiry, error)) {
+				expiries, query, scan := preparePasswordExpiryNotificationsQuery()
+				return query(expiries), scan
+			},
+			want: want{
+				sqlExpectations: mockQueryErr(
+					passwordExpiryNotificationsQuery,
+					sql.ErrConnDone,
+					domain.UserStateActive,
+					true,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err)
+		})
+	}
+}
+
+func TestPasswordExpiry_ExpirationDate(t *testing.T) {
+	changed := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	expiry := &PasswordExpiry{
+		PasswordChanged: changed,
+		MaxAgeDays:      30,
+		ExpireWarnDays:  5,
+	}
+	if got, want := expiry.ExpirationDate(), changed.AddDate(0, 0, 30); !got.Equal(want) {
+		t.Errorf("ExpirationDate() = %v, want %v", got, want)
+	}
+}
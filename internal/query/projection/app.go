@@ -35,25 +35,29 @@ const (
 	AppAPIConfigColumnClientSecret = "client_secret"
 	AppAPIConfigColumnAuthMethod   = "auth_method"
 
-	appOIDCTableSuffix                          = "oidc_configs"
-	AppOIDCConfigColumnAppID                    = "app_id"
-	AppOIDCConfigColumnInstanceID               = "instance_id"
-	AppOIDCConfigColumnVersion                  = "version"
-	AppOIDCConfigColumnClientID                 = "client_id"
-	AppOIDCConfigColumnClientSecret             = "client_secret"
-	AppOIDCConfigColumnRedirectUris             = "redirect_uris"
-	AppOIDCConfigColumnResponseTypes            = "response_types"
-	AppOIDCConfigColumnGrantTypes               = "grant_types"
-	AppOIDCConfigColumnApplicationType          = "application_type"
-	AppOIDCConfigColumnAuthMethodType           = "auth_method_type"
-	AppOIDCConfigColumnPostLogoutRedirectUris   = "post_logout_redirect_uris"
-	AppOIDCConfigColumnDevMode                  = "is_dev_mode"
-	AppOIDCConfigColumnAccessTokenType          = "access_token_type"
-	AppOIDCConfigColumnAccessTokenRoleAssertion = "access_token_role_assertion"
-	AppOIDCConfigColumnIDTokenRoleAssertion     = "id_token_role_assertion"
-	AppOIDCConfigColumnIDTokenUserinfoAssertion = "id_token_userinfo_assertion"
-	AppOIDCConfigColumnClockSkew                = "clock_skew"
-	AppOIDCConfigColumnAdditionalOrigins        = "additional_origins"
+	appOIDCTableSuffix                            = "oidc_configs"
+	AppOIDCConfigColumnAppID                      = "app_id"
+	AppOIDCConfigColumnInstanceID                 = "instance_id"
+	AppOIDCConfigColumnVersion                    = "version"
+	AppOIDCConfigColumnClientID                   = "client_id"
+	AppOIDCConfigColumnClientSecret               = "client_secret"
+	AppOIDCConfigColumnRedirectUris               = "redirect_uris"
+	AppOIDCConfigColumnResponseTypes              = "response_types"
+	AppOIDCConfigColumnGrantTypes                 = "grant_types"
+	AppOIDCConfigColumnApplicationType            = "application_type"
+	AppOIDCConfigColumnAuthMethodType             = "auth_method_type"
+	AppOIDCConfigColumnPostLogoutRedirectUris     = "post_logout_redirect_uris"
+	AppOIDCConfigColumnDevMode                    = "is_dev_mode"
+	AppOIDCConfigColumnAccessTokenType            = "access_token_type"
+	AppOIDCConfigColumnAccessTokenRoleAssertion   = "access_token_role_assertion"
+	AppOIDCConfigColumnIDTokenRoleAssertion       = "id_token_role_assertion"
+	AppOIDCConfigColumnIDTokenUserinfoAssertion   = "id_token_userinfo_assertion"
+	AppOIDCConfigColumnClockSkew                  = "clock_skew"
+	AppOIDCConfigColumnAdditionalOrigins          = "additional_origins"
+	AppOIDCConfigColumnBackChannelLogoutURI       = "back_channel_logout_uri"
+	AppOIDCConfigColumnFrontChannelLogoutURI      = "front_channel_logout_uri"
+	AppOIDCConfigColumnRequirePushedAuthRequests  = "require_pushed_auth_requests"
+	AppOIDCConfigColumnRequireSignedRequestObject = "require_signed_request_object"
 
 	appSAMLTableSuffix             = "saml_configs"
 	AppSAMLConfigColumnAppID       = "app_id"
@@ -118,6 +122,10 @@ func newAppProjection(ctx context.Context, config crdb.StatementHandlerConfig) *
 			crdb.NewColumn(AppOIDCConfigColumnIDTokenUserinfoAssertion, crdb.ColumnTypeBool, crdb.Default(false)),
 			crdb.NewColumn(AppOIDCConfigColumnClockSkew, crdb.ColumnTypeInt64, crdb.Default(0)),
 			crdb.NewColumn(AppOIDCConfigColumnAdditionalOrigins, crdb.ColumnTypeTextArray, crdb.Nullable()),
+			crdb.NewColumn(AppOIDCConfigColumnBackChannelLogoutURI, crdb.ColumnTypeText, crdb.Nullable()),
+			crdb.NewColumn(AppOIDCConfigColumnFrontChannelLogoutURI, crdb.ColumnTypeText, crdb.Nullable()),
+			crdb.NewColumn(AppOIDCConfigColumnRequirePushedAuthRequests, crdb.ColumnTypeBool, crdb.Default(false)),
+			crdb.NewColumn(AppOIDCConfigColumnRequireSignedRequestObject, crdb.ColumnTypeBool, crdb.Default(false)),
 		},
 			crdb.NewPrimaryKey(AppOIDCConfigColumnInstanceID, AppOIDCConfigColumnAppID),
 			appOIDCTableSuffix,
@@ -441,6 +449,10 @@ func (p *appProjection) reduceOIDCConfigAdded(event eventstore.Event) (*handler.
 				handler.NewCol(AppOIDCConfigColumnIDTokenUserinfoAssertion, e.IDTokenUserinfoAssertion),
 				handler.NewCol(AppOIDCConfigColumnClockSkew, e.ClockSkew),
 				handler.NewCol(AppOIDCConfigColumnAdditionalOrigins, database.StringArray(e.AdditionalOrigins)),
+				handler.NewCol(AppOIDCConfigColumnBackChannelLogoutURI, e.BackChannelLogoutURI),
+				handler.NewCol(AppOIDCConfigColumnFrontChannelLogoutURI, e.FrontChannelLogoutURI),
+				handler.NewCol(AppOIDCConfigColumnRequirePushedAuthRequests, e.RequirePushedAuthRequests),
+				handler.NewCol(AppOIDCConfigColumnRequireSignedRequestObject, e.RequireSignedRequestObject),
 			},
 			crdb.WithTableSuffix(appOIDCTableSuffix),
 		),
@@ -463,7 +475,7 @@ func (p *appProjection) reduceOIDCConfigChanged(event eventstore.Event) (*handle
 		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-GNHU1", "reduce.wrong.event.type %s", project.OIDCConfigChangedType)
 	}
 
-	cols := make([]handler.Column, 0, 15)
+	cols := make([]handler.Column, 0, 19)
 	if e.Version != nil {
 		cols = append(cols, handler.NewCol(AppOIDCConfigColumnVersion, *e.Version))
 	}
@@ -506,6 +518,18 @@ func (p *appProjection) reduceOIDCConfigChanged(event eventstore.Event) (*handle
 	if e.AdditionalOrigins != nil {
 		cols = append(cols, handler.NewCol(AppOIDCConfigColumnAdditionalOrigins, database.StringArray(*e.AdditionalOrigins)))
 	}
+	if e.BackChannelLogoutURI != nil {
+		cols = append(cols, handler.NewCol(AppOIDCConfigColumnBackChannelLogoutURI, *e.BackChannelLogoutURI))
+	}
+	if e.FrontChannelLogoutURI != nil {
+		cols = append(cols, handler.NewCol(AppOIDCConfigColumnFrontChannelLogoutURI, *e.FrontChannelLogoutURI))
+	}
+	if e.RequirePushedAuthRequests != nil {
+		cols = append(cols, handler.NewCol(AppOIDCConfigColumnRequirePushedAuthRequests, *e.RequirePushedAuthRequests))
+	}
+	if e.RequireSignedRequestObject != nil {
+		cols = append(cols, handler.NewCol(AppOIDCConfigColumnRequireSignedRequestObject, *e.RequireSignedRequestObject))
+	}
 
 	if len(cols) == 0 {
 		return crdb.NewNoOpStatement(e), nil
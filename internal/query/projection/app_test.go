@@ -394,7 +394,11 @@ func TestAppProjection_reduces(t *testing.T) {
                         "idTokenRoleAssertion": true,
                         "idTokenUserinfoAssertion": true,
                         "clockSkew": 1000,
-                        "additionalOrigins": ["origin.one.ch", "origin.two.ch"]
+                        "additionalOrigins": ["origin.one.ch", "origin.two.ch"],
+                        "backChannelLogoutUri": "https://backchannel.one.ch",
+                        "frontChannelLogoutUri": "https://frontchannel.one.ch",
+                        "requirePushedAuthRequests": true,
+                        "requireSignedRequestObject": true
 		}`),
 				), project.OIDCConfigAddedEventMapper),
 			},
@@ -407,7 +411,7 @@ func TestAppProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "INSERT INTO projections.apps3_oidc_configs (app_id, instance_id, version, client_id, client_secret, redirect_uris, response_types, grant_types, application_type, auth_method_type, post_logout_redirect_uris, is_dev_mode, access_token_type, access_token_role_assertion, id_token_role_assertion, id_token_userinfo_assertion, clock_skew, additional_origins) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18)",
+							expectedStmt: "INSERT INTO projections.apps3_oidc_configs (app_id, instance_id, version, client_id, client_secret, redirect_uris, response_types, grant_types, application_type, auth_method_type, post_logout_redirect_uris, is_dev_mode, access_token_type, access_token_role_assertion, id_token_role_assertion, id_token_userinfo_assertion, clock_skew, additional_origins, back_channel_logout_uri, front_channel_logout_uri, require_pushed_auth_requests, require_signed_request_object) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22)",
 							expectedArgs: []interface{}{
 								"app-id",
 								"instance-id",
@@ -427,6 +431,10 @@ func TestAppProjection_reduces(t *testing.T) {
 								true,
 								1 * time.Microsecond,
 								database.StringArray{"origin.one.ch", "origin.two.ch"},
+								"https://backchannel.one.ch",
+								"https://frontchannel.one.ch",
+								true,
+								true,
 							},
 						},
 						{
@@ -463,7 +471,11 @@ func TestAppProjection_reduces(t *testing.T) {
                         "idTokenRoleAssertion": true,
                         "idTokenUserinfoAssertion": true,
                         "clockSkew": 1000,
-                        "additionalOrigins": ["origin.one.ch", "origin.two.ch"]
+                        "additionalOrigins": ["origin.one.ch", "origin.two.ch"],
+                        "backChannelLogoutUri": "https://backchannel.one.ch",
+                        "frontChannelLogoutUri": "https://frontchannel.one.ch",
+                        "requirePushedAuthRequests": true,
+                        "requireSignedRequestObject": true
 		}`),
 				), project.OIDCConfigChangedEventMapper),
 			},
@@ -476,7 +488,7 @@ func TestAppProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "UPDATE projections.apps3_oidc_configs SET (version, redirect_uris, response_types, grant_types, application_type, auth_method_type, post_logout_redirect_uris, is_dev_mode, access_token_type, access_token_role_assertion, id_token_role_assertion, id_token_userinfo_assertion, clock_skew, additional_origins) = ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14) WHERE (app_id = $15) AND (instance_id = $16)",
+							expectedStmt: "UPDATE projections.apps3_oidc_configs SET (version, redirect_uris, response_types, grant_types, application_type, auth_method_type, post_logout_redirect_uris, is_dev_mode, access_token_type, access_token_role_assertion, id_token_role_assertion, id_token_userinfo_assertion, clock_skew, additional_origins, back_channel_logout_uri, front_channel_logout_uri, require_pushed_auth_requests, require_signed_request_object) = ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18) WHERE (app_id = $19) AND (instance_id = $20)",
 							expectedArgs: []interface{}{
 								domain.OIDCVersionV1,
 								database.StringArray{"redirect.one.ch", "redirect.two.ch"},
@@ -492,6 +504,10 @@ func TestAppProjection_reduces(t *testing.T) {
 								true,
 								1 * time.Microsecond,
 								database.StringArray{"origin.one.ch", "origin.two.ch"},
+								"https://backchannel.one.ch",
+								"https://frontchannel.one.ch",
+								true,
+								true,
 								"app-id",
 								"instance-id",
 							},
@@ -0,0 +1,177 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/dennigogo/zitadel/internal/database"
+	"github.com/dennigogo/zitadel/internal/domain"
+	"github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/eventstore"
+	"github.com/dennigogo/zitadel/internal/eventstore/handler"
+	"github.com/dennigogo/zitadel/internal/eventstore/handler/crdb"
+	"github.com/dennigogo/zitadel/internal/repository/deviceauth"
+)
+
+const (
+	DeviceAuthProjectionTable = "projections.device_authorizations"
+
+	DeviceAuthColumnID            = "id"
+	DeviceAuthColumnCreationDate  = "creation_date"
+	DeviceAuthColumnChangeDate    = "change_date"
+	DeviceAuthColumnSequence      = "sequence"
+	DeviceAuthColumnResourceOwner = "resource_owner"
+	DeviceAuthColumnInstanceID    = "instance_id"
+	DeviceAuthColumnClientID      = "client_id"
+	DeviceAuthColumnDeviceCode    = "device_code"
+	DeviceAuthColumnUserCode      = "user_code"
+	DeviceAuthColumnExpires       = "expires"
+	DeviceAuthColumnScopes        = "scopes"
+	DeviceAuthColumnState         = "state"
+	DeviceAuthColumnSubject       = "subject"
+	DeviceAuthColumnUserOrgID     = "user_org_id"
+	DeviceAuthColumnAMR           = "amr"
+	DeviceAuthColumnAuthTime      = "auth_time"
+)
+
+type deviceAuthProjection struct {
+	crdb.StatementHandler
+}
+
+func newDeviceAuthProjection(ctx context.Context, config crdb.StatementHandlerConfig) *deviceAuthProjection {
+	p := new(deviceAuthProjection)
+	config.ProjectionName = DeviceAuthProjectionTable
+	config.Reducers = p.reducers()
+	config.InitCheck = crdb.NewTableCheck(
+		crdb.NewTable([]*crdb.Column{
+			crdb.NewColumn(DeviceAuthColumnID, crdb.ColumnTypeText),
+			crdb.NewColumn(DeviceAuthColumnCreationDate, crdb.ColumnTypeTimestamp),
+			crdb.NewColumn(DeviceAuthColumnChangeDate, crdb.ColumnTypeTimestamp),
+			crdb.NewColumn(DeviceAuthColumnSequence, crdb.ColumnTypeInt64),
+			crdb.NewColumn(DeviceAuthColumnResourceOwner, crdb.ColumnTypeText),
+			crdb.NewColumn(DeviceAuthColumnInstanceID, crdb.ColumnTypeText),
+			crdb.NewColumn(DeviceAuthColumnClientID, crdb.ColumnTypeText),
+			crdb.NewColumn(DeviceAuthColumnDeviceCode, crdb.ColumnTypeText),
+			crdb.NewColumn(DeviceAuthColumnUserCode, crdb.ColumnTypeText),
+			crdb.NewColumn(DeviceAuthColumnExpires, crdb.ColumnTypeTimestamp),
+			crdb.NewColumn(DeviceAuthColumnScopes, crdb.ColumnTypeTextArray, crdb.Nullable()),
+			crdb.NewColumn(DeviceAuthColumnState, crdb.ColumnTypeEnum),
+			crdb.NewColumn(DeviceAuthColumnSubject, crdb.ColumnTypeText, crdb.Default("")),
+			crdb.NewColumn(DeviceAuthColumnUserOrgID, crdb.ColumnTypeText, crdb.Default("")),
+			crdb.NewColumn(DeviceAuthColumnAMR, crdb.ColumnTypeTextArray, crdb.Nullable()),
+			crdb.NewColumn(DeviceAuthColumnAuthTime, crdb.ColumnTypeTimestamp, crdb.Nullable()),
+		},
+			crdb.NewPrimaryKey(DeviceAuthColumnInstanceID, DeviceAuthColumnID),
+			crdb.WithIndex(crdb.NewIndex("device_auth_device_code_idx", []string{DeviceAuthColumnDeviceCode})),
+			crdb.WithIndex(crdb.NewIndex("device_auth_user_code_idx", []string{DeviceAuthColumnUserCode})),
+		),
+	)
+
+	p.StatementHandler = crdb.NewStatementHandler(ctx, config)
+	return p
+}
+
+func (p *deviceAuthProjection) reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: deviceauth.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  deviceauth.AddedEventType,
+					Reduce: p.reduceAdded,
+				},
+				{
+					Event:  deviceauth.ApprovedEventType,
+					Reduce: p.reduceApproved,
+				},
+				{
+					Event:  deviceauth.DeniedEventType,
+					Reduce: p.reduceDenied,
+				},
+				{
+					Event:  deviceauth.RemovedEventType,
+					Reduce: p.reduceRemoved,
+				},
+			},
+		},
+	}
+}
+
+func (p *deviceAuthProjection) reduceAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*deviceauth.AddedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Dv5k2", "reduce.wrong.event.type %s", deviceauth.AddedEventType)
+	}
+	return crdb.NewCreateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(DeviceAuthColumnID, e.Aggregate().ID),
+			handler.NewCol(DeviceAuthColumnCreationDate, e.CreationDate()),
+			handler.NewCol(DeviceAuthColumnChangeDate, e.CreationDate()),
+			handler.NewCol(DeviceAuthColumnSequence, e.Sequence()),
+			handler.NewCol(DeviceAuthColumnResourceOwner, e.Aggregate().ResourceOwner),
+			handler.NewCol(DeviceAuthColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCol(DeviceAuthColumnClientID, e.ClientID),
+			handler.NewCol(DeviceAuthColumnDeviceCode, e.DeviceCode),
+			handler.NewCol(DeviceAuthColumnUserCode, e.UserCode),
+			handler.NewCol(DeviceAuthColumnExpires, e.Expires),
+			handler.NewCol(DeviceAuthColumnScopes, database.StringArray(e.Scopes)),
+			handler.NewCol(DeviceAuthColumnState, domain.DeviceAuthStateInitiated),
+		},
+	), nil
+}
+
+func (p *deviceAuthProjection) reduceApproved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*deviceauth.ApprovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Dv9m3", "reduce.wrong.event.type %s", deviceauth.ApprovedEventType)
+	}
+	return crdb.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(DeviceAuthColumnChangeDate, e.CreationDate()),
+			handler.NewCol(DeviceAuthColumnSequence, e.Sequence()),
+			handler.NewCol(DeviceAuthColumnState, domain.DeviceAuthStateApproved),
+			handler.NewCol(DeviceAuthColumnSubject, e.Subject),
+			handler.NewCol(DeviceAuthColumnUserOrgID, e.UserOrgID),
+			handler.NewCol(DeviceAuthColumnAMR, database.StringArray(e.AuthMethodsReferences)),
+			handler.NewCol(DeviceAuthColumnAuthTime, e.AuthTime),
+		},
+		[]handler.Condition{
+			handler.NewCond(DeviceAuthColumnID, e.Aggregate().ID),
+			handler.NewCond(DeviceAuthColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *deviceAuthProjection) reduceDenied(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*deviceauth.DeniedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Dv2w7", "reduce.wrong.event.type %s", deviceauth.DeniedEventType)
+	}
+	return crdb.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(DeviceAuthColumnChangeDate, e.CreationDate()),
+			handler.NewCol(DeviceAuthColumnSequence, e.Sequence()),
+			handler.NewCol(DeviceAuthColumnState, domain.DeviceAuthStateDenied),
+		},
+		[]handler.Condition{
+			handler.NewCond(DeviceAuthColumnID, e.Aggregate().ID),
+			handler.NewCond(DeviceAuthColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *deviceAuthProjection) reduceRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*deviceauth.RemovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Dv6r4", "reduce.wrong.event.type %s", deviceauth.RemovedEventType)
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(DeviceAuthColumnID, e.Aggregate().ID),
+			handler.NewCond(DeviceAuthColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
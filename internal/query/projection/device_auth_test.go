@@ -0,0 +1,142 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/dennigogo/zitadel/internal/database"
+	"github.com/dennigogo/zitadel/internal/domain"
+	"github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/eventstore"
+	"github.com/dennigogo/zitadel/internal/eventstore/handler"
+	"github.com/dennigogo/zitadel/internal/eventstore/repository"
+	"github.com/dennigogo/zitadel/internal/repository/deviceauth"
+)
+
+func TestDeviceAuthProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceAdded",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(deviceauth.AddedEventType),
+					deviceauth.AggregateType,
+					[]byte(`{
+						"clientId": "client-id",
+						"deviceCode": "device-code",
+						"userCode": "ABCD-EFGH",
+						"expires": "2022-10-10T10:00:00Z",
+						"scopes": ["openid"]
+					}`),
+				), deviceauth.AddedEventMapper),
+			},
+			reduce: (&deviceAuthProjection{}).reduceAdded,
+			want: wantReduce{
+				aggregateType:    deviceauth.AggregateType,
+				sequence:         15,
+				previousSequence: 10,
+				projection:       DeviceAuthProjectionTable,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.device_authorizations (id, creation_date, change_date, sequence, resource_owner, instance_id, client_id, device_code, user_code, expires, scopes, state) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"ro-id",
+								"instance-id",
+								"client-id",
+								"device-code",
+								"ABCD-EFGH",
+								anyArg{},
+								database.StringArray{"openid"},
+								domain.DeviceAuthStateInitiated,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceDenied",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(deviceauth.DeniedEventType),
+					deviceauth.AggregateType,
+					nil,
+				), deviceauth.DeniedEventMapper),
+			},
+			reduce: (&deviceAuthProjection{}).reduceDenied,
+			want: wantReduce{
+				aggregateType:    deviceauth.AggregateType,
+				sequence:         15,
+				previousSequence: 10,
+				projection:       DeviceAuthProjectionTable,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.device_authorizations SET (change_date, sequence, state) = ($1, $2, $3) WHERE (id = $4) AND (instance_id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.DeviceAuthStateDenied,
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(deviceauth.RemovedEventType),
+					deviceauth.AggregateType,
+					nil,
+				), deviceauth.RemovedEventMapper),
+			},
+			reduce: (&deviceAuthProjection{}).reduceRemoved,
+			want: wantReduce{
+				aggregateType:    deviceauth.AggregateType,
+				sequence:         15,
+				previousSequence: 10,
+				projection:       DeviceAuthProjectionTable,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.device_authorizations WHERE (id = $1) AND (instance_id = $2)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if _, ok := err.(errors.InvalidArgument); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, tt.want)
+		})
+	}
+}
@@ -15,12 +15,18 @@ import (
 )
 
 const (
-	IDPTable     = "projections.idps2"
-	IDPOIDCTable = IDPTable + "_" + IDPOIDCSuffix
-	IDPJWTTable  = IDPTable + "_" + IDPJWTSuffix
+	IDPTable       = "projections.idps2"
+	IDPOIDCTable   = IDPTable + "_" + IDPOIDCSuffix
+	IDPJWTTable    = IDPTable + "_" + IDPJWTSuffix
+	IDPSAMLTable   = IDPTable + "_" + IDPSAMLSuffix
+	IDPLDAPTable   = IDPTable + "_" + IDPLDAPSuffix
+	IDPOAuth2Table = IDPTable + "_" + IDPOAuth2Suffix
 
-	IDPOIDCSuffix = "oidc_config"
-	IDPJWTSuffix  = "jwt_config"
+	IDPOIDCSuffix   = "oidc_config"
+	IDPJWTSuffix    = "jwt_config"
+	IDPSAMLSuffix   = "saml_config"
+	IDPLDAPSuffix   = "ldap_config"
+	IDPOAuth2Suffix = "oauth2_config"
 
 	IDPIDCol            = "id"
 	IDPCreationDateCol  = "creation_date"
@@ -52,6 +58,46 @@ const (
 	JWTConfigKeysEndpointCol = "keys_endpoint"
 	JWTConfigHeaderNameCol   = "header_name"
 	JWTConfigEndpointCol     = "endpoint"
+
+	SAMLConfigIDPIDCol             = "idp_id"
+	SAMLConfigInstanceIDCol        = "instance_id"
+	SAMLConfigMetadataCol          = "metadata"
+	SAMLConfigMetadataURLCol       = "metadata_url"
+	SAMLConfigKeyCol               = "key"
+	SAMLConfigCertificateCol       = "certificate"
+	SAMLConfigWithSignedRequestCol = "with_signed_request"
+
+	LDAPConfigIDPIDCol                      = "idp_id"
+	LDAPConfigInstanceIDCol                 = "instance_id"
+	LDAPConfigURLCol                        = "url"
+	LDAPConfigStartTLSCol                   = "start_tls"
+	LDAPConfigBaseDNCol                     = "base_dn"
+	LDAPConfigBindDNCol                     = "bind_dn"
+	LDAPConfigBindPasswordCol               = "bind_password"
+	LDAPConfigUserFilterCol                 = "user_filter"
+	LDAPConfigIDAttributeCol                = "id_attribute"
+	LDAPConfigPreferredUsernameAttributeCol = "preferred_username_attribute"
+	LDAPConfigFirstNameAttributeCol         = "first_name_attribute"
+	LDAPConfigLastNameAttributeCol          = "last_name_attribute"
+	LDAPConfigDisplayNameAttributeCol       = "display_name_attribute"
+	LDAPConfigEmailAttributeCol             = "email_attribute"
+	LDAPConfigPhoneAttributeCol             = "phone_attribute"
+
+	OAuth2ConfigIDPIDCol                 = "idp_id"
+	OAuth2ConfigInstanceIDCol            = "instance_id"
+	OAuth2ConfigClientIDCol              = "client_id"
+	OAuth2ConfigClientSecretCol          = "client_secret"
+	OAuth2ConfigAuthorizationEndpointCol = "authorization_endpoint"
+	OAuth2ConfigTokenEndpointCol         = "token_endpoint"
+	OAuth2ConfigUserEndpointCol          = "user_endpoint"
+	OAuth2ConfigScopesCol                = "scopes"
+	OAuth2ConfigIDPathCol                = "id_path"
+	OAuth2ConfigPreferredUsernamePathCol = "preferred_username_path"
+	OAuth2ConfigFirstNamePathCol         = "first_name_path"
+	OAuth2ConfigLastNamePathCol          = "last_name_path"
+	OAuth2ConfigDisplayNamePathCol       = "display_name_path"
+	OAuth2ConfigEmailPathCol             = "email_path"
+	OAuth2ConfigPhonePathCol             = "phone_path"
 )
 
 type idpProjection struct {
@@ -109,6 +155,61 @@ func newIDPProjection(ctx context.Context, config crdb.StatementHandlerConfig) *
 			IDPJWTSuffix,
 			crdb.WithForeignKey(crdb.NewForeignKeyOfPublicKeys("fk_jwt_ref_idp")),
 		),
+		crdb.NewSuffixedTable([]*crdb.Column{
+			crdb.NewColumn(SAMLConfigIDPIDCol, crdb.ColumnTypeText),
+			crdb.NewColumn(SAMLConfigInstanceIDCol, crdb.ColumnTypeText),
+			crdb.NewColumn(SAMLConfigMetadataCol, crdb.ColumnTypeBytes),
+			crdb.NewColumn(SAMLConfigMetadataURLCol, crdb.ColumnTypeText, crdb.Nullable()),
+			crdb.NewColumn(SAMLConfigKeyCol, crdb.ColumnTypeJSONB),
+			crdb.NewColumn(SAMLConfigCertificateCol, crdb.ColumnTypeBytes),
+			crdb.NewColumn(SAMLConfigWithSignedRequestCol, crdb.ColumnTypeBool, crdb.Default(false)),
+		},
+			crdb.NewPrimaryKey(SAMLConfigInstanceIDCol, SAMLConfigIDPIDCol),
+			IDPSAMLSuffix,
+			crdb.WithForeignKey(crdb.NewForeignKeyOfPublicKeys("fk_saml_ref_idp")),
+		),
+		crdb.NewSuffixedTable([]*crdb.Column{
+			crdb.NewColumn(LDAPConfigIDPIDCol, crdb.ColumnTypeText),
+			crdb.NewColumn(LDAPConfigInstanceIDCol, crdb.ColumnTypeText),
+			crdb.NewColumn(LDAPConfigURLCol, crdb.ColumnTypeText),
+			crdb.NewColumn(LDAPConfigStartTLSCol, crdb.ColumnTypeBool, crdb.Default(false)),
+			crdb.NewColumn(LDAPConfigBaseDNCol, crdb.ColumnTypeText),
+			crdb.NewColumn(LDAPConfigBindDNCol, crdb.ColumnTypeText, crdb.Nullable()),
+			crdb.NewColumn(LDAPConfigBindPasswordCol, crdb.ColumnTypeJSONB, crdb.Nullable()),
+			crdb.NewColumn(LDAPConfigUserFilterCol, crdb.ColumnTypeText),
+			crdb.NewColumn(LDAPConfigIDAttributeCol, crdb.ColumnTypeText),
+			crdb.NewColumn(LDAPConfigPreferredUsernameAttributeCol, crdb.ColumnTypeText, crdb.Nullable()),
+			crdb.NewColumn(LDAPConfigFirstNameAttributeCol, crdb.ColumnTypeText, crdb.Nullable()),
+			crdb.NewColumn(LDAPConfigLastNameAttributeCol, crdb.ColumnTypeText, crdb.Nullable()),
+			crdb.NewColumn(LDAPConfigDisplayNameAttributeCol, crdb.ColumnTypeText, crdb.Nullable()),
+			crdb.NewColumn(LDAPConfigEmailAttributeCol, crdb.ColumnTypeText, crdb.Nullable()),
+			crdb.NewColumn(LDAPConfigPhoneAttributeCol, crdb.ColumnTypeText, crdb.Nullable()),
+		},
+			crdb.NewPrimaryKey(LDAPConfigInstanceIDCol, LDAPConfigIDPIDCol),
+			IDPLDAPSuffix,
+			crdb.WithForeignKey(crdb.NewForeignKeyOfPublicKeys("fk_ldap_ref_idp")),
+		),
+		crdb.NewSuffixedTable([]*crdb.Column{
+			crdb.NewColumn(OAuth2ConfigIDPIDCol, crdb.ColumnTypeText),
+			crdb.NewColumn(OAuth2ConfigInstanceIDCol, crdb.ColumnTypeText),
+			crdb.NewColumn(OAuth2ConfigClientIDCol, crdb.ColumnTypeText),
+			crdb.NewColumn(OAuth2ConfigClientSecretCol, crdb.ColumnTypeJSONB),
+			crdb.NewColumn(OAuth2ConfigAuthorizationEndpointCol, crdb.ColumnTypeText),
+			crdb.NewColumn(OAuth2ConfigTokenEndpointCol, crdb.ColumnTypeText),
+			crdb.NewColumn(OAuth2ConfigUserEndpointCol, crdb.ColumnTypeText),
+			crdb.NewColumn(OAuth2ConfigScopesCol, crdb.ColumnTypeTextArray, crdb.Nullable()),
+			crdb.NewColumn(OAuth2ConfigIDPathCol, crdb.ColumnTypeText),
+			crdb.NewColumn(OAuth2ConfigPreferredUsernamePathCol, crdb.ColumnTypeText, crdb.Nullable()),
+			crdb.NewColumn(OAuth2ConfigFirstNamePathCol, crdb.ColumnTypeText, crdb.Nullable()),
+			crdb.NewColumn(OAuth2ConfigLastNamePathCol, crdb.ColumnTypeText, crdb.Nullable()),
+			crdb.NewColumn(OAuth2ConfigDisplayNamePathCol, crdb.ColumnTypeText, crdb.Nullable()),
+			crdb.NewColumn(OAuth2ConfigEmailPathCol, crdb.ColumnTypeText, crdb.Nullable()),
+			crdb.NewColumn(OAuth2ConfigPhonePathCol, crdb.ColumnTypeText, crdb.Nullable()),
+		},
+			crdb.NewPrimaryKey(OAuth2ConfigInstanceIDCol, OAuth2ConfigIDPIDCol),
+			IDPOAuth2Suffix,
+			crdb.WithForeignKey(crdb.NewForeignKeyOfPublicKeys("fk_oauth2_ref_idp")),
+		),
 	)
 	p.StatementHandler = crdb.NewStatementHandler(ctx, config)
 	return p
@@ -155,6 +256,30 @@ func (p *idpProjection) reducers() []handler.AggregateReducer {
 					Event:  instance.IDPJWTConfigChangedEventType,
 					Reduce: p.reduceJWTConfigChanged,
 				},
+				{
+					Event:  instance.IDPSAMLConfigAddedEventType,
+					Reduce: p.reduceSAMLConfigAdded,
+				},
+				{
+					Event:  instance.IDPSAMLConfigChangedEventType,
+					Reduce: p.reduceSAMLConfigChanged,
+				},
+				{
+					Event:  instance.IDPLDAPConfigAddedEventType,
+					Reduce: p.reduceLDAPConfigAdded,
+				},
+				{
+					Event:  instance.IDPLDAPConfigChangedEventType,
+					Reduce: p.reduceLDAPConfigChanged,
+				},
+				{
+					Event:  instance.IDPOAuth2ConfigAddedEventType,
+					Reduce: p.reduceOAuth2ConfigAdded,
+				},
+				{
+					Event:  instance.IDPOAuth2ConfigChangedEventType,
+					Reduce: p.reduceOAuth2ConfigChanged,
+				},
 			},
 		},
 		{
@@ -196,6 +321,30 @@ func (p *idpProjection) reducers() []handler.AggregateReducer {
 					Event:  org.IDPJWTConfigChangedEventType,
 					Reduce: p.reduceJWTConfigChanged,
 				},
+				{
+					Event:  org.IDPSAMLConfigAddedEventType,
+					Reduce: p.reduceSAMLConfigAdded,
+				},
+				{
+					Event:  org.IDPSAMLConfigChangedEventType,
+					Reduce: p.reduceSAMLConfigChanged,
+				},
+				{
+					Event:  org.IDPLDAPConfigAddedEventType,
+					Reduce: p.reduceLDAPConfigAdded,
+				},
+				{
+					Event:  org.IDPLDAPConfigChangedEventType,
+					Reduce: p.reduceLDAPConfigChanged,
+				},
+				{
+					Event:  org.IDPOAuth2ConfigAddedEventType,
+					Reduce: p.reduceOAuth2ConfigAdded,
+				},
+				{
+					Event:  org.IDPOAuth2ConfigChangedEventType,
+					Reduce: p.reduceOAuth2ConfigChanged,
+				},
 			},
 		},
 	}
@@ -537,3 +686,346 @@ func (p *idpProjection) reduceJWTConfigChanged(event eventstore.Event) (*handler
 		),
 	), nil
 }
+
+func (p *idpProjection) reduceSAMLConfigAdded(event eventstore.Event) (*handler.Statement, error) {
+	var idpEvent idpconfig.SAMLConfigAddedEvent
+	switch e := event.(type) {
+	case *org.IDPSAMLConfigAddedEvent:
+		idpEvent = e.SAMLConfigAddedEvent
+	case *instance.IDPSAMLConfigAddedEvent:
+		idpEvent = e.SAMLConfigAddedEvent
+	default:
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-9s0fK", "reduce.wrong.event.type %v", []eventstore.EventType{org.IDPSAMLConfigAddedEventType, instance.IDPSAMLConfigAddedEventType})
+	}
+
+	return crdb.NewMultiStatement(&idpEvent,
+		crdb.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(IDPChangeDateCol, idpEvent.CreationDate()),
+				handler.NewCol(IDPSequenceCol, idpEvent.Sequence()),
+				handler.NewCol(IDPTypeCol, domain.IDPConfigTypeSAML),
+			},
+			[]handler.Condition{
+				handler.NewCond(IDPIDCol, idpEvent.IDPConfigID),
+				handler.NewCond(IDPInstanceIDCol, idpEvent.Aggregate().InstanceID),
+			},
+		),
+		crdb.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(SAMLConfigIDPIDCol, idpEvent.IDPConfigID),
+				handler.NewCol(SAMLConfigInstanceIDCol, idpEvent.Aggregate().InstanceID),
+				handler.NewCol(SAMLConfigMetadataCol, idpEvent.Metadata),
+				handler.NewCol(SAMLConfigMetadataURLCol, idpEvent.MetadataURL),
+				handler.NewCol(SAMLConfigKeyCol, idpEvent.Key),
+				handler.NewCol(SAMLConfigCertificateCol, idpEvent.Certificate),
+				handler.NewCol(SAMLConfigWithSignedRequestCol, idpEvent.WithSignedRequest),
+			},
+			crdb.WithTableSuffix(IDPSAMLSuffix),
+		),
+	), nil
+}
+
+func (p *idpProjection) reduceSAMLConfigChanged(event eventstore.Event) (*handler.Statement, error) {
+	var idpEvent idpconfig.SAMLConfigChangedEvent
+	switch e := event.(type) {
+	case *org.IDPSAMLConfigChangedEvent:
+		idpEvent = e.SAMLConfigChangedEvent
+	case *instance.IDPSAMLConfigChangedEvent:
+		idpEvent = e.SAMLConfigChangedEvent
+	default:
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-2mf9S", "reduce.wrong.event.type %v", []eventstore.EventType{org.IDPSAMLConfigChangedEventType, instance.IDPSAMLConfigChangedEventType})
+	}
+
+	cols := make([]handler.Column, 0, 5)
+
+	if idpEvent.Metadata != nil {
+		cols = append(cols, handler.NewCol(SAMLConfigMetadataCol, idpEvent.Metadata))
+	}
+	if idpEvent.MetadataURL != nil {
+		cols = append(cols, handler.NewCol(SAMLConfigMetadataURLCol, *idpEvent.MetadataURL))
+	}
+	if idpEvent.Key != nil {
+		cols = append(cols, handler.NewCol(SAMLConfigKeyCol, idpEvent.Key))
+	}
+	if idpEvent.Certificate != nil {
+		cols = append(cols, handler.NewCol(SAMLConfigCertificateCol, idpEvent.Certificate))
+	}
+	if idpEvent.WithSignedRequest != nil {
+		cols = append(cols, handler.NewCol(SAMLConfigWithSignedRequestCol, *idpEvent.WithSignedRequest))
+	}
+
+	if len(cols) == 0 {
+		return crdb.NewNoOpStatement(&idpEvent), nil
+	}
+
+	return crdb.NewMultiStatement(&idpEvent,
+		crdb.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(IDPChangeDateCol, idpEvent.CreationDate()),
+				handler.NewCol(IDPSequenceCol, idpEvent.Sequence()),
+			},
+			[]handler.Condition{
+				handler.NewCond(IDPIDCol, idpEvent.IDPConfigID),
+				handler.NewCond(IDPInstanceIDCol, idpEvent.Aggregate().InstanceID),
+			},
+		),
+		crdb.AddUpdateStatement(
+			cols,
+			[]handler.Condition{
+				handler.NewCond(SAMLConfigIDPIDCol, idpEvent.IDPConfigID),
+				handler.NewCond(SAMLConfigInstanceIDCol, idpEvent.Aggregate().InstanceID),
+			},
+			crdb.WithTableSuffix(IDPSAMLSuffix),
+		),
+	), nil
+}
+
+func (p *idpProjection) reduceLDAPConfigAdded(event eventstore.Event) (*handler.Statement, error) {
+	var idpEvent idpconfig.LDAPConfigAddedEvent
+	switch e := event.(type) {
+	case *org.IDPLDAPConfigAddedEvent:
+		idpEvent = e.LDAPConfigAddedEvent
+	case *instance.IDPLDAPConfigAddedEvent:
+		idpEvent = e.LDAPConfigAddedEvent
+	default:
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Lr8qw", "reduce.wrong.event.type %v", []eventstore.EventType{org.IDPLDAPConfigAddedEventType, instance.IDPLDAPConfigAddedEventType})
+	}
+
+	return crdb.NewMultiStatement(&idpEvent,
+		crdb.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(IDPChangeDateCol, idpEvent.CreationDate()),
+				handler.NewCol(IDPSequenceCol, idpEvent.Sequence()),
+				handler.NewCol(IDPTypeCol, domain.IDPConfigTypeLDAP),
+			},
+			[]handler.Condition{
+				handler.NewCond(IDPIDCol, idpEvent.IDPConfigID),
+				handler.NewCond(IDPInstanceIDCol, idpEvent.Aggregate().InstanceID),
+			},
+		),
+		crdb.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(LDAPConfigIDPIDCol, idpEvent.IDPConfigID),
+				handler.NewCol(LDAPConfigInstanceIDCol, idpEvent.Aggregate().InstanceID),
+				handler.NewCol(LDAPConfigURLCol, idpEvent.URL),
+				handler.NewCol(LDAPConfigStartTLSCol, idpEvent.StartTLS),
+				handler.NewCol(LDAPConfigBaseDNCol, idpEvent.BaseDN),
+				handler.NewCol(LDAPConfigBindDNCol, idpEvent.BindDN),
+				handler.NewCol(LDAPConfigBindPasswordCol, idpEvent.BindPassword),
+				handler.NewCol(LDAPConfigUserFilterCol, idpEvent.UserFilter),
+				handler.NewCol(LDAPConfigIDAttributeCol, idpEvent.IDAttribute),
+				handler.NewCol(LDAPConfigPreferredUsernameAttributeCol, idpEvent.PreferredUsernameAttribute),
+				handler.NewCol(LDAPConfigFirstNameAttributeCol, idpEvent.FirstNameAttribute),
+				handler.NewCol(LDAPConfigLastNameAttributeCol, idpEvent.LastNameAttribute),
+				handler.NewCol(LDAPConfigDisplayNameAttributeCol, idpEvent.DisplayNameAttribute),
+				handler.NewCol(LDAPConfigEmailAttributeCol, idpEvent.EmailAttribute),
+				handler.NewCol(LDAPConfigPhoneAttributeCol, idpEvent.PhoneAttribute),
+			},
+			crdb.WithTableSuffix(IDPLDAPSuffix),
+		),
+	), nil
+}
+
+func (p *idpProjection) reduceLDAPConfigChanged(event eventstore.Event) (*handler.Statement, error) {
+	var idpEvent idpconfig.LDAPConfigChangedEvent
+	switch e := event.(type) {
+	case *org.IDPLDAPConfigChangedEvent:
+		idpEvent = e.LDAPConfigChangedEvent
+	case *instance.IDPLDAPConfigChangedEvent:
+		idpEvent = e.LDAPConfigChangedEvent
+	default:
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Lc2nv", "reduce.wrong.event.type %v", []eventstore.EventType{org.IDPLDAPConfigChangedEventType, instance.IDPLDAPConfigChangedEventType})
+	}
+
+	cols := make([]handler.Column, 0, 13)
+
+	if idpEvent.URL != nil {
+		cols = append(cols, handler.NewCol(LDAPConfigURLCol, *idpEvent.URL))
+	}
+	if idpEvent.StartTLS != nil {
+		cols = append(cols, handler.NewCol(LDAPConfigStartTLSCol, *idpEvent.StartTLS))
+	}
+	if idpEvent.BaseDN != nil {
+		cols = append(cols, handler.NewCol(LDAPConfigBaseDNCol, *idpEvent.BaseDN))
+	}
+	if idpEvent.BindDN != nil {
+		cols = append(cols, handler.NewCol(LDAPConfigBindDNCol, *idpEvent.BindDN))
+	}
+	if idpEvent.BindPassword != nil {
+		cols = append(cols, handler.NewCol(LDAPConfigBindPasswordCol, idpEvent.BindPassword))
+	}
+	if idpEvent.UserFilter != nil {
+		cols = append(cols, handler.NewCol(LDAPConfigUserFilterCol, *idpEvent.UserFilter))
+	}
+	if idpEvent.IDAttribute != nil {
+		cols = append(cols, handler.NewCol(LDAPConfigIDAttributeCol, *idpEvent.IDAttribute))
+	}
+	if idpEvent.PreferredUsernameAttribute != nil {
+		cols = append(cols, handler.NewCol(LDAPConfigPreferredUsernameAttributeCol, *idpEvent.PreferredUsernameAttribute))
+	}
+	if idpEvent.FirstNameAttribute != nil {
+		cols = append(cols, handler.NewCol(LDAPConfigFirstNameAttributeCol, *idpEvent.FirstNameAttribute))
+	}
+	if idpEvent.LastNameAttribute != nil {
+		cols = append(cols, handler.NewCol(LDAPConfigLastNameAttributeCol, *idpEvent.LastNameAttribute))
+	}
+	if idpEvent.DisplayNameAttribute != nil {
+		cols = append(cols, handler.NewCol(LDAPConfigDisplayNameAttributeCol, *idpEvent.DisplayNameAttribute))
+	}
+	if idpEvent.EmailAttribute != nil {
+		cols = append(cols, handler.NewCol(LDAPConfigEmailAttributeCol, *idpEvent.EmailAttribute))
+	}
+	if idpEvent.PhoneAttribute != nil {
+		cols = append(cols, handler.NewCol(LDAPConfigPhoneAttributeCol, *idpEvent.PhoneAttribute))
+	}
+
+	if len(cols) == 0 {
+		return crdb.NewNoOpStatement(&idpEvent), nil
+	}
+
+	return crdb.NewMultiStatement(&idpEvent,
+		crdb.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(IDPChangeDateCol, idpEvent.CreationDate()),
+				handler.NewCol(IDPSequenceCol, idpEvent.Sequence()),
+			},
+			[]handler.Condition{
+				handler.NewCond(IDPIDCol, idpEvent.IDPConfigID),
+				handler.NewCond(IDPInstanceIDCol, idpEvent.Aggregate().InstanceID),
+			},
+		),
+		crdb.AddUpdateStatement(
+			cols,
+			[]handler.Condition{
+				handler.NewCond(LDAPConfigIDPIDCol, idpEvent.IDPConfigID),
+				handler.NewCond(LDAPConfigInstanceIDCol, idpEvent.Aggregate().InstanceID),
+			},
+			crdb.WithTableSuffix(IDPLDAPSuffix),
+		),
+	), nil
+}
+
+func (p *idpProjection) reduceOAuth2ConfigAdded(event eventstore.Event) (*handler.Statement, error) {
+	var idpEvent idpconfig.OAuth2ConfigAddedEvent
+	switch e := event.(type) {
+	case *org.IDPOAuth2ConfigAddedEvent:
+		idpEvent = e.OAuth2ConfigAddedEvent
+	case *instance.IDPOAuth2ConfigAddedEvent:
+		idpEvent = e.OAuth2ConfigAddedEvent
+	default:
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Oa3kd", "reduce.wrong.event.type %v", []eventstore.EventType{org.IDPOAuth2ConfigAddedEventType, instance.IDPOAuth2ConfigAddedEventType})
+	}
+
+	return crdb.NewMultiStatement(&idpEvent,
+		crdb.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(IDPChangeDateCol, idpEvent.CreationDate()),
+				handler.NewCol(IDPSequenceCol, idpEvent.Sequence()),
+				handler.NewCol(IDPTypeCol, domain.IDPConfigTypeOAuth2),
+			},
+			[]handler.Condition{
+				handler.NewCond(IDPIDCol, idpEvent.IDPConfigID),
+				handler.NewCond(IDPInstanceIDCol, idpEvent.Aggregate().InstanceID),
+			},
+		),
+		crdb.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(OAuth2ConfigIDPIDCol, idpEvent.IDPConfigID),
+				handler.NewCol(OAuth2ConfigInstanceIDCol, idpEvent.Aggregate().InstanceID),
+				handler.NewCol(OAuth2ConfigClientIDCol, idpEvent.ClientID),
+				handler.NewCol(OAuth2ConfigClientSecretCol, idpEvent.ClientSecret),
+				handler.NewCol(OAuth2ConfigAuthorizationEndpointCol, idpEvent.AuthorizationEndpoint),
+				handler.NewCol(OAuth2ConfigTokenEndpointCol, idpEvent.TokenEndpoint),
+				handler.NewCol(OAuth2ConfigUserEndpointCol, idpEvent.UserEndpoint),
+				handler.NewCol(OAuth2ConfigScopesCol, database.StringArray(idpEvent.Scopes)),
+				handler.NewCol(OAuth2ConfigIDPathCol, idpEvent.IDPath),
+				handler.NewCol(OAuth2ConfigPreferredUsernamePathCol, idpEvent.PreferredUsernamePath),
+				handler.NewCol(OAuth2ConfigFirstNamePathCol, idpEvent.FirstNamePath),
+				handler.NewCol(OAuth2ConfigLastNamePathCol, idpEvent.LastNamePath),
+				handler.NewCol(OAuth2ConfigDisplayNamePathCol, idpEvent.DisplayNamePath),
+				handler.NewCol(OAuth2ConfigEmailPathCol, idpEvent.EmailPath),
+				handler.NewCol(OAuth2ConfigPhonePathCol, idpEvent.PhonePath),
+			},
+			crdb.WithTableSuffix(IDPOAuth2Suffix),
+		),
+	), nil
+}
+
+func (p *idpProjection) reduceOAuth2ConfigChanged(event eventstore.Event) (*handler.Statement, error) {
+	var idpEvent idpconfig.OAuth2ConfigChangedEvent
+	switch e := event.(type) {
+	case *org.IDPOAuth2ConfigChangedEvent:
+		idpEvent = e.OAuth2ConfigChangedEvent
+	case *instance.IDPOAuth2ConfigChangedEvent:
+		idpEvent = e.OAuth2ConfigChangedEvent
+	default:
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Oc8mz", "reduce.wrong.event.type %v", []eventstore.EventType{org.IDPOAuth2ConfigChangedEventType, instance.IDPOAuth2ConfigChangedEventType})
+	}
+
+	cols := make([]handler.Column, 0, 13)
+
+	if idpEvent.ClientID != nil {
+		cols = append(cols, handler.NewCol(OAuth2ConfigClientIDCol, *idpEvent.ClientID))
+	}
+	if idpEvent.ClientSecret != nil {
+		cols = append(cols, handler.NewCol(OAuth2ConfigClientSecretCol, idpEvent.ClientSecret))
+	}
+	if idpEvent.AuthorizationEndpoint != nil {
+		cols = append(cols, handler.NewCol(OAuth2ConfigAuthorizationEndpointCol, *idpEvent.AuthorizationEndpoint))
+	}
+	if idpEvent.TokenEndpoint != nil {
+		cols = append(cols, handler.NewCol(OAuth2ConfigTokenEndpointCol, *idpEvent.TokenEndpoint))
+	}
+	if idpEvent.UserEndpoint != nil {
+		cols = append(cols, handler.NewCol(OAuth2ConfigUserEndpointCol, *idpEvent.UserEndpoint))
+	}
+	if idpEvent.Scopes != nil {
+		cols = append(cols, handler.NewCol(OAuth2ConfigScopesCol, database.StringArray(idpEvent.Scopes)))
+	}
+	if idpEvent.IDPath != nil {
+		cols = append(cols, handler.NewCol(OAuth2ConfigIDPathCol, *idpEvent.IDPath))
+	}
+	if idpEvent.PreferredUsernamePath != nil {
+		cols = append(cols, handler.NewCol(OAuth2ConfigPreferredUsernamePathCol, *idpEvent.PreferredUsernamePath))
+	}
+	if idpEvent.FirstNamePath != nil {
+		cols = append(cols, handler.NewCol(OAuth2ConfigFirstNamePathCol, *idpEvent.FirstNamePath))
+	}
+	if idpEvent.LastNamePath != nil {
+		cols = append(cols, handler.NewCol(OAuth2ConfigLastNamePathCol, *idpEvent.LastNamePath))
+	}
+	if idpEvent.DisplayNamePath != nil {
+		cols = append(cols, handler.NewCol(OAuth2ConfigDisplayNamePathCol, *idpEvent.DisplayNamePath))
+	}
+	if idpEvent.EmailPath != nil {
+		cols = append(cols, handler.NewCol(OAuth2ConfigEmailPathCol, *idpEvent.EmailPath))
+	}
+	if idpEvent.PhonePath != nil {
+		cols = append(cols, handler.NewCol(OAuth2ConfigPhonePathCol, *idpEvent.PhonePath))
+	}
+
+	if len(cols) == 0 {
+		return crdb.NewNoOpStatement(&idpEvent), nil
+	}
+
+	return crdb.NewMultiStatement(&idpEvent,
+		crdb.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(IDPChangeDateCol, idpEvent.CreationDate()),
+				handler.NewCol(IDPSequenceCol, idpEvent.Sequence()),
+			},
+			[]handler.Condition{
+				handler.NewCond(IDPIDCol, idpEvent.IDPConfigID),
+				handler.NewCond(IDPInstanceIDCol, idpEvent.Aggregate().InstanceID),
+			},
+		),
+		crdb.AddUpdateStatement(
+			cols,
+			[]handler.Condition{
+				handler.NewCond(OAuth2ConfigIDPIDCol, idpEvent.IDPConfigID),
+				handler.NewCond(OAuth2ConfigInstanceIDCol, idpEvent.Aggregate().InstanceID),
+			},
+			crdb.WithTableSuffix(IDPOAuth2Suffix),
+		),
+	), nil
+}
@@ -454,6 +454,392 @@ func TestIDPProjection_reduces(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "instance.reduceSAMLConfigAdded",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(instance.IDPSAMLConfigAddedEventType),
+					instance.AggregateType,
+					[]byte(`{
+	"idpConfigId": "idp-config-id",
+	"metadata": "bWV0YWRhdGE=",
+	"metadataUrl": "https://idp.zitadel.ch/saml/metadata",
+	"key": {
+        "cryptoType": 0,
+        "algorithm": "RSA-265",
+        "keyId": "key-id"
+    },
+	"certificate": "Y2VydGlmaWNhdGU=",
+	"withSignedRequest": true
+}`),
+				), instance.IDPSAMLConfigAddedEventMapper),
+			},
+			reduce: (&idpProjection{}).reduceSAMLConfigAdded,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("instance"),
+				sequence:         15,
+				previousSequence: 10,
+				projection:       IDPTable,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.idps2 SET (change_date, sequence, type) = ($1, $2, $3) WHERE (id = $4) AND (instance_id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.IDPConfigTypeSAML,
+								"idp-config-id",
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "INSERT INTO projections.idps2_saml_config (idp_id, instance_id, metadata, metadata_url, key, certificate, with_signed_request) VALUES ($1, $2, $3, $4, $5, $6, $7)",
+							expectedArgs: []interface{}{
+								"idp-config-id",
+								"instance-id",
+								[]byte("metadata"),
+								"https://idp.zitadel.ch/saml/metadata",
+								anyArg{},
+								[]byte("certificate"),
+								true,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance.reduceSAMLConfigChanged",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(instance.IDPSAMLConfigChangedEventType),
+					instance.AggregateType,
+					[]byte(`{
+	"idpConfigId": "idp-config-id",
+	"metadata": "bWV0YWRhdGE=",
+	"metadataUrl": "https://idp.zitadel.ch/saml/metadata",
+	"withSignedRequest": false
+}`),
+				), instance.IDPSAMLConfigChangedEventMapper),
+			},
+			reduce: (&idpProjection{}).reduceSAMLConfigChanged,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("instance"),
+				sequence:         15,
+				previousSequence: 10,
+				projection:       IDPTable,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.idps2 SET (change_date, sequence) = ($1, $2) WHERE (id = $3) AND (instance_id = $4)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"idp-config-id",
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.idps2_saml_config SET (metadata, metadata_url, with_signed_request) = ($1, $2, $3) WHERE (idp_id = $4) AND (instance_id = $5)",
+							expectedArgs: []interface{}{
+								[]byte("metadata"),
+								"https://idp.zitadel.ch/saml/metadata",
+								false,
+								"idp-config-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance.reduceSAMLConfigChanged: no op",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(instance.IDPSAMLConfigChangedEventType),
+					instance.AggregateType,
+					[]byte(`{}`),
+				), instance.IDPSAMLConfigChangedEventMapper),
+			},
+			reduce: (&idpProjection{}).reduceSAMLConfigChanged,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("instance"),
+				sequence:         15,
+				previousSequence: 10,
+				projection:       IDPTable,
+				executer: &testExecuter{
+					executions: []execution{},
+				},
+			},
+		},
+		{
+			name: "instance.reduceLDAPConfigAdded",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(instance.IDPLDAPConfigAddedEventType),
+					instance.AggregateType,
+					[]byte(`{
+	"idpConfigId": "idp-config-id",
+	"url": "ldaps://ldap.zitadel.ch",
+	"startTls": false,
+	"baseDn": "dc=zitadel,dc=ch",
+	"bindDn": "cn=admin,dc=zitadel,dc=ch",
+	"bindPassword": {
+        "cryptoType": 0,
+        "algorithm": "RSA-265",
+        "keyId": "key-id"
+    },
+	"userFilter": "(uid=%s)",
+	"idAttribute": "uid",
+	"preferredUsernameAttribute": "uid",
+	"firstNameAttribute": "givenName",
+	"lastNameAttribute": "sn",
+	"displayNameAttribute": "cn",
+	"emailAttribute": "mail",
+	"phoneAttribute": "telephoneNumber"
+}`),
+				), instance.IDPLDAPConfigAddedEventMapper),
+			},
+			reduce: (&idpProjection{}).reduceLDAPConfigAdded,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("instance"),
+				sequence:         15,
+				previousSequence: 10,
+				projection:       IDPTable,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.idps2 SET (change_date, sequence, type) = ($1, $2, $3) WHERE (id = $4) AND (instance_id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.IDPConfigTypeLDAP,
+								"idp-config-id",
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "INSERT INTO projections.idps2_ldap_config (idp_id, instance_id, url, start_tls, base_dn, bind_dn, bind_password, user_filter, id_attribute, preferred_username_attribute, first_name_attribute, last_name_attribute, display_name_attribute, email_attribute, phone_attribute) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)",
+							expectedArgs: []interface{}{
+								"idp-config-id",
+								"instance-id",
+								"ldaps://ldap.zitadel.ch",
+								false,
+								"dc=zitadel,dc=ch",
+								"cn=admin,dc=zitadel,dc=ch",
+								anyArg{},
+								"(uid=%s)",
+								"uid",
+								"uid",
+								"givenName",
+								"sn",
+								"cn",
+								"mail",
+								"telephoneNumber",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance.reduceLDAPConfigChanged",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(instance.IDPLDAPConfigChangedEventType),
+					instance.AggregateType,
+					[]byte(`{
+	"idpConfigId": "idp-config-id",
+	"url": "ldap://ldap.zitadel.ch",
+	"startTls": true,
+	"emailAttribute": "email"
+}`),
+				), instance.IDPLDAPConfigChangedEventMapper),
+			},
+			reduce: (&idpProjection{}).reduceLDAPConfigChanged,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("instance"),
+				sequence:         15,
+				previousSequence: 10,
+				projection:       IDPTable,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.idps2 SET (change_date, sequence) = ($1, $2) WHERE (id = $3) AND (instance_id = $4)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"idp-config-id",
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.idps2_ldap_config SET (url, start_tls, email_attribute) = ($1, $2, $3) WHERE (idp_id = $4) AND (instance_id = $5)",
+							expectedArgs: []interface{}{
+								"ldap://ldap.zitadel.ch",
+								true,
+								"email",
+								"idp-config-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance.reduceLDAPConfigChanged: no op",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(instance.IDPLDAPConfigChangedEventType),
+					instance.AggregateType,
+					[]byte(`{}`),
+				), instance.IDPLDAPConfigChangedEventMapper),
+			},
+			reduce: (&idpProjection{}).reduceLDAPConfigChanged,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("instance"),
+				sequence:         15,
+				previousSequence: 10,
+				projection:       IDPTable,
+				executer: &testExecuter{
+					executions: []execution{},
+				},
+			},
+		},
+		{
+			name: "instance.reduceOAuth2ConfigAdded",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(instance.IDPOAuth2ConfigAddedEventType),
+					instance.AggregateType,
+					[]byte(`{
+	"idpConfigId": "idp-config-id",
+	"clientId": "client-id",
+	"clientSecret": {
+        "cryptoType": 0,
+        "algorithm": "RSA-265",
+        "keyId": "key-id"
+    },
+	"authorizationEndpoint": "https://github.com/login/oauth/authorize",
+	"tokenEndpoint": "https://github.com/login/oauth/access_token",
+	"userEndpoint": "https://api.github.com/user",
+	"scopes": ["read:user", "user:email"],
+	"idPath": "id",
+	"preferredUsernamePath": "login",
+	"displayNamePath": "name",
+	"emailPath": "email"
+}`),
+				), instance.IDPOAuth2ConfigAddedEventMapper),
+			},
+			reduce: (&idpProjection{}).reduceOAuth2ConfigAdded,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("instance"),
+				sequence:         15,
+				previousSequence: 10,
+				projection:       IDPTable,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.idps2 SET (change_date, sequence, type) = ($1, $2, $3) WHERE (id = $4) AND (instance_id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.IDPConfigTypeOAuth2,
+								"idp-config-id",
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "INSERT INTO projections.idps2_oauth2_config (idp_id, instance_id, client_id, client_secret, authorization_endpoint, token_endpoint, user_endpoint, scopes, id_path, preferred_username_path, first_name_path, last_name_path, display_name_path, email_path, phone_path) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)",
+							expectedArgs: []interface{}{
+								"idp-config-id",
+								"instance-id",
+								"client-id",
+								anyArg{},
+								"https://github.com/login/oauth/authorize",
+								"https://github.com/login/oauth/access_token",
+								"https://api.github.com/user",
+								database.StringArray{"read:user", "user:email"},
+								"id",
+								"login",
+								"",
+								"",
+								"name",
+								"email",
+								"",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance.reduceOAuth2ConfigChanged",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(instance.IDPOAuth2ConfigChangedEventType),
+					instance.AggregateType,
+					[]byte(`{
+	"idpConfigId": "idp-config-id",
+	"userEndpoint": "https://api.github.com/users/me",
+	"scopes": ["read:user"],
+	"emailPath": "emails.0.email"
+}`),
+				), instance.IDPOAuth2ConfigChangedEventMapper),
+			},
+			reduce: (&idpProjection{}).reduceOAuth2ConfigChanged,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("instance"),
+				sequence:         15,
+				previousSequence: 10,
+				projection:       IDPTable,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.idps2 SET (change_date, sequence) = ($1, $2) WHERE (id = $3) AND (instance_id = $4)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"idp-config-id",
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.idps2_oauth2_config SET (user_endpoint, scopes, email_path) = ($1, $2, $3) WHERE (idp_id = $4) AND (instance_id = $5)",
+							expectedArgs: []interface{}{
+								"https://api.github.com/users/me",
+								database.StringArray{"read:user"},
+								"emails.0.email",
+								"idp-config-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance.reduceOAuth2ConfigChanged: no op",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(instance.IDPOAuth2ConfigChangedEventType),
+					instance.AggregateType,
+					[]byte(`{}`),
+				), instance.IDPOAuth2ConfigChangedEventMapper),
+			},
+			reduce: (&idpProjection{}).reduceOAuth2ConfigChanged,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("instance"),
+				sequence:         15,
+				previousSequence: 10,
+				projection:       IDPTable,
+				executer: &testExecuter{
+					executions: []execution{},
+				},
+			},
+		},
 		{
 			name: "org.reduceIDPAdded",
 			args: args{
@@ -885,6 +1271,125 @@ func TestIDPProjection_reduces(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "org.reduceSAMLConfigAdded",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(org.IDPSAMLConfigAddedEventType),
+					org.AggregateType,
+					[]byte(`{
+	"idpConfigId": "idp-config-id",
+	"metadata": "bWV0YWRhdGE=",
+	"metadataUrl": "https://idp.zitadel.ch/saml/metadata",
+	"key": {
+        "cryptoType": 0,
+        "algorithm": "RSA-265",
+        "keyId": "key-id"
+    },
+	"certificate": "Y2VydGlmaWNhdGU=",
+	"withSignedRequest": true
+}`),
+				), org.IDPSAMLConfigAddedEventMapper),
+			},
+			reduce: (&idpProjection{}).reduceSAMLConfigAdded,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("org"),
+				sequence:         15,
+				previousSequence: 10,
+				projection:       IDPTable,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.idps2 SET (change_date, sequence, type) = ($1, $2, $3) WHERE (id = $4) AND (instance_id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.IDPConfigTypeSAML,
+								"idp-config-id",
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "INSERT INTO projections.idps2_saml_config (idp_id, instance_id, metadata, metadata_url, key, certificate, with_signed_request) VALUES ($1, $2, $3, $4, $5, $6, $7)",
+							expectedArgs: []interface{}{
+								"idp-config-id",
+								"instance-id",
+								[]byte("metadata"),
+								"https://idp.zitadel.ch/saml/metadata",
+								anyArg{},
+								[]byte("certificate"),
+								true,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "org.reduceSAMLConfigChanged",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(org.IDPSAMLConfigChangedEventType),
+					org.AggregateType,
+					[]byte(`{
+	"idpConfigId": "idp-config-id",
+	"metadata": "bWV0YWRhdGE=",
+	"metadataUrl": "https://idp.zitadel.ch/saml/metadata",
+	"withSignedRequest": false
+}`),
+				), org.IDPSAMLConfigChangedEventMapper),
+			},
+			reduce: (&idpProjection{}).reduceSAMLConfigChanged,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("org"),
+				sequence:         15,
+				previousSequence: 10,
+				projection:       IDPTable,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.idps2 SET (change_date, sequence) = ($1, $2) WHERE (id = $3) AND (instance_id = $4)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"idp-config-id",
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.idps2_saml_config SET (metadata, metadata_url, with_signed_request) = ($1, $2, $3) WHERE (idp_id = $4) AND (instance_id = $5)",
+							expectedArgs: []interface{}{
+								[]byte("metadata"),
+								"https://idp.zitadel.ch/saml/metadata",
+								false,
+								"idp-config-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "org.reduceSAMLConfigChanged: no op",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(org.IDPSAMLConfigChangedEventType),
+					org.AggregateType,
+					[]byte(`{}`),
+				), org.IDPSAMLConfigChangedEventMapper),
+			},
+			reduce: (&idpProjection{}).reduceSAMLConfigChanged,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("org"),
+				sequence:         15,
+				previousSequence: 10,
+				projection:       IDPTable,
+				executer: &testExecuter{
+					executions: []execution{},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,131 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/eventstore"
+	"github.com/dennigogo/zitadel/internal/eventstore/handler"
+	"github.com/dennigogo/zitadel/internal/eventstore/handler/crdb"
+	"github.com/dennigogo/zitadel/internal/repository/kvstore"
+	"github.com/dennigogo/zitadel/internal/repository/org"
+)
+
+const (
+	KeyValueProjectionTable = "projections.kv_store"
+
+	KeyValueColumnOrgID         = "org_id"
+	KeyValueColumnCreationDate  = "creation_date"
+	KeyValueColumnChangeDate    = "change_date"
+	KeyValueColumnSequence      = "sequence"
+	KeyValueColumnResourceOwner = "resource_owner"
+	KeyValueColumnInstanceID    = "instance_id"
+	KeyValueColumnKey           = "key"
+	KeyValueColumnValue         = "value"
+)
+
+type keyValueProjection struct {
+	crdb.StatementHandler
+}
+
+func newKeyValueProjection(ctx context.Context, config crdb.StatementHandlerConfig) *keyValueProjection {
+	p := new(keyValueProjection)
+	config.ProjectionName = KeyValueProjectionTable
+	config.Reducers = p.reducers()
+	config.InitCheck = crdb.NewTableCheck(
+		crdb.NewTable([]*crdb.Column{
+			crdb.NewColumn(KeyValueColumnOrgID, crdb.ColumnTypeText),
+			crdb.NewColumn(KeyValueColumnCreationDate, crdb.ColumnTypeTimestamp),
+			crdb.NewColumn(KeyValueColumnChangeDate, crdb.ColumnTypeTimestamp),
+			crdb.NewColumn(KeyValueColumnSequence, crdb.ColumnTypeInt64),
+			crdb.NewColumn(KeyValueColumnResourceOwner, crdb.ColumnTypeText),
+			crdb.NewColumn(KeyValueColumnInstanceID, crdb.ColumnTypeText),
+			crdb.NewColumn(KeyValueColumnKey, crdb.ColumnTypeText),
+			crdb.NewColumn(KeyValueColumnValue, crdb.ColumnTypeBytes),
+		},
+			crdb.NewPrimaryKey(KeyValueColumnInstanceID, KeyValueColumnOrgID, KeyValueColumnKey),
+		),
+	)
+
+	p.StatementHandler = crdb.NewStatementHandler(ctx, config)
+	return p
+}
+
+func (p *keyValueProjection) reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: kvstore.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  kvstore.EntrySetEventType,
+					Reduce: p.reduceEntrySet,
+				},
+				{
+					Event:  kvstore.EntryRemovedEventType,
+					Reduce: p.reduceEntryRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOrgRemoved,
+				},
+			},
+		},
+	}
+}
+
+func (p *keyValueProjection) reduceEntrySet(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*kvstore.EntrySetEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Kv3x7", "reduce.wrong.event.type %s", kvstore.EntrySetEventType)
+	}
+	return crdb.NewUpsertStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(KeyValueColumnInstanceID, nil),
+			handler.NewCol(KeyValueColumnOrgID, nil),
+			handler.NewCol(KeyValueColumnKey, e.Key),
+		},
+		[]handler.Column{
+			handler.NewCol(KeyValueColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCol(KeyValueColumnOrgID, e.Aggregate().ID),
+			handler.NewCol(KeyValueColumnKey, e.Key),
+			handler.NewCol(KeyValueColumnResourceOwner, e.Aggregate().ResourceOwner),
+			handler.NewCol(KeyValueColumnCreationDate, e.CreationDate()),
+			handler.NewCol(KeyValueColumnChangeDate, e.CreationDate()),
+			handler.NewCol(KeyValueColumnSequence, e.Sequence()),
+			handler.NewCol(KeyValueColumnValue, e.Value),
+		},
+	), nil
+}
+
+func (p *keyValueProjection) reduceEntryRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*kvstore.EntryRemovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Kv9w2", "reduce.wrong.event.type %s", kvstore.EntryRemovedEventType)
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(KeyValueColumnOrgID, e.Aggregate().ID),
+			handler.NewCond(KeyValueColumnKey, e.Key),
+		},
+	), nil
+}
+
+func (p *keyValueProjection) reduceOrgRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgRemovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Kv4t6", "reduce.wrong.event.type %s", org.OrgRemovedEventType)
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(KeyValueColumnOrgID, e.Aggregate().ID),
+		},
+	), nil
+}
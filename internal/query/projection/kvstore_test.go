@@ -0,0 +1,132 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/eventstore"
+	"github.com/dennigogo/zitadel/internal/eventstore/handler"
+	"github.com/dennigogo/zitadel/internal/eventstore/repository"
+	"github.com/dennigogo/zitadel/internal/repository/kvstore"
+	"github.com/dennigogo/zitadel/internal/repository/org"
+)
+
+func TestKeyValueProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceEntrySet",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(kvstore.EntrySetEventType),
+					kvstore.AggregateType,
+					[]byte(`{
+						"key": "key",
+						"value": "dmFsdWU="
+					}`),
+				), kvstore.EntrySetEventMapper),
+			},
+			reduce: (&keyValueProjection{}).reduceEntrySet,
+			want: wantReduce{
+				aggregateType:    kvstore.AggregateType,
+				sequence:         15,
+				previousSequence: 10,
+				projection:       KeyValueProjectionTable,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.kv_store (instance_id, org_id, key, resource_owner, creation_date, change_date, sequence, value) VALUES ($1, $2, $3, $4, $5, $6, $7, $8) ON CONFLICT (instance_id, org_id, key) DO UPDATE SET (resource_owner, creation_date, change_date, sequence, value) = (EXCLUDED.resource_owner, EXCLUDED.creation_date, EXCLUDED.change_date, EXCLUDED.sequence, EXCLUDED.value)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								"key",
+								"ro-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								[]byte("value"),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceEntryRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(kvstore.EntryRemovedEventType),
+					kvstore.AggregateType,
+					[]byte(`{
+						"key": "key"
+					}`),
+				), kvstore.EntryRemovedEventMapper),
+			},
+			reduce: (&keyValueProjection{}).reduceEntryRemoved,
+			want: wantReduce{
+				aggregateType:    kvstore.AggregateType,
+				sequence:         15,
+				previousSequence: 10,
+				projection:       KeyValueProjectionTable,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.kv_store WHERE (org_id = $1) AND (key = $2)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"key",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceOrgRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(org.OrgRemovedEventType),
+					org.AggregateType,
+					nil,
+				), org.OrgRemovedEventMapper),
+			},
+			reduce: (&keyValueProjection{}).reduceOrgRemoved,
+			want: wantReduce{
+				aggregateType:    org.AggregateType,
+				sequence:         15,
+				previousSequence: 10,
+				projection:       KeyValueProjectionTable,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.kv_store WHERE (org_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if _, ok := err.(errors.InvalidArgument); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, tt.want)
+		})
+	}
+}
@@ -25,7 +25,12 @@ const (
 	LockoutPolicyResourceOwnerCol       = "resource_owner"
 	LockoutPolicyInstanceIDCol          = "instance_id"
 	LockoutPolicyMaxPasswordAttemptsCol = "max_password_attempts"
+	LockoutPolicyMaxOTPAttemptsCol      = "max_otp_attempts"
+	LockoutPolicyMaxU2FAttemptsCol      = "max_u2f_attempts"
 	LockoutPolicyShowLockOutFailuresCol = "show_failure"
+	LockoutPolicyAutoUnlockAfterCol     = "auto_unlock_after"
+	LockoutPolicyAttemptDelayCol        = "attempt_delay"
+	LockoutPolicyMaxAttemptDelayCol     = "max_attempt_delay"
 )
 
 type lockoutPolicyProjection struct {
@@ -48,6 +53,11 @@ func newLockoutPolicyProjection(ctx context.Context, config crdb.StatementHandle
 			crdb.NewColumn(LockoutPolicyInstanceIDCol, crdb.ColumnTypeText),
 			crdb.NewColumn(LockoutPolicyMaxPasswordAttemptsCol, crdb.ColumnTypeInt64),
 			crdb.NewColumn(LockoutPolicyShowLockOutFailuresCol, crdb.ColumnTypeBool),
+			crdb.NewColumn(LockoutPolicyMaxOTPAttemptsCol, crdb.ColumnTypeInt64, crdb.Default(0)),
+			crdb.NewColumn(LockoutPolicyMaxU2FAttemptsCol, crdb.ColumnTypeInt64, crdb.Default(0)),
+			crdb.NewColumn(LockoutPolicyAutoUnlockAfterCol, crdb.ColumnTypeInt64, crdb.Default(0)),
+			crdb.NewColumn(LockoutPolicyAttemptDelayCol, crdb.ColumnTypeInt64, crdb.Default(0)),
+			crdb.NewColumn(LockoutPolicyMaxAttemptDelayCol, crdb.ColumnTypeInt64, crdb.Default(0)),
 		},
 			crdb.NewPrimaryKey(LockoutPolicyInstanceIDCol, LockoutPolicyIDCol),
 		),
@@ -114,6 +124,11 @@ func (p *lockoutPolicyProjection) reduceAdded(event eventstore.Event) (*handler.
 			handler.NewCol(LockoutPolicyStateCol, domain.PolicyStateActive),
 			handler.NewCol(LockoutPolicyMaxPasswordAttemptsCol, policyEvent.MaxPasswordAttempts),
 			handler.NewCol(LockoutPolicyShowLockOutFailuresCol, policyEvent.ShowLockOutFailures),
+			handler.NewCol(LockoutPolicyMaxOTPAttemptsCol, policyEvent.MaxOTPAttempts),
+			handler.NewCol(LockoutPolicyMaxU2FAttemptsCol, policyEvent.MaxU2FAttempts),
+			handler.NewCol(LockoutPolicyAutoUnlockAfterCol, policyEvent.AutoUnlockAfter),
+			handler.NewCol(LockoutPolicyAttemptDelayCol, policyEvent.AttemptDelay),
+			handler.NewCol(LockoutPolicyMaxAttemptDelayCol, policyEvent.MaxAttemptDelay),
 			handler.NewCol(LockoutPolicyIsDefaultCol, isDefault),
 			handler.NewCol(LockoutPolicyResourceOwnerCol, policyEvent.Aggregate().ResourceOwner),
 			handler.NewCol(LockoutPolicyInstanceIDCol, policyEvent.Aggregate().InstanceID),
@@ -140,6 +155,21 @@ func (p *lockoutPolicyProjection) reduceChanged(event eventstore.Event) (*handle
 	if policyEvent.ShowLockOutFailures != nil {
 		cols = append(cols, handler.NewCol(LockoutPolicyShowLockOutFailuresCol, *policyEvent.ShowLockOutFailures))
 	}
+	if policyEvent.MaxOTPAttempts != nil {
+		cols = append(cols, handler.NewCol(LockoutPolicyMaxOTPAttemptsCol, *policyEvent.MaxOTPAttempts))
+	}
+	if policyEvent.MaxU2FAttempts != nil {
+		cols = append(cols, handler.NewCol(LockoutPolicyMaxU2FAttemptsCol, *policyEvent.MaxU2FAttempts))
+	}
+	if policyEvent.AutoUnlockAfter != nil {
+		cols = append(cols, handler.NewCol(LockoutPolicyAutoUnlockAfterCol, *policyEvent.AutoUnlockAfter))
+	}
+	if policyEvent.AttemptDelay != nil {
+		cols = append(cols, handler.NewCol(LockoutPolicyAttemptDelayCol, *policyEvent.AttemptDelay))
+	}
+	if policyEvent.MaxAttemptDelay != nil {
+		cols = append(cols, handler.NewCol(LockoutPolicyMaxAttemptDelayCol, *policyEvent.MaxAttemptDelay))
+	}
 	return crdb.NewUpdateStatement(
 		&policyEvent,
 		cols,
@@ -2,6 +2,7 @@ package projection
 
 import (
 	"testing"
+	"time"
 
 	"github.com/dennigogo/zitadel/internal/domain"
 	"github.com/dennigogo/zitadel/internal/errors"
@@ -30,7 +31,12 @@ func TestLockoutPolicyProjection_reduces(t *testing.T) {
 					org.AggregateType,
 					[]byte(`{
 						"maxPasswordAttempts": 10,
-						"showLockOutFailures": true
+						"maxOTPAttempts": 5,
+						"maxU2FAttempts": 3,
+						"showLockOutFailures": true,
+						"autoUnlockAfter": 3600000000000,
+						"attemptDelay": 1000000000,
+						"maxAttemptDelay": 60000000000
 }`),
 				), org.LockoutPolicyAddedEventMapper),
 			},
@@ -43,7 +49,7 @@ func TestLockoutPolicyProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "INSERT INTO projections.lockout_policies (creation_date, change_date, sequence, id, state, max_password_attempts, show_failure, is_default, resource_owner, instance_id) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)",
+							expectedStmt: "INSERT INTO projections.lockout_policies (creation_date, change_date, sequence, id, state, max_password_attempts, show_failure, max_otp_attempts, max_u2f_attempts, auto_unlock_after, attempt_delay, max_attempt_delay, is_default, resource_owner, instance_id) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)",
 							expectedArgs: []interface{}{
 								anyArg{},
 								anyArg{},
@@ -52,6 +58,11 @@ func TestLockoutPolicyProjection_reduces(t *testing.T) {
 								domain.PolicyStateActive,
 								uint64(10),
 								true,
+								uint64(5),
+								uint64(3),
+								time.Hour,
+								time.Second,
+								time.Minute,
 								false,
 								"ro-id",
 								"instance-id",
@@ -70,7 +81,8 @@ func TestLockoutPolicyProjection_reduces(t *testing.T) {
 					org.AggregateType,
 					[]byte(`{
 						"maxPasswordAttempts": 10,
-						"showLockOutFailures": true
+						"showLockOutFailures": true,
+						"autoUnlockAfter": 3600000000000
 		}`),
 				), org.LockoutPolicyChangedEventMapper),
 			},
@@ -82,12 +94,13 @@ func TestLockoutPolicyProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "UPDATE projections.lockout_policies SET (change_date, sequence, max_password_attempts, show_failure) = ($1, $2, $3, $4) WHERE (id = $5)",
+							expectedStmt: "UPDATE projections.lockout_policies SET (change_date, sequence, max_password_attempts, show_failure, auto_unlock_after) = ($1, $2, $3, $4, $5) WHERE (id = $6)",
 							expectedArgs: []interface{}{
 								anyArg{},
 								uint64(15),
 								uint64(10),
 								true,
+								time.Hour,
 								"agg-id",
 							},
 						},
@@ -143,7 +156,7 @@ func TestLockoutPolicyProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "INSERT INTO projections.lockout_policies (creation_date, change_date, sequence, id, state, max_password_attempts, show_failure, is_default, resource_owner, instance_id) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)",
+							expectedStmt: "INSERT INTO projections.lockout_policies (creation_date, change_date, sequence, id, state, max_password_attempts, show_failure, max_otp_attempts, max_u2f_attempts, auto_unlock_after, attempt_delay, max_attempt_delay, is_default, resource_owner, instance_id) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)",
 							expectedArgs: []interface{}{
 								anyArg{},
 								anyArg{},
@@ -152,6 +165,11 @@ func TestLockoutPolicyProjection_reduces(t *testing.T) {
 								domain.PolicyStateActive,
 								uint64(10),
 								true,
+								uint64(0),
+								uint64(0),
+								time.Duration(0),
+								time.Duration(0),
+								time.Duration(0),
 								true,
 								"ro-id",
 								"instance-id",
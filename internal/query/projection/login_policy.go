@@ -39,6 +39,7 @@ const (
 	MFAInitSkipLifetimeCol              = "mfa_init_skip_lifetime"
 	SecondFactorCheckLifetimeCol        = "second_factor_check_lifetime"
 	MultiFactorCheckLifetimeCol         = "multi_factor_check_lifetime"
+	TrustedDeviceLifetimeCol            = "trusted_device_lifetime"
 )
 
 type loginPolicyProjection struct {
@@ -75,6 +76,7 @@ func newLoginPolicyProjection(ctx context.Context, config crdb.StatementHandlerC
 			crdb.NewColumn(MFAInitSkipLifetimeCol, crdb.ColumnTypeInt64),
 			crdb.NewColumn(SecondFactorCheckLifetimeCol, crdb.ColumnTypeInt64),
 			crdb.NewColumn(MultiFactorCheckLifetimeCol, crdb.ColumnTypeInt64),
+			crdb.NewColumn(TrustedDeviceLifetimeCol, crdb.ColumnTypeInt64, crdb.Default(0)),
 		},
 			crdb.NewPrimaryKey(LoginPolicyInstanceIDCol, LoginPolicyIDCol),
 		),
@@ -187,6 +189,7 @@ func (p *loginPolicyProjection) reduceLoginPolicyAdded(event eventstore.Event) (
 		handler.NewCol(MFAInitSkipLifetimeCol, policyEvent.MFAInitSkipLifetime),
 		handler.NewCol(SecondFactorCheckLifetimeCol, policyEvent.SecondFactorCheckLifetime),
 		handler.NewCol(MultiFactorCheckLifetimeCol, policyEvent.MultiFactorCheckLifetime),
+		handler.NewCol(TrustedDeviceLifetimeCol, policyEvent.TrustedDeviceLifetime),
 	}), nil
 }
 
@@ -253,6 +256,9 @@ func (p *loginPolicyProjection) reduceLoginPolicyChanged(event eventstore.Event)
 	if policyEvent.MultiFactorCheckLifetime != nil {
 		cols = append(cols, handler.NewCol(MultiFactorCheckLifetimeCol, *policyEvent.MultiFactorCheckLifetime))
 	}
+	if policyEvent.TrustedDeviceLifetime != nil {
+		cols = append(cols, handler.NewCol(TrustedDeviceLifetimeCol, *policyEvent.TrustedDeviceLifetime))
+	}
 
 	return crdb.NewUpdateStatement(
 		&policyEvent,
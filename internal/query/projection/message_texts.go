@@ -240,7 +240,13 @@ func isMessageTemplate(template string) bool {
 		template == domain.VerifyEmailMessageType ||
 		template == domain.VerifyPhoneMessageType ||
 		template == domain.DomainClaimedMessageType ||
-		template == domain.PasswordlessRegistrationMessageType
+		template == domain.PasswordlessRegistrationMessageType ||
+		template == domain.VerifyEmailOTPMessageType ||
+		template == domain.VerifySMSOTPMessageType ||
+		template == domain.RecoveryCodeUsedMessageType ||
+		template == domain.RecoveryCodesLowMessageType ||
+		template == domain.PasswordExpiryMessageType ||
+		template == domain.UserLockedMessageType
 }
 func isTitle(key string) bool {
 	return key == domain.MessageTitle
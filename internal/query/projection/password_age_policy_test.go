@@ -30,7 +30,8 @@ func TestPasswordAgeProjection_reduces(t *testing.T) {
 					org.AggregateType,
 					[]byte(`{
 						"expireWarnDays": 10,
-						"maxAgeDays": 13
+						"maxAgeDays": 13,
+						"historyCount": 5
 }`),
 				), org.PasswordAgePolicyAddedEventMapper),
 			},
@@ -43,7 +44,7 @@ func TestPasswordAgeProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "INSERT INTO projections.password_age_policies (creation_date, change_date, sequence, id, state, expire_warn_days, max_age_days, is_default, resource_owner, instance_id) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)",
+							expectedStmt: "INSERT INTO projections.password_age_policies (creation_date, change_date, sequence, id, state, expire_warn_days, max_age_days, history_count, is_default, resource_owner, instance_id) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)",
 							expectedArgs: []interface{}{
 								anyArg{},
 								anyArg{},
@@ -52,6 +53,7 @@ func TestPasswordAgeProjection_reduces(t *testing.T) {
 								domain.PolicyStateActive,
 								uint64(10),
 								uint64(13),
+								uint64(5),
 								false,
 								"ro-id",
 								"instance-id",
@@ -70,7 +72,8 @@ func TestPasswordAgeProjection_reduces(t *testing.T) {
 					org.AggregateType,
 					[]byte(`{
 						"expireWarnDays": 10,
-						"maxAgeDays": 13
+						"maxAgeDays": 13,
+						"historyCount": 5
 		}`),
 				), org.PasswordAgePolicyChangedEventMapper),
 			},
@@ -82,12 +85,13 @@ func TestPasswordAgeProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "UPDATE projections.password_age_policies SET (change_date, sequence, expire_warn_days, max_age_days) = ($1, $2, $3, $4) WHERE (id = $5)",
+							expectedStmt: "UPDATE projections.password_age_policies SET (change_date, sequence, expire_warn_days, max_age_days, history_count) = ($1, $2, $3, $4, $5) WHERE (id = $6)",
 							expectedArgs: []interface{}{
 								anyArg{},
 								uint64(15),
 								uint64(10),
 								uint64(13),
+								uint64(5),
 								"agg-id",
 							},
 						},
@@ -131,7 +135,8 @@ func TestPasswordAgeProjection_reduces(t *testing.T) {
 					instance.AggregateType,
 					[]byte(`{
 						"expireWarnDays": 10,
-						"maxAgeDays": 13
+						"maxAgeDays": 13,
+						"historyCount": 5
 					}`),
 				), instance.PasswordAgePolicyAddedEventMapper),
 			},
@@ -143,7 +148,7 @@ func TestPasswordAgeProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "INSERT INTO projections.password_age_policies (creation_date, change_date, sequence, id, state, expire_warn_days, max_age_days, is_default, resource_owner, instance_id) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)",
+							expectedStmt: "INSERT INTO projections.password_age_policies (creation_date, change_date, sequence, id, state, expire_warn_days, max_age_days, history_count, is_default, resource_owner, instance_id) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)",
 							expectedArgs: []interface{}{
 								anyArg{},
 								anyArg{},
@@ -152,6 +157,7 @@ func TestPasswordAgeProjection_reduces(t *testing.T) {
 								domain.PolicyStateActive,
 								uint64(10),
 								uint64(13),
+								uint64(5),
 								true,
 								"ro-id",
 								"instance-id",
@@ -170,7 +176,8 @@ func TestPasswordAgeProjection_reduces(t *testing.T) {
 					instance.AggregateType,
 					[]byte(`{
 						"expireWarnDays": 10,
-						"maxAgeDays": 13
+						"maxAgeDays": 13,
+						"historyCount": 5
 					}`),
 				), instance.PasswordAgePolicyChangedEventMapper),
 			},
@@ -182,12 +189,13 @@ func TestPasswordAgeProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "UPDATE projections.password_age_policies SET (change_date, sequence, expire_warn_days, max_age_days) = ($1, $2, $3, $4) WHERE (id = $5)",
+							expectedStmt: "UPDATE projections.password_age_policies SET (change_date, sequence, expire_warn_days, max_age_days, history_count) = ($1, $2, $3, $4, $5) WHERE (id = $6)",
 							expectedArgs: []interface{}{
 								anyArg{},
 								uint64(15),
 								uint64(10),
 								uint64(13),
+								uint64(5),
 								"agg-id",
 							},
 						},
@@ -60,7 +60,15 @@ var (
 	OIDCSettingsProjection              *oidcSettingsProjection
 	DebugNotificationProviderProjection *debugNotificationProviderProjection
 	KeyProjection                       *keyProjection
+	WebhookProjection                   *webhookProjection
+	KeyValueProjection                  *keyValueProjection
+	DeviceAuthProjection                *deviceAuthProjection
+	QuotaProjection                     *quotaProjection
+	RateLimitsProjection                *rateLimitsProjection
+	UserTrustedDeviceProjection         *userTrustedDeviceProjection
 	NotificationsProjection             interface{}
+	WebhookDeliveriesProjection         interface{}
+	BackChannelLogoutProjection         interface{}
 )
 
 func Start(ctx context.Context, sqlClient *sql.DB, es *eventstore.Eventstore, config Config, keyEncryptionAlgorithm crypto.EncryptionAlgorithm, certEncryptionAlgorithm crypto.EncryptionAlgorithm) error {
@@ -123,6 +131,12 @@ func Start(ctx context.Context, sqlClient *sql.DB, es *eventstore.Eventstore, co
 	OIDCSettingsProjection = newOIDCSettingsProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["oidc_settings"]))
 	DebugNotificationProviderProjection = newDebugNotificationProviderProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["debug_notification_provider"]))
 	KeyProjection = newKeyProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["keys"]), keyEncryptionAlgorithm, certEncryptionAlgorithm)
+	WebhookProjection = newWebhookProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["webhooks"]))
+	KeyValueProjection = newKeyValueProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["kv_store"]))
+	DeviceAuthProjection = newDeviceAuthProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["device_authorizations"]))
+	QuotaProjection = newQuotaProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["quotas"]))
+	RateLimitsProjection = newRateLimitsProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["rate_limits"]))
+	UserTrustedDeviceProjection = newUserTrustedDeviceProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["user_trusted_devices"]))
 	return nil
 }
 
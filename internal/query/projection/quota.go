@@ -0,0 +1,124 @@
+package projection
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/eventstore"
+	"github.com/dennigogo/zitadel/internal/eventstore/handler"
+	"github.com/dennigogo/zitadel/internal/eventstore/handler/crdb"
+	"github.com/dennigogo/zitadel/internal/repository/quota"
+)
+
+const (
+	QuotaTable            = "projections.quotas"
+	QuotaInstanceIDCol    = "instance_id"
+	QuotaUnitCol          = "unit"
+	QuotaCreationDateCol  = "creation_date"
+	QuotaChangeDateCol    = "change_date"
+	QuotaSequenceCol      = "sequence"
+	QuotaFromCol          = "from_anchor"
+	QuotaResetIntervalCol = "reset_interval"
+	QuotaAmountCol        = "amount"
+	QuotaLimitCol         = "limit_usage"
+	QuotaNotificationsCol = "notifications"
+)
+
+type quotaProjection struct {
+	crdb.StatementHandler
+}
+
+func newQuotaProjection(ctx context.Context, config crdb.StatementHandlerConfig) *quotaProjection {
+	p := new(quotaProjection)
+	config.ProjectionName = QuotaTable
+	config.Reducers = p.reducers()
+	config.InitCheck = crdb.NewTableCheck(
+		crdb.NewTable([]*crdb.Column{
+			crdb.NewColumn(QuotaInstanceIDCol, crdb.ColumnTypeText),
+			crdb.NewColumn(QuotaUnitCol, crdb.ColumnTypeEnum),
+			crdb.NewColumn(QuotaCreationDateCol, crdb.ColumnTypeTimestamp),
+			crdb.NewColumn(QuotaChangeDateCol, crdb.ColumnTypeTimestamp),
+			crdb.NewColumn(QuotaSequenceCol, crdb.ColumnTypeInt64),
+			crdb.NewColumn(QuotaFromCol, crdb.ColumnTypeTimestamp, crdb.Nullable()),
+			crdb.NewColumn(QuotaResetIntervalCol, crdb.ColumnTypeInt64, crdb.Default(0)),
+			crdb.NewColumn(QuotaAmountCol, crdb.ColumnTypeInt64),
+			crdb.NewColumn(QuotaLimitCol, crdb.ColumnTypeBool, crdb.Default(false)),
+			crdb.NewColumn(QuotaNotificationsCol, crdb.ColumnTypeJSONB, crdb.Nullable()),
+		},
+			crdb.NewPrimaryKey(QuotaInstanceIDCol, QuotaUnitCol),
+		),
+	)
+	p.StatementHandler = crdb.NewStatementHandler(ctx, config)
+	return p
+}
+
+func (p *quotaProjection) reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: quota.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  quota.SetEventType,
+					Reduce: p.reduceQuotaSet,
+				},
+				{
+					Event:  quota.RemovedEventType,
+					Reduce: p.reduceQuotaRemoved,
+				},
+			},
+		},
+	}
+}
+
+func (p *quotaProjection) reduceQuotaSet(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*quota.SetEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Qs4k1", "reduce.wrong.event.type %s", quota.SetEventType)
+	}
+	var notifications []byte
+	if len(e.Notifications) > 0 {
+		var err error
+		notifications, err = json.Marshal(e.Notifications)
+		if err != nil {
+			return nil, errors.ThrowInternal(err, "HANDL-Qs7n2", "unable to marshal quota notifications")
+		}
+	}
+	var from interface{}
+	if !e.From.IsZero() {
+		from = e.From
+	}
+	return crdb.NewUpsertStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(QuotaInstanceIDCol, nil),
+			handler.NewCol(QuotaUnitCol, nil),
+		},
+		[]handler.Column{
+			handler.NewCol(QuotaInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCol(QuotaUnitCol, e.Unit),
+			handler.NewCol(QuotaCreationDateCol, e.CreationDate()),
+			handler.NewCol(QuotaChangeDateCol, e.CreationDate()),
+			handler.NewCol(QuotaSequenceCol, e.Sequence()),
+			handler.NewCol(QuotaFromCol, from),
+			handler.NewCol(QuotaResetIntervalCol, e.ResetInterval),
+			handler.NewCol(QuotaAmountCol, e.Amount),
+			handler.NewCol(QuotaLimitCol, e.Limit),
+			handler.NewCol(QuotaNotificationsCol, notifications),
+		},
+	), nil
+}
+
+func (p *quotaProjection) reduceQuotaRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*quota.RemovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Qr2m8", "reduce.wrong.event.type %s", quota.RemovedEventType)
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(QuotaInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(QuotaUnitCol, e.Unit),
+		},
+	), nil
+}
@@ -0,0 +1,110 @@
+package projection
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dennigogo/zitadel/internal/domain"
+	"github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/eventstore"
+	"github.com/dennigogo/zitadel/internal/eventstore/handler"
+	"github.com/dennigogo/zitadel/internal/eventstore/repository"
+	"github.com/dennigogo/zitadel/internal/repository/quota"
+)
+
+func TestQuotaProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceQuotaSet",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(quota.SetEventType),
+					quota.AggregateType,
+					[]byte(`{
+						"unit": 1,
+						"from": "2023-01-01T00:00:00Z",
+						"resetInterval": 86400000000000,
+						"amount": 1000,
+						"limit": true,
+						"notifications": [{"percent": 80, "repeat": true, "callUrl": "https://example.com/quota"}]
+					}`),
+				), quota.SetEventMapper),
+			},
+			reduce: (&quotaProjection{}).reduceQuotaSet,
+			want: wantReduce{
+				aggregateType:    quota.AggregateType,
+				sequence:         15,
+				previousSequence: 10,
+				projection:       QuotaTable,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.quotas (instance_id, unit, creation_date, change_date, sequence, from_anchor, reset_interval, amount, limit_usage, notifications) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) ON CONFLICT (instance_id, unit) DO UPDATE SET (creation_date, change_date, sequence, from_anchor, reset_interval, amount, limit_usage, notifications) = (EXCLUDED.creation_date, EXCLUDED.change_date, EXCLUDED.sequence, EXCLUDED.from_anchor, EXCLUDED.reset_interval, EXCLUDED.amount, EXCLUDED.limit_usage, EXCLUDED.notifications)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								domain.QuotaUnitRequestsAllAuthenticated,
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+								24 * time.Hour,
+								uint64(1000),
+								true,
+								[]byte(`[{"percent":80,"repeat":true,"callUrl":"https://example.com/quota"}]`),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceQuotaRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(quota.RemovedEventType),
+					quota.AggregateType,
+					[]byte(`{"unit": 4}`),
+				), quota.RemovedEventMapper),
+			},
+			reduce: (&quotaProjection{}).reduceQuotaRemoved,
+			want: wantReduce{
+				aggregateType:    quota.AggregateType,
+				sequence:         15,
+				previousSequence: 10,
+				projection:       QuotaTable,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.quotas WHERE (instance_id = $1) AND (unit = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								domain.QuotaUnitUsers,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if _, ok := err.(errors.InvalidArgument); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, tt.want)
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/eventstore"
+	"github.com/dennigogo/zitadel/internal/eventstore/handler"
+	"github.com/dennigogo/zitadel/internal/eventstore/handler/crdb"
+	"github.com/dennigogo/zitadel/internal/repository/instance"
+)
+
+const (
+	RateLimitsTable               = "projections.rate_limits"
+	RateLimitsInstanceIDCol       = "instance_id"
+	RateLimitsCreationDateCol     = "creation_date"
+	RateLimitsChangeDateCol       = "change_date"
+	RateLimitsSequenceCol         = "sequence"
+	RateLimitsInstanceRequestsCol = "instance_requests"
+	RateLimitsInstanceIntervalCol = "instance_interval"
+	RateLimitsClientRequestsCol   = "client_requests"
+	RateLimitsClientIntervalCol   = "client_interval"
+	RateLimitsIPRequestsCol       = "ip_requests"
+	RateLimitsIPIntervalCol       = "ip_interval"
+)
+
+type rateLimitsProjection struct {
+	crdb.StatementHandler
+}
+
+func newRateLimitsProjection(ctx context.Context, config crdb.StatementHandlerConfig) *rateLimitsProjection {
+	p := new(rateLimitsProjection)
+	config.ProjectionName = RateLimitsTable
+	config.Reducers = p.reducers()
+	config.InitCheck = crdb.NewTableCheck(
+		crdb.NewTable([]*crdb.Column{
+			crdb.NewColumn(RateLimitsInstanceIDCol, crdb.ColumnTypeText),
+			crdb.NewColumn(RateLimitsCreationDateCol, crdb.ColumnTypeTimestamp),
+			crdb.NewColumn(RateLimitsChangeDateCol, crdb.ColumnTypeTimestamp),
+			crdb.NewColumn(RateLimitsSequenceCol, crdb.ColumnTypeInt64),
+			crdb.NewColumn(RateLimitsInstanceRequestsCol, crdb.ColumnTypeInt64, crdb.Default(0)),
+			crdb.NewColumn(RateLimitsInstanceIntervalCol, crdb.ColumnTypeInt64, crdb.Default(0)),
+			crdb.NewColumn(RateLimitsClientRequestsCol, crdb.ColumnTypeInt64, crdb.Default(0)),
+			crdb.NewColumn(RateLimitsClientIntervalCol, crdb.ColumnTypeInt64, crdb.Default(0)),
+			crdb.NewColumn(RateLimitsIPRequestsCol, crdb.ColumnTypeInt64, crdb.Default(0)),
+			crdb.NewColumn(RateLimitsIPIntervalCol, crdb.ColumnTypeInt64, crdb.Default(0)),
+		},
+			crdb.NewPrimaryKey(RateLimitsInstanceIDCol),
+		),
+	)
+	p.StatementHandler = crdb.NewStatementHandler(ctx, config)
+	return p
+}
+
+func (p *rateLimitsProjection) reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: instance.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  instance.RateLimitsSetEventType,
+					Reduce: p.reduceRateLimitsSet,
+				},
+				{
+					Event:  instance.RateLimitsResetEventType,
+					Reduce: p.reduceRateLimitsReset,
+				},
+			},
+		},
+	}
+}
+
+func (p *rateLimitsProjection) reduceRateLimitsSet(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*instance.RateLimitsSetEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Rl6s2", "reduce.wrong.event.type %s", instance.RateLimitsSetEventType)
+	}
+	return crdb.NewUpsertStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(RateLimitsInstanceIDCol, nil),
+		},
+		[]handler.Column{
+			handler.NewCol(RateLimitsInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCol(RateLimitsCreationDateCol, e.CreationDate()),
+			handler.NewCol(RateLimitsChangeDateCol, e.CreationDate()),
+			handler.NewCol(RateLimitsSequenceCol, e.Sequence()),
+			handler.NewCol(RateLimitsInstanceRequestsCol, e.Instance.Requests),
+			handler.NewCol(RateLimitsInstanceIntervalCol, e.Instance.Interval),
+			handler.NewCol(RateLimitsClientRequestsCol, e.Client.Requests),
+			handler.NewCol(RateLimitsClientIntervalCol, e.Client.Interval),
+			handler.NewCol(RateLimitsIPRequestsCol, e.IP.Requests),
+			handler.NewCol(RateLimitsIPIntervalCol, e.IP.Interval),
+		},
+	), nil
+}
+
+func (p *rateLimitsProjection) reduceRateLimitsReset(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*instance.RateLimitsResetEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Rl8r4", "reduce.wrong.event.type %s", instance.RateLimitsResetEventType)
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(RateLimitsInstanceIDCol, e.Aggregate().InstanceID),
+		},
+	), nil
+}
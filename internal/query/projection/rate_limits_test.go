@@ -0,0 +1,105 @@
+package projection
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/eventstore"
+	"github.com/dennigogo/zitadel/internal/eventstore/handler"
+	"github.com/dennigogo/zitadel/internal/eventstore/repository"
+	"github.com/dennigogo/zitadel/internal/repository/instance"
+)
+
+func TestRateLimitsProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceRateLimitsSet",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(instance.RateLimitsSetEventType),
+					instance.AggregateType,
+					[]byte(`{
+						"instance": {"requests": 1000, "interval": 1000000000},
+						"client": {"requests": 10, "interval": 1000000000},
+						"ip": {}
+					}`),
+				), instance.RateLimitsSetEventMapper),
+			},
+			reduce: (&rateLimitsProjection{}).reduceRateLimitsSet,
+			want: wantReduce{
+				aggregateType:    instance.AggregateType,
+				sequence:         15,
+				previousSequence: 10,
+				projection:       RateLimitsTable,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.rate_limits (instance_id, creation_date, change_date, sequence, instance_requests, instance_interval, client_requests, client_interval, ip_requests, ip_interval) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) ON CONFLICT (instance_id) DO UPDATE SET (creation_date, change_date, sequence, instance_requests, instance_interval, client_requests, client_interval, ip_requests, ip_interval) = (EXCLUDED.creation_date, EXCLUDED.change_date, EXCLUDED.sequence, EXCLUDED.instance_requests, EXCLUDED.instance_interval, EXCLUDED.client_requests, EXCLUDED.client_interval, EXCLUDED.ip_requests, EXCLUDED.ip_interval)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								uint64(1000),
+								time.Second,
+								uint64(10),
+								time.Second,
+								uint64(0),
+								time.Duration(0),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceRateLimitsReset",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(instance.RateLimitsResetEventType),
+					instance.AggregateType,
+					nil,
+				), instance.RateLimitsResetEventMapper),
+			},
+			reduce: (&rateLimitsProjection{}).reduceRateLimitsReset,
+			want: wantReduce{
+				aggregateType:    instance.AggregateType,
+				sequence:         15,
+				previousSequence: 10,
+				projection:       RateLimitsTable,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.rate_limits WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if _, ok := err.(errors.InvalidArgument); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, tt.want)
+		})
+	}
+}
@@ -3,6 +3,7 @@ package projection
 import (
 	"context"
 
+	"github.com/dennigogo/zitadel/internal/database"
 	"github.com/dennigogo/zitadel/internal/domain"
 	"github.com/dennigogo/zitadel/internal/errors"
 	"github.com/dennigogo/zitadel/internal/eventstore"
@@ -14,6 +15,8 @@ import (
 const (
 	SMSConfigProjectionTable = "projections.sms_configs"
 	SMSTwilioTable           = SMSConfigProjectionTable + "_" + smsTwilioTableSuffix
+	SMSHTTPTable             = SMSConfigProjectionTable + "_" + smsHTTPTableSuffix
+	SMSSMPPTable             = SMSConfigProjectionTable + "_" + smsSMPPTableSuffix
 
 	SMSColumnID            = "id"
 	SMSColumnAggregateID   = "aggregate_id"
@@ -30,6 +33,29 @@ const (
 	SMSTwilioConfigColumnSID          = "sid"
 	SMSTwilioConfigColumnSenderNumber = "sender_number"
 	SMSTwilioConfigColumnToken        = "token"
+
+	smsHTTPTableSuffix                    = "http"
+	SMSHTTPConfigColumnSMSID              = "sms_id"
+	SMSHTTPColumnInstanceID               = "instance_id"
+	SMSHTTPConfigColumnEndpoint           = "endpoint"
+	SMSHTTPConfigColumnMethod             = "method"
+	SMSHTTPConfigColumnContentType        = "content_type"
+	SMSHTTPConfigColumnBodyTemplate       = "body_template"
+	SMSHTTPConfigColumnAuthHeaderName     = "auth_header_name"
+	SMSHTTPConfigColumnAuthHeaderValue    = "auth_header_value"
+	SMSHTTPConfigColumnSuccessStatusCodes = "success_status_codes"
+	SMSHTTPConfigColumnSenderNumber       = "sender_number"
+
+	smsSMPPTableSuffix              = "smpp"
+	SMSSMPPConfigColumnSMSID        = "sms_id"
+	SMSSMPPColumnInstanceID         = "instance_id"
+	SMSSMPPConfigColumnHost         = "host"
+	SMSSMPPConfigColumnPort         = "port"
+	SMSSMPPConfigColumnTLS          = "tls"
+	SMSSMPPConfigColumnSystemID     = "system_id"
+	SMSSMPPConfigColumnPassword     = "password"
+	SMSSMPPConfigColumnSystemType   = "system_type"
+	SMSSMPPConfigColumnSenderNumber = "sender_number"
 )
 
 type smsConfigProjection struct {
@@ -64,6 +90,37 @@ func newSMSConfigProjection(ctx context.Context, config crdb.StatementHandlerCon
 			smsTwilioTableSuffix,
 			crdb.WithForeignKey(crdb.NewForeignKeyOfPublicKeys("fk_twilio_ref_sms")),
 		),
+		crdb.NewSuffixedTable([]*crdb.Column{
+			crdb.NewColumn(SMSHTTPConfigColumnSMSID, crdb.ColumnTypeText),
+			crdb.NewColumn(SMSHTTPColumnInstanceID, crdb.ColumnTypeText),
+			crdb.NewColumn(SMSHTTPConfigColumnEndpoint, crdb.ColumnTypeText),
+			crdb.NewColumn(SMSHTTPConfigColumnMethod, crdb.ColumnTypeText),
+			crdb.NewColumn(SMSHTTPConfigColumnContentType, crdb.ColumnTypeText),
+			crdb.NewColumn(SMSHTTPConfigColumnBodyTemplate, crdb.ColumnTypeText),
+			crdb.NewColumn(SMSHTTPConfigColumnAuthHeaderName, crdb.ColumnTypeText),
+			crdb.NewColumn(SMSHTTPConfigColumnAuthHeaderValue, crdb.ColumnTypeJSONB, crdb.Nullable()),
+			crdb.NewColumn(SMSHTTPConfigColumnSuccessStatusCodes, crdb.ColumnTypeEnumArray, crdb.Nullable()),
+			crdb.NewColumn(SMSHTTPConfigColumnSenderNumber, crdb.ColumnTypeText),
+		},
+			crdb.NewPrimaryKey(SMSHTTPConfigColumnSMSID, SMSHTTPColumnInstanceID),
+			smsHTTPTableSuffix,
+			crdb.WithForeignKey(crdb.NewForeignKeyOfPublicKeys("fk_http_ref_sms")),
+		),
+		crdb.NewSuffixedTable([]*crdb.Column{
+			crdb.NewColumn(SMSSMPPConfigColumnSMSID, crdb.ColumnTypeText),
+			crdb.NewColumn(SMSSMPPColumnInstanceID, crdb.ColumnTypeText),
+			crdb.NewColumn(SMSSMPPConfigColumnHost, crdb.ColumnTypeText),
+			crdb.NewColumn(SMSSMPPConfigColumnPort, crdb.ColumnTypeInt64),
+			crdb.NewColumn(SMSSMPPConfigColumnTLS, crdb.ColumnTypeBool),
+			crdb.NewColumn(SMSSMPPConfigColumnSystemID, crdb.ColumnTypeText),
+			crdb.NewColumn(SMSSMPPConfigColumnPassword, crdb.ColumnTypeJSONB, crdb.Nullable()),
+			crdb.NewColumn(SMSSMPPConfigColumnSystemType, crdb.ColumnTypeText),
+			crdb.NewColumn(SMSSMPPConfigColumnSenderNumber, crdb.ColumnTypeText),
+		},
+			crdb.NewPrimaryKey(SMSSMPPConfigColumnSMSID, SMSSMPPColumnInstanceID),
+			smsSMPPTableSuffix,
+			crdb.WithForeignKey(crdb.NewForeignKeyOfPublicKeys("fk_smpp_ref_sms")),
+		),
 	)
 	p.StatementHandler = crdb.NewStatementHandler(ctx, config)
 	return p
@@ -86,6 +143,30 @@ func (p *smsConfigProjection) reducers() []handler.AggregateReducer {
 					Event:  instance.SMSConfigTwilioTokenChangedEventType,
 					Reduce: p.reduceSMSConfigTwilioTokenChanged,
 				},
+				{
+					Event:  instance.SMSConfigHTTPAddedEventType,
+					Reduce: p.reduceSMSConfigHTTPAdded,
+				},
+				{
+					Event:  instance.SMSConfigHTTPChangedEventType,
+					Reduce: p.reduceSMSConfigHTTPChanged,
+				},
+				{
+					Event:  instance.SMSConfigHTTPAuthHeaderChangedEventType,
+					Reduce: p.reduceSMSConfigHTTPAuthHeaderChanged,
+				},
+				{
+					Event:  instance.SMSConfigSMPPAddedEventType,
+					Reduce: p.reduceSMSConfigSMPPAdded,
+				},
+				{
+					Event:  instance.SMSConfigSMPPChangedEventType,
+					Reduce: p.reduceSMSConfigSMPPChanged,
+				},
+				{
+					Event:  instance.SMSConfigSMPPPasswordChangedEventType,
+					Reduce: p.reduceSMSConfigSMPPPasswordChanged,
+				},
 				{
 					Event:  instance.SMSConfigActivatedEventType,
 					Reduce: p.reduceSMSConfigActivated,
@@ -205,6 +286,242 @@ func (p *smsConfigProjection) reduceSMSConfigTwilioTokenChanged(event eventstore
 	), nil
 }
 
+func (p *smsConfigProjection) reduceSMSConfigHTTPAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*instance.SMSConfigHTTPAddedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Hq3d8", "reduce.wrong.event.type %s", instance.SMSConfigHTTPAddedEventType)
+	}
+
+	return crdb.NewMultiStatement(
+		e,
+		crdb.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSColumnID, e.ID),
+				handler.NewCol(SMSColumnAggregateID, e.Aggregate().ID),
+				handler.NewCol(SMSColumnCreationDate, e.CreationDate()),
+				handler.NewCol(SMSColumnChangeDate, e.CreationDate()),
+				handler.NewCol(SMSColumnResourceOwner, e.Aggregate().ResourceOwner),
+				handler.NewCol(SMSColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCol(SMSColumnState, domain.SMSConfigStateInactive),
+				handler.NewCol(SMSColumnSequence, e.Sequence()),
+			},
+		),
+		crdb.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSHTTPConfigColumnSMSID, e.ID),
+				handler.NewCol(SMSHTTPColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCol(SMSHTTPConfigColumnEndpoint, e.Endpoint),
+				handler.NewCol(SMSHTTPConfigColumnMethod, e.Method),
+				handler.NewCol(SMSHTTPConfigColumnContentType, e.ContentType),
+				handler.NewCol(SMSHTTPConfigColumnBodyTemplate, e.BodyTemplate),
+				handler.NewCol(SMSHTTPConfigColumnAuthHeaderName, e.AuthHeaderName),
+				handler.NewCol(SMSHTTPConfigColumnAuthHeaderValue, e.AuthHeaderValue),
+				handler.NewCol(SMSHTTPConfigColumnSuccessStatusCodes, database.EnumArray[int32](e.SuccessStatusCodes)),
+				handler.NewCol(SMSHTTPConfigColumnSenderNumber, e.SenderNumber),
+			},
+			crdb.WithTableSuffix(smsHTTPTableSuffix),
+		),
+	), nil
+}
+
+func (p *smsConfigProjection) reduceSMSConfigHTTPChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*instance.SMSConfigHTTPChangedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Hw7c2", "reduce.wrong.event.type %s", instance.SMSConfigHTTPChangedEventType)
+	}
+	columns := make([]handler.Column, 0)
+	if e.Endpoint != nil {
+		columns = append(columns, handler.NewCol(SMSHTTPConfigColumnEndpoint, *e.Endpoint))
+	}
+	if e.Method != nil {
+		columns = append(columns, handler.NewCol(SMSHTTPConfigColumnMethod, *e.Method))
+	}
+	if e.ContentType != nil {
+		columns = append(columns, handler.NewCol(SMSHTTPConfigColumnContentType, *e.ContentType))
+	}
+	if e.BodyTemplate != nil {
+		columns = append(columns, handler.NewCol(SMSHTTPConfigColumnBodyTemplate, *e.BodyTemplate))
+	}
+	if e.AuthHeaderName != nil {
+		columns = append(columns, handler.NewCol(SMSHTTPConfigColumnAuthHeaderName, *e.AuthHeaderName))
+	}
+	if e.SuccessStatusCodes != nil {
+		columns = append(columns, handler.NewCol(SMSHTTPConfigColumnSuccessStatusCodes, database.EnumArray[int32](*e.SuccessStatusCodes)))
+	}
+	if e.SenderNumber != nil {
+		columns = append(columns, handler.NewCol(SMSHTTPConfigColumnSenderNumber, *e.SenderNumber))
+	}
+
+	return crdb.NewMultiStatement(
+		e,
+		crdb.AddUpdateStatement(
+			columns,
+			[]handler.Condition{
+				handler.NewCond(SMSHTTPConfigColumnSMSID, e.ID),
+				handler.NewCond(SMSHTTPColumnInstanceID, e.Aggregate().InstanceID),
+			},
+			crdb.WithTableSuffix(smsHTTPTableSuffix),
+		),
+		crdb.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSColumnChangeDate, e.CreationDate()),
+				handler.NewCol(SMSColumnSequence, e.Sequence()),
+			},
+			[]handler.Condition{
+				handler.NewCond(SMSColumnID, e.ID),
+				handler.NewCond(SMSColumnInstanceID, e.Aggregate().InstanceID),
+			},
+		),
+	), nil
+}
+
+func (p *smsConfigProjection) reduceSMSConfigHTTPAuthHeaderChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*instance.SMSConfigHTTPAuthHeaderChangedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Hz4k9", "reduce.wrong.event.type %s", instance.SMSConfigHTTPAuthHeaderChangedEventType)
+	}
+
+	return crdb.NewMultiStatement(
+		e,
+		crdb.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSHTTPConfigColumnAuthHeaderValue, e.AuthHeaderValue),
+			},
+			[]handler.Condition{
+				handler.NewCond(SMSHTTPConfigColumnSMSID, e.ID),
+				handler.NewCond(SMSHTTPColumnInstanceID, e.Aggregate().InstanceID),
+			},
+			crdb.WithTableSuffix(smsHTTPTableSuffix),
+		),
+		crdb.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSColumnChangeDate, e.CreationDate()),
+				handler.NewCol(SMSColumnSequence, e.Sequence()),
+			},
+			[]handler.Condition{
+				handler.NewCond(SMSColumnID, e.ID),
+				handler.NewCond(SMSColumnInstanceID, e.Aggregate().InstanceID),
+			},
+		),
+	), nil
+}
+
+func (p *smsConfigProjection) reduceSMSConfigSMPPAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*instance.SMSConfigSMPPAddedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Sk2m7", "reduce.wrong.event.type %s", instance.SMSConfigSMPPAddedEventType)
+	}
+
+	return crdb.NewMultiStatement(
+		e,
+		crdb.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSColumnID, e.ID),
+				handler.NewCol(SMSColumnAggregateID, e.Aggregate().ID),
+				handler.NewCol(SMSColumnCreationDate, e.CreationDate()),
+				handler.NewCol(SMSColumnChangeDate, e.CreationDate()),
+				handler.NewCol(SMSColumnResourceOwner, e.Aggregate().ResourceOwner),
+				handler.NewCol(SMSColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCol(SMSColumnState, domain.SMSConfigStateInactive),
+				handler.NewCol(SMSColumnSequence, e.Sequence()),
+			},
+		),
+		crdb.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSSMPPConfigColumnSMSID, e.ID),
+				handler.NewCol(SMSSMPPColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCol(SMSSMPPConfigColumnHost, e.Host),
+				handler.NewCol(SMSSMPPConfigColumnPort, e.Port),
+				handler.NewCol(SMSSMPPConfigColumnTLS, e.TLS),
+				handler.NewCol(SMSSMPPConfigColumnSystemID, e.SystemID),
+				handler.NewCol(SMSSMPPConfigColumnPassword, e.Password),
+				handler.NewCol(SMSSMPPConfigColumnSystemType, e.SystemType),
+				handler.NewCol(SMSSMPPConfigColumnSenderNumber, e.SenderNumber),
+			},
+			crdb.WithTableSuffix(smsSMPPTableSuffix),
+		),
+	), nil
+}
+
+func (p *smsConfigProjection) reduceSMSConfigSMPPChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*instance.SMSConfigSMPPChangedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Sd5q1", "reduce.wrong.event.type %s", instance.SMSConfigSMPPChangedEventType)
+	}
+	columns := make([]handler.Column, 0)
+	if e.Host != nil {
+		columns = append(columns, handler.NewCol(SMSSMPPConfigColumnHost, *e.Host))
+	}
+	if e.Port != nil {
+		columns = append(columns, handler.NewCol(SMSSMPPConfigColumnPort, *e.Port))
+	}
+	if e.TLS != nil {
+		columns = append(columns, handler.NewCol(SMSSMPPConfigColumnTLS, *e.TLS))
+	}
+	if e.SystemID != nil {
+		columns = append(columns, handler.NewCol(SMSSMPPConfigColumnSystemID, *e.SystemID))
+	}
+	if e.SystemType != nil {
+		columns = append(columns, handler.NewCol(SMSSMPPConfigColumnSystemType, *e.SystemType))
+	}
+	if e.SenderNumber != nil {
+		columns = append(columns, handler.NewCol(SMSSMPPConfigColumnSenderNumber, *e.SenderNumber))
+	}
+
+	return crdb.NewMultiStatement(
+		e,
+		crdb.AddUpdateStatement(
+			columns,
+			[]handler.Condition{
+				handler.NewCond(SMSSMPPConfigColumnSMSID, e.ID),
+				handler.NewCond(SMSSMPPColumnInstanceID, e.Aggregate().InstanceID),
+			},
+			crdb.WithTableSuffix(smsSMPPTableSuffix),
+		),
+		crdb.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSColumnChangeDate, e.CreationDate()),
+				handler.NewCol(SMSColumnSequence, e.Sequence()),
+			},
+			[]handler.Condition{
+				handler.NewCond(SMSColumnID, e.ID),
+				handler.NewCond(SMSColumnInstanceID, e.Aggregate().InstanceID),
+			},
+		),
+	), nil
+}
+
+func (p *smsConfigProjection) reduceSMSConfigSMPPPasswordChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*instance.SMSConfigSMPPPasswordChangedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Sx8w3", "reduce.wrong.event.type %s", instance.SMSConfigSMPPPasswordChangedEventType)
+	}
+
+	return crdb.NewMultiStatement(
+		e,
+		crdb.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSSMPPConfigColumnPassword, e.Password),
+			},
+			[]handler.Condition{
+				handler.NewCond(SMSSMPPConfigColumnSMSID, e.ID),
+				handler.NewCond(SMSSMPPColumnInstanceID, e.Aggregate().InstanceID),
+			},
+			crdb.WithTableSuffix(smsSMPPTableSuffix),
+		),
+		crdb.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSColumnChangeDate, e.CreationDate()),
+				handler.NewCol(SMSColumnSequence, e.Sequence()),
+			},
+			[]handler.Condition{
+				handler.NewCond(SMSColumnID, e.ID),
+				handler.NewCond(SMSColumnInstanceID, e.Aggregate().InstanceID),
+			},
+		),
+	), nil
+}
+
 func (p *smsConfigProjection) reduceSMSConfigActivated(event eventstore.Event) (*handler.Statement, error) {
 	e, ok := event.(*instance.SMSConfigActivatedEvent)
 	if !ok {
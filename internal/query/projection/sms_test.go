@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/dennigogo/zitadel/internal/crypto"
+	"github.com/dennigogo/zitadel/internal/database"
 	"github.com/dennigogo/zitadel/internal/domain"
 	"github.com/dennigogo/zitadel/internal/errors"
 	"github.com/dennigogo/zitadel/internal/eventstore"
@@ -175,6 +176,330 @@ func TestSMSProjection_reduces(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "instance.reduceSMSConfigHTTPAdded",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(instance.SMSConfigHTTPAddedEventType),
+					instance.AggregateType,
+					[]byte(`{
+						"id": "id",
+						"endpoint": "https://sms.example.com",
+						"method": "POST",
+						"contentType": "application/json",
+						"bodyTemplate": "{{json .Content}}",
+						"authHeaderName": "Authorization",
+						"authHeaderValue": {
+							"cryptoType": 0,
+							"algorithm": "RSA-265",
+							"keyId": "key-id",
+							"crypted": "Y3J5cHRlZA=="
+						},
+						"successStatusCodes": [200, 202],
+						"senderNumber": "sender-number"
+					}`),
+				), instance.SMSConfigHTTPAddedEventMapper),
+			},
+			reduce: (&smsConfigProjection{}).reduceSMSConfigHTTPAdded,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("instance"),
+				sequence:         15,
+				previousSequence: 10,
+				projection:       SMSConfigProjectionTable,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.sms_configs (id, aggregate_id, creation_date, change_date, resource_owner, instance_id, state, sequence) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)",
+							expectedArgs: []interface{}{
+								"id",
+								"agg-id",
+								anyArg{},
+								anyArg{},
+								"ro-id",
+								"instance-id",
+								domain.SMSConfigStateInactive,
+								uint64(15),
+							},
+						},
+						{
+							expectedStmt: "INSERT INTO projections.sms_configs_http (sms_id, instance_id, endpoint, method, content_type, body_template, auth_header_name, auth_header_value, success_status_codes, sender_number) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)",
+							expectedArgs: []interface{}{
+								"id",
+								"instance-id",
+								"https://sms.example.com",
+								"POST",
+								"application/json",
+								"{{json .Content}}",
+								"Authorization",
+								&crypto.CryptoValue{
+									CryptoType: crypto.TypeEncryption,
+									Algorithm:  "RSA-265",
+									KeyID:      "key-id",
+									Crypted:    []byte("crypted"),
+								},
+								database.EnumArray[int32]{200, 202},
+								"sender-number",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance.reduceSMSConfigHTTPChanged",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(instance.SMSConfigHTTPChangedEventType),
+					instance.AggregateType,
+					[]byte(`{
+						"id": "id",
+						"endpoint": "https://sms.example.com",
+						"successStatusCodes": [201]
+					}`),
+				), instance.SMSConfigHTTPChangedEventMapper),
+			},
+			reduce: (&smsConfigProjection{}).reduceSMSConfigHTTPChanged,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("instance"),
+				sequence:         15,
+				previousSequence: 10,
+				projection:       SMSConfigProjectionTable,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.sms_configs_http SET (endpoint, success_status_codes) = ($1, $2) WHERE (sms_id = $3) AND (instance_id = $4)",
+							expectedArgs: []interface{}{
+								"https://sms.example.com",
+								database.EnumArray[int32]{201},
+								"id",
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.sms_configs SET (change_date, sequence) = ($1, $2) WHERE (id = $3) AND (instance_id = $4)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance.reduceSMSConfigHTTPAuthHeaderChanged",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(instance.SMSConfigHTTPAuthHeaderChangedEventType),
+					instance.AggregateType,
+					[]byte(`{
+						"id": "id",
+						"authHeaderValue": {
+							"cryptoType": 0,
+							"algorithm": "RSA-265",
+							"keyId": "key-id",
+							"crypted": "Y3J5cHRlZA=="
+						}
+					}`),
+				), instance.SMSConfigHTTPAuthHeaderChangedEventMapper),
+			},
+			reduce: (&smsConfigProjection{}).reduceSMSConfigHTTPAuthHeaderChanged,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("instance"),
+				sequence:         15,
+				previousSequence: 10,
+				projection:       SMSConfigProjectionTable,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.sms_configs_http SET auth_header_value = $1 WHERE (sms_id = $2) AND (instance_id = $3)",
+							expectedArgs: []interface{}{
+								&crypto.CryptoValue{
+									CryptoType: crypto.TypeEncryption,
+									Algorithm:  "RSA-265",
+									KeyID:      "key-id",
+									Crypted:    []byte("crypted"),
+								},
+								"id",
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.sms_configs SET (change_date, sequence) = ($1, $2) WHERE (id = $3) AND (instance_id = $4)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance.reduceSMSConfigSMPPAdded",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(instance.SMSConfigSMPPAddedEventType),
+					instance.AggregateType,
+					[]byte(`{
+						"id": "id",
+						"host": "smpp.example.com",
+						"port": 2775,
+						"tls": true,
+						"systemId": "system-id",
+						"password": {
+							"cryptoType": 0,
+							"algorithm": "RSA-265",
+							"keyId": "key-id",
+							"crypted": "Y3J5cHRlZA=="
+						},
+						"systemType": "system-type",
+						"senderNumber": "sender-number"
+					}`),
+				), instance.SMSConfigSMPPAddedEventMapper),
+			},
+			reduce: (&smsConfigProjection{}).reduceSMSConfigSMPPAdded,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("instance"),
+				sequence:         15,
+				previousSequence: 10,
+				projection:       SMSConfigProjectionTable,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.sms_configs (id, aggregate_id, creation_date, change_date, resource_owner, instance_id, state, sequence) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)",
+							expectedArgs: []interface{}{
+								"id",
+								"agg-id",
+								anyArg{},
+								anyArg{},
+								"ro-id",
+								"instance-id",
+								domain.SMSConfigStateInactive,
+								uint64(15),
+							},
+						},
+						{
+							expectedStmt: "INSERT INTO projections.sms_configs_smpp (sms_id, instance_id, host, port, tls, system_id, password, system_type, sender_number) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)",
+							expectedArgs: []interface{}{
+								"id",
+								"instance-id",
+								"smpp.example.com",
+								uint16(2775),
+								true,
+								"system-id",
+								&crypto.CryptoValue{
+									CryptoType: crypto.TypeEncryption,
+									Algorithm:  "RSA-265",
+									KeyID:      "key-id",
+									Crypted:    []byte("crypted"),
+								},
+								"system-type",
+								"sender-number",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance.reduceSMSConfigSMPPChanged",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(instance.SMSConfigSMPPChangedEventType),
+					instance.AggregateType,
+					[]byte(`{
+						"id": "id",
+						"port": 3550,
+						"tls": false
+					}`),
+				), instance.SMSConfigSMPPChangedEventMapper),
+			},
+			reduce: (&smsConfigProjection{}).reduceSMSConfigSMPPChanged,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("instance"),
+				sequence:         15,
+				previousSequence: 10,
+				projection:       SMSConfigProjectionTable,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.sms_configs_smpp SET (port, tls) = ($1, $2) WHERE (sms_id = $3) AND (instance_id = $4)",
+							expectedArgs: []interface{}{
+								uint16(3550),
+								false,
+								"id",
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.sms_configs SET (change_date, sequence) = ($1, $2) WHERE (id = $3) AND (instance_id = $4)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance.reduceSMSConfigSMPPPasswordChanged",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(instance.SMSConfigSMPPPasswordChangedEventType),
+					instance.AggregateType,
+					[]byte(`{
+						"id": "id",
+						"password": {
+							"cryptoType": 0,
+							"algorithm": "RSA-265",
+							"keyId": "key-id",
+							"crypted": "Y3J5cHRlZA=="
+						}
+					}`),
+				), instance.SMSConfigSMPPPasswordChangedEventMapper),
+			},
+			reduce: (&smsConfigProjection{}).reduceSMSConfigSMPPPasswordChanged,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("instance"),
+				sequence:         15,
+				previousSequence: 10,
+				projection:       SMSConfigProjectionTable,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.sms_configs_smpp SET password = $1 WHERE (sms_id = $2) AND (instance_id = $3)",
+							expectedArgs: []interface{}{
+								&crypto.CryptoValue{
+									CryptoType: crypto.TypeEncryption,
+									Algorithm:  "RSA-265",
+									KeyID:      "key-id",
+									Crypted:    []byte("crypted"),
+								},
+								"id",
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.sms_configs SET (change_date, sequence) = ($1, $2) WHERE (id = $3) AND (instance_id = $4)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "instance.reduceSMSConfigActivated",
 			args: args{
@@ -69,6 +69,9 @@ const (
 	NotifyLastPhoneCol     = "last_phone"
 	NotifyVerifiedPhoneCol = "verified_phone"
 	NotifyPasswordSetCol   = "password_set"
+	// NotifyPasswordChangedCol and NotifyPasswordExpiryNotifiedCol are used to notify users about expiring passwords
+	NotifyPasswordChangedCol        = "password_changed"
+	NotifyPasswordExpiryNotifiedCol = "password_expiry_notified"
 )
 
 func newUserProjection(ctx context.Context, config crdb.StatementHandlerConfig) *userProjection {
@@ -129,6 +132,8 @@ func newUserProjection(ctx context.Context, config crdb.StatementHandlerConfig)
 			crdb.NewColumn(NotifyLastPhoneCol, crdb.ColumnTypeText, crdb.Nullable()),
 			crdb.NewColumn(NotifyVerifiedPhoneCol, crdb.ColumnTypeText, crdb.Nullable()),
 			crdb.NewColumn(NotifyPasswordSetCol, crdb.ColumnTypeBool, crdb.Default(false)),
+			crdb.NewColumn(NotifyPasswordChangedCol, crdb.ColumnTypeTimestamp, crdb.Nullable()),
+			crdb.NewColumn(NotifyPasswordExpiryNotifiedCol, crdb.ColumnTypeTimestamp, crdb.Nullable()),
 		},
 			crdb.NewPrimaryKey(NotifyUserIDCol, NotifyInstanceIDCol),
 			UserNotifySuffix,
@@ -272,6 +277,14 @@ func (p *userProjection) reducers() []handler.AggregateReducer {
 					Event:  user.HumanPasswordChangedType,
 					Reduce: p.reduceHumanPasswordChanged,
 				},
+				{
+					Event:  user.HumanPasswordExpiryNotificationRequestedType,
+					Reduce: p.reduceHumanPasswordExpiryNotificationRequested,
+				},
+				{
+					Event:  user.HumanPasswordExpiryNotificationSentType,
+					Reduce: p.reduceHumanPasswordExpiryNotificationSent,
+				},
 			},
 		},
 	}
@@ -319,6 +332,7 @@ func (p *userProjection) reduceHumanAdded(event eventstore.Event) (*handler.Stat
 				handler.NewCol(NotifyLastEmailCol, e.EmailAddress),
 				handler.NewCol(NotifyLastPhoneCol, &sql.NullString{String: e.PhoneNumber, Valid: e.PhoneNumber != ""}),
 				handler.NewCol(NotifyPasswordSetCol, e.Secret != nil),
+				handler.NewCol(NotifyPasswordChangedCol, &sql.NullTime{Time: e.CreationDate(), Valid: e.Secret != nil}),
 			},
 			crdb.WithTableSuffix(UserNotifySuffix),
 		),
@@ -367,6 +381,7 @@ func (p *userProjection) reduceHumanRegistered(event eventstore.Event) (*handler
 				handler.NewCol(NotifyLastEmailCol, e.EmailAddress),
 				handler.NewCol(NotifyLastPhoneCol, &sql.NullString{String: e.PhoneNumber, Valid: e.PhoneNumber != ""}),
 				handler.NewCol(NotifyPasswordSetCol, e.Secret != nil),
+				handler.NewCol(NotifyPasswordChangedCol, &sql.NullTime{Time: e.CreationDate(), Valid: e.Secret != nil}),
 			},
 			crdb.WithTableSuffix(UserNotifySuffix),
 		),
@@ -876,6 +891,47 @@ func (p *userProjection) reduceHumanPasswordChanged(event eventstore.Event) (*ha
 		e,
 		[]handler.Column{
 			handler.NewCol(NotifyPasswordSetCol, true),
+			handler.NewCol(NotifyPasswordChangedCol, e.CreationDate()),
+		},
+		[]handler.Condition{
+			handler.NewCond(NotifyUserIDCol, e.Aggregate().ID),
+			handler.NewCond(NotifyInstanceIDCol, e.Aggregate().InstanceID),
+		},
+		crdb.WithTableSuffix(UserNotifySuffix),
+	), nil
+}
+
+// reduceHumanPasswordExpiryNotificationRequested marks the user as notified,
+// the notification itself is sent by the notifications projection
+func (p *userProjection) reduceHumanPasswordExpiryNotificationRequested(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanPasswordExpiryNotificationRequestedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Pe7rN", "reduce.wrong.event.type %s", user.HumanPasswordExpiryNotificationRequestedType)
+	}
+
+	return crdb.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(NotifyPasswordExpiryNotifiedCol, e.CreationDate()),
+		},
+		[]handler.Condition{
+			handler.NewCond(NotifyUserIDCol, e.Aggregate().ID),
+			handler.NewCond(NotifyInstanceIDCol, e.Aggregate().InstanceID),
+		},
+		crdb.WithTableSuffix(UserNotifySuffix),
+	), nil
+}
+
+func (p *userProjection) reduceHumanPasswordExpiryNotificationSent(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanPasswordExpiryNotificationSentEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Pe4wQ", "reduce.wrong.event.type %s", user.HumanPasswordExpiryNotificationSentType)
+	}
+
+	return crdb.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(NotifyPasswordExpiryNotifiedCol, e.CreationDate()),
 		},
 		[]handler.Condition{
 			handler.NewCond(NotifyUserIDCol, e.Aggregate().ID),
@@ -79,13 +79,14 @@ func TestUserProjection_reduces(t *testing.T) {
 							},
 						},
 						{
-							expectedStmt: "INSERT INTO projections.users4_notifications (user_id, instance_id, last_email, last_phone, password_set) VALUES ($1, $2, $3, $4, $5)",
+							expectedStmt: "INSERT INTO projections.users4_notifications (user_id, instance_id, last_email, last_phone, password_set, password_changed) VALUES ($1, $2, $3, $4, $5, $6)",
 							expectedArgs: []interface{}{
 								"agg-id",
 								"instance-id",
 								"email@zitadel.com",
 								&sql.NullString{String: "+41 00 000 00 00", Valid: true},
 								false,
+								anyArg{},
 							},
 						},
 					},
@@ -149,13 +150,14 @@ func TestUserProjection_reduces(t *testing.T) {
 							},
 						},
 						{
-							expectedStmt: "INSERT INTO projections.users4_notifications (user_id, instance_id, last_email, last_phone, password_set) VALUES ($1, $2, $3, $4, $5)",
+							expectedStmt: "INSERT INTO projections.users4_notifications (user_id, instance_id, last_email, last_phone, password_set, password_changed) VALUES ($1, $2, $3, $4, $5, $6)",
 							expectedArgs: []interface{}{
 								"agg-id",
 								"instance-id",
 								"email@zitadel.com",
 								&sql.NullString{String: "+41 00 000 00 00", Valid: true},
 								false,
+								anyArg{},
 							},
 						},
 					},
@@ -214,13 +216,14 @@ func TestUserProjection_reduces(t *testing.T) {
 							},
 						},
 						{
-							expectedStmt: "INSERT INTO projections.users4_notifications (user_id, instance_id, last_email, last_phone, password_set) VALUES ($1, $2, $3, $4, $5)",
+							expectedStmt: "INSERT INTO projections.users4_notifications (user_id, instance_id, last_email, last_phone, password_set, password_changed) VALUES ($1, $2, $3, $4, $5, $6)",
 							expectedArgs: []interface{}{
 								"agg-id",
 								"instance-id",
 								"email@zitadel.com",
 								&sql.NullString{String: "", Valid: false},
 								false,
+								anyArg{},
 							},
 						},
 					},
@@ -284,13 +287,14 @@ func TestUserProjection_reduces(t *testing.T) {
 							},
 						},
 						{
-							expectedStmt: "INSERT INTO projections.users4_notifications (user_id, instance_id, last_email, last_phone, password_set) VALUES ($1, $2, $3, $4, $5)",
+							expectedStmt: "INSERT INTO projections.users4_notifications (user_id, instance_id, last_email, last_phone, password_set, password_changed) VALUES ($1, $2, $3, $4, $5, $6)",
 							expectedArgs: []interface{}{
 								"agg-id",
 								"instance-id",
 								"email@zitadel.com",
 								&sql.NullString{String: "+41 00 000 00 00", Valid: true},
 								false,
+								anyArg{},
 							},
 						},
 					},
@@ -354,13 +358,14 @@ func TestUserProjection_reduces(t *testing.T) {
 							},
 						},
 						{
-							expectedStmt: "INSERT INTO projections.users4_notifications (user_id, instance_id, last_email, last_phone, password_set) VALUES ($1, $2, $3, $4, $5)",
+							expectedStmt: "INSERT INTO projections.users4_notifications (user_id, instance_id, last_email, last_phone, password_set, password_changed) VALUES ($1, $2, $3, $4, $5, $6)",
 							expectedArgs: []interface{}{
 								"agg-id",
 								"instance-id",
 								"email@zitadel.com",
 								&sql.NullString{String: "+41 00 000 00 00", Valid: true},
 								false,
+								anyArg{},
 							},
 						},
 					},
@@ -419,13 +424,14 @@ func TestUserProjection_reduces(t *testing.T) {
 							},
 						},
 						{
-							expectedStmt: "INSERT INTO projections.users4_notifications (user_id, instance_id, last_email, last_phone, password_set) VALUES ($1, $2, $3, $4, $5)",
+							expectedStmt: "INSERT INTO projections.users4_notifications (user_id, instance_id, last_email, last_phone, password_set, password_changed) VALUES ($1, $2, $3, $4, $5, $6)",
 							expectedArgs: []interface{}{
 								"agg-id",
 								"instance-id",
 								"email@zitadel.com",
 								&sql.NullString{String: "", Valid: false},
 								false,
+								anyArg{},
 							},
 						},
 					},
@@ -1293,6 +1299,94 @@ func TestUserProjection_reduces(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "reduceHumanPasswordChanged",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(user.HumanPasswordChangedType),
+					user.AggregateType,
+					[]byte(`{}`),
+				), user.HumanPasswordChangedEventMapper),
+			},
+			reduce: (&userProjection{}).reduceHumanPasswordChanged,
+			want: wantReduce{
+				aggregateType:    user.AggregateType,
+				sequence:         15,
+				previousSequence: 10,
+				projection:       UserTable,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.users4_notifications SET (password_set, password_changed) = ($1, $2) WHERE (user_id = $3) AND (instance_id = $4)",
+							expectedArgs: []interface{}{
+								true,
+								anyArg{},
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceHumanPasswordExpiryNotificationRequested",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(user.HumanPasswordExpiryNotificationRequestedType),
+					user.AggregateType,
+					[]byte(`{"expirationDate": "2023-01-01T00:00:00Z"}`),
+				), user.HumanPasswordExpiryNotificationRequestedEventMapper),
+			},
+			reduce: (&userProjection{}).reduceHumanPasswordExpiryNotificationRequested,
+			want: wantReduce{
+				aggregateType:    user.AggregateType,
+				sequence:         15,
+				previousSequence: 10,
+				projection:       UserTable,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.users4_notifications SET password_expiry_notified = $1 WHERE (user_id = $2) AND (instance_id = $3)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceHumanPasswordExpiryNotificationSent",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(user.HumanPasswordExpiryNotificationSentType),
+					user.AggregateType,
+					[]byte(`{"expirationDate": "2023-01-01T00:00:00Z"}`),
+				), user.HumanPasswordExpiryNotificationSentEventMapper),
+			},
+			reduce: (&userProjection{}).reduceHumanPasswordExpiryNotificationSent,
+			want: wantReduce{
+				aggregateType:    user.AggregateType,
+				sequence:         15,
+				previousSequence: 10,
+				projection:       UserTable,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.users4_notifications SET password_expiry_notified = $1 WHERE (user_id = $2) AND (instance_id = $3)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "reduceUserV1EmailVerified",
 			args: args{
@@ -0,0 +1,128 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/eventstore"
+	"github.com/dennigogo/zitadel/internal/eventstore/handler"
+	"github.com/dennigogo/zitadel/internal/eventstore/handler/crdb"
+	"github.com/dennigogo/zitadel/internal/repository/user"
+)
+
+const (
+	UserTrustedDeviceProjectionTable = "projections.user_trusted_devices"
+
+	UserTrustedDeviceColumnUserID        = "user_id"
+	UserTrustedDeviceColumnUserAgentID   = "user_agent_id"
+	UserTrustedDeviceColumnCreationDate  = "creation_date"
+	UserTrustedDeviceColumnChangeDate    = "change_date"
+	UserTrustedDeviceColumnSequence      = "sequence"
+	UserTrustedDeviceColumnResourceOwner = "resource_owner"
+	UserTrustedDeviceColumnInstanceID    = "instance_id"
+	UserTrustedDeviceColumnDescription   = "description"
+)
+
+type userTrustedDeviceProjection struct {
+	crdb.StatementHandler
+}
+
+func newUserTrustedDeviceProjection(ctx context.Context, config crdb.StatementHandlerConfig) *userTrustedDeviceProjection {
+	p := new(userTrustedDeviceProjection)
+	config.ProjectionName = UserTrustedDeviceProjectionTable
+	config.Reducers = p.reducers()
+	config.InitCheck = crdb.NewTableCheck(
+		crdb.NewTable([]*crdb.Column{
+			crdb.NewColumn(UserTrustedDeviceColumnUserID, crdb.ColumnTypeText),
+			crdb.NewColumn(UserTrustedDeviceColumnUserAgentID, crdb.ColumnTypeText),
+			crdb.NewColumn(UserTrustedDeviceColumnCreationDate, crdb.ColumnTypeTimestamp),
+			crdb.NewColumn(UserTrustedDeviceColumnChangeDate, crdb.ColumnTypeTimestamp),
+			crdb.NewColumn(UserTrustedDeviceColumnSequence, crdb.ColumnTypeInt64),
+			crdb.NewColumn(UserTrustedDeviceColumnResourceOwner, crdb.ColumnTypeText),
+			crdb.NewColumn(UserTrustedDeviceColumnInstanceID, crdb.ColumnTypeText),
+			crdb.NewColumn(UserTrustedDeviceColumnDescription, crdb.ColumnTypeText, crdb.Default("")),
+		},
+			crdb.NewPrimaryKey(UserTrustedDeviceColumnInstanceID, UserTrustedDeviceColumnUserID, UserTrustedDeviceColumnUserAgentID),
+			crdb.WithIndex(crdb.NewIndex("usr_td_ro_idx", []string{UserTrustedDeviceColumnResourceOwner})),
+		),
+	)
+
+	p.StatementHandler = crdb.NewStatementHandler(ctx, config)
+	return p
+}
+
+func (p *userTrustedDeviceProjection) reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: user.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  user.HumanMFADeviceTrustedType,
+					Reduce: p.reduceDeviceTrusted,
+				},
+				{
+					Event:  user.HumanMFADeviceRevokedType,
+					Reduce: p.reduceDeviceRevoked,
+				},
+				{
+					Event:  user.UserRemovedType,
+					Reduce: p.reduceUserRemoved,
+				},
+			},
+		},
+	}
+}
+
+func (p *userTrustedDeviceProjection) reduceDeviceTrusted(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanMFADeviceTrustedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Td6rk", "reduce.wrong.event.type %s", user.HumanMFADeviceTrustedType)
+	}
+	return crdb.NewUpsertStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(UserTrustedDeviceColumnInstanceID, nil),
+			handler.NewCol(UserTrustedDeviceColumnUserID, nil),
+			handler.NewCol(UserTrustedDeviceColumnUserAgentID, nil),
+		},
+		[]handler.Column{
+			handler.NewCol(UserTrustedDeviceColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCol(UserTrustedDeviceColumnUserID, e.Aggregate().ID),
+			handler.NewCol(UserTrustedDeviceColumnUserAgentID, e.UserAgentID),
+			handler.NewCol(UserTrustedDeviceColumnResourceOwner, e.Aggregate().ResourceOwner),
+			handler.NewCol(UserTrustedDeviceColumnCreationDate, e.CreationDate()),
+			handler.NewCol(UserTrustedDeviceColumnChangeDate, e.CreationDate()),
+			handler.NewCol(UserTrustedDeviceColumnSequence, e.Sequence()),
+			handler.NewCol(UserTrustedDeviceColumnDescription, e.Description),
+		},
+	), nil
+}
+
+func (p *userTrustedDeviceProjection) reduceDeviceRevoked(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanMFADeviceRevokedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Td3ws", "reduce.wrong.event.type %s", user.HumanMFADeviceRevokedType)
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(UserTrustedDeviceColumnUserID, e.Aggregate().ID),
+			handler.NewCond(UserTrustedDeviceColumnUserAgentID, e.UserAgentID),
+			handler.NewCond(UserTrustedDeviceColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *userTrustedDeviceProjection) reduceUserRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.UserRemovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Td9pl", "reduce.wrong.event.type %s", user.UserRemovedType)
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(UserTrustedDeviceColumnUserID, e.Aggregate().ID),
+			handler.NewCond(UserTrustedDeviceColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
@@ -0,0 +1,133 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/eventstore"
+	"github.com/dennigogo/zitadel/internal/eventstore/handler"
+	"github.com/dennigogo/zitadel/internal/eventstore/repository"
+	"github.com/dennigogo/zitadel/internal/repository/user"
+)
+
+func TestUserTrustedDeviceProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceDeviceTrusted",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(user.HumanMFADeviceTrustedType),
+					user.AggregateType,
+					[]byte(`{
+						"userAgentID": "agent-id",
+						"description": "browser"
+					}`),
+				), user.HumanMFADeviceTrustedEventMapper),
+			},
+			reduce: (&userTrustedDeviceProjection{}).reduceDeviceTrusted,
+			want: wantReduce{
+				aggregateType:    user.AggregateType,
+				sequence:         15,
+				previousSequence: 10,
+				projection:       UserTrustedDeviceProjectionTable,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.user_trusted_devices (instance_id, user_id, user_agent_id, resource_owner, creation_date, change_date, sequence, description) VALUES ($1, $2, $3, $4, $5, $6, $7, $8) ON CONFLICT (instance_id, user_id, user_agent_id) DO UPDATE SET (resource_owner, creation_date, change_date, sequence, description) = (EXCLUDED.resource_owner, EXCLUDED.creation_date, EXCLUDED.change_date, EXCLUDED.sequence, EXCLUDED.description)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								"agent-id",
+								"ro-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"browser",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceDeviceRevoked",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(user.HumanMFADeviceRevokedType),
+					user.AggregateType,
+					[]byte(`{
+						"userAgentID": "agent-id"
+					}`),
+				), user.HumanMFADeviceRevokedEventMapper),
+			},
+			reduce: (&userTrustedDeviceProjection{}).reduceDeviceRevoked,
+			want: wantReduce{
+				aggregateType:    user.AggregateType,
+				sequence:         15,
+				previousSequence: 10,
+				projection:       UserTrustedDeviceProjectionTable,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.user_trusted_devices WHERE (user_id = $1) AND (user_agent_id = $2) AND (instance_id = $3)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"agent-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceUserRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(user.UserRemovedType),
+					user.AggregateType,
+					nil,
+				), user.UserRemovedEventMapper),
+			},
+			reduce: (&userTrustedDeviceProjection{}).reduceUserRemoved,
+			want: wantReduce{
+				aggregateType:    user.AggregateType,
+				sequence:         15,
+				previousSequence: 10,
+				projection:       UserTrustedDeviceProjectionTable,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.user_trusted_devices WHERE (user_id = $1) AND (instance_id = $2)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if _, ok := err.(errors.InvalidArgument); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, tt.want)
+		})
+	}
+}
@@ -0,0 +1,256 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/dennigogo/zitadel/internal/database"
+	"github.com/dennigogo/zitadel/internal/domain"
+	"github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/eventstore"
+	"github.com/dennigogo/zitadel/internal/eventstore/handler"
+	"github.com/dennigogo/zitadel/internal/eventstore/handler/crdb"
+	"github.com/dennigogo/zitadel/internal/repository/org"
+	"github.com/dennigogo/zitadel/internal/repository/webhook"
+)
+
+const (
+	WebhookTable            = "projections.webhooks"
+	WebhookIDCol            = "id"
+	WebhookCreationDateCol  = "creation_date"
+	WebhookChangeDateCol    = "change_date"
+	WebhookResourceOwnerCol = "resource_owner"
+	WebhookInstanceIDCol    = "instance_id"
+	WebhookStateCol         = "webhook_state"
+	WebhookSequenceCol      = "sequence"
+	WebhookNameCol          = "name"
+	WebhookURLCol           = "url"
+	WebhookEventTypesCol    = "event_types"
+	WebhookSigningSecretCol = "signing_secret"
+)
+
+// the delivery log is written by the webhook delivery handler
+const (
+	WebhookDeliveryTable            = "projections.webhook_deliveries"
+	WebhookDeliveryWebhookIDCol     = "webhook_id"
+	WebhookDeliveryCreationDateCol  = "creation_date"
+	WebhookDeliveryChangeDateCol    = "change_date"
+	WebhookDeliveryResourceOwnerCol = "resource_owner"
+	WebhookDeliveryInstanceIDCol    = "instance_id"
+	WebhookDeliveryStateCol         = "delivery_state"
+	WebhookDeliveryEventSequenceCol = "event_sequence"
+	WebhookDeliveryEventTypeCol     = "event_type"
+	WebhookDeliveryAggregateTypeCol = "aggregate_type"
+	WebhookDeliveryAggregateIDCol   = "aggregate_id"
+	WebhookDeliveryAttemptsCol      = "attempts"
+	WebhookDeliveryStatusCodeCol    = "status_code"
+	WebhookDeliveryErrorCol         = "error"
+)
+
+type webhookProjection struct {
+	crdb.StatementHandler
+}
+
+func newWebhookProjection(ctx context.Context, config crdb.StatementHandlerConfig) *webhookProjection {
+	p := new(webhookProjection)
+	config.ProjectionName = WebhookTable
+	config.Reducers = p.reducers()
+	config.InitCheck = crdb.NewTableCheck(
+		crdb.NewTable([]*crdb.Column{
+			crdb.NewColumn(WebhookIDCol, crdb.ColumnTypeText),
+			crdb.NewColumn(WebhookCreationDateCol, crdb.ColumnTypeTimestamp),
+			crdb.NewColumn(WebhookChangeDateCol, crdb.ColumnTypeTimestamp),
+			crdb.NewColumn(WebhookResourceOwnerCol, crdb.ColumnTypeText),
+			crdb.NewColumn(WebhookInstanceIDCol, crdb.ColumnTypeText),
+			crdb.NewColumn(WebhookStateCol, crdb.ColumnTypeEnum),
+			crdb.NewColumn(WebhookSequenceCol, crdb.ColumnTypeInt64),
+			crdb.NewColumn(WebhookNameCol, crdb.ColumnTypeText),
+			crdb.NewColumn(WebhookURLCol, crdb.ColumnTypeText),
+			crdb.NewColumn(WebhookEventTypesCol, crdb.ColumnTypeTextArray),
+			crdb.NewColumn(WebhookSigningSecretCol, crdb.ColumnTypeJSONB),
+		},
+			crdb.NewPrimaryKey(WebhookInstanceIDCol, WebhookIDCol),
+			crdb.WithIndex(crdb.NewIndex("webhooks_ro_idx", []string{WebhookResourceOwnerCol})),
+		),
+	)
+	p.StatementHandler = crdb.NewStatementHandler(ctx, config)
+	return p
+}
+
+func (p *webhookProjection) reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: webhook.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  webhook.AddedEventType,
+					Reduce: p.reduceWebhookAdded,
+				},
+				{
+					Event:  webhook.ChangedEventType,
+					Reduce: p.reduceWebhookChanged,
+				},
+				{
+					Event:  webhook.SecretChangedEventType,
+					Reduce: p.reduceWebhookSecretChanged,
+				},
+				{
+					Event:  webhook.DeactivatedEventType,
+					Reduce: p.reduceWebhookDeactivated,
+				},
+				{
+					Event:  webhook.ReactivatedEventType,
+					Reduce: p.reduceWebhookReactivated,
+				},
+				{
+					Event:  webhook.RemovedEventType,
+					Reduce: p.reduceWebhookRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+	}
+}
+
+func (p *webhookProjection) reduceWebhookAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*webhook.AddedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Wk3s8", "reduce.wrong.event.type %s", webhook.AddedEventType)
+	}
+	return crdb.NewCreateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(WebhookIDCol, e.Aggregate().ID),
+			handler.NewCol(WebhookCreationDateCol, e.CreationDate()),
+			handler.NewCol(WebhookChangeDateCol, e.CreationDate()),
+			handler.NewCol(WebhookResourceOwnerCol, e.Aggregate().ResourceOwner),
+			handler.NewCol(WebhookInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCol(WebhookSequenceCol, e.Sequence()),
+			handler.NewCol(WebhookNameCol, e.Name),
+			handler.NewCol(WebhookURLCol, e.URL),
+			handler.NewCol(WebhookEventTypesCol, database.StringArray(e.EventTypes)),
+			handler.NewCol(WebhookSigningSecretCol, e.Secret),
+			handler.NewCol(WebhookStateCol, domain.WebhookStateActive),
+		},
+	), nil
+}
+
+func (p *webhookProjection) reduceWebhookChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*webhook.ChangedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Wm7d2", "reduce.wrong.event.type %s", webhook.ChangedEventType)
+	}
+	values := []handler.Column{
+		handler.NewCol(WebhookChangeDateCol, e.CreationDate()),
+		handler.NewCol(WebhookSequenceCol, e.Sequence()),
+	}
+	if e.Name != nil {
+		values = append(values, handler.NewCol(WebhookNameCol, *e.Name))
+	}
+	if e.URL != nil {
+		values = append(values, handler.NewCol(WebhookURLCol, *e.URL))
+	}
+	if e.EventTypes != nil {
+		values = append(values, handler.NewCol(WebhookEventTypesCol, database.StringArray(e.EventTypes)))
+	}
+	return crdb.NewUpdateStatement(
+		e,
+		values,
+		[]handler.Condition{
+			handler.NewCond(WebhookIDCol, e.Aggregate().ID),
+			handler.NewCond(WebhookInstanceIDCol, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *webhookProjection) reduceWebhookSecretChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*webhook.SecretChangedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Wq1n6", "reduce.wrong.event.type %s", webhook.SecretChangedEventType)
+	}
+	return crdb.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(WebhookChangeDateCol, e.CreationDate()),
+			handler.NewCol(WebhookSequenceCol, e.Sequence()),
+			handler.NewCol(WebhookSigningSecretCol, e.Secret),
+		},
+		[]handler.Condition{
+			handler.NewCond(WebhookIDCol, e.Aggregate().ID),
+			handler.NewCond(WebhookInstanceIDCol, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *webhookProjection) reduceWebhookDeactivated(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*webhook.DeactivatedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Wt5c9", "reduce.wrong.event.type %s", webhook.DeactivatedEventType)
+	}
+	return crdb.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(WebhookChangeDateCol, e.CreationDate()),
+			handler.NewCol(WebhookSequenceCol, e.Sequence()),
+			handler.NewCol(WebhookStateCol, domain.WebhookStateInactive),
+		},
+		[]handler.Condition{
+			handler.NewCond(WebhookIDCol, e.Aggregate().ID),
+			handler.NewCond(WebhookInstanceIDCol, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *webhookProjection) reduceWebhookReactivated(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*webhook.ReactivatedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Wx2v4", "reduce.wrong.event.type %s", webhook.ReactivatedEventType)
+	}
+	return crdb.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(WebhookChangeDateCol, e.CreationDate()),
+			handler.NewCol(WebhookSequenceCol, e.Sequence()),
+			handler.NewCol(WebhookStateCol, domain.WebhookStateActive),
+		},
+		[]handler.Condition{
+			handler.NewCond(WebhookIDCol, e.Aggregate().ID),
+			handler.NewCond(WebhookInstanceIDCol, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *webhookProjection) reduceWebhookRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*webhook.RemovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Wz8h1", "reduce.wrong.event.type %s", webhook.RemovedEventType)
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(WebhookIDCol, e.Aggregate().ID),
+			handler.NewCond(WebhookInstanceIDCol, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *webhookProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgRemovedEvent)
+	if !ok {
+		return nil, errors.ThrowInvalidArgumentf(nil, "HANDL-Wr4f7", "reduce.wrong.event.type %s", org.OrgRemovedEventType)
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(WebhookResourceOwnerCol, e.Aggregate().ID),
+			handler.NewCond(WebhookInstanceIDCol, e.Aggregate().InstanceID),
+		},
+	), nil
+}
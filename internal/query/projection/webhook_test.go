@@ -0,0 +1,267 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/dennigogo/zitadel/internal/crypto"
+	"github.com/dennigogo/zitadel/internal/database"
+	"github.com/dennigogo/zitadel/internal/domain"
+	"github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/eventstore"
+	"github.com/dennigogo/zitadel/internal/eventstore/handler"
+	"github.com/dennigogo/zitadel/internal/eventstore/repository"
+	"github.com/dennigogo/zitadel/internal/repository/org"
+	"github.com/dennigogo/zitadel/internal/repository/webhook"
+)
+
+func TestWebhookProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceWebhookAdded",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(webhook.AddedEventType),
+					webhook.AggregateType,
+					[]byte(`{"name": "name", "url": "https://example.com/hook", "eventTypes": ["user.human.added"], "secret": {"cryptoType": 0, "algorithm": "RSA-265", "keyId": "key-id"}}`),
+				), webhook.AddedEventMapper),
+			},
+			reduce: (&webhookProjection{}).reduceWebhookAdded,
+			want: wantReduce{
+				projection:       WebhookTable,
+				aggregateType:    eventstore.AggregateType("webhook"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.webhooks (id, creation_date, change_date, resource_owner, instance_id, sequence, name, url, event_types, signing_secret, webhook_state) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								anyArg{},
+								anyArg{},
+								"ro-id",
+								"instance-id",
+								uint64(15),
+								"name",
+								"https://example.com/hook",
+								database.StringArray{"user.human.added"},
+								&crypto.CryptoValue{
+									CryptoType: crypto.TypeEncryption,
+									Algorithm:  "RSA-265",
+									KeyID:      "key-id",
+								},
+								domain.WebhookStateActive,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceWebhookChanged",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(webhook.ChangedEventType),
+					webhook.AggregateType,
+					[]byte(`{"url": "https://example.com/hook2", "eventTypes": ["user.human.added", "user.grant.changed"]}`),
+				), webhook.ChangedEventMapper),
+			},
+			reduce: (&webhookProjection{}).reduceWebhookChanged,
+			want: wantReduce{
+				projection:       WebhookTable,
+				aggregateType:    eventstore.AggregateType("webhook"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.webhooks SET (change_date, sequence, url, event_types) = ($1, $2, $3, $4) WHERE (id = $5) AND (instance_id = $6)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"https://example.com/hook2",
+								database.StringArray{"user.human.added", "user.grant.changed"},
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceWebhookSecretChanged",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(webhook.SecretChangedEventType),
+					webhook.AggregateType,
+					[]byte(`{"secret": {"cryptoType": 0, "algorithm": "RSA-265", "keyId": "key-id"}}`),
+				), webhook.SecretChangedEventMapper),
+			},
+			reduce: (&webhookProjection{}).reduceWebhookSecretChanged,
+			want: wantReduce{
+				projection:       WebhookTable,
+				aggregateType:    eventstore.AggregateType("webhook"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.webhooks SET (change_date, sequence, signing_secret) = ($1, $2, $3) WHERE (id = $4) AND (instance_id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								&crypto.CryptoValue{
+									CryptoType: crypto.TypeEncryption,
+									Algorithm:  "RSA-265",
+									KeyID:      "key-id",
+								},
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceWebhookDeactivated",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(webhook.DeactivatedEventType),
+					webhook.AggregateType,
+					[]byte(`{}`),
+				), webhook.DeactivatedEventMapper),
+			},
+			reduce: (&webhookProjection{}).reduceWebhookDeactivated,
+			want: wantReduce{
+				projection:       WebhookTable,
+				aggregateType:    eventstore.AggregateType("webhook"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.webhooks SET (change_date, sequence, webhook_state) = ($1, $2, $3) WHERE (id = $4) AND (instance_id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.WebhookStateInactive,
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceWebhookReactivated",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(webhook.ReactivatedEventType),
+					webhook.AggregateType,
+					[]byte(`{}`),
+				), webhook.ReactivatedEventMapper),
+			},
+			reduce: (&webhookProjection{}).reduceWebhookReactivated,
+			want: wantReduce{
+				projection:       WebhookTable,
+				aggregateType:    eventstore.AggregateType("webhook"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.webhooks SET (change_date, sequence, webhook_state) = ($1, $2, $3) WHERE (id = $4) AND (instance_id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.WebhookStateActive,
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceWebhookRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(webhook.RemovedEventType),
+					webhook.AggregateType,
+					[]byte(`{}`),
+				), webhook.RemovedEventMapper),
+			},
+			reduce: (&webhookProjection{}).reduceWebhookRemoved,
+			want: wantReduce{
+				projection:       WebhookTable,
+				aggregateType:    eventstore.AggregateType("webhook"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.webhooks WHERE (id = $1) AND (instance_id = $2)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceOwnerRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(org.OrgRemovedEventType),
+					org.AggregateType,
+					[]byte(`{}`),
+				), org.OrgRemovedEventMapper),
+			},
+			reduce: (&webhookProjection{}).reduceOwnerRemoved,
+			want: wantReduce{
+				projection:       WebhookTable,
+				aggregateType:    eventstore.AggregateType("org"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.webhooks WHERE (resource_owner = $1) AND (instance_id = $2)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if _, ok := err.(errors.InvalidArgument); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, tt.want)
+		})
+	}
+}
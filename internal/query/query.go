@@ -17,12 +17,16 @@ import (
 	"github.com/dennigogo/zitadel/internal/eventstore"
 	"github.com/dennigogo/zitadel/internal/query/projection"
 	"github.com/dennigogo/zitadel/internal/repository/action"
+	"github.com/dennigogo/zitadel/internal/repository/deviceauth"
 	iam_repo "github.com/dennigogo/zitadel/internal/repository/instance"
 	"github.com/dennigogo/zitadel/internal/repository/keypair"
+	"github.com/dennigogo/zitadel/internal/repository/kvstore"
 	"github.com/dennigogo/zitadel/internal/repository/org"
 	"github.com/dennigogo/zitadel/internal/repository/project"
+	"github.com/dennigogo/zitadel/internal/repository/quota"
 	usr_repo "github.com/dennigogo/zitadel/internal/repository/user"
 	"github.com/dennigogo/zitadel/internal/repository/usergrant"
+	"github.com/dennigogo/zitadel/internal/repository/webhook"
 )
 
 type Queries struct {
@@ -70,6 +74,10 @@ func StartQueries(ctx context.Context, es *eventstore.Eventstore, sqlClient *sql
 	action.RegisterEventMappers(repo.eventstore)
 	keypair.RegisterEventMappers(repo.eventstore)
 	usergrant.RegisterEventMappers(repo.eventstore)
+	webhook.RegisterEventMappers(repo.eventstore)
+	kvstore.RegisterEventMappers(repo.eventstore)
+	deviceauth.RegisterEventMappers(repo.eventstore)
+	quota.RegisterEventMappers(repo.eventstore)
 
 	repo.idpConfigEncryption = idpConfigEncryption
 	repo.multifactors = domain.MultifactorConfigs{
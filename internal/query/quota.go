@@ -0,0 +1,288 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	errs "errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/dennigogo/zitadel/internal/api/authz"
+	"github.com/dennigogo/zitadel/internal/domain"
+	"github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/query/projection"
+)
+
+// the usage of the periodic quotas is written by the metering of the quotas
+const (
+	QuotaPeriodTable = "system.quota_periods"
+
+	QuotaPeriodInstanceIDCol = "instance_id"
+	QuotaPeriodUnitCol       = "unit"
+	QuotaPeriodStartCol      = "start"
+	QuotaPeriodUsageCol      = "usage"
+)
+
+var (
+	quotaTable = table{
+		name: projection.QuotaTable,
+	}
+	QuotaColumnInstanceID = Column{
+		name:  projection.QuotaInstanceIDCol,
+		table: quotaTable,
+	}
+	QuotaColumnUnit = Column{
+		name:  projection.QuotaUnitCol,
+		table: quotaTable,
+	}
+	QuotaColumnCreationDate = Column{
+		name:  projection.QuotaCreationDateCol,
+		table: quotaTable,
+	}
+	QuotaColumnChangeDate = Column{
+		name:  projection.QuotaChangeDateCol,
+		table: quotaTable,
+	}
+	QuotaColumnSequence = Column{
+		name:  projection.QuotaSequenceCol,
+		table: quotaTable,
+	}
+	QuotaColumnFrom = Column{
+		name:  projection.QuotaFromCol,
+		table: quotaTable,
+	}
+	QuotaColumnResetInterval = Column{
+		name:  projection.QuotaResetIntervalCol,
+		table: quotaTable,
+	}
+	QuotaColumnAmount = Column{
+		name:  projection.QuotaAmountCol,
+		table: quotaTable,
+	}
+	QuotaColumnLimit = Column{
+		name:  projection.QuotaLimitCol,
+		table: quotaTable,
+	}
+	QuotaColumnNotifications = Column{
+		name:  projection.QuotaNotificationsCol,
+		table: quotaTable,
+	}
+
+	quotaPeriodTable = table{
+		name: QuotaPeriodTable,
+	}
+	QuotaPeriodColumnInstanceID = Column{
+		name:  QuotaPeriodInstanceIDCol,
+		table: quotaPeriodTable,
+	}
+	QuotaPeriodColumnUnit = Column{
+		name:  QuotaPeriodUnitCol,
+		table: quotaPeriodTable,
+	}
+	QuotaPeriodColumnStart = Column{
+		name:  QuotaPeriodStartCol,
+		table: quotaPeriodTable,
+	}
+	QuotaPeriodColumnUsage = Column{
+		name:  QuotaPeriodUsageCol,
+		table: quotaPeriodTable,
+	}
+)
+
+type Quotas struct {
+	SearchResponse
+	Quotas []*Quota
+}
+
+type Quota struct {
+	InstanceID    string
+	Unit          domain.QuotaUnit
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	Sequence      uint64
+	From          time.Time
+	ResetInterval time.Duration
+	Amount        uint64
+	Limit         bool
+	Notifications []*QuotaNotification
+}
+
+type QuotaNotification struct {
+	Percent uint16 `json:"percent"`
+	Repeat  bool   `json:"repeat,omitempty"`
+	CallURL string `json:"callUrl"`
+}
+
+// PeriodStart returns the start of the period of the quota containing t,
+// quotas which are not periodic only have a single period
+func (q *Quota) PeriodStart(t time.Time) time.Time {
+	if q.ResetInterval <= 0 || t.Before(q.From) {
+		return q.From
+	}
+	return q.From.Add(t.Sub(q.From) / q.ResetInterval * q.ResetInterval)
+}
+
+// GetQuota returns the quota of the unit of the instance in the context
+func (q *Queries) GetQuota(ctx context.Context, unit domain.QuotaUnit) (*Quota, error) {
+	stmt, scan := prepareQuotaQuery()
+	query, args, err := stmt.Where(
+		sq.Eq{
+			QuotaColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+			QuotaColumnUnit.identifier():       unit,
+		}).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Qt2g5", "Errors.Query.SQLStatement")
+	}
+
+	row := q.client.QueryRowContext(ctx, query, args...)
+	return scan(row)
+}
+
+// SearchQuotas returns all quotas of the instance in the context
+func (q *Queries) SearchQuotas(ctx context.Context) (*Quotas, error) {
+	stmt, scan := prepareQuotasQuery()
+	query, args, err := stmt.Where(
+		sq.Eq{
+			QuotaColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+		}).
+		OrderBy(QuotaColumnUnit.identifier()).
+		ToSql()
+	if err != nil {
+		return nil, errors.ThrowInvalidArgument(err, "QUERY-Qt5s8", "Errors.Query.InvalidRequest")
+	}
+
+	rows, err := q.client.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Qt8e1", "Errors.Internal")
+	}
+	return scan(rows)
+}
+
+// QuotaUsage returns the usage of the quota at the time t:
+// the metered usage of the current period of periodic quotas
+// or the current amount of users or organisations
+func (q *Queries) QuotaUsage(ctx context.Context, quota *Quota, t time.Time) (uint64, error) {
+	var stmt sq.SelectBuilder
+	switch quota.Unit {
+	case domain.QuotaUnitUsers:
+		stmt = sq.Select("COUNT(*)").
+			From(userTable.identifier()).
+			Where(sq.Eq{UserInstanceIDCol.identifier(): quota.InstanceID})
+	case domain.QuotaUnitOrgs:
+		stmt = sq.Select("COUNT(*)").
+			From(orgsTable.identifier()).
+			Where(sq.Eq{OrgColumnInstanceID.identifier(): quota.InstanceID})
+	default:
+		stmt = sq.Select(QuotaPeriodColumnUsage.identifier()).
+			From(quotaPeriodTable.identifier()).
+			Where(sq.Eq{
+				QuotaPeriodColumnInstanceID.identifier(): quota.InstanceID,
+				QuotaPeriodColumnUnit.identifier():       quota.Unit,
+				QuotaPeriodColumnStart.identifier():      quota.PeriodStart(t),
+			})
+	}
+	query, args, err := stmt.PlaceholderFormat(sq.Dollar).ToSql()
+	if err != nil {
+		return 0, errors.ThrowInternal(err, "QUERY-Qt3u7", "Errors.Query.SQLStatement")
+	}
+
+	var usage uint64
+	err = q.client.QueryRowContext(ctx, query, args...).Scan(&usage)
+	if err != nil && !errs.Is(err, sql.ErrNoRows) {
+		return 0, errors.ThrowInternal(err, "QUERY-Qt6w4", "Errors.Internal")
+	}
+	return usage, nil
+}
+
+func prepareQuotaQuery() (sq.SelectBuilder, func(row *sql.Row) (*Quota, error)) {
+	return sq.Select(
+			QuotaColumnInstanceID.identifier(),
+			QuotaColumnUnit.identifier(),
+			QuotaColumnCreationDate.identifier(),
+			QuotaColumnChangeDate.identifier(),
+			QuotaColumnSequence.identifier(),
+			QuotaColumnFrom.identifier(),
+			QuotaColumnResetInterval.identifier(),
+			QuotaColumnAmount.identifier(),
+			QuotaColumnLimit.identifier(),
+			QuotaColumnNotifications.identifier(),
+		).From(quotaTable.identifier()).PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*Quota, error) {
+			quota, err := scanQuota(row.Scan)
+			if err != nil {
+				if errs.Is(err, sql.ErrNoRows) {
+					return nil, errors.ThrowNotFound(err, "QUERY-Qt9n2", "Errors.Quota.NotFound")
+				}
+				return nil, errors.ThrowInternal(err, "QUERY-Qt1i6", "Errors.Internal")
+			}
+			return quota, nil
+		}
+}
+
+func prepareQuotasQuery() (sq.SelectBuilder, func(rows *sql.Rows) (*Quotas, error)) {
+	return sq.Select(
+			QuotaColumnInstanceID.identifier(),
+			QuotaColumnUnit.identifier(),
+			QuotaColumnCreationDate.identifier(),
+			QuotaColumnChangeDate.identifier(),
+			QuotaColumnSequence.identifier(),
+			QuotaColumnFrom.identifier(),
+			QuotaColumnResetInterval.identifier(),
+			QuotaColumnAmount.identifier(),
+			QuotaColumnLimit.identifier(),
+			QuotaColumnNotifications.identifier(),
+		).From(quotaTable.identifier()).PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*Quotas, error) {
+			quotas := make([]*Quota, 0)
+			for rows.Next() {
+				quota, err := scanQuota(rows.Scan)
+				if err != nil {
+					return nil, errors.ThrowInternal(err, "QUERY-Qt4c9", "Errors.Internal")
+				}
+				quotas = append(quotas, quota)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, errors.ThrowInternal(err, "QUERY-Qt7l3", "Errors.Query.CloseRows")
+			}
+
+			return &Quotas{
+				Quotas: quotas,
+				SearchResponse: SearchResponse{
+					Count: uint64(len(quotas)),
+				},
+			}, nil
+		}
+}
+
+func scanQuota(scan func(dest ...interface{}) error) (*Quota, error) {
+	quota := new(Quota)
+	var (
+		from          sql.NullTime
+		notifications []byte
+	)
+	err := scan(
+		&quota.InstanceID,
+		&quota.Unit,
+		&quota.CreationDate,
+		&quota.ChangeDate,
+		&quota.Sequence,
+		&from,
+		&quota.ResetInterval,
+		&quota.Amount,
+		&quota.Limit,
+		&notifications,
+	)
+	if err != nil {
+		return nil, err
+	}
+	quota.From = from.Time
+	if len(notifications) > 0 {
+		if err = json.Unmarshal(notifications, &quota.Notifications); err != nil {
+			return nil, err
+		}
+	}
+	return quota, nil
+}
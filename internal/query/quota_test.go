@@ -0,0 +1,241 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dennigogo/zitadel/internal/domain"
+	errs "github.com/dennigogo/zitadel/internal/errors"
+)
+
+var (
+	quotaQuery = regexp.QuoteMeta(`SELECT projections.quotas.instance_id,` +
+		` projections.quotas.unit,` +
+		` projections.quotas.creation_date,` +
+		` projections.quotas.change_date,` +
+		` projections.quotas.sequence,` +
+		` projections.quotas.from_anchor,` +
+		` projections.quotas.reset_interval,` +
+		` projections.quotas.amount,` +
+		` projections.quotas.limit_usage,` +
+		` projections.quotas.notifications` +
+		` FROM projections.quotas`)
+	quotaCols = []string{
+		"instance_id",
+		"unit",
+		"creation_date",
+		"change_date",
+		"sequence",
+		"from_anchor",
+		"reset_interval",
+		"amount",
+		"limit_usage",
+		"notifications",
+	}
+)
+
+func Test_QuotaPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareQuotaQuery no result",
+			prepare: prepareQuotaQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					quotaQuery,
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !errs.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*Quota)(nil),
+		},
+		{
+			name:    "prepareQuotaQuery found",
+			prepare: prepareQuotaQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					quotaQuery,
+					quotaCols,
+					[]driver.Value{
+						"instance-id",
+						domain.QuotaUnitRequestsAllAuthenticated,
+						testNow,
+						testNow,
+						uint64(20211109),
+						testNow,
+						int64(24 * time.Hour),
+						uint64(1000),
+						true,
+						[]byte(`[{"percent":80,"repeat":true,"callUrl":"https://example.com/quota"}]`),
+					},
+				),
+			},
+			object: &Quota{
+				InstanceID:    "instance-id",
+				Unit:          domain.QuotaUnitRequestsAllAuthenticated,
+				CreationDate:  testNow,
+				ChangeDate:    testNow,
+				Sequence:      20211109,
+				From:          testNow,
+				ResetInterval: 24 * time.Hour,
+				Amount:        1000,
+				Limit:         true,
+				Notifications: []*QuotaNotification{
+					{Percent: 80, Repeat: true, CallURL: "https://example.com/quota"},
+				},
+			},
+		},
+		{
+			name:    "prepareQuotaQuery sql err",
+			prepare: prepareQuotaQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					quotaQuery,
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: nil,
+		},
+		{
+			name:    "prepareQuotasQuery no result",
+			prepare: prepareQuotasQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					quotaQuery,
+					nil,
+					nil,
+				),
+			},
+			object: &Quotas{Quotas: []*Quota{}},
+		},
+		{
+			name:    "prepareQuotasQuery one result",
+			prepare: prepareQuotasQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					quotaQuery,
+					quotaCols,
+					[][]driver.Value{
+						{
+							"instance-id",
+							domain.QuotaUnitUsers,
+							testNow,
+							testNow,
+							uint64(20211109),
+							nil,
+							int64(0),
+							uint64(10),
+							true,
+							nil,
+						},
+					},
+				),
+			},
+			object: &Quotas{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				Quotas: []*Quota{
+					{
+						InstanceID:   "instance-id",
+						Unit:         domain.QuotaUnitUsers,
+						CreationDate: testNow,
+						ChangeDate:   testNow,
+						Sequence:     20211109,
+						Amount:       10,
+						Limit:        true,
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareQuotasQuery sql err",
+			prepare: prepareQuotasQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					quotaQuery,
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err)
+		})
+	}
+}
+
+func TestQuota_PeriodStart(t *testing.T) {
+	from := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name  string
+		quota *Quota
+		t     time.Time
+		want  time.Time
+	}{
+		{
+			name:  "not periodic",
+			quota: &Quota{},
+			t:     from,
+			want:  time.Time{},
+		},
+		{
+			name:  "before from",
+			quota: &Quota{From: from, ResetInterval: 24 * time.Hour},
+			t:     from.Add(-time.Hour),
+			want:  from,
+		},
+		{
+			name:  "first period",
+			quota: &Quota{From: from, ResetInterval: 24 * time.Hour},
+			t:     from.Add(23 * time.Hour),
+			want:  from,
+		},
+		{
+			name:  "later period",
+			quota: &Quota{From: from, ResetInterval: 24 * time.Hour},
+			t:     from.Add(50 * time.Hour),
+			want:  from.Add(48 * time.Hour),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.quota.PeriodStart(tt.t))
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	errs "errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/dennigogo/zitadel/internal/api/authz"
+	"github.com/dennigogo/zitadel/internal/domain"
+	"github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/query/projection"
+)
+
+var (
+	rateLimitsTable = table{
+		name: projection.RateLimitsTable,
+	}
+	RateLimitsColumnInstanceID = Column{
+		name:  projection.RateLimitsInstanceIDCol,
+		table: rateLimitsTable,
+	}
+	RateLimitsColumnCreationDate = Column{
+		name:  projection.RateLimitsCreationDateCol,
+		table: rateLimitsTable,
+	}
+	RateLimitsColumnChangeDate = Column{
+		name:  projection.RateLimitsChangeDateCol,
+		table: rateLimitsTable,
+	}
+	RateLimitsColumnSequence = Column{
+		name:  projection.RateLimitsSequenceCol,
+		table: rateLimitsTable,
+	}
+	RateLimitsColumnInstanceRequests = Column{
+		name:  projection.RateLimitsInstanceRequestsCol,
+		table: rateLimitsTable,
+	}
+	RateLimitsColumnInstanceInterval = Column{
+		name:  projection.RateLimitsInstanceIntervalCol,
+		table: rateLimitsTable,
+	}
+	RateLimitsColumnClientRequests = Column{
+		name:  projection.RateLimitsClientRequestsCol,
+		table: rateLimitsTable,
+	}
+	RateLimitsColumnClientInterval = Column{
+		name:  projection.RateLimitsClientIntervalCol,
+		table: rateLimitsTable,
+	}
+	RateLimitsColumnIPRequests = Column{
+		name:  projection.RateLimitsIPRequestsCol,
+		table: rateLimitsTable,
+	}
+	RateLimitsColumnIPInterval = Column{
+		name:  projection.RateLimitsIPIntervalCol,
+		table: rateLimitsTable,
+	}
+)
+
+type RateLimits struct {
+	InstanceID   string
+	CreationDate time.Time
+	ChangeDate   time.Time
+	Sequence     uint64
+
+	domain.RateLimits
+}
+
+// GetRateLimits returns the rate limits set for the instance in the context
+func (q *Queries) GetRateLimits(ctx context.Context) (*RateLimits, error) {
+	stmt, scan := prepareRateLimitsQuery()
+	query, args, err := stmt.Where(
+		sq.Eq{
+			RateLimitsColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+		}).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Rl4k2", "Errors.Query.SQLStatement")
+	}
+
+	row := q.client.QueryRowContext(ctx, query, args...)
+	return scan(row)
+}
+
+func prepareRateLimitsQuery() (sq.SelectBuilder, func(row *sql.Row) (*RateLimits, error)) {
+	return sq.Select(
+			RateLimitsColumnInstanceID.identifier(),
+			RateLimitsColumnCreationDate.identifier(),
+			RateLimitsColumnChangeDate.identifier(),
+			RateLimitsColumnSequence.identifier(),
+			RateLimitsColumnInstanceRequests.identifier(),
+			RateLimitsColumnInstanceInterval.identifier(),
+			RateLimitsColumnClientRequests.identifier(),
+			RateLimitsColumnClientInterval.identifier(),
+			RateLimitsColumnIPRequests.identifier(),
+			RateLimitsColumnIPInterval.identifier(),
+		).From(rateLimitsTable.identifier()).PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*RateLimits, error) {
+			limits := new(RateLimits)
+			err := row.Scan(
+				&limits.InstanceID,
+				&limits.CreationDate,
+				&limits.ChangeDate,
+				&limits.Sequence,
+				&limits.Instance.Requests,
+				&limits.Instance.Interval,
+				&limits.Client.Requests,
+				&limits.Client.Interval,
+				&limits.IP.Requests,
+				&limits.IP.Interval,
+			)
+			if err != nil {
+				if errs.Is(err, sql.ErrNoRows) {
+					return nil, errors.ThrowNotFound(err, "QUERY-Rl7n5", "Errors.RateLimit.NotFound")
+				}
+				return nil, errors.ThrowInternal(err, "QUERY-Rl2i9", "Errors.Internal")
+			}
+			return limits, nil
+		}
+}
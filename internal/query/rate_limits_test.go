@@ -0,0 +1,126 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/dennigogo/zitadel/internal/domain"
+	errs "github.com/dennigogo/zitadel/internal/errors"
+)
+
+var (
+	rateLimitsQuery = regexp.QuoteMeta(`SELECT projections.rate_limits.instance_id,` +
+		` projections.rate_limits.creation_date,` +
+		` projections.rate_limits.change_date,` +
+		` projections.rate_limits.sequence,` +
+		` projections.rate_limits.instance_requests,` +
+		` projections.rate_limits.instance_interval,` +
+		` projections.rate_limits.client_requests,` +
+		` projections.rate_limits.client_interval,` +
+		` projections.rate_limits.ip_requests,` +
+		` projections.rate_limits.ip_interval` +
+		` FROM projections.rate_limits`)
+	rateLimitsCols = []string{
+		"instance_id",
+		"creation_date",
+		"change_date",
+		"sequence",
+		"instance_requests",
+		"instance_interval",
+		"client_requests",
+		"client_interval",
+		"ip_requests",
+		"ip_interval",
+	}
+)
+
+func Test_RateLimitsPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareRateLimitsQuery no result",
+			prepare: prepareRateLimitsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					rateLimitsQuery,
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !errs.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*RateLimits)(nil),
+		},
+		{
+			name:    "prepareRateLimitsQuery found",
+			prepare: prepareRateLimitsQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					rateLimitsQuery,
+					rateLimitsCols,
+					[]driver.Value{
+						"instance-id",
+						testNow,
+						testNow,
+						uint64(20211109),
+						uint64(1000),
+						int64(time.Second),
+						uint64(10),
+						int64(time.Second),
+						uint64(0),
+						int64(0),
+					},
+				),
+			},
+			object: &RateLimits{
+				InstanceID:   "instance-id",
+				CreationDate: testNow,
+				ChangeDate:   testNow,
+				Sequence:     20211109,
+				RateLimits: domain.RateLimits{
+					Instance: domain.RateLimit{Requests: 1000, Interval: time.Second},
+					Client:   domain.RateLimit{Requests: 10, Interval: time.Second},
+				},
+			},
+		},
+		{
+			name:    "prepareRateLimitsQuery sql err",
+			prepare: prepareRateLimitsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					rateLimitsQuery,
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err)
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/dennigogo/zitadel/internal/api/authz"
 	"github.com/dennigogo/zitadel/internal/crypto"
+	"github.com/dennigogo/zitadel/internal/database"
 	"github.com/dennigogo/zitadel/internal/domain"
 	"github.com/dennigogo/zitadel/internal/errors"
 	"github.com/dennigogo/zitadel/internal/query/projection"
@@ -30,6 +31,8 @@ type SMSConfig struct {
 	Sequence      uint64
 
 	TwilioConfig *Twilio
+	HTTPConfig   *HTTP
+	SMPPConfig   *SMPP
 }
 
 type Twilio struct {
@@ -38,6 +41,27 @@ type Twilio struct {
 	SenderNumber string
 }
 
+type HTTP struct {
+	Endpoint           string
+	Method             string
+	ContentType        string
+	BodyTemplate       string
+	AuthHeaderName     string
+	AuthHeaderValue    *crypto.CryptoValue
+	SuccessStatusCodes []int32
+	SenderNumber       string
+}
+
+type SMPP struct {
+	Host         string
+	Port         uint16
+	TLS          bool
+	SystemID     string
+	Password     *crypto.CryptoValue
+	SystemType   string
+	SenderNumber string
+}
+
 type SMSConfigsSearchQueries struct {
 	SearchRequest
 	Queries []SearchQuery
@@ -111,6 +135,86 @@ var (
 	}
 )
 
+var (
+	smsHTTPConfigsTable = table{
+		name: projection.SMSHTTPTable,
+	}
+	SMSHTTPConfigColumnSMSID = Column{
+		name:  projection.SMSHTTPConfigColumnSMSID,
+		table: smsHTTPConfigsTable,
+	}
+	SMSHTTPConfigColumnEndpoint = Column{
+		name:  projection.SMSHTTPConfigColumnEndpoint,
+		table: smsHTTPConfigsTable,
+	}
+	SMSHTTPConfigColumnMethod = Column{
+		name:  projection.SMSHTTPConfigColumnMethod,
+		table: smsHTTPConfigsTable,
+	}
+	SMSHTTPConfigColumnContentType = Column{
+		name:  projection.SMSHTTPConfigColumnContentType,
+		table: smsHTTPConfigsTable,
+	}
+	SMSHTTPConfigColumnBodyTemplate = Column{
+		name:  projection.SMSHTTPConfigColumnBodyTemplate,
+		table: smsHTTPConfigsTable,
+	}
+	SMSHTTPConfigColumnAuthHeaderName = Column{
+		name:  projection.SMSHTTPConfigColumnAuthHeaderName,
+		table: smsHTTPConfigsTable,
+	}
+	SMSHTTPConfigColumnAuthHeaderValue = Column{
+		name:  projection.SMSHTTPConfigColumnAuthHeaderValue,
+		table: smsHTTPConfigsTable,
+	}
+	SMSHTTPConfigColumnSuccessStatusCodes = Column{
+		name:  projection.SMSHTTPConfigColumnSuccessStatusCodes,
+		table: smsHTTPConfigsTable,
+	}
+	SMSHTTPConfigColumnSenderNumber = Column{
+		name:  projection.SMSHTTPConfigColumnSenderNumber,
+		table: smsHTTPConfigsTable,
+	}
+)
+
+var (
+	smsSMPPConfigsTable = table{
+		name: projection.SMSSMPPTable,
+	}
+	SMSSMPPConfigColumnSMSID = Column{
+		name:  projection.SMSSMPPConfigColumnSMSID,
+		table: smsSMPPConfigsTable,
+	}
+	SMSSMPPConfigColumnHost = Column{
+		name:  projection.SMSSMPPConfigColumnHost,
+		table: smsSMPPConfigsTable,
+	}
+	SMSSMPPConfigColumnPort = Column{
+		name:  projection.SMSSMPPConfigColumnPort,
+		table: smsSMPPConfigsTable,
+	}
+	SMSSMPPConfigColumnTLS = Column{
+		name:  projection.SMSSMPPConfigColumnTLS,
+		table: smsSMPPConfigsTable,
+	}
+	SMSSMPPConfigColumnSystemID = Column{
+		name:  projection.SMSSMPPConfigColumnSystemID,
+		table: smsSMPPConfigsTable,
+	}
+	SMSSMPPConfigColumnPassword = Column{
+		name:  projection.SMSSMPPConfigColumnPassword,
+		table: smsSMPPConfigsTable,
+	}
+	SMSSMPPConfigColumnSystemType = Column{
+		name:  projection.SMSSMPPConfigColumnSystemType,
+		table: smsSMPPConfigsTable,
+	}
+	SMSSMPPConfigColumnSenderNumber = Column{
+		name:  projection.SMSSMPPConfigColumnSenderNumber,
+		table: smsSMPPConfigsTable,
+	}
+)
+
 func (q *Queries) SMSProviderConfigByID(ctx context.Context, id string) (*SMSConfig, error) {
 	query, scan := prepareSMSConfigQuery()
 	stmt, args, err := query.Where(
@@ -185,13 +289,36 @@ func prepareSMSConfigQuery() (sq.SelectBuilder, func(*sql.Row) (*SMSConfig, erro
 			SMSTwilioConfigColumnSID.identifier(),
 			SMSTwilioConfigColumnToken.identifier(),
 			SMSTwilioConfigColumnSenderNumber.identifier(),
+
+			SMSHTTPConfigColumnSMSID.identifier(),
+			SMSHTTPConfigColumnEndpoint.identifier(),
+			SMSHTTPConfigColumnMethod.identifier(),
+			SMSHTTPConfigColumnContentType.identifier(),
+			SMSHTTPConfigColumnBodyTemplate.identifier(),
+			SMSHTTPConfigColumnAuthHeaderName.identifier(),
+			SMSHTTPConfigColumnAuthHeaderValue.identifier(),
+			SMSHTTPConfigColumnSuccessStatusCodes.identifier(),
+			SMSHTTPConfigColumnSenderNumber.identifier(),
+
+			SMSSMPPConfigColumnSMSID.identifier(),
+			SMSSMPPConfigColumnHost.identifier(),
+			SMSSMPPConfigColumnPort.identifier(),
+			SMSSMPPConfigColumnTLS.identifier(),
+			SMSSMPPConfigColumnSystemID.identifier(),
+			SMSSMPPConfigColumnPassword.identifier(),
+			SMSSMPPConfigColumnSystemType.identifier(),
+			SMSSMPPConfigColumnSenderNumber.identifier(),
 		).From(smsConfigsTable.identifier()).
 			LeftJoin(join(SMSTwilioConfigColumnSMSID, SMSConfigColumnID)).
+			LeftJoin(join(SMSHTTPConfigColumnSMSID, SMSConfigColumnID)).
+			LeftJoin(join(SMSSMPPConfigColumnSMSID, SMSConfigColumnID)).
 			PlaceholderFormat(sq.Dollar), func(row *sql.Row) (*SMSConfig, error) {
 			config := new(SMSConfig)
 
 			var (
 				twilioConfig = sqlTwilioConfig{}
+				httpConfig   = sqlHTTPConfig{}
+				smppConfig   = sqlSMPPConfig{}
 			)
 
 			err := row.Scan(
@@ -207,6 +334,25 @@ func prepareSMSConfigQuery() (sq.SelectBuilder, func(*sql.Row) (*SMSConfig, erro
 				&twilioConfig.sid,
 				&twilioConfig.token,
 				&twilioConfig.senderNumber,
+
+				&httpConfig.smsID,
+				&httpConfig.endpoint,
+				&httpConfig.method,
+				&httpConfig.contentType,
+				&httpConfig.bodyTemplate,
+				&httpConfig.authHeaderName,
+				&httpConfig.authHeaderValue,
+				&httpConfig.successStatusCodes,
+				&httpConfig.senderNumber,
+
+				&smppConfig.smsID,
+				&smppConfig.host,
+				&smppConfig.port,
+				&smppConfig.tls,
+				&smppConfig.systemID,
+				&smppConfig.password,
+				&smppConfig.systemType,
+				&smppConfig.senderNumber,
 			)
 
 			if err != nil {
@@ -217,6 +363,8 @@ func prepareSMSConfigQuery() (sq.SelectBuilder, func(*sql.Row) (*SMSConfig, erro
 			}
 
 			twilioConfig.set(config)
+			httpConfig.set(config)
+			smppConfig.set(config)
 
 			return config, nil
 		}
@@ -236,9 +384,30 @@ func prepareSMSConfigsQuery() (sq.SelectBuilder, func(*sql.Rows) (*SMSConfigs, e
 			SMSTwilioConfigColumnSID.identifier(),
 			SMSTwilioConfigColumnToken.identifier(),
 			SMSTwilioConfigColumnSenderNumber.identifier(),
+
+			SMSHTTPConfigColumnSMSID.identifier(),
+			SMSHTTPConfigColumnEndpoint.identifier(),
+			SMSHTTPConfigColumnMethod.identifier(),
+			SMSHTTPConfigColumnContentType.identifier(),
+			SMSHTTPConfigColumnBodyTemplate.identifier(),
+			SMSHTTPConfigColumnAuthHeaderName.identifier(),
+			SMSHTTPConfigColumnAuthHeaderValue.identifier(),
+			SMSHTTPConfigColumnSuccessStatusCodes.identifier(),
+			SMSHTTPConfigColumnSenderNumber.identifier(),
+
+			SMSSMPPConfigColumnSMSID.identifier(),
+			SMSSMPPConfigColumnHost.identifier(),
+			SMSSMPPConfigColumnPort.identifier(),
+			SMSSMPPConfigColumnTLS.identifier(),
+			SMSSMPPConfigColumnSystemID.identifier(),
+			SMSSMPPConfigColumnPassword.identifier(),
+			SMSSMPPConfigColumnSystemType.identifier(),
+			SMSSMPPConfigColumnSenderNumber.identifier(),
 			countColumn.identifier(),
 		).From(smsConfigsTable.identifier()).
 			LeftJoin(join(SMSTwilioConfigColumnSMSID, SMSConfigColumnID)).
+			LeftJoin(join(SMSHTTPConfigColumnSMSID, SMSConfigColumnID)).
+			LeftJoin(join(SMSSMPPConfigColumnSMSID, SMSConfigColumnID)).
 			PlaceholderFormat(sq.Dollar), func(row *sql.Rows) (*SMSConfigs, error) {
 			configs := &SMSConfigs{Configs: []*SMSConfig{}}
 
@@ -246,6 +415,8 @@ func prepareSMSConfigsQuery() (sq.SelectBuilder, func(*sql.Rows) (*SMSConfigs, e
 				config := new(SMSConfig)
 				var (
 					twilioConfig = sqlTwilioConfig{}
+					httpConfig   = sqlHTTPConfig{}
+					smppConfig   = sqlSMPPConfig{}
 				)
 
 				err := row.Scan(
@@ -261,6 +432,25 @@ func prepareSMSConfigsQuery() (sq.SelectBuilder, func(*sql.Rows) (*SMSConfigs, e
 					&twilioConfig.sid,
 					&twilioConfig.token,
 					&twilioConfig.senderNumber,
+
+					&httpConfig.smsID,
+					&httpConfig.endpoint,
+					&httpConfig.method,
+					&httpConfig.contentType,
+					&httpConfig.bodyTemplate,
+					&httpConfig.authHeaderName,
+					&httpConfig.authHeaderValue,
+					&httpConfig.successStatusCodes,
+					&httpConfig.senderNumber,
+
+					&smppConfig.smsID,
+					&smppConfig.host,
+					&smppConfig.port,
+					&smppConfig.tls,
+					&smppConfig.systemID,
+					&smppConfig.password,
+					&smppConfig.systemType,
+					&smppConfig.senderNumber,
 					&configs.Count,
 				)
 
@@ -269,6 +459,8 @@ func prepareSMSConfigsQuery() (sq.SelectBuilder, func(*sql.Rows) (*SMSConfigs, e
 				}
 
 				twilioConfig.set(config)
+				httpConfig.set(config)
+				smppConfig.set(config)
 
 				configs.Configs = append(configs.Configs, config)
 			}
@@ -294,3 +486,57 @@ func (c sqlTwilioConfig) set(smsConfig *SMSConfig) {
 		SenderNumber: c.senderNumber.String,
 	}
 }
+
+type sqlHTTPConfig struct {
+	smsID              sql.NullString
+	endpoint           sql.NullString
+	method             sql.NullString
+	contentType        sql.NullString
+	bodyTemplate       sql.NullString
+	authHeaderName     sql.NullString
+	authHeaderValue    *crypto.CryptoValue
+	successStatusCodes database.EnumArray[int32]
+	senderNumber       sql.NullString
+}
+
+func (c sqlHTTPConfig) set(smsConfig *SMSConfig) {
+	if !c.smsID.Valid {
+		return
+	}
+	smsConfig.HTTPConfig = &HTTP{
+		Endpoint:           c.endpoint.String,
+		Method:             c.method.String,
+		ContentType:        c.contentType.String,
+		BodyTemplate:       c.bodyTemplate.String,
+		AuthHeaderName:     c.authHeaderName.String,
+		AuthHeaderValue:    c.authHeaderValue,
+		SuccessStatusCodes: c.successStatusCodes,
+		SenderNumber:       c.senderNumber.String,
+	}
+}
+
+type sqlSMPPConfig struct {
+	smsID        sql.NullString
+	host         sql.NullString
+	port         sql.NullInt32
+	tls          sql.NullBool
+	systemID     sql.NullString
+	password     *crypto.CryptoValue
+	systemType   sql.NullString
+	senderNumber sql.NullString
+}
+
+func (c sqlSMPPConfig) set(smsConfig *SMSConfig) {
+	if !c.smsID.Valid {
+		return
+	}
+	smsConfig.SMPPConfig = &SMPP{
+		Host:         c.host.String,
+		Port:         uint16(c.port.Int32),
+		TLS:          c.tls.Bool,
+		SystemID:     c.systemID.String,
+		Password:     c.password,
+		SystemType:   c.systemType.String,
+		SenderNumber: c.senderNumber.String,
+	}
+}
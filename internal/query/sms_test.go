@@ -26,9 +26,30 @@ var (
 		` projections.sms_configs_twilio.sms_id,` +
 		` projections.sms_configs_twilio.sid,` +
 		` projections.sms_configs_twilio.token,` +
-		` projections.sms_configs_twilio.sender_number` +
+		` projections.sms_configs_twilio.sender_number,` +
+		// http config
+		` projections.sms_configs_http.sms_id,` +
+		` projections.sms_configs_http.endpoint,` +
+		` projections.sms_configs_http.method,` +
+		` projections.sms_configs_http.content_type,` +
+		` projections.sms_configs_http.body_template,` +
+		` projections.sms_configs_http.auth_header_name,` +
+		` projections.sms_configs_http.auth_header_value,` +
+		` projections.sms_configs_http.success_status_codes,` +
+		` projections.sms_configs_http.sender_number,` +
+		// smpp config
+		` projections.sms_configs_smpp.sms_id,` +
+		` projections.sms_configs_smpp.host,` +
+		` projections.sms_configs_smpp.port,` +
+		` projections.sms_configs_smpp.tls,` +
+		` projections.sms_configs_smpp.system_id,` +
+		` projections.sms_configs_smpp.password,` +
+		` projections.sms_configs_smpp.system_type,` +
+		` projections.sms_configs_smpp.sender_number` +
 		` FROM projections.sms_configs` +
-		` LEFT JOIN projections.sms_configs_twilio ON projections.sms_configs.id = projections.sms_configs_twilio.sms_id`)
+		` LEFT JOIN projections.sms_configs_twilio ON projections.sms_configs.id = projections.sms_configs_twilio.sms_id` +
+		` LEFT JOIN projections.sms_configs_http ON projections.sms_configs.id = projections.sms_configs_http.sms_id` +
+		` LEFT JOIN projections.sms_configs_smpp ON projections.sms_configs.id = projections.sms_configs_smpp.sms_id`)
 	expectedSMSConfigsQuery = regexp.QuoteMeta(`SELECT projections.sms_configs.id,` +
 		` projections.sms_configs.aggregate_id,` +
 		` projections.sms_configs.creation_date,` +
@@ -42,9 +63,30 @@ var (
 		` projections.sms_configs_twilio.sid,` +
 		` projections.sms_configs_twilio.token,` +
 		` projections.sms_configs_twilio.sender_number,` +
+		// http config
+		` projections.sms_configs_http.sms_id,` +
+		` projections.sms_configs_http.endpoint,` +
+		` projections.sms_configs_http.method,` +
+		` projections.sms_configs_http.content_type,` +
+		` projections.sms_configs_http.body_template,` +
+		` projections.sms_configs_http.auth_header_name,` +
+		` projections.sms_configs_http.auth_header_value,` +
+		` projections.sms_configs_http.success_status_codes,` +
+		` projections.sms_configs_http.sender_number,` +
+		// smpp config
+		` projections.sms_configs_smpp.sms_id,` +
+		` projections.sms_configs_smpp.host,` +
+		` projections.sms_configs_smpp.port,` +
+		` projections.sms_configs_smpp.tls,` +
+		` projections.sms_configs_smpp.system_id,` +
+		` projections.sms_configs_smpp.password,` +
+		` projections.sms_configs_smpp.system_type,` +
+		` projections.sms_configs_smpp.sender_number,` +
 		` COUNT(*) OVER ()` +
 		` FROM projections.sms_configs` +
-		` LEFT JOIN projections.sms_configs_twilio ON projections.sms_configs.id = projections.sms_configs_twilio.sms_id`)
+		` LEFT JOIN projections.sms_configs_twilio ON projections.sms_configs.id = projections.sms_configs_twilio.sms_id` +
+		` LEFT JOIN projections.sms_configs_http ON projections.sms_configs.id = projections.sms_configs_http.sms_id` +
+		` LEFT JOIN projections.sms_configs_smpp ON projections.sms_configs.id = projections.sms_configs_smpp.sms_id`)
 
 	smsConfigCols = []string{
 		"id",
@@ -59,6 +101,25 @@ var (
 		"sid",
 		"token",
 		"sender-number",
+		// http config
+		"sms_id",
+		"endpoint",
+		"method",
+		"content_type",
+		"body_template",
+		"auth_header_name",
+		"auth_header_value",
+		"success_status_codes",
+		"sender_number",
+		// smpp config
+		"sms_id",
+		"host",
+		"port",
+		"tls",
+		"system_id",
+		"password",
+		"system_type",
+		"sender_number",
 	}
 	smsConfigsCols = append(smsConfigCols, "count")
 )
@@ -107,6 +168,25 @@ func Test_SMSConfigssPrepare(t *testing.T) {
 							"sid",
 							&crypto.CryptoValue{},
 							"sender-number",
+							// http config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// smpp config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -133,6 +213,77 @@ func Test_SMSConfigssPrepare(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:    "prepareSMSQuery http config",
+			prepare: prepareSMSConfigsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					expectedSMSConfigsQuery,
+					smsConfigsCols,
+					[][]driver.Value{
+						{
+							"sms-id",
+							"agg-id",
+							testNow,
+							testNow,
+							"ro",
+							domain.SMSConfigStateActive,
+							uint64(20211109),
+							// twilio config
+							nil,
+							nil,
+							nil,
+							nil,
+							// http config
+							"sms-id",
+							"https://sms.example.com",
+							"POST",
+							"application/json",
+							`{"to": {{json .RecipientNumber}}}`,
+							"Authorization",
+							&crypto.CryptoValue{},
+							"{200,202}",
+							"sender-number",
+							// smpp config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+						},
+					},
+				),
+			},
+			object: &SMSConfigs{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				Configs: []*SMSConfig{
+					{
+						ID:            "sms-id",
+						AggregateID:   "agg-id",
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						ResourceOwner: "ro",
+						State:         domain.SMSConfigStateActive,
+						Sequence:      20211109,
+						HTTPConfig: &HTTP{
+							Endpoint:           "https://sms.example.com",
+							Method:             "POST",
+							ContentType:        "application/json",
+							BodyTemplate:       `{"to": {{json .RecipientNumber}}}`,
+							AuthHeaderName:     "Authorization",
+							AuthHeaderValue:    &crypto.CryptoValue{},
+							SuccessStatusCodes: []int32{200, 202},
+							SenderNumber:       "sender-number",
+						},
+					},
+				},
+			},
+		},
 		{
 			name:    "prepareSMSConfigsQuery multiple result",
 			prepare: prepareSMSConfigsQuery,
@@ -154,6 +305,25 @@ func Test_SMSConfigssPrepare(t *testing.T) {
 							"sid",
 							&crypto.CryptoValue{},
 							"sender-number",
+							// http config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// smpp config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 						{
 							"sms-id2",
@@ -168,6 +338,25 @@ func Test_SMSConfigssPrepare(t *testing.T) {
 							"sid2",
 							&crypto.CryptoValue{},
 							"sender-number2",
+							// http config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// smpp config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -282,6 +471,25 @@ func Test_SMSConfigPrepare(t *testing.T) {
 						"sid",
 						&crypto.CryptoValue{},
 						"sender-number",
+						// http config
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// smpp config
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 					},
 				),
 			},
@@ -300,6 +508,67 @@ func Test_SMSConfigPrepare(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:    "prepareSMSConfigQuery smpp config",
+			prepare: prepareSMSConfigQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					expectedSMSConfigQuery,
+					smsConfigCols,
+					[]driver.Value{
+						"sms-id",
+						"agg-id",
+						testNow,
+						testNow,
+						"ro",
+						domain.SMSConfigStateInactive,
+						uint64(20211109),
+						// twilio config
+						nil,
+						nil,
+						nil,
+						nil,
+						// http config
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// smpp config
+						"sms-id",
+						"smpp.example.com",
+						int64(2775),
+						true,
+						"system-id",
+						&crypto.CryptoValue{},
+						"system-type",
+						"sender-number",
+					},
+				),
+			},
+			object: &SMSConfig{
+				ID:            "sms-id",
+				AggregateID:   "agg-id",
+				CreationDate:  testNow,
+				ChangeDate:    testNow,
+				ResourceOwner: "ro",
+				State:         domain.SMSConfigStateInactive,
+				Sequence:      20211109,
+				SMPPConfig: &SMPP{
+					Host:         "smpp.example.com",
+					Port:         2775,
+					TLS:          true,
+					SystemID:     "system-id",
+					Password:     &crypto.CryptoValue{},
+					SystemType:   "system-type",
+					SenderNumber: "sender-number",
+				},
+			},
+		},
 		{
 			name:    "prepareSMSConfigQuery sql err",
 			prepare: prepareSMSConfigQuery,
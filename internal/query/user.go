@@ -290,6 +290,18 @@ var (
 		name:  projection.NotifyPasswordSetCol,
 		table: notifyTable,
 	}
+	NotifyInstanceIDCol = Column{
+		name:  projection.NotifyInstanceIDCol,
+		table: notifyTable,
+	}
+	NotifyPasswordChangedCol = Column{
+		name:  projection.NotifyPasswordChangedCol,
+		table: notifyTable,
+	}
+	NotifyPasswordExpiryNotifiedCol = Column{
+		name:  projection.NotifyPasswordExpiryNotifiedCol,
+		table: notifyTable,
+	}
 )
 
 func (q *Queries) GetUserByID(ctx context.Context, shouldTriggerBulk bool, userID string, queries ...SearchQuery) (*User, error) {
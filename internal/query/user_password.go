@@ -85,6 +85,8 @@ func (wm *HumanPasswordWriteModel) Reduce() error {
 			wm.SecretChangeRequired = e.ChangeRequired
 			wm.Code = nil
 			wm.PasswordCheckFailedCount = 0
+		case *user.HumanPasswordHashUpdatedEvent:
+			wm.Secret = e.Secret
 		case *user.HumanPasswordCodeAddedEvent:
 			wm.Code = e.Code
 			wm.CodeCreationDate = e.CreationDate()
@@ -116,6 +118,7 @@ func (wm *HumanPasswordWriteModel) Query() *eventstore.SearchQueryBuilder {
 			user.HumanInitialCodeAddedType,
 			user.HumanInitializedCheckSucceededType,
 			user.HumanPasswordChangedType,
+			user.HumanPasswordHashUpdatedType,
 			user.HumanPasswordCodeAddedType,
 			user.HumanEmailVerifiedType,
 			user.HumanPasswordCheckFailedType,
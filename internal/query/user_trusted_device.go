@@ -0,0 +1,230 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	errs "errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/dennigogo/zitadel/internal/api/authz"
+	"github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/query/projection"
+	"github.com/dennigogo/zitadel/internal/telemetry/tracing"
+)
+
+type UserTrustedDevices struct {
+	SearchResponse
+	Devices []*UserTrustedDevice
+}
+
+type UserTrustedDevice struct {
+	UserID        string
+	UserAgentID   string
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	ResourceOwner string
+	Sequence      uint64
+	Description   string
+}
+
+type UserTrustedDeviceSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+var (
+	userTrustedDeviceTable = table{
+		name: projection.UserTrustedDeviceProjectionTable,
+	}
+	UserTrustedDeviceUserIDCol = Column{
+		name:  projection.UserTrustedDeviceColumnUserID,
+		table: userTrustedDeviceTable,
+	}
+	UserTrustedDeviceUserAgentIDCol = Column{
+		name:  projection.UserTrustedDeviceColumnUserAgentID,
+		table: userTrustedDeviceTable,
+	}
+	UserTrustedDeviceCreationDateCol = Column{
+		name:  projection.UserTrustedDeviceColumnCreationDate,
+		table: userTrustedDeviceTable,
+	}
+	UserTrustedDeviceChangeDateCol = Column{
+		name:  projection.UserTrustedDeviceColumnChangeDate,
+		table: userTrustedDeviceTable,
+	}
+	UserTrustedDeviceResourceOwnerCol = Column{
+		name:  projection.UserTrustedDeviceColumnResourceOwner,
+		table: userTrustedDeviceTable,
+	}
+	UserTrustedDeviceInstanceIDCol = Column{
+		name:  projection.UserTrustedDeviceColumnInstanceID,
+		table: userTrustedDeviceTable,
+	}
+	UserTrustedDeviceSequenceCol = Column{
+		name:  projection.UserTrustedDeviceColumnSequence,
+		table: userTrustedDeviceTable,
+	}
+	UserTrustedDeviceDescriptionCol = Column{
+		name:  projection.UserTrustedDeviceColumnDescription,
+		table: userTrustedDeviceTable,
+	}
+)
+
+// UserTrustedDeviceByID returns the trust of the user agent for the user,
+// it's not checked against the trusted device lifetime of the login policy
+func (q *Queries) UserTrustedDeviceByID(ctx context.Context, shouldTriggerBulk bool, userID, userAgentID string) (_ *UserTrustedDevice, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if shouldTriggerBulk {
+		projection.UserTrustedDeviceProjection.Trigger(ctx)
+	}
+
+	query, scan := prepareUserTrustedDeviceQuery()
+	stmt, args, err := query.Where(
+		sq.Eq{
+			UserTrustedDeviceUserIDCol.identifier():      userID,
+			UserTrustedDeviceUserAgentIDCol.identifier(): userAgentID,
+			UserTrustedDeviceInstanceIDCol.identifier():  authz.GetInstance(ctx).InstanceID(),
+		}).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Td4mc", "Errors.Query.SQLStatment")
+	}
+
+	row := q.client.QueryRowContext(ctx, stmt, args...)
+	return scan(row)
+}
+
+func (q *Queries) SearchUserTrustedDevices(ctx context.Context, shouldTriggerBulk bool, userID string, queries *UserTrustedDeviceSearchQueries) (_ *UserTrustedDevices, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if shouldTriggerBulk {
+		projection.UserTrustedDeviceProjection.Trigger(ctx)
+	}
+
+	query, scan := prepareUserTrustedDevicesQuery()
+	stmt, args, err := queries.toQuery(query).Where(
+		sq.Eq{
+			UserTrustedDeviceUserIDCol.identifier():     userID,
+			UserTrustedDeviceInstanceIDCol.identifier(): authz.GetInstance(ctx).InstanceID(),
+		}).
+		ToSql()
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Td2gv", "Errors.Query.SQLStatment")
+	}
+
+	rows, err := q.client.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Td6nq", "Errors.Internal")
+	}
+	devices, err := scan(rows)
+	if err != nil {
+		return nil, err
+	}
+	devices.LatestSequence, err = q.latestSequence(ctx, userTrustedDeviceTable)
+	return devices, err
+}
+
+func (q *UserTrustedDeviceSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func (r *UserTrustedDeviceSearchQueries) AppendMyResourceOwnerQuery(orgID string) error {
+	query, err := NewUserTrustedDeviceResourceOwnerSearchQuery(orgID)
+	if err != nil {
+		return err
+	}
+	r.Queries = append(r.Queries, query)
+	return nil
+}
+
+func NewUserTrustedDeviceResourceOwnerSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(UserTrustedDeviceResourceOwnerCol, value, TextEquals)
+}
+
+func prepareUserTrustedDeviceQuery() (sq.SelectBuilder, func(*sql.Row) (*UserTrustedDevice, error)) {
+	return sq.Select(
+			UserTrustedDeviceUserIDCol.identifier(),
+			UserTrustedDeviceUserAgentIDCol.identifier(),
+			UserTrustedDeviceCreationDateCol.identifier(),
+			UserTrustedDeviceChangeDateCol.identifier(),
+			UserTrustedDeviceResourceOwnerCol.identifier(),
+			UserTrustedDeviceSequenceCol.identifier(),
+			UserTrustedDeviceDescriptionCol.identifier(),
+		).
+			From(userTrustedDeviceTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*UserTrustedDevice, error) {
+			d := new(UserTrustedDevice)
+			err := row.Scan(
+				&d.UserID,
+				&d.UserAgentID,
+				&d.CreationDate,
+				&d.ChangeDate,
+				&d.ResourceOwner,
+				&d.Sequence,
+				&d.Description,
+			)
+			if err != nil {
+				if errs.Is(err, sql.ErrNoRows) {
+					return nil, errors.ThrowNotFound(err, "QUERY-Td8xh", "Errors.User.MFA.TrustedDevice.NotExisting")
+				}
+				return nil, errors.ThrowInternal(err, "QUERY-Td1ra", "Errors.Internal")
+			}
+			return d, nil
+		}
+}
+
+func prepareUserTrustedDevicesQuery() (sq.SelectBuilder, func(*sql.Rows) (*UserTrustedDevices, error)) {
+	return sq.Select(
+			UserTrustedDeviceUserIDCol.identifier(),
+			UserTrustedDeviceUserAgentIDCol.identifier(),
+			UserTrustedDeviceCreationDateCol.identifier(),
+			UserTrustedDeviceChangeDateCol.identifier(),
+			UserTrustedDeviceResourceOwnerCol.identifier(),
+			UserTrustedDeviceSequenceCol.identifier(),
+			UserTrustedDeviceDescriptionCol.identifier(),
+			countColumn.identifier()).
+			From(userTrustedDeviceTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*UserTrustedDevices, error) {
+			devices := make([]*UserTrustedDevice, 0)
+			var count uint64
+			for rows.Next() {
+				d := new(UserTrustedDevice)
+				err := rows.Scan(
+					&d.UserID,
+					&d.UserAgentID,
+					&d.CreationDate,
+					&d.ChangeDate,
+					&d.ResourceOwner,
+					&d.Sequence,
+					&d.Description,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+
+				devices = append(devices, d)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, errors.ThrowInternal(err, "QUERY-Td5yb", "Errors.Query.CloseRows")
+			}
+
+			return &UserTrustedDevices{
+				Devices: devices,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
@@ -0,0 +1,205 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	errs "github.com/dennigogo/zitadel/internal/errors"
+)
+
+var (
+	userTrustedDeviceQuery = `SELECT projections.user_trusted_devices.user_id,` +
+		` projections.user_trusted_devices.user_agent_id,` +
+		` projections.user_trusted_devices.creation_date,` +
+		` projections.user_trusted_devices.change_date,` +
+		` projections.user_trusted_devices.resource_owner,` +
+		` projections.user_trusted_devices.sequence,` +
+		` projections.user_trusted_devices.description` +
+		` FROM projections.user_trusted_devices`
+	userTrustedDeviceCols = []string{
+		"user_id",
+		"user_agent_id",
+		"creation_date",
+		"change_date",
+		"resource_owner",
+		"sequence",
+		"description",
+	}
+	userTrustedDevicesQuery = `SELECT projections.user_trusted_devices.user_id,` +
+		` projections.user_trusted_devices.user_agent_id,` +
+		` projections.user_trusted_devices.creation_date,` +
+		` projections.user_trusted_devices.change_date,` +
+		` projections.user_trusted_devices.resource_owner,` +
+		` projections.user_trusted_devices.sequence,` +
+		` projections.user_trusted_devices.description,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.user_trusted_devices`
+	userTrustedDevicesCols = []string{
+		"user_id",
+		"user_agent_id",
+		"creation_date",
+		"change_date",
+		"resource_owner",
+		"sequence",
+		"description",
+		"count",
+	}
+)
+
+func Test_UserTrustedDevicePrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareUserTrustedDeviceQuery no result",
+			prepare: prepareUserTrustedDeviceQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(userTrustedDeviceQuery),
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !errs.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*UserTrustedDevice)(nil),
+		},
+		{
+			name:    "prepareUserTrustedDeviceQuery found",
+			prepare: prepareUserTrustedDeviceQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(userTrustedDeviceQuery),
+					userTrustedDeviceCols,
+					[]driver.Value{
+						"user-id",
+						"agent-id",
+						testNow,
+						testNow,
+						"resource_owner",
+						uint64(20211108),
+						"browser",
+					},
+				),
+			},
+			object: &UserTrustedDevice{
+				UserID:        "user-id",
+				UserAgentID:   "agent-id",
+				CreationDate:  testNow,
+				ChangeDate:    testNow,
+				ResourceOwner: "resource_owner",
+				Sequence:      20211108,
+				Description:   "browser",
+			},
+		},
+		{
+			name:    "prepareUserTrustedDeviceQuery sql err",
+			prepare: prepareUserTrustedDeviceQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(userTrustedDeviceQuery),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: nil,
+		},
+		{
+			name:    "prepareUserTrustedDevicesQuery no result",
+			prepare: prepareUserTrustedDevicesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(userTrustedDevicesQuery),
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !errs.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: &UserTrustedDevices{Devices: []*UserTrustedDevice{}},
+		},
+		{
+			name:    "prepareUserTrustedDevicesQuery one result",
+			prepare: prepareUserTrustedDevicesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(userTrustedDevicesQuery),
+					userTrustedDevicesCols,
+					[][]driver.Value{
+						{
+							"user-id",
+							"agent-id",
+							testNow,
+							testNow,
+							"resource_owner",
+							uint64(20211108),
+							"browser",
+						},
+					},
+				),
+			},
+			object: &UserTrustedDevices{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				Devices: []*UserTrustedDevice{
+					{
+						UserID:        "user-id",
+						UserAgentID:   "agent-id",
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						ResourceOwner: "resource_owner",
+						Sequence:      20211108,
+						Description:   "browser",
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareUserTrustedDevicesQuery sql err",
+			prepare: prepareUserTrustedDevicesQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(userTrustedDevicesQuery),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err)
+		})
+	}
+}
@@ -0,0 +1,270 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	errs "errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/dennigogo/zitadel/internal/api/authz"
+	"github.com/dennigogo/zitadel/internal/crypto"
+	"github.com/dennigogo/zitadel/internal/database"
+	"github.com/dennigogo/zitadel/internal/domain"
+	"github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/eventstore"
+	"github.com/dennigogo/zitadel/internal/query/projection"
+)
+
+var (
+	webhookTable = table{
+		name: projection.WebhookTable,
+	}
+	WebhookColumnID = Column{
+		name:  projection.WebhookIDCol,
+		table: webhookTable,
+	}
+	WebhookColumnCreationDate = Column{
+		name:  projection.WebhookCreationDateCol,
+		table: webhookTable,
+	}
+	WebhookColumnChangeDate = Column{
+		name:  projection.WebhookChangeDateCol,
+		table: webhookTable,
+	}
+	WebhookColumnResourceOwner = Column{
+		name:  projection.WebhookResourceOwnerCol,
+		table: webhookTable,
+	}
+	WebhookColumnInstanceID = Column{
+		name:  projection.WebhookInstanceIDCol,
+		table: webhookTable,
+	}
+	WebhookColumnSequence = Column{
+		name:  projection.WebhookSequenceCol,
+		table: webhookTable,
+	}
+	WebhookColumnState = Column{
+		name:  projection.WebhookStateCol,
+		table: webhookTable,
+	}
+	WebhookColumnName = Column{
+		name:  projection.WebhookNameCol,
+		table: webhookTable,
+	}
+	WebhookColumnURL = Column{
+		name:  projection.WebhookURLCol,
+		table: webhookTable,
+	}
+	WebhookColumnEventTypes = Column{
+		name:  projection.WebhookEventTypesCol,
+		table: webhookTable,
+	}
+	WebhookColumnSigningSecret = Column{
+		name:  projection.WebhookSigningSecretCol,
+		table: webhookTable,
+	}
+)
+
+type Webhooks struct {
+	SearchResponse
+	Webhooks []*Webhook
+}
+
+type Webhook struct {
+	ID            string
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	ResourceOwner string
+	State         domain.WebhookState
+	Sequence      uint64
+
+	Name          string
+	URL           string
+	EventTypes    database.StringArray
+	SigningSecret *crypto.CryptoValue
+}
+
+type WebhookSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *WebhookSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func (q *Queries) SearchWebhooks(ctx context.Context, queries *WebhookSearchQueries) (webhooks *Webhooks, err error) {
+	query, scan := prepareWebhooksQuery()
+	stmt, args, err := queries.toQuery(query).
+		Where(sq.Eq{
+			WebhookColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+		}).
+		ToSql()
+	if err != nil {
+		return nil, errors.ThrowInvalidArgument(err, "QUERY-Wh2s9", "Errors.Query.InvalidRequest")
+	}
+
+	rows, err := q.client.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Wh5k1", "Errors.Internal")
+	}
+	webhooks, err = scan(rows)
+	if err != nil {
+		return nil, err
+	}
+	webhooks.LatestSequence, err = q.latestSequence(ctx, webhookTable)
+	return webhooks, err
+}
+
+func (q *Queries) GetWebhookByID(ctx context.Context, id string, resourceOwner string) (*Webhook, error) {
+	stmt, scan := prepareWebhookQuery()
+	query, args, err := stmt.Where(
+		sq.Eq{
+			WebhookColumnID.identifier():            id,
+			WebhookColumnResourceOwner.identifier(): resourceOwner,
+			WebhookColumnInstanceID.identifier():    authz.GetInstance(ctx).InstanceID(),
+		}).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Wh8d3", "Errors.Query.SQLStatement")
+	}
+
+	row := q.client.QueryRowContext(ctx, query, args...)
+	return scan(row)
+}
+
+// ActiveWebhooksByEvent returns the active webhooks of the instance and of the resource owner
+// which are subscribed to the event type
+func (q *Queries) ActiveWebhooksByEvent(ctx context.Context, resourceOwner string, eventType eventstore.EventType) (*Webhooks, error) {
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	eventTypeQuery, err := NewWebhookEventTypeSearchQuery(string(eventType))
+	if err != nil {
+		return nil, err
+	}
+	query, scan := prepareWebhooksQuery()
+	stmt, args, err := eventTypeQuery.toQuery(query).
+		Where(sq.Eq{
+			WebhookColumnInstanceID.identifier():    instanceID,
+			WebhookColumnState.identifier():         domain.WebhookStateActive,
+			WebhookColumnResourceOwner.identifier(): []string{instanceID, resourceOwner},
+		}).
+		ToSql()
+	if err != nil {
+		return nil, errors.ThrowInvalidArgument(err, "QUERY-Wh4m7", "Errors.Query.InvalidRequest")
+	}
+
+	rows, err := q.client.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Wh6n2", "Errors.Internal")
+	}
+	return scan(rows)
+}
+
+func NewWebhookResourceOwnerQuery(id string) (SearchQuery, error) {
+	return NewTextQuery(WebhookColumnResourceOwner, id, TextEquals)
+}
+
+func NewWebhookNameSearchQuery(method TextComparison, value string) (SearchQuery, error) {
+	return NewTextQuery(WebhookColumnName, value, method)
+}
+
+func NewWebhookStateSearchQuery(value domain.WebhookState) (SearchQuery, error) {
+	return NewNumberQuery(WebhookColumnState, int(value), NumberEquals)
+}
+
+func NewWebhookEventTypeSearchQuery(eventType string) (SearchQuery, error) {
+	return NewTextQuery(WebhookColumnEventTypes, eventType, TextListContains)
+}
+
+func prepareWebhooksQuery() (sq.SelectBuilder, func(rows *sql.Rows) (*Webhooks, error)) {
+	return sq.Select(
+			WebhookColumnID.identifier(),
+			WebhookColumnCreationDate.identifier(),
+			WebhookColumnChangeDate.identifier(),
+			WebhookColumnResourceOwner.identifier(),
+			WebhookColumnSequence.identifier(),
+			WebhookColumnState.identifier(),
+			WebhookColumnName.identifier(),
+			WebhookColumnURL.identifier(),
+			WebhookColumnEventTypes.identifier(),
+			WebhookColumnSigningSecret.identifier(),
+			countColumn.identifier(),
+		).From(webhookTable.identifier()).PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*Webhooks, error) {
+			webhooks := make([]*Webhook, 0)
+			var count uint64
+			for rows.Next() {
+				webhook := new(Webhook)
+				err := rows.Scan(
+					&webhook.ID,
+					&webhook.CreationDate,
+					&webhook.ChangeDate,
+					&webhook.ResourceOwner,
+					&webhook.Sequence,
+					&webhook.State,
+					&webhook.Name,
+					&webhook.URL,
+					&webhook.EventTypes,
+					&webhook.SigningSecret,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				webhooks = append(webhooks, webhook)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, errors.ThrowInternal(err, "QUERY-Wh1c5", "Errors.Query.CloseRows")
+			}
+
+			return &Webhooks{
+				Webhooks: webhooks,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
+
+func prepareWebhookQuery() (sq.SelectBuilder, func(row *sql.Row) (*Webhook, error)) {
+	return sq.Select(
+			WebhookColumnID.identifier(),
+			WebhookColumnCreationDate.identifier(),
+			WebhookColumnChangeDate.identifier(),
+			WebhookColumnResourceOwner.identifier(),
+			WebhookColumnSequence.identifier(),
+			WebhookColumnState.identifier(),
+			WebhookColumnName.identifier(),
+			WebhookColumnURL.identifier(),
+			WebhookColumnEventTypes.identifier(),
+			WebhookColumnSigningSecret.identifier(),
+		).From(webhookTable.identifier()).PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*Webhook, error) {
+			webhook := new(Webhook)
+			err := row.Scan(
+				&webhook.ID,
+				&webhook.CreationDate,
+				&webhook.ChangeDate,
+				&webhook.ResourceOwner,
+				&webhook.Sequence,
+				&webhook.State,
+				&webhook.Name,
+				&webhook.URL,
+				&webhook.EventTypes,
+				&webhook.SigningSecret,
+			)
+			if err != nil {
+				if errs.Is(err, sql.ErrNoRows) {
+					return nil, errors.ThrowNotFound(err, "QUERY-Wh3p8", "Errors.Webhook.NotFound")
+				}
+				return nil, errors.ThrowInternal(err, "QUERY-Wh7r4", "Errors.Internal")
+			}
+			return webhook, nil
+		}
+}
@@ -0,0 +1,202 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/dennigogo/zitadel/internal/api/authz"
+	"github.com/dennigogo/zitadel/internal/domain"
+	"github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/query/projection"
+)
+
+var (
+	webhookDeliveryTable = table{
+		name: projection.WebhookDeliveryTable,
+	}
+	WebhookDeliveryColumnWebhookID = Column{
+		name:  projection.WebhookDeliveryWebhookIDCol,
+		table: webhookDeliveryTable,
+	}
+	WebhookDeliveryColumnCreationDate = Column{
+		name:  projection.WebhookDeliveryCreationDateCol,
+		table: webhookDeliveryTable,
+	}
+	WebhookDeliveryColumnChangeDate = Column{
+		name:  projection.WebhookDeliveryChangeDateCol,
+		table: webhookDeliveryTable,
+	}
+	WebhookDeliveryColumnResourceOwner = Column{
+		name:  projection.WebhookDeliveryResourceOwnerCol,
+		table: webhookDeliveryTable,
+	}
+	WebhookDeliveryColumnInstanceID = Column{
+		name:  projection.WebhookDeliveryInstanceIDCol,
+		table: webhookDeliveryTable,
+	}
+	WebhookDeliveryColumnState = Column{
+		name:  projection.WebhookDeliveryStateCol,
+		table: webhookDeliveryTable,
+	}
+	WebhookDeliveryColumnEventSequence = Column{
+		name:  projection.WebhookDeliveryEventSequenceCol,
+		table: webhookDeliveryTable,
+	}
+	WebhookDeliveryColumnEventType = Column{
+		name:  projection.WebhookDeliveryEventTypeCol,
+		table: webhookDeliveryTable,
+	}
+	WebhookDeliveryColumnAggregateType = Column{
+		name:  projection.WebhookDeliveryAggregateTypeCol,
+		table: webhookDeliveryTable,
+	}
+	WebhookDeliveryColumnAggregateID = Column{
+		name:  projection.WebhookDeliveryAggregateIDCol,
+		table: webhookDeliveryTable,
+	}
+	WebhookDeliveryColumnAttempts = Column{
+		name:  projection.WebhookDeliveryAttemptsCol,
+		table: webhookDeliveryTable,
+	}
+	WebhookDeliveryColumnStatusCode = Column{
+		name:  projection.WebhookDeliveryStatusCodeCol,
+		table: webhookDeliveryTable,
+	}
+	WebhookDeliveryColumnError = Column{
+		name:  projection.WebhookDeliveryErrorCol,
+		table: webhookDeliveryTable,
+	}
+)
+
+type WebhookDeliveries struct {
+	SearchResponse
+	Deliveries []*WebhookDelivery
+}
+
+type WebhookDelivery struct {
+	WebhookID     string
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	ResourceOwner string
+	State         domain.WebhookDeliveryState
+
+	EventSequence uint64
+	EventType     string
+	AggregateType string
+	AggregateID   string
+	Attempts      uint32
+	StatusCode    uint32
+	Error         string
+}
+
+type WebhookDeliverySearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *WebhookDeliverySearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func (q *Queries) SearchWebhookDeliveries(ctx context.Context, queries *WebhookDeliverySearchQueries) (deliveries *WebhookDeliveries, err error) {
+	query, scan := prepareWebhookDeliveriesQuery()
+	stmt, args, err := queries.toQuery(query).
+		Where(sq.Eq{
+			WebhookDeliveryColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+		}).
+		ToSql()
+	if err != nil {
+		return nil, errors.ThrowInvalidArgument(err, "QUERY-Wd2k7", "Errors.Query.InvalidRequest")
+	}
+
+	rows, err := q.client.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Wd5m3", "Errors.Internal")
+	}
+	deliveries, err = scan(rows)
+	if err != nil {
+		return nil, err
+	}
+	deliveries.LatestSequence, err = q.latestSequence(ctx, webhookDeliveryTable)
+	return deliveries, err
+}
+
+func NewWebhookDeliveryWebhookIDSearchQuery(id string) (SearchQuery, error) {
+	return NewTextQuery(WebhookDeliveryColumnWebhookID, id, TextEquals)
+}
+
+func NewWebhookDeliveryResourceOwnerSearchQuery(id string) (SearchQuery, error) {
+	return NewTextQuery(WebhookDeliveryColumnResourceOwner, id, TextEquals)
+}
+
+func NewWebhookDeliveryStateSearchQuery(value domain.WebhookDeliveryState) (SearchQuery, error) {
+	return NewNumberQuery(WebhookDeliveryColumnState, int(value), NumberEquals)
+}
+
+func prepareWebhookDeliveriesQuery() (sq.SelectBuilder, func(rows *sql.Rows) (*WebhookDeliveries, error)) {
+	return sq.Select(
+			WebhookDeliveryColumnWebhookID.identifier(),
+			WebhookDeliveryColumnCreationDate.identifier(),
+			WebhookDeliveryColumnChangeDate.identifier(),
+			WebhookDeliveryColumnResourceOwner.identifier(),
+			WebhookDeliveryColumnState.identifier(),
+			WebhookDeliveryColumnEventSequence.identifier(),
+			WebhookDeliveryColumnEventType.identifier(),
+			WebhookDeliveryColumnAggregateType.identifier(),
+			WebhookDeliveryColumnAggregateID.identifier(),
+			WebhookDeliveryColumnAttempts.identifier(),
+			WebhookDeliveryColumnStatusCode.identifier(),
+			WebhookDeliveryColumnError.identifier(),
+			countColumn.identifier(),
+		).From(webhookDeliveryTable.identifier()).PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*WebhookDeliveries, error) {
+			deliveries := make([]*WebhookDelivery, 0)
+			var count uint64
+			for rows.Next() {
+				delivery := new(WebhookDelivery)
+				var (
+					statusCode  sql.NullInt32
+					deliveryErr sql.NullString
+				)
+				err := rows.Scan(
+					&delivery.WebhookID,
+					&delivery.CreationDate,
+					&delivery.ChangeDate,
+					&delivery.ResourceOwner,
+					&delivery.State,
+					&delivery.EventSequence,
+					&delivery.EventType,
+					&delivery.AggregateType,
+					&delivery.AggregateID,
+					&delivery.Attempts,
+					&statusCode,
+					&deliveryErr,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				delivery.StatusCode = uint32(statusCode.Int32)
+				delivery.Error = deliveryErr.String
+				deliveries = append(deliveries, delivery)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, errors.ThrowInternal(err, "QUERY-Wd8q1", "Errors.Query.CloseRows")
+			}
+
+			return &WebhookDeliveries{
+				Deliveries: deliveries,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
@@ -0,0 +1,327 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/dennigogo/zitadel/internal/crypto"
+	"github.com/dennigogo/zitadel/internal/database"
+	"github.com/dennigogo/zitadel/internal/domain"
+	errs "github.com/dennigogo/zitadel/internal/errors"
+)
+
+var (
+	webhooksQuery = regexp.QuoteMeta(`SELECT projections.webhooks.id,` +
+		` projections.webhooks.creation_date,` +
+		` projections.webhooks.change_date,` +
+		` projections.webhooks.resource_owner,` +
+		` projections.webhooks.sequence,` +
+		` projections.webhooks.webhook_state,` +
+		` projections.webhooks.name,` +
+		` projections.webhooks.url,` +
+		` projections.webhooks.event_types,` +
+		` projections.webhooks.signing_secret,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.webhooks`)
+	webhookQuery = regexp.QuoteMeta(`SELECT projections.webhooks.id,` +
+		` projections.webhooks.creation_date,` +
+		` projections.webhooks.change_date,` +
+		` projections.webhooks.resource_owner,` +
+		` projections.webhooks.sequence,` +
+		` projections.webhooks.webhook_state,` +
+		` projections.webhooks.name,` +
+		` projections.webhooks.url,` +
+		` projections.webhooks.event_types,` +
+		` projections.webhooks.signing_secret` +
+		` FROM projections.webhooks`)
+	webhookCols = []string{
+		"id",
+		"creation_date",
+		"change_date",
+		"resource_owner",
+		"sequence",
+		"webhook_state",
+		"name",
+		"url",
+		"event_types",
+		"signing_secret",
+	}
+	webhookDeliveriesQuery = regexp.QuoteMeta(`SELECT projections.webhook_deliveries.webhook_id,` +
+		` projections.webhook_deliveries.creation_date,` +
+		` projections.webhook_deliveries.change_date,` +
+		` projections.webhook_deliveries.resource_owner,` +
+		` projections.webhook_deliveries.delivery_state,` +
+		` projections.webhook_deliveries.event_sequence,` +
+		` projections.webhook_deliveries.event_type,` +
+		` projections.webhook_deliveries.aggregate_type,` +
+		` projections.webhook_deliveries.aggregate_id,` +
+		` projections.webhook_deliveries.attempts,` +
+		` projections.webhook_deliveries.status_code,` +
+		` projections.webhook_deliveries.error,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.webhook_deliveries`)
+	webhookDeliveriesCols = []string{
+		"webhook_id",
+		"creation_date",
+		"change_date",
+		"resource_owner",
+		"delivery_state",
+		"event_sequence",
+		"event_type",
+		"aggregate_type",
+		"aggregate_id",
+		"attempts",
+		"status_code",
+		"error",
+		"count",
+	}
+)
+
+func Test_WebhookPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareWebhooksQuery no result",
+			prepare: prepareWebhooksQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					webhooksQuery,
+					nil,
+					nil,
+				),
+			},
+			object: &Webhooks{Webhooks: []*Webhook{}},
+		},
+		{
+			name:    "prepareWebhooksQuery one result",
+			prepare: prepareWebhooksQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					webhooksQuery,
+					append(webhookCols, "count"),
+					[][]driver.Value{
+						{
+							"id",
+							testNow,
+							testNow,
+							"ro",
+							uint64(20211109),
+							domain.WebhookStateActive,
+							"webhook-name",
+							"https://example.com/hook",
+							database.StringArray{"user.human.added"},
+							&crypto.CryptoValue{},
+						},
+					},
+				),
+			},
+			object: &Webhooks{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				Webhooks: []*Webhook{
+					{
+						ID:            "id",
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						ResourceOwner: "ro",
+						State:         domain.WebhookStateActive,
+						Sequence:      20211109,
+						Name:          "webhook-name",
+						URL:           "https://example.com/hook",
+						EventTypes:    database.StringArray{"user.human.added"},
+						SigningSecret: &crypto.CryptoValue{},
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareWebhooksQuery sql err",
+			prepare: prepareWebhooksQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					webhooksQuery,
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: nil,
+		},
+		{
+			name:    "prepareWebhookQuery no result",
+			prepare: prepareWebhookQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					webhookQuery,
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !errs.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*Webhook)(nil),
+		},
+		{
+			name:    "prepareWebhookQuery found",
+			prepare: prepareWebhookQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					webhookQuery,
+					webhookCols,
+					[]driver.Value{
+						"id",
+						testNow,
+						testNow,
+						"ro",
+						uint64(20211109),
+						domain.WebhookStateInactive,
+						"webhook-name",
+						"https://example.com/hook",
+						database.StringArray{"user.human.added", "user.grant.changed"},
+						&crypto.CryptoValue{},
+					},
+				),
+			},
+			object: &Webhook{
+				ID:            "id",
+				CreationDate:  testNow,
+				ChangeDate:    testNow,
+				ResourceOwner: "ro",
+				State:         domain.WebhookStateInactive,
+				Sequence:      20211109,
+				Name:          "webhook-name",
+				URL:           "https://example.com/hook",
+				EventTypes:    database.StringArray{"user.human.added", "user.grant.changed"},
+				SigningSecret: &crypto.CryptoValue{},
+			},
+		},
+		{
+			name:    "prepareWebhookDeliveriesQuery no result",
+			prepare: prepareWebhookDeliveriesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					webhookDeliveriesQuery,
+					nil,
+					nil,
+				),
+			},
+			object: &WebhookDeliveries{Deliveries: []*WebhookDelivery{}},
+		},
+		{
+			name:    "prepareWebhookDeliveriesQuery multiple result",
+			prepare: prepareWebhookDeliveriesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					webhookDeliveriesQuery,
+					webhookDeliveriesCols,
+					[][]driver.Value{
+						{
+							"webhook-id",
+							testNow,
+							testNow,
+							"ro",
+							domain.WebhookDeliveryStateDelivered,
+							uint64(20211109),
+							"user.human.added",
+							"user",
+							"user-id",
+							1,
+							200,
+							nil,
+						},
+						{
+							"webhook-id",
+							testNow,
+							testNow,
+							"ro",
+							domain.WebhookDeliveryStateDeadLetter,
+							uint64(20211110),
+							"user.removed",
+							"user",
+							"user-id",
+							5,
+							nil,
+							"connection refused",
+						},
+					},
+				),
+			},
+			object: &WebhookDeliveries{
+				SearchResponse: SearchResponse{
+					Count: 2,
+				},
+				Deliveries: []*WebhookDelivery{
+					{
+						WebhookID:     "webhook-id",
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						ResourceOwner: "ro",
+						State:         domain.WebhookDeliveryStateDelivered,
+						EventSequence: 20211109,
+						EventType:     "user.human.added",
+						AggregateType: "user",
+						AggregateID:   "user-id",
+						Attempts:      1,
+						StatusCode:    200,
+					},
+					{
+						WebhookID:     "webhook-id",
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						ResourceOwner: "ro",
+						State:         domain.WebhookDeliveryStateDeadLetter,
+						EventSequence: 20211110,
+						EventType:     "user.removed",
+						AggregateType: "user",
+						AggregateID:   "user-id",
+						Attempts:      5,
+						Error:         "connection refused",
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareWebhookDeliveriesQuery sql err",
+			prepare: prepareWebhookDeliveriesQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					webhookDeliveriesQuery,
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err)
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package quota
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/dennigogo/zitadel/internal/actions"
+	"github.com/dennigogo/zitadel/internal/domain"
+	"github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/query"
+)
+
+var unitNames = map[domain.QuotaUnit]string{
+	domain.QuotaUnitRequestsAllAuthenticated: "requests.all.authenticated",
+	domain.QuotaUnitActionsAllRunsSeconds:    "actions.all.runs.seconds",
+	domain.QuotaUnitNotificationsAll:         "notifications.all",
+	domain.QuotaUnitUsers:                    "users",
+	domain.QuotaUnitOrgs:                     "orgs",
+}
+
+// dueNotification is a notification of a quota,
+// whose threshold (percentage of the amount) was reached
+type dueNotification struct {
+	callURL   string
+	threshold uint64
+}
+
+type notificationPayload struct {
+	InstanceID  string    `json:"instanceID"`
+	Unit        string    `json:"unit"`
+	PeriodStart time.Time `json:"periodStart"`
+	// Threshold is the reached percentage of the amount
+	Threshold uint64 `json:"threshold"`
+	Usage     uint64 `json:"usage"`
+	Amount    uint64 `json:"amount"`
+	Limit     bool   `json:"limit"`
+}
+
+// dueNotifications returns the notifications of the quota,
+// whose threshold was reached by the increase of the usage from before to after.
+// Repeated notifications are due for every multiple of their percentage,
+// but only once per increase.
+func dueNotifications(quota *query.Quota, before, after uint64) []*dueNotification {
+	due := make([]*dueNotification, 0, len(quota.Notifications))
+	for _, notification := range quota.Notifications {
+		step := quota.Amount * uint64(notification.Percent) / 100
+		if step == 0 {
+			step = 1
+		}
+		reached := after / step
+		if reached <= before/step {
+			continue
+		}
+		if !notification.Repeat {
+			if before >= step {
+				continue
+			}
+			reached = 1
+		}
+		due = append(due, &dueNotification{
+			callURL:   notification.CallURL,
+			threshold: reached * uint64(notification.Percent),
+		})
+	}
+	return due
+}
+
+func (s *Service) notify(quota *query.Quota, due *dueNotification, periodStart time.Time, usage uint64) {
+	err := s.callNotification(quota, due, periodStart, usage)
+	logging.WithFields("instance", quota.InstanceID, "unit", quota.Unit, "threshold", due.threshold).OnError(err).Warn("unable to call quota notification")
+}
+
+func (s *Service) callNotification(quota *query.Quota, due *dueNotification, periodStart time.Time, usage uint64) error {
+	target, err := url.Parse(due.callURL)
+	if err != nil {
+		return errors.ThrowInvalidArgument(err, "QUOTA-Nu2r4", "invalid url")
+	}
+	if actions.IsHostBlocked(target) {
+		return errors.ThrowPermissionDenied(nil, "QUOTA-Nu5h8", "host is denied")
+	}
+	body, err := json.Marshal(&notificationPayload{
+		InstanceID:  quota.InstanceID,
+		Unit:        unitNames[quota.Unit],
+		PeriodStart: periodStart,
+		Threshold:   due.threshold,
+		Usage:       usage,
+		Amount:      quota.Amount,
+		Limit:       quota.Limit,
+	})
+	if err != nil {
+		return errors.ThrowInternal(err, "QUOTA-Nu7m1", "unable to marshal payload")
+	}
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, due.callURL, bytes.NewReader(body))
+	if err != nil {
+		return errors.ThrowInternal(err, "QUOTA-Nu3q9", "unable to create request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return errors.ThrowUnavailable(err, "QUOTA-Nu8c6", "unable to send request")
+	}
+	defer resp.Body.Close()
+	// drain the body so the connection can be reused
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return errors.ThrowUnavailablef(nil, "QUOTA-Nu1s5", "unexpected status code %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,273 @@
+package quota
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/dennigogo/zitadel/internal/api/authz"
+	"github.com/dennigogo/zitadel/internal/domain"
+	"github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/query"
+)
+
+const flushTimeout = 30 * time.Second
+
+// Config of the metering and enforcement of the quotas
+type Config struct {
+	// FlushInterval defines how often the usage counted in memory is added to the usage of the periods
+	FlushInterval time.Duration
+	// CacheDuration defines how long the quotas and their usage are cached to enforce the limits
+	CacheDuration time.Duration
+	// NotificationTimeout is the timeout of the calls to the notification urls
+	NotificationTimeout time.Duration
+}
+
+// Enforcer meters the usage of the quotas and checks their limits
+type Enforcer interface {
+	// Report adds the amount to the usage of the periodic quota of the unit of the instance in the context
+	Report(ctx context.Context, unit domain.QuotaUnit, amount uint64)
+	// Exhausted returns true if the quota of the unit of the instance in the context is limited and its amount is reached
+	Exhausted(ctx context.Context, unit domain.QuotaUnit) bool
+}
+
+// Queries reads the quotas and their usage
+type Queries interface {
+	GetQuota(ctx context.Context, unit domain.QuotaUnit) (*query.Quota, error)
+	QuotaUsage(ctx context.Context, quota *query.Quota, t time.Time) (uint64, error)
+}
+
+var _ Enforcer = (*Service)(nil)
+
+// Service counts the usage of the periodic quotas in memory and adds it to the database periodically.
+// The limits are enforced based on the cached usage,
+// so the amount of a quota might be exceeded by the usage of the last CacheDuration.
+type Service struct {
+	client     *sql.DB
+	queries    Queries
+	config     Config
+	httpClient *http.Client
+
+	mutex   sync.Mutex
+	pending map[pendingKey]uint64
+	cache   map[usageKey]*cachedUsage
+}
+
+type usageKey struct {
+	instanceID string
+	unit       domain.QuotaUnit
+}
+
+// pendingKey groups the usage counted in memory by the second it was reported,
+// so it's added to the period it occurred in, even if the period ended before the flush
+type pendingKey struct {
+	usageKey
+	reported time.Time
+}
+
+type cachedUsage struct {
+	// quota is nil if no quota is set for the unit
+	quota       *query.Quota
+	usage       uint64
+	periodStart time.Time
+	expiration  time.Time
+}
+
+func (c *cachedUsage) exhausted(now time.Time) bool {
+	if c.quota == nil || !c.quota.Limit {
+		return false
+	}
+	// the usage of the previous period must not block the new one
+	if c.quota.Unit.IsPeriodic() && !c.quota.PeriodStart(now).Equal(c.periodStart) {
+		return false
+	}
+	return c.usage >= c.quota.Amount
+}
+
+func Start(ctx context.Context, client *sql.DB, queries Queries, config Config) *Service {
+	s := newService(client, queries, config)
+	if config.FlushInterval > 0 {
+		go s.flushPeriodically(ctx)
+	}
+	return s
+}
+
+func newService(client *sql.DB, queries Queries, config Config) *Service {
+	return &Service{
+		client:  client,
+		queries: queries,
+		config:  config,
+		httpClient: &http.Client{
+			Timeout: config.NotificationTimeout,
+			// redirects are not followed, the target might not pass the deny list
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		pending: make(map[pendingKey]uint64),
+		cache:   make(map[usageKey]*cachedUsage),
+	}
+}
+
+func (s *Service) Report(ctx context.Context, unit domain.QuotaUnit, amount uint64) {
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	if amount == 0 || instanceID == "" || !unit.IsPeriodic() {
+		return
+	}
+	key := usageKey{instanceID: instanceID, unit: unit}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.pending[pendingKey{usageKey: key, reported: time.Now().Truncate(time.Second)}] += amount
+	if cached, ok := s.cache[key]; ok {
+		cached.usage += amount
+	}
+}
+
+// Exhausted doesn't block the usage if the quota can't be read
+func (s *Service) Exhausted(ctx context.Context, unit domain.QuotaUnit) bool {
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	if instanceID == "" {
+		return false
+	}
+	key := usageKey{instanceID: instanceID, unit: unit}
+	now := time.Now()
+	s.mutex.Lock()
+	cached, ok := s.cache[key]
+	s.mutex.Unlock()
+	if !ok || now.After(cached.expiration) {
+		var err error
+		cached, err = s.loadUsage(ctx, key, now)
+		if err != nil {
+			logging.WithFields("instance", instanceID, "unit", unit).WithError(err).Warn("unable to check quota")
+			return false
+		}
+		s.mutex.Lock()
+		s.cache[key] = cached
+		s.mutex.Unlock()
+	}
+	return cached.exhausted(now)
+}
+
+func (s *Service) loadUsage(ctx context.Context, key usageKey, now time.Time) (*cachedUsage, error) {
+	cached := &cachedUsage{expiration: now.Add(s.config.CacheDuration)}
+	quota, err := s.queries.GetQuota(ctx, key.unit)
+	if errors.IsNotFound(err) {
+		return cached, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	usage, err := s.queries.QuotaUsage(ctx, quota, now)
+	if err != nil {
+		return nil, err
+	}
+	periodStart := quota.PeriodStart(now)
+	s.mutex.Lock()
+	for pending, amount := range s.pending {
+		if pending.usageKey == key && quota.PeriodStart(pending.reported).Equal(periodStart) {
+			usage += amount
+		}
+	}
+	s.mutex.Unlock()
+	cached.quota = quota
+	cached.usage = usage
+	cached.periodStart = periodStart
+	return cached, nil
+}
+
+func (s *Service) flushPeriodically(ctx context.Context) {
+	ticker := time.NewTicker(s.config.FlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			// the usage of the last interval must not get lost on shutdown
+			flushCtx, cancel := context.WithTimeout(context.Background(), flushTimeout)
+			s.flush(flushCtx)
+			cancel()
+			return
+		case <-ticker.C:
+			flushCtx, cancel := context.WithTimeout(ctx, flushTimeout)
+			s.flush(flushCtx)
+			cancel()
+		}
+	}
+}
+
+func (s *Service) flush(ctx context.Context) {
+	s.mutex.Lock()
+	pending := s.pending
+	s.pending = make(map[pendingKey]uint64)
+	s.mutex.Unlock()
+
+	reported := make(map[usageKey]map[time.Time]uint64)
+	for key, amount := range pending {
+		if reported[key.usageKey] == nil {
+			reported[key.usageKey] = make(map[time.Time]uint64)
+		}
+		reported[key.usageKey][key.reported] += amount
+	}
+	for key, amounts := range reported {
+		failed, err := s.addUsage(ctx, key, amounts)
+		if err == nil {
+			continue
+		}
+		logging.WithFields("instance", key.instanceID, "unit", key.unit).WithError(err).Warn("unable to add quota usage")
+		s.mutex.Lock()
+		for at, amount := range failed {
+			s.pending[pendingKey{usageKey: key, reported: at}] += amount
+		}
+		s.mutex.Unlock()
+	}
+}
+
+// addUsage adds the amounts to the usage of the periods they were reported in
+// and calls the notifications of the quota, which became due.
+// The amounts which couldn't be added are returned by the start of their period.
+func (s *Service) addUsage(ctx context.Context, key usageKey, reported map[time.Time]uint64) (failed map[time.Time]uint64, err error) {
+	ctx = authz.WithInstanceID(ctx, key.instanceID)
+	quota, err := s.queries.GetQuota(ctx, key.unit)
+	if errors.IsNotFound(err) {
+		// the usage is only metered for units with a quota
+		return nil, nil
+	}
+	if err != nil {
+		return reported, err
+	}
+	periods := make(map[time.Time]uint64, len(reported))
+	for at, amount := range reported {
+		periods[quota.PeriodStart(at)] += amount
+	}
+	failed = make(map[time.Time]uint64)
+	for periodStart, amount := range periods {
+		usage, incrementErr := s.incrementUsage(ctx, key, periodStart, amount)
+		if incrementErr != nil {
+			failed[periodStart] = amount
+			err = incrementErr
+			continue
+		}
+		for _, due := range dueNotifications(quota, usage-amount, usage) {
+			go s.notify(quota, due, periodStart, usage)
+		}
+	}
+	return failed, err
+}
+
+func (s *Service) incrementUsage(ctx context.Context, key usageKey, periodStart time.Time, amount uint64) (usage uint64, err error) {
+	err = s.client.QueryRowContext(ctx, fmt.Sprintf(
+		"INSERT INTO %[1]s AS p (%[2]s, %[3]s, %[4]s, %[5]s) VALUES ($1, $2, $3, $4) "+
+			"ON CONFLICT (%[2]s, %[3]s, %[4]s) DO UPDATE SET %[5]s = p.%[5]s + EXCLUDED.%[5]s "+
+			"RETURNING %[5]s",
+		query.QuotaPeriodTable,
+		query.QuotaPeriodInstanceIDCol,
+		query.QuotaPeriodUnitCol,
+		query.QuotaPeriodStartCol,
+		query.QuotaPeriodUsageCol,
+	), key.instanceID, key.unit, periodStart, amount).Scan(&usage)
+	return usage, err
+}
@@ -0,0 +1,233 @@
+package quota
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dennigogo/zitadel/internal/api/authz"
+	"github.com/dennigogo/zitadel/internal/domain"
+	"github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/query"
+)
+
+type testQueries struct {
+	quotas map[domain.QuotaUnit]*query.Quota
+	usage  uint64
+}
+
+func (q *testQueries) GetQuota(_ context.Context, unit domain.QuotaUnit) (*query.Quota, error) {
+	quota, ok := q.quotas[unit]
+	if !ok {
+		return nil, errors.ThrowNotFound(nil, "QUOTA-Test1", "Errors.Quota.NotFound")
+	}
+	return quota, nil
+}
+
+func (q *testQueries) QuotaUsage(context.Context, *query.Quota, time.Time) (uint64, error) {
+	return q.usage, nil
+}
+
+func Test_dueNotifications(t *testing.T) {
+	quota := &query.Quota{
+		Amount: 1000,
+		Notifications: []*query.QuotaNotification{
+			{Percent: 80, CallURL: "https://example.com/once"},
+			{Percent: 50, Repeat: true, CallURL: "https://example.com/repeat"},
+		},
+	}
+	tests := []struct {
+		name   string
+		before uint64
+		after  uint64
+		want   []*dueNotification
+	}{
+		{
+			name:   "no threshold reached",
+			before: 100,
+			after:  499,
+			want:   []*dueNotification{},
+		},
+		{
+			name:   "repeated threshold reached",
+			before: 499,
+			after:  500,
+			want: []*dueNotification{
+				{callURL: "https://example.com/repeat", threshold: 50},
+			},
+		},
+		{
+			name:   "both thresholds reached",
+			before: 700,
+			after:  1000,
+			want: []*dueNotification{
+				{callURL: "https://example.com/once", threshold: 80},
+				{callURL: "https://example.com/repeat", threshold: 100},
+			},
+		},
+		{
+			name:   "single threshold only once",
+			before: 1000,
+			after:  1600,
+			want: []*dueNotification{
+				{callURL: "https://example.com/repeat", threshold: 150},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, dueNotifications(quota, tt.before, tt.after))
+		})
+	}
+}
+
+func TestService_Exhausted(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "instance")
+	from := time.Now().Add(-time.Hour)
+	tests := []struct {
+		name     string
+		queries  *testQueries
+		unit     domain.QuotaUnit
+		reported uint64
+		want     bool
+	}{
+		{
+			name:    "no quota",
+			queries: &testQueries{},
+			unit:    domain.QuotaUnitRequestsAllAuthenticated,
+			want:    false,
+		},
+		{
+			name: "not limited",
+			queries: &testQueries{
+				quotas: map[domain.QuotaUnit]*query.Quota{
+					domain.QuotaUnitUsers: {Unit: domain.QuotaUnitUsers, Amount: 10},
+				},
+				usage: 10,
+			},
+			unit: domain.QuotaUnitUsers,
+			want: false,
+		},
+		{
+			name: "amount reached",
+			queries: &testQueries{
+				quotas: map[domain.QuotaUnit]*query.Quota{
+					domain.QuotaUnitUsers: {Unit: domain.QuotaUnitUsers, Amount: 10, Limit: true},
+				},
+				usage: 10,
+			},
+			unit: domain.QuotaUnitUsers,
+			want: true,
+		},
+		{
+			name: "amount not reached",
+			queries: &testQueries{
+				quotas: map[domain.QuotaUnit]*query.Quota{
+					domain.QuotaUnitRequestsAllAuthenticated: {Unit: domain.QuotaUnitRequestsAllAuthenticated, From: from, ResetInterval: 24 * time.Hour, Amount: 10, Limit: true},
+				},
+				usage: 8,
+			},
+			unit:     domain.QuotaUnitRequestsAllAuthenticated,
+			reported: 1,
+			want:     false,
+		},
+		{
+			name: "amount reached by reported usage",
+			queries: &testQueries{
+				quotas: map[domain.QuotaUnit]*query.Quota{
+					domain.QuotaUnitRequestsAllAuthenticated: {Unit: domain.QuotaUnitRequestsAllAuthenticated, From: from, ResetInterval: 24 * time.Hour, Amount: 10, Limit: true},
+				},
+				usage: 8,
+			},
+			unit:     domain.QuotaUnitRequestsAllAuthenticated,
+			reported: 2,
+			want:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newService(nil, tt.queries, Config{CacheDuration: time.Minute})
+			s.Exhausted(ctx, tt.unit)
+			s.Report(ctx, tt.unit, tt.reported)
+			assert.Equal(t, tt.want, s.Exhausted(ctx, tt.unit))
+		})
+	}
+}
+
+func TestService_flush(t *testing.T) {
+	notifications := make(chan *notificationPayload, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		payload := new(notificationPayload)
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(payload))
+		notifications <- payload
+	}))
+	defer server.Close()
+
+	client, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer client.Close()
+
+	from := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	quota := &query.Quota{
+		InstanceID:    "instance",
+		Unit:          domain.QuotaUnitRequestsAllAuthenticated,
+		From:          from,
+		ResetInterval: 24 * time.Hour,
+		Amount:        10,
+		Notifications: []*query.QuotaNotification{
+			{Percent: 50, CallURL: server.URL},
+		},
+	}
+	periodStart := quota.PeriodStart(time.Now())
+	previousPeriodStart := periodStart.Add(-quota.ResetInterval)
+	// the usage is added per period, the order of the periods is not defined
+	mock.MatchExpectationsInOrder(false)
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO system.quota_periods AS p (instance_id, unit, start, usage) VALUES ($1, $2, $3, $4) ON CONFLICT (instance_id, unit, start) DO UPDATE SET usage = p.usage + EXCLUDED.usage RETURNING usage")).
+		WithArgs("instance", domain.QuotaUnitRequestsAllAuthenticated, periodStart, uint64(3)).
+		WillReturnRows(sqlmock.NewRows([]string{"usage"}).AddRow(6))
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO system.quota_periods")).
+		WithArgs("instance", domain.QuotaUnitRequestsAllAuthenticated, previousPeriodStart, uint64(4)).
+		WillReturnError(sql.ErrConnDone)
+
+	s := newService(client, &testQueries{quotas: map[domain.QuotaUnit]*query.Quota{quota.Unit: quota}}, Config{NotificationTimeout: time.Second})
+	ctx := authz.WithInstanceID(context.Background(), "instance")
+	s.Report(ctx, domain.QuotaUnitRequestsAllAuthenticated, 2)
+	s.Report(ctx, domain.QuotaUnitRequestsAllAuthenticated, 1)
+	// the usage of units without quota is dropped
+	s.Report(ctx, domain.QuotaUnitNotificationsAll, 1)
+	// the usage reported before the current period started is added to the previous period
+	previousKey := pendingKey{
+		usageKey: usageKey{instanceID: "instance", unit: domain.QuotaUnitRequestsAllAuthenticated},
+		reported: periodStart.Add(-time.Second),
+	}
+	s.pending[previousKey] = 4
+	s.flush(context.Background())
+
+	require.NoError(t, mock.ExpectationsWereMet())
+	select {
+	case payload := <-notifications:
+		assert.Equal(t, &notificationPayload{
+			InstanceID:  "instance",
+			Unit:        "requests.all.authenticated",
+			PeriodStart: periodStart,
+			Threshold:   50,
+			Usage:       6,
+			Amount:      10,
+		}, payload)
+	case <-time.After(5 * time.Second):
+		t.Fatal("notification not called")
+	}
+	// the usage which couldn't be added is kept in its period
+	assert.Equal(t, map[pendingKey]uint64{
+		{usageKey: previousKey.usageKey, reported: previousPeriodStart}: 4,
+	}, s.pending)
+}
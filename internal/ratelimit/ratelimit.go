@@ -0,0 +1,325 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/dennigogo/zitadel/internal/api/authz"
+	http_util "github.com/dennigogo/zitadel/internal/api/http"
+	"github.com/dennigogo/zitadel/internal/domain"
+	"github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/query"
+)
+
+const cleanupInterval = time.Minute
+
+// Config of the default rate limits,
+// which apply to all instances which don't override them
+type Config struct {
+	Enabled bool
+	// Instance limits all requests to an instance
+	Instance domain.RateLimit
+	// Client limits the requests of an authenticated user or client of an instance
+	Client domain.RateLimit
+	// IP limits the requests of a single ip address to an instance
+	IP domain.RateLimit
+	// CacheDuration defines how long the limits set for an instance are cached
+	CacheDuration time.Duration
+	// TrustedProxies is the number of reverse proxies in front of ZITADEL which append the address of their peer to the X-Forwarded-For header,
+	// the ip of the client is read from the entry the outermost trusted proxy appended
+	TrustedProxies int
+}
+
+// Queries reads the rate limits set for an instance
+type Queries interface {
+	GetRateLimits(ctx context.Context) (*query.RateLimits, error)
+}
+
+// Checker checks the requests against the rate limits
+type Checker interface {
+	// Allow checks the request of the client from the ip to the instance in the context
+	Allow(ctx context.Context, client, ip string) *Result
+	// AllowClient checks the request of the authenticated client against the client limit only,
+	// it's used by apis which authenticate the client after the request was checked by Allow
+	AllowClient(ctx context.Context, client string) *Result
+	// TrustedProxies returns the number of reverse proxies which append to the X-Forwarded-For header
+	TrustedProxies() int
+}
+
+var _ Checker = (*Limiter)(nil)
+
+// Result of a request checked against the rate limits,
+// Limit and Remaining are of the most restrictive limit
+type Result struct {
+	Allowed   bool
+	Limit     uint64
+	Remaining uint64
+	// Reset is the duration until the bucket of the limit is full again
+	Reset time.Duration
+	// RetryAfter is the duration until the next request is allowed
+	RetryAfter time.Duration
+}
+
+// Headers returns the RateLimit and Retry-After header values of the result,
+// it's empty if no limit applied
+func (r *Result) Headers() map[string]string {
+	if r.Limit == 0 {
+		return nil
+	}
+	headers := map[string]string{
+		http_util.RateLimitLimit:     strconv.FormatUint(r.Limit, 10),
+		http_util.RateLimitRemaining: strconv.FormatUint(r.Remaining, 10),
+		http_util.RateLimitReset:     seconds(r.Reset),
+	}
+	if !r.Allowed {
+		headers[http_util.RetryAfter] = seconds(r.RetryAfter)
+	}
+	return headers
+}
+
+// seconds returns the started seconds of the duration
+func seconds(d time.Duration) string {
+	return strconv.FormatInt(int64(math.Ceil(d.Seconds())), 10)
+}
+
+// Limiter limits the requests per instance, client and ip using token buckets.
+// A nil Limiter allows all requests.
+type Limiter struct {
+	queries Queries
+	config  Config
+	now     func() time.Time
+
+	mutex     sync.Mutex
+	buckets   map[bucketKey]*bucket
+	overrides map[string]*cachedLimits
+}
+
+type scope int
+
+const (
+	scopeInstance scope = iota
+	scopeClient
+	scopeIP
+)
+
+type bucketKey struct {
+	scope      scope
+	instanceID string
+	value      string
+}
+
+type bucket struct {
+	limit  domain.RateLimit
+	tokens float64
+	last   time.Time
+}
+
+// refill adds the tokens since the last request, the bucket holds at most limit.Requests tokens
+func (b *bucket) refill(limit domain.RateLimit, now time.Time) {
+	b.limit = limit
+	b.tokens = math.Min(float64(limit.Requests), b.tokens+now.Sub(b.last).Seconds()*b.rate())
+	b.last = now
+}
+
+// rate returns the tokens added per second
+func (b *bucket) rate() float64 {
+	return float64(b.limit.Requests) / b.limit.Interval.Seconds()
+}
+
+func (b *bucket) isFull(now time.Time) bool {
+	return b.tokens+now.Sub(b.last).Seconds()*b.rate() >= float64(b.limit.Requests)
+}
+
+// result returns the state of the bucket after the request was checked
+func (b *bucket) result(allowed bool) *Result {
+	result := &Result{
+		Allowed:   allowed,
+		Limit:     b.limit.Requests,
+		Remaining: uint64(math.Max(0, math.Floor(b.tokens))),
+		Reset:     b.duration(float64(b.limit.Requests) - b.tokens),
+	}
+	if !allowed {
+		result.RetryAfter = b.duration(1 - b.tokens)
+	}
+	return result
+}
+
+// duration returns the time it takes to refill the tokens
+func (b *bucket) duration(tokens float64) time.Duration {
+	return time.Duration(tokens / b.rate() * float64(time.Second))
+}
+
+type cachedLimits struct {
+	limits     domain.RateLimits
+	expiration time.Time
+}
+
+func Start(ctx context.Context, queries Queries, config Config) *Limiter {
+	if !config.Enabled {
+		return nil
+	}
+	l := newLimiter(queries, config)
+	go l.cleanupPeriodically(ctx)
+	return l
+}
+
+func newLimiter(queries Queries, config Config) *Limiter {
+	return &Limiter{
+		queries:   queries,
+		config:    config,
+		now:       time.Now,
+		buckets:   make(map[bucketKey]*bucket),
+		overrides: make(map[string]*cachedLimits),
+	}
+}
+
+// Allow checks the request of the client from the ip to the instance in the context against the limits.
+// The request only consumes tokens if all limits allow it.
+// The client and ip are only limited if they are not empty.
+func (l *Limiter) Allow(ctx context.Context, client, ip string) *Result {
+	return l.allow(ctx, true, client, ip)
+}
+
+// AllowClient checks the request of the authenticated client to the instance in the context against the client limit.
+func (l *Limiter) AllowClient(ctx context.Context, client string) *Result {
+	return l.allow(ctx, false, client, "")
+}
+
+func (l *Limiter) TrustedProxies() int {
+	if l == nil {
+		return 0
+	}
+	return l.config.TrustedProxies
+}
+
+func (l *Limiter) allow(ctx context.Context, checkInstance bool, client, ip string) *Result {
+	if l == nil {
+		return &Result{Allowed: true}
+	}
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	if instanceID == "" {
+		return &Result{Allowed: true}
+	}
+	limits := l.limits(ctx, instanceID)
+	keys := make(map[bucketKey]domain.RateLimit, 3)
+	if checkInstance && !limits.Instance.IsZero() {
+		keys[bucketKey{scope: scopeInstance, instanceID: instanceID}] = limits.Instance
+	}
+	if client != "" && !limits.Client.IsZero() {
+		keys[bucketKey{scope: scopeClient, instanceID: instanceID, value: client}] = limits.Client
+	}
+	if ip != "" && !limits.IP.IsZero() {
+		keys[bucketKey{scope: scopeIP, instanceID: instanceID, value: ip}] = limits.IP
+	}
+
+	now := l.now()
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	buckets := make([]*bucket, 0, len(keys))
+	allowed := true
+	for key, limit := range keys {
+		b, ok := l.buckets[key]
+		if !ok {
+			b = &bucket{tokens: float64(limit.Requests), last: now}
+			l.buckets[key] = b
+		}
+		b.refill(limit, now)
+		allowed = allowed && b.tokens >= 1
+		buckets = append(buckets, b)
+	}
+	result := &Result{Allowed: true}
+	for _, b := range buckets {
+		if allowed {
+			b.tokens--
+		}
+		result = mostRestrictive(result, b.result(allowed), allowed)
+	}
+	return result
+}
+
+// mostRestrictive returns the result with the longest wait for denied requests
+// and the result with the least remaining requests for allowed ones
+func mostRestrictive(current, next *Result, allowed bool) *Result {
+	if current.Limit == 0 {
+		return next
+	}
+	if !allowed {
+		if next.RetryAfter > current.RetryAfter {
+			return next
+		}
+		return current
+	}
+	if next.Remaining < current.Remaining {
+		return next
+	}
+	return current
+}
+
+// limits returns the limits of the instance, which fall back to the defaults of the config.
+// The defaults apply if the limits of the instance can't be read.
+func (l *Limiter) limits(ctx context.Context, instanceID string) domain.RateLimits {
+	now := l.now()
+	l.mutex.Lock()
+	cached, ok := l.overrides[instanceID]
+	l.mutex.Unlock()
+	if !ok || now.After(cached.expiration) {
+		cached = &cachedLimits{expiration: now.Add(l.config.CacheDuration)}
+		limits, err := l.queries.GetRateLimits(ctx)
+		if err != nil && !errors.IsNotFound(err) {
+			logging.WithFields("instance", instanceID).WithError(err).Warn("unable to get rate limits")
+		}
+		if err == nil {
+			cached.limits = limits.RateLimits
+		}
+		l.mutex.Lock()
+		l.overrides[instanceID] = cached
+		l.mutex.Unlock()
+	}
+	return domain.RateLimits{
+		Instance: withDefault(cached.limits.Instance, l.config.Instance),
+		Client:   withDefault(cached.limits.Client, l.config.Client),
+		IP:       withDefault(cached.limits.IP, l.config.IP),
+	}
+}
+
+func withDefault(limit, defaultLimit domain.RateLimit) domain.RateLimit {
+	if limit.IsZero() {
+		return defaultLimit
+	}
+	return limit
+}
+
+func (l *Limiter) cleanupPeriodically(ctx context.Context) {
+	ticker := time.NewTicker(cleanupInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			l.cleanup()
+		}
+	}
+}
+
+// cleanup removes the full buckets, they are equal to new ones
+func (l *Limiter) cleanup() {
+	now := l.now()
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	for key, b := range l.buckets {
+		if b.isFull(now) {
+			delete(l.buckets, key)
+		}
+	}
+	for instanceID, cached := range l.overrides {
+		if now.After(cached.expiration) {
+			delete(l.overrides, instanceID)
+		}
+	}
+}
@@ -0,0 +1,269 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dennigogo/zitadel/internal/api/authz"
+	"github.com/dennigogo/zitadel/internal/domain"
+	"github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/query"
+)
+
+type mockQueries struct {
+	limits *query.RateLimits
+	err    error
+	calls  int
+}
+
+func (m *mockQueries) GetRateLimits(context.Context) (*query.RateLimits, error) {
+	m.calls++
+	return m.limits, m.err
+}
+
+func TestLimiter_Allow(t *testing.T) {
+	type request struct {
+		client string
+		ip     string
+		// after is the time since the first request
+		after time.Duration
+	}
+	tests := []struct {
+		name     string
+		config   Config
+		queries  *mockQueries
+		requests []request
+		want     *Result
+	}{
+		{
+			name: "no limits, allowed",
+			config: Config{
+				Enabled: true,
+			},
+			queries:  &mockQueries{err: errors.ThrowNotFound(nil, "ID", "not found")},
+			requests: []request{{}, {}, {}},
+			want:     &Result{Allowed: true},
+		},
+		{
+			name: "instance limit, allowed",
+			config: Config{
+				Enabled:  true,
+				Instance: domain.RateLimit{Requests: 2, Interval: time.Second},
+			},
+			queries:  &mockQueries{err: errors.ThrowNotFound(nil, "ID", "not found")},
+			requests: []request{{}},
+			want: &Result{
+				Allowed:   true,
+				Limit:     2,
+				Remaining: 1,
+				Reset:     500 * time.Millisecond,
+			},
+		},
+		{
+			name: "instance limit, denied",
+			config: Config{
+				Enabled:  true,
+				Instance: domain.RateLimit{Requests: 2, Interval: time.Second},
+			},
+			queries:  &mockQueries{err: errors.ThrowNotFound(nil, "ID", "not found")},
+			requests: []request{{}, {}, {}},
+			want: &Result{
+				Allowed:    false,
+				Limit:      2,
+				Remaining:  0,
+				Reset:      time.Second,
+				RetryAfter: 500 * time.Millisecond,
+			},
+		},
+		{
+			name: "instance limit, refilled",
+			config: Config{
+				Enabled:  true,
+				Instance: domain.RateLimit{Requests: 2, Interval: time.Second},
+			},
+			queries:  &mockQueries{err: errors.ThrowNotFound(nil, "ID", "not found")},
+			requests: []request{{}, {}, {after: time.Second}},
+			want: &Result{
+				Allowed:   true,
+				Limit:     2,
+				Remaining: 1,
+				Reset:     500 * time.Millisecond,
+			},
+		},
+		{
+			name: "client limit of other client, allowed",
+			config: Config{
+				Enabled: true,
+				Client:  domain.RateLimit{Requests: 1, Interval: time.Second},
+			},
+			queries:  &mockQueries{err: errors.ThrowNotFound(nil, "ID", "not found")},
+			requests: []request{{client: "client1"}, {client: "client2"}},
+			want: &Result{
+				Allowed:   true,
+				Limit:     1,
+				Remaining: 0,
+				Reset:     time.Second,
+			},
+		},
+		{
+			name: "ip limit, most restrictive denied",
+			config: Config{
+				Enabled:  true,
+				Instance: domain.RateLimit{Requests: 100, Interval: time.Second},
+				IP:       domain.RateLimit{Requests: 1, Interval: 10 * time.Second},
+			},
+			queries:  &mockQueries{err: errors.ThrowNotFound(nil, "ID", "not found")},
+			requests: []request{{ip: "1.2.3.4"}, {ip: "1.2.3.4"}},
+			want: &Result{
+				Allowed:    false,
+				Limit:      1,
+				Remaining:  0,
+				Reset:      10 * time.Second,
+				RetryAfter: 10 * time.Second,
+			},
+		},
+		{
+			name: "overridden limit, denied",
+			config: Config{
+				Enabled:  true,
+				Instance: domain.RateLimit{Requests: 100, Interval: time.Second},
+			},
+			queries: &mockQueries{limits: &query.RateLimits{
+				RateLimits: domain.RateLimits{
+					Instance: domain.RateLimit{Requests: 1, Interval: time.Second},
+				},
+			}},
+			requests: []request{{}, {}},
+			want: &Result{
+				Allowed:    false,
+				Limit:      1,
+				Remaining:  0,
+				Reset:      time.Second,
+				RetryAfter: time.Second,
+			},
+		},
+		{
+			name: "overrides unavailable, defaults apply",
+			config: Config{
+				Enabled:  true,
+				Instance: domain.RateLimit{Requests: 1, Interval: time.Second},
+			},
+			queries:  &mockQueries{err: errors.ThrowInternal(nil, "ID", "internal")},
+			requests: []request{{}, {}},
+			want: &Result{
+				Allowed:    false,
+				Limit:      1,
+				Remaining:  0,
+				Reset:      time.Second,
+				RetryAfter: time.Second,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+			l := newLimiter(tt.queries, tt.config)
+			ctx := authz.WithInstanceID(context.Background(), "instance")
+			var got *Result
+			for _, req := range tt.requests {
+				l.now = func() time.Time { return start.Add(req.after) }
+				got = l.Allow(ctx, req.client, req.ip)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestLimiter_Allow_nil(t *testing.T) {
+	var l *Limiter
+	assert.Equal(t, &Result{Allowed: true}, l.Allow(authz.WithInstanceID(context.Background(), "instance"), "client", "ip"))
+	assert.Equal(t, &Result{Allowed: true}, l.AllowClient(authz.WithInstanceID(context.Background(), "instance"), "client"))
+	assert.Equal(t, 0, l.TrustedProxies())
+}
+
+func TestLimiter_AllowClient(t *testing.T) {
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	l := newLimiter(&mockQueries{err: errors.ThrowNotFound(nil, "ID", "not found")}, Config{
+		Enabled:  true,
+		Instance: domain.RateLimit{Requests: 1, Interval: time.Minute},
+		Client:   domain.RateLimit{Requests: 2, Interval: time.Minute},
+	})
+	l.now = func() time.Time { return start }
+	ctx := authz.WithInstanceID(context.Background(), "instance")
+
+	assert.True(t, l.Allow(ctx, "", "1.2.3.4").Allowed)
+	// the instance limit is only checked before the authentication
+	assert.True(t, l.AllowClient(ctx, "client").Allowed)
+	assert.True(t, l.AllowClient(ctx, "client").Allowed)
+	assert.False(t, l.AllowClient(ctx, "client").Allowed)
+	assert.True(t, l.AllowClient(ctx, "other").Allowed)
+	assert.Len(t, l.buckets, 3)
+}
+
+func TestLimiter_cleanup(t *testing.T) {
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	queries := &mockQueries{err: errors.ThrowNotFound(nil, "ID", "not found")}
+	l := newLimiter(queries, Config{
+		Enabled:       true,
+		Instance:      domain.RateLimit{Requests: 10, Interval: time.Second},
+		IP:            domain.RateLimit{Requests: 10, Interval: time.Minute},
+		CacheDuration: time.Second,
+	})
+	l.now = func() time.Time { return start }
+	l.Allow(authz.WithInstanceID(context.Background(), "instance"), "", "1.2.3.4")
+
+	l.now = func() time.Time { return start.Add(2 * time.Second) }
+	l.cleanup()
+	assert.Len(t, l.buckets, 1, "only the ip bucket must be left")
+	assert.Len(t, l.overrides, 0)
+}
+
+func TestResult_Headers(t *testing.T) {
+	tests := []struct {
+		name   string
+		result *Result
+		want   map[string]string
+	}{
+		{
+			name:   "no limit",
+			result: &Result{Allowed: true},
+			want:   nil,
+		},
+		{
+			name: "allowed",
+			result: &Result{
+				Allowed:   true,
+				Limit:     10,
+				Remaining: 9,
+				Reset:     100 * time.Millisecond,
+			},
+			want: map[string]string{
+				"ratelimit-limit":     "10",
+				"ratelimit-remaining": "9",
+				"ratelimit-reset":     "1",
+			},
+		},
+		{
+			name: "denied",
+			result: &Result{
+				Limit:      10,
+				Reset:      10 * time.Second,
+				RetryAfter: 1500 * time.Millisecond,
+			},
+			want: map[string]string{
+				"ratelimit-limit":     "10",
+				"ratelimit-remaining": "0",
+				"ratelimit-reset":     "10",
+				"retry-after":         "2",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.result.Headers())
+		})
+	}
+}
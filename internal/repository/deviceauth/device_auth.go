@@ -0,0 +1,217 @@
+package deviceauth
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/eventstore"
+	"github.com/dennigogo/zitadel/internal/eventstore/repository"
+)
+
+const (
+	UniqueDeviceCodeType = "device_auth_device_code"
+	UniqueUserCodeType   = "device_auth_user_code"
+
+	eventTypePrefix   = eventstore.EventType("device.authorization.")
+	AddedEventType    = eventTypePrefix + "added"
+	ApprovedEventType = eventTypePrefix + "approved"
+	DeniedEventType   = eventTypePrefix + "denied"
+	RemovedEventType  = eventTypePrefix + "removed"
+)
+
+func NewAddUniqueConstraints(deviceCode, userCode string) []*eventstore.EventUniqueConstraint {
+	return []*eventstore.EventUniqueConstraint{
+		eventstore.NewAddEventUniqueConstraint(
+			UniqueDeviceCodeType,
+			deviceCode,
+			"Errors.DeviceAuth.AlreadyExists"),
+		eventstore.NewAddEventUniqueConstraint(
+			UniqueUserCodeType,
+			userCode,
+			"Errors.DeviceAuth.AlreadyExists"),
+	}
+}
+
+func NewRemoveUniqueConstraints(deviceCode, userCode string) []*eventstore.EventUniqueConstraint {
+	return []*eventstore.EventUniqueConstraint{
+		eventstore.NewRemoveEventUniqueConstraint(
+			UniqueDeviceCodeType,
+			deviceCode),
+		eventstore.NewRemoveEventUniqueConstraint(
+			UniqueUserCodeType,
+			userCode),
+	}
+}
+
+type AddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ClientID   string    `json:"clientId"`
+	DeviceCode string    `json:"deviceCode"`
+	UserCode   string    `json:"userCode"`
+	Expires    time.Time `json:"expires"`
+	Scopes     []string  `json:"scopes,omitempty"`
+}
+
+func (e *AddedEvent) Data() interface{} {
+	return e
+}
+
+func (e *AddedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return NewAddUniqueConstraints(e.DeviceCode, e.UserCode)
+}
+
+func NewAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	clientID,
+	deviceCode,
+	userCode string,
+	expires time.Time,
+	scopes []string,
+) *AddedEvent {
+	return &AddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			AddedEventType,
+		),
+		ClientID:   clientID,
+		DeviceCode: deviceCode,
+		UserCode:   userCode,
+		Expires:    expires,
+		Scopes:     scopes,
+	}
+}
+
+func AddedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &AddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "DEVAU-Tp3kd", "unable to unmarshal device authorization added")
+	}
+
+	return e, nil
+}
+
+type ApprovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Subject               string    `json:"subject"`
+	UserOrgID             string    `json:"userOrgId"`
+	AuthMethodsReferences []string  `json:"authMethodsReferences,omitempty"`
+	AuthTime              time.Time `json:"authTime"`
+}
+
+func (e *ApprovedEvent) Data() interface{} {
+	return e
+}
+
+func (e *ApprovedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewApprovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	subject,
+	userOrgID string,
+	amr []string,
+	authTime time.Time,
+) *ApprovedEvent {
+	return &ApprovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			ApprovedEventType,
+		),
+		Subject:               subject,
+		UserOrgID:             userOrgID,
+		AuthMethodsReferences: amr,
+		AuthTime:              authTime,
+	}
+}
+
+func ApprovedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &ApprovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "DEVAU-Wq8cj", "unable to unmarshal device authorization approved")
+	}
+
+	return e, nil
+}
+
+type DeniedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *DeniedEvent) Data() interface{} {
+	return nil
+}
+
+func (e *DeniedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewDeniedEvent(ctx context.Context, aggregate *eventstore.Aggregate) *DeniedEvent {
+	return &DeniedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			DeniedEventType,
+		),
+	}
+}
+
+func DeniedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	return &DeniedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
+
+type RemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	deviceCode string
+	userCode   string
+}
+
+func (e *RemovedEvent) Data() interface{} {
+	return nil
+}
+
+func (e *RemovedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return NewRemoveUniqueConstraints(e.deviceCode, e.userCode)
+}
+
+func NewRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	deviceCode,
+	userCode string,
+) *RemovedEvent {
+	return &RemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			RemovedEventType,
+		),
+		deviceCode: deviceCode,
+		userCode:   userCode,
+	}
+}
+
+func RemovedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	return &RemovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
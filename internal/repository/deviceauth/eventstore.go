@@ -0,0 +1,10 @@
+package deviceauth
+
+import "github.com/dennigogo/zitadel/internal/eventstore"
+
+func RegisterEventMappers(es *eventstore.Eventstore) {
+	es.RegisterFilterEventMapper(AddedEventType, AddedEventMapper).
+		RegisterFilterEventMapper(ApprovedEventType, ApprovedEventMapper).
+		RegisterFilterEventMapper(DeniedEventType, DeniedEventMapper).
+		RegisterFilterEventMapper(RemovedEventType, RemovedEventMapper)
+}
@@ -0,0 +1,223 @@
+package idpconfig
+
+import (
+	"encoding/json"
+
+	"github.com/dennigogo/zitadel/internal/crypto"
+	"github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/eventstore"
+	"github.com/dennigogo/zitadel/internal/eventstore/repository"
+)
+
+const (
+	LDAPConfigAddedEventType   eventstore.EventType = "ldap.config.added"
+	LDAPConfigChangedEventType eventstore.EventType = "ldap.config.changed"
+)
+
+type LDAPConfigAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	IDPConfigID  string              `json:"idpConfigId"`
+	URL          string              `json:"url,omitempty"`
+	StartTLS     bool                `json:"startTls,omitempty"`
+	BaseDN       string              `json:"baseDn,omitempty"`
+	BindDN       string              `json:"bindDn,omitempty"`
+	BindPassword *crypto.CryptoValue `json:"bindPassword,omitempty"`
+	UserFilter   string              `json:"userFilter,omitempty"`
+	LDAPAttributes
+}
+
+// LDAPAttributes are the names of the ldap attributes mapped to the user
+type LDAPAttributes struct {
+	IDAttribute                string `json:"idAttribute,omitempty"`
+	PreferredUsernameAttribute string `json:"preferredUsernameAttribute,omitempty"`
+	FirstNameAttribute         string `json:"firstNameAttribute,omitempty"`
+	LastNameAttribute          string `json:"lastNameAttribute,omitempty"`
+	DisplayNameAttribute       string `json:"displayNameAttribute,omitempty"`
+	EmailAttribute             string `json:"emailAttribute,omitempty"`
+	PhoneAttribute             string `json:"phoneAttribute,omitempty"`
+}
+
+func (e *LDAPConfigAddedEvent) Data() interface{} {
+	return e
+}
+
+func (e *LDAPConfigAddedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewLDAPConfigAddedEvent(
+	base *eventstore.BaseEvent,
+	idpConfigID,
+	url string,
+	startTLS bool,
+	baseDN,
+	bindDN string,
+	bindPassword *crypto.CryptoValue,
+	userFilter string,
+	attributes LDAPAttributes,
+) *LDAPConfigAddedEvent {
+	return &LDAPConfigAddedEvent{
+		BaseEvent:      *base,
+		IDPConfigID:    idpConfigID,
+		URL:            url,
+		StartTLS:       startTLS,
+		BaseDN:         baseDN,
+		BindDN:         bindDN,
+		BindPassword:   bindPassword,
+		UserFilter:     userFilter,
+		LDAPAttributes: attributes,
+	}
+}
+
+func LDAPConfigAddedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &LDAPConfigAddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "LDAP-Fn3s9", "unable to unmarshal event")
+	}
+
+	return e, nil
+}
+
+type LDAPConfigChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	IDPConfigID string `json:"idpConfigId"`
+
+	URL                        *string             `json:"url,omitempty"`
+	StartTLS                   *bool               `json:"startTls,omitempty"`
+	BaseDN                     *string             `json:"baseDn,omitempty"`
+	BindDN                     *string             `json:"bindDn,omitempty"`
+	BindPassword               *crypto.CryptoValue `json:"bindPassword,omitempty"`
+	UserFilter                 *string             `json:"userFilter,omitempty"`
+	IDAttribute                *string             `json:"idAttribute,omitempty"`
+	PreferredUsernameAttribute *string             `json:"preferredUsernameAttribute,omitempty"`
+	FirstNameAttribute         *string             `json:"firstNameAttribute,omitempty"`
+	LastNameAttribute          *string             `json:"lastNameAttribute,omitempty"`
+	DisplayNameAttribute       *string             `json:"displayNameAttribute,omitempty"`
+	EmailAttribute             *string             `json:"emailAttribute,omitempty"`
+	PhoneAttribute             *string             `json:"phoneAttribute,omitempty"`
+}
+
+func (e *LDAPConfigChangedEvent) Data() interface{} {
+	return e
+}
+
+func (e *LDAPConfigChangedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewLDAPConfigChangedEvent(
+	base *eventstore.BaseEvent,
+	idpConfigID string,
+	changes []LDAPConfigChanges,
+) (*LDAPConfigChangedEvent, error) {
+	if len(changes) == 0 {
+		return nil, errors.ThrowPreconditionFailed(nil, "IDPCONFIG-N9s2f", "Errors.NoChangesFound")
+	}
+	changeEvent := &LDAPConfigChangedEvent{
+		BaseEvent:   *base,
+		IDPConfigID: idpConfigID,
+	}
+	for _, change := range changes {
+		change(changeEvent)
+	}
+	return changeEvent, nil
+}
+
+type LDAPConfigChanges func(*LDAPConfigChangedEvent)
+
+func ChangeLDAPURL(url string) func(*LDAPConfigChangedEvent) {
+	return func(e *LDAPConfigChangedEvent) {
+		e.URL = &url
+	}
+}
+
+func ChangeLDAPStartTLS(startTLS bool) func(*LDAPConfigChangedEvent) {
+	return func(e *LDAPConfigChangedEvent) {
+		e.StartTLS = &startTLS
+	}
+}
+
+func ChangeLDAPBaseDN(baseDN string) func(*LDAPConfigChangedEvent) {
+	return func(e *LDAPConfigChangedEvent) {
+		e.BaseDN = &baseDN
+	}
+}
+
+func ChangeLDAPBindDN(bindDN string) func(*LDAPConfigChangedEvent) {
+	return func(e *LDAPConfigChangedEvent) {
+		e.BindDN = &bindDN
+	}
+}
+
+func ChangeLDAPBindPassword(bindPassword *crypto.CryptoValue) func(*LDAPConfigChangedEvent) {
+	return func(e *LDAPConfigChangedEvent) {
+		e.BindPassword = bindPassword
+	}
+}
+
+func ChangeLDAPUserFilter(userFilter string) func(*LDAPConfigChangedEvent) {
+	return func(e *LDAPConfigChangedEvent) {
+		e.UserFilter = &userFilter
+	}
+}
+
+func ChangeLDAPIDAttribute(attribute string) func(*LDAPConfigChangedEvent) {
+	return func(e *LDAPConfigChangedEvent) {
+		e.IDAttribute = &attribute
+	}
+}
+
+func ChangeLDAPPreferredUsernameAttribute(attribute string) func(*LDAPConfigChangedEvent) {
+	return func(e *LDAPConfigChangedEvent) {
+		e.PreferredUsernameAttribute = &attribute
+	}
+}
+
+func ChangeLDAPFirstNameAttribute(attribute string) func(*LDAPConfigChangedEvent) {
+	return func(e *LDAPConfigChangedEvent) {
+		e.FirstNameAttribute = &attribute
+	}
+}
+
+func ChangeLDAPLastNameAttribute(attribute string) func(*LDAPConfigChangedEvent) {
+	return func(e *LDAPConfigChangedEvent) {
+		e.LastNameAttribute = &attribute
+	}
+}
+
+func ChangeLDAPDisplayNameAttribute(attribute string) func(*LDAPConfigChangedEvent) {
+	return func(e *LDAPConfigChangedEvent) {
+		e.DisplayNameAttribute = &attribute
+	}
+}
+
+func ChangeLDAPEmailAttribute(attribute string) func(*LDAPConfigChangedEvent) {
+	return func(e *LDAPConfigChangedEvent) {
+		e.EmailAttribute = &attribute
+	}
+}
+
+func ChangeLDAPPhoneAttribute(attribute string) func(*LDAPConfigChangedEvent) {
+	return func(e *LDAPConfigChangedEvent) {
+		e.PhoneAttribute = &attribute
+	}
+}
+
+func LDAPConfigChangedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &LDAPConfigChangedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "LDAP-Ms9f2", "unable to unmarshal event")
+	}
+
+	return e, nil
+}
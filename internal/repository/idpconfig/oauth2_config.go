@@ -0,0 +1,223 @@
+package idpconfig
+
+import (
+	"encoding/json"
+
+	"github.com/dennigogo/zitadel/internal/crypto"
+	"github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/eventstore"
+	"github.com/dennigogo/zitadel/internal/eventstore/repository"
+)
+
+const (
+	OAuth2ConfigAddedEventType   eventstore.EventType = "oauth2.config.added"
+	OAuth2ConfigChangedEventType eventstore.EventType = "oauth2.config.changed"
+)
+
+type OAuth2ConfigAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	IDPConfigID           string              `json:"idpConfigId"`
+	ClientID              string              `json:"clientId,omitempty"`
+	ClientSecret          *crypto.CryptoValue `json:"clientSecret,omitempty"`
+	AuthorizationEndpoint string              `json:"authorizationEndpoint,omitempty"`
+	TokenEndpoint         string              `json:"tokenEndpoint,omitempty"`
+	UserEndpoint          string              `json:"userEndpoint,omitempty"`
+	Scopes                []string            `json:"scopes,omitempty"`
+	OAuth2UserMapping
+}
+
+// OAuth2UserMapping are the paths of the userinfo values mapped to the user
+type OAuth2UserMapping struct {
+	IDPath                string `json:"idPath,omitempty"`
+	PreferredUsernamePath string `json:"preferredUsernamePath,omitempty"`
+	FirstNamePath         string `json:"firstNamePath,omitempty"`
+	LastNamePath          string `json:"lastNamePath,omitempty"`
+	DisplayNamePath       string `json:"displayNamePath,omitempty"`
+	EmailPath             string `json:"emailPath,omitempty"`
+	PhonePath             string `json:"phonePath,omitempty"`
+}
+
+func (e *OAuth2ConfigAddedEvent) Data() interface{} {
+	return e
+}
+
+func (e *OAuth2ConfigAddedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewOAuth2ConfigAddedEvent(
+	base *eventstore.BaseEvent,
+	idpConfigID,
+	clientID string,
+	clientSecret *crypto.CryptoValue,
+	authorizationEndpoint,
+	tokenEndpoint,
+	userEndpoint string,
+	scopes []string,
+	mapping OAuth2UserMapping,
+) *OAuth2ConfigAddedEvent {
+	return &OAuth2ConfigAddedEvent{
+		BaseEvent:             *base,
+		IDPConfigID:           idpConfigID,
+		ClientID:              clientID,
+		ClientSecret:          clientSecret,
+		AuthorizationEndpoint: authorizationEndpoint,
+		TokenEndpoint:         tokenEndpoint,
+		UserEndpoint:          userEndpoint,
+		Scopes:                scopes,
+		OAuth2UserMapping:     mapping,
+	}
+}
+
+func OAuth2ConfigAddedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &OAuth2ConfigAddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "OAUTH2-Hs8d1", "unable to unmarshal event")
+	}
+
+	return e, nil
+}
+
+type OAuth2ConfigChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	IDPConfigID string `json:"idpConfigId"`
+
+	ClientID              *string             `json:"clientId,omitempty"`
+	ClientSecret          *crypto.CryptoValue `json:"clientSecret,omitempty"`
+	AuthorizationEndpoint *string             `json:"authorizationEndpoint,omitempty"`
+	TokenEndpoint         *string             `json:"tokenEndpoint,omitempty"`
+	UserEndpoint          *string             `json:"userEndpoint,omitempty"`
+	Scopes                []string            `json:"scopes,omitempty"`
+	IDPath                *string             `json:"idPath,omitempty"`
+	PreferredUsernamePath *string             `json:"preferredUsernamePath,omitempty"`
+	FirstNamePath         *string             `json:"firstNamePath,omitempty"`
+	LastNamePath          *string             `json:"lastNamePath,omitempty"`
+	DisplayNamePath       *string             `json:"displayNamePath,omitempty"`
+	EmailPath             *string             `json:"emailPath,omitempty"`
+	PhonePath             *string             `json:"phonePath,omitempty"`
+}
+
+func (e *OAuth2ConfigChangedEvent) Data() interface{} {
+	return e
+}
+
+func (e *OAuth2ConfigChangedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewOAuth2ConfigChangedEvent(
+	base *eventstore.BaseEvent,
+	idpConfigID string,
+	changes []OAuth2ConfigChanges,
+) (*OAuth2ConfigChangedEvent, error) {
+	if len(changes) == 0 {
+		return nil, errors.ThrowPreconditionFailed(nil, "IDPCONFIG-Kd9s3", "Errors.NoChangesFound")
+	}
+	changeEvent := &OAuth2ConfigChangedEvent{
+		BaseEvent:   *base,
+		IDPConfigID: idpConfigID,
+	}
+	for _, change := range changes {
+		change(changeEvent)
+	}
+	return changeEvent, nil
+}
+
+type OAuth2ConfigChanges func(*OAuth2ConfigChangedEvent)
+
+func ChangeOAuth2ClientID(clientID string) func(*OAuth2ConfigChangedEvent) {
+	return func(e *OAuth2ConfigChangedEvent) {
+		e.ClientID = &clientID
+	}
+}
+
+func ChangeOAuth2ClientSecret(secret *crypto.CryptoValue) func(*OAuth2ConfigChangedEvent) {
+	return func(e *OAuth2ConfigChangedEvent) {
+		e.ClientSecret = secret
+	}
+}
+
+func ChangeOAuth2AuthorizationEndpoint(endpoint string) func(*OAuth2ConfigChangedEvent) {
+	return func(e *OAuth2ConfigChangedEvent) {
+		e.AuthorizationEndpoint = &endpoint
+	}
+}
+
+func ChangeOAuth2TokenEndpoint(endpoint string) func(*OAuth2ConfigChangedEvent) {
+	return func(e *OAuth2ConfigChangedEvent) {
+		e.TokenEndpoint = &endpoint
+	}
+}
+
+func ChangeOAuth2UserEndpoint(endpoint string) func(*OAuth2ConfigChangedEvent) {
+	return func(e *OAuth2ConfigChangedEvent) {
+		e.UserEndpoint = &endpoint
+	}
+}
+
+func ChangeOAuth2Scopes(scopes []string) func(*OAuth2ConfigChangedEvent) {
+	return func(e *OAuth2ConfigChangedEvent) {
+		e.Scopes = scopes
+	}
+}
+
+func ChangeOAuth2IDPath(path string) func(*OAuth2ConfigChangedEvent) {
+	return func(e *OAuth2ConfigChangedEvent) {
+		e.IDPath = &path
+	}
+}
+
+func ChangeOAuth2PreferredUsernamePath(path string) func(*OAuth2ConfigChangedEvent) {
+	return func(e *OAuth2ConfigChangedEvent) {
+		e.PreferredUsernamePath = &path
+	}
+}
+
+func ChangeOAuth2FirstNamePath(path string) func(*OAuth2ConfigChangedEvent) {
+	return func(e *OAuth2ConfigChangedEvent) {
+		e.FirstNamePath = &path
+	}
+}
+
+func ChangeOAuth2LastNamePath(path string) func(*OAuth2ConfigChangedEvent) {
+	return func(e *OAuth2ConfigChangedEvent) {
+		e.LastNamePath = &path
+	}
+}
+
+func ChangeOAuth2DisplayNamePath(path string) func(*OAuth2ConfigChangedEvent) {
+	return func(e *OAuth2ConfigChangedEvent) {
+		e.DisplayNamePath = &path
+	}
+}
+
+func ChangeOAuth2EmailPath(path string) func(*OAuth2ConfigChangedEvent) {
+	return func(e *OAuth2ConfigChangedEvent) {
+		e.EmailPath = &path
+	}
+}
+
+func ChangeOAuth2PhonePath(path string) func(*OAuth2ConfigChangedEvent) {
+	return func(e *OAuth2ConfigChangedEvent) {
+		e.PhonePath = &path
+	}
+}
+
+func OAuth2ConfigChangedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &OAuth2ConfigChangedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "OAUTH2-Jd7s2", "unable to unmarshal event")
+	}
+
+	return e, nil
+}
@@ -0,0 +1,145 @@
+package idpconfig
+
+import (
+	"encoding/json"
+
+	"github.com/dennigogo/zitadel/internal/crypto"
+	"github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/eventstore"
+	"github.com/dennigogo/zitadel/internal/eventstore/repository"
+)
+
+const (
+	SAMLConfigAddedEventType   eventstore.EventType = "saml.config.added"
+	SAMLConfigChangedEventType eventstore.EventType = "saml.config.changed"
+)
+
+type SAMLConfigAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	IDPConfigID       string              `json:"idpConfigId"`
+	Metadata          []byte              `json:"metadata,omitempty"`
+	MetadataURL       string              `json:"metadataUrl,omitempty"`
+	Key               *crypto.CryptoValue `json:"key,omitempty"`
+	Certificate       []byte              `json:"certificate,omitempty"`
+	WithSignedRequest bool                `json:"withSignedRequest,omitempty"`
+}
+
+func (e *SAMLConfigAddedEvent) Data() interface{} {
+	return e
+}
+
+func (e *SAMLConfigAddedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewSAMLConfigAddedEvent(
+	base *eventstore.BaseEvent,
+	idpConfigID string,
+	metadata []byte,
+	metadataURL string,
+	key *crypto.CryptoValue,
+	certificate []byte,
+	withSignedRequest bool,
+) *SAMLConfigAddedEvent {
+	return &SAMLConfigAddedEvent{
+		BaseEvent:         *base,
+		IDPConfigID:       idpConfigID,
+		Metadata:          metadata,
+		MetadataURL:       metadataURL,
+		Key:               key,
+		Certificate:       certificate,
+		WithSignedRequest: withSignedRequest,
+	}
+}
+
+func SAMLConfigAddedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &SAMLConfigAddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "SAML-9fk2s", "unable to unmarshal event")
+	}
+
+	return e, nil
+}
+
+type SAMLConfigChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	IDPConfigID string `json:"idpConfigId"`
+
+	Metadata          []byte              `json:"metadata,omitempty"`
+	MetadataURL       *string             `json:"metadataUrl,omitempty"`
+	Key               *crypto.CryptoValue `json:"key,omitempty"`
+	Certificate       []byte              `json:"certificate,omitempty"`
+	WithSignedRequest *bool               `json:"withSignedRequest,omitempty"`
+}
+
+func (e *SAMLConfigChangedEvent) Data() interface{} {
+	return e
+}
+
+func (e *SAMLConfigChangedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewSAMLConfigChangedEvent(
+	base *eventstore.BaseEvent,
+	idpConfigID string,
+	changes []SAMLConfigChanges,
+) (*SAMLConfigChangedEvent, error) {
+	if len(changes) == 0 {
+		return nil, errors.ThrowPreconditionFailed(nil, "IDPCONFIG-Sfe2w", "Errors.NoChangesFound")
+	}
+	changeEvent := &SAMLConfigChangedEvent{
+		BaseEvent:   *base,
+		IDPConfigID: idpConfigID,
+	}
+	for _, change := range changes {
+		change(changeEvent)
+	}
+	return changeEvent, nil
+}
+
+type SAMLConfigChanges func(*SAMLConfigChangedEvent)
+
+func ChangeSAMLMetadata(metadata []byte) func(*SAMLConfigChangedEvent) {
+	return func(e *SAMLConfigChangedEvent) {
+		e.Metadata = metadata
+	}
+}
+
+func ChangeSAMLMetadataURL(metadataURL string) func(*SAMLConfigChangedEvent) {
+	return func(e *SAMLConfigChangedEvent) {
+		e.MetadataURL = &metadataURL
+	}
+}
+
+func ChangeSAMLKeyPair(key *crypto.CryptoValue, certificate []byte) func(*SAMLConfigChangedEvent) {
+	return func(e *SAMLConfigChangedEvent) {
+		e.Key = key
+		e.Certificate = certificate
+	}
+}
+
+func ChangeSAMLWithSignedRequest(withSignedRequest bool) func(*SAMLConfigChangedEvent) {
+	return func(e *SAMLConfigChangedEvent) {
+		e.WithSignedRequest = &withSignedRequest
+	}
+}
+
+func SAMLConfigChangedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &SAMLConfigChangedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "SAML-2ks9f", "unable to unmarshal event")
+	}
+
+	return e, nil
+}
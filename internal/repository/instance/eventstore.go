@@ -19,6 +19,12 @@ func RegisterEventMappers(es *eventstore.Eventstore) {
 		RegisterFilterEventMapper(SMSConfigTwilioAddedEventType, SMSConfigTwilioAddedEventMapper).
 		RegisterFilterEventMapper(SMSConfigTwilioChangedEventType, SMSConfigTwilioChangedEventMapper).
 		RegisterFilterEventMapper(SMSConfigTwilioTokenChangedEventType, SMSConfigTwilioTokenChangedEventMapper).
+		RegisterFilterEventMapper(SMSConfigHTTPAddedEventType, SMSConfigHTTPAddedEventMapper).
+		RegisterFilterEventMapper(SMSConfigHTTPChangedEventType, SMSConfigHTTPChangedEventMapper).
+		RegisterFilterEventMapper(SMSConfigHTTPAuthHeaderChangedEventType, SMSConfigHTTPAuthHeaderChangedEventMapper).
+		RegisterFilterEventMapper(SMSConfigSMPPAddedEventType, SMSConfigSMPPAddedEventMapper).
+		RegisterFilterEventMapper(SMSConfigSMPPChangedEventType, SMSConfigSMPPChangedEventMapper).
+		RegisterFilterEventMapper(SMSConfigSMPPPasswordChangedEventType, SMSConfigSMPPPasswordChangedEventMapper).
 		RegisterFilterEventMapper(SMSConfigActivatedEventType, SMSConfigActivatedEventMapper).
 		RegisterFilterEventMapper(SMSConfigDeactivatedEventType, SMSConfigDeactivatedEventMapper).
 		RegisterFilterEventMapper(SMSConfigRemovedEventType, SMSConfigRemovedEventMapper).
@@ -30,6 +36,8 @@ func RegisterEventMappers(es *eventstore.Eventstore) {
 		RegisterFilterEventMapper(DebugNotificationProviderLogRemovedEventType, DebugNotificationProviderLogRemovedEventMapper).
 		RegisterFilterEventMapper(OIDCSettingsAddedEventType, OIDCSettingsAddedEventMapper).
 		RegisterFilterEventMapper(OIDCSettingsChangedEventType, OIDCSettingsChangedEventMapper).
+		RegisterFilterEventMapper(RateLimitsSetEventType, RateLimitsSetEventMapper).
+		RegisterFilterEventMapper(RateLimitsResetEventType, RateLimitsResetEventMapper).
 		RegisterFilterEventMapper(LabelPolicyAddedEventType, LabelPolicyAddedEventMapper).
 		RegisterFilterEventMapper(LabelPolicyChangedEventType, LabelPolicyChangedEventMapper).
 		RegisterFilterEventMapper(LabelPolicyActivatedEventType, LabelPolicyActivatedEventMapper).
@@ -69,6 +77,12 @@ func RegisterEventMappers(es *eventstore.Eventstore) {
 		RegisterFilterEventMapper(IDPOIDCConfigChangedEventType, IDPOIDCConfigChangedEventMapper).
 		RegisterFilterEventMapper(IDPJWTConfigAddedEventType, IDPJWTConfigAddedEventMapper).
 		RegisterFilterEventMapper(IDPJWTConfigChangedEventType, IDPJWTConfigChangedEventMapper).
+		RegisterFilterEventMapper(IDPSAMLConfigAddedEventType, IDPSAMLConfigAddedEventMapper).
+		RegisterFilterEventMapper(IDPSAMLConfigChangedEventType, IDPSAMLConfigChangedEventMapper).
+		RegisterFilterEventMapper(IDPLDAPConfigAddedEventType, IDPLDAPConfigAddedEventMapper).
+		RegisterFilterEventMapper(IDPLDAPConfigChangedEventType, IDPLDAPConfigChangedEventMapper).
+		RegisterFilterEventMapper(IDPOAuth2ConfigAddedEventType, IDPOAuth2ConfigAddedEventMapper).
+		RegisterFilterEventMapper(IDPOAuth2ConfigChangedEventType, IDPOAuth2ConfigChangedEventMapper).
 		RegisterFilterEventMapper(LoginPolicyIDPProviderAddedEventType, IdentityProviderAddedEventMapper).
 		RegisterFilterEventMapper(LoginPolicyIDPProviderRemovedEventType, IdentityProviderRemovedEventMapper).
 		RegisterFilterEventMapper(LoginPolicyIDPProviderCascadeRemovedEventType, IdentityProviderCascadeRemovedEventMapper).
@@ -38,7 +38,8 @@ func NewLoginPolicyAddedEvent(
 	externalLoginCheckLifetime,
 	mfaInitSkipLifetime,
 	secondFactorCheckLifetime,
-	multiFactorCheckLifetime time.Duration,
+	multiFactorCheckLifetime,
+	trustedDeviceLifetime time.Duration,
 ) *LoginPolicyAddedEvent {
 	return &LoginPolicyAddedEvent{
 		LoginPolicyAddedEvent: *policy.NewLoginPolicyAddedEvent(
@@ -61,7 +62,8 @@ func NewLoginPolicyAddedEvent(
 			externalLoginCheckLifetime,
 			mfaInitSkipLifetime,
 			secondFactorCheckLifetime,
-			multiFactorCheckLifetime),
+			multiFactorCheckLifetime,
+			trustedDeviceLifetime),
 	}
 }
 
@@ -3,6 +3,7 @@ package instance
 import (
 	"context"
 
+	"github.com/dennigogo/zitadel/internal/domain"
 	"github.com/dennigogo/zitadel/internal/eventstore"
 
 	"github.com/dennigogo/zitadel/internal/eventstore/repository"
@@ -26,6 +27,7 @@ func NewPasswordComplexityPolicyAddedEvent(
 	hasUppercase,
 	hasNumber,
 	hasSymbol bool,
+	breachedPasswordCheck domain.BreachedPasswordCheck,
 ) *PasswordComplexityPolicyAddedEvent {
 	return &PasswordComplexityPolicyAddedEvent{
 		PasswordComplexityPolicyAddedEvent: *policy.NewPasswordComplexityPolicyAddedEvent(
@@ -37,7 +39,8 @@ func NewPasswordComplexityPolicyAddedEvent(
 			hasLowercase,
 			hasUppercase,
 			hasNumber,
-			hasSymbol),
+			hasSymbol,
+			breachedPasswordCheck),
 	}
 }
 
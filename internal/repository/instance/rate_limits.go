@@ -0,0 +1,100 @@
+package instance
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/eventstore"
+	"github.com/dennigogo/zitadel/internal/eventstore/repository"
+)
+
+const (
+	rateLimitsPrefix         = "ratelimits."
+	RateLimitsSetEventType   = instanceEventTypePrefix + rateLimitsPrefix + "set"
+	RateLimitsResetEventType = instanceEventTypePrefix + rateLimitsPrefix + "reset"
+)
+
+type RateLimit struct {
+	Requests uint64        `json:"requests,omitempty"`
+	Interval time.Duration `json:"interval,omitempty"`
+}
+
+type RateLimitsSetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Instance RateLimit `json:"instance,omitempty"`
+	Client   RateLimit `json:"client,omitempty"`
+	IP       RateLimit `json:"ip,omitempty"`
+}
+
+func NewRateLimitsSetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	instanceLimit,
+	clientLimit,
+	ipLimit RateLimit,
+) *RateLimitsSetEvent {
+	return &RateLimitsSetEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			RateLimitsSetEventType,
+		),
+		Instance: instanceLimit,
+		Client:   clientLimit,
+		IP:       ipLimit,
+	}
+}
+
+func (e *RateLimitsSetEvent) Data() interface{} {
+	return e
+}
+
+func (e *RateLimitsSetEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func RateLimitsSetEventMapper(event *repository.Event) (eventstore.Event, error) {
+	rateLimitsSet := &RateLimitsSetEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := json.Unmarshal(event.Data, rateLimitsSet)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "IAM-Rl3s9", "unable to unmarshal rate limits set")
+	}
+
+	return rateLimitsSet, nil
+}
+
+type RateLimitsResetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func NewRateLimitsResetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+) *RateLimitsResetEvent {
+	return &RateLimitsResetEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			RateLimitsResetEventType,
+		),
+	}
+}
+
+func (e *RateLimitsResetEvent) Data() interface{} {
+	return nil
+}
+
+func (e *RateLimitsResetEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func RateLimitsResetEventMapper(event *repository.Event) (eventstore.Event, error) {
+	return &RateLimitsResetEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
@@ -11,14 +11,22 @@ import (
 )
 
 const (
-	smsConfigPrefix                      = "sms.config"
-	smsConfigTwilioPrefix                = "twilio."
-	SMSConfigTwilioAddedEventType        = instanceEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "added"
-	SMSConfigTwilioChangedEventType      = instanceEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "changed"
-	SMSConfigTwilioTokenChangedEventType = instanceEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "token.changed"
-	SMSConfigActivatedEventType          = instanceEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "activated"
-	SMSConfigDeactivatedEventType        = instanceEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "deactivated"
-	SMSConfigRemovedEventType            = instanceEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "removed"
+	smsConfigPrefix                         = "sms.config"
+	smsConfigTwilioPrefix                   = "twilio."
+	smsConfigHTTPPrefix                     = "http."
+	smsConfigSMPPPrefix                     = "smpp."
+	SMSConfigTwilioAddedEventType           = instanceEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "added"
+	SMSConfigTwilioChangedEventType         = instanceEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "changed"
+	SMSConfigTwilioTokenChangedEventType    = instanceEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "token.changed"
+	SMSConfigHTTPAddedEventType             = instanceEventTypePrefix + smsConfigPrefix + smsConfigHTTPPrefix + "added"
+	SMSConfigHTTPChangedEventType           = instanceEventTypePrefix + smsConfigPrefix + smsConfigHTTPPrefix + "changed"
+	SMSConfigHTTPAuthHeaderChangedEventType = instanceEventTypePrefix + smsConfigPrefix + smsConfigHTTPPrefix + "authheader.changed"
+	SMSConfigSMPPAddedEventType             = instanceEventTypePrefix + smsConfigPrefix + smsConfigSMPPPrefix + "added"
+	SMSConfigSMPPChangedEventType           = instanceEventTypePrefix + smsConfigPrefix + smsConfigSMPPPrefix + "changed"
+	SMSConfigSMPPPasswordChangedEventType   = instanceEventTypePrefix + smsConfigPrefix + smsConfigSMPPPrefix + "password.changed"
+	SMSConfigActivatedEventType             = instanceEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "activated"
+	SMSConfigDeactivatedEventType           = instanceEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "deactivated"
+	SMSConfigRemovedEventType               = instanceEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "removed"
 )
 
 type SMSConfigTwilioAddedEvent struct {
@@ -180,6 +188,414 @@ func SMSConfigTwilioTokenChangedEventMapper(event *repository.Event) (eventstore
 	return smtpConfigTokenChagned, nil
 }
 
+type SMSConfigHTTPAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID                 string              `json:"id,omitempty"`
+	Endpoint           string              `json:"endpoint,omitempty"`
+	Method             string              `json:"method,omitempty"`
+	ContentType        string              `json:"contentType,omitempty"`
+	BodyTemplate       string              `json:"bodyTemplate,omitempty"`
+	AuthHeaderName     string              `json:"authHeaderName,omitempty"`
+	AuthHeaderValue    *crypto.CryptoValue `json:"authHeaderValue,omitempty"`
+	SuccessStatusCodes []int32             `json:"successStatusCodes,omitempty"`
+	SenderNumber       string              `json:"senderNumber,omitempty"`
+}
+
+func NewSMSConfigHTTPAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id,
+	endpoint,
+	method,
+	contentType,
+	bodyTemplate,
+	authHeaderName string,
+	authHeaderValue *crypto.CryptoValue,
+	successStatusCodes []int32,
+	senderNumber string,
+) *SMSConfigHTTPAddedEvent {
+	return &SMSConfigHTTPAddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMSConfigHTTPAddedEventType,
+		),
+		ID:                 id,
+		Endpoint:           endpoint,
+		Method:             method,
+		ContentType:        contentType,
+		BodyTemplate:       bodyTemplate,
+		AuthHeaderName:     authHeaderName,
+		AuthHeaderValue:    authHeaderValue,
+		SuccessStatusCodes: successStatusCodes,
+		SenderNumber:       senderNumber,
+	}
+}
+
+func (e *SMSConfigHTTPAddedEvent) Data() interface{} {
+	return e
+}
+
+func (e *SMSConfigHTTPAddedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func SMSConfigHTTPAddedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	smsConfigAdded := &SMSConfigHTTPAddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := json.Unmarshal(event.Data, smsConfigAdded)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "IAM-Hs8d2", "unable to unmarshal sms config http added")
+	}
+
+	return smsConfigAdded, nil
+}
+
+type SMSConfigHTTPChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID                 string   `json:"id,omitempty"`
+	Endpoint           *string  `json:"endpoint,omitempty"`
+	Method             *string  `json:"method,omitempty"`
+	ContentType        *string  `json:"contentType,omitempty"`
+	BodyTemplate       *string  `json:"bodyTemplate,omitempty"`
+	AuthHeaderName     *string  `json:"authHeaderName,omitempty"`
+	SuccessStatusCodes *[]int32 `json:"successStatusCodes,omitempty"`
+	SenderNumber       *string  `json:"senderNumber,omitempty"`
+}
+
+func NewSMSConfigHTTPChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	changes []SMSConfigHTTPChanges,
+) (*SMSConfigHTTPChangedEvent, error) {
+	if len(changes) == 0 {
+		return nil, errors.ThrowPreconditionFailed(nil, "IAM-Hd9s1", "Errors.NoChangesFound")
+	}
+	changeEvent := &SMSConfigHTTPChangedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMSConfigHTTPChangedEventType,
+		),
+		ID: id,
+	}
+	for _, change := range changes {
+		change(changeEvent)
+	}
+	return changeEvent, nil
+}
+
+type SMSConfigHTTPChanges func(event *SMSConfigHTTPChangedEvent)
+
+func ChangeSMSConfigHTTPEndpoint(endpoint string) func(event *SMSConfigHTTPChangedEvent) {
+	return func(e *SMSConfigHTTPChangedEvent) {
+		e.Endpoint = &endpoint
+	}
+}
+
+func ChangeSMSConfigHTTPMethod(method string) func(event *SMSConfigHTTPChangedEvent) {
+	return func(e *SMSConfigHTTPChangedEvent) {
+		e.Method = &method
+	}
+}
+
+func ChangeSMSConfigHTTPContentType(contentType string) func(event *SMSConfigHTTPChangedEvent) {
+	return func(e *SMSConfigHTTPChangedEvent) {
+		e.ContentType = &contentType
+	}
+}
+
+func ChangeSMSConfigHTTPBodyTemplate(bodyTemplate string) func(event *SMSConfigHTTPChangedEvent) {
+	return func(e *SMSConfigHTTPChangedEvent) {
+		e.BodyTemplate = &bodyTemplate
+	}
+}
+
+func ChangeSMSConfigHTTPAuthHeaderName(authHeaderName string) func(event *SMSConfigHTTPChangedEvent) {
+	return func(e *SMSConfigHTTPChangedEvent) {
+		e.AuthHeaderName = &authHeaderName
+	}
+}
+
+func ChangeSMSConfigHTTPSuccessStatusCodes(successStatusCodes []int32) func(event *SMSConfigHTTPChangedEvent) {
+	return func(e *SMSConfigHTTPChangedEvent) {
+		e.SuccessStatusCodes = &successStatusCodes
+	}
+}
+
+func ChangeSMSConfigHTTPSenderNumber(senderNumber string) func(event *SMSConfigHTTPChangedEvent) {
+	return func(e *SMSConfigHTTPChangedEvent) {
+		e.SenderNumber = &senderNumber
+	}
+}
+
+func (e *SMSConfigHTTPChangedEvent) Data() interface{} {
+	return e
+}
+
+func (e *SMSConfigHTTPChangedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func SMSConfigHTTPChangedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	smsConfigChanged := &SMSConfigHTTPChangedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := json.Unmarshal(event.Data, smsConfigChanged)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "IAM-Hk2f7", "unable to unmarshal sms config http changed")
+	}
+
+	return smsConfigChanged, nil
+}
+
+type SMSConfigHTTPAuthHeaderChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID              string              `json:"id,omitempty"`
+	AuthHeaderValue *crypto.CryptoValue `json:"authHeaderValue,omitempty"`
+}
+
+func NewSMSConfigHTTPAuthHeaderChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	authHeaderValue *crypto.CryptoValue,
+) *SMSConfigHTTPAuthHeaderChangedEvent {
+	return &SMSConfigHTTPAuthHeaderChangedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMSConfigHTTPAuthHeaderChangedEventType,
+		),
+		ID:              id,
+		AuthHeaderValue: authHeaderValue,
+	}
+}
+
+func (e *SMSConfigHTTPAuthHeaderChangedEvent) Data() interface{} {
+	return e
+}
+
+func (e *SMSConfigHTTPAuthHeaderChangedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func SMSConfigHTTPAuthHeaderChangedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	authHeaderChanged := &SMSConfigHTTPAuthHeaderChangedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := json.Unmarshal(event.Data, authHeaderChanged)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "IAM-Hm4a8", "unable to unmarshal sms config http auth header changed")
+	}
+
+	return authHeaderChanged, nil
+}
+
+type SMSConfigSMPPAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID           string              `json:"id,omitempty"`
+	Host         string              `json:"host,omitempty"`
+	Port         uint16              `json:"port,omitempty"`
+	TLS          bool                `json:"tls,omitempty"`
+	SystemID     string              `json:"systemId,omitempty"`
+	Password     *crypto.CryptoValue `json:"password,omitempty"`
+	SystemType   string              `json:"systemType,omitempty"`
+	SenderNumber string              `json:"senderNumber,omitempty"`
+}
+
+func NewSMSConfigSMPPAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id,
+	host string,
+	port uint16,
+	tls bool,
+	systemID string,
+	password *crypto.CryptoValue,
+	systemType,
+	senderNumber string,
+) *SMSConfigSMPPAddedEvent {
+	return &SMSConfigSMPPAddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMSConfigSMPPAddedEventType,
+		),
+		ID:           id,
+		Host:         host,
+		Port:         port,
+		TLS:          tls,
+		SystemID:     systemID,
+		Password:     password,
+		SystemType:   systemType,
+		SenderNumber: senderNumber,
+	}
+}
+
+func (e *SMSConfigSMPPAddedEvent) Data() interface{} {
+	return e
+}
+
+func (e *SMSConfigSMPPAddedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func SMSConfigSMPPAddedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	smsConfigAdded := &SMSConfigSMPPAddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := json.Unmarshal(event.Data, smsConfigAdded)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "IAM-Sp3k9", "unable to unmarshal sms config smpp added")
+	}
+
+	return smsConfigAdded, nil
+}
+
+type SMSConfigSMPPChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID           string  `json:"id,omitempty"`
+	Host         *string `json:"host,omitempty"`
+	Port         *uint16 `json:"port,omitempty"`
+	TLS          *bool   `json:"tls,omitempty"`
+	SystemID     *string `json:"systemId,omitempty"`
+	SystemType   *string `json:"systemType,omitempty"`
+	SenderNumber *string `json:"senderNumber,omitempty"`
+}
+
+func NewSMSConfigSMPPChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	changes []SMSConfigSMPPChanges,
+) (*SMSConfigSMPPChangedEvent, error) {
+	if len(changes) == 0 {
+		return nil, errors.ThrowPreconditionFailed(nil, "IAM-Sq8d4", "Errors.NoChangesFound")
+	}
+	changeEvent := &SMSConfigSMPPChangedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMSConfigSMPPChangedEventType,
+		),
+		ID: id,
+	}
+	for _, change := range changes {
+		change(changeEvent)
+	}
+	return changeEvent, nil
+}
+
+type SMSConfigSMPPChanges func(event *SMSConfigSMPPChangedEvent)
+
+func ChangeSMSConfigSMPPHost(host string) func(event *SMSConfigSMPPChangedEvent) {
+	return func(e *SMSConfigSMPPChangedEvent) {
+		e.Host = &host
+	}
+}
+
+func ChangeSMSConfigSMPPPort(port uint16) func(event *SMSConfigSMPPChangedEvent) {
+	return func(e *SMSConfigSMPPChangedEvent) {
+		e.Port = &port
+	}
+}
+
+func ChangeSMSConfigSMPPTLS(tls bool) func(event *SMSConfigSMPPChangedEvent) {
+	return func(e *SMSConfigSMPPChangedEvent) {
+		e.TLS = &tls
+	}
+}
+
+func ChangeSMSConfigSMPPSystemID(systemID string) func(event *SMSConfigSMPPChangedEvent) {
+	return func(e *SMSConfigSMPPChangedEvent) {
+		e.SystemID = &systemID
+	}
+}
+
+func ChangeSMSConfigSMPPSystemType(systemType string) func(event *SMSConfigSMPPChangedEvent) {
+	return func(e *SMSConfigSMPPChangedEvent) {
+		e.SystemType = &systemType
+	}
+}
+
+func ChangeSMSConfigSMPPSenderNumber(senderNumber string) func(event *SMSConfigSMPPChangedEvent) {
+	return func(e *SMSConfigSMPPChangedEvent) {
+		e.SenderNumber = &senderNumber
+	}
+}
+
+func (e *SMSConfigSMPPChangedEvent) Data() interface{} {
+	return e
+}
+
+func (e *SMSConfigSMPPChangedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func SMSConfigSMPPChangedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	smsConfigChanged := &SMSConfigSMPPChangedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := json.Unmarshal(event.Data, smsConfigChanged)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "IAM-Sr6v2", "unable to unmarshal sms config smpp changed")
+	}
+
+	return smsConfigChanged, nil
+}
+
+type SMSConfigSMPPPasswordChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID       string              `json:"id,omitempty"`
+	Password *crypto.CryptoValue `json:"password,omitempty"`
+}
+
+func NewSMSConfigSMPPPasswordChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	password *crypto.CryptoValue,
+) *SMSConfigSMPPPasswordChangedEvent {
+	return &SMSConfigSMPPPasswordChangedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMSConfigSMPPPasswordChangedEventType,
+		),
+		ID:       id,
+		Password: password,
+	}
+}
+
+func (e *SMSConfigSMPPPasswordChangedEvent) Data() interface{} {
+	return e
+}
+
+func (e *SMSConfigSMPPPasswordChangedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func SMSConfigSMPPPasswordChangedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	passwordChanged := &SMSConfigSMPPPasswordChangedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := json.Unmarshal(event.Data, passwordChanged)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "IAM-Sw1n5", "unable to unmarshal sms config smpp password changed")
+	}
+
+	return passwordChanged, nil
+}
+
 type SMSConfigActivatedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 	ID                   string `json:"id,omitempty"`
@@ -0,0 +1,25 @@
+package kvstore
+
+import "github.com/dennigogo/zitadel/internal/eventstore"
+
+const (
+	AggregateType    = "kvstore"
+	AggregateVersion = "v1"
+)
+
+type Aggregate struct {
+	eventstore.Aggregate
+}
+
+// NewAggregate returns the key value store of an organisation,
+// there is exactly one store per organisation
+func NewAggregate(orgID string) *Aggregate {
+	return &Aggregate{
+		Aggregate: eventstore.Aggregate{
+			Type:          AggregateType,
+			Version:       AggregateVersion,
+			ID:            orgID,
+			ResourceOwner: orgID,
+		},
+	}
+}
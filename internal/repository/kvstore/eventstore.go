@@ -0,0 +1,8 @@
+package kvstore
+
+import "github.com/dennigogo/zitadel/internal/eventstore"
+
+func RegisterEventMappers(es *eventstore.Eventstore) {
+	es.RegisterFilterEventMapper(EntrySetEventType, EntrySetEventMapper).
+		RegisterFilterEventMapper(EntryRemovedEventType, EntryRemovedEventMapper)
+}
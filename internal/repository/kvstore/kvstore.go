@@ -0,0 +1,103 @@
+package kvstore
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/eventstore"
+	"github.com/dennigogo/zitadel/internal/eventstore/repository"
+)
+
+const (
+	eventTypePrefix       = eventstore.EventType("kvstore.entry.")
+	EntrySetEventType     = eventTypePrefix + "set"
+	EntryRemovedEventType = eventTypePrefix + "removed"
+)
+
+type EntrySetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Key   string `json:"key"`
+	Value []byte `json:"value"`
+}
+
+func (e *EntrySetEvent) Data() interface{} {
+	return e
+}
+
+func (e *EntrySetEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewEntrySetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	key string,
+	value []byte,
+) *EntrySetEvent {
+	return &EntrySetEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			EntrySetEventType,
+		),
+		Key:   key,
+		Value: value,
+	}
+}
+
+func EntrySetEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &EntrySetEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "KVSTO-Hq8d2", "unable to unmarshal kv entry set")
+	}
+
+	return e, nil
+}
+
+type EntryRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Key string `json:"key"`
+}
+
+func (e *EntryRemovedEvent) Data() interface{} {
+	return e
+}
+
+func (e *EntryRemovedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewEntryRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	key string,
+) *EntryRemovedEvent {
+	return &EntryRemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			EntryRemovedEventType,
+		),
+		Key: key,
+	}
+}
+
+func EntryRemovedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &EntryRemovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "KVSTO-Jk3m9", "unable to unmarshal kv entry removed")
+	}
+
+	return e, nil
+}
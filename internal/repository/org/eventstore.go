@@ -77,6 +77,12 @@ func RegisterEventMappers(es *eventstore.Eventstore) {
 		RegisterFilterEventMapper(IDPOIDCConfigChangedEventType, IDPOIDCConfigChangedEventMapper).
 		RegisterFilterEventMapper(IDPJWTConfigAddedEventType, IDPJWTConfigAddedEventMapper).
 		RegisterFilterEventMapper(IDPJWTConfigChangedEventType, IDPJWTConfigChangedEventMapper).
+		RegisterFilterEventMapper(IDPSAMLConfigAddedEventType, IDPSAMLConfigAddedEventMapper).
+		RegisterFilterEventMapper(IDPSAMLConfigChangedEventType, IDPSAMLConfigChangedEventMapper).
+		RegisterFilterEventMapper(IDPLDAPConfigAddedEventType, IDPLDAPConfigAddedEventMapper).
+		RegisterFilterEventMapper(IDPLDAPConfigChangedEventType, IDPLDAPConfigChangedEventMapper).
+		RegisterFilterEventMapper(IDPOAuth2ConfigAddedEventType, IDPOAuth2ConfigAddedEventMapper).
+		RegisterFilterEventMapper(IDPOAuth2ConfigChangedEventType, IDPOAuth2ConfigChangedEventMapper).
 		RegisterFilterEventMapper(TriggerActionsSetEventType, TriggerActionsSetEventMapper).
 		RegisterFilterEventMapper(TriggerActionsCascadeRemovedEventType, TriggerActionsCascadeRemovedEventMapper).
 		RegisterFilterEventMapper(FlowClearedEventType, FlowClearedEventMapper).
@@ -0,0 +1,92 @@
+package org
+
+import (
+	"context"
+
+	"github.com/dennigogo/zitadel/internal/crypto"
+	"github.com/dennigogo/zitadel/internal/eventstore"
+	"github.com/dennigogo/zitadel/internal/eventstore/repository"
+	"github.com/dennigogo/zitadel/internal/repository/idpconfig"
+)
+
+const (
+	IDPOAuth2ConfigAddedEventType   eventstore.EventType = "org.idp." + idpconfig.OAuth2ConfigAddedEventType
+	IDPOAuth2ConfigChangedEventType eventstore.EventType = "org.idp." + idpconfig.OAuth2ConfigChangedEventType
+)
+
+type IDPOAuth2ConfigAddedEvent struct {
+	idpconfig.OAuth2ConfigAddedEvent
+}
+
+func NewIDPOAuth2ConfigAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	idpConfigID,
+	clientID string,
+	clientSecret *crypto.CryptoValue,
+	authorizationEndpoint,
+	tokenEndpoint,
+	userEndpoint string,
+	scopes []string,
+	mapping idpconfig.OAuth2UserMapping,
+) *IDPOAuth2ConfigAddedEvent {
+	return &IDPOAuth2ConfigAddedEvent{
+		OAuth2ConfigAddedEvent: *idpconfig.NewOAuth2ConfigAddedEvent(
+			eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				IDPOAuth2ConfigAddedEventType,
+			),
+			idpConfigID,
+			clientID,
+			clientSecret,
+			authorizationEndpoint,
+			tokenEndpoint,
+			userEndpoint,
+			scopes,
+			mapping,
+		),
+	}
+}
+
+func IDPOAuth2ConfigAddedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e, err := idpconfig.OAuth2ConfigAddedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &IDPOAuth2ConfigAddedEvent{OAuth2ConfigAddedEvent: *e.(*idpconfig.OAuth2ConfigAddedEvent)}, nil
+}
+
+type IDPOAuth2ConfigChangedEvent struct {
+	idpconfig.OAuth2ConfigChangedEvent
+}
+
+func NewIDPOAuth2ConfigChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	idpConfigID string,
+	changes []idpconfig.OAuth2ConfigChanges,
+) (*IDPOAuth2ConfigChangedEvent, error) {
+	changeEvent, err := idpconfig.NewOAuth2ConfigChangedEvent(
+		eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			IDPOAuth2ConfigChangedEventType),
+		idpConfigID,
+		changes,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &IDPOAuth2ConfigChangedEvent{OAuth2ConfigChangedEvent: *changeEvent}, nil
+}
+
+func IDPOAuth2ConfigChangedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e, err := idpconfig.OAuth2ConfigChangedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &IDPOAuth2ConfigChangedEvent{OAuth2ConfigChangedEvent: *e.(*idpconfig.OAuth2ConfigChangedEvent)}, nil
+}
@@ -0,0 +1,88 @@
+package org
+
+import (
+	"context"
+
+	"github.com/dennigogo/zitadel/internal/crypto"
+	"github.com/dennigogo/zitadel/internal/eventstore"
+	"github.com/dennigogo/zitadel/internal/eventstore/repository"
+	"github.com/dennigogo/zitadel/internal/repository/idpconfig"
+)
+
+const (
+	IDPSAMLConfigAddedEventType   eventstore.EventType = "org.idp." + idpconfig.SAMLConfigAddedEventType
+	IDPSAMLConfigChangedEventType eventstore.EventType = "org.idp." + idpconfig.SAMLConfigChangedEventType
+)
+
+type IDPSAMLConfigAddedEvent struct {
+	idpconfig.SAMLConfigAddedEvent
+}
+
+func NewIDPSAMLConfigAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	idpConfigID string,
+	metadata []byte,
+	metadataURL string,
+	key *crypto.CryptoValue,
+	certificate []byte,
+	withSignedRequest bool,
+) *IDPSAMLConfigAddedEvent {
+	return &IDPSAMLConfigAddedEvent{
+		SAMLConfigAddedEvent: *idpconfig.NewSAMLConfigAddedEvent(
+			eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				IDPSAMLConfigAddedEventType,
+			),
+			idpConfigID,
+			metadata,
+			metadataURL,
+			key,
+			certificate,
+			withSignedRequest,
+		),
+	}
+}
+
+func IDPSAMLConfigAddedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e, err := idpconfig.SAMLConfigAddedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &IDPSAMLConfigAddedEvent{SAMLConfigAddedEvent: *e.(*idpconfig.SAMLConfigAddedEvent)}, nil
+}
+
+type IDPSAMLConfigChangedEvent struct {
+	idpconfig.SAMLConfigChangedEvent
+}
+
+func NewIDPSAMLConfigChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	idpConfigID string,
+	changes []idpconfig.SAMLConfigChanges,
+) (*IDPSAMLConfigChangedEvent, error) {
+	changeEvent, err := idpconfig.NewSAMLConfigChangedEvent(
+		eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			IDPSAMLConfigChangedEventType),
+		idpConfigID,
+		changes,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &IDPSAMLConfigChangedEvent{SAMLConfigChangedEvent: *changeEvent}, nil
+}
+
+func IDPSAMLConfigChangedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e, err := idpconfig.SAMLConfigChangedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &IDPSAMLConfigChangedEvent{SAMLConfigChangedEvent: *e.(*idpconfig.SAMLConfigChangedEvent)}, nil
+}
@@ -39,7 +39,8 @@ func NewLoginPolicyAddedEvent(
 	externalLoginCheckLifetime,
 	mfaInitSkipLifetime,
 	secondFactorCheckLifetime,
-	multiFactorCheckLifetime time.Duration,
+	multiFactorCheckLifetime,
+	trustedDeviceLifetime time.Duration,
 ) *LoginPolicyAddedEvent {
 	return &LoginPolicyAddedEvent{
 		LoginPolicyAddedEvent: *policy.NewLoginPolicyAddedEvent(
@@ -63,6 +64,7 @@ func NewLoginPolicyAddedEvent(
 			mfaInitSkipLifetime,
 			secondFactorCheckLifetime,
 			multiFactorCheckLifetime,
+			trustedDeviceLifetime,
 		),
 	}
 }
@@ -2,6 +2,7 @@ package org
 
 import (
 	"context"
+	"time"
 
 	"github.com/dennigogo/zitadel/internal/eventstore"
 
@@ -22,8 +23,13 @@ type LockoutPolicyAddedEvent struct {
 func NewLockoutPolicyAddedEvent(
 	ctx context.Context,
 	aggregate *eventstore.Aggregate,
-	maxAttempts uint64,
+	maxAttempts,
+	maxOTPAttempts,
+	maxU2FAttempts uint64,
 	showLockoutFailure bool,
+	autoUnlockAfter,
+	attemptDelay,
+	maxAttemptDelay time.Duration,
 ) *LockoutPolicyAddedEvent {
 	return &LockoutPolicyAddedEvent{
 		LockoutPolicyAddedEvent: *policy.NewLockoutPolicyAddedEvent(
@@ -32,7 +38,12 @@ func NewLockoutPolicyAddedEvent(
 				aggregate,
 				LockoutPolicyAddedEventType),
 			maxAttempts,
-			showLockoutFailure),
+			maxOTPAttempts,
+			maxU2FAttempts,
+			showLockoutFailure,
+			autoUnlockAfter,
+			attemptDelay,
+			maxAttemptDelay),
 	}
 }
 
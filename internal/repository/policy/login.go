@@ -36,6 +36,7 @@ type LoginPolicyAddedEvent struct {
 	MFAInitSkipLifetime        time.Duration           `json:"mfaInitSkipLifetime,omitempty"`
 	SecondFactorCheckLifetime  time.Duration           `json:"secondFactorCheckLifetime,omitempty"`
 	MultiFactorCheckLifetime   time.Duration           `json:"multiFactorCheckLifetime,omitempty"`
+	TrustedDeviceLifetime      time.Duration           `json:"trustedDeviceLifetime,omitempty"`
 }
 
 func (e *LoginPolicyAddedEvent) Data() interface{} {
@@ -63,7 +64,8 @@ func NewLoginPolicyAddedEvent(
 	externalLoginCheckLifetime,
 	mfaInitSkipLifetime,
 	secondFactorCheckLifetime,
-	multiFactorCheckLifetime time.Duration,
+	multiFactorCheckLifetime,
+	trustedDeviceLifetime time.Duration,
 ) *LoginPolicyAddedEvent {
 	return &LoginPolicyAddedEvent{
 		BaseEvent:                  *base,
@@ -81,6 +83,7 @@ func NewLoginPolicyAddedEvent(
 		MFAInitSkipLifetime:        mfaInitSkipLifetime,
 		SecondFactorCheckLifetime:  secondFactorCheckLifetime,
 		MultiFactorCheckLifetime:   multiFactorCheckLifetime,
+		TrustedDeviceLifetime:      trustedDeviceLifetime,
 		DisableLoginWithEmail:      disableLoginWithEmail,
 		DisableLoginWithPhone:      disableLoginWithPhone,
 	}
@@ -118,6 +121,7 @@ type LoginPolicyChangedEvent struct {
 	MFAInitSkipLifetime        *time.Duration           `json:"mfaInitSkipLifetime,omitempty"`
 	SecondFactorCheckLifetime  *time.Duration           `json:"secondFactorCheckLifetime,omitempty"`
 	MultiFactorCheckLifetime   *time.Duration           `json:"multiFactorCheckLifetime,omitempty"`
+	TrustedDeviceLifetime      *time.Duration           `json:"trustedDeviceLifetime,omitempty"`
 }
 
 func (e *LoginPolicyChangedEvent) Data() interface{} {
@@ -212,6 +216,12 @@ func ChangeMultiFactorCheckLifetime(multiFactorCheckLifetime time.Duration) func
 	}
 }
 
+func ChangeTrustedDeviceLifetime(trustedDeviceLifetime time.Duration) func(*LoginPolicyChangedEvent) {
+	return func(e *LoginPolicyChangedEvent) {
+		e.TrustedDeviceLifetime = &trustedDeviceLifetime
+	}
+}
+
 func ChangeIgnoreUnknownUsernames(ignoreUnknownUsernames bool) func(*LoginPolicyChangedEvent) {
 	return func(e *LoginPolicyChangedEvent) {
 		e.IgnoreUnknownUsernames = &ignoreUnknownUsernames
@@ -3,6 +3,7 @@ package policy
 import (
 	"encoding/json"
 
+	"github.com/dennigogo/zitadel/internal/domain"
 	"github.com/dennigogo/zitadel/internal/eventstore"
 
 	"github.com/dennigogo/zitadel/internal/errors"
@@ -23,6 +24,8 @@ type PasswordComplexityPolicyAddedEvent struct {
 	HasUppercase bool   `json:"hasUppercase,omitempty"`
 	HasNumber    bool   `json:"hasNumber,omitempty"`
 	HasSymbol    bool   `json:"hasSymbol,omitempty"`
+
+	BreachedPasswordCheck domain.BreachedPasswordCheck `json:"breachedPasswordCheck,omitempty"`
 }
 
 func (e *PasswordComplexityPolicyAddedEvent) Data() interface{} {
@@ -40,14 +43,16 @@ func NewPasswordComplexityPolicyAddedEvent(
 	hasUpperCase,
 	hasNumber,
 	hasSymbol bool,
+	breachedPasswordCheck domain.BreachedPasswordCheck,
 ) *PasswordComplexityPolicyAddedEvent {
 	return &PasswordComplexityPolicyAddedEvent{
-		BaseEvent:    *base,
-		MinLength:    minLength,
-		HasLowercase: hasLowerCase,
-		HasUppercase: hasUpperCase,
-		HasNumber:    hasNumber,
-		HasSymbol:    hasSymbol,
+		BaseEvent:             *base,
+		MinLength:             minLength,
+		HasLowercase:          hasLowerCase,
+		HasUppercase:          hasUpperCase,
+		HasNumber:             hasNumber,
+		HasSymbol:             hasSymbol,
+		BreachedPasswordCheck: breachedPasswordCheck,
 	}
 }
 
@@ -72,6 +77,8 @@ type PasswordComplexityPolicyChangedEvent struct {
 	HasUppercase *bool   `json:"hasUppercase,omitempty"`
 	HasNumber    *bool   `json:"hasNumber,omitempty"`
 	HasSymbol    *bool   `json:"hasSymbol,omitempty"`
+
+	BreachedPasswordCheck *domain.BreachedPasswordCheck `json:"breachedPasswordCheck,omitempty"`
 }
 
 func (e *PasswordComplexityPolicyChangedEvent) Data() interface{} {
@@ -130,6 +137,12 @@ func ChangeHasSymbol(hasSymbol bool) func(*PasswordComplexityPolicyChangedEvent)
 	}
 }
 
+func ChangeBreachedPasswordCheck(breachedPasswordCheck domain.BreachedPasswordCheck) func(*PasswordComplexityPolicyChangedEvent) {
+	return func(e *PasswordComplexityPolicyChangedEvent) {
+		e.BreachedPasswordCheck = &breachedPasswordCheck
+	}
+}
+
 func PasswordComplexityPolicyChangedEventMapper(event *repository.Event) (eventstore.Event, error) {
 	e := &PasswordComplexityPolicyChangedEvent{
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
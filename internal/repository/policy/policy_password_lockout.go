@@ -2,6 +2,7 @@ package policy
 
 import (
 	"encoding/json"
+	"time"
 
 	"github.com/dennigogo/zitadel/internal/eventstore"
 
@@ -18,8 +19,13 @@ const (
 type LockoutPolicyAddedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
-	MaxPasswordAttempts uint64 `json:"maxPasswordAttempts,omitempty"`
-	ShowLockOutFailures bool   `json:"showLockOutFailures,omitempty"`
+	MaxPasswordAttempts uint64        `json:"maxPasswordAttempts,omitempty"`
+	MaxOTPAttempts      uint64        `json:"maxOTPAttempts,omitempty"`
+	MaxU2FAttempts      uint64        `json:"maxU2FAttempts,omitempty"`
+	ShowLockOutFailures bool          `json:"showLockOutFailures,omitempty"`
+	AutoUnlockAfter     time.Duration `json:"autoUnlockAfter,omitempty"`
+	AttemptDelay        time.Duration `json:"attemptDelay,omitempty"`
+	MaxAttemptDelay     time.Duration `json:"maxAttemptDelay,omitempty"`
 }
 
 func (e *LockoutPolicyAddedEvent) Data() interface{} {
@@ -32,14 +38,24 @@ func (e *LockoutPolicyAddedEvent) UniqueConstraints() []*eventstore.EventUniqueC
 
 func NewLockoutPolicyAddedEvent(
 	base *eventstore.BaseEvent,
-	maxAttempts uint64,
+	maxAttempts,
+	maxOTPAttempts,
+	maxU2FAttempts uint64,
 	showLockOutFailures bool,
+	autoUnlockAfter,
+	attemptDelay,
+	maxAttemptDelay time.Duration,
 ) *LockoutPolicyAddedEvent {
 
 	return &LockoutPolicyAddedEvent{
 		BaseEvent:           *base,
 		MaxPasswordAttempts: maxAttempts,
+		MaxOTPAttempts:      maxOTPAttempts,
+		MaxU2FAttempts:      maxU2FAttempts,
 		ShowLockOutFailures: showLockOutFailures,
+		AutoUnlockAfter:     autoUnlockAfter,
+		AttemptDelay:        attemptDelay,
+		MaxAttemptDelay:     maxAttemptDelay,
 	}
 }
 
@@ -59,8 +75,13 @@ func LockoutPolicyAddedEventMapper(event *repository.Event) (eventstore.Event, e
 type LockoutPolicyChangedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
-	MaxPasswordAttempts *uint64 `json:"maxPasswordAttempts,omitempty"`
-	ShowLockOutFailures *bool   `json:"showLockOutFailures,omitempty"`
+	MaxPasswordAttempts *uint64        `json:"maxPasswordAttempts,omitempty"`
+	MaxOTPAttempts      *uint64        `json:"maxOTPAttempts,omitempty"`
+	MaxU2FAttempts      *uint64        `json:"maxU2FAttempts,omitempty"`
+	ShowLockOutFailures *bool          `json:"showLockOutFailures,omitempty"`
+	AutoUnlockAfter     *time.Duration `json:"autoUnlockAfter,omitempty"`
+	AttemptDelay        *time.Duration `json:"attemptDelay,omitempty"`
+	MaxAttemptDelay     *time.Duration `json:"maxAttemptDelay,omitempty"`
 }
 
 func (e *LockoutPolicyChangedEvent) Data() interface{} {
@@ -95,12 +116,42 @@ func ChangeMaxAttempts(maxAttempts uint64) func(*LockoutPolicyChangedEvent) {
 	}
 }
 
+func ChangeMaxOTPAttempts(maxAttempts uint64) func(*LockoutPolicyChangedEvent) {
+	return func(e *LockoutPolicyChangedEvent) {
+		e.MaxOTPAttempts = &maxAttempts
+	}
+}
+
+func ChangeMaxU2FAttempts(maxAttempts uint64) func(*LockoutPolicyChangedEvent) {
+	return func(e *LockoutPolicyChangedEvent) {
+		e.MaxU2FAttempts = &maxAttempts
+	}
+}
+
 func ChangeShowLockOutFailures(showLockOutFailures bool) func(*LockoutPolicyChangedEvent) {
 	return func(e *LockoutPolicyChangedEvent) {
 		e.ShowLockOutFailures = &showLockOutFailures
 	}
 }
 
+func ChangeAutoUnlockAfter(autoUnlockAfter time.Duration) func(*LockoutPolicyChangedEvent) {
+	return func(e *LockoutPolicyChangedEvent) {
+		e.AutoUnlockAfter = &autoUnlockAfter
+	}
+}
+
+func ChangeAttemptDelay(attemptDelay time.Duration) func(*LockoutPolicyChangedEvent) {
+	return func(e *LockoutPolicyChangedEvent) {
+		e.AttemptDelay = &attemptDelay
+	}
+}
+
+func ChangeMaxAttemptDelay(maxAttemptDelay time.Duration) func(*LockoutPolicyChangedEvent) {
+	return func(e *LockoutPolicyChangedEvent) {
+		e.MaxAttemptDelay = &maxAttemptDelay
+	}
+}
+
 func LockoutPolicyChangedEventMapper(event *repository.Event) (eventstore.Event, error) {
 	e := &LockoutPolicyChangedEvent{
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
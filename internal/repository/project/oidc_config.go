@@ -23,23 +23,27 @@ const (
 type OIDCConfigAddedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
-	Version                  domain.OIDCVersion         `json:"oidcVersion,omitempty"`
-	AppID                    string                     `json:"appId"`
-	ClientID                 string                     `json:"clientId,omitempty"`
-	ClientSecret             *crypto.CryptoValue        `json:"clientSecret,omitempty"`
-	RedirectUris             []string                   `json:"redirectUris,omitempty"`
-	ResponseTypes            []domain.OIDCResponseType  `json:"responseTypes,omitempty"`
-	GrantTypes               []domain.OIDCGrantType     `json:"grantTypes,omitempty"`
-	ApplicationType          domain.OIDCApplicationType `json:"applicationType,omitempty"`
-	AuthMethodType           domain.OIDCAuthMethodType  `json:"authMethodType,omitempty"`
-	PostLogoutRedirectUris   []string                   `json:"postLogoutRedirectUris,omitempty"`
-	DevMode                  bool                       `json:"devMode,omitempty"`
-	AccessTokenType          domain.OIDCTokenType       `json:"accessTokenType,omitempty"`
-	AccessTokenRoleAssertion bool                       `json:"accessTokenRoleAssertion,omitempty"`
-	IDTokenRoleAssertion     bool                       `json:"idTokenRoleAssertion,omitempty"`
-	IDTokenUserinfoAssertion bool                       `json:"idTokenUserinfoAssertion,omitempty"`
-	ClockSkew                time.Duration              `json:"clockSkew,omitempty"`
-	AdditionalOrigins        []string                   `json:"additionalOrigins,omitempty"`
+	Version                    domain.OIDCVersion         `json:"oidcVersion,omitempty"`
+	AppID                      string                     `json:"appId"`
+	ClientID                   string                     `json:"clientId,omitempty"`
+	ClientSecret               *crypto.CryptoValue        `json:"clientSecret,omitempty"`
+	RedirectUris               []string                   `json:"redirectUris,omitempty"`
+	ResponseTypes              []domain.OIDCResponseType  `json:"responseTypes,omitempty"`
+	GrantTypes                 []domain.OIDCGrantType     `json:"grantTypes,omitempty"`
+	ApplicationType            domain.OIDCApplicationType `json:"applicationType,omitempty"`
+	AuthMethodType             domain.OIDCAuthMethodType  `json:"authMethodType,omitempty"`
+	PostLogoutRedirectUris     []string                   `json:"postLogoutRedirectUris,omitempty"`
+	DevMode                    bool                       `json:"devMode,omitempty"`
+	AccessTokenType            domain.OIDCTokenType       `json:"accessTokenType,omitempty"`
+	AccessTokenRoleAssertion   bool                       `json:"accessTokenRoleAssertion,omitempty"`
+	IDTokenRoleAssertion       bool                       `json:"idTokenRoleAssertion,omitempty"`
+	IDTokenUserinfoAssertion   bool                       `json:"idTokenUserinfoAssertion,omitempty"`
+	ClockSkew                  time.Duration              `json:"clockSkew,omitempty"`
+	AdditionalOrigins          []string                   `json:"additionalOrigins,omitempty"`
+	BackChannelLogoutURI       string                     `json:"backChannelLogoutUri,omitempty"`
+	FrontChannelLogoutURI      string                     `json:"frontChannelLogoutUri,omitempty"`
+	RequirePushedAuthRequests  bool                       `json:"requirePushedAuthRequests,omitempty"`
+	RequireSignedRequestObject bool                       `json:"requireSignedRequestObject,omitempty"`
 }
 
 func (e *OIDCConfigAddedEvent) Data() interface{} {
@@ -70,6 +74,10 @@ func NewOIDCConfigAddedEvent(
 	idTokenUserinfoAssertion bool,
 	clockSkew time.Duration,
 	additionalOrigins []string,
+	backChannelLogoutURI string,
+	frontChannelLogoutURI string,
+	requirePushedAuthRequests bool,
+	requireSignedRequestObject bool,
 ) *OIDCConfigAddedEvent {
 	return &OIDCConfigAddedEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
@@ -77,23 +85,27 @@ func NewOIDCConfigAddedEvent(
 			aggregate,
 			OIDCConfigAddedType,
 		),
-		Version:                  version,
-		AppID:                    appID,
-		ClientID:                 clientID,
-		ClientSecret:             clientSecret,
-		RedirectUris:             redirectUris,
-		ResponseTypes:            responseTypes,
-		GrantTypes:               grantTypes,
-		ApplicationType:          applicationType,
-		AuthMethodType:           authMethodType,
-		PostLogoutRedirectUris:   postLogoutRedirectUris,
-		DevMode:                  devMode,
-		AccessTokenType:          accessTokenType,
-		AccessTokenRoleAssertion: accessTokenRoleAssertion,
-		IDTokenRoleAssertion:     idTokenRoleAssertion,
-		IDTokenUserinfoAssertion: idTokenUserinfoAssertion,
-		ClockSkew:                clockSkew,
-		AdditionalOrigins:        additionalOrigins,
+		Version:                    version,
+		AppID:                      appID,
+		ClientID:                   clientID,
+		ClientSecret:               clientSecret,
+		RedirectUris:               redirectUris,
+		ResponseTypes:              responseTypes,
+		GrantTypes:                 grantTypes,
+		ApplicationType:            applicationType,
+		AuthMethodType:             authMethodType,
+		PostLogoutRedirectUris:     postLogoutRedirectUris,
+		DevMode:                    devMode,
+		AccessTokenType:            accessTokenType,
+		AccessTokenRoleAssertion:   accessTokenRoleAssertion,
+		IDTokenRoleAssertion:       idTokenRoleAssertion,
+		IDTokenUserinfoAssertion:   idTokenUserinfoAssertion,
+		ClockSkew:                  clockSkew,
+		AdditionalOrigins:          additionalOrigins,
+		BackChannelLogoutURI:       backChannelLogoutURI,
+		FrontChannelLogoutURI:      frontChannelLogoutURI,
+		RequirePushedAuthRequests:  requirePushedAuthRequests,
+		RequireSignedRequestObject: requireSignedRequestObject,
 	}
 }
 
@@ -179,6 +191,18 @@ func (e *OIDCConfigAddedEvent) Validate(cmd eventstore.Command) bool {
 			return false
 		}
 	}
+	if e.BackChannelLogoutURI != c.BackChannelLogoutURI {
+		return false
+	}
+	if e.FrontChannelLogoutURI != c.FrontChannelLogoutURI {
+		return false
+	}
+	if e.RequirePushedAuthRequests != c.RequirePushedAuthRequests {
+		return false
+	}
+	if e.RequireSignedRequestObject != c.RequireSignedRequestObject {
+		return false
+	}
 
 	return true
 }
@@ -199,21 +223,25 @@ func OIDCConfigAddedEventMapper(event *repository.Event) (eventstore.Event, erro
 type OIDCConfigChangedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
-	Version                  *domain.OIDCVersion         `json:"oidcVersion,omitempty"`
-	AppID                    string                      `json:"appId"`
-	RedirectUris             *[]string                   `json:"redirectUris,omitempty"`
-	ResponseTypes            *[]domain.OIDCResponseType  `json:"responseTypes,omitempty"`
-	GrantTypes               *[]domain.OIDCGrantType     `json:"grantTypes,omitempty"`
-	ApplicationType          *domain.OIDCApplicationType `json:"applicationType,omitempty"`
-	AuthMethodType           *domain.OIDCAuthMethodType  `json:"authMethodType,omitempty"`
-	PostLogoutRedirectUris   *[]string                   `json:"postLogoutRedirectUris,omitempty"`
-	DevMode                  *bool                       `json:"devMode,omitempty"`
-	AccessTokenType          *domain.OIDCTokenType       `json:"accessTokenType,omitempty"`
-	AccessTokenRoleAssertion *bool                       `json:"accessTokenRoleAssertion,omitempty"`
-	IDTokenRoleAssertion     *bool                       `json:"idTokenRoleAssertion,omitempty"`
-	IDTokenUserinfoAssertion *bool                       `json:"idTokenUserinfoAssertion,omitempty"`
-	ClockSkew                *time.Duration              `json:"clockSkew,omitempty"`
-	AdditionalOrigins        *[]string                   `json:"additionalOrigins,omitempty"`
+	Version                    *domain.OIDCVersion         `json:"oidcVersion,omitempty"`
+	AppID                      string                      `json:"appId"`
+	RedirectUris               *[]string                   `json:"redirectUris,omitempty"`
+	ResponseTypes              *[]domain.OIDCResponseType  `json:"responseTypes,omitempty"`
+	GrantTypes                 *[]domain.OIDCGrantType     `json:"grantTypes,omitempty"`
+	ApplicationType            *domain.OIDCApplicationType `json:"applicationType,omitempty"`
+	AuthMethodType             *domain.OIDCAuthMethodType  `json:"authMethodType,omitempty"`
+	PostLogoutRedirectUris     *[]string                   `json:"postLogoutRedirectUris,omitempty"`
+	DevMode                    *bool                       `json:"devMode,omitempty"`
+	AccessTokenType            *domain.OIDCTokenType       `json:"accessTokenType,omitempty"`
+	AccessTokenRoleAssertion   *bool                       `json:"accessTokenRoleAssertion,omitempty"`
+	IDTokenRoleAssertion       *bool                       `json:"idTokenRoleAssertion,omitempty"`
+	IDTokenUserinfoAssertion   *bool                       `json:"idTokenUserinfoAssertion,omitempty"`
+	ClockSkew                  *time.Duration              `json:"clockSkew,omitempty"`
+	AdditionalOrigins          *[]string                   `json:"additionalOrigins,omitempty"`
+	BackChannelLogoutURI       *string                     `json:"backChannelLogoutUri,omitempty"`
+	FrontChannelLogoutURI      *string                     `json:"frontChannelLogoutUri,omitempty"`
+	RequirePushedAuthRequests  *bool                       `json:"requirePushedAuthRequests,omitempty"`
+	RequireSignedRequestObject *bool                       `json:"requireSignedRequestObject,omitempty"`
 }
 
 func (e *OIDCConfigChangedEvent) Data() interface{} {
@@ -334,6 +362,30 @@ func ChangeAdditionalOrigins(additionalOrigins []string) func(event *OIDCConfigC
 	}
 }
 
+func ChangeBackChannelLogoutURI(backChannelLogoutURI string) func(event *OIDCConfigChangedEvent) {
+	return func(e *OIDCConfigChangedEvent) {
+		e.BackChannelLogoutURI = &backChannelLogoutURI
+	}
+}
+
+func ChangeFrontChannelLogoutURI(frontChannelLogoutURI string) func(event *OIDCConfigChangedEvent) {
+	return func(e *OIDCConfigChangedEvent) {
+		e.FrontChannelLogoutURI = &frontChannelLogoutURI
+	}
+}
+
+func ChangeRequirePushedAuthRequests(requirePushedAuthRequests bool) func(event *OIDCConfigChangedEvent) {
+	return func(e *OIDCConfigChangedEvent) {
+		e.RequirePushedAuthRequests = &requirePushedAuthRequests
+	}
+}
+
+func ChangeRequireSignedRequestObject(requireSignedRequestObject bool) func(event *OIDCConfigChangedEvent) {
+	return func(e *OIDCConfigChangedEvent) {
+		e.RequireSignedRequestObject = &requireSignedRequestObject
+	}
+}
+
 func OIDCConfigChangedEventMapper(event *repository.Event) (eventstore.Event, error) {
 	e := &OIDCConfigChangedEvent{
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
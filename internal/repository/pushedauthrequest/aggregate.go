@@ -0,0 +1,42 @@
+package pushedauthrequest
+
+import "github.com/dennigogo/zitadel/internal/eventstore"
+
+const (
+	AggregateType    = "pushed_auth_request"
+	AggregateVersion = "v1"
+)
+
+type Aggregate struct {
+	eventstore.Aggregate
+}
+
+// NewAggregate returns the pushed authorization request with the given id,
+// pushed authorization requests belong to the instance
+func NewAggregate(id, instanceID string) *Aggregate {
+	return &Aggregate{
+		Aggregate: eventstore.Aggregate{
+			Type:          AggregateType,
+			Version:       AggregateVersion,
+			ID:            id,
+			ResourceOwner: instanceID,
+		},
+	}
+}
+
+const (
+	RequestObjectAggregateType = "request_object"
+)
+
+// NewRequestObjectAggregate returns the used request objects of the client,
+// they belong to the instance as well
+func NewRequestObjectAggregate(clientID, instanceID string) *Aggregate {
+	return &Aggregate{
+		Aggregate: eventstore.Aggregate{
+			Type:          RequestObjectAggregateType,
+			Version:       AggregateVersion,
+			ID:            clientID,
+			ResourceOwner: instanceID,
+		},
+	}
+}
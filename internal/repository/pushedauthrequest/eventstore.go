@@ -0,0 +1,9 @@
+package pushedauthrequest
+
+import "github.com/dennigogo/zitadel/internal/eventstore"
+
+func RegisterEventMappers(es *eventstore.Eventstore) {
+	es.RegisterFilterEventMapper(AddedEventType, AddedEventMapper).
+		RegisterFilterEventMapper(UsedEventType, UsedEventMapper).
+		RegisterFilterEventMapper(RequestObjectUsedEventType, RequestObjectUsedEventMapper)
+}
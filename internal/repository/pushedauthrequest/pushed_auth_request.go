@@ -0,0 +1,103 @@
+package pushedauthrequest
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/eventstore"
+	"github.com/dennigogo/zitadel/internal/eventstore/repository"
+)
+
+const (
+	UniqueUsedType = "pushed_auth_request_used"
+
+	eventTypePrefix = eventstore.EventType("pushed_auth_request.")
+	AddedEventType  = eventTypePrefix + "added"
+	UsedEventType   = eventTypePrefix + "used"
+)
+
+type AddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ClientID string              `json:"clientId"`
+	Params   map[string][]string `json:"params"`
+	Expires  time.Time           `json:"expires"`
+}
+
+func (e *AddedEvent) Data() interface{} {
+	return e
+}
+
+func (e *AddedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	clientID string,
+	params map[string][]string,
+	expires time.Time,
+) *AddedEvent {
+	return &AddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			AddedEventType,
+		),
+		ClientID: clientID,
+		Params:   params,
+		Expires:  expires,
+	}
+}
+
+func AddedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &AddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "PAR-Jq4ns", "unable to unmarshal pushed authorization request added")
+	}
+
+	return e, nil
+}
+
+// NewUsedUniqueConstraint prevents that a pushed authorization request is used concurrently
+func NewUsedUniqueConstraint(id string) *eventstore.EventUniqueConstraint {
+	return eventstore.NewAddEventUniqueConstraint(
+		UniqueUsedType,
+		id,
+		"Errors.PushedAuthRequest.AlreadyUsed")
+}
+
+type UsedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *UsedEvent) Data() interface{} {
+	return nil
+}
+
+func (e *UsedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return []*eventstore.EventUniqueConstraint{NewUsedUniqueConstraint(e.Aggregate().ID)}
+}
+
+func NewUsedEvent(ctx context.Context, aggregate *eventstore.Aggregate) *UsedEvent {
+	return &UsedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			UsedEventType,
+		),
+	}
+}
+
+func UsedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	return &UsedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
@@ -0,0 +1,70 @@
+package pushedauthrequest
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/eventstore"
+	"github.com/dennigogo/zitadel/internal/eventstore/repository"
+)
+
+const (
+	UniqueRequestObjectType = "request_object_jti"
+
+	RequestObjectUsedEventType = eventstore.EventType("request_object.used")
+)
+
+// NewRequestObjectUniqueConstraint prevents that a request object (identified by its jti) of the client is replayed
+func NewRequestObjectUniqueConstraint(clientID, jwtID string) *eventstore.EventUniqueConstraint {
+	return eventstore.NewAddEventUniqueConstraint(
+		UniqueRequestObjectType,
+		clientID+":"+jwtID,
+		"Errors.RequestObject.AlreadyUsed")
+}
+
+type RequestObjectUsedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	JWTID   string    `json:"jti"`
+	Expires time.Time `json:"expires"`
+}
+
+func (e *RequestObjectUsedEvent) Data() interface{} {
+	return e
+}
+
+func (e *RequestObjectUsedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return []*eventstore.EventUniqueConstraint{NewRequestObjectUniqueConstraint(e.Aggregate().ID, e.JWTID)}
+}
+
+func NewRequestObjectUsedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	jwtID string,
+	expires time.Time,
+) *RequestObjectUsedEvent {
+	return &RequestObjectUsedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			RequestObjectUsedEventType,
+		),
+		JWTID:   jwtID,
+		Expires: expires,
+	}
+}
+
+func RequestObjectUsedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &RequestObjectUsedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "PAR-Ro7sk", "unable to unmarshal request object used")
+	}
+
+	return e, nil
+}
@@ -0,0 +1,25 @@
+package quota
+
+import "github.com/dennigogo/zitadel/internal/eventstore"
+
+const (
+	AggregateType    = "quota"
+	AggregateVersion = "v1"
+)
+
+type Aggregate struct {
+	eventstore.Aggregate
+}
+
+// NewAggregate returns the aggregate of the quotas of an instance,
+// which is identified by the id of the instance
+func NewAggregate(instanceID string) *Aggregate {
+	return &Aggregate{
+		Aggregate: eventstore.Aggregate{
+			Type:          AggregateType,
+			Version:       AggregateVersion,
+			ID:            instanceID,
+			ResourceOwner: instanceID,
+		},
+	}
+}
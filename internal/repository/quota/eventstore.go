@@ -0,0 +1,8 @@
+package quota
+
+import "github.com/dennigogo/zitadel/internal/eventstore"
+
+func RegisterEventMappers(es *eventstore.Eventstore) {
+	es.RegisterFilterEventMapper(SetEventType, SetEventMapper).
+		RegisterFilterEventMapper(RemovedEventType, RemovedEventMapper)
+}
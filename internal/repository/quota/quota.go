@@ -0,0 +1,123 @@
+package quota
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/dennigogo/zitadel/internal/domain"
+	"github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/eventstore"
+	"github.com/dennigogo/zitadel/internal/eventstore/repository"
+)
+
+const (
+	eventTypePrefix  = eventstore.EventType("quota.")
+	SetEventType     = eventTypePrefix + "set"
+	RemovedEventType = eventTypePrefix + "removed"
+)
+
+type Notification struct {
+	Percent uint16 `json:"percent"`
+	Repeat  bool   `json:"repeat,omitempty"`
+	CallURL string `json:"callUrl"`
+}
+
+type SetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Unit          domain.QuotaUnit `json:"unit"`
+	From          time.Time        `json:"from,omitempty"`
+	ResetInterval time.Duration    `json:"resetInterval,omitempty"`
+	Amount        uint64           `json:"amount"`
+	Limit         bool             `json:"limit,omitempty"`
+	Notifications []*Notification  `json:"notifications,omitempty"`
+}
+
+func (e *SetEvent) Data() interface{} {
+	return e
+}
+
+func (e *SetEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewSetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	unit domain.QuotaUnit,
+	from time.Time,
+	resetInterval time.Duration,
+	amount uint64,
+	limit bool,
+	notifications []*Notification,
+) *SetEvent {
+	return &SetEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SetEventType,
+		),
+		Unit:          unit,
+		From:          from,
+		ResetInterval: resetInterval,
+		Amount:        amount,
+		Limit:         limit,
+		Notifications: notifications,
+	}
+}
+
+func SetEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &SetEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUOTA-Sf8k2", "unable to unmarshal quota set")
+	}
+
+	return e, nil
+}
+
+type RemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Unit domain.QuotaUnit `json:"unit"`
+}
+
+func (e *RemovedEvent) Data() interface{} {
+	return e
+}
+
+func (e *RemovedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	unit domain.QuotaUnit,
+) *RemovedEvent {
+	return &RemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			RemovedEventType,
+		),
+		Unit: unit,
+	}
+}
+
+func RemovedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &RemovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUOTA-Rm3d7", "unable to unmarshal quota removed")
+	}
+
+	return e, nil
+}
@@ -37,12 +37,14 @@ func RegisterEventMappers(es *eventstore.Eventstore) {
 		RegisterFilterEventMapper(UserV1MFAOTPCheckSucceededType, HumanOTPCheckSucceededEventMapper).
 		RegisterFilterEventMapper(UserV1MFAOTPCheckFailedType, HumanOTPCheckFailedEventMapper).
 		RegisterFilterEventMapper(UserLockedType, UserLockedEventMapper).
+		RegisterFilterEventMapper(UserLockedNotificationSentType, UserLockedNotificationSentEventMapper).
 		RegisterFilterEventMapper(UserUnlockedType, UserUnlockedEventMapper).
 		RegisterFilterEventMapper(UserDeactivatedType, UserDeactivatedEventMapper).
 		RegisterFilterEventMapper(UserReactivatedType, UserReactivatedEventMapper).
 		RegisterFilterEventMapper(UserRemovedType, UserRemovedEventMapper).
 		RegisterFilterEventMapper(UserTokenAddedType, UserTokenAddedEventMapper).
 		RegisterFilterEventMapper(UserTokenRemovedType, UserTokenRemovedEventMapper).
+		RegisterFilterEventMapper(UserImpersonatedType, UserImpersonatedEventMapper).
 		RegisterFilterEventMapper(UserDomainClaimedType, DomainClaimedEventMapper).
 		RegisterFilterEventMapper(UserDomainClaimedSentType, DomainClaimedSentEventMapper).
 		RegisterFilterEventMapper(UserUserNameChangedType, UsernameChangedEventMapper).
@@ -57,10 +59,13 @@ func RegisterEventMappers(es *eventstore.Eventstore) {
 		RegisterFilterEventMapper(HumanInitializedCheckFailedType, HumanInitializedCheckFailedEventMapper).
 		RegisterFilterEventMapper(HumanSignedOutType, HumanSignedOutEventMapper).
 		RegisterFilterEventMapper(HumanPasswordChangedType, HumanPasswordChangedEventMapper).
+		RegisterFilterEventMapper(HumanPasswordHashUpdatedType, HumanPasswordHashUpdatedEventMapper).
 		RegisterFilterEventMapper(HumanPasswordCodeAddedType, HumanPasswordCodeAddedEventMapper).
 		RegisterFilterEventMapper(HumanPasswordCodeSentType, HumanPasswordCodeSentEventMapper).
 		RegisterFilterEventMapper(HumanPasswordCheckSucceededType, HumanPasswordCheckSucceededEventMapper).
 		RegisterFilterEventMapper(HumanPasswordCheckFailedType, HumanPasswordCheckFailedEventMapper).
+		RegisterFilterEventMapper(HumanPasswordExpiryNotificationRequestedType, HumanPasswordExpiryNotificationRequestedEventMapper).
+		RegisterFilterEventMapper(HumanPasswordExpiryNotificationSentType, HumanPasswordExpiryNotificationSentEventMapper).
 		RegisterFilterEventMapper(UserIDPLinkAddedType, UserIDPLinkAddedEventMapper).
 		RegisterFilterEventMapper(UserIDPLinkRemovedType, UserIDPLinkRemovedEventMapper).
 		RegisterFilterEventMapper(UserIDPLinkCascadeRemovedType, UserIDPLinkCascadeRemovedEventMapper).
@@ -81,11 +86,29 @@ func RegisterEventMappers(es *eventstore.Eventstore) {
 		RegisterFilterEventMapper(HumanAvatarRemovedType, HumanAvatarRemovedEventMapper).
 		RegisterFilterEventMapper(HumanAddressChangedType, HumanAddressChangedEventMapper).
 		RegisterFilterEventMapper(HumanMFAInitSkippedType, HumanMFAInitSkippedEventMapper).
+		RegisterFilterEventMapper(HumanRecoveryCodesAddedType, HumanRecoveryCodesAddedEventMapper).
+		RegisterFilterEventMapper(HumanRecoveryCodeCheckSucceededType, HumanRecoveryCodeCheckSucceededEventMapper).
+		RegisterFilterEventMapper(HumanRecoveryCodeCheckFailedType, HumanRecoveryCodeCheckFailedEventMapper).
+		RegisterFilterEventMapper(HumanRecoveryCodeNotificationSentType, HumanRecoveryCodeNotificationSentEventMapper).
+		RegisterFilterEventMapper(HumanMFADeviceTrustedType, HumanMFADeviceTrustedEventMapper).
+		RegisterFilterEventMapper(HumanMFADeviceRevokedType, HumanMFADeviceRevokedEventMapper).
 		RegisterFilterEventMapper(HumanMFAOTPAddedType, HumanOTPAddedEventMapper).
 		RegisterFilterEventMapper(HumanMFAOTPVerifiedType, HumanOTPVerifiedEventMapper).
 		RegisterFilterEventMapper(HumanMFAOTPRemovedType, HumanOTPRemovedEventMapper).
 		RegisterFilterEventMapper(HumanMFAOTPCheckSucceededType, HumanOTPCheckSucceededEventMapper).
 		RegisterFilterEventMapper(HumanMFAOTPCheckFailedType, HumanOTPCheckFailedEventMapper).
+		RegisterFilterEventMapper(HumanOTPSMSAddedType, HumanOTPSMSAddedEventMapper).
+		RegisterFilterEventMapper(HumanOTPSMSRemovedType, HumanOTPSMSRemovedEventMapper).
+		RegisterFilterEventMapper(HumanOTPSMSCodeAddedType, HumanOTPSMSCodeAddedEventMapper).
+		RegisterFilterEventMapper(HumanOTPSMSCodeSentType, HumanOTPSMSCodeSentEventMapper).
+		RegisterFilterEventMapper(HumanOTPSMSCheckSucceededType, HumanOTPSMSCheckSucceededEventMapper).
+		RegisterFilterEventMapper(HumanOTPSMSCheckFailedType, HumanOTPSMSCheckFailedEventMapper).
+		RegisterFilterEventMapper(HumanOTPEmailAddedType, HumanOTPEmailAddedEventMapper).
+		RegisterFilterEventMapper(HumanOTPEmailRemovedType, HumanOTPEmailRemovedEventMapper).
+		RegisterFilterEventMapper(HumanOTPEmailCodeAddedType, HumanOTPEmailCodeAddedEventMapper).
+		RegisterFilterEventMapper(HumanOTPEmailCodeSentType, HumanOTPEmailCodeSentEventMapper).
+		RegisterFilterEventMapper(HumanOTPEmailCheckSucceededType, HumanOTPEmailCheckSucceededEventMapper).
+		RegisterFilterEventMapper(HumanOTPEmailCheckFailedType, HumanOTPEmailCheckFailedEventMapper).
 		RegisterFilterEventMapper(HumanU2FTokenAddedType, HumanU2FAddedEventMapper).
 		RegisterFilterEventMapper(HumanU2FTokenVerifiedType, HumanU2FVerifiedEventMapper).
 		RegisterFilterEventMapper(HumanU2FTokenSignCountChangedType, HumanU2FSignCountChangedEventMapper).
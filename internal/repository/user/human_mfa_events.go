@@ -2,7 +2,10 @@ package user
 
 import (
 	"context"
+	"encoding/json"
 
+	"github.com/dennigogo/zitadel/internal/crypto"
+	"github.com/dennigogo/zitadel/internal/errors"
 	"github.com/dennigogo/zitadel/internal/eventstore"
 
 	"github.com/dennigogo/zitadel/internal/eventstore/repository"
@@ -11,6 +14,12 @@ import (
 const (
 	mfaEventPrefix          = humanEventPrefix + "mfa."
 	HumanMFAInitSkippedType = mfaEventPrefix + "init.skipped"
+
+	recoveryCodesEventPrefix              = mfaEventPrefix + "recovery.codes."
+	HumanRecoveryCodesAddedType           = recoveryCodesEventPrefix + "added"
+	HumanRecoveryCodeCheckSucceededType   = recoveryCodesEventPrefix + "check.succeeded"
+	HumanRecoveryCodeCheckFailedType      = recoveryCodesEventPrefix + "check.failed"
+	HumanRecoveryCodeNotificationSentType = recoveryCodesEventPrefix + "notification.sent"
 )
 
 type HumanMFAInitSkippedEvent struct {
@@ -40,3 +49,158 @@ func HumanMFAInitSkippedEventMapper(event *repository.Event) (eventstore.Event,
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
 	}, nil
 }
+
+// HumanRecoveryCodesAddedEvent replaces all existing recovery codes of the user
+type HumanRecoveryCodesAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Codes []*crypto.CryptoValue `json:"codes,omitempty"`
+}
+
+func (e *HumanRecoveryCodesAddedEvent) Data() interface{} {
+	return e
+}
+
+func (e *HumanRecoveryCodesAddedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewHumanRecoveryCodesAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	codes []*crypto.CryptoValue,
+) *HumanRecoveryCodesAddedEvent {
+	return &HumanRecoveryCodesAddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanRecoveryCodesAddedType,
+		),
+		Codes: codes,
+	}
+}
+
+func HumanRecoveryCodesAddedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	codesAdded := &HumanRecoveryCodesAddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := json.Unmarshal(event.Data, codesAdded)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "USER-Rc3dA", "unable to unmarshal human recovery codes added")
+	}
+	return codesAdded, nil
+}
+
+type HumanRecoveryCodeCheckSucceededEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	// CodeIndex is the position of the used code in the codes of the last added event
+	CodeIndex      int `json:"codeIndex"`
+	RemainingCodes int `json:"remainingCodes"`
+	*AuthRequestInfo
+}
+
+func (e *HumanRecoveryCodeCheckSucceededEvent) Data() interface{} {
+	return e
+}
+
+func (e *HumanRecoveryCodeCheckSucceededEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewHumanRecoveryCodeCheckSucceededEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	codeIndex,
+	remainingCodes int,
+	info *AuthRequestInfo,
+) *HumanRecoveryCodeCheckSucceededEvent {
+	return &HumanRecoveryCodeCheckSucceededEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanRecoveryCodeCheckSucceededType,
+		),
+		CodeIndex:       codeIndex,
+		RemainingCodes:  remainingCodes,
+		AuthRequestInfo: info,
+	}
+}
+
+func HumanRecoveryCodeCheckSucceededEventMapper(event *repository.Event) (eventstore.Event, error) {
+	checkSucceeded := &HumanRecoveryCodeCheckSucceededEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := json.Unmarshal(event.Data, checkSucceeded)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "USER-Rc8sK", "unable to unmarshal human recovery code check succeeded")
+	}
+	return checkSucceeded, nil
+}
+
+type HumanRecoveryCodeCheckFailedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+	*AuthRequestInfo
+}
+
+func (e *HumanRecoveryCodeCheckFailedEvent) Data() interface{} {
+	return e
+}
+
+func (e *HumanRecoveryCodeCheckFailedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewHumanRecoveryCodeCheckFailedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	info *AuthRequestInfo,
+) *HumanRecoveryCodeCheckFailedEvent {
+	return &HumanRecoveryCodeCheckFailedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanRecoveryCodeCheckFailedType,
+		),
+		AuthRequestInfo: info,
+	}
+}
+
+func HumanRecoveryCodeCheckFailedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	checkFailed := &HumanRecoveryCodeCheckFailedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := json.Unmarshal(event.Data, checkFailed)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "USER-Rc0fE", "unable to unmarshal human recovery code check failed")
+	}
+	return checkFailed, nil
+}
+
+type HumanRecoveryCodeNotificationSentEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *HumanRecoveryCodeNotificationSentEvent) Data() interface{} {
+	return nil
+}
+
+func (e *HumanRecoveryCodeNotificationSentEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewHumanRecoveryCodeNotificationSentEvent(ctx context.Context, aggregate *eventstore.Aggregate) *HumanRecoveryCodeNotificationSentEvent {
+	return &HumanRecoveryCodeNotificationSentEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanRecoveryCodeNotificationSentType,
+		),
+	}
+}
+
+func HumanRecoveryCodeNotificationSentEventMapper(event *repository.Event) (eventstore.Event, error) {
+	return &HumanRecoveryCodeNotificationSentEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
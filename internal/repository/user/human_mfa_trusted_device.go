@@ -0,0 +1,102 @@
+package user
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/eventstore"
+	"github.com/dennigogo/zitadel/internal/eventstore/repository"
+)
+
+const (
+	trustedDeviceEventPrefix  = mfaEventPrefix + "device."
+	HumanMFADeviceTrustedType = trustedDeviceEventPrefix + "trusted"
+	HumanMFADeviceRevokedType = trustedDeviceEventPrefix + "revoked"
+)
+
+// HumanMFADeviceTrustedEvent marks the user agent as trusted,
+// mfa is skipped on it for the trusted device lifetime of the login policy
+type HumanMFADeviceTrustedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	UserAgentID string `json:"userAgentID"`
+	// Description describes the device to the user, e.g. the user agent header of the browser
+	Description string `json:"description,omitempty"`
+}
+
+func (e *HumanMFADeviceTrustedEvent) Data() interface{} {
+	return e
+}
+
+func (e *HumanMFADeviceTrustedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewHumanMFADeviceTrustedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userAgentID,
+	description string,
+) *HumanMFADeviceTrustedEvent {
+	return &HumanMFADeviceTrustedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanMFADeviceTrustedType,
+		),
+		UserAgentID: userAgentID,
+		Description: description,
+	}
+}
+
+func HumanMFADeviceTrustedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	deviceTrusted := &HumanMFADeviceTrustedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := json.Unmarshal(event.Data, deviceTrusted)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "USER-Td3kf", "unable to unmarshal human mfa device trusted")
+	}
+	return deviceTrusted, nil
+}
+
+type HumanMFADeviceRevokedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	UserAgentID string `json:"userAgentID"`
+}
+
+func (e *HumanMFADeviceRevokedEvent) Data() interface{} {
+	return e
+}
+
+func (e *HumanMFADeviceRevokedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewHumanMFADeviceRevokedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userAgentID string,
+) *HumanMFADeviceRevokedEvent {
+	return &HumanMFADeviceRevokedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanMFADeviceRevokedType,
+		),
+		UserAgentID: userAgentID,
+	}
+}
+
+func HumanMFADeviceRevokedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	deviceRevoked := &HumanMFADeviceRevokedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := json.Unmarshal(event.Data, deviceRevoked)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "USER-Td8sw", "unable to unmarshal human mfa device revoked")
+	}
+	return deviceRevoked, nil
+}
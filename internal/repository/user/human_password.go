@@ -20,6 +20,10 @@ const (
 	HumanPasswordCodeSentType       = passwordEventPrefix + "code.sent"
 	HumanPasswordCheckSucceededType = passwordEventPrefix + "check.succeeded"
 	HumanPasswordCheckFailedType    = passwordEventPrefix + "check.failed"
+	HumanPasswordHashUpdatedType    = passwordEventPrefix + "hash.updated"
+
+	HumanPasswordExpiryNotificationRequestedType = passwordEventPrefix + "expiry.notification.requested"
+	HumanPasswordExpiryNotificationSentType      = passwordEventPrefix + "expiry.notification.sent"
 )
 
 type HumanPasswordChangedEvent struct {
@@ -69,6 +73,49 @@ func HumanPasswordChangedEventMapper(event *repository.Event) (eventstore.Event,
 	return humanAdded, nil
 }
 
+// HumanPasswordHashUpdatedEvent is pushed if the hash of the unchanged password was updated,
+// e.g. because the preferred algorithm or cost changed
+type HumanPasswordHashUpdatedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Secret *crypto.CryptoValue `json:"secret,omitempty"`
+}
+
+func (e *HumanPasswordHashUpdatedEvent) Data() interface{} {
+	return e
+}
+
+func (e *HumanPasswordHashUpdatedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewHumanPasswordHashUpdatedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	secret *crypto.CryptoValue,
+) *HumanPasswordHashUpdatedEvent {
+	return &HumanPasswordHashUpdatedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanPasswordHashUpdatedType,
+		),
+		Secret: secret,
+	}
+}
+
+func HumanPasswordHashUpdatedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	hashUpdated := &HumanPasswordHashUpdatedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := json.Unmarshal(event.Data, hashUpdated)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "USER-Hs7d3", "unable to unmarshal human password hash updated")
+	}
+
+	return hashUpdated, nil
+}
+
 type HumanPasswordCodeAddedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
@@ -223,3 +270,77 @@ func HumanPasswordCheckFailedEventMapper(event *repository.Event) (eventstore.Ev
 
 	return humanAdded, nil
 }
+
+type HumanPasswordExpiryNotificationRequestedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ExpirationDate time.Time `json:"expirationDate,omitempty"`
+}
+
+func (e *HumanPasswordExpiryNotificationRequestedEvent) Data() interface{} {
+	return e
+}
+
+func (e *HumanPasswordExpiryNotificationRequestedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewHumanPasswordExpiryNotificationRequestedEvent(ctx context.Context, aggregate *eventstore.Aggregate, expirationDate time.Time) *HumanPasswordExpiryNotificationRequestedEvent {
+	return &HumanPasswordExpiryNotificationRequestedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanPasswordExpiryNotificationRequestedType,
+		),
+		ExpirationDate: expirationDate,
+	}
+}
+
+func HumanPasswordExpiryNotificationRequestedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	requested := &HumanPasswordExpiryNotificationRequestedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := json.Unmarshal(event.Data, requested)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "USER-Pe6rQ", "unable to unmarshal human password expiry notification requested")
+	}
+
+	return requested, nil
+}
+
+type HumanPasswordExpiryNotificationSentEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ExpirationDate time.Time `json:"expirationDate,omitempty"`
+}
+
+func (e *HumanPasswordExpiryNotificationSentEvent) Data() interface{} {
+	return e
+}
+
+func (e *HumanPasswordExpiryNotificationSentEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewHumanPasswordExpiryNotificationSentEvent(ctx context.Context, aggregate *eventstore.Aggregate, expirationDate time.Time) *HumanPasswordExpiryNotificationSentEvent {
+	return &HumanPasswordExpiryNotificationSentEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanPasswordExpiryNotificationSentType,
+		),
+		ExpirationDate: expirationDate,
+	}
+}
+
+func HumanPasswordExpiryNotificationSentEventMapper(event *repository.Event) (eventstore.Event, error) {
+	sent := &HumanPasswordExpiryNotificationSentEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := json.Unmarshal(event.Data, sent)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "USER-Pe3xN", "unable to unmarshal human password expiry notification sent")
+	}
+
+	return sent, nil
+}
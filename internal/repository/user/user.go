@@ -13,18 +13,20 @@ import (
 )
 
 const (
-	UniqueUsername            = "usernames"
-	userEventTypePrefix       = eventstore.EventType("user.")
-	UserLockedType            = userEventTypePrefix + "locked"
-	UserUnlockedType          = userEventTypePrefix + "unlocked"
-	UserDeactivatedType       = userEventTypePrefix + "deactivated"
-	UserReactivatedType       = userEventTypePrefix + "reactivated"
-	UserRemovedType           = userEventTypePrefix + "removed"
-	UserTokenAddedType        = userEventTypePrefix + "token.added"
-	UserTokenRemovedType      = userEventTypePrefix + "token.removed"
-	UserDomainClaimedType     = userEventTypePrefix + "domain.claimed"
-	UserDomainClaimedSentType = userEventTypePrefix + "domain.claimed.sent"
-	UserUserNameChangedType   = userEventTypePrefix + "username.changed"
+	UniqueUsername                 = "usernames"
+	userEventTypePrefix            = eventstore.EventType("user.")
+	UserLockedType                 = userEventTypePrefix + "locked"
+	UserLockedNotificationSentType = userEventTypePrefix + "locked.notification.sent"
+	UserUnlockedType               = userEventTypePrefix + "unlocked"
+	UserDeactivatedType            = userEventTypePrefix + "deactivated"
+	UserReactivatedType            = userEventTypePrefix + "reactivated"
+	UserRemovedType                = userEventTypePrefix + "removed"
+	UserTokenAddedType             = userEventTypePrefix + "token.added"
+	UserTokenRemovedType           = userEventTypePrefix + "token.removed"
+	UserImpersonatedType           = userEventTypePrefix + "impersonated"
+	UserDomainClaimedType          = userEventTypePrefix + "domain.claimed"
+	UserDomainClaimedSentType      = userEventTypePrefix + "domain.claimed.sent"
+	UserUserNameChangedType        = userEventTypePrefix + "username.changed"
 )
 
 func NewAddUsernameUniqueConstraint(userName, resourceOwner string, userLoginMustBeDomain bool) *eventstore.EventUniqueConstraint {
@@ -50,10 +52,17 @@ func NewRemoveUsernameUniqueConstraint(userName, resourceOwner string, userLogin
 
 type UserLockedEvent struct {
 	eventstore.BaseEvent `json:"-"`
+
+	// AutoUnlock is set if the user was locked because of too many failed checks,
+	// such locks are lifted after the auto unlock duration of the lockout policy
+	AutoUnlock bool `json:"autoUnlock,omitempty"`
 }
 
 func (e *UserLockedEvent) Data() interface{} {
-	return nil
+	if !e.AutoUnlock {
+		return nil
+	}
+	return e
 }
 
 func (e *UserLockedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
@@ -70,8 +79,51 @@ func NewUserLockedEvent(ctx context.Context, aggregate *eventstore.Aggregate) *U
 	}
 }
 
+// NewUserLockedByLockoutEvent locks the user because the attempts of the lockout policy are exceeded
+func NewUserLockedByLockoutEvent(ctx context.Context, aggregate *eventstore.Aggregate) *UserLockedEvent {
+	event := NewUserLockedEvent(ctx, aggregate)
+	event.AutoUnlock = true
+	return event
+}
+
 func UserLockedEventMapper(event *repository.Event) (eventstore.Event, error) {
-	return &UserLockedEvent{
+	lockedEvent := &UserLockedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	if len(event.Data) == 0 {
+		return lockedEvent, nil
+	}
+	err := json.Unmarshal(event.Data, lockedEvent)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "USER-Lo5kq", "unable to unmarshal user locked")
+	}
+	return lockedEvent, nil
+}
+
+type UserLockedNotificationSentEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *UserLockedNotificationSentEvent) Data() interface{} {
+	return nil
+}
+
+func (e *UserLockedNotificationSentEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewUserLockedNotificationSentEvent(ctx context.Context, aggregate *eventstore.Aggregate) *UserLockedNotificationSentEvent {
+	return &UserLockedNotificationSentEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			UserLockedNotificationSentType,
+		),
+	}
+}
+
+func UserLockedNotificationSentEventMapper(event *repository.Event) (eventstore.Event, error) {
+	return &UserLockedNotificationSentEvent{
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
 	}, nil
 }
@@ -219,6 +271,11 @@ type UserTokenAddedEvent struct {
 	Scopes            []string  `json:"scopes"`
 	Expiration        time.Time `json:"expiration"`
 	PreferredLanguage string    `json:"preferredLanguage"`
+	// DPoPJKT and CertificateThumbprint bind the token to the key of the client (DPoP or mTLS)
+	DPoPJKT               string `json:"dpopJkt,omitempty"`
+	CertificateThumbprint string `json:"certificateThumbprint,omitempty"`
+	// Actor is set for tokens issued by a token exchange with an acting party
+	Actor *domain.TokenActor `json:"actor,omitempty"`
 }
 
 func (e *UserTokenAddedEvent) Data() interface{} {
@@ -240,6 +297,9 @@ func NewUserTokenAddedEvent(
 	audience,
 	scopes []string,
 	expiration time.Time,
+	dpopJKT,
+	certificateThumbprint string,
+	actor *domain.TokenActor,
 ) *UserTokenAddedEvent {
 	return &UserTokenAddedEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
@@ -255,6 +315,10 @@ func NewUserTokenAddedEvent(
 		Scopes:            scopes,
 		Expiration:        expiration,
 		PreferredLanguage: preferredLanguage,
+
+		DPoPJKT:               dpopJKT,
+		CertificateThumbprint: certificateThumbprint,
+		Actor:                 actor,
 	}
 }
 
@@ -311,6 +375,50 @@ func UserTokenRemovedEventMapper(event *repository.Event) (eventstore.Event, err
 	return tokenRemoved, nil
 }
 
+type UserImpersonatedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ApplicationID string             `json:"applicationId,omitempty"`
+	Actor         *domain.TokenActor `json:"actor,omitempty"`
+}
+
+func (e *UserImpersonatedEvent) Data() interface{} {
+	return e
+}
+
+func (e *UserImpersonatedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewUserImpersonatedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	applicationID string,
+	actor *domain.TokenActor,
+) *UserImpersonatedEvent {
+	return &UserImpersonatedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			UserImpersonatedType,
+		),
+		ApplicationID: applicationID,
+		Actor:         actor,
+	}
+}
+
+func UserImpersonatedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	impersonated := &UserImpersonatedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := json.Unmarshal(event.Data, impersonated)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "USER-Imp4r", "unable to unmarshal user impersonated")
+	}
+
+	return impersonated, nil
+}
+
 type DomainClaimedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
@@ -0,0 +1,12 @@
+package webhook
+
+import "github.com/dennigogo/zitadel/internal/eventstore"
+
+func RegisterEventMappers(es *eventstore.Eventstore) {
+	es.RegisterFilterEventMapper(AddedEventType, AddedEventMapper).
+		RegisterFilterEventMapper(ChangedEventType, ChangedEventMapper).
+		RegisterFilterEventMapper(SecretChangedEventType, SecretChangedEventMapper).
+		RegisterFilterEventMapper(DeactivatedEventType, DeactivatedEventMapper).
+		RegisterFilterEventMapper(ReactivatedEventType, ReactivatedEventMapper).
+		RegisterFilterEventMapper(RemovedEventType, RemovedEventMapper)
+}
@@ -0,0 +1,66 @@
+package webhook
+
+import (
+	"github.com/dennigogo/zitadel/internal/eventstore"
+	"github.com/dennigogo/zitadel/internal/repository/org"
+	"github.com/dennigogo/zitadel/internal/repository/project"
+	"github.com/dennigogo/zitadel/internal/repository/user"
+	"github.com/dennigogo/zitadel/internal/repository/usergrant"
+)
+
+// SubscribableEvents lists the event types webhooks can subscribe to, grouped by their aggregate type.
+// Events carrying credentials (passwords, codes, keys, tokens) are left out on purpose.
+var SubscribableEvents = map[eventstore.AggregateType][]eventstore.EventType{
+	user.AggregateType: {
+		user.HumanAddedType,
+		user.HumanRegisteredType,
+		user.HumanProfileChangedType,
+		user.HumanEmailChangedType,
+		user.HumanEmailVerifiedType,
+		user.HumanPhoneChangedType,
+		user.HumanPhoneVerifiedType,
+		user.MachineAddedEventType,
+		user.MachineChangedEventType,
+		user.UserUserNameChangedType,
+		user.UserLockedType,
+		user.UserUnlockedType,
+		user.UserDeactivatedType,
+		user.UserReactivatedType,
+		user.UserRemovedType,
+	},
+	usergrant.AggregateType: {
+		usergrant.UserGrantAddedType,
+		usergrant.UserGrantChangedType,
+		usergrant.UserGrantCascadeChangedType,
+		usergrant.UserGrantDeactivatedType,
+		usergrant.UserGrantReactivatedType,
+		usergrant.UserGrantRemovedType,
+		usergrant.UserGrantCascadeRemovedType,
+	},
+	org.AggregateType: {
+		org.OrgAddedEventType,
+		org.OrgChangedEventType,
+		org.OrgDeactivatedEventType,
+		org.OrgReactivatedEventType,
+		org.OrgRemovedEventType,
+	},
+	project.AggregateType: {
+		project.ProjectAddedType,
+		project.ProjectChangedType,
+		project.ProjectDeactivatedType,
+		project.ProjectReactivatedType,
+		project.ProjectRemovedType,
+	},
+}
+
+// IsSubscribable checks if a webhook is allowed to subscribe to the event type
+func IsSubscribable(eventType string) bool {
+	for _, eventTypes := range SubscribableEvents {
+		for _, subscribable := range eventTypes {
+			if string(subscribable) == eventType {
+				return true
+			}
+		}
+	}
+	return false
+}
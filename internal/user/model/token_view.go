@@ -22,6 +22,11 @@ type TokenView struct {
 	PreferredLanguage string
 	RefreshTokenID    string
 	IsPAT             bool
+	// DPoPJKT and CertificateThumbprint are set for sender-constrained tokens (`cnf` claim)
+	DPoPJKT               string
+	CertificateThumbprint string
+	// Actor is set for tokens issued by a token exchange with an acting party (`act` claim)
+	Actor *domain.TokenActor
 }
 
 type TokenSearchRequest struct {
@@ -17,6 +17,7 @@ type UserView struct {
 	CreationDate       time.Time
 	ChangeDate         time.Time
 	State              UserState
+	LockedAt           time.Time
 	Sequence           uint64
 	ResourceOwner      string
 	LastLogin          time.Time
@@ -49,6 +50,9 @@ type HumanView struct {
 	Region                   string
 	StreetAddress            string
 	OTPState                 MFAState
+	OTPSMSAdded              bool
+	OTPEmailAdded            bool
+	RecoveryCodesAdded       bool
 	U2FTokens                []*WebAuthNView
 	PasswordlessTokens       []*WebAuthNView
 	MFAMaxSetUp              domain.MFALevel
@@ -161,10 +165,17 @@ func (u *UserView) MFATypesSetupPossible(level domain.MFALevel, policy *domain.L
 					}
 				case domain.SecondFactorTypeU2F:
 					types = append(types, domain.MFATypeU2F)
+				case domain.SecondFactorTypeOTPSMS:
+					if !u.OTPSMSAdded && u.IsPhoneVerified {
+						types = append(types, domain.MFATypeOTPSMS)
+					}
+				case domain.SecondFactorTypeOTPEmail:
+					if !u.OTPEmailAdded && u.IsEmailVerified {
+						types = append(types, domain.MFATypeOTPEmail)
+					}
 				}
 			}
 		}
-		//PLANNED: add sms
 	}
 	return types
 }
@@ -188,10 +199,22 @@ func (u *UserView) MFATypesAllowed(level domain.MFALevel, policy *domain.LoginPo
 					if u.IsU2FReady() {
 						types = append(types, domain.MFATypeU2F)
 					}
+				case domain.SecondFactorTypeOTPSMS:
+					if u.OTPSMSAdded && u.IsPhoneVerified {
+						types = append(types, domain.MFATypeOTPSMS)
+					}
+				case domain.SecondFactorTypeOTPEmail:
+					if u.OTPEmailAdded && u.IsEmailVerified {
+						types = append(types, domain.MFATypeOTPEmail)
+					}
 				}
 			}
 		}
-		//PLANNED: add sms
+		// recovery codes are only a fallback for the other factors
+		// and must never become the default (last) provider
+		if u.RecoveryCodesAdded && len(types) > 0 {
+			types = append([]domain.MFAType{domain.MFATypeRecoveryCode}, types...)
+		}
 	}
 	return types, required
 }
@@ -1,12 +1,14 @@
 package model
 
 import (
+	"database/sql/driver"
 	"encoding/json"
 	"time"
 
 	"github.com/zitadel/logging"
 
 	"github.com/dennigogo/zitadel/internal/database"
+	"github.com/dennigogo/zitadel/internal/domain"
 	caos_errs "github.com/dennigogo/zitadel/internal/errors"
 	"github.com/dennigogo/zitadel/internal/eventstore"
 	es_models "github.com/dennigogo/zitadel/internal/eventstore/v1/models"
@@ -42,6 +44,27 @@ type TokenView struct {
 	IsPAT             bool                 `json:"-" gorm:"is_pat"`
 	Deactivated       bool                 `json:"-" gorm:"-"`
 	InstanceID        string               `json:"instanceID" gorm:"column:instance_id;primary_key"`
+
+	DPoPJKT               string      `json:"dpopJkt,omitempty" gorm:"column:dpop_jkt"`
+	CertificateThumbprint string      `json:"certificateThumbprint,omitempty" gorm:"column:certificate_thumbprint"`
+	Actor                 *TokenActor `json:"actor,omitempty" gorm:"column:actor"`
+}
+
+// TokenActor is the actor of a token issued by a token exchange, which is stored as JSON
+type TokenActor domain.TokenActor
+
+func (a TokenActor) Value() (driver.Value, error) {
+	return json.Marshal(&a)
+}
+
+func (a *TokenActor) Scan(src interface{}) error {
+	if b, ok := src.([]byte); ok {
+		return json.Unmarshal(b, a)
+	}
+	if s, ok := src.(string); ok {
+		return json.Unmarshal([]byte(s), a)
+	}
+	return nil
 }
 
 func TokenViewToModel(token *TokenView) *usr_model.TokenView {
@@ -60,6 +83,10 @@ func TokenViewToModel(token *TokenView) *usr_model.TokenView {
 		PreferredLanguage: token.PreferredLanguage,
 		RefreshTokenID:    token.RefreshTokenID,
 		IsPAT:             token.IsPAT,
+
+		DPoPJKT:               token.DPoPJKT,
+		CertificateThumbprint: token.CertificateThumbprint,
+		Actor:                 (*domain.TokenActor)(token.Actor),
 	}
 }
 
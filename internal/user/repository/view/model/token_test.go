@@ -0,0 +1,63 @@
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dennigogo/zitadel/internal/domain"
+	es_models "github.com/dennigogo/zitadel/internal/eventstore/v1/models"
+	"github.com/dennigogo/zitadel/internal/repository/user"
+)
+
+func TestTokenView_AppendEvent(t *testing.T) {
+	tokenAdded := func(actor *domain.TokenActor) *es_models.Event {
+		data, err := json.Marshal(user.NewUserTokenAddedEvent(context.Background(), &user.NewAggregate("user1", "org1").Aggregate,
+			"token1", "client1", "agent1", "en", "", []string{"client1"}, []string{"openid"}, time.Time{}, "", "", actor))
+		require.NoError(t, err)
+		return &es_models.Event{
+			AggregateID:   "user1",
+			ResourceOwner: "org1",
+			CreationDate:  now(),
+			Type:          es_models.EventType(user.UserTokenAddedType),
+			Data:          data,
+		}
+	}
+	tests := []struct {
+		name  string
+		event *es_models.Event
+		want  *domain.TokenActor
+	}{
+		{
+			name:  "token without actor",
+			event: tokenAdded(nil),
+			want:  nil,
+		},
+		{
+			name:  "token with actor",
+			event: tokenAdded(&domain.TokenActor{UserID: "actor1", Issuer: "issuer"}),
+			want:  &domain.TokenActor{UserID: "actor1", Issuer: "issuer"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token := new(TokenView)
+			require.NoError(t, token.AppendEvent(tt.event))
+			assert.Equal(t, "token1", token.ID)
+			assert.Equal(t, tt.want, TokenViewToModel(token).Actor)
+		})
+	}
+}
+
+func TestTokenActor_ValueScan(t *testing.T) {
+	actor := &TokenActor{UserID: "actor1", Issuer: "issuer", Actor: &domain.TokenActor{UserID: "actor2"}}
+	value, err := actor.Value()
+	require.NoError(t, err)
+	scanned := new(TokenActor)
+	require.NoError(t, scanned.Scan(value))
+	assert.Equal(t, actor, scanned)
+}
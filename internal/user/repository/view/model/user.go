@@ -47,6 +47,7 @@ type UserView struct {
 	ChangeDate         time.Time            `json:"-" gorm:"column:change_date"`
 	ResourceOwner      string               `json:"-" gorm:"column:resource_owner"`
 	State              int32                `json:"-" gorm:"column:user_state"`
+	LockedAt           time.Time            `json:"-" gorm:"column:locked_at"`
 	LastLogin          time.Time            `json:"-" gorm:"column:last_login"`
 	LoginNames         database.StringArray `json:"-" gorm:"column:login_names"`
 	PreferredLoginName string               `json:"-" gorm:"column:preferred_login_name"`
@@ -88,6 +89,9 @@ type HumanView struct {
 	Region                   string         `json:"region" gorm:"column:region"`
 	StreetAddress            string         `json:"streetAddress" gorm:"column:street_address"`
 	OTPState                 int32          `json:"-" gorm:"column:otp_state"`
+	OTPSMSAdded              bool           `json:"-" gorm:"column:otp_sms_added"`
+	OTPEmailAdded            bool           `json:"-" gorm:"column:otp_email_added"`
+	RecoveryCodesAdded       bool           `json:"-" gorm:"column:recovery_codes_added"`
 	U2FTokens                WebAuthNTokens `json:"-" gorm:"column:u2f_tokens"`
 	MFAMaxSetUp              int32          `json:"-" gorm:"column:mfa_max_set_up"`
 	MFAInitSkipped           time.Time      `json:"-" gorm:"column:mfa_init_skipped"`
@@ -147,6 +151,7 @@ func UserToModel(user *UserView) *model.UserView {
 		CreationDate:       user.CreationDate,
 		ResourceOwner:      user.ResourceOwner,
 		State:              model.UserState(user.State),
+		LockedAt:           user.LockedAt,
 		LastLogin:          user.LastLogin,
 		PreferredLoginName: user.PreferredLoginName,
 		LoginNames:         user.LoginNames,
@@ -177,6 +182,9 @@ func UserToModel(user *UserView) *model.UserView {
 			Region:                   user.Region,
 			StreetAddress:            user.StreetAddress,
 			OTPState:                 model.MFAState(user.OTPState),
+			OTPSMSAdded:              user.OTPSMSAdded,
+			OTPEmailAdded:            user.OTPEmailAdded,
+			RecoveryCodesAdded:       user.RecoveryCodesAdded,
 			MFAMaxSetUp:              domain.MFALevel(user.MFAMaxSetUp),
 			MFAInitSkipped:           user.MFAInitSkipped,
 			InitRequired:             user.InitRequired,
@@ -305,8 +313,10 @@ func (u *UserView) AppendEvent(event *models.Event) (err error) {
 	case user.UserReactivatedType,
 		user.UserUnlockedType:
 		u.State = int32(model.UserStateActive)
+		u.LockedAt = time.Time{}
 	case user.UserLockedType:
 		u.State = int32(model.UserStateLocked)
+		err = u.setLockedAt(event)
 	case user.UserV1MFAOTPAddedType,
 		user.HumanMFAOTPAddedType:
 		if u.HumanView == nil {
@@ -325,6 +335,28 @@ func (u *UserView) AppendEvent(event *models.Event) (err error) {
 	case user.UserV1MFAOTPRemovedType,
 		user.HumanMFAOTPRemovedType:
 		u.OTPState = int32(model.MFAStateUnspecified)
+	case user.HumanOTPSMSAddedType:
+		if u.HumanView == nil {
+			logging.WithFields("sequence", event.Sequence, "instance", event.InstanceID).Warn("event is ignored because human not exists")
+			return errors.ThrowInvalidArgument(nil, "MODEL-Hs9fG", "event ignored: human not exists")
+		}
+		u.OTPSMSAdded = true
+		u.MFAInitSkipped = time.Time{}
+	case user.HumanOTPSMSRemovedType:
+		u.OTPSMSAdded = false
+	case user.HumanOTPEmailAddedType:
+		if u.HumanView == nil {
+			logging.WithFields("sequence", event.Sequence, "instance", event.InstanceID).Warn("event is ignored because human not exists")
+			return errors.ThrowInvalidArgument(nil, "MODEL-Qw2xE", "event ignored: human not exists")
+		}
+		u.OTPEmailAdded = true
+		u.MFAInitSkipped = time.Time{}
+	case user.HumanOTPEmailRemovedType:
+		u.OTPEmailAdded = false
+	case user.HumanRecoveryCodesAddedType:
+		u.RecoveryCodesAdded = true
+	case user.HumanRecoveryCodeCheckSucceededType:
+		err = u.setRecoveryCodesData(event)
 	case user.HumanU2FTokenAddedType:
 		err = u.addU2FToken(event)
 	case user.HumanU2FTokenVerifiedType:
@@ -373,6 +405,24 @@ func (u *UserView) setData(event *models.Event) error {
 	return nil
 }
 
+// setLockedAt sets the date of the lock if the user was locked because of failed checks,
+// so the lock can be lifted after the auto unlock duration of the lockout policy
+func (u *UserView) setLockedAt(event *models.Event) error {
+	u.LockedAt = time.Time{}
+	if len(event.Data) == 0 {
+		return nil
+	}
+	locked := new(user.UserLockedEvent)
+	if err := json.Unmarshal(event.Data, locked); err != nil {
+		logging.Log("MODEL-Lo8ds").WithError(err).Error("could not unmarshal event data")
+		return errors.ThrowInternal(nil, "MODEL-Lo2sx", "could not unmarshal data")
+	}
+	if locked.AutoUnlock {
+		u.LockedAt = event.CreationDate
+	}
+	return nil
+}
+
 func (u *UserView) setPasswordData(event *models.Event) error {
 	password := new(es_model.Password)
 	if err := json.Unmarshal(event.Data, password); err != nil {
@@ -386,6 +436,16 @@ func (u *UserView) setPasswordData(event *models.Event) error {
 	return nil
 }
 
+func (u *UserView) setRecoveryCodesData(event *models.Event) error {
+	check := new(user.HumanRecoveryCodeCheckSucceededEvent)
+	if err := json.Unmarshal(event.Data, check); err != nil {
+		logging.Log("MODEL-Rc8dW").WithError(err).Error("could not unmarshal event data")
+		return errors.ThrowInternal(nil, "MODEL-Rc2nF", "could not unmarshal data")
+	}
+	u.RecoveryCodesAdded = check.RemainingCodes > 0
+	return nil
+}
+
 func (u *UserView) addPasswordlessToken(event *models.Event) error {
 	token, err := webAuthNViewFromEvent(event)
 	if err != nil {
@@ -519,7 +579,9 @@ func (u *UserView) ComputeMFAMaxSetUp() {
 			return
 		}
 	}
-	if u.OTPState == int32(model.MFAStateReady) {
+	if u.OTPState == int32(model.MFAStateReady) ||
+		u.OTPSMSAdded && u.IsPhoneVerified ||
+		u.OTPEmailAdded && u.IsEmailVerified {
 		u.MFAMaxSetUp = int32(domain.MFALevelSecondFactor)
 		return
 	}
@@ -138,10 +138,21 @@ func (v *UserSessionView) AppendEvent(event *models.Event) error {
 	case user.UserV1MFAOTPCheckSucceededType,
 		user.HumanMFAOTPCheckSucceededType:
 		v.setSecondFactorVerification(event.CreationDate, domain.MFATypeOTP)
+	case user.HumanOTPSMSCheckSucceededType:
+		v.setSecondFactorVerification(event.CreationDate, domain.MFATypeOTPSMS)
+	case user.HumanOTPEmailCheckSucceededType:
+		v.setSecondFactorVerification(event.CreationDate, domain.MFATypeOTPEmail)
+	case user.HumanRecoveryCodeCheckSucceededType:
+		v.setSecondFactorVerification(event.CreationDate, domain.MFATypeRecoveryCode)
 	case user.UserV1MFAOTPCheckFailedType,
 		user.UserV1MFAOTPRemovedType,
 		user.HumanMFAOTPCheckFailedType,
 		user.HumanMFAOTPRemovedType,
+		user.HumanOTPSMSCheckFailedType,
+		user.HumanOTPSMSRemovedType,
+		user.HumanOTPEmailCheckFailedType,
+		user.HumanOTPEmailRemovedType,
+		user.HumanRecoveryCodeCheckFailedType,
 		user.HumanU2FTokenCheckFailedType,
 		user.HumanU2FTokenRemovedType:
 		v.SecondFactorVerification = time.Time{}
@@ -292,6 +292,14 @@ func TestUserAppendEvent(t *testing.T) {
 			},
 			result: &UserView{ID: "AggregateID", ResourceOwner: "GrantedOrgID", UserName: "UserName", HumanView: &HumanView{FirstName: "FirstName", LastName: "LastName", Email: "Email", Phone: "Phone", Country: "Country"}, State: int32(model.UserStateLocked)},
 		},
+		{
+			name: "append user lock by lockout event",
+			args: args{
+				event: &es_models.Event{AggregateID: "AggregateID", Sequence: 1, Type: es_models.EventType(user.UserLockedType), ResourceOwner: "GrantedOrgID", CreationDate: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), Data: []byte(`{"autoUnlock":true}`)},
+				user:  &UserView{ID: "AggregateID", ResourceOwner: "GrantedOrgID", UserName: "UserName", HumanView: &HumanView{FirstName: "FirstName", LastName: "LastName", Email: "Email", Phone: "Phone", Country: "Country"}, State: int32(model.UserStateActive)},
+			},
+			result: &UserView{ID: "AggregateID", ResourceOwner: "GrantedOrgID", UserName: "UserName", HumanView: &HumanView{FirstName: "FirstName", LastName: "LastName", Email: "Email", Phone: "Phone", Country: "Country"}, State: int32(model.UserStateLocked), LockedAt: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)},
+		},
 		{
 			name: "append user unlock event",
 			args: args{
@@ -377,6 +385,9 @@ func TestUserAppendEvent(t *testing.T) {
 			if tt.args.user.State != tt.result.State {
 				t.Errorf("got wrong result state: expected: %v, actual: %v ", tt.result.State, tt.args.user.State)
 			}
+			if !tt.args.user.LockedAt.Equal(tt.result.LockedAt) {
+				t.Errorf("got wrong result LockedAt: expected: %v, actual: %v ", tt.result.LockedAt, tt.args.user.LockedAt)
+			}
 			if human := tt.args.user.HumanView; human != nil {
 				if human.FirstName != tt.result.FirstName {
 					t.Errorf("got wrong result FirstName: expected: %v, actual: %v ", tt.result.FirstName, tt.args.user.FirstName)
@@ -0,0 +1,240 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/zitadel/logging"
+
+	"github.com/dennigogo/zitadel/internal/crypto"
+	"github.com/dennigogo/zitadel/internal/delivery"
+	"github.com/dennigogo/zitadel/internal/domain"
+	"github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/eventstore"
+	"github.com/dennigogo/zitadel/internal/query"
+	"github.com/dennigogo/zitadel/internal/query/projection"
+)
+
+const (
+	HeaderWebhookID     = "Zitadel-Webhook-Id"
+	HeaderEventType     = "Zitadel-Event-Type"
+	HeaderEventSequence = "Zitadel-Event-Sequence"
+	// HeaderSignature contains the timestamp and the hex encoded HMAC-SHA256 of "<timestamp>.<body>"
+	// signed with the secret of the webhook, e.g. t=1660000000,v1=5257a869...
+	HeaderSignature = "Zitadel-Signature"
+
+	maxErrorMessageLen = 500
+)
+
+// redactedDataKeys are removed from the event data before it's sent
+var redactedDataKeys = []string{"secret", "code", "encodedHash"}
+
+type payload struct {
+	EventType     eventstore.EventType     `json:"eventType"`
+	AggregateType eventstore.AggregateType `json:"aggregateType"`
+	AggregateID   string                   `json:"aggregateID"`
+	ResourceOwner string                   `json:"resourceOwner"`
+	InstanceID    string                   `json:"instanceID"`
+	Sequence      uint64                   `json:"sequence"`
+	CreationDate  time.Time                `json:"creationDate"`
+	EditorUser    string                   `json:"editorUser,omitempty"`
+	Data          json.RawMessage          `json:"data,omitempty"`
+}
+
+func newPayload(event eventstore.Event) ([]byte, error) {
+	data, err := redactData(event.DataAsBytes())
+	if err != nil {
+		return nil, err
+	}
+	body, err := json.Marshal(&payload{
+		EventType:     event.Type(),
+		AggregateType: event.Aggregate().Type,
+		AggregateID:   event.Aggregate().ID,
+		ResourceOwner: event.Aggregate().ResourceOwner,
+		InstanceID:    event.Aggregate().InstanceID,
+		Sequence:      event.Sequence(),
+		CreationDate:  event.CreationDate(),
+		EditorUser:    event.EditorUser(),
+		Data:          data,
+	})
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "WEBHO-Pq4d8", "unable to marshal payload")
+	}
+	return body, nil
+}
+
+func redactData(data []byte) (json.RawMessage, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	fields := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, errors.ThrowInternal(err, "WEBHO-Rk2s7", "unable to unmarshal event data")
+	}
+	for _, key := range redactedDataKeys {
+		delete(fields, key)
+	}
+	redacted, err := json.Marshal(fields)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "WEBHO-Rm8c1", "unable to marshal event data")
+	}
+	return redacted, nil
+}
+
+func sign(secret []byte, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return fmt.Sprintf("t=%d,v1=%s", timestamp, hex.EncodeToString(mac.Sum(nil)))
+}
+
+// queuedDelivery is the payload of a delivery in the queue,
+// the url and the secret of the webhook are read when it's sent
+type queuedDelivery struct {
+	WebhookID     string               `json:"webhookID"`
+	ResourceOwner string               `json:"resourceOwner"`
+	Sequence      uint64               `json:"sequence"`
+	EventType     eventstore.EventType `json:"eventType"`
+	Body          json.RawMessage      `json:"body"`
+}
+
+func newQueuedDelivery(webhook *query.Webhook, event eventstore.Event, body []byte) ([]byte, error) {
+	queued, err := json.Marshal(&queuedDelivery{
+		WebhookID:     webhook.ID,
+		ResourceOwner: webhook.ResourceOwner,
+		Sequence:      event.Sequence(),
+		EventType:     event.Type(),
+		Body:          body,
+	})
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "WEBHO-Qd3m8", "unable to marshal delivery")
+	}
+	return queued, nil
+}
+
+func deliveryID(webhookID string, sequence uint64) string {
+	return webhookID + ":" + strconv.FormatUint(sequence, 10)
+}
+
+type webhookQueries interface {
+	GetWebhookByID(ctx context.Context, id string, resourceOwner string) (*query.Webhook, error)
+}
+
+var _ delivery.Handler = (*deliveryHandler)(nil)
+
+// deliveryHandler signs the queued events and writes the results of the attempts to the delivery log
+type deliveryHandler struct {
+	client       *sql.DB
+	queries      webhookQueries
+	secretCrypto crypto.EncryptionAlgorithm
+}
+
+func newDeliveryHandler(client *sql.DB, queries webhookQueries, secretCrypto crypto.EncryptionAlgorithm) *deliveryHandler {
+	return &deliveryHandler{
+		client:       client,
+		queries:      queries,
+		secretCrypto: secretCrypto,
+	}
+}
+
+// Request reads the current url and secret of the webhook,
+// so deliveries of deactivated webhooks are given up
+func (h *deliveryHandler) Request(ctx context.Context, d *delivery.Delivery) (*http.Request, error) {
+	queued := new(queuedDelivery)
+	if err := json.Unmarshal(d.Payload, queued); err != nil {
+		return nil, errors.ThrowInvalidArgument(err, "WEBHO-Rq2v8", "unable to unmarshal delivery")
+	}
+	webhook, err := h.queries.GetWebhookByID(ctx, queued.WebhookID, queued.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if webhook.State != domain.WebhookStateActive {
+		return nil, errors.ThrowPreconditionFailed(nil, "WEBHO-Rq5n1", "webhook is not active")
+	}
+	secret, err := crypto.Decrypt(webhook.SigningSecret, h.secretCrypto)
+	if err != nil {
+		return nil, errors.ThrowPreconditionFailed(err, "WEBHO-Rq7s3", "unable to decrypt signing secret")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(queued.Body))
+	if err != nil {
+		return nil, errors.ThrowInvalidArgument(err, "WEBHO-Pz5v3", "unable to create request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderWebhookID, queued.WebhookID)
+	req.Header.Set(HeaderEventType, string(queued.EventType))
+	req.Header.Set(HeaderEventSequence, strconv.FormatUint(queued.Sequence, 10))
+	req.Header.Set(HeaderSignature, sign(secret, time.Now().Unix(), queued.Body))
+	return req, nil
+}
+
+// Attempted updates the entry of the delivery log
+func (h *deliveryHandler) Attempted(ctx context.Context, d *delivery.Delivery, result *delivery.Result) error {
+	queued := new(queuedDelivery)
+	if err := json.Unmarshal(d.Payload, queued); err != nil {
+		return errors.ThrowInvalidArgument(err, "WEBHO-At4c6", "unable to unmarshal delivery")
+	}
+	state := deliveryState(result)
+	if state == domain.WebhookDeliveryStateDeadLetter {
+		logging.WithFields("webhook", queued.WebhookID, "instance", d.InstanceID, "sequence", queued.Sequence, "attempts", result.Attempts).WithError(result.Err).Info("webhook delivery moved to dead letter")
+	}
+	stmt, args, err := squirrel.Update(projection.WebhookDeliveryTable).
+		SetMap(map[string]interface{}{
+			projection.WebhookDeliveryChangeDateCol: time.Now(),
+			projection.WebhookDeliveryStateCol:      state,
+			projection.WebhookDeliveryAttemptsCol:   result.Attempts,
+			projection.WebhookDeliveryStatusCodeCol: statusCode(result),
+			projection.WebhookDeliveryErrorCol:      errorMessage(result),
+		}).
+		Where(squirrel.Eq{
+			projection.WebhookDeliveryInstanceIDCol:    d.InstanceID,
+			projection.WebhookDeliveryWebhookIDCol:     queued.WebhookID,
+			projection.WebhookDeliveryEventSequenceCol: queued.Sequence,
+		}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return errors.ThrowInternal(err, "WEBHO-At7m2", "unable to create statement")
+	}
+	_, err = h.client.ExecContext(ctx, stmt, args...)
+	return err
+}
+
+func deliveryState(result *delivery.Result) domain.WebhookDeliveryState {
+	switch {
+	case result.Succeeded():
+		return domain.WebhookDeliveryStateDelivered
+	case result.Final:
+		return domain.WebhookDeliveryStateDeadLetter
+	default:
+		return domain.WebhookDeliveryStatePending
+	}
+}
+
+func statusCode(result *delivery.Result) interface{} {
+	if result.StatusCode == 0 {
+		return nil
+	}
+	return result.StatusCode
+}
+
+func errorMessage(result *delivery.Result) interface{} {
+	if result.Err == nil {
+		return nil
+	}
+	message := result.Err.Error()
+	if len(message) > maxErrorMessageLen {
+		message = message[:maxErrorMessageLen]
+	}
+	return message
+}
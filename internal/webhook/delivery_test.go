@@ -0,0 +1,186 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dennigogo/zitadel/internal/crypto"
+	"github.com/dennigogo/zitadel/internal/delivery"
+	"github.com/dennigogo/zitadel/internal/domain"
+	"github.com/dennigogo/zitadel/internal/errors"
+	"github.com/dennigogo/zitadel/internal/query"
+)
+
+type testQueries struct {
+	webhook *query.Webhook
+	err     error
+}
+
+func (q *testQueries) GetWebhookByID(context.Context, string, string) (*query.Webhook, error) {
+	return q.webhook, q.err
+}
+
+func queuedPayload(t *testing.T, body string) []byte {
+	t.Helper()
+	payload, err := json.Marshal(&queuedDelivery{
+		WebhookID:     "webhook1",
+		ResourceOwner: "org1",
+		Sequence:      15,
+		EventType:     "user.human.added",
+		Body:          json.RawMessage(body),
+	})
+	require.NoError(t, err)
+	return payload
+}
+
+func Test_deliveryHandler_Request(t *testing.T) {
+	alg := crypto.CreateMockEncryptionAlg(gomock.NewController(t))
+	activeWebhook := &query.Webhook{
+		ID:            "webhook1",
+		ResourceOwner: "org1",
+		State:         domain.WebhookStateActive,
+		URL:           "https://example.com/hook",
+		SigningSecret: &crypto.CryptoValue{
+			CryptoType: crypto.TypeEncryption,
+			Algorithm:  "enc",
+			KeyID:      "id",
+			Crypted:    []byte("secret"),
+		},
+	}
+	tests := []struct {
+		name    string
+		queries *testQueries
+		wantErr func(error) bool
+	}{
+		{
+			name:    "webhook removed, given up",
+			queries: &testQueries{err: errors.ThrowNotFound(nil, "QUERY-Test1", "Errors.Webhook.NotFound")},
+			wantErr: errors.IsNotFound,
+		},
+		{
+			name: "webhook inactive, given up",
+			queries: &testQueries{webhook: &query.Webhook{
+				ID:    "webhook1",
+				State: domain.WebhookStateInactive,
+			}},
+			wantErr: errors.IsPreconditionFailed,
+		},
+		{
+			name:    "query failed, retried",
+			queries: &testQueries{err: errors.ThrowInternal(nil, "QUERY-Test2", "Errors.Internal")},
+			wantErr: errors.IsInternal,
+		},
+		{
+			name:    "signed request",
+			queries: &testQueries{webhook: activeWebhook},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body := `{"eventType":"user.human.added"}`
+			h := newDeliveryHandler(nil, tt.queries, alg)
+			req, err := h.Request(context.Background(), &delivery.Delivery{
+				InstanceID: "instance1",
+				ID:         deliveryID("webhook1", 15),
+				Payload:    queuedPayload(t, body),
+			})
+			if tt.wantErr != nil {
+				assert.True(t, tt.wantErr(err), "unexpected error: %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, http.MethodPost, req.Method)
+			assert.Equal(t, "https://example.com/hook", req.URL.String())
+			assert.Equal(t, "application/json", req.Header.Get("Content-Type"))
+			assert.Equal(t, "webhook1", req.Header.Get(HeaderWebhookID))
+			assert.Equal(t, "user.human.added", req.Header.Get(HeaderEventType))
+			assert.Equal(t, "15", req.Header.Get(HeaderEventSequence))
+
+			received, err := io.ReadAll(req.Body)
+			require.NoError(t, err)
+			assert.Equal(t, body, string(received))
+
+			parts := strings.Split(req.Header.Get(HeaderSignature), ",")
+			require.Len(t, parts, 2)
+			timestamp := strings.TrimPrefix(parts[0], "t=")
+			mac := hmac.New(sha256.New, []byte("secret"))
+			mac.Write([]byte(timestamp + "."))
+			mac.Write(received)
+			assert.Equal(t, "v1="+hex.EncodeToString(mac.Sum(nil)), parts[1])
+		})
+	}
+}
+
+func Test_deliveryHandler_Attempted(t *testing.T) {
+	tests := []struct {
+		name      string
+		result    *delivery.Result
+		wantState domain.WebhookDeliveryState
+	}{
+		{
+			name:      "delivered",
+			result:    &delivery.Result{Attempts: 1, StatusCode: http.StatusOK, Final: true},
+			wantState: domain.WebhookDeliveryStateDelivered,
+		},
+		{
+			name:      "failed, retried",
+			result:    &delivery.Result{Attempts: 1, StatusCode: http.StatusBadGateway, Err: errors.ThrowUnavailable(nil, "DELIV-Test1", "unexpected status code 502")},
+			wantState: domain.WebhookDeliveryStatePending,
+		},
+		{
+			name:      "failed, dead letter",
+			result:    &delivery.Result{Attempts: 1, StatusCode: http.StatusBadRequest, Err: errors.ThrowUnavailable(nil, "DELIV-Test2", "unexpected status code 400"), Final: true},
+			wantState: domain.WebhookDeliveryStateDeadLetter,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer client.Close()
+			mock.ExpectExec(regexp.QuoteMeta("UPDATE projections.webhook_deliveries SET")).
+				WithArgs(tt.result.Attempts, sqlmock.AnyArg(), tt.wantState, errorMessage(tt.result), statusCode(tt.result), 15, "instance1", "webhook1").
+				WillReturnResult(sqlmock.NewResult(0, 1))
+
+			h := newDeliveryHandler(client, nil, nil)
+			err = h.Attempted(context.Background(), &delivery.Delivery{
+				InstanceID: "instance1",
+				ID:         deliveryID("webhook1", 15),
+				Payload:    queuedPayload(t, `{}`),
+			}, tt.result)
+			require.NoError(t, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func Test_errorMessage(t *testing.T) {
+	assert.Nil(t, errorMessage(&delivery.Result{}))
+	got := errorMessage(&delivery.Result{Err: errors.ThrowUnavailable(nil, "DELIV-Test3", strings.Repeat("a", 2*maxErrorMessageLen))})
+	assert.Len(t, got, maxErrorMessageLen)
+}
+
+func Test_redactData(t *testing.T) {
+	got, err := redactData([]byte(`{"userName":"user","secret":{"cryptoType":1},"code":{"cryptoType":0}}`))
+	require.NoError(t, err)
+	fields := make(map[string]interface{})
+	require.NoError(t, json.Unmarshal(got, &fields))
+	assert.Equal(t, map[string]interface{}{"userName": "user"}, fields)
+
+	got, err = redactData(nil)
+	require.NoError(t, err)
+	assert.Nil(t, got)
+}
@@ -0,0 +1,146 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/dennigogo/zitadel/internal/api/authz"
+	"github.com/dennigogo/zitadel/internal/config/systemdefaults"
+	"github.com/dennigogo/zitadel/internal/crypto"
+	"github.com/dennigogo/zitadel/internal/delivery"
+	"github.com/dennigogo/zitadel/internal/domain"
+	"github.com/dennigogo/zitadel/internal/eventstore"
+	"github.com/dennigogo/zitadel/internal/eventstore/handler"
+	"github.com/dennigogo/zitadel/internal/eventstore/handler/crdb"
+	"github.com/dennigogo/zitadel/internal/query"
+	"github.com/dennigogo/zitadel/internal/query/projection"
+	webhook_repo "github.com/dennigogo/zitadel/internal/repository/webhook"
+)
+
+const deliveryKind delivery.Kind = "webhook"
+
+// Start starts the projection, which queues the deliveries of the subscribed events,
+// and the worker, which sends them
+func Start(ctx context.Context, customConfig projection.CustomConfig, config systemdefaults.WebhookDelivery, queries *query.Queries, secretCrypto crypto.EncryptionAlgorithm) {
+	projectionConfig := projection.ApplyCustomConfig(customConfig)
+	projection.WebhookDeliveriesProjection = newDeliveriesProjection(ctx, projectionConfig, queries)
+	delivery.Start(ctx, projectionConfig.Client, deliveryKind, deliveryConfig(config), newDeliveryHandler(projectionConfig.Client, queries, secretCrypto))
+}
+
+func deliveryConfig(config systemdefaults.WebhookDelivery) delivery.Config {
+	return delivery.Config{
+		Timeout:        config.Timeout,
+		MaxAttempts:    config.MaxAttempts,
+		InitialBackoff: config.InitialBackoff,
+		MaxBackoff:     config.MaxBackoff,
+		Interval:       config.Interval,
+		BatchSize:      config.BatchSize,
+	}
+}
+
+// deliveriesProjection queues the subscribed events for the webhooks
+// and keeps a log of the deliveries
+type deliveriesProjection struct {
+	crdb.StatementHandler
+	queries *query.Queries
+}
+
+func newDeliveriesProjection(
+	ctx context.Context,
+	config crdb.StatementHandlerConfig,
+	queries *query.Queries,
+) *deliveriesProjection {
+	p := new(deliveriesProjection)
+	config.ProjectionName = projection.WebhookDeliveryTable
+	config.Reducers = p.reducers()
+	config.InitCheck = crdb.NewTableCheck(
+		crdb.NewTable([]*crdb.Column{
+			crdb.NewColumn(projection.WebhookDeliveryWebhookIDCol, crdb.ColumnTypeText),
+			crdb.NewColumn(projection.WebhookDeliveryCreationDateCol, crdb.ColumnTypeTimestamp),
+			crdb.NewColumn(projection.WebhookDeliveryChangeDateCol, crdb.ColumnTypeTimestamp),
+			crdb.NewColumn(projection.WebhookDeliveryResourceOwnerCol, crdb.ColumnTypeText),
+			crdb.NewColumn(projection.WebhookDeliveryInstanceIDCol, crdb.ColumnTypeText),
+			crdb.NewColumn(projection.WebhookDeliveryStateCol, crdb.ColumnTypeEnum),
+			crdb.NewColumn(projection.WebhookDeliveryEventSequenceCol, crdb.ColumnTypeInt64),
+			crdb.NewColumn(projection.WebhookDeliveryEventTypeCol, crdb.ColumnTypeText),
+			crdb.NewColumn(projection.WebhookDeliveryAggregateTypeCol, crdb.ColumnTypeText),
+			crdb.NewColumn(projection.WebhookDeliveryAggregateIDCol, crdb.ColumnTypeText),
+			crdb.NewColumn(projection.WebhookDeliveryAttemptsCol, crdb.ColumnTypeInt64),
+			crdb.NewColumn(projection.WebhookDeliveryStatusCodeCol, crdb.ColumnTypeInt64, crdb.Nullable()),
+			crdb.NewColumn(projection.WebhookDeliveryErrorCol, crdb.ColumnTypeText, crdb.Nullable()),
+		},
+			crdb.NewPrimaryKey(projection.WebhookDeliveryInstanceIDCol, projection.WebhookDeliveryWebhookIDCol, projection.WebhookDeliveryEventSequenceCol),
+			crdb.WithIndex(crdb.NewIndex("webhook_deliveries_ro_idx", []string{projection.WebhookDeliveryResourceOwnerCol})),
+		),
+	)
+	p.queries = queries
+	p.StatementHandler = crdb.NewStatementHandler(ctx, config)
+	return p
+}
+
+func (p *deliveriesProjection) reducers() []handler.AggregateReducer {
+	reducers := make([]handler.AggregateReducer, 0, len(webhook_repo.SubscribableEvents))
+	for aggregateType, eventTypes := range webhook_repo.SubscribableEvents {
+		eventReducers := make([]handler.EventReducer, len(eventTypes))
+		for i, eventType := range eventTypes {
+			eventReducers[i] = handler.EventReducer{
+				Event:  eventType,
+				Reduce: p.reduceEvent,
+			}
+		}
+		reducers = append(reducers, handler.AggregateReducer{
+			Aggregate:     aggregateType,
+			EventRedusers: eventReducers,
+		})
+	}
+	return reducers
+}
+
+// reduceEvent only queues the deliveries, they are sent by the worker
+// so a slow or unreachable webhook doesn't block the projection
+func (p *deliveriesProjection) reduceEvent(event eventstore.Event) (*handler.Statement, error) {
+	ctx := authz.WithInstanceID(context.Background(), event.Aggregate().InstanceID)
+	webhooks, err := p.queries.ActiveWebhooksByEvent(ctx, event.Aggregate().ResourceOwner, event.Type())
+	if err != nil {
+		return nil, err
+	}
+	if len(webhooks.Webhooks) == 0 {
+		return crdb.NewNoOpStatement(event), nil
+	}
+	body, err := newPayload(event)
+	if err != nil {
+		return nil, err
+	}
+	statements := make([]func(eventstore.Event) crdb.Exec, 0, 2*len(webhooks.Webhooks))
+	for _, webhook := range webhooks.Webhooks {
+		queued, err := newQueuedDelivery(webhook, event, body)
+		if err != nil {
+			return nil, err
+		}
+		statements = append(statements,
+			crdb.AddUpsertStatement(
+				[]handler.Column{
+					handler.NewCol(projection.WebhookDeliveryInstanceIDCol, nil),
+					handler.NewCol(projection.WebhookDeliveryWebhookIDCol, nil),
+					handler.NewCol(projection.WebhookDeliveryEventSequenceCol, nil),
+				},
+				[]handler.Column{
+					handler.NewCol(projection.WebhookDeliveryWebhookIDCol, webhook.ID),
+					handler.NewCol(projection.WebhookDeliveryCreationDateCol, event.CreationDate()),
+					handler.NewCol(projection.WebhookDeliveryChangeDateCol, event.CreationDate()),
+					handler.NewCol(projection.WebhookDeliveryResourceOwnerCol, webhook.ResourceOwner),
+					handler.NewCol(projection.WebhookDeliveryInstanceIDCol, event.Aggregate().InstanceID),
+					handler.NewCol(projection.WebhookDeliveryStateCol, domain.WebhookDeliveryStatePending),
+					handler.NewCol(projection.WebhookDeliveryEventSequenceCol, event.Sequence()),
+					handler.NewCol(projection.WebhookDeliveryEventTypeCol, event.Type()),
+					handler.NewCol(projection.WebhookDeliveryAggregateTypeCol, event.Aggregate().Type),
+					handler.NewCol(projection.WebhookDeliveryAggregateIDCol, event.Aggregate().ID),
+					handler.NewCol(projection.WebhookDeliveryAttemptsCol, 0),
+					handler.NewCol(projection.WebhookDeliveryStatusCodeCol, nil),
+					handler.NewCol(projection.WebhookDeliveryErrorCol, nil),
+				},
+			),
+			delivery.AddEnqueueStatement(deliveryKind, deliveryID(webhook.ID, event.Sequence()), queued),
+		)
+	}
+	return crdb.NewMultiStatement(event, statements...), nil
+}
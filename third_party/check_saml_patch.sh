@@ -0,0 +1,25 @@
+#!/bin/sh
+# verifies that the fork in third_party/saml is the pinned upstream version of github.com/zitadel/saml
+# with saml.patch applied, so the fork doesn't drift from the patch described in saml/PATCHES.md
+set -eu
+
+cd "$(dirname "$0")"
+
+version=$(go list -m -f '{{.Version}}' github.com/zitadel/saml)
+upstream=$(go mod download -json "github.com/zitadel/saml@${version}" | jq -r .Dir)
+
+tmp=$(mktemp -d)
+trap 'rm -rf "${tmp}"' EXIT
+
+cp -R "${upstream}/." "${tmp}"
+chmod -R u+w "${tmp}"
+patch --quiet --directory="${tmp}" -p1 < saml.patch
+
+# only the files used by the go build are compared
+for file in go.mod go.sum pkg; do
+	if ! diff -r "${tmp}/${file}" "saml/${file}"; then
+		echo "third_party/saml differs from github.com/zitadel/saml@${version} with saml.patch applied" >&2
+		exit 1
+	fi
+done
+echo "third_party/saml equals github.com/zitadel/saml@${version} with saml.patch applied"
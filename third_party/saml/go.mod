@@ -0,0 +1,28 @@
+module github.com/zitadel/saml
+
+go 1.17
+
+require (
+	github.com/amdonov/xmlsig v0.1.0
+	github.com/beevik/etree v1.1.0
+	github.com/golang/mock v1.6.0
+	github.com/google/uuid v1.3.0
+	github.com/gorilla/handlers v1.5.1
+	github.com/gorilla/mux v1.8.0
+	github.com/russellhaering/goxmldsig v1.2.0
+	github.com/stretchr/testify v1.8.0
+	github.com/zitadel/logging v0.3.4
+	gopkg.in/square/go-jose.v2 v2.6.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/felixge/httpsnoop v1.0.1 // indirect
+	github.com/google/go-cmp v0.5.2 // indirect
+	github.com/jonboulle/clockwork v0.2.2 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	github.com/sirupsen/logrus v1.8.1 // indirect
+	golang.org/x/crypto v0.0.0-20200622213623-75b288015ac9 // indirect
+	golang.org/x/sys v0.0.0-20220207234003-57398862261d // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
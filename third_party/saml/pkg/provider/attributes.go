@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"github.com/zitadel/saml/pkg/provider/models"
+	"github.com/zitadel/saml/pkg/provider/xml/saml"
+)
+
+const (
+	AttributeEmail int = iota
+	AttributeFullName
+	AttributeGivenName
+	AttributeSurname
+	AttributeUsername
+	AttributeUserID
+)
+
+type Attributes struct {
+	email     string
+	fullName  string
+	givenName string
+	surname   string
+	userID    string
+	username  string
+
+	nameIDFormat     string
+	nameID           string
+	customAttributes []*saml.AttributeType
+}
+
+var _ models.AttributeSetter = &Attributes{}
+
+func (a *Attributes) GetNameID() *saml.NameIDType {
+	if a.nameID != "" {
+		return &saml.NameIDType{
+			Format: a.nameIDFormat,
+			Text:   a.nameID,
+		}
+	}
+	return &saml.NameIDType{
+		Format: "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress",
+		Text:   a.username,
+	}
+}
+
+// SetNameID overwrites the NameID of the subject, which defaults to the username in the emailAddress format
+func (a *Attributes) SetNameID(format, value string) {
+	a.nameIDFormat = format
+	a.nameID = value
+}
+
+// SetCustomAttribute adds an attribute besides the predefined ones,
+// an existing custom attribute with the same name is replaced
+func (a *Attributes) SetCustomAttribute(name, friendlyName, nameFormat string, attributeValue []string) {
+	if nameFormat == "" {
+		nameFormat = "urn:oasis:names:tc:SAML:2.0:attrname-format:basic"
+	}
+	attribute := &saml.AttributeType{
+		Name:           name,
+		FriendlyName:   friendlyName,
+		NameFormat:     nameFormat,
+		AttributeValue: attributeValue,
+	}
+	for i, custom := range a.customAttributes {
+		if custom.Name == name {
+			a.customAttributes[i] = attribute
+			return
+		}
+	}
+	a.customAttributes = append(a.customAttributes, attribute)
+}
+
+func (a *Attributes) SetEmail(value string) {
+	a.email = value
+}
+
+func (a *Attributes) SetFullName(value string) {
+	a.fullName = value
+}
+
+func (a *Attributes) SetGivenName(value string) {
+	a.givenName = value
+}
+
+func (a *Attributes) SetSurname(value string) {
+	a.surname = value
+}
+
+func (a *Attributes) SetUsername(value string) {
+	a.username = value
+}
+
+func (a *Attributes) SetUserID(value string) {
+	a.userID = value
+}
+
+func (a *Attributes) GetSAML() []*saml.AttributeType {
+	attrs := make([]*saml.AttributeType, 0)
+	if a.email != "" {
+		attrs = append(attrs, &saml.AttributeType{
+			Name:           "Email",
+			NameFormat:     "urn:oasis:names:tc:SAML:2.0:attrname-format:basic",
+			AttributeValue: []string{a.email},
+		})
+	}
+	if a.surname != "" {
+		attrs = append(attrs, &saml.AttributeType{
+			Name:           "SurName",
+			NameFormat:     "urn:oasis:names:tc:SAML:2.0:attrname-format:basic",
+			AttributeValue: []string{a.surname},
+		})
+	}
+	if a.givenName != "" {
+		attrs = append(attrs, &saml.AttributeType{
+			Name:           "FirstName",
+			NameFormat:     "urn:oasis:names:tc:SAML:2.0:attrname-format:basic",
+			AttributeValue: []string{a.givenName},
+		})
+	}
+	if a.fullName != "" {
+		attrs = append(attrs, &saml.AttributeType{
+			Name:           "FullName",
+			NameFormat:     "urn:oasis:names:tc:SAML:2.0:attrname-format:basic",
+			AttributeValue: []string{a.fullName},
+		})
+	}
+	if a.username != "" {
+		attrs = append(attrs, &saml.AttributeType{
+			Name:           "UserName",
+			NameFormat:     "urn:oasis:names:tc:SAML:2.0:attrname-format:basic",
+			AttributeValue: []string{a.username},
+		})
+	}
+	if a.userID != "" {
+		attrs = append(attrs, &saml.AttributeType{
+			Name:           "UserID",
+			NameFormat:     "urn:oasis:names:tc:SAML:2.0:attrname-format:basic",
+			AttributeValue: []string{a.userID},
+		})
+	}
+	return append(attrs, a.customAttributes...)
+}
@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/saml/pkg/provider/xml/saml"
+)
+
+func TestAttributes_GetNameID(t *testing.T) {
+	tests := []struct {
+		name       string
+		attributes func(*Attributes)
+		want       *saml.NameIDType
+	}{
+		{
+			name: "default username",
+			attributes: func(a *Attributes) {
+				a.SetUsername("user@example.com")
+			},
+			want: &saml.NameIDType{Format: "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress", Text: "user@example.com"},
+		},
+		{
+			name: "overwritten",
+			attributes: func(a *Attributes) {
+				a.SetUsername("user@example.com")
+				a.SetNameID("urn:oasis:names:tc:SAML:2.0:nameid-format:persistent", "id")
+			},
+			want: &saml.NameIDType{Format: "urn:oasis:names:tc:SAML:2.0:nameid-format:persistent", Text: "id"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attributes := new(Attributes)
+			tt.attributes(attributes)
+			assert.Equal(t, tt.want, attributes.GetNameID())
+		})
+	}
+}
+
+func TestAttributes_SetCustomAttribute(t *testing.T) {
+	attributes := new(Attributes)
+	attributes.SetUserID("id")
+	attributes.SetCustomAttribute("groups", "", "", []string{"admin"})
+	attributes.SetCustomAttribute("department", "Department", "urn:oasis:names:tc:SAML:2.0:attrname-format:uri", []string{"it"})
+	attributes.SetCustomAttribute("groups", "", "", []string{"admin", "user"})
+
+	assert.Equal(t, []*saml.AttributeType{
+		{Name: "UserID", NameFormat: "urn:oasis:names:tc:SAML:2.0:attrname-format:basic", AttributeValue: []string{"id"}},
+		{Name: "groups", NameFormat: "urn:oasis:names:tc:SAML:2.0:attrname-format:basic", AttributeValue: []string{"admin", "user"}},
+		{Name: "department", FriendlyName: "Department", NameFormat: "urn:oasis:names:tc:SAML:2.0:attrname-format:uri", AttributeValue: []string{"it"}},
+	}, attributes.GetSAML())
+}
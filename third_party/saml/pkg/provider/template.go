@@ -0,0 +1,55 @@
+package provider
+
+const postTemplate = `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE html PUBLIC "-//W3C//DTD XHTML 1.1//EN"
+"http://www.w3.org/TR/xhtml11/DTD/xhtml11.dtd">
+<html xmlns="http://www.w3.org/1999/xhtml" xml:lang="en">
+<body onload="document.getElementById('samlpost').submit()">
+<noscript>
+<p>
+<strong>Note:</strong> Since your browser does not support JavaScript,
+you must press the Continue button once to proceed.
+</p>
+</noscript>
+<form action="{{ .AssertionConsumerServiceURL }}" method="post" id="samlpost">
+<div>
+<input type="hidden" name="RelayState"
+value="{{ .RelayState }}"/>
+<input type="hidden" name="SAMLResponse"
+value="{{ .SAMLResponse }}"/>
+</div>
+<noscript>
+<div>
+<input type="submit" value="Continue"/>
+</div>
+</noscript>
+</form>
+</body>
+</html>`
+
+const logoutTemplate = `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE html PUBLIC "-//W3C//DTD XHTML 1.1//EN"
+"http://www.w3.org/TR/xhtml11/DTD/xhtml11.dtd">
+<html xmlns="http://www.w3.org/1999/xhtml" xml:lang="en">
+<body onload="document.getElementById('samlpost').submit()">
+<noscript>
+<p>
+<strong>Note:</strong> Since your browser does not support JavaScript,
+you must press the Continue button once to proceed.
+</p>
+</noscript>
+<form action="{{ .LogoutURL }}" method="post" id="samlpost">
+<div>
+<input type="hidden" name="RelayState"
+value="{{ .RelayState }}"/>
+<input type="hidden" name="SAMLResponse"
+value="{{ .SAMLResponse }}"/>
+</div>
+<noscript>
+<div>
+<input type="submit" value="Continue"/>
+</div>
+</noscript>
+</form>
+</body>
+</html>`